@@ -142,6 +142,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListDynamicConfigHistory(
+		ctx context.Context,
+		Request *admin.ListDynamicConfigHistoryRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListDynamicConfigHistoryResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -202,12 +208,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.RespondCrossClusterTasksCompletedResponse, error)
 
+	RestoreArchivedWorkflow(
+		ctx context.Context,
+		Request *admin.RestoreArchivedWorkflowRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RestoreDynamicConfig(
 		ctx context.Context,
 		Request *admin.RestoreDynamicConfigRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	RollbackDynamicConfig(
+		ctx context.Context,
+		Request *admin.RollbackDynamicConfigRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	UpdateDomainIsolationGroups(
 		ctx context.Context,
 		Request *admin.UpdateDomainIsolationGroupsRequest,
@@ -731,6 +749,34 @@ func (c client) ListDynamicConfig(
 	return
 }
 
+func (c client) ListDynamicConfigHistory(
+	ctx context.Context,
+	_Request *admin.ListDynamicConfigHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListDynamicConfigHistoryResponse, err error) {
+
+	var result admin.AdminService_ListDynamicConfigHistory_Result
+	args := admin.AdminService_ListDynamicConfigHistory_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListDynamicConfigHistory_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MaintainCorruptWorkflow(
 	ctx context.Context,
 	_Request *admin.AdminMaintainWorkflowRequest,
@@ -1011,6 +1057,34 @@ func (c client) RespondCrossClusterTasksCompleted(
 	return
 }
 
+func (c client) RestoreArchivedWorkflow(
+	ctx context.Context,
+	_Request *admin.RestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_RestoreArchivedWorkflow_Result
+	args := admin.AdminService_RestoreArchivedWorkflow_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_RestoreArchivedWorkflow_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RestoreDynamicConfig(
 	ctx context.Context,
 	_Request *admin.RestoreDynamicConfigRequest,
@@ -1039,6 +1113,34 @@ func (c client) RestoreDynamicConfig(
 	return
 }
 
+func (c client) RollbackDynamicConfig(
+	ctx context.Context,
+	_Request *admin.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_RollbackDynamicConfig_Result
+	args := admin.AdminService_RollbackDynamicConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_RollbackDynamicConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomainIsolationGroups(
 	ctx context.Context,
 	_Request *admin.UpdateDomainIsolationGroupsRequest,
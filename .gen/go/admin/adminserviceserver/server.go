@@ -125,6 +125,11 @@ type Interface interface {
 		Request *admin.ListDynamicConfigRequest,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListDynamicConfigHistory(
+		ctx context.Context,
+		Request *admin.ListDynamicConfigHistoryRequest,
+	) (*admin.ListDynamicConfigHistoryResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -175,11 +180,21 @@ type Interface interface {
 		Request *shared.RespondCrossClusterTasksCompletedRequest,
 	) (*shared.RespondCrossClusterTasksCompletedResponse, error)
 
+	RestoreArchivedWorkflow(
+		ctx context.Context,
+		Request *admin.RestoreArchivedWorkflowRequest,
+	) error
+
 	RestoreDynamicConfig(
 		ctx context.Context,
 		Request *admin.RestoreDynamicConfigRequest,
 	) error
 
+	RollbackDynamicConfig(
+		ctx context.Context,
+		Request *admin.RollbackDynamicConfigRequest,
+	) error
+
 	UpdateDomainIsolationGroups(
 		ctx context.Context,
 		Request *admin.UpdateDomainIsolationGroupsRequest,
@@ -411,6 +426,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListDynamicConfigHistory",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListDynamicConfigHistory),
+					NoWire: listdynamicconfighistory_NoWireHandler{impl},
+				},
+				Signature:    "ListDynamicConfigHistory(Request *admin.ListDynamicConfigHistoryRequest) (*admin.ListDynamicConfigHistoryResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "MaintainCorruptWorkflow",
 				HandlerSpec: thrift.HandlerSpec{
@@ -531,6 +558,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RestoreArchivedWorkflow",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RestoreArchivedWorkflow),
+					NoWire: restorearchivedworkflow_NoWireHandler{impl},
+				},
+				Signature:    "RestoreArchivedWorkflow(Request *admin.RestoreArchivedWorkflowRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RestoreDynamicConfig",
 				HandlerSpec: thrift.HandlerSpec{
@@ -543,6 +582,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RollbackDynamicConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RollbackDynamicConfig),
+					NoWire: rollbackdynamicconfig_NoWireHandler{impl},
+				},
+				Signature:    "RollbackDynamicConfig(Request *admin.RollbackDynamicConfigRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDomainIsolationGroups",
 				HandlerSpec: thrift.HandlerSpec{
@@ -581,7 +632,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 31)
+	procedures := make([]transport.Procedure, 0, 34)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -1102,6 +1153,36 @@ func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift
 	return response, err
 }
 
+func (h handler) ListDynamicConfigHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListDynamicConfigHistory_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ListDynamicConfigHistory': %w", err)
+	}
+
+	success, appErr := h.impl.ListDynamicConfigHistory(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ListDynamicConfigHistory_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) MaintainCorruptWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_MaintainCorruptWorkflow_Args
 	if err := args.FromWire(body); err != nil {
@@ -1402,6 +1483,36 @@ func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wir
 	return response, err
 }
 
+func (h handler) RestoreArchivedWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RestoreArchivedWorkflow_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RestoreArchivedWorkflow': %w", err)
+	}
+
+	appErr := h.impl.RestoreArchivedWorkflow(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RestoreArchivedWorkflow_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_RestoreDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
@@ -1432,6 +1543,36 @@ func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) RollbackDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RollbackDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RollbackDynamicConfig': %w", err)
+	}
+
+	appErr := h.impl.RollbackDynamicConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RollbackDynamicConfig_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) UpdateDomainIsolationGroups(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_UpdateDomainIsolationGroups_Args
 	if err := args.FromWire(body); err != nil {
@@ -2151,6 +2292,43 @@ func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *
 
 }
 
+type listdynamicconfighistory_NoWireHandler struct{ impl Interface }
+
+func (h listdynamicconfighistory_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ListDynamicConfigHistory_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListDynamicConfigHistory': %w", err)
+	}
+
+	success, appErr := h.impl.ListDynamicConfigHistory(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ListDynamicConfigHistory_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type maintaincorruptworkflow_NoWireHandler struct{ impl Interface }
 
 func (h maintaincorruptworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2521,6 +2699,43 @@ func (h respondcrossclustertaskscompleted_NoWireHandler) HandleNoWire(ctx contex
 
 }
 
+type restorearchivedworkflow_NoWireHandler struct{ impl Interface }
+
+func (h restorearchivedworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_RestoreArchivedWorkflow_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'RestoreArchivedWorkflow': %w", err)
+	}
+
+	appErr := h.impl.RestoreArchivedWorkflow(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RestoreArchivedWorkflow_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type restoredynamicconfig_NoWireHandler struct{ impl Interface }
 
 func (h restoredynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2558,6 +2773,43 @@ func (h restoredynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
+type rollbackdynamicconfig_NoWireHandler struct{ impl Interface }
+
+func (h rollbackdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_RollbackDynamicConfig_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'RollbackDynamicConfig': %w", err)
+	}
+
+	appErr := h.impl.RollbackDynamicConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RollbackDynamicConfig_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type updatedomainisolationgroups_NoWireHandler struct{ impl Interface }
 
 func (h updatedomainisolationgroups_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -623,6 +623,39 @@ func (mr *_MockClientRecorder) ListDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListDynamicConfig", args...)
 }
 
+// ListDynamicConfigHistory responds to a ListDynamicConfigHistory call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListDynamicConfigHistory(gomock.Any(), ...).Return(...)
+//	... := client.ListDynamicConfigHistory(...)
+func (m *MockClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	_Request *admin.ListDynamicConfigHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListDynamicConfigHistoryResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", args...)
+	success, _ = ret[i].(*admin.ListDynamicConfigHistoryResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListDynamicConfigHistory(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListDynamicConfigHistory", args...)
+}
+
 // MaintainCorruptWorkflow responds to a MaintainCorruptWorkflow call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -941,6 +974,37 @@ func (mr *_MockClientRecorder) RespondCrossClusterTasksCompleted(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondCrossClusterTasksCompleted", args...)
 }
 
+// RestoreArchivedWorkflow responds to a RestoreArchivedWorkflow call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RestoreArchivedWorkflow(gomock.Any(), ...).Return(...)
+//	... := client.RestoreArchivedWorkflow(...)
+func (m *MockClient) RestoreArchivedWorkflow(
+	ctx context.Context,
+	_Request *admin.RestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflow", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RestoreArchivedWorkflow(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RestoreArchivedWorkflow", args...)
+}
+
 // RestoreDynamicConfig responds to a RestoreDynamicConfig call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -972,6 +1036,37 @@ func (mr *_MockClientRecorder) RestoreDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RestoreDynamicConfig", args...)
 }
 
+// RollbackDynamicConfig responds to a RollbackDynamicConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RollbackDynamicConfig(gomock.Any(), ...).Return(...)
+//	... := client.RollbackDynamicConfig(...)
+func (m *MockClient) RollbackDynamicConfig(
+	ctx context.Context,
+	_Request *admin.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RollbackDynamicConfig", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RollbackDynamicConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RollbackDynamicConfig", args...)
+}
+
 // UpdateDomainIsolationGroups responds to a UpdateDomainIsolationGroups call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -41,6 +41,8 @@ import (
 type DynamicConfigBlob struct {
 	SchemaVersion *int64                `json:"schemaVersion,omitempty"`
 	Entries       []*DynamicConfigEntry `json:"entries,omitempty"`
+	Author        *string               `json:"author,omitempty"`
+	Timestamp     *int64                `json:"timestamp,omitempty"`
 }
 
 type _List_DynamicConfigEntry_ValueList []*DynamicConfigEntry
@@ -89,7 +91,7 @@ func (_List_DynamicConfigEntry_ValueList) Close() {}
 //	}
 func (v *DynamicConfigBlob) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -111,6 +113,22 @@ func (v *DynamicConfigBlob) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Author != nil {
+		w, err = wire.NewValueString(*(v.Author)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -178,6 +196,26 @@ func (v *DynamicConfigBlob) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Author = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -239,6 +277,30 @@ func (v *DynamicConfigBlob) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Author != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Author)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Timestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Timestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -310,6 +372,22 @@ func (v *DynamicConfigBlob) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Author = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Timestamp = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -339,7 +417,7 @@ func (v *DynamicConfigBlob) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
 	if v.SchemaVersion != nil {
 		fields[i] = fmt.Sprintf("SchemaVersion: %v", *(v.SchemaVersion))
@@ -349,6 +427,14 @@ func (v *DynamicConfigBlob) String() string {
 		fields[i] = fmt.Sprintf("Entries: %v", v.Entries)
 		i++
 	}
+	if v.Author != nil {
+		fields[i] = fmt.Sprintf("Author: %v", *(v.Author))
+		i++
+	}
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
 
 	return fmt.Sprintf("DynamicConfigBlob{%v}", strings.Join(fields[:i], ", "))
 }
@@ -378,6 +464,16 @@ func _List_DynamicConfigEntry_Equals(lhs, rhs []*DynamicConfigEntry) bool {
 	return true
 }
 
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this DynamicConfigBlob match the
 // provided DynamicConfigBlob.
 //
@@ -394,6 +490,12 @@ func (v *DynamicConfigBlob) Equals(rhs *DynamicConfigBlob) bool {
 	if !((v.Entries == nil && rhs.Entries == nil) || (v.Entries != nil && rhs.Entries != nil && _List_DynamicConfigEntry_Equals(v.Entries, rhs.Entries))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.Author, rhs.Author) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
 
 	return true
 }
@@ -421,6 +523,12 @@ func (v *DynamicConfigBlob) MarshalLogObject(enc zapcore.ObjectEncoder) (err err
 	if v.Entries != nil {
 		err = multierr.Append(err, enc.AddArray("entries", (_List_DynamicConfigEntry_Zapper)(v.Entries)))
 	}
+	if v.Author != nil {
+		enc.AddString("author", *v.Author)
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
 	return err
 }
 
@@ -454,6 +562,36 @@ func (v *DynamicConfigBlob) IsSetEntries() bool {
 	return v != nil && v.Entries != nil
 }
 
+// GetAuthor returns the value of Author if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigBlob) GetAuthor() (o string) {
+	if v != nil && v.Author != nil {
+		return *v.Author
+	}
+
+	return
+}
+
+// IsSetAuthor returns true if Author is not nil.
+func (v *DynamicConfigBlob) IsSetAuthor() bool {
+	return v != nil && v.Author != nil
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigBlob) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *DynamicConfigBlob) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
+}
+
 type DynamicConfigEntry struct {
 	Name   *string               `json:"name,omitempty"`
 	Values []*DynamicConfigValue `json:"values,omitempty"`
@@ -769,16 +907,6 @@ func (v *DynamicConfigEntry) String() string {
 	return fmt.Sprintf("DynamicConfigEntry{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 func _List_DynamicConfigValue_Equals(lhs, rhs []*DynamicConfigValue) bool {
 	if len(lhs) != len(rhs) {
 		return false
@@ -1155,6 +1283,7 @@ func (v *DynamicConfigFilter) IsSetValue() bool {
 type DynamicConfigValue struct {
 	Value   *shared.DataBlob       `json:"value,omitempty"`
 	Filters []*DynamicConfigFilter `json:"filters,omitempty"`
+	Source  *string                `json:"source,omitempty"`
 }
 
 type _List_DynamicConfigFilter_ValueList []*DynamicConfigFilter
@@ -1203,7 +1332,7 @@ func (_List_DynamicConfigFilter_ValueList) Close() {}
 //	}
 func (v *DynamicConfigValue) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1225,6 +1354,14 @@ func (v *DynamicConfigValue) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Source != nil {
+		w, err = wire.NewValueString(*(v.Source)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1290,6 +1427,16 @@ func (v *DynamicConfigValue) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Source = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1351,6 +1498,18 @@ func (v *DynamicConfigValue) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Source != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Source)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -1420,6 +1579,14 @@ func (v *DynamicConfigValue) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Source = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -1449,7 +1616,7 @@ func (v *DynamicConfigValue) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Value != nil {
 		fields[i] = fmt.Sprintf("Value: %v", v.Value)
@@ -1459,6 +1626,10 @@ func (v *DynamicConfigValue) String() string {
 		fields[i] = fmt.Sprintf("Filters: %v", v.Filters)
 		i++
 	}
+	if v.Source != nil {
+		fields[i] = fmt.Sprintf("Source: %v", *(v.Source))
+		i++
+	}
 
 	return fmt.Sprintf("DynamicConfigValue{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1494,6 +1665,9 @@ func (v *DynamicConfigValue) Equals(rhs *DynamicConfigValue) bool {
 	if !((v.Filters == nil && rhs.Filters == nil) || (v.Filters != nil && rhs.Filters != nil && _List_DynamicConfigFilter_Equals(v.Filters, rhs.Filters))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.Source, rhs.Source) {
+		return false
+	}
 
 	return true
 }
@@ -1521,6 +1695,9 @@ func (v *DynamicConfigValue) MarshalLogObject(enc zapcore.ObjectEncoder) (err er
 	if v.Filters != nil {
 		err = multierr.Append(err, enc.AddArray("filters", (_List_DynamicConfigFilter_Zapper)(v.Filters)))
 	}
+	if v.Source != nil {
+		enc.AddString("source", *v.Source)
+	}
 	return err
 }
 
@@ -1554,16 +1731,429 @@ func (v *DynamicConfigValue) IsSetFilters() bool {
 	return v != nil && v.Filters != nil
 }
 
+// GetSource returns the value of Source if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigValue) GetSource() (o string) {
+	if v != nil && v.Source != nil {
+		return *v.Source
+	}
+
+	return
+}
+
+// IsSetSource returns true if Source is not nil.
+func (v *DynamicConfigValue) IsSetSource() bool {
+	return v != nil && v.Source != nil
+}
+
+type DynamicConfigVersion struct {
+	Version   *int64                `json:"version,omitempty"`
+	Author    *string               `json:"author,omitempty"`
+	Timestamp *int64                `json:"timestamp,omitempty"`
+	Entries   []*DynamicConfigEntry `json:"entries,omitempty"`
+}
+
+// ToWire translates a DynamicConfigVersion struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *DynamicConfigVersion) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Author != nil {
+		w, err = wire.NewValueString(*(v.Author)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Entries != nil {
+		w, err = wire.NewValueList(_List_DynamicConfigEntry_ValueList(v.Entries)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DynamicConfigVersion struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DynamicConfigVersion struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v DynamicConfigVersion
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *DynamicConfigVersion) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Author = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.Entries, err = _List_DynamicConfigEntry_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a DynamicConfigVersion struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DynamicConfigVersion struct could not be encoded.
+func (v *DynamicConfigVersion) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Author != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Author)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Timestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Timestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Entries != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_DynamicConfigEntry_Encode(v.Entries, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a DynamicConfigVersion struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DynamicConfigVersion struct could not be generated from the wire
+// representation.
+func (v *DynamicConfigVersion) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Author = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Timestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TList:
+			v.Entries, err = _List_DynamicConfigEntry_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DynamicConfigVersion
+// struct.
+func (v *DynamicConfigVersion) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.Author != nil {
+		fields[i] = fmt.Sprintf("Author: %v", *(v.Author))
+		i++
+	}
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+	if v.Entries != nil {
+		fields[i] = fmt.Sprintf("Entries: %v", v.Entries)
+		i++
+	}
+
+	return fmt.Sprintf("DynamicConfigVersion{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DynamicConfigVersion match the
+// provided DynamicConfigVersion.
+//
+// This function performs a deep comparison.
+func (v *DynamicConfigVersion) Equals(rhs *DynamicConfigVersion) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Author, rhs.Author) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+	if !((v.Entries == nil && rhs.Entries == nil) || (v.Entries != nil && rhs.Entries != nil && _List_DynamicConfigEntry_Equals(v.Entries, rhs.Entries))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DynamicConfigVersion.
+func (v *DynamicConfigVersion) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.Author != nil {
+		enc.AddString("author", *v.Author)
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	if v.Entries != nil {
+		err = multierr.Append(err, enc.AddArray("entries", (_List_DynamicConfigEntry_Zapper)(v.Entries)))
+	}
+	return err
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigVersion) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *DynamicConfigVersion) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// GetAuthor returns the value of Author if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigVersion) GetAuthor() (o string) {
+	if v != nil && v.Author != nil {
+		return *v.Author
+	}
+
+	return
+}
+
+// IsSetAuthor returns true if Author is not nil.
+func (v *DynamicConfigVersion) IsSetAuthor() bool {
+	return v != nil && v.Author != nil
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigVersion) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *DynamicConfigVersion) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
+}
+
+// GetEntries returns the value of Entries if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigVersion) GetEntries() (o []*DynamicConfigEntry) {
+	if v != nil && v.Entries != nil {
+		return v.Entries
+	}
+
+	return
+}
+
+// IsSetEntries returns true if Entries is not nil.
+func (v *DynamicConfigVersion) IsSetEntries() bool {
+	return v != nil && v.Entries != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "config",
 	Package:  "github.com/uber/cadence/.gen/go/config",
 	FilePath: "config.thrift",
-	SHA1:     "cbc9d97e2a2f4820452fc2d273d5102e4721cf34",
+	SHA1:     "920c63530cb438e990ae4a274c16a9a2b84301ba",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.config\n\ninclude \"shared.thrift\"\n\nstruct DynamicConfigBlob {\n\t10: optional i64 schemaVersion\n\t20: optional list<DynamicConfigEntry> entries\n}\n\nstruct DynamicConfigEntry {\n  10: optional string name\n  20: optional list<DynamicConfigValue> values\n}\n\nstruct DynamicConfigValue {\n  10: optional shared.DataBlob value\n  20: optional list<DynamicConfigFilter> filters\n}\n\nstruct DynamicConfigFilter {\n  10: optional string name\n  20: optional shared.DataBlob value\n}\n"
+const rawIDL = "//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.config\n\ninclude \"shared.thrift\"\n\nstruct DynamicConfigBlob {\n\t10: optional i64 schemaVersion\n\t20: optional list<DynamicConfigEntry> entries\n\t// author and timestamp of the change which produced this snapshot\n\t30: optional string author\n\t40: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct DynamicConfigVersion {\n  10: optional i64 (js.type = \"Long\") version\n  20: optional string author\n  30: optional i64 (js.type = \"Long\") timestamp\n  40: optional list<DynamicConfigEntry> entries\n}\n\nstruct DynamicConfigEntry {\n  10: optional string name\n  20: optional list<DynamicConfigValue> values\n}\n\nstruct DynamicConfigValue {\n  10: optional shared.DataBlob value\n  20: optional list<DynamicConfigFilter> filters\n  // source is the config layer the value comes from, e.g. the base file, an overlay file or an environment variable\n  30: optional string source\n}\n\nstruct DynamicConfigFilter {\n  10: optional string name\n  20: optional shared.DataBlob value\n}\n"
@@ -131,6 +131,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.QueryWorkflowResponse, error)
 
+	RatelimitUpdate(
+		ctx context.Context,
+		Request *history.RatelimitUpdateRequest,
+		opts ...yarpc.CallOption,
+	) (*history.RatelimitUpdateResponse, error)
+
 	ReadDLQMessages(
 		ctx context.Context,
 		Request *replicator.ReadDLQMessagesRequest,
@@ -251,6 +257,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RestoreArchivedWorkflow(
+		ctx context.Context,
+		Request *history.RestoreArchivedWorkflowRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -743,6 +755,34 @@ func (c client) QueryWorkflow(
 	return
 }
 
+func (c client) RatelimitUpdate(
+	ctx context.Context,
+	_Request *history.RatelimitUpdateRequest,
+	opts ...yarpc.CallOption,
+) (success *history.RatelimitUpdateResponse, err error) {
+
+	var result history.HistoryService_RatelimitUpdate_Result
+	args := history.HistoryService_RatelimitUpdate_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_RatelimitUpdate_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReadDLQMessages(
 	ctx context.Context,
 	_Request *replicator.ReadDLQMessagesRequest,
@@ -1303,6 +1343,34 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) RestoreArchivedWorkflow(
+	ctx context.Context,
+	_Request *history.RestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_RestoreArchivedWorkflow_Result
+	args := history.HistoryService_RestoreArchivedWorkflow_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_RestoreArchivedWorkflow_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
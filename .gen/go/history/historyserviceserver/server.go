@@ -116,6 +116,11 @@ type Interface interface {
 		QueryRequest *history.QueryWorkflowRequest,
 	) (*history.QueryWorkflowResponse, error)
 
+	RatelimitUpdate(
+		ctx context.Context,
+		Request *history.RatelimitUpdateRequest,
+	) (*history.RatelimitUpdateResponse, error)
+
 	ReadDLQMessages(
 		ctx context.Context,
 		Request *replicator.ReadDLQMessagesRequest,
@@ -216,6 +221,11 @@ type Interface interface {
 		FailedRequest *history.RespondDecisionTaskFailedRequest,
 	) error
 
+	RestoreArchivedWorkflow(
+		ctx context.Context,
+		Request *history.RestoreArchivedWorkflowRequest,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -443,6 +453,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RatelimitUpdate",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RatelimitUpdate),
+					NoWire: ratelimitupdate_NoWireHandler{impl},
+				},
+				Signature:    "RatelimitUpdate(Request *history.RatelimitUpdateRequest) (*history.RatelimitUpdateResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReadDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -683,6 +705,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RestoreArchivedWorkflow",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RestoreArchivedWorkflow),
+					NoWire: restorearchivedworkflow_NoWireHandler{impl},
+				},
+				Signature:    "RestoreArchivedWorkflow(Request *history.RestoreArchivedWorkflowRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ScheduleDecisionTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -769,7 +803,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 42)
+	procedures := make([]transport.Procedure, 0, 44)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -1230,6 +1264,36 @@ func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) RatelimitUpdate(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RatelimitUpdate_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RatelimitUpdate': %w", err)
+	}
+
+	success, appErr := h.impl.RatelimitUpdate(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RatelimitUpdate_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ReadDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -1830,6 +1894,36 @@ func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) RestoreArchivedWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RestoreArchivedWorkflow_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RestoreArchivedWorkflow': %w", err)
+	}
+
+	appErr := h.impl.RestoreArchivedWorkflow(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RestoreArchivedWorkflow_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ScheduleDecisionTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -2595,6 +2689,43 @@ func (h queryworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
+type ratelimitupdate_NoWireHandler struct{ impl Interface }
+
+func (h ratelimitupdate_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_RatelimitUpdate_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'RatelimitUpdate': %w", err)
+	}
+
+	success, appErr := h.impl.RatelimitUpdate(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RatelimitUpdate_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type readdlqmessages_NoWireHandler struct{ impl Interface }
 
 func (h readdlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3335,6 +3466,43 @@ func (h responddecisiontaskfailed_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
+type restorearchivedworkflow_NoWireHandler struct{ impl Interface }
+
+func (h restorearchivedworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_RestoreArchivedWorkflow_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'RestoreArchivedWorkflow': %w", err)
+	}
+
+	appErr := h.impl.RestoreArchivedWorkflow(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RestoreArchivedWorkflow_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type scheduledecisiontask_NoWireHandler struct{ impl Interface }
 
 func (h scheduledecisiontask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
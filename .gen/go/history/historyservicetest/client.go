@@ -557,6 +557,39 @@ func (mr *_MockClientRecorder) QueryWorkflow(
 	return mr.mock.ctrl.RecordCall(mr.mock, "QueryWorkflow", args...)
 }
 
+// RatelimitUpdate responds to a RatelimitUpdate call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RatelimitUpdate(gomock.Any(), ...).Return(...)
+//	... := client.RatelimitUpdate(...)
+func (m *MockClient) RatelimitUpdate(
+	ctx context.Context,
+	_Request *history.RatelimitUpdateRequest,
+	opts ...yarpc.CallOption,
+) (success *history.RatelimitUpdateResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RatelimitUpdate", args...)
+	success, _ = ret[i].(*history.RatelimitUpdateResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RatelimitUpdate(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RatelimitUpdate", args...)
+}
+
 // ReadDLQMessages responds to a ReadDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1193,6 +1226,37 @@ func (mr *_MockClientRecorder) RespondDecisionTaskFailed(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondDecisionTaskFailed", args...)
 }
 
+// RestoreArchivedWorkflow responds to a RestoreArchivedWorkflow call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RestoreArchivedWorkflow(gomock.Any(), ...).Return(...)
+//	... := client.RestoreArchivedWorkflow(...)
+func (m *MockClient) RestoreArchivedWorkflow(
+	ctx context.Context,
+	_Request *history.RestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflow", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RestoreArchivedWorkflow(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RestoreArchivedWorkflow", args...)
+}
+
 // ScheduleDecisionTask responds to a ScheduleDecisionTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -64,6 +64,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	GetTaskListPartitionConfig(
+		ctx context.Context,
+		Request *matching.GetTaskListPartitionConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*matching.GetTaskListPartitionConfigResponse, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
@@ -242,6 +248,34 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) GetTaskListPartitionConfig(
+	ctx context.Context,
+	_Request *matching.GetTaskListPartitionConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.GetTaskListPartitionConfigResponse, err error) {
+
+	var result matching.MatchingService_GetTaskListPartitionConfig_Result
+	args := matching.MatchingService_GetTaskListPartitionConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_GetTaskListPartitionConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetTaskListsByDomain(
 	ctx context.Context,
 	_Request *shared.GetTaskListsByDomainRequest,
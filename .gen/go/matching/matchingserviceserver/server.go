@@ -60,6 +60,11 @@ type Interface interface {
 		Request *matching.DescribeTaskListRequest,
 	) (*shared.DescribeTaskListResponse, error)
 
+	GetTaskListPartitionConfig(
+		ctx context.Context,
+		Request *matching.GetTaskListPartitionConfigRequest,
+	) (*matching.GetTaskListPartitionConfigResponse, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
@@ -150,6 +155,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetTaskListPartitionConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetTaskListPartitionConfig),
+					NoWire: gettasklistpartitionconfig_NoWireHandler{impl},
+				},
+				Signature:    "GetTaskListPartitionConfig(Request *matching.GetTaskListPartitionConfigRequest) (*matching.GetTaskListPartitionConfigResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetTaskListsByDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -224,7 +241,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 10)
+	procedures := make([]transport.Procedure, 0, 11)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -355,6 +372,36 @@ func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) GetTaskListPartitionConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_GetTaskListPartitionConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'GetTaskListPartitionConfig': %w", err)
+	}
+
+	success, appErr := h.impl.GetTaskListPartitionConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_GetTaskListPartitionConfig_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_GetTaskListsByDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -683,6 +730,43 @@ func (h describetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type gettasklistpartitionconfig_NoWireHandler struct{ impl Interface }
+
+func (h gettasklistpartitionconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args matching.MatchingService_GetTaskListPartitionConfig_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'MatchingService' procedure 'GetTaskListPartitionConfig': %w", err)
+	}
+
+	success, appErr := h.impl.GetTaskListPartitionConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_GetTaskListPartitionConfig_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type gettasklistsbydomain_NoWireHandler struct{ impl Interface }
 
 func (h gettasklistsbydomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -193,6 +193,39 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskList", args...)
 }
 
+// GetTaskListPartitionConfig responds to a GetTaskListPartitionConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetTaskListPartitionConfig(gomock.Any(), ...).Return(...)
+//	... := client.GetTaskListPartitionConfig(...)
+func (m *MockClient) GetTaskListPartitionConfig(
+	ctx context.Context,
+	_Request *matching.GetTaskListPartitionConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.GetTaskListPartitionConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetTaskListPartitionConfig", args...)
+	success, _ = ret[i].(*matching.GetTaskListPartitionConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetTaskListPartitionConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListPartitionConfig", args...)
+}
+
 // GetTaskListsByDomain responds to a GetTaskListsByDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -26,6 +26,7 @@
 package historyv1
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -4379,6 +4380,100 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
+type RestoreArchivedWorkflowRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RestoreArchivedWorkflowRequest) Reset()         { *m = RestoreArchivedWorkflowRequest{} }
+func (m *RestoreArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreArchivedWorkflowRequest) ProtoMessage()    {}
+func (*RestoreArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{70}
+}
+func (m *RestoreArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *RestoreArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreArchivedWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *RestoreArchivedWorkflowRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *RestoreArchivedWorkflowRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+type RestoreArchivedWorkflowResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreArchivedWorkflowResponse) Reset()         { *m = RestoreArchivedWorkflowResponse{} }
+func (m *RestoreArchivedWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreArchivedWorkflowResponse) ProtoMessage()    {}
+func (*RestoreArchivedWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{71}
+}
+func (m *RestoreArchivedWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreArchivedWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreArchivedWorkflowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreArchivedWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreArchivedWorkflowResponse.Merge(m, src)
+}
+func (m *RestoreArchivedWorkflowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreArchivedWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreArchivedWorkflowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreArchivedWorkflowResponse proto.InternalMessageInfo
+
 type CountDLQMessagesRequest struct {
 	ForceFetch           bool     `protobuf:"varint,1,opt,name=forceFetch,proto3" json:"forceFetch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4390,7 +4485,7 @@ func (m *CountDLQMessagesRequest) Reset()         { *m = CountDLQMessagesRequest
 func (m *CountDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountDLQMessagesRequest) ProtoMessage()    {}
 func (*CountDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{70}
+	return fileDescriptor_fee8ff76963a38ed, []int{72}
 }
 func (m *CountDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4437,7 +4532,7 @@ func (m *CountDLQMessagesResponse) Reset()         { *m = CountDLQMessagesRespon
 func (m *CountDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountDLQMessagesResponse) ProtoMessage()    {}
 func (*CountDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{71}
+	return fileDescriptor_fee8ff76963a38ed, []int{73}
 }
 func (m *CountDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4489,7 +4584,7 @@ func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{}
 func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesRequest) ProtoMessage()    {}
 func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{72}
+	return fileDescriptor_fee8ff76963a38ed, []int{74}
 }
 func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4574,7 +4669,7 @@ func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse
 func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesResponse) ProtoMessage()    {}
 func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{73}
+	return fileDescriptor_fee8ff76963a38ed, []int{75}
 }
 func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4645,7 +4740,7 @@ func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest
 func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{74}
+	return fileDescriptor_fee8ff76963a38ed, []int{76}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4712,7 +4807,7 @@ func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesRespon
 func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{75}
+	return fileDescriptor_fee8ff76963a38ed, []int{77}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4757,7 +4852,7 @@ func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest
 func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesRequest) ProtoMessage()    {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{76}
+	return fileDescriptor_fee8ff76963a38ed, []int{78}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4839,7 +4934,7 @@ func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesRespon
 func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesResponse) ProtoMessage()    {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{77}
+	return fileDescriptor_fee8ff76963a38ed, []int{79}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4886,7 +4981,7 @@ func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMark
 func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
 func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{78}
+	return fileDescriptor_fee8ff76963a38ed, []int{80}
 }
 func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4932,7 +5027,7 @@ func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMar
 func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
 func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{79}
+	return fileDescriptor_fee8ff76963a38ed, []int{81}
 }
 func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4973,7 +5068,7 @@ func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTask
 func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
 func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{80}
+	return fileDescriptor_fee8ff76963a38ed, []int{82}
 }
 func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5028,7 +5123,7 @@ func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTas
 func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
 func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{81}
+	return fileDescriptor_fee8ff76963a38ed, []int{83}
 }
 func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5087,7 +5182,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
 func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
 func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{82}
+	return fileDescriptor_fee8ff76963a38ed, []int{84}
 }
 func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5159,7 +5254,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) String() string {
 }
 func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
 func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{83}
+	return fileDescriptor_fee8ff76963a38ed, []int{85}
 }
 func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5206,7 +5301,7 @@ func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{}
 func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoRequest) ProtoMessage()    {}
 func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{84}
+	return fileDescriptor_fee8ff76963a38ed, []int{86}
 }
 func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5254,7 +5349,7 @@ func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse
 func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoResponse) ProtoMessage()    {}
 func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{85}
+	return fileDescriptor_fee8ff76963a38ed, []int{87}
 }
 func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5297,6 +5392,111 @@ func (m *GetFailoverInfoResponse) GetPendingShards() []int32 {
 	return nil
 }
 
+type RatelimitUpdateRequest struct {
+	// Identity of the host reporting the usage.
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Requests per second seen by the caller since its last update, keyed by global rate limit key.
+	Usage                map[string]float64 `protobuf:"bytes,2,rep,name=usage,proto3" json:"usage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RatelimitUpdateRequest) Reset()         { *m = RatelimitUpdateRequest{} }
+func (m *RatelimitUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*RatelimitUpdateRequest) ProtoMessage()    {}
+func (*RatelimitUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{88}
+}
+func (m *RatelimitUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatelimitUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatelimitUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatelimitUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatelimitUpdateRequest.Merge(m, src)
+}
+func (m *RatelimitUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatelimitUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatelimitUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatelimitUpdateRequest proto.InternalMessageInfo
+
+func (m *RatelimitUpdateRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *RatelimitUpdateRequest) GetUsage() map[string]float64 {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type RatelimitUpdateResponse struct {
+	// Share of each global rate limit the caller is allowed to use, keyed by global rate limit key.
+	Weights              map[string]float64 `protobuf:"bytes,1,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RatelimitUpdateResponse) Reset()         { *m = RatelimitUpdateResponse{} }
+func (m *RatelimitUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*RatelimitUpdateResponse) ProtoMessage()    {}
+func (*RatelimitUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{89}
+}
+func (m *RatelimitUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatelimitUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatelimitUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatelimitUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatelimitUpdateResponse.Merge(m, src)
+}
+func (m *RatelimitUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatelimitUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatelimitUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatelimitUpdateResponse proto.InternalMessageInfo
+
+func (m *RatelimitUpdateResponse) GetWeights() map[string]float64 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
 	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest.PartitionConfigEntry")
@@ -5373,6 +5573,8 @@ func init() {
 	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.history.v1.ReapplyEventsResponse")
 	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksRequest")
 	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*RestoreArchivedWorkflowRequest)(nil), "uber.cadence.history.v1.RestoreArchivedWorkflowRequest")
+	proto.RegisterType((*RestoreArchivedWorkflowResponse)(nil), "uber.cadence.history.v1.RestoreArchivedWorkflowResponse")
 	proto.RegisterType((*CountDLQMessagesRequest)(nil), "uber.cadence.history.v1.CountDLQMessagesRequest")
 	proto.RegisterType((*CountDLQMessagesResponse)(nil), "uber.cadence.history.v1.CountDLQMessagesResponse")
 	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.history.v1.ReadDLQMessagesRequest")
@@ -5391,6 +5593,10 @@ func init() {
 	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedResponse")
 	proto.RegisterType((*GetFailoverInfoRequest)(nil), "uber.cadence.history.v1.GetFailoverInfoRequest")
 	proto.RegisterType((*GetFailoverInfoResponse)(nil), "uber.cadence.history.v1.GetFailoverInfoResponse")
+	proto.RegisterType((*RatelimitUpdateRequest)(nil), "uber.cadence.history.v1.RatelimitUpdateRequest")
+	proto.RegisterMapType((map[string]float64)(nil), "uber.cadence.history.v1.RatelimitUpdateRequest.UsageEntry")
+	proto.RegisterType((*RatelimitUpdateResponse)(nil), "uber.cadence.history.v1.RatelimitUpdateResponse")
+	proto.RegisterMapType((map[string]float64)(nil), "uber.cadence.history.v1.RatelimitUpdateResponse.WeightsEntry")
 }
 
 func init() {
@@ -5398,312 +5604,322 @@ func init() {
 }
 
 var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4872 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
-	0x76, 0xe8, 0x19, 0xf1, 0xf7, 0x48, 0x0e, 0xc9, 0x12, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0x6d, 0xcb,
-	0xa6, 0xe5, 0xd5, 0xd0, 0xa2, 0x6c, 0x49, 0x96, 0xe5, 0xd5, 0x4a, 0xa4, 0x24, 0x8f, 0xa3, 0x6f,
-	0x93, 0x2b, 0x27, 0x41, 0xe2, 0xde, 0xe6, 0x74, 0x0d, 0xd9, 0x51, 0x4f, 0xf7, 0xa8, 0xbb, 0x87,
-	0xd4, 0xf8, 0x10, 0x38, 0xd8, 0x20, 0xc0, 0x2e, 0x82, 0xec, 0x66, 0xb1, 0x09, 0x02, 0x04, 0x08,
-	0x10, 0x6c, 0x80, 0xc5, 0x1a, 0xb9, 0x25, 0xb7, 0x20, 0xa7, 0x00, 0xc1, 0x1e, 0x73, 0xcd, 0x29,
-	0x81, 0xe1, 0x3d, 0x24, 0x40, 0x4e, 0xd9, 0x73, 0x10, 0xd4, 0xa7, 0x7b, 0xfa, 0x53, 0x5d, 0x3d,
-	0x24, 0x83, 0xc8, 0xeb, 0xf5, 0x8d, 0x53, 0x55, 0xef, 0xd5, 0xab, 0x57, 0xef, 0xbd, 0x7a, 0xbf,
-	0x26, 0x9c, 0xef, 0xee, 0x62, 0x6f, 0xbd, 0x69, 0x98, 0xd8, 0x69, 0xe2, 0xf5, 0x7d, 0xcb, 0x0f,
-	0x5c, 0xaf, 0xb7, 0x7e, 0x70, 0x69, 0xdd, 0xc7, 0xde, 0x81, 0xd5, 0xc4, 0xf5, 0x8e, 0xe7, 0x06,
-	0x2e, 0x5a, 0x20, 0xcb, 0xea, 0x7c, 0x59, 0x9d, 0x2f, 0xab, 0x1f, 0x5c, 0xaa, 0x2d, 0xef, 0xb9,
-	0xee, 0x9e, 0x8d, 0xd7, 0xe9, 0xb2, 0xdd, 0x6e, 0x6b, 0xdd, 0xec, 0x7a, 0x46, 0x60, 0xb9, 0x0e,
-	0x03, 0xac, 0x9d, 0x4b, 0xcf, 0x07, 0x56, 0x1b, 0xfb, 0x81, 0xd1, 0xee, 0xf0, 0x05, 0x19, 0x04,
-	0x87, 0x9e, 0xd1, 0xe9, 0x60, 0xcf, 0xe7, 0xf3, 0x2b, 0x09, 0x02, 0x8d, 0x8e, 0x45, 0x88, 0x6b,
-	0xba, 0xed, 0x76, 0xb4, 0xc5, 0xaa, 0x68, 0x45, 0x48, 0x22, 0xa7, 0x42, 0xb4, 0xe4, 0x79, 0x17,
-	0x47, 0x0b, 0x54, 0xd1, 0x82, 0xc0, 0xf0, 0x9f, 0xd9, 0x96, 0x1f, 0xc8, 0xd6, 0x1c, 0xba, 0xde,
-	0xb3, 0x96, 0xed, 0x1e, 0xf2, 0x35, 0x17, 0x44, 0x6b, 0x38, 0x2b, 0xf5, 0xd4, 0xda, 0xb5, 0xa2,
-	0xb5, 0xd8, 0xe3, 0x2b, 0x5f, 0x49, 0xae, 0x34, 0xdb, 0x96, 0x43, 0xb9, 0x60, 0x77, 0xfd, 0xa0,
-	0x68, 0x51, 0x92, 0x11, 0xab, 0xe2, 0x45, 0xcf, 0xbb, 0xb8, 0xcb, 0xaf, 0xba, 0xf6, 0xba, 0x78,
-	0x89, 0x87, 0x3b, 0xb6, 0xd5, 0x8c, 0x5f, 0xed, 0xab, 0x89, 0x85, 0xfe, 0xbe, 0xe1, 0x61, 0x33,
-	0xbb, 0xe3, 0xf9, 0x9c, 0x55, 0x49, 0x66, 0xa8, 0x5f, 0x0c, 0xc3, 0xd9, 0xed, 0xc0, 0xf0, 0x82,
-	0x8f, 0xf8, 0xf8, 0x9d, 0x17, 0xb8, 0xd9, 0x25, 0xbb, 0x69, 0xf8, 0x79, 0x17, 0xfb, 0x01, 0xba,
-	0x0f, 0x23, 0x1e, 0xfb, 0xb3, 0xaa, 0xac, 0x28, 0x6b, 0xe3, 0x1b, 0x1b, 0xf5, 0x84, 0x50, 0x1a,
-	0x1d, 0xab, 0x7e, 0x70, 0xa9, 0x2e, 0x45, 0xa2, 0x85, 0x28, 0xd0, 0x12, 0x8c, 0x99, 0x6e, 0xdb,
-	0xb0, 0x1c, 0xdd, 0x32, 0xab, 0xa5, 0x15, 0x65, 0x6d, 0x4c, 0x1b, 0x65, 0x03, 0x0d, 0x13, 0xfd,
-	0x0e, 0xcc, 0x75, 0x0c, 0x0f, 0x3b, 0x81, 0x8e, 0x43, 0x04, 0xba, 0xe5, 0xb4, 0xdc, 0x6a, 0x99,
-	0x6e, 0xbc, 0x26, 0xdc, 0xf8, 0x31, 0x85, 0x88, 0x76, 0x6c, 0x38, 0x2d, 0x57, 0x3b, 0xdd, 0xc9,
-	0x0e, 0xa2, 0x2a, 0x8c, 0x18, 0x41, 0x80, 0xdb, 0x9d, 0xa0, 0x7a, 0x6a, 0x45, 0x59, 0x1b, 0xd2,
-	0xc2, 0x9f, 0x68, 0x13, 0xa6, 0xf0, 0x8b, 0x8e, 0xc5, 0x14, 0x48, 0x27, 0x9a, 0x52, 0x1d, 0xa2,
-	0x3b, 0xd6, 0xea, 0x4c, 0x4b, 0xea, 0xa1, 0x96, 0xd4, 0x77, 0x42, 0x35, 0xd2, 0x2a, 0x7d, 0x10,
-	0x32, 0x88, 0x5a, 0xb0, 0xd8, 0x74, 0x9d, 0xc0, 0x72, 0xba, 0x58, 0x37, 0x7c, 0xdd, 0xc1, 0x87,
-	0xba, 0xe5, 0x58, 0x81, 0x65, 0x04, 0xae, 0x57, 0x1d, 0x5e, 0x51, 0xd6, 0x2a, 0x1b, 0x6f, 0x0a,
-	0x0f, 0xb0, 0xc9, 0xa1, 0x6e, 0xf9, 0x0f, 0xf1, 0x61, 0x23, 0x04, 0xd1, 0xe6, 0x9b, 0xc2, 0x71,
-	0xd4, 0x80, 0x99, 0x70, 0xc6, 0xd4, 0x5b, 0x86, 0x65, 0x77, 0x3d, 0x5c, 0x1d, 0xa1, 0xe4, 0x9e,
-	0x11, 0xe2, 0xbf, 0xcb, 0xd6, 0x68, 0xd3, 0x11, 0x18, 0x1f, 0x41, 0x1a, 0xcc, 0xdb, 0x86, 0x1f,
-	0xe8, 0x4d, 0xb7, 0xdd, 0xb1, 0x31, 0x3d, 0xbc, 0x87, 0xfd, 0xae, 0x1d, 0x54, 0x47, 0x25, 0xf8,
-	0x1e, 0x1b, 0x3d, 0xdb, 0x35, 0x4c, 0x6d, 0x96, 0xc0, 0x6e, 0x46, 0xa0, 0x1a, 0x85, 0x44, 0xbf,
-	0x09, 0x4b, 0x2d, 0xcb, 0xf3, 0x03, 0xdd, 0xc4, 0x4d, 0xcb, 0xa7, 0xfc, 0x34, 0xfc, 0x67, 0xfa,
-	0xae, 0xd1, 0x7c, 0xe6, 0xb6, 0x5a, 0xd5, 0x31, 0x8a, 0x78, 0x31, 0xc3, 0xd7, 0x2d, 0x6e, 0xbe,
-	0xb4, 0x2a, 0x85, 0xde, 0xe2, 0xc0, 0x3b, 0x86, 0xff, 0xec, 0x36, 0x03, 0x45, 0x07, 0x30, 0xdd,
-	0x31, 0xbc, 0xc0, 0xa2, 0x74, 0x36, 0x5d, 0xa7, 0x65, 0xed, 0x55, 0x61, 0xa5, 0xbc, 0x36, 0xbe,
-	0xf1, 0x1b, 0xf5, 0x1c, 0x33, 0x29, 0x97, 0x4a, 0x22, 0x3a, 0x0c, 0xdd, 0x26, 0xc5, 0x76, 0xc7,
-	0x09, 0xbc, 0x9e, 0x36, 0xd5, 0x49, 0x8e, 0xd6, 0x6e, 0xc3, 0xac, 0x68, 0x21, 0x9a, 0x86, 0xf2,
-	0x33, 0xdc, 0xa3, 0x4a, 0x31, 0xa6, 0x91, 0x3f, 0xd1, 0x2c, 0x0c, 0x1d, 0x18, 0x76, 0x17, 0x73,
-	0xc1, 0x66, 0x3f, 0xae, 0x97, 0xae, 0x29, 0xea, 0x55, 0x58, 0xce, 0x23, 0xc5, 0xef, 0xb8, 0x8e,
-	0x8f, 0xd1, 0x1c, 0x0c, 0x7b, 0x5d, 0xaa, 0x15, 0x0c, 0xe1, 0x90, 0xd7, 0x75, 0x1a, 0xa6, 0xfa,
-	0x37, 0x25, 0x58, 0xde, 0xb6, 0xf6, 0x1c, 0xc3, 0xce, 0x55, 0xd0, 0x07, 0x69, 0x05, 0xbd, 0x2c,
-	0x56, 0x50, 0x29, 0x96, 0x01, 0x35, 0xb4, 0x05, 0x4b, 0xf8, 0x45, 0x80, 0x3d, 0xc7, 0xb0, 0x23,
-	0xb3, 0xda, 0x57, 0x56, 0xae, 0xa7, 0xaf, 0x09, 0xf7, 0xcf, 0xee, 0xbc, 0x18, 0xa2, 0xca, 0x4c,
-	0xa1, 0x3a, 0x9c, 0x6e, 0xee, 0x5b, 0xb6, 0xd9, 0xdf, 0xc4, 0x75, 0xec, 0x1e, 0xd5, 0xdb, 0x51,
-	0x6d, 0x86, 0x4e, 0x85, 0x40, 0x8f, 0x1c, 0xbb, 0xa7, 0xae, 0xc2, 0xb9, 0xdc, 0xf3, 0x31, 0x06,
-	0xab, 0xbf, 0x28, 0xc1, 0xeb, 0x7c, 0x8d, 0x15, 0xec, 0xcb, 0x6d, 0xde, 0xd3, 0x34, 0x4b, 0x6f,
-	0xc8, 0x58, 0x5a, 0x84, 0x6e, 0x40, 0xde, 0x7e, 0xaa, 0x08, 0x04, 0xbc, 0x4c, 0x05, 0xfc, 0xdb,
-	0xf9, 0x02, 0x3e, 0x18, 0x09, 0xff, 0x8f, 0xa2, 0x7e, 0x0b, 0xd6, 0x8a, 0x89, 0x92, 0x0b, 0xfd,
-	0xf7, 0x15, 0x38, 0xab, 0x61, 0x1f, 0x9f, 0xf8, 0x51, 0x92, 0x22, 0x19, 0xec, 0x5a, 0x88, 0xea,
-	0xe6, 0xa1, 0x91, 0x9f, 0xe2, 0xb3, 0x12, 0xac, 0xee, 0x60, 0xaf, 0x6d, 0x39, 0x46, 0x80, 0x73,
-	0x4f, 0xf2, 0x38, 0x7d, 0x92, 0x2b, 0xc2, 0x93, 0x14, 0x22, 0xfa, 0x15, 0x57, 0xe0, 0x57, 0x41,
-	0x95, 0x1d, 0x91, 0xeb, 0xf0, 0x0f, 0x15, 0x58, 0xd9, 0xc2, 0x7e, 0xd3, 0xb3, 0x76, 0xf3, 0x39,
-	0xfa, 0x28, 0xcd, 0xd1, 0x77, 0x84, 0xc7, 0x29, 0xc2, 0x33, 0xa0, 0x78, 0xfc, 0x4f, 0x19, 0x56,
-	0x25, 0xa8, 0xb8, 0x88, 0xd8, 0xb0, 0xd0, 0x77, 0x69, 0x98, 0x6a, 0xf3, 0x07, 0x4f, 0x6a, 0xb3,
-	0x33, 0x08, 0x37, 0xe3, 0xa0, 0xda, 0x3c, 0x16, 0x8e, 0xa3, 0x5d, 0x58, 0xc8, 0xde, 0x2d, 0xf3,
-	0xa4, 0x4a, 0x74, 0xb7, 0x0b, 0x83, 0xed, 0x46, 0x7d, 0xa9, 0xb9, 0x43, 0xd1, 0x30, 0xfa, 0x08,
-	0x50, 0x07, 0x3b, 0xa6, 0xe5, 0xec, 0xe9, 0x46, 0x33, 0xb0, 0x0e, 0xac, 0xc0, 0xc2, 0x3e, 0x37,
-	0x57, 0x39, 0x8e, 0x1a, 0x5b, 0x7e, 0x8b, 0xad, 0xee, 0x51, 0xe4, 0x33, 0x9d, 0xc4, 0xa0, 0x85,
-	0x7d, 0xf4, 0x5b, 0x30, 0x1d, 0x22, 0xa6, 0x62, 0xe2, 0x61, 0xa7, 0x7a, 0x8a, 0xa2, 0xad, 0xcb,
-	0xd0, 0x6e, 0x92, 0xb5, 0x49, 0xca, 0xa7, 0x3a, 0xb1, 0x29, 0x0f, 0x3b, 0x68, 0xbb, 0x8f, 0x3a,
-	0xf4, 0x4e, 0xb8, 0xa3, 0x27, 0xa5, 0x38, 0x74, 0x46, 0x12, 0x48, 0xc3, 0x41, 0xf5, 0x05, 0xcc,
-	0x3e, 0x21, 0x11, 0x4d, 0xc8, 0xbd, 0x50, 0x0c, 0x37, 0xd3, 0x62, 0xf8, 0x86, 0x70, 0x0f, 0x11,
-	0xec, 0x80, 0xa2, 0xf7, 0x13, 0x05, 0xe6, 0x52, 0xe0, 0x5c, 0xdc, 0x6e, 0xc2, 0x04, 0x8d, 0xb2,
-	0x42, 0x77, 0x4e, 0x19, 0xc0, 0x9d, 0x1b, 0xa7, 0x10, 0xdc, 0x8b, 0x6b, 0x40, 0x25, 0x44, 0xf0,
-	0x7b, 0xb8, 0x19, 0x60, 0x93, 0x0b, 0x8e, 0x9a, 0x7f, 0x06, 0x8d, 0xaf, 0xd4, 0x26, 0x9f, 0xc7,
-	0x7f, 0xaa, 0x7f, 0xa8, 0x40, 0x8d, 0x1a, 0xd0, 0xed, 0xc0, 0x6a, 0x3e, 0xeb, 0x11, 0x8f, 0xee,
-	0xbe, 0xe5, 0x07, 0x21, 0x9b, 0x1a, 0x69, 0x36, 0xad, 0xe7, 0x5b, 0x72, 0x21, 0x86, 0x01, 0x99,
-	0x75, 0x16, 0x96, 0x84, 0x38, 0xb8, 0x65, 0xf9, 0x6f, 0x05, 0xe6, 0xef, 0xe1, 0xe0, 0x41, 0x37,
-	0x30, 0x76, 0x6d, 0xbc, 0x1d, 0x18, 0x01, 0xd6, 0x44, 0x68, 0x95, 0x94, 0x3d, 0xfd, 0x36, 0x20,
-	0x81, 0x19, 0x2d, 0x1d, 0xc9, 0x8c, 0xce, 0x64, 0x34, 0x0c, 0x5d, 0x86, 0x79, 0xfc, 0xa2, 0x43,
-	0x19, 0xa8, 0x3b, 0xf8, 0x45, 0xa0, 0xe3, 0x03, 0x12, 0x16, 0x59, 0x26, 0xb5, 0xd0, 0x65, 0xed,
-	0x74, 0x38, 0xfb, 0x10, 0xbf, 0x08, 0xee, 0x90, 0xb9, 0x86, 0x89, 0xde, 0x82, 0xd9, 0x66, 0xd7,
-	0xa3, 0xf1, 0xd3, 0xae, 0x67, 0x38, 0xcd, 0x7d, 0x3d, 0x70, 0x9f, 0x51, 0xed, 0x51, 0xd6, 0x26,
-	0x34, 0xc4, 0xe7, 0x6e, 0xd3, 0xa9, 0x1d, 0x32, 0xa3, 0xfe, 0x78, 0x0c, 0x16, 0x32, 0xa7, 0xe6,
-	0x32, 0x24, 0x3e, 0x99, 0x72, 0xd2, 0x93, 0xdd, 0x85, 0xc9, 0x08, 0x6d, 0xd0, 0xeb, 0x60, 0xce,
-	0xab, 0x55, 0x29, 0xc6, 0x9d, 0x5e, 0x07, 0x6b, 0x13, 0x87, 0xb1, 0x5f, 0x48, 0x85, 0x49, 0x11,
-	0x63, 0xc6, 0x9d, 0x18, 0x43, 0x9e, 0xc2, 0x62, 0xc7, 0xc3, 0x07, 0x96, 0xdb, 0xf5, 0x75, 0x9f,
-	0x78, 0x22, 0xd8, 0xec, 0xaf, 0x3f, 0x45, 0xf7, 0x5d, 0xca, 0x44, 0x22, 0x0d, 0x27, 0xb8, 0xf2,
-	0xf6, 0x53, 0xe2, 0xce, 0x68, 0xf3, 0x21, 0xf4, 0x36, 0x03, 0x0e, 0xf1, 0x5e, 0x84, 0xd3, 0x34,
-	0x6e, 0x62, 0x81, 0x4e, 0x84, 0x71, 0x88, 0x52, 0x30, 0x4d, 0xa6, 0xee, 0x92, 0x99, 0x70, 0xf9,
-	0x75, 0x18, 0xa3, 0x31, 0x90, 0x6d, 0xf9, 0x01, 0x8d, 0x04, 0xc7, 0x37, 0xce, 0x8a, 0x1f, 0xf9,
-	0x50, 0x2a, 0x47, 0x03, 0xfe, 0x17, 0xba, 0x07, 0xd3, 0x3e, 0x95, 0x58, 0xbd, 0x8f, 0x62, 0x64,
-	0x10, 0x14, 0x15, 0x3f, 0x21, 0xe8, 0xe8, 0x6d, 0x98, 0x6f, 0xda, 0x16, 0xa1, 0xd4, 0xb6, 0x76,
-	0x3d, 0xc3, 0xeb, 0xe9, 0x07, 0xd8, 0xa3, 0x16, 0x70, 0x94, 0x8a, 0xf4, 0x2c, 0x9b, 0xbd, 0xcf,
-	0x26, 0x9f, 0xb2, 0xb9, 0x18, 0x54, 0x0b, 0x1b, 0x41, 0xd7, 0xc3, 0x11, 0xd4, 0x58, 0x1c, 0xea,
-	0x2e, 0x9b, 0x0c, 0xa1, 0xce, 0xc1, 0x38, 0x87, 0xb2, 0xda, 0x1d, 0xbb, 0x0a, 0x74, 0x29, 0xb0,
-	0xa1, 0x46, 0xbb, 0x63, 0x23, 0x1f, 0x2e, 0xa4, 0x4f, 0xa5, 0xfb, 0xcd, 0x7d, 0x6c, 0x76, 0x6d,
-	0xac, 0x07, 0x2e, 0xbb, 0x2c, 0x1a, 0x88, 0xbb, 0xdd, 0xa0, 0x3a, 0x5e, 0x14, 0x33, 0xbe, 0x9a,
-	0x3c, 0xeb, 0x36, 0xc7, 0xb4, 0xe3, 0xd2, 0x7b, 0xdb, 0x61, 0x68, 0x88, 0x4b, 0xc2, 0xae, 0x8a,
-	0x38, 0xcf, 0xfd, 0x83, 0x4c, 0xd0, 0x5c, 0xc0, 0x0c, 0x9d, 0xda, 0x26, 0x33, 0xe1, 0x29, 0xf2,
-	0xd4, 0x69, 0x32, 0x4f, 0x9d, 0xd0, 0x7d, 0xa8, 0x44, 0xb2, 0xed, 0x13, 0x65, 0xaa, 0x56, 0x68,
-	0xdc, 0x7f, 0x3e, 0x79, 0x55, 0x2c, 0x19, 0x13, 0x97, 0x6f, 0xa6, 0x79, 0x91, 0x62, 0xd0, 0x9f,
-	0xa8, 0x09, 0xb3, 0x11, 0xb6, 0xa6, 0xed, 0xfa, 0x98, 0xe3, 0x9c, 0xa2, 0x38, 0x2f, 0x0d, 0xe8,
-	0x30, 0x10, 0x40, 0x82, 0xaf, 0xeb, 0x6b, 0x91, 0x3e, 0x47, 0x83, 0x44, 0xcb, 0x67, 0x38, 0x23,
-	0x74, 0x16, 0x55, 0x90, 0x57, 0x7c, 0x5a, 0xf4, 0x26, 0xf6, 0xa9, 0xe6, 0x0c, 0xfa, 0x20, 0x5c,
-	0xaf, 0x4d, 0x1f, 0xa4, 0x46, 0xd0, 0x0d, 0x58, 0xb2, 0x88, 0xce, 0xa5, 0xee, 0x18, 0x3b, 0xc4,
-	0xce, 0x98, 0xd5, 0x19, 0xea, 0x06, 0x2e, 0x58, 0x7e, 0xd2, 0x1a, 0xdf, 0x61, 0xd3, 0xea, 0x2f,
-	0x15, 0x58, 0x78, 0xec, 0xda, 0xf6, 0xaf, 0x99, 0x35, 0xfe, 0xe9, 0x28, 0x54, 0xb3, 0xc7, 0xfe,
-	0xda, 0x1c, 0x7f, 0x6d, 0x8e, 0xbf, 0x8a, 0xe6, 0x38, 0x4f, 0x3f, 0x26, 0x72, 0xcd, 0xab, 0xd0,
-	0x56, 0x4d, 0x9e, 0xd8, 0x56, 0xfd, 0xea, 0x59, 0x6d, 0xf5, 0x9f, 0x4a, 0xb0, 0xa2, 0xe1, 0xa6,
-	0xeb, 0x99, 0xf1, 0x44, 0x29, 0x57, 0x8b, 0x97, 0x69, 0x29, 0xcf, 0xc1, 0x78, 0x24, 0x38, 0x91,
-	0x11, 0x80, 0x70, 0xa8, 0x61, 0xa2, 0x05, 0x18, 0xa1, 0x32, 0xc6, 0x35, 0xbe, 0xac, 0x0d, 0x93,
-	0x9f, 0x0d, 0x13, 0x9d, 0x05, 0xe0, 0x7e, 0x7c, 0xa8, 0xbb, 0x63, 0xda, 0x18, 0x1f, 0x69, 0x98,
-	0x48, 0x83, 0x89, 0x8e, 0x6b, 0xdb, 0x7a, 0x18, 0x2b, 0x0c, 0x4b, 0x62, 0x05, 0x62, 0x43, 0xef,
-	0xba, 0x5e, 0x9c, 0x35, 0x61, 0xac, 0x30, 0x4e, 0x90, 0xf0, 0x1f, 0xea, 0xbf, 0x8d, 0xc0, 0xaa,
-	0x84, 0x8b, 0xdc, 0xf0, 0x66, 0x2c, 0xa4, 0x72, 0x3c, 0x0b, 0x29, 0xb5, 0x7e, 0xa5, 0xe3, 0x5b,
-	0xbf, 0x6f, 0x00, 0x0a, 0xf9, 0x6b, 0xa6, 0xcd, 0xef, 0x74, 0x34, 0x13, 0xae, 0x5e, 0x23, 0x06,
-	0x4c, 0x60, 0x7a, 0xcb, 0xc4, 0x42, 0x25, 0xf0, 0x66, 0x2c, 0xfa, 0x50, 0xd6, 0xa2, 0xc7, 0x4a,
-	0x2a, 0xc3, 0xc9, 0x92, 0xca, 0x35, 0xa8, 0x72, 0x93, 0xd2, 0x4f, 0x40, 0x84, 0xaf, 0xff, 0x08,
-	0x7d, 0xfd, 0xe7, 0xd9, 0x7c, 0x24, 0x3b, 0xfc, 0xf1, 0x47, 0x1a, 0x4c, 0x46, 0xa5, 0x03, 0x9a,
-	0xb2, 0x60, 0xb5, 0x88, 0x8b, 0x79, 0xda, 0xb8, 0xe3, 0x19, 0x8e, 0x4f, 0x4c, 0x59, 0x22, 0x4c,
-	0x9f, 0x30, 0x63, 0xbf, 0xd0, 0xc7, 0x70, 0x46, 0x90, 0x10, 0xe9, 0x9b, 0xf0, 0xb1, 0x41, 0x4c,
-	0xf8, 0x62, 0x46, 0xdc, 0x23, 0x6b, 0x9e, 0xe3, 0x5a, 0x42, 0x9e, 0x6b, 0xb9, 0x0a, 0x13, 0x09,
-	0x9b, 0x37, 0x4e, 0x6d, 0xde, 0xf8, 0x6e, 0xcc, 0xd8, 0xdd, 0x82, 0x4a, 0xff, 0x5a, 0x69, 0x49,
-	0x6a, 0xa2, 0xb0, 0x24, 0x35, 0x19, 0x41, 0xd0, 0x8a, 0xd4, 0xfb, 0x30, 0x11, 0xde, 0x35, 0x45,
-	0x30, 0x59, 0x88, 0x60, 0x9c, 0xaf, 0xa7, 0xe0, 0x06, 0x8c, 0x90, 0x48, 0x9e, 0x18, 0xd9, 0x0a,
-	0xcd, 0xbf, 0xdc, 0xcb, 0xcd, 0x42, 0x17, 0x6a, 0x11, 0x4d, 0x11, 0x58, 0xd8, 0x67, 0x79, 0xe7,
-	0x10, 0x6f, 0xed, 0x63, 0x98, 0x88, 0x4f, 0x08, 0xf2, 0xcc, 0xd7, 0xe2, 0x79, 0xe6, 0xbc, 0xfc,
-	0x43, 0xa8, 0x75, 0x2c, 0x0f, 0x11, 0xcb, 0x45, 0xf7, 0xed, 0x64, 0x98, 0x75, 0xfa, 0xda, 0x4e,
-	0x66, 0xec, 0x64, 0x9c, 0x35, 0x42, 0x3b, 0xf9, 0x45, 0x39, 0xb4, 0x93, 0x42, 0x2e, 0x72, 0x3b,
-	0xf9, 0x21, 0x4c, 0xa5, 0xec, 0x90, 0xd4, 0x52, 0xb2, 0xf7, 0xb7, 0x47, 0x2d, 0x89, 0x56, 0x49,
-	0xda, 0xa9, 0x8c, 0xe4, 0x96, 0x8e, 0x26, 0xb9, 0x31, 0xb3, 0x54, 0x4e, 0x9a, 0xa5, 0x8f, 0x61,
-	0x39, 0xa9, 0x55, 0xba, 0xdb, 0xd2, 0x83, 0x7d, 0xcb, 0xd7, 0xe3, 0xa5, 0x61, 0xf9, 0x56, 0xb5,
-	0x84, 0x96, 0x3d, 0x6a, 0xed, 0xec, 0x5b, 0xfe, 0x2d, 0x8e, 0xbf, 0x01, 0x33, 0xfb, 0xd8, 0xf0,
-	0x82, 0x5d, 0x6c, 0x04, 0xba, 0x89, 0x03, 0xc3, 0xb2, 0x7d, 0x9e, 0x62, 0x94, 0x67, 0xdf, 0xa6,
-	0x23, 0xb0, 0x2d, 0x06, 0x95, 0x7d, 0x77, 0x86, 0x8f, 0xf7, 0xee, 0xbc, 0x0e, 0x53, 0x11, 0x1e,
-	0x26, 0xd6, 0xd4, 0x00, 0x8f, 0x69, 0x91, 0xd7, 0xb3, 0x45, 0x47, 0xd5, 0x3f, 0x57, 0xe0, 0x15,
-	0x76, 0x9b, 0x09, 0x4d, 0xe6, 0x15, 0xde, 0xbe, 0xbe, 0x68, 0xe9, 0x8c, 0xdd, 0xb5, 0xbc, 0x8c,
-	0x5d, 0x11, 0xaa, 0x01, 0x53, 0x77, 0x7f, 0x57, 0x86, 0x57, 0xe5, 0xd8, 0xb8, 0x08, 0xe2, 0xfe,
-	0xe3, 0xe6, 0xf1, 0x31, 0x4e, 0xe2, 0xf5, 0xe3, 0x9b, 0x2e, 0x6d, 0xca, 0x4f, 0x49, 0xfa, 0x4f,
-	0x14, 0x58, 0xee, 0xe7, 0xbc, 0x89, 0x83, 0x6c, 0x5a, 0x7e, 0xc7, 0x08, 0x9a, 0xfb, 0xba, 0xed,
-	0x36, 0x0d, 0xdb, 0xee, 0x55, 0x4b, 0xd4, 0x60, 0x7e, 0x2c, 0xd9, 0xb5, 0xf8, 0x38, 0xf5, 0x7e,
-	0x52, 0x7c, 0xc7, 0xdd, 0xe2, 0x3b, 0xdc, 0x67, 0x1b, 0x30, 0x3b, 0xba, 0x64, 0xe4, 0xaf, 0xa8,
-	0xfd, 0x3e, 0xac, 0x14, 0x21, 0x10, 0xd8, 0xdb, 0xad, 0xa4, 0xbd, 0x15, 0xa7, 0xdc, 0x43, 0x33,
-	0x40, 0x71, 0x85, 0x88, 0xe9, 0xb3, 0x1b, 0xb3, 0xbd, 0x3f, 0x54, 0x88, 0xed, 0xcd, 0x1c, 0xf3,
-	0xae, 0x61, 0xd9, 0x7d, 0x59, 0x1a, 0xb0, 0x56, 0x53, 0x84, 0x67, 0x40, 0x41, 0x7a, 0x85, 0xd8,
-	0xb1, 0x5c, 0x4c, 0x3c, 0x13, 0xfc, 0x63, 0x05, 0xd4, 0xac, 0xb5, 0xfb, 0x20, 0x54, 0xcf, 0x90,
-	0xf2, 0x27, 0x69, 0xca, 0xaf, 0xe6, 0x50, 0x5e, 0x84, 0x69, 0x40, 0xda, 0x1f, 0x13, 0xe5, 0x94,
-	0xe0, 0xe2, 0xb2, 0xf9, 0x06, 0x4c, 0x37, 0x0d, 0xa7, 0x89, 0xa3, 0x17, 0x00, 0xb3, 0x37, 0x6d,
-	0x54, 0x9b, 0x62, 0xe3, 0x5a, 0x38, 0x1c, 0xd7, 0xf7, 0x38, 0xce, 0x13, 0xea, 0xbb, 0x0c, 0xd5,
-	0x80, 0x47, 0x7d, 0x2d, 0x52, 0xf7, 0x1c, 0x64, 0xb1, 0x6a, 0xa0, 0x60, 0xe1, 0x49, 0x24, 0x2c,
-	0x17, 0xcf, 0x91, 0x25, 0x4c, 0x84, 0x29, 0x21, 0x61, 0xd9, 0x03, 0xd2, 0xfb, 0xe9, 0x53, 0x3e,
-	0xb0, 0x84, 0x15, 0x61, 0x1a, 0x90, 0xf6, 0xf3, 0x62, 0x71, 0x88, 0x70, 0x71, 0xea, 0xff, 0x5e,
-	0x81, 0x73, 0x1a, 0x6e, 0xbb, 0x07, 0x98, 0x95, 0xf9, 0xbf, 0x2c, 0x49, 0xba, 0xa4, 0x63, 0x54,
-	0x4e, 0x39, 0x46, 0xaa, 0x4a, 0x64, 0x25, 0x8f, 0x6a, 0x7e, 0xb4, 0x7f, 0x28, 0xc1, 0x79, 0x7e,
-	0x04, 0x76, 0xec, 0xdc, 0x1a, 0xb3, 0xf4, 0x80, 0x06, 0x54, 0x92, 0x3a, 0xc8, 0x0f, 0x77, 0x3d,
-	0xe7, 0xfe, 0x06, 0xd8, 0x50, 0x9b, 0x4c, 0x68, 0x2f, 0xda, 0x85, 0x85, 0xa8, 0x8c, 0x2f, 0xec,
-	0x95, 0x13, 0x57, 0x78, 0xef, 0x70, 0x98, 0x54, 0x85, 0x17, 0x8b, 0x86, 0x8f, 0x5c, 0xc2, 0x5f,
-	0x83, 0xd7, 0x8a, 0xce, 0xc2, 0xf9, 0xfc, 0x8f, 0x0a, 0x2c, 0x85, 0x59, 0x21, 0x41, 0x94, 0xfe,
-	0x52, 0xc4, 0xe7, 0x02, 0xcc, 0x58, 0xbe, 0x9e, 0x6c, 0x5d, 0xa3, 0xbc, 0x1c, 0xd5, 0xa6, 0x2c,
-	0xff, 0x6e, 0xbc, 0x29, 0x4d, 0x5d, 0x86, 0x33, 0x62, 0xf2, 0xf9, 0xf9, 0xbe, 0x28, 0x11, 0x0b,
-	0x46, 0x8c, 0x75, 0xb2, 0x2a, 0x9d, 0x31, 0xad, 0x2f, 0xe3, 0xa0, 0xab, 0x30, 0xc1, 0xfb, 0x12,
-	0xb1, 0x19, 0x4b, 0xd4, 0x46, 0x63, 0x0d, 0x13, 0x7d, 0x04, 0xa7, 0x9b, 0x21, 0xa9, 0xb1, 0xad,
-	0x4f, 0x1d, 0x69, 0x6b, 0x14, 0xa1, 0xe8, 0xef, 0x7d, 0x1f, 0xa6, 0x63, 0xbd, 0x86, 0x2c, 0x48,
-	0x18, 0x1a, 0x34, 0x48, 0x98, 0xea, 0x83, 0xd2, 0x01, 0xf5, 0x75, 0xa2, 0xad, 0x52, 0x2e, 0xf3,
-	0xfb, 0xf8, 0x8f, 0x12, 0x54, 0x35, 0xde, 0x47, 0x8b, 0x29, 0xac, 0xff, 0x74, 0xe3, 0x65, 0xde,
-	0xc1, 0xef, 0xc2, 0x5c, 0x32, 0x93, 0xd9, 0xd3, 0xad, 0x00, 0xb7, 0xc3, 0xfe, 0x89, 0x74, 0xa7,
-	0x80, 0xd9, 0xb6, 0x9c, 0x4c, 0x32, 0xb3, 0xd7, 0x08, 0x70, 0x5b, 0x3b, 0x7d, 0x90, 0x19, 0xf3,
-	0xd1, 0x3b, 0x30, 0x4c, 0x79, 0xeb, 0xf3, 0x2b, 0x13, 0x27, 0x36, 0xb6, 0x8c, 0xc0, 0xb8, 0x6d,
-	0xbb, 0xbb, 0x1a, 0x5f, 0x8c, 0x36, 0xa1, 0xe2, 0xe0, 0x43, 0xdd, 0xeb, 0xf2, 0xab, 0x09, 0x23,
-	0x97, 0x02, 0xf0, 0x09, 0x07, 0x1f, 0x6a, 0x5d, 0x76, 0x27, 0xbe, 0xba, 0x04, 0x8b, 0x02, 0x56,
-	0xf3, 0x8b, 0xf8, 0xbe, 0x02, 0xf3, 0xdb, 0x3d, 0xa7, 0xb9, 0xbd, 0x6f, 0x78, 0x26, 0xcf, 0x6f,
-	0xf2, 0x6b, 0x38, 0x0f, 0x15, 0xdf, 0xed, 0x7a, 0x4d, 0xac, 0xf3, 0xf6, 0x6a, 0x7e, 0x17, 0x93,
-	0x6c, 0x74, 0x93, 0x0d, 0xa2, 0x45, 0x18, 0xf5, 0x09, 0x70, 0xf8, 0x80, 0x0d, 0x69, 0x23, 0xf4,
-	0x77, 0xc3, 0x44, 0x75, 0x38, 0x45, 0x83, 0xc5, 0x72, 0x61, 0x04, 0x47, 0xd7, 0xa9, 0x8b, 0xb0,
-	0x90, 0xa1, 0x85, 0xd3, 0xf9, 0xf3, 0x21, 0x38, 0x4d, 0xe6, 0xc2, 0x87, 0xf0, 0x65, 0xca, 0x4a,
-	0x15, 0x46, 0xc2, 0x7c, 0x12, 0x53, 0xd5, 0xf0, 0x27, 0xd1, 0xe4, 0x7e, 0x30, 0x1b, 0x25, 0x0a,
-	0xa2, 0xc4, 0x02, 0xe1, 0x49, 0x36, 0x8b, 0x34, 0x74, 0xd4, 0x2c, 0xd2, 0x59, 0x80, 0x30, 0xa8,
-	0xb2, 0x4c, 0x1a, 0x84, 0x96, 0xb5, 0x31, 0x3e, 0xd2, 0x30, 0x33, 0xa1, 0xfa, 0xc8, 0xd1, 0x42,
-	0xf5, 0x0f, 0x79, 0xed, 0xa6, 0x1f, 0x35, 0x53, 0x2c, 0xa3, 0x85, 0x58, 0x66, 0x08, 0x58, 0xe4,
-	0xff, 0x52, 0x5c, 0x57, 0x60, 0x24, 0x0c, 0xb9, 0xc7, 0x06, 0x08, 0xb9, 0xc3, 0xc5, 0xf1, 0x74,
-	0x01, 0x24, 0xd3, 0x05, 0x37, 0x61, 0x82, 0x55, 0x96, 0x78, 0x9b, 0xf5, 0xf8, 0x00, 0x6d, 0xd6,
-	0xe3, 0xb4, 0xe0, 0xc4, 0x3b, 0xac, 0xdf, 0x02, 0xda, 0x25, 0xcd, 0x3f, 0x2b, 0xd0, 0x2d, 0x13,
-	0x3b, 0x81, 0x15, 0xf4, 0x68, 0x2e, 0x6f, 0x4c, 0x43, 0x64, 0xee, 0x23, 0x3a, 0xd5, 0xe0, 0x33,
-	0xe8, 0x21, 0x4c, 0xa5, 0x4c, 0x03, 0xcf, 0xdb, 0x9d, 0x1f, 0xc8, 0x28, 0x68, 0x95, 0xa4, 0x41,
-	0x50, 0xe7, 0x61, 0x36, 0x29, 0xc9, 0x5c, 0xc4, 0xff, 0x54, 0x81, 0xa5, 0xb0, 0x6f, 0xed, 0x4b,
-	0xe2, 0xc2, 0xa9, 0x7f, 0xa2, 0xc0, 0x19, 0x31, 0x4d, 0x3c, 0xba, 0xb9, 0x0c, 0xf3, 0x6d, 0x36,
-	0xce, 0xaa, 0x2a, 0xba, 0xe5, 0xe8, 0x4d, 0xa3, 0xb9, 0x8f, 0x39, 0x85, 0xa7, 0xdb, 0x31, 0xa8,
-	0x86, 0xb3, 0x49, 0xa6, 0xd0, 0xbb, 0xb0, 0x98, 0x01, 0x32, 0x8d, 0xc0, 0xd8, 0x35, 0xfc, 0xb0,
-	0x7d, 0x75, 0x3e, 0x09, 0xb7, 0xc5, 0x67, 0xd5, 0x33, 0x50, 0x0b, 0xe9, 0xe1, 0xfc, 0xfc, 0xc0,
-	0x8d, 0x1a, 0x8f, 0xd4, 0x3f, 0x28, 0xf5, 0x59, 0x98, 0x98, 0xe6, 0xd4, 0xae, 0xc1, 0xb4, 0xd3,
-	0x6d, 0xef, 0x62, 0x4f, 0x77, 0x5b, 0x3a, 0xb5, 0x52, 0x3e, 0xa5, 0x73, 0x48, 0xab, 0xb0, 0xf1,
-	0x47, 0x2d, 0x6a, 0x7c, 0x7c, 0xc2, 0xec, 0xd0, 0xaa, 0xf9, 0x34, 0x77, 0x30, 0xa4, 0x8d, 0x72,
-	0xb3, 0xe6, 0xa3, 0x06, 0x4c, 0xf0, 0x9b, 0x60, 0x47, 0x15, 0xf7, 0x68, 0x86, 0xe2, 0xc0, 0x92,
-	0x39, 0xf4, 0xe4, 0xd4, 0xb9, 0x1b, 0x37, 0xfb, 0x03, 0xe8, 0x0a, 0x2c, 0xb0, 0x7d, 0x9a, 0xae,
-	0x13, 0x78, 0xae, 0x6d, 0x63, 0x8f, 0xf2, 0xa4, 0xcb, 0x5e, 0x8a, 0x31, 0x6d, 0x8e, 0x4e, 0x6f,
-	0x46, 0xb3, 0xcc, 0x2e, 0x52, 0x0d, 0x31, 0x4d, 0x0f, 0xfb, 0x3e, 0xcf, 0x38, 0x86, 0x3f, 0xd5,
-	0x3a, 0xcc, 0xb0, 0xba, 0x14, 0x81, 0x0b, 0x65, 0x27, 0x6e, 0xa4, 0x95, 0x84, 0x91, 0x56, 0x67,
-	0x01, 0xc5, 0xd7, 0x73, 0x61, 0xfc, 0x2f, 0x05, 0x66, 0x98, 0x77, 0x1e, 0x77, 0x03, 0xf3, 0xd1,
-	0xa0, 0x1b, 0xbc, 0x86, 0x1b, 0x95, 0xac, 0x2b, 0x1b, 0xe7, 0x72, 0x18, 0x42, 0x30, 0xd2, 0xb4,
-	0x18, 0xad, 0xe2, 0xd2, 0x94, 0x58, 0x2c, 0xb9, 0x5a, 0x4e, 0x24, 0x57, 0x37, 0x61, 0xea, 0xc0,
-	0xf2, 0xad, 0x5d, 0xcb, 0xb6, 0x82, 0x1e, 0xb3, 0x44, 0xc5, 0xf9, 0xc0, 0x4a, 0x1f, 0x84, 0x9a,
-	0xa1, 0x55, 0x98, 0xe0, 0x4f, 0x98, 0xee, 0x18, 0xdc, 0xe2, 0x8e, 0x69, 0xe3, 0x7c, 0xec, 0xa1,
-	0xd1, 0xc6, 0x84, 0x0b, 0xf1, 0xe3, 0x72, 0x2e, 0xfc, 0x80, 0x72, 0xc1, 0xc7, 0xc1, 0x93, 0x2e,
-	0xee, 0xe2, 0x01, 0xb8, 0x90, 0xde, 0xa9, 0x94, 0xd9, 0x29, 0xc9, 0xa8, 0xf2, 0x11, 0x19, 0xc5,
-	0xe8, 0xec, 0x13, 0xc4, 0xe9, 0xfc, 0x91, 0x02, 0xb3, 0xa1, 0xdc, 0x7f, 0x69, 0x48, 0x7d, 0x04,
-	0x73, 0x29, 0x9a, 0xb8, 0x16, 0x5e, 0x81, 0x85, 0x8e, 0xe7, 0x36, 0xb1, 0xef, 0x5b, 0xce, 0x9e,
-	0x4e, 0xbf, 0xb8, 0x62, 0x76, 0x80, 0x28, 0x63, 0x99, 0xc8, 0x7c, 0x7f, 0x9a, 0x42, 0x52, 0x23,
-	0xe0, 0xab, 0xdf, 0x55, 0xe0, 0xec, 0x3d, 0x1c, 0x68, 0xfd, 0xef, 0xaf, 0x1e, 0x60, 0xdf, 0x37,
-	0xf6, 0x70, 0xe4, 0xb2, 0xdc, 0x84, 0x61, 0x5a, 0xbe, 0x61, 0x88, 0xc6, 0x37, 0x5e, 0xcf, 0xa1,
-	0x36, 0x86, 0x82, 0xd6, 0x76, 0x34, 0x0e, 0x36, 0x00, 0x53, 0x88, 0x8d, 0x59, 0xce, 0xa3, 0x82,
-	0x1f, 0xf0, 0x39, 0x54, 0x18, 0xd7, 0xdb, 0x7c, 0x86, 0x93, 0xf3, 0x61, 0x6e, 0xf6, 0x51, 0x8e,
-	0xb0, 0x4e, 0x75, 0x33, 0x1c, 0x65, 0x99, 0xc6, 0x49, 0x3f, 0x3e, 0x56, 0xb3, 0x01, 0x65, 0x17,
-	0xc5, 0xb3, 0x89, 0x43, 0x2c, 0x9b, 0xf8, 0xad, 0x64, 0x36, 0xf1, 0x42, 0x31, 0x83, 0x22, 0x62,
-	0x62, 0x99, 0xc4, 0x36, 0xac, 0xdc, 0xc3, 0xc1, 0xd6, 0xfd, 0x27, 0x92, 0xbb, 0x68, 0x00, 0x30,
-	0x95, 0x76, 0x5a, 0x6e, 0xc8, 0x80, 0x01, 0xb6, 0x23, 0x82, 0x44, 0xcd, 0x24, 0x15, 0x3d, 0xf2,
-	0x97, 0xaf, 0xbe, 0x80, 0x55, 0xc9, 0x76, 0x9c, 0xe9, 0xdb, 0x30, 0x13, 0xfb, 0x32, 0x8f, 0x96,
-	0x12, 0xc3, 0x6d, 0x5f, 0x1b, 0x6c, 0x5b, 0x6d, 0xda, 0x4b, 0x0e, 0xf8, 0xea, 0xbf, 0x2a, 0x30,
-	0xab, 0x61, 0xa3, 0xd3, 0xb1, 0x59, 0xc8, 0x13, 0x9d, 0x6e, 0x1e, 0x86, 0x79, 0xea, 0x9e, 0xbd,
-	0x73, 0xfc, 0x97, 0xbc, 0xd5, 0x5f, 0xfc, 0x48, 0x97, 0x4f, 0xea, 0x8f, 0x1e, 0x2f, 0xb8, 0x50,
-	0x17, 0x60, 0x2e, 0x75, 0x34, 0x6e, 0x4d, 0x7e, 0xa6, 0xc0, 0x92, 0x86, 0x5b, 0x1e, 0xf6, 0xf7,
-	0xa3, 0x2a, 0x06, 0xe1, 0xc6, 0x97, 0xf0, 0xec, 0x24, 0xf0, 0x17, 0x93, 0xca, 0xcf, 0xf2, 0x2e,
-	0x2c, 0x6c, 0xba, 0x5d, 0x87, 0x08, 0x4f, 0x5a, 0x40, 0x97, 0x01, 0x5a, 0xae, 0xd7, 0xc4, 0x77,
-	0x71, 0xd0, 0xdc, 0xe7, 0x29, 0xd9, 0xd8, 0x88, 0x6a, 0x40, 0x35, 0x0b, 0xca, 0x85, 0xed, 0x0e,
-	0x8c, 0x60, 0x27, 0xa0, 0x95, 0x58, 0x26, 0x62, 0x6f, 0xe6, 0x88, 0x18, 0xf7, 0x42, 0xb6, 0xee,
-	0x3f, 0xa1, 0xb8, 0x78, 0xb5, 0x95, 0xc3, 0xaa, 0x3f, 0x2b, 0xc1, 0xbc, 0x86, 0x0d, 0x53, 0x40,
-	0xdd, 0x06, 0x9c, 0x8a, 0x7a, 0x1b, 0x2a, 0x1b, 0xcb, 0x79, 0xbe, 0xc5, 0xfd, 0x27, 0xd4, 0xea,
-	0xd2, 0xb5, 0xb2, 0x50, 0x2c, 0x1b, 0xcc, 0x95, 0x45, 0xc1, 0xdc, 0x0e, 0x54, 0x2d, 0x87, 0xac,
-	0xb0, 0x0e, 0xb0, 0x8e, 0x9d, 0xc8, 0x82, 0x0d, 0xd8, 0x0f, 0x36, 0x17, 0x01, 0xdf, 0x71, 0x42,
-	0x53, 0xd4, 0x30, 0x89, 0x60, 0x74, 0x08, 0x12, 0xdf, 0xfa, 0x84, 0x3d, 0xbe, 0x43, 0xda, 0x28,
-	0x19, 0xd8, 0xb6, 0x3e, 0xc1, 0xe8, 0x35, 0x98, 0xa2, 0x5d, 0x0d, 0x74, 0x05, 0x2b, 0xbe, 0x0f,
-	0xd3, 0xe2, 0x3b, 0x6d, 0x76, 0x78, 0x6c, 0xec, 0x61, 0xd6, 0x8b, 0xf7, 0xb7, 0x25, 0x58, 0xc8,
-	0xf0, 0x8a, 0x5f, 0xc7, 0x71, 0x98, 0x25, 0xb4, 0x17, 0xa5, 0x93, 0xd9, 0x0b, 0xf4, 0x1d, 0x98,
-	0xcf, 0x20, 0x0d, 0x93, 0x80, 0x47, 0x35, 0x80, 0xb3, 0x69, 0xec, 0x34, 0x07, 0x28, 0x60, 0xd7,
-	0x29, 0x11, 0xbb, 0x7e, 0xa1, 0xc0, 0xc2, 0xe3, 0xae, 0xb7, 0x87, 0xbf, 0xda, 0xb2, 0xa5, 0xd6,
-	0xa0, 0x9a, 0x3d, 0x26, 0x57, 0xfe, 0xcf, 0x4a, 0xb0, 0xf0, 0x00, 0x7f, 0xe5, 0x79, 0xf0, 0x7f,
-	0xa3, 0x5f, 0xb7, 0xa1, 0x9a, 0xe5, 0x15, 0xd7, 0x2f, 0x01, 0x0e, 0x45, 0x84, 0xe3, 0x53, 0x05,
-	0xce, 0x3c, 0x74, 0x03, 0xab, 0xd5, 0x23, 0xe1, 0xb6, 0x7b, 0x80, 0xbd, 0x07, 0x06, 0x89, 0xa5,
-	0x23, 0xae, 0x7f, 0x07, 0xe6, 0x5b, 0x7c, 0x46, 0x6f, 0xd3, 0x29, 0x3d, 0xe1, 0xb0, 0xe5, 0xe9,
-	0x47, 0x12, 0x1d, 0xf3, 0xd9, 0x66, 0x5b, 0xd9, 0x41, 0x5f, 0x3d, 0x07, 0x67, 0x73, 0x28, 0xe0,
-	0x42, 0x61, 0xc0, 0xd2, 0x3d, 0x1c, 0x6c, 0x7a, 0xae, 0xef, 0xf3, 0x5b, 0x49, 0x3c, 0x6e, 0x89,
-	0xc0, 0x4f, 0x49, 0x05, 0x7e, 0xe7, 0xa1, 0x12, 0x18, 0xde, 0x1e, 0x0e, 0xa2, 0x5b, 0x66, 0xcf,
-	0xdc, 0x24, 0x1b, 0xe5, 0xf8, 0xd4, 0x5f, 0x96, 0xe1, 0x8c, 0x78, 0x0f, 0xce, 0xcf, 0x36, 0xc1,
-	0x43, 0x4c, 0xc3, 0x6e, 0x8f, 0x85, 0xa1, 0xfc, 0xf8, 0xf7, 0x64, 0x0e, 0x62, 0x2e, 0x3a, 0xea,
-	0x7c, 0xfb, 0xb7, 0x7b, 0xd4, 0x01, 0x64, 0x2f, 0xcc, 0x44, 0x10, 0x1b, 0x42, 0x9f, 0x2a, 0x30,
-	0xd7, 0xa2, 0x15, 0x2f, 0xbd, 0x69, 0x74, 0x7d, 0xdc, 0xdf, 0x96, 0xd9, 0xbb, 0x07, 0xc7, 0xdb,
-	0x96, 0x15, 0xd1, 0x36, 0x09, 0xc6, 0xc4, 0xe6, 0xa8, 0x95, 0x99, 0xa8, 0x75, 0x60, 0x26, 0x43,
-	0xa5, 0xc0, 0x3d, 0xbd, 0x93, 0x74, 0x4f, 0xd7, 0x73, 0xc4, 0x21, 0x4d, 0x13, 0xbf, 0xbc, 0xb8,
-	0x8f, 0x5a, 0xeb, 0xc0, 0x42, 0x0e, 0x81, 0x82, 0x7d, 0x6f, 0xc6, 0xf7, 0xad, 0xe4, 0xa6, 0x7b,
-	0xef, 0xe1, 0xa0, 0x5f, 0x3d, 0xa4, 0x78, 0xe3, 0x5e, 0xf1, 0x7f, 0x2a, 0xb0, 0xc6, 0xeb, 0x75,
-	0x19, 0xa6, 0x65, 0x0a, 0x0d, 0x92, 0xc8, 0x6c, 0x30, 0x29, 0x43, 0x4f, 0x99, 0x10, 0x45, 0x8d,
-	0x15, 0x61, 0xae, 0x7a, 0x70, 0xa6, 0xf1, 0x76, 0x8a, 0xc9, 0x20, 0xf6, 0xcb, 0x47, 0xaf, 0xc2,
-	0x64, 0x8b, 0x38, 0x40, 0x0f, 0x31, 0xf3, 0xa5, 0x78, 0x7d, 0x29, 0x39, 0xa8, 0x7a, 0xf0, 0xc6,
-	0x00, 0x67, 0x8d, 0xdc, 0xa5, 0xa1, 0xd0, 0x1f, 0x3f, 0xde, 0xb5, 0x52, 0x68, 0xf5, 0x1d, 0xfa,
-	0x45, 0x58, 0xa8, 0xd8, 0xf4, 0x91, 0x1c, 0x20, 0x37, 0xa6, 0x06, 0xf4, 0x93, 0xaa, 0x24, 0x58,
-	0xe4, 0x38, 0xcc, 0xf5, 0xeb, 0x2a, 0x61, 0x22, 0xa6, 0xcb, 0x1b, 0xa5, 0x86, 0xb4, 0x7e, 0xd1,
-	0x65, 0x9b, 0x65, 0x61, 0xba, 0x0e, 0xcd, 0x8b, 0x87, 0xdf, 0x2c, 0xf2, 0x14, 0x12, 0xcb, 0x0f,
-	0x4d, 0xf2, 0x51, 0x96, 0x41, 0xda, 0xf8, 0xe7, 0x8b, 0x00, 0xdc, 0xfb, 0xbb, 0xf5, 0xb8, 0x81,
-	0xbe, 0xa7, 0xc0, 0xbc, 0xf8, 0xdb, 0x6b, 0x74, 0xe5, 0x78, 0xff, 0x2c, 0xa1, 0x76, 0xf5, 0xc8,
-	0x70, 0xfc, 0xd4, 0x7f, 0xac, 0xc0, 0x42, 0xce, 0xc7, 0xf9, 0xe8, 0x6a, 0xd1, 0x87, 0xed, 0x79,
-	0xd4, 0x5c, 0x3b, 0x3a, 0x20, 0x27, 0xe7, 0xa7, 0x0a, 0xac, 0x14, 0x7d, 0xa0, 0x8e, 0xbe, 0x75,
-	0xd2, 0x0f, 0xee, 0x6b, 0xb7, 0x4e, 0x80, 0x81, 0x53, 0x4a, 0x2e, 0x51, 0xfc, 0xe9, 0xb9, 0xe4,
-	0x12, 0xa5, 0x9f, 0xbc, 0x4b, 0x2e, 0xb1, 0xe0, 0x1b, 0xf7, 0x3f, 0x53, 0xa0, 0x96, 0xff, 0x81,
-	0x36, 0xca, 0xef, 0xaf, 0x2a, 0xfc, 0x70, 0xbd, 0xf6, 0xde, 0xb1, 0x60, 0x39, 0x5d, 0x3f, 0x52,
-	0x60, 0x31, 0xf7, 0xf3, 0x6b, 0xf4, 0x6e, 0x2e, 0xea, 0xa2, 0xaf, 0xbf, 0x6b, 0xd7, 0x8f, 0x03,
-	0xca, 0x89, 0x72, 0x60, 0x32, 0xf1, 0x5d, 0x2e, 0xba, 0x98, 0x8b, 0x4c, 0xf4, 0xf9, 0x6f, 0xad,
-	0x3e, 0xe8, 0x72, 0xbe, 0xdf, 0xa7, 0x0a, 0x9c, 0x16, 0x7c, 0xdc, 0x8a, 0x2e, 0xcb, 0x6f, 0x5b,
-	0xf8, 0x39, 0x6d, 0xed, 0xed, 0xa3, 0x01, 0x71, 0x12, 0x02, 0x98, 0x4a, 0x7d, 0x48, 0x8a, 0xd6,
-	0x65, 0xef, 0xbc, 0xa0, 0xe4, 0x50, 0x7b, 0x6b, 0x70, 0x00, 0xbe, 0xeb, 0x21, 0x4c, 0xa7, 0x3f,
-	0x98, 0x42, 0xf9, 0x58, 0x72, 0x3e, 0x29, 0xab, 0x5d, 0x3a, 0x02, 0x44, 0x4c, 0xec, 0x72, 0x3b,
-	0x07, 0x25, 0x62, 0x57, 0xf4, 0xd1, 0x46, 0xed, 0x04, 0x8d, 0x8a, 0xe8, 0x2f, 0x15, 0x38, 0x23,
-	0x6b, 0x2c, 0x44, 0x37, 0x8e, 0xd9, 0x8f, 0xc8, 0x48, 0x7b, 0xff, 0x44, 0xdd, 0x8c, 0x9c, 0x65,
-	0x39, 0xdd, 0x77, 0x52, 0x96, 0xc9, 0x7b, 0xff, 0xa4, 0x2c, 0x2b, 0x68, 0xf6, 0x8b, 0xdd, 0xa3,
-	0xa0, 0xb5, 0xb9, 0xf0, 0x1e, 0xf3, 0x9b, 0xca, 0x0b, 0xef, 0x51, 0xd6, 0x49, 0x1d, 0xbb, 0x47,
-	0x61, 0x03, 0x5c, 0xf1, 0x3d, 0xca, 0x9a, 0xf0, 0x8a, 0xef, 0x51, 0xda, 0x75, 0x17, 0xbf, 0xc7,
-	0x6c, 0x8f, 0x5b, 0xf1, 0x3d, 0xe6, 0x76, 0xd8, 0x15, 0xdf, 0x63, 0x7e, 0x4b, 0x1d, 0xfa, 0x0b,
-	0x9a, 0x44, 0xcc, 0x6d, 0x5e, 0x43, 0xef, 0x1d, 0xe9, 0xcc, 0xc9, 0xf6, 0xb9, 0xda, 0x8d, 0xe3,
-	0x01, 0x27, 0x48, 0xcb, 0xed, 0xdc, 0x94, 0x92, 0x56, 0xd4, 0x3b, 0x2a, 0x25, 0xad, 0xb8, 0x59,
-	0xf4, 0xaf, 0x15, 0x58, 0x96, 0xb7, 0x6c, 0xa1, 0x6f, 0x4a, 0x36, 0x18, 0xa0, 0x6f, 0xad, 0x76,
-	0xf3, 0xd8, 0xf0, 0x9c, 0xc6, 0x1f, 0x28, 0x50, 0xcd, 0x6b, 0xdc, 0x43, 0xd7, 0x24, 0xd8, 0xa5,
-	0x1d, 0x8a, 0xb5, 0x77, 0x8f, 0x01, 0xc9, 0x29, 0xfa, 0xae, 0x02, 0xb3, 0xa2, 0xf6, 0x2f, 0x94,
-	0xff, 0x72, 0x4a, 0x9a, 0xdd, 0x6a, 0xef, 0x1c, 0x11, 0x8a, 0x53, 0xf1, 0x57, 0xf4, 0x7f, 0x24,
-	0x49, 0xba, 0x9f, 0xd0, 0xfb, 0x05, 0xb2, 0x21, 0xef, 0x4d, 0xab, 0x7d, 0xf3, 0xb8, 0xe0, 0x9c,
-	0xc0, 0x4f, 0x60, 0x26, 0xd3, 0x08, 0x84, 0x2e, 0x49, 0x90, 0x8a, 0xfb, 0xb3, 0x6a, 0x1b, 0x47,
-	0x01, 0xe9, 0x7b, 0x23, 0xa9, 0xd6, 0x1e, 0x89, 0x37, 0x22, 0x6e, 0x48, 0x92, 0x78, 0x23, 0x39,
-	0x5d, 0x43, 0xe8, 0x19, 0x4c, 0xc4, 0x5b, 0x2d, 0xd0, 0x37, 0xa4, 0x18, 0x52, 0xbd, 0x45, 0xb5,
-	0x8b, 0x03, 0xae, 0x8e, 0x49, 0xa1, 0xa8, 0x57, 0x42, 0x22, 0x85, 0x92, 0x76, 0x0f, 0x89, 0x14,
-	0x4a, 0x1b, 0x32, 0x88, 0xe7, 0x29, 0x68, 0x81, 0x90, 0x78, 0x9e, 0xf9, 0xfd, 0x14, 0xb5, 0xb7,
-	0x8f, 0x06, 0x14, 0x7d, 0xf4, 0x01, 0xfd, 0x8e, 0x02, 0x74, 0x21, 0x17, 0x47, 0xa6, 0x4d, 0xa1,
-	0xf6, 0xe6, 0x40, 0x6b, 0xfb, 0xdb, 0xf4, 0x4b, 0xf6, 0x92, 0x6d, 0x32, 0x6d, 0x0c, 0x92, 0x6d,
-	0xb2, 0x3d, 0x00, 0x6c, 0x9b, 0xb0, 0xe2, 0x2e, 0xdd, 0x26, 0xd5, 0x27, 0x20, 0xdd, 0x26, 0x5d,
-	0xc2, 0x27, 0x11, 0x4a, 0xa2, 0x5a, 0x2e, 0x89, 0x50, 0x44, 0x95, 0x7e, 0x49, 0x84, 0x22, 0x2e,
-	0xc2, 0x7f, 0x8f, 0xfd, 0x7b, 0x1d, 0x41, 0x45, 0x55, 0x12, 0xca, 0x4a, 0xab, 0xef, 0x92, 0x50,
-	0xb6, 0xa0, 0x5e, 0x4e, 0x1c, 0x98, 0xdc, 0x02, 0xaf, 0xc4, 0x81, 0x29, 0xaa, 0x41, 0x4b, 0x1c,
-	0x98, 0xe2, 0x7a, 0xb2, 0x03, 0x93, 0x89, 0xf2, 0xa8, 0xe4, 0x42, 0x44, 0x15, 0x62, 0xc9, 0x85,
-	0x08, 0xab, 0xae, 0xd4, 0x7c, 0x88, 0x4a, 0x99, 0x48, 0x16, 0xfe, 0xe5, 0x16, 0x69, 0x25, 0xe6,
-	0x43, 0x56, 0x2f, 0x25, 0xf1, 0x5b, 0xba, 0xe8, 0x29, 0x89, 0xdf, 0x72, 0x4a, 0xab, 0x92, 0xf8,
-	0x2d, 0xb7, 0xa2, 0x1a, 0xc0, 0x54, 0xaa, 0xba, 0x27, 0x79, 0x20, 0xc4, 0x35, 0x53, 0xc9, 0x03,
-	0x91, 0x57, 0x38, 0x24, 0xe1, 0x6a, 0xaa, 0x7a, 0x24, 0x0b, 0x57, 0xc5, 0xf5, 0x34, 0x59, 0xb8,
-	0x9a, 0x53, 0x9a, 0x22, 0x1b, 0xa7, 0xab, 0x2d, 0x92, 0x8d, 0x73, 0x8a, 0x58, 0x92, 0x8d, 0x73,
-	0x4b, 0x39, 0x7f, 0xa4, 0xc0, 0x9c, 0xb0, 0x40, 0x82, 0xf2, 0x25, 0x46, 0x56, 0xd2, 0xa9, 0x5d,
-	0x39, 0x2a, 0x58, 0x4c, 0xde, 0x45, 0xe5, 0x05, 0x89, 0xbc, 0x4b, 0xea, 0x36, 0x12, 0x79, 0x97,
-	0x56, 0x62, 0x3e, 0x53, 0xa2, 0xef, 0x83, 0xf2, 0xf3, 0xd8, 0xe8, 0x56, 0x51, 0xbc, 0x51, 0x98,
-	0xef, 0xaf, 0xdd, 0x3e, 0x09, 0x8a, 0x44, 0x4a, 0x27, 0x9e, 0xc8, 0x96, 0xa7, 0x74, 0x04, 0x99,
-	0x72, 0x79, 0x4a, 0x47, 0x94, 0x23, 0xbf, 0x7d, 0xe7, 0xe7, 0x9f, 0x2f, 0x2b, 0xff, 0xf2, 0xf9,
-	0xb2, 0xf2, 0xef, 0x9f, 0x2f, 0x2b, 0xbf, 0x7d, 0x75, 0xcf, 0x0a, 0xf6, 0xbb, 0xbb, 0xf5, 0xa6,
-	0xdb, 0x5e, 0x4f, 0xfc, 0x43, 0xeb, 0xfa, 0x1e, 0x76, 0xd8, 0xff, 0x2e, 0x8f, 0xfd, 0xf3, 0xf4,
-	0xf7, 0xf8, 0x9f, 0x07, 0x97, 0x76, 0x87, 0xe9, 0xdc, 0xe5, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0x4e, 0x85, 0xd6, 0x41, 0x68, 0x5d, 0x00, 0x00,
+	// 5034 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x4b, 0x6c, 0x1c, 0x47,
+	0x76, 0x68, 0x52, 0xfc, 0x3d, 0xfe, 0x4b, 0xfc, 0x8c, 0x86, 0x12, 0x45, 0xb6, 0x2d, 0x9b, 0x96,
+	0xd7, 0x43, 0x8b, 0xb6, 0x65, 0x59, 0xb6, 0xd7, 0x2b, 0x91, 0x92, 0x3c, 0x8e, 0x24, 0x4b, 0x4d,
+	0x5a, 0x4a, 0x82, 0xc4, 0xbd, 0xcd, 0xee, 0x1a, 0xb2, 0xc3, 0x9e, 0xee, 0x51, 0x77, 0x0f, 0xa9,
+	0xf1, 0x21, 0x70, 0xb0, 0x41, 0x80, 0x5d, 0x04, 0xd9, 0xcd, 0x62, 0x13, 0x04, 0x08, 0x10, 0x20,
+	0xd8, 0x00, 0x8b, 0x35, 0x82, 0x5c, 0x92, 0x5b, 0x92, 0x53, 0x2e, 0x0b, 0xe4, 0x92, 0x6b, 0x4e,
+	0x09, 0x0c, 0xef, 0x21, 0x01, 0x72, 0xca, 0x9e, 0x83, 0xa0, 0x3e, 0xfd, 0xaf, 0xae, 0x1e, 0x92,
+	0x41, 0xe4, 0xf5, 0xfa, 0xc6, 0xa9, 0xaa, 0xf7, 0xea, 0xd5, 0xab, 0xf7, 0x5e, 0xbf, 0x5f, 0x37,
+	0xe1, 0x52, 0x77, 0x17, 0xfb, 0xeb, 0xa6, 0x61, 0x61, 0xd7, 0xc4, 0xeb, 0xfb, 0x76, 0x10, 0x7a,
+	0x7e, 0x6f, 0xfd, 0xf0, 0xca, 0x7a, 0x80, 0xfd, 0x43, 0xdb, 0xc4, 0x8d, 0x8e, 0xef, 0x85, 0x1e,
+	0x5a, 0x24, 0xcb, 0x1a, 0x7c, 0x59, 0x83, 0x2f, 0x6b, 0x1c, 0x5e, 0xa9, 0x2f, 0xef, 0x79, 0xde,
+	0x9e, 0x83, 0xd7, 0xe9, 0xb2, 0xdd, 0x6e, 0x6b, 0xdd, 0xea, 0xfa, 0x46, 0x68, 0x7b, 0x2e, 0x03,
+	0xac, 0x5f, 0xcc, 0xcf, 0x87, 0x76, 0x1b, 0x07, 0xa1, 0xd1, 0xee, 0xf0, 0x05, 0x05, 0x04, 0x47,
+	0xbe, 0xd1, 0xe9, 0x60, 0x3f, 0xe0, 0xf3, 0x2b, 0x19, 0x02, 0x8d, 0x8e, 0x4d, 0x88, 0x33, 0xbd,
+	0x76, 0x3b, 0xde, 0x62, 0x55, 0xb4, 0x22, 0x22, 0x91, 0x53, 0x21, 0x5a, 0xf2, 0xa4, 0x8b, 0xe3,
+	0x05, 0xaa, 0x68, 0x41, 0x68, 0x04, 0x07, 0x8e, 0x1d, 0x84, 0xb2, 0x35, 0x47, 0x9e, 0x7f, 0xd0,
+	0x72, 0xbc, 0x23, 0xbe, 0xe6, 0xb2, 0x68, 0x0d, 0x67, 0xa5, 0x9e, 0x5b, 0xbb, 0x56, 0xb5, 0x16,
+	0xfb, 0x7c, 0xe5, 0x73, 0xd9, 0x95, 0x56, 0xdb, 0x76, 0x29, 0x17, 0x9c, 0x6e, 0x10, 0x56, 0x2d,
+	0xca, 0x32, 0x62, 0x55, 0xbc, 0xe8, 0x49, 0x17, 0x77, 0xf9, 0x55, 0xd7, 0x5f, 0x14, 0x2f, 0xf1,
+	0x71, 0xc7, 0xb1, 0xcd, 0xf4, 0xd5, 0x3e, 0x9f, 0x59, 0x18, 0xec, 0x1b, 0x3e, 0xb6, 0x8a, 0x3b,
+	0x5e, 0x2a, 0x59, 0x95, 0x65, 0x86, 0xfa, 0xc5, 0x30, 0x5c, 0xd8, 0x0e, 0x0d, 0x3f, 0x7c, 0xcc,
+	0xc7, 0x6f, 0x3d, 0xc5, 0x66, 0x97, 0xec, 0xa6, 0xe1, 0x27, 0x5d, 0x1c, 0x84, 0xe8, 0x2e, 0x8c,
+	0xf8, 0xec, 0xcf, 0x9a, 0xb2, 0xa2, 0xac, 0x8d, 0x6f, 0x6c, 0x34, 0x32, 0x42, 0x69, 0x74, 0xec,
+	0xc6, 0xe1, 0x95, 0x86, 0x14, 0x89, 0x16, 0xa1, 0x40, 0x4b, 0x30, 0x66, 0x79, 0x6d, 0xc3, 0x76,
+	0x75, 0xdb, 0xaa, 0x0d, 0xac, 0x28, 0x6b, 0x63, 0xda, 0x28, 0x1b, 0x68, 0x5a, 0xe8, 0xb7, 0x60,
+	0xbe, 0x63, 0xf8, 0xd8, 0x0d, 0x75, 0x1c, 0x21, 0xd0, 0x6d, 0xb7, 0xe5, 0xd5, 0x06, 0xe9, 0xc6,
+	0x6b, 0xc2, 0x8d, 0x1f, 0x50, 0x88, 0x78, 0xc7, 0xa6, 0xdb, 0xf2, 0xb4, 0xb3, 0x9d, 0xe2, 0x20,
+	0xaa, 0xc1, 0x88, 0x11, 0x86, 0xb8, 0xdd, 0x09, 0x6b, 0x67, 0x56, 0x94, 0xb5, 0x21, 0x2d, 0xfa,
+	0x89, 0x36, 0x61, 0x1a, 0x3f, 0xed, 0xd8, 0x4c, 0x81, 0x74, 0xa2, 0x29, 0xb5, 0x21, 0xba, 0x63,
+	0xbd, 0xc1, 0xb4, 0xa4, 0x11, 0x69, 0x49, 0x63, 0x27, 0x52, 0x23, 0x6d, 0x2a, 0x01, 0x21, 0x83,
+	0xa8, 0x05, 0xe7, 0x4c, 0xcf, 0x0d, 0x6d, 0xb7, 0x8b, 0x75, 0x23, 0xd0, 0x5d, 0x7c, 0xa4, 0xdb,
+	0xae, 0x1d, 0xda, 0x46, 0xe8, 0xf9, 0xb5, 0xe1, 0x15, 0x65, 0x6d, 0x6a, 0xe3, 0x65, 0xe1, 0x01,
+	0x36, 0x39, 0xd4, 0x8d, 0xe0, 0x3e, 0x3e, 0x6a, 0x46, 0x20, 0xda, 0x82, 0x29, 0x1c, 0x47, 0x4d,
+	0x98, 0x8d, 0x66, 0x2c, 0xbd, 0x65, 0xd8, 0x4e, 0xd7, 0xc7, 0xb5, 0x11, 0x4a, 0xee, 0x79, 0x21,
+	0xfe, 0xdb, 0x6c, 0x8d, 0x36, 0x13, 0x83, 0xf1, 0x11, 0xa4, 0xc1, 0x82, 0x63, 0x04, 0xa1, 0x6e,
+	0x7a, 0xed, 0x8e, 0x83, 0xe9, 0xe1, 0x7d, 0x1c, 0x74, 0x9d, 0xb0, 0x36, 0x2a, 0xc1, 0xf7, 0xc0,
+	0xe8, 0x39, 0x9e, 0x61, 0x69, 0x73, 0x04, 0x76, 0x33, 0x06, 0xd5, 0x28, 0x24, 0xfa, 0x75, 0x58,
+	0x6a, 0xd9, 0x7e, 0x10, 0xea, 0x16, 0x36, 0xed, 0x80, 0xf2, 0xd3, 0x08, 0x0e, 0xf4, 0x5d, 0xc3,
+	0x3c, 0xf0, 0x5a, 0xad, 0xda, 0x18, 0x45, 0x7c, 0xae, 0xc0, 0xd7, 0x2d, 0x6e, 0xbe, 0xb4, 0x1a,
+	0x85, 0xde, 0xe2, 0xc0, 0x3b, 0x46, 0x70, 0x70, 0x93, 0x81, 0xa2, 0x43, 0x98, 0xe9, 0x18, 0x7e,
+	0x68, 0x53, 0x3a, 0x4d, 0xcf, 0x6d, 0xd9, 0x7b, 0x35, 0x58, 0x19, 0x5c, 0x1b, 0xdf, 0xf8, 0xb5,
+	0x46, 0x89, 0x99, 0x94, 0x4b, 0x25, 0x11, 0x1d, 0x86, 0x6e, 0x93, 0x62, 0xbb, 0xe5, 0x86, 0x7e,
+	0x4f, 0x9b, 0xee, 0x64, 0x47, 0xeb, 0x37, 0x61, 0x4e, 0xb4, 0x10, 0xcd, 0xc0, 0xe0, 0x01, 0xee,
+	0x51, 0xa5, 0x18, 0xd3, 0xc8, 0x9f, 0x68, 0x0e, 0x86, 0x0e, 0x0d, 0xa7, 0x8b, 0xb9, 0x60, 0xb3,
+	0x1f, 0xd7, 0x07, 0xae, 0x29, 0xea, 0x9b, 0xb0, 0x5c, 0x46, 0x4a, 0xd0, 0xf1, 0xdc, 0x00, 0xa3,
+	0x79, 0x18, 0xf6, 0xbb, 0x54, 0x2b, 0x18, 0xc2, 0x21, 0xbf, 0xeb, 0x36, 0x2d, 0xf5, 0xaf, 0x06,
+	0x60, 0x79, 0xdb, 0xde, 0x73, 0x0d, 0xa7, 0x54, 0x41, 0xef, 0xe5, 0x15, 0xf4, 0x35, 0xb1, 0x82,
+	0x4a, 0xb1, 0xf4, 0xa9, 0xa1, 0x2d, 0x58, 0xc2, 0x4f, 0x43, 0xec, 0xbb, 0x86, 0x13, 0x9b, 0xd5,
+	0x44, 0x59, 0xb9, 0x9e, 0xbe, 0x20, 0xdc, 0xbf, 0xb8, 0xf3, 0xb9, 0x08, 0x55, 0x61, 0x0a, 0x35,
+	0xe0, 0xac, 0xb9, 0x6f, 0x3b, 0x56, 0xb2, 0x89, 0xe7, 0x3a, 0x3d, 0xaa, 0xb7, 0xa3, 0xda, 0x2c,
+	0x9d, 0x8a, 0x80, 0x3e, 0x74, 0x9d, 0x9e, 0xba, 0x0a, 0x17, 0x4b, 0xcf, 0xc7, 0x18, 0xac, 0xfe,
+	0x7c, 0x00, 0x5e, 0xe4, 0x6b, 0xec, 0x70, 0x5f, 0x6e, 0xf3, 0x1e, 0xe5, 0x59, 0xfa, 0x8e, 0x8c,
+	0xa5, 0x55, 0xe8, 0xfa, 0xe4, 0xed, 0xa7, 0x8a, 0x40, 0xc0, 0x07, 0xa9, 0x80, 0x7f, 0x54, 0x2e,
+	0xe0, 0xfd, 0x91, 0xf0, 0xff, 0x28, 0xea, 0x37, 0x60, 0xad, 0x9a, 0x28, 0xb9, 0xd0, 0x7f, 0x4f,
+	0x81, 0x0b, 0x1a, 0x0e, 0xf0, 0xa9, 0x1f, 0x4a, 0x52, 0x24, 0xfd, 0x5d, 0x0b, 0x51, 0xdd, 0x32,
+	0x34, 0xf2, 0x53, 0x7c, 0x36, 0x00, 0xab, 0x3b, 0xd8, 0x6f, 0xdb, 0xae, 0x11, 0xe2, 0xd2, 0x93,
+	0x3c, 0xc8, 0x9f, 0xe4, 0xaa, 0xf0, 0x24, 0x95, 0x88, 0x7e, 0xc9, 0x15, 0xf8, 0x79, 0x50, 0x65,
+	0x47, 0xe4, 0x3a, 0xfc, 0x03, 0x05, 0x56, 0xb6, 0x70, 0x60, 0xfa, 0xf6, 0x6e, 0x39, 0x47, 0x3f,
+	0xcc, 0x73, 0xf4, 0x0d, 0xe1, 0x71, 0xaa, 0xf0, 0xf4, 0x29, 0x1e, 0xff, 0x33, 0x08, 0xab, 0x12,
+	0x54, 0x5c, 0x44, 0x1c, 0x58, 0x4c, 0x5c, 0x1a, 0xa6, 0xda, 0xfc, 0x81, 0x27, 0xb5, 0xd9, 0x05,
+	0x84, 0x9b, 0x69, 0x50, 0x6d, 0x01, 0x0b, 0xc7, 0xd1, 0x2e, 0x2c, 0x16, 0xef, 0x96, 0x79, 0x52,
+	0x03, 0x74, 0xb7, 0xcb, 0xfd, 0xed, 0x46, 0x7d, 0xa9, 0xf9, 0x23, 0xd1, 0x30, 0x7a, 0x0c, 0xa8,
+	0x83, 0x5d, 0xcb, 0x76, 0xf7, 0x74, 0xc3, 0x0c, 0xed, 0x43, 0x3b, 0xb4, 0x71, 0xc0, 0xcd, 0x55,
+	0x89, 0xa3, 0xc6, 0x96, 0xdf, 0x60, 0xab, 0x7b, 0x14, 0xf9, 0x6c, 0x27, 0x33, 0x68, 0xe3, 0x00,
+	0xfd, 0x06, 0xcc, 0x44, 0x88, 0xa9, 0x98, 0xf8, 0xd8, 0xad, 0x9d, 0xa1, 0x68, 0x1b, 0x32, 0xb4,
+	0x9b, 0x64, 0x6d, 0x96, 0xf2, 0xe9, 0x4e, 0x6a, 0xca, 0xc7, 0x2e, 0xda, 0x4e, 0x50, 0x47, 0xde,
+	0x09, 0x77, 0xf4, 0xa4, 0x14, 0x47, 0xce, 0x48, 0x06, 0x69, 0x34, 0xa8, 0x3e, 0x85, 0xb9, 0x87,
+	0x24, 0xa2, 0x89, 0xb8, 0x17, 0x89, 0xe1, 0x66, 0x5e, 0x0c, 0x5f, 0x12, 0xee, 0x21, 0x82, 0xed,
+	0x53, 0xf4, 0x7e, 0xac, 0xc0, 0x7c, 0x0e, 0x9c, 0x8b, 0xdb, 0x7b, 0x30, 0x41, 0xa3, 0xac, 0xc8,
+	0x9d, 0x53, 0xfa, 0x70, 0xe7, 0xc6, 0x29, 0x04, 0xf7, 0xe2, 0x9a, 0x30, 0x15, 0x21, 0xf8, 0x1d,
+	0x6c, 0x86, 0xd8, 0xe2, 0x82, 0xa3, 0x96, 0x9f, 0x41, 0xe3, 0x2b, 0xb5, 0xc9, 0x27, 0xe9, 0x9f,
+	0xea, 0xef, 0x2b, 0x50, 0xa7, 0x06, 0x74, 0x3b, 0xb4, 0xcd, 0x83, 0x1e, 0xf1, 0xe8, 0xee, 0xda,
+	0x41, 0x18, 0xb1, 0xa9, 0x99, 0x67, 0xd3, 0x7a, 0xb9, 0x25, 0x17, 0x62, 0xe8, 0x93, 0x59, 0x17,
+	0x60, 0x49, 0x88, 0x83, 0x5b, 0x96, 0xff, 0x56, 0x60, 0xe1, 0x0e, 0x0e, 0xef, 0x75, 0x43, 0x63,
+	0xd7, 0xc1, 0xdb, 0xa1, 0x11, 0x62, 0x4d, 0x84, 0x56, 0xc9, 0xd9, 0xd3, 0x8f, 0x00, 0x09, 0xcc,
+	0xe8, 0xc0, 0xb1, 0xcc, 0xe8, 0x6c, 0x41, 0xc3, 0xd0, 0x6b, 0xb0, 0x80, 0x9f, 0x76, 0x28, 0x03,
+	0x75, 0x17, 0x3f, 0x0d, 0x75, 0x7c, 0x48, 0xc2, 0x22, 0xdb, 0xa2, 0x16, 0x7a, 0x50, 0x3b, 0x1b,
+	0xcd, 0xde, 0xc7, 0x4f, 0xc3, 0x5b, 0x64, 0xae, 0x69, 0xa1, 0x57, 0x61, 0xce, 0xec, 0xfa, 0x34,
+	0x7e, 0xda, 0xf5, 0x0d, 0xd7, 0xdc, 0xd7, 0x43, 0xef, 0x80, 0x6a, 0x8f, 0xb2, 0x36, 0xa1, 0x21,
+	0x3e, 0x77, 0x93, 0x4e, 0xed, 0x90, 0x19, 0xf5, 0x47, 0x63, 0xb0, 0x58, 0x38, 0x35, 0x97, 0x21,
+	0xf1, 0xc9, 0x94, 0xd3, 0x9e, 0xec, 0x36, 0x4c, 0xc6, 0x68, 0xc3, 0x5e, 0x07, 0x73, 0x5e, 0xad,
+	0x4a, 0x31, 0xee, 0xf4, 0x3a, 0x58, 0x9b, 0x38, 0x4a, 0xfd, 0x42, 0x2a, 0x4c, 0x8a, 0x18, 0x33,
+	0xee, 0xa6, 0x18, 0xf2, 0x08, 0xce, 0x75, 0x7c, 0x7c, 0x68, 0x7b, 0xdd, 0x40, 0x0f, 0x88, 0x27,
+	0x82, 0xad, 0x64, 0xfd, 0x19, 0xba, 0xef, 0x52, 0x21, 0x12, 0x69, 0xba, 0xe1, 0xd5, 0xd7, 0x1f,
+	0x11, 0x77, 0x46, 0x5b, 0x88, 0xa0, 0xb7, 0x19, 0x70, 0x84, 0xf7, 0x15, 0x38, 0x4b, 0xe3, 0x26,
+	0x16, 0xe8, 0xc4, 0x18, 0x87, 0x28, 0x05, 0x33, 0x64, 0xea, 0x36, 0x99, 0x89, 0x96, 0x5f, 0x87,
+	0x31, 0x1a, 0x03, 0x39, 0x76, 0x10, 0xd2, 0x48, 0x70, 0x7c, 0xe3, 0x82, 0xf8, 0x21, 0x1f, 0x49,
+	0xe5, 0x68, 0xc8, 0xff, 0x42, 0x77, 0x60, 0x26, 0xa0, 0x12, 0xab, 0x27, 0x28, 0x46, 0xfa, 0x41,
+	0x31, 0x15, 0x64, 0x04, 0x1d, 0xbd, 0x0e, 0x0b, 0xa6, 0x63, 0x13, 0x4a, 0x1d, 0x7b, 0xd7, 0x37,
+	0xfc, 0x9e, 0x7e, 0x88, 0x7d, 0x6a, 0x01, 0x47, 0xa9, 0x48, 0xcf, 0xb1, 0xd9, 0xbb, 0x6c, 0xf2,
+	0x11, 0x9b, 0x4b, 0x41, 0xb5, 0xb0, 0x11, 0x76, 0x7d, 0x1c, 0x43, 0x8d, 0xa5, 0xa1, 0x6e, 0xb3,
+	0xc9, 0x08, 0xea, 0x22, 0x8c, 0x73, 0x28, 0xbb, 0xdd, 0x71, 0x6a, 0x40, 0x97, 0x02, 0x1b, 0x6a,
+	0xb6, 0x3b, 0x0e, 0x0a, 0xe0, 0x72, 0xfe, 0x54, 0x7a, 0x60, 0xee, 0x63, 0xab, 0xeb, 0x60, 0x3d,
+	0xf4, 0xd8, 0x65, 0xd1, 0x40, 0xdc, 0xeb, 0x86, 0xb5, 0xf1, 0xaa, 0x98, 0xf1, 0xf9, 0xec, 0x59,
+	0xb7, 0x39, 0xa6, 0x1d, 0x8f, 0xde, 0xdb, 0x0e, 0x43, 0x43, 0x5c, 0x12, 0x76, 0x55, 0xc4, 0x79,
+	0x4e, 0x0e, 0x32, 0x41, 0x73, 0x01, 0xb3, 0x74, 0x6a, 0x9b, 0xcc, 0x44, 0xa7, 0x28, 0x53, 0xa7,
+	0xc9, 0x32, 0x75, 0x42, 0x77, 0x61, 0x2a, 0x96, 0xed, 0x80, 0x28, 0x53, 0x6d, 0x8a, 0xc6, 0xfd,
+	0x97, 0xb2, 0x57, 0xc5, 0x92, 0x31, 0x69, 0xf9, 0x66, 0x9a, 0x17, 0x2b, 0x06, 0xfd, 0x89, 0x4c,
+	0x98, 0x8b, 0xb1, 0x99, 0x8e, 0x17, 0x60, 0x8e, 0x73, 0x9a, 0xe2, 0xbc, 0xd2, 0xa7, 0xc3, 0x40,
+	0x00, 0x09, 0xbe, 0x6e, 0xa0, 0xc5, 0xfa, 0x1c, 0x0f, 0x12, 0x2d, 0x9f, 0xe5, 0x8c, 0xd0, 0x59,
+	0x54, 0x41, 0x9e, 0xe2, 0x33, 0xa2, 0x67, 0x62, 0x42, 0x35, 0x67, 0xd0, 0xfb, 0xd1, 0x7a, 0x6d,
+	0xe6, 0x30, 0x37, 0x82, 0xde, 0x81, 0x25, 0x9b, 0xe8, 0x5c, 0xee, 0x8e, 0xb1, 0x4b, 0xec, 0x8c,
+	0x55, 0x9b, 0xa5, 0x6e, 0xe0, 0xa2, 0x1d, 0x64, 0xad, 0xf1, 0x2d, 0x36, 0xad, 0xfe, 0x42, 0x81,
+	0xc5, 0x07, 0x9e, 0xe3, 0xfc, 0x8a, 0x59, 0xe3, 0x9f, 0x8c, 0x42, 0xad, 0x78, 0xec, 0xaf, 0xcd,
+	0xf1, 0xd7, 0xe6, 0xf8, 0xab, 0x68, 0x8e, 0xcb, 0xf4, 0x63, 0xa2, 0xd4, 0xbc, 0x0a, 0x6d, 0xd5,
+	0xe4, 0xa9, 0x6d, 0xd5, 0x2f, 0x9f, 0xd5, 0x56, 0xff, 0x69, 0x00, 0x56, 0x34, 0x6c, 0x7a, 0xbe,
+	0x95, 0x4e, 0x94, 0x72, 0xb5, 0x78, 0x96, 0x96, 0xf2, 0x22, 0x8c, 0xc7, 0x82, 0x13, 0x1b, 0x01,
+	0x88, 0x86, 0x9a, 0x16, 0x5a, 0x84, 0x11, 0x2a, 0x63, 0x5c, 0xe3, 0x07, 0xb5, 0x61, 0xf2, 0xb3,
+	0x69, 0xa1, 0x0b, 0x00, 0xdc, 0x8f, 0x8f, 0x74, 0x77, 0x4c, 0x1b, 0xe3, 0x23, 0x4d, 0x0b, 0x69,
+	0x30, 0xd1, 0xf1, 0x1c, 0x47, 0x8f, 0x62, 0x85, 0x61, 0x49, 0xac, 0x40, 0x6c, 0xe8, 0x6d, 0xcf,
+	0x4f, 0xb3, 0x26, 0x8a, 0x15, 0xc6, 0x09, 0x12, 0xfe, 0x43, 0xfd, 0xb7, 0x11, 0x58, 0x95, 0x70,
+	0x91, 0x1b, 0xde, 0x82, 0x85, 0x54, 0x4e, 0x66, 0x21, 0xa5, 0xd6, 0x6f, 0xe0, 0xe4, 0xd6, 0xef,
+	0x1b, 0x80, 0x22, 0xfe, 0x5a, 0x79, 0xf3, 0x3b, 0x13, 0xcf, 0x44, 0xab, 0xd7, 0x88, 0x01, 0x13,
+	0x98, 0xde, 0x41, 0x62, 0xa1, 0x32, 0x78, 0x0b, 0x16, 0x7d, 0xa8, 0x68, 0xd1, 0x53, 0x25, 0x95,
+	0xe1, 0x6c, 0x49, 0xe5, 0x1a, 0xd4, 0xb8, 0x49, 0x49, 0x12, 0x10, 0xd1, 0xd3, 0x7f, 0x84, 0x3e,
+	0xfd, 0x17, 0xd8, 0x7c, 0x2c, 0x3b, 0xfc, 0xe1, 0x8f, 0x34, 0x98, 0x8c, 0x4b, 0x07, 0x34, 0x65,
+	0xc1, 0x6a, 0x11, 0xaf, 0x94, 0x69, 0xe3, 0x8e, 0x6f, 0xb8, 0x01, 0x31, 0x65, 0x99, 0x30, 0x7d,
+	0xc2, 0x4a, 0xfd, 0x42, 0x1f, 0xc3, 0x79, 0x41, 0x42, 0x24, 0x31, 0xe1, 0x63, 0xfd, 0x98, 0xf0,
+	0x73, 0x05, 0x71, 0x8f, 0xad, 0x79, 0x89, 0x6b, 0x09, 0x65, 0xae, 0xe5, 0x2a, 0x4c, 0x64, 0x6c,
+	0xde, 0x38, 0xb5, 0x79, 0xe3, 0xbb, 0x29, 0x63, 0x77, 0x03, 0xa6, 0x92, 0x6b, 0xa5, 0x25, 0xa9,
+	0x89, 0xca, 0x92, 0xd4, 0x64, 0x0c, 0x41, 0x2b, 0x52, 0xef, 0xc2, 0x44, 0x74, 0xd7, 0x14, 0xc1,
+	0x64, 0x25, 0x82, 0x71, 0xbe, 0x9e, 0x82, 0x1b, 0x30, 0x42, 0x22, 0x79, 0x62, 0x64, 0xa7, 0x68,
+	0xfe, 0xe5, 0x4e, 0x69, 0x16, 0xba, 0x52, 0x8b, 0x68, 0x8a, 0xc0, 0xc6, 0x01, 0xcb, 0x3b, 0x47,
+	0x78, 0xeb, 0x1f, 0xc3, 0x44, 0x7a, 0x42, 0x90, 0x67, 0xbe, 0x96, 0xce, 0x33, 0x97, 0xe5, 0x1f,
+	0x22, 0xad, 0x63, 0x79, 0x88, 0x54, 0x2e, 0x3a, 0xb1, 0x93, 0x51, 0xd6, 0xe9, 0x6b, 0x3b, 0x59,
+	0xb0, 0x93, 0x69, 0xd6, 0x08, 0xed, 0xe4, 0x17, 0x83, 0x91, 0x9d, 0x14, 0x72, 0x91, 0xdb, 0xc9,
+	0x0f, 0x60, 0x3a, 0x67, 0x87, 0xa4, 0x96, 0x92, 0x3d, 0x7f, 0x7b, 0xd4, 0x92, 0x68, 0x53, 0x59,
+	0x3b, 0x55, 0x90, 0xdc, 0x81, 0xe3, 0x49, 0x6e, 0xca, 0x2c, 0x0d, 0x66, 0xcd, 0xd2, 0xc7, 0xb0,
+	0x9c, 0xd5, 0x2a, 0xdd, 0x6b, 0xe9, 0xe1, 0xbe, 0x1d, 0xe8, 0xe9, 0xd2, 0xb0, 0x7c, 0xab, 0x7a,
+	0x46, 0xcb, 0x3e, 0x6c, 0xed, 0xec, 0xdb, 0xc1, 0x0d, 0x8e, 0xbf, 0x09, 0xb3, 0xfb, 0xd8, 0xf0,
+	0xc3, 0x5d, 0x6c, 0x84, 0xba, 0x85, 0x43, 0xc3, 0x76, 0x02, 0x9e, 0x62, 0x94, 0x67, 0xdf, 0x66,
+	0x62, 0xb0, 0x2d, 0x06, 0x55, 0x7c, 0xee, 0x0c, 0x9f, 0xec, 0xb9, 0xf3, 0x22, 0x4c, 0xc7, 0x78,
+	0x98, 0x58, 0x53, 0x03, 0x3c, 0xa6, 0xc5, 0x5e, 0xcf, 0x16, 0x1d, 0x55, 0xff, 0x54, 0x81, 0xe7,
+	0xd8, 0x6d, 0x66, 0x34, 0x99, 0x57, 0x78, 0x13, 0x7d, 0xd1, 0xf2, 0x19, 0xbb, 0x6b, 0x65, 0x19,
+	0xbb, 0x2a, 0x54, 0x7d, 0xa6, 0xee, 0xfe, 0x76, 0x10, 0x9e, 0x97, 0x63, 0xe3, 0x22, 0x88, 0x93,
+	0x87, 0x9b, 0xcf, 0xc7, 0x38, 0x89, 0xd7, 0x4f, 0x6e, 0xba, 0xb4, 0xe9, 0x20, 0x27, 0xe9, 0x3f,
+	0x56, 0x60, 0x39, 0xc9, 0x79, 0x13, 0x07, 0xd9, 0xb2, 0x83, 0x8e, 0x11, 0x9a, 0xfb, 0xba, 0xe3,
+	0x99, 0x86, 0xe3, 0xf4, 0x6a, 0x03, 0xd4, 0x60, 0x7e, 0x2c, 0xd9, 0xb5, 0xfa, 0x38, 0x8d, 0x24,
+	0x29, 0xbe, 0xe3, 0x6d, 0xf1, 0x1d, 0xee, 0xb2, 0x0d, 0x98, 0x1d, 0x5d, 0x32, 0xca, 0x57, 0xd4,
+	0x7f, 0x17, 0x56, 0xaa, 0x10, 0x08, 0xec, 0xed, 0x56, 0xd6, 0xde, 0x8a, 0x53, 0xee, 0x91, 0x19,
+	0xa0, 0xb8, 0x22, 0xc4, 0xf4, 0xb1, 0x9b, 0xb2, 0xbd, 0x3f, 0x50, 0x88, 0xed, 0x2d, 0x1c, 0xf3,
+	0xb6, 0x61, 0x3b, 0x89, 0x2c, 0xf5, 0x59, 0xab, 0xa9, 0xc2, 0xd3, 0xa7, 0x20, 0x3d, 0x47, 0xec,
+	0x58, 0x29, 0x26, 0x9e, 0x09, 0xfe, 0x91, 0x02, 0x6a, 0xd1, 0xda, 0xbd, 0x1f, 0xa9, 0x67, 0x44,
+	0xf9, 0xc3, 0x3c, 0xe5, 0x6f, 0x96, 0x50, 0x5e, 0x85, 0xa9, 0x4f, 0xda, 0x1f, 0x10, 0xe5, 0x94,
+	0xe0, 0xe2, 0xb2, 0xf9, 0x12, 0xcc, 0x98, 0x86, 0x6b, 0xe2, 0xf8, 0x09, 0x80, 0xd9, 0x33, 0x6d,
+	0x54, 0x9b, 0x66, 0xe3, 0x5a, 0x34, 0x9c, 0xd6, 0xf7, 0x34, 0xce, 0x53, 0xea, 0xbb, 0x0c, 0x55,
+	0x9f, 0x47, 0x7d, 0x21, 0x56, 0xf7, 0x12, 0x64, 0xa9, 0x6a, 0xa0, 0x60, 0xe1, 0x69, 0x24, 0xac,
+	0x14, 0xcf, 0xb1, 0x25, 0x4c, 0x84, 0x29, 0x23, 0x61, 0xc5, 0x03, 0xd2, 0xfb, 0x49, 0x28, 0xef,
+	0x5b, 0xc2, 0xaa, 0x30, 0xf5, 0x49, 0xfb, 0x25, 0xb1, 0x38, 0xc4, 0xb8, 0x38, 0xf5, 0x7f, 0xa7,
+	0xc0, 0x45, 0x0d, 0xb7, 0xbd, 0x43, 0xcc, 0xca, 0xfc, 0x5f, 0x96, 0x24, 0x5d, 0xd6, 0x31, 0x1a,
+	0xcc, 0x39, 0x46, 0xaa, 0x4a, 0x64, 0xa5, 0x8c, 0x6a, 0x7e, 0xb4, 0xbf, 0x1f, 0x80, 0x4b, 0xfc,
+	0x08, 0xec, 0xd8, 0xa5, 0x35, 0x66, 0xe9, 0x01, 0x0d, 0x98, 0xca, 0xea, 0x20, 0x3f, 0xdc, 0xf5,
+	0x92, 0xfb, 0xeb, 0x63, 0x43, 0x6d, 0x32, 0xa3, 0xbd, 0x68, 0x17, 0x16, 0xe3, 0x32, 0xbe, 0xb0,
+	0x57, 0x4e, 0x5c, 0xe1, 0xbd, 0xc5, 0x61, 0x72, 0x15, 0x5e, 0x2c, 0x1a, 0x3e, 0x76, 0x09, 0x7f,
+	0x0d, 0x5e, 0xa8, 0x3a, 0x0b, 0xe7, 0xf3, 0x3f, 0x2a, 0xb0, 0x14, 0x65, 0x85, 0x04, 0x51, 0xfa,
+	0x33, 0x11, 0x9f, 0xcb, 0x30, 0x6b, 0x07, 0x7a, 0xb6, 0x75, 0x8d, 0xf2, 0x72, 0x54, 0x9b, 0xb6,
+	0x83, 0xdb, 0xe9, 0xa6, 0x34, 0x75, 0x19, 0xce, 0x8b, 0xc9, 0xe7, 0xe7, 0xfb, 0x62, 0x80, 0x58,
+	0x30, 0x62, 0xac, 0xb3, 0x55, 0xe9, 0x82, 0x69, 0x7d, 0x16, 0x07, 0x5d, 0x85, 0x09, 0xde, 0x97,
+	0x88, 0xad, 0x54, 0xa2, 0x36, 0x1e, 0x6b, 0x5a, 0xe8, 0x31, 0x9c, 0x35, 0x23, 0x52, 0x53, 0x5b,
+	0x9f, 0x39, 0xd6, 0xd6, 0x28, 0x46, 0x91, 0xec, 0x7d, 0x17, 0x66, 0x52, 0xbd, 0x86, 0x2c, 0x48,
+	0x18, 0xea, 0x37, 0x48, 0x98, 0x4e, 0x40, 0xe9, 0x80, 0xfa, 0x22, 0xd1, 0x56, 0x29, 0x97, 0xf9,
+	0x7d, 0xfc, 0xc7, 0x00, 0xd4, 0x34, 0xde, 0x47, 0x8b, 0x29, 0x6c, 0xf0, 0x68, 0xe3, 0x59, 0xde,
+	0xc1, 0x6f, 0xc3, 0x7c, 0x36, 0x93, 0xd9, 0xd3, 0xed, 0x10, 0xb7, 0xa3, 0xfe, 0x89, 0x7c, 0xa7,
+	0x80, 0xd5, 0xb6, 0xdd, 0x42, 0x32, 0xb3, 0xd7, 0x0c, 0x71, 0x5b, 0x3b, 0x7b, 0x58, 0x18, 0x0b,
+	0xd0, 0x1b, 0x30, 0x4c, 0x79, 0x1b, 0xf0, 0x2b, 0x13, 0x27, 0x36, 0xb6, 0x8c, 0xd0, 0xb8, 0xe9,
+	0x78, 0xbb, 0x1a, 0x5f, 0x8c, 0x36, 0x61, 0xca, 0xc5, 0x47, 0xba, 0xdf, 0xe5, 0x57, 0x13, 0x45,
+	0x2e, 0x15, 0xe0, 0x13, 0x2e, 0x3e, 0xd2, 0xba, 0xec, 0x4e, 0x02, 0x75, 0x09, 0xce, 0x09, 0x58,
+	0xcd, 0x2f, 0xe2, 0x7b, 0x0a, 0x2c, 0x6c, 0xf7, 0x5c, 0x73, 0x7b, 0xdf, 0xf0, 0x2d, 0x9e, 0xdf,
+	0xe4, 0xd7, 0x70, 0x09, 0xa6, 0x02, 0xaf, 0xeb, 0x9b, 0x58, 0xe7, 0xed, 0xd5, 0xfc, 0x2e, 0x26,
+	0xd9, 0xe8, 0x26, 0x1b, 0x44, 0xe7, 0x60, 0x34, 0x20, 0xc0, 0xd1, 0x03, 0x6c, 0x48, 0x1b, 0xa1,
+	0xbf, 0x9b, 0x16, 0x6a, 0xc0, 0x19, 0x1a, 0x2c, 0x0e, 0x56, 0x46, 0x70, 0x74, 0x9d, 0x7a, 0x0e,
+	0x16, 0x0b, 0xb4, 0x70, 0x3a, 0x7f, 0x36, 0x04, 0x67, 0xc9, 0x5c, 0xf4, 0x20, 0x7c, 0x96, 0xb2,
+	0x52, 0x83, 0x91, 0x28, 0x9f, 0xc4, 0x54, 0x35, 0xfa, 0x49, 0x34, 0x39, 0x09, 0x66, 0xe3, 0x44,
+	0x41, 0x9c, 0x58, 0x20, 0x3c, 0x29, 0x66, 0x91, 0x86, 0x8e, 0x9b, 0x45, 0xba, 0x00, 0x10, 0x05,
+	0x55, 0xb6, 0x45, 0x83, 0xd0, 0x41, 0x6d, 0x8c, 0x8f, 0x34, 0xad, 0x42, 0xa8, 0x3e, 0x72, 0xbc,
+	0x50, 0xfd, 0x03, 0x5e, 0xbb, 0x49, 0xa2, 0x66, 0x8a, 0x65, 0xb4, 0x12, 0xcb, 0x2c, 0x01, 0x8b,
+	0xfd, 0x5f, 0x8a, 0xeb, 0x2a, 0x8c, 0x44, 0x21, 0xf7, 0x58, 0x1f, 0x21, 0x77, 0xb4, 0x38, 0x9d,
+	0x2e, 0x80, 0x6c, 0xba, 0xe0, 0x3d, 0x98, 0x60, 0x95, 0x25, 0xde, 0x66, 0x3d, 0xde, 0x47, 0x9b,
+	0xf5, 0x38, 0x2d, 0x38, 0xf1, 0x0e, 0xeb, 0x57, 0x81, 0x76, 0x49, 0xf3, 0xd7, 0x0a, 0x74, 0xdb,
+	0xc2, 0x6e, 0x68, 0x87, 0x3d, 0x9a, 0xcb, 0x1b, 0xd3, 0x10, 0x99, 0x7b, 0x4c, 0xa7, 0x9a, 0x7c,
+	0x06, 0xdd, 0x87, 0xe9, 0x9c, 0x69, 0xe0, 0x79, 0xbb, 0x4b, 0x7d, 0x19, 0x05, 0x6d, 0x2a, 0x6b,
+	0x10, 0xd4, 0x05, 0x98, 0xcb, 0x4a, 0x32, 0x17, 0xf1, 0x3f, 0x56, 0x60, 0x29, 0xea, 0x5b, 0xfb,
+	0x92, 0xb8, 0x70, 0xea, 0x1f, 0x29, 0x70, 0x5e, 0x4c, 0x13, 0x8f, 0x6e, 0x5e, 0x83, 0x85, 0x36,
+	0x1b, 0x67, 0x55, 0x15, 0xdd, 0x76, 0x75, 0xd3, 0x30, 0xf7, 0x31, 0xa7, 0xf0, 0x6c, 0x3b, 0x05,
+	0xd5, 0x74, 0x37, 0xc9, 0x14, 0x7a, 0x0b, 0xce, 0x15, 0x80, 0x2c, 0x23, 0x34, 0x76, 0x8d, 0x20,
+	0x6a, 0x5f, 0x5d, 0xc8, 0xc2, 0x6d, 0xf1, 0x59, 0xf5, 0x3c, 0xd4, 0x23, 0x7a, 0x38, 0x3f, 0xdf,
+	0xf7, 0xe2, 0xc6, 0x23, 0xf5, 0xf7, 0x06, 0x12, 0x16, 0x66, 0xa6, 0x39, 0xb5, 0x6b, 0x30, 0xe3,
+	0x76, 0xdb, 0xbb, 0xd8, 0xd7, 0xbd, 0x96, 0x4e, 0xad, 0x54, 0x40, 0xe9, 0x1c, 0xd2, 0xa6, 0xd8,
+	0xf8, 0x87, 0x2d, 0x6a, 0x7c, 0x02, 0xc2, 0xec, 0xc8, 0xaa, 0x05, 0x34, 0x77, 0x30, 0xa4, 0x8d,
+	0x72, 0xb3, 0x16, 0xa0, 0x26, 0x4c, 0xf0, 0x9b, 0x60, 0x47, 0x15, 0xf7, 0x68, 0x46, 0xe2, 0xc0,
+	0x92, 0x39, 0xf4, 0xe4, 0xd4, 0xb9, 0x1b, 0xb7, 0x92, 0x01, 0x74, 0x15, 0x16, 0xd9, 0x3e, 0xa6,
+	0xe7, 0x86, 0xbe, 0xe7, 0x38, 0xd8, 0xa7, 0x3c, 0xe9, 0xb2, 0x27, 0xc5, 0x98, 0x36, 0x4f, 0xa7,
+	0x37, 0xe3, 0x59, 0x66, 0x17, 0xa9, 0x86, 0x58, 0x96, 0x8f, 0x83, 0x80, 0x67, 0x1c, 0xa3, 0x9f,
+	0x6a, 0x03, 0x66, 0x59, 0x5d, 0x8a, 0xc0, 0x45, 0xb2, 0x93, 0x36, 0xd2, 0x4a, 0xc6, 0x48, 0xab,
+	0x73, 0x80, 0xd2, 0xeb, 0xb9, 0x30, 0xfe, 0x97, 0x02, 0xb3, 0xcc, 0x3b, 0x4f, 0xbb, 0x81, 0xe5,
+	0x68, 0xd0, 0x3b, 0xbc, 0x86, 0x1b, 0x97, 0xac, 0xa7, 0x36, 0x2e, 0x96, 0x30, 0x84, 0x60, 0xa4,
+	0x69, 0x31, 0x5a, 0xc5, 0xa5, 0x29, 0xb1, 0x54, 0x72, 0x75, 0x30, 0x93, 0x5c, 0xdd, 0x84, 0xe9,
+	0x43, 0x3b, 0xb0, 0x77, 0x6d, 0xc7, 0x0e, 0x7b, 0xcc, 0x12, 0x55, 0xe7, 0x03, 0xa7, 0x12, 0x10,
+	0x6a, 0x86, 0x56, 0x61, 0x82, 0x3f, 0xc2, 0x74, 0xd7, 0xe0, 0x16, 0x77, 0x4c, 0x1b, 0xe7, 0x63,
+	0xf7, 0x8d, 0x36, 0x26, 0x5c, 0x48, 0x1f, 0x97, 0x73, 0xe1, 0xfb, 0x94, 0x0b, 0x01, 0x0e, 0x1f,
+	0x76, 0x71, 0x17, 0xf7, 0xc1, 0x85, 0xfc, 0x4e, 0x03, 0x85, 0x9d, 0xb2, 0x8c, 0x1a, 0x3c, 0x26,
+	0xa3, 0x18, 0x9d, 0x09, 0x41, 0x9c, 0xce, 0x1f, 0x2a, 0x30, 0x17, 0xc9, 0xfd, 0x97, 0x86, 0xd4,
+	0x0f, 0x61, 0x3e, 0x47, 0x13, 0xd7, 0xc2, 0xab, 0xb0, 0xd8, 0xf1, 0x3d, 0x13, 0x07, 0x81, 0xed,
+	0xee, 0xe9, 0xf4, 0x8d, 0x2b, 0x66, 0x07, 0x88, 0x32, 0x0e, 0x12, 0x99, 0x4f, 0xa6, 0x29, 0x24,
+	0x35, 0x02, 0x81, 0xfa, 0x1d, 0x05, 0x2e, 0xdc, 0xc1, 0xa1, 0x96, 0xbc, 0x7f, 0x75, 0x0f, 0x07,
+	0x81, 0xb1, 0x87, 0x63, 0x97, 0xe5, 0x3d, 0x18, 0xa6, 0xe5, 0x1b, 0x86, 0x68, 0x7c, 0xe3, 0xc5,
+	0x12, 0x6a, 0x53, 0x28, 0x68, 0x6d, 0x47, 0xe3, 0x60, 0x7d, 0x30, 0x85, 0xd8, 0x98, 0xe5, 0x32,
+	0x2a, 0xf8, 0x01, 0x9f, 0xc0, 0x14, 0xe3, 0x7a, 0x9b, 0xcf, 0x70, 0x72, 0x3e, 0x28, 0xcd, 0x3e,
+	0xca, 0x11, 0x36, 0xa8, 0x6e, 0x46, 0xa3, 0x2c, 0xd3, 0x38, 0x19, 0xa4, 0xc7, 0xea, 0x0e, 0xa0,
+	0xe2, 0xa2, 0x74, 0x36, 0x71, 0x88, 0x65, 0x13, 0xbf, 0x95, 0xcd, 0x26, 0x5e, 0xae, 0x66, 0x50,
+	0x4c, 0x4c, 0x2a, 0x93, 0xd8, 0x86, 0x95, 0x3b, 0x38, 0xdc, 0xba, 0xfb, 0x50, 0x72, 0x17, 0x4d,
+	0x00, 0xa6, 0xd2, 0x6e, 0xcb, 0x8b, 0x18, 0xd0, 0xc7, 0x76, 0x44, 0x90, 0xa8, 0x99, 0xa4, 0xa2,
+	0x47, 0xfe, 0x0a, 0xd4, 0xa7, 0xb0, 0x2a, 0xd9, 0x8e, 0x33, 0x7d, 0x1b, 0x66, 0x53, 0x6f, 0xe6,
+	0xd1, 0x52, 0x62, 0xb4, 0xed, 0x0b, 0xfd, 0x6d, 0xab, 0xcd, 0xf8, 0xd9, 0x81, 0x40, 0xfd, 0x57,
+	0x05, 0xe6, 0x34, 0x6c, 0x74, 0x3a, 0x0e, 0x0b, 0x79, 0xe2, 0xd3, 0x2d, 0xc0, 0x30, 0x4f, 0xdd,
+	0xb3, 0xe7, 0x1c, 0xff, 0x25, 0x6f, 0xf5, 0x17, 0x3f, 0xa4, 0x07, 0x4f, 0xeb, 0x8f, 0x9e, 0x2c,
+	0xb8, 0x50, 0x17, 0x61, 0x3e, 0x77, 0x34, 0x6e, 0x4d, 0x7e, 0xaa, 0xc0, 0x92, 0x86, 0x5b, 0x3e,
+	0x0e, 0xf6, 0xe3, 0x2a, 0x06, 0xe1, 0xc6, 0x97, 0xf0, 0xec, 0x24, 0xf0, 0x17, 0x93, 0x9a, 0x64,
+	0xf6, 0x96, 0x35, 0x4c, 0x8b, 0xc0, 0x37, 0x7c, 0x73, 0xdf, 0x3e, 0xc4, 0x56, 0xbe, 0x2d, 0xfc,
+	0x59, 0xf8, 0x55, 0xab, 0x70, 0xb1, 0x94, 0x2a, 0x4e, 0xf9, 0x5b, 0xb0, 0xb8, 0xe9, 0x75, 0x5d,
+	0x22, 0xf6, 0x79, 0xd5, 0x5a, 0x06, 0x68, 0x79, 0xbe, 0x89, 0x6f, 0xe3, 0xd0, 0xdc, 0xe7, 0xc9,
+	0xe4, 0xd4, 0x88, 0x6a, 0x40, 0xad, 0x08, 0xca, 0xd5, 0xe4, 0x16, 0x8c, 0x60, 0x37, 0xa4, 0x35,
+	0x64, 0xa6, 0x1c, 0x2f, 0x97, 0x28, 0x07, 0xf7, 0x9f, 0xb6, 0xee, 0x3e, 0xa4, 0xb8, 0x78, 0x9d,
+	0x98, 0xc3, 0xaa, 0x3f, 0x1d, 0x80, 0x05, 0x0d, 0x1b, 0x96, 0x80, 0xba, 0x0d, 0x38, 0x13, 0x77,
+	0x65, 0x4c, 0x6d, 0x2c, 0x97, 0x79, 0x45, 0x77, 0x1f, 0xd2, 0xe7, 0x05, 0x5d, 0x2b, 0x0b, 0x22,
+	0x8b, 0x61, 0xe8, 0xa0, 0x28, 0x0c, 0xdd, 0x81, 0x9a, 0xed, 0x92, 0x15, 0xf6, 0x21, 0xd6, 0xb1,
+	0x1b, 0xdb, 0xde, 0x3e, 0x3b, 0xd9, 0xe6, 0x63, 0xe0, 0x5b, 0x6e, 0x64, 0x44, 0x9b, 0x16, 0x91,
+	0x8d, 0x0e, 0x41, 0x12, 0xd8, 0x9f, 0x30, 0xb7, 0x61, 0x48, 0x1b, 0x25, 0x03, 0xdb, 0xf6, 0x27,
+	0x18, 0xbd, 0x00, 0xd3, 0xb4, 0x1f, 0x83, 0xae, 0x60, 0x6d, 0x03, 0xc3, 0xb4, 0x6d, 0x80, 0xb6,
+	0x69, 0x3c, 0x30, 0xf6, 0x30, 0xeb, 0x22, 0xfc, 0xeb, 0x01, 0x58, 0x2c, 0xf0, 0x8a, 0x5f, 0xc7,
+	0x49, 0x98, 0x25, 0xb4, 0x74, 0x03, 0xa7, 0xb3, 0x74, 0xe8, 0xdb, 0xb0, 0x50, 0x40, 0x1a, 0xa5,
+	0x2f, 0x8f, 0x6b, 0xba, 0xe7, 0xf2, 0xd8, 0x69, 0xf6, 0x52, 0xc0, 0xae, 0x33, 0x22, 0x76, 0xfd,
+	0x5c, 0x81, 0xc5, 0x07, 0x5d, 0x7f, 0x0f, 0x7f, 0xb5, 0x65, 0x4b, 0xad, 0x43, 0xad, 0x78, 0x4c,
+	0xae, 0xfc, 0x9f, 0x0d, 0xc0, 0xe2, 0x3d, 0xfc, 0x95, 0xe7, 0xc1, 0xff, 0x8d, 0x7e, 0xdd, 0x84,
+	0x5a, 0x91, 0x57, 0x5c, 0xbf, 0x04, 0x38, 0x14, 0x11, 0x8e, 0x4f, 0x15, 0x38, 0x7f, 0xdf, 0x0b,
+	0xed, 0x56, 0xef, 0xb6, 0x61, 0x3b, 0xde, 0x21, 0xf6, 0xef, 0x19, 0xfe, 0x01, 0xf6, 0x63, 0xae,
+	0x7f, 0x1b, 0x16, 0x5a, 0x7c, 0x46, 0x6f, 0xd3, 0x29, 0x3d, 0xe3, 0x6a, 0x96, 0xe9, 0x47, 0x16,
+	0x1d, 0xf3, 0x36, 0xe7, 0x5a, 0xc5, 0xc1, 0x40, 0xbd, 0x08, 0x17, 0x4a, 0x28, 0xe0, 0x42, 0x61,
+	0xc0, 0xd2, 0x1d, 0x1c, 0x6e, 0xfa, 0x5e, 0x10, 0xf0, 0x5b, 0xc9, 0x3c, 0x96, 0x33, 0x21, 0xab,
+	0x92, 0x0b, 0x59, 0x2f, 0xc1, 0x54, 0x68, 0xf8, 0x7b, 0x38, 0x8c, 0x6f, 0x99, 0x3d, 0xa0, 0x27,
+	0xd9, 0x28, 0xc7, 0xa7, 0xfe, 0x62, 0x10, 0xce, 0x8b, 0xf7, 0xe0, 0xfc, 0x6c, 0x13, 0x3c, 0xc4,
+	0x34, 0xec, 0xf6, 0x58, 0x00, 0xcd, 0x8f, 0x7f, 0x47, 0xe6, 0xda, 0x96, 0xa2, 0xa3, 0x61, 0x43,
+	0x70, 0xb3, 0x47, 0x5d, 0x57, 0xf6, 0x84, 0x99, 0x08, 0x53, 0x43, 0xe8, 0x53, 0x05, 0xe6, 0x5b,
+	0xb4, 0x56, 0xa7, 0x9b, 0x46, 0x37, 0xc0, 0xc9, 0xb6, 0xcc, 0xde, 0xdd, 0x3b, 0xd9, 0xb6, 0xac,
+	0xfc, 0xb7, 0x49, 0x30, 0x66, 0x36, 0x47, 0xad, 0xc2, 0x44, 0xbd, 0x03, 0xb3, 0x05, 0x2a, 0x05,
+	0x8e, 0xf5, 0xad, 0xac, 0x63, 0xbd, 0x5e, 0x22, 0x0e, 0x79, 0x9a, 0xf8, 0xe5, 0xa5, 0xbd, 0xeb,
+	0x7a, 0x07, 0x16, 0x4b, 0x08, 0x14, 0xec, 0xfb, 0x5e, 0x7a, 0xdf, 0xa9, 0xd2, 0x44, 0xf5, 0x1d,
+	0x1c, 0x26, 0x75, 0x4f, 0x8a, 0x37, 0xed, 0xcf, 0xff, 0xa7, 0x02, 0x6b, 0xbc, 0xd2, 0x58, 0x60,
+	0x5a, 0xa1, 0x44, 0x22, 0x89, 0x29, 0xfb, 0x93, 0x32, 0xf4, 0x88, 0x09, 0x51, 0xdc, 0x12, 0x12,
+	0x65, 0xd9, 0xfb, 0x67, 0x1a, 0x6f, 0x04, 0x99, 0x0c, 0x53, 0xbf, 0x02, 0xf4, 0x3c, 0x4c, 0xb6,
+	0x88, 0x03, 0x74, 0x1f, 0x33, 0x2f, 0x90, 0x57, 0xc6, 0xb2, 0x83, 0xaa, 0x0f, 0x2f, 0xf5, 0x71,
+	0xd6, 0xd8, 0x5d, 0x1a, 0x8a, 0x22, 0x89, 0x93, 0x5d, 0x2b, 0x85, 0x56, 0xdf, 0xa0, 0xef, 0xb2,
+	0x45, 0x8a, 0x4d, 0x1f, 0x92, 0x7d, 0x78, 0x9f, 0x6a, 0x48, 0x5f, 0x06, 0xcb, 0x82, 0xc5, 0x8e,
+	0xc3, 0x7c, 0x52, 0x11, 0x8a, 0x52, 0x48, 0x5d, 0xde, 0xe2, 0x35, 0xa4, 0x25, 0xe5, 0xa2, 0x6d,
+	0x96, 0x3f, 0xea, 0xba, 0x34, 0xa3, 0x1f, 0xbd, 0x6d, 0xc9, 0x93, 0x5f, 0x2c, 0xb3, 0x35, 0xc9,
+	0x47, 0x59, 0xee, 0x4b, 0xfd, 0x07, 0x05, 0x16, 0x34, 0x23, 0xc4, 0x8e, 0xdd, 0xb6, 0xc3, 0x8f,
+	0x3a, 0x56, 0x2a, 0x07, 0xb9, 0x00, 0xc3, 0xa6, 0xe1, 0x38, 0x71, 0x2d, 0x80, 0xff, 0x42, 0x0f,
+	0x60, 0xa8, 0x4b, 0x0c, 0x2f, 0x57, 0x4b, 0x49, 0x73, 0x8f, 0x10, 0x6f, 0xe3, 0x23, 0x02, 0xcc,
+	0x74, 0x90, 0x21, 0xaa, 0x5f, 0x03, 0x48, 0x06, 0xab, 0x5e, 0x77, 0x57, 0xd2, 0xc2, 0xfc, 0x37,
+	0x0a, 0x2c, 0x16, 0xb6, 0xe1, 0x5c, 0x7b, 0x0c, 0x23, 0x47, 0xd8, 0xde, 0xdb, 0x0f, 0x23, 0xb3,
+	0xfd, 0x6e, 0xff, 0x94, 0x72, 0xdb, 0xf1, 0x98, 0xc1, 0x73, 0x7f, 0x98, 0x63, 0xab, 0x5f, 0x87,
+	0x89, 0xf4, 0xc4, 0x71, 0x08, 0xde, 0xf8, 0xe7, 0x75, 0x00, 0xee, 0x6d, 0xdf, 0x78, 0xd0, 0x44,
+	0xdf, 0x55, 0x60, 0x41, 0xfc, 0x96, 0x3e, 0xba, 0x7a, 0xb2, 0xcf, 0x6a, 0xd4, 0xdf, 0x3c, 0x36,
+	0x1c, 0xe7, 0xd7, 0x1f, 0x2a, 0xb0, 0x58, 0xf2, 0x19, 0x07, 0xf4, 0x66, 0xd5, 0x27, 0x10, 0xca,
+	0xa8, 0xb9, 0x76, 0x7c, 0x40, 0x4e, 0xce, 0x4f, 0x14, 0x58, 0xa9, 0xfa, 0x94, 0x01, 0xfa, 0xd6,
+	0x69, 0x3f, 0xcd, 0x50, 0xbf, 0x71, 0x0a, 0x0c, 0x9c, 0x52, 0x72, 0x89, 0xe2, 0x8f, 0x14, 0x48,
+	0x2e, 0x51, 0xfa, 0x71, 0x04, 0xc9, 0x25, 0x56, 0x7c, 0x0d, 0xe1, 0x4f, 0x14, 0xa8, 0x97, 0xbf,
+	0xca, 0x8f, 0xca, 0x95, 0xb5, 0xf2, 0x13, 0x07, 0xf5, 0xb7, 0x4f, 0x04, 0xcb, 0xe9, 0xfa, 0xa1,
+	0x02, 0xe7, 0x4a, 0x5f, 0xd4, 0x47, 0x6f, 0x95, 0xa2, 0xae, 0xfa, 0x4e, 0x40, 0xfd, 0xfa, 0x49,
+	0x40, 0x39, 0x51, 0x2e, 0x4c, 0x66, 0xde, 0xe0, 0x46, 0xaf, 0x94, 0x22, 0x13, 0xbd, 0x28, 0x5e,
+	0x6f, 0xf4, 0xbb, 0x9c, 0xef, 0xf7, 0xa9, 0x02, 0x67, 0x05, 0xaf, 0x41, 0xa3, 0xd7, 0xe4, 0xb7,
+	0x2d, 0x7c, 0xf1, 0xba, 0xfe, 0xfa, 0xf1, 0x80, 0x38, 0x09, 0x21, 0x4c, 0xe7, 0x5e, 0x39, 0x46,
+	0xeb, 0x32, 0xbf, 0x4a, 0x50, 0x9c, 0xaa, 0xbf, 0xda, 0x3f, 0x00, 0xdf, 0xf5, 0x08, 0x66, 0xf2,
+	0xaf, 0xd6, 0xa1, 0x72, 0x2c, 0x25, 0x2f, 0x1f, 0xd6, 0xaf, 0x1c, 0x03, 0x22, 0x25, 0x76, 0xa5,
+	0x3d, 0xa6, 0x12, 0xb1, 0xab, 0x7a, 0xbd, 0xa7, 0x7e, 0x8a, 0x96, 0x56, 0xf4, 0xe7, 0x0a, 0x9c,
+	0x97, 0xb5, 0xa0, 0xa2, 0x77, 0x4e, 0xd8, 0xb9, 0xca, 0x48, 0x7b, 0xf7, 0x54, 0x7d, 0xaf, 0x9c,
+	0x65, 0x25, 0x7d, 0x9a, 0x52, 0x96, 0xc9, 0xbb, 0x44, 0xa5, 0x2c, 0xab, 0x68, 0x0b, 0x4d, 0xdd,
+	0xa3, 0xa0, 0x09, 0xbe, 0xf2, 0x1e, 0xcb, 0x5f, 0x3f, 0xa8, 0xbc, 0x47, 0x59, 0xcf, 0x7d, 0xea,
+	0x1e, 0x85, 0xad, 0x92, 0xd5, 0xf7, 0x28, 0x6b, 0xd7, 0xac, 0xbe, 0x47, 0x69, 0x7f, 0x66, 0xfa,
+	0x1e, 0x8b, 0xdd, 0x90, 0xd5, 0xf7, 0x58, 0xda, 0x8b, 0x59, 0x7d, 0x8f, 0xe5, 0xcd, 0x97, 0xe8,
+	0xcf, 0x68, 0xba, 0xb9, 0xb4, 0xcd, 0x11, 0xbd, 0x7d, 0xac, 0x33, 0x67, 0x1b, 0x2d, 0xeb, 0xef,
+	0x9c, 0x0c, 0x38, 0x43, 0x5a, 0x69, 0x8f, 0xaf, 0x94, 0xb4, 0xaa, 0x2e, 0x63, 0x29, 0x69, 0xd5,
+	0x6d, 0xc5, 0x7f, 0x49, 0x13, 0xdb, 0xb2, 0xe6, 0x3e, 0xf4, 0x4d, 0xc9, 0x06, 0x7d, 0x74, 0x38,
+	0xd6, 0xdf, 0x3b, 0x31, 0x3c, 0xa7, 0xf1, 0xfb, 0x0a, 0xd4, 0xca, 0x5a, 0x3c, 0xd1, 0x35, 0x09,
+	0x76, 0x69, 0x2f, 0x6b, 0xfd, 0xad, 0x13, 0x40, 0x72, 0x8a, 0xbe, 0xa3, 0xc0, 0x9c, 0xa8, 0x51,
+	0x10, 0x95, 0x3f, 0x39, 0x25, 0x6d, 0x91, 0xf5, 0x37, 0x8e, 0x09, 0xc5, 0xa9, 0xf8, 0x0b, 0xfa,
+	0x35, 0x2d, 0x49, 0x9f, 0x1c, 0x7a, 0xb7, 0x42, 0x36, 0xe4, 0x5d, 0x8c, 0xf5, 0x6f, 0x9e, 0x14,
+	0x9c, 0x13, 0xf8, 0x09, 0xcc, 0x16, 0x5a, 0xc6, 0xd0, 0x15, 0x09, 0x52, 0x71, 0x27, 0x5f, 0x7d,
+	0xe3, 0x38, 0x20, 0x89, 0x37, 0x92, 0x6b, 0x02, 0x93, 0x78, 0x23, 0xe2, 0xd6, 0x35, 0x89, 0x37,
+	0x52, 0xd2, 0x5f, 0x86, 0x0e, 0x60, 0x22, 0xdd, 0x94, 0x83, 0xbe, 0x21, 0xc5, 0x90, 0xeb, 0x42,
+	0xab, 0xbf, 0xd2, 0xe7, 0xea, 0x94, 0x14, 0x8a, 0xba, 0x6a, 0x24, 0x52, 0x28, 0x69, 0x0c, 0x92,
+	0x48, 0xa1, 0xb4, 0x75, 0x87, 0x78, 0x9e, 0x82, 0x66, 0x19, 0x89, 0xe7, 0x59, 0xde, 0x79, 0x53,
+	0x7f, 0xfd, 0x78, 0x40, 0xf1, 0xeb, 0x41, 0x90, 0xf4, 0x9e, 0xa0, 0xcb, 0xa5, 0x38, 0x0a, 0x0d,
+	0x2d, 0xf5, 0x97, 0xfb, 0x5a, 0x9b, 0x6c, 0x93, 0x34, 0x77, 0x48, 0xb6, 0x29, 0x34, 0xbc, 0x48,
+	0xb6, 0x29, 0x76, 0x8b, 0xb0, 0x6d, 0xa2, 0xde, 0x0c, 0xe9, 0x36, 0xb9, 0x8e, 0x12, 0xe9, 0x36,
+	0xf9, 0x66, 0x0f, 0x12, 0xa1, 0x64, 0xfa, 0x2a, 0x24, 0x11, 0x8a, 0xa8, 0x27, 0x44, 0x12, 0xa1,
+	0x88, 0xdb, 0x35, 0xbe, 0xcb, 0x3e, 0xc4, 0x24, 0xa8, 0xbd, 0x4b, 0x42, 0x59, 0x69, 0x9f, 0x86,
+	0x24, 0x94, 0xad, 0xe8, 0xac, 0x20, 0x0e, 0x4c, 0x69, 0x2b, 0x80, 0xc4, 0x81, 0xa9, 0xea, 0x56,
+	0x90, 0x38, 0x30, 0xd5, 0x9d, 0x07, 0x2e, 0x4c, 0x66, 0x0a, 0xe9, 0x92, 0x0b, 0x11, 0xf5, 0x12,
+	0x48, 0x2e, 0x44, 0x58, 0x9f, 0xa7, 0xe6, 0x43, 0x54, 0xf4, 0x46, 0xb2, 0xf0, 0xaf, 0xb4, 0x9c,
+	0x2f, 0x31, 0x1f, 0xb2, 0xca, 0x3a, 0x4d, 0x0d, 0x95, 0xd4, 0xb0, 0x91, 0x34, 0x55, 0x21, 0xa9,
+	0xc5, 0xd7, 0xaf, 0x1d, 0x1f, 0x30, 0x09, 0x27, 0xf3, 0x35, 0x6f, 0x49, 0x38, 0x59, 0x52, 0x59,
+	0x97, 0x84, 0x93, 0xa5, 0x05, 0xf5, 0x10, 0xa6, 0x73, 0xc5, 0x5d, 0xc9, 0xf3, 0x4a, 0x5c, 0x32,
+	0x97, 0x3c, 0xaf, 0xca, 0xea, 0xc6, 0x24, 0x7a, 0xce, 0x15, 0x0f, 0x65, 0xd1, 0xb3, 0xb8, 0x9c,
+	0x2a, 0x8b, 0x9e, 0x4b, 0x2a, 0x93, 0x64, 0xe3, 0x7c, 0xb1, 0x4d, 0xb2, 0x71, 0x49, 0x0d, 0x53,
+	0xb2, 0x71, 0x69, 0x25, 0xef, 0x0f, 0x14, 0x98, 0x17, 0xd6, 0xc7, 0x50, 0xb9, 0x00, 0xcb, 0x2a,
+	0x7a, 0xf5, 0xab, 0xc7, 0x05, 0x4b, 0xa9, 0x9f, 0xa8, 0xba, 0x24, 0x51, 0x3f, 0x49, 0xd9, 0x4e,
+	0xa2, 0x7e, 0xd2, 0x42, 0xdc, 0x67, 0x4a, 0xfc, 0x62, 0x5b, 0x79, 0x19, 0x03, 0xdd, 0xa8, 0x0a,
+	0x7f, 0x2a, 0xcb, 0x3d, 0xf5, 0x9b, 0xa7, 0x41, 0x91, 0xc9, 0x30, 0xa5, 0xeb, 0x18, 0xf2, 0x0c,
+	0x93, 0xa0, 0x50, 0x22, 0xcf, 0x30, 0x09, 0x4b, 0x24, 0x44, 0x33, 0xb3, 0x49, 0x7c, 0x99, 0x66,
+	0x0a, 0x0b, 0x13, 0x32, 0xcd, 0x14, 0xd7, 0x07, 0x6e, 0xde, 0xfa, 0xd9, 0xe7, 0xcb, 0xca, 0xbf,
+	0x7c, 0xbe, 0xac, 0xfc, 0xfb, 0xe7, 0xcb, 0xca, 0x6f, 0xbe, 0xb9, 0x67, 0x87, 0xfb, 0xdd, 0xdd,
+	0x86, 0xe9, 0xb5, 0xd7, 0x33, 0xdf, 0x7f, 0x6f, 0xec, 0x61, 0x97, 0x7d, 0xea, 0x3f, 0xf5, 0xbf,
+	0x06, 0xde, 0xe6, 0x7f, 0x1e, 0x5e, 0xd9, 0x1d, 0xa6, 0x73, 0xaf, 0xfd, 0x6f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x78, 0x40, 0xee, 0x36, 0x97, 0x60, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -9469,6 +9685,79 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *RestoreArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreArchivedWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreArchivedWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreArchivedWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CountDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9967,21 +10256,21 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA92 := make([]byte, len(m.ShardIds)*10)
-		var j91 int
+		dAtA93 := make([]byte, len(m.ShardIds)*10)
+		var j92 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j91++
+				j92++
 			}
-			dAtA92[j91] = uint8(num)
-			j91++
+			dAtA93[j92] = uint8(num)
+			j92++
 		}
-		i -= j91
-		copy(dAtA[i:], dAtA92[:j91])
-		i = encodeVarintService(dAtA, i, uint64(j91))
+		i -= j92
+		copy(dAtA[i:], dAtA93[:j92])
+		i = encodeVarintService(dAtA, i, uint64(j92))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10215,21 +10504,21 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.PendingShards) > 0 {
-		dAtA96 := make([]byte, len(m.PendingShards)*10)
-		var j95 int
+		dAtA97 := make([]byte, len(m.PendingShards)*10)
+		var j96 int
 		for _, num1 := range m.PendingShards {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA97[j96] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j95++
+				j96++
 			}
-			dAtA96[j95] = uint8(num)
-			j95++
+			dAtA97[j96] = uint8(num)
+			j96++
 		}
-		i -= j95
-		copy(dAtA[i:], dAtA96[:j95])
-		i = encodeVarintService(dAtA, i, uint64(j95))
+		i -= j96
+		copy(dAtA[i:], dAtA97[:j96])
+		i = encodeVarintService(dAtA, i, uint64(j96))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10241,33 +10530,130 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RatelimitUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RatelimitUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatelimitUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ParentExecutionInfo != nil {
-		l = m.ParentExecutionInfo.Size()
+	if len(m.Usage) > 0 {
+		for k := range m.Usage {
+			v := m.Usage[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RatelimitUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatelimitUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatelimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Weights) > 0 {
+		for k := range m.Weights {
+			v := m.Weights[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ParentExecutionInfo != nil {
+		l = m.ParentExecutionInfo.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
 	if m.Attempt != 0 {
@@ -11887,6 +12273,38 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
+func (m *RestoreArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreArchivedWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CountDLQMessagesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12239,6 +12657,50 @@ func (m *GetFailoverInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *RatelimitUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Usage) > 0 {
+		for k, v := range m.Usage {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RatelimitUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for k, v := range m.Weights {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -22402,7 +22864,7 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22425,17 +22887,17 @@ func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreArchivedWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceFetch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22445,12 +22907,60 @@ func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceFetch = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22473,7 +22983,7 @@ func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *RestoreArchivedWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22496,46 +23006,12 @@ func (m *CountDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreArchivedWorkflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreArchivedWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entries = append(m.Entries, &v12.HistoryDLQCountEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22558,7 +23034,7 @@ func (m *CountDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *CountDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22581,17 +23057,17 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceFetch", wireType)
 			}
-			m.Type = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22601,7 +23077,163 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v12.DLQType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceFetch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &v12.HistoryDLQCountEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v12.DLQType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -24475,6 +25107,352 @@ func (m *GetFailoverInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RatelimitUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatelimitUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatelimitUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Usage[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RatelimitUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatelimitUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatelimitUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Weights == nil {
+				m.Weights = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Weights[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
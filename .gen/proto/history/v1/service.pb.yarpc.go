@@ -79,6 +79,7 @@ type HistoryAPIYARPCClient interface {
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest, ...yarpc.CallOption) (*ReapplyEventsResponse, error)
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest, ...yarpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	RestoreArchivedWorkflow(context.Context, *RestoreArchivedWorkflowRequest, ...yarpc.CallOption) (*RestoreArchivedWorkflowResponse, error)
 	CountDLQMessages(context.Context, *CountDLQMessagesRequest, ...yarpc.CallOption) (*CountDLQMessagesResponse, error)
 	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest, ...yarpc.CallOption) (*ReadDLQMessagesResponse, error)
 	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest, ...yarpc.CallOption) (*PurgeDLQMessagesResponse, error)
@@ -87,6 +88,7 @@ type HistoryAPIYARPCClient interface {
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest, ...yarpc.CallOption) (*GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest, ...yarpc.CallOption) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest, ...yarpc.CallOption) (*GetFailoverInfoResponse, error)
+	RatelimitUpdate(context.Context, *RatelimitUpdateRequest, ...yarpc.CallOption) (*RatelimitUpdateResponse, error)
 }
 
 func newHistoryAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) HistoryAPIYARPCClient {
@@ -142,6 +144,7 @@ type HistoryAPIYARPCServer interface {
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	RestoreArchivedWorkflow(context.Context, *RestoreArchivedWorkflowRequest) (*RestoreArchivedWorkflowResponse, error)
 	CountDLQMessages(context.Context, *CountDLQMessagesRequest) (*CountDLQMessagesResponse, error)
 	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest) (*ReadDLQMessagesResponse, error)
 	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest) (*PurgeDLQMessagesResponse, error)
@@ -150,6 +153,7 @@ type HistoryAPIYARPCServer interface {
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest) (*GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest) (*GetFailoverInfoResponse, error)
+	RatelimitUpdate(context.Context, *RatelimitUpdateRequest) (*RatelimitUpdateResponse, error)
 }
 
 type buildHistoryAPIYARPCProceduresParams struct {
@@ -513,6 +517,16 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "RestoreArchivedWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RestoreArchivedWorkflow,
+							NewRequest:  newHistoryAPIServiceRestoreArchivedWorkflowYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "CountDLQMessages",
 					Handler: protobuf.NewUnaryHandler(
@@ -593,6 +607,16 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "RatelimitUpdate",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RatelimitUpdate,
+							NewRequest:  newHistoryAPIServiceRatelimitUpdateYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -1131,6 +1155,18 @@ func (c *_HistoryAPIYARPCCaller) RefreshWorkflowTasks(ctx context.Context, reque
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) RestoreArchivedWorkflow(ctx context.Context, request *RestoreArchivedWorkflowRequest, options ...yarpc.CallOption) (*RestoreArchivedWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RestoreArchivedWorkflow", request, newHistoryAPIServiceRestoreArchivedWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RestoreArchivedWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceRestoreArchivedWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) CountDLQMessages(ctx context.Context, request *CountDLQMessagesRequest, options ...yarpc.CallOption) (*CountDLQMessagesResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "CountDLQMessages", request, newHistoryAPIServiceCountDLQMessagesYARPCResponse, options...)
 	if responseMessage == nil {
@@ -1227,6 +1263,18 @@ func (c *_HistoryAPIYARPCCaller) GetFailoverInfo(ctx context.Context, request *G
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) RatelimitUpdate(ctx context.Context, request *RatelimitUpdateRequest, options ...yarpc.CallOption) (*RatelimitUpdateResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RatelimitUpdate", request, newHistoryAPIServiceRatelimitUpdateYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RatelimitUpdateResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceRatelimitUpdateYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _HistoryAPIYARPCHandler struct {
 	server HistoryAPIYARPCServer
 }
@@ -1791,6 +1839,22 @@ func (h *_HistoryAPIYARPCHandler) RefreshWorkflowTasks(ctx context.Context, requ
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) RestoreArchivedWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RestoreArchivedWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RestoreArchivedWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceRestoreArchivedWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RestoreArchivedWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) CountDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *CountDLQMessagesRequest
 	var ok bool
@@ -1919,6 +1983,22 @@ func (h *_HistoryAPIYARPCHandler) GetFailoverInfo(ctx context.Context, requestMe
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) RatelimitUpdate(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RatelimitUpdateRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RatelimitUpdateRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceRatelimitUpdateYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RatelimitUpdate(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newHistoryAPIServiceStartWorkflowExecutionYARPCRequest() proto.Message {
 	return &StartWorkflowExecutionRequest{}
 }
@@ -2199,6 +2279,14 @@ func newHistoryAPIServiceRefreshWorkflowTasksYARPCResponse() proto.Message {
 	return &RefreshWorkflowTasksResponse{}
 }
 
+func newHistoryAPIServiceRestoreArchivedWorkflowYARPCRequest() proto.Message {
+	return &RestoreArchivedWorkflowRequest{}
+}
+
+func newHistoryAPIServiceRestoreArchivedWorkflowYARPCResponse() proto.Message {
+	return &RestoreArchivedWorkflowResponse{}
+}
+
 func newHistoryAPIServiceCountDLQMessagesYARPCRequest() proto.Message {
 	return &CountDLQMessagesRequest{}
 }
@@ -2263,6 +2351,14 @@ func newHistoryAPIServiceGetFailoverInfoYARPCResponse() proto.Message {
 	return &GetFailoverInfoResponse{}
 }
 
+func newHistoryAPIServiceRatelimitUpdateYARPCRequest() proto.Message {
+	return &RatelimitUpdateRequest{}
+}
+
+func newHistoryAPIServiceRatelimitUpdateYARPCResponse() proto.Message {
+	return &RatelimitUpdateResponse{}
+}
+
 var (
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCRequest             = &StartWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCResponse            = &StartWorkflowExecutionResponse{}
@@ -2334,6 +2430,8 @@ var (
 	emptyHistoryAPIServiceReapplyEventsYARPCResponse                     = &ReapplyEventsResponse{}
 	emptyHistoryAPIServiceRefreshWorkflowTasksYARPCRequest               = &RefreshWorkflowTasksRequest{}
 	emptyHistoryAPIServiceRefreshWorkflowTasksYARPCResponse              = &RefreshWorkflowTasksResponse{}
+	emptyHistoryAPIServiceRestoreArchivedWorkflowYARPCRequest            = &RestoreArchivedWorkflowRequest{}
+	emptyHistoryAPIServiceRestoreArchivedWorkflowYARPCResponse           = &RestoreArchivedWorkflowResponse{}
 	emptyHistoryAPIServiceCountDLQMessagesYARPCRequest                   = &CountDLQMessagesRequest{}
 	emptyHistoryAPIServiceCountDLQMessagesYARPCResponse                  = &CountDLQMessagesResponse{}
 	emptyHistoryAPIServiceReadDLQMessagesYARPCRequest                    = &ReadDLQMessagesRequest{}
@@ -2350,315 +2448,327 @@ var (
 	emptyHistoryAPIServiceRespondCrossClusterTasksCompletedYARPCResponse = &RespondCrossClusterTasksCompletedResponse{}
 	emptyHistoryAPIServiceGetFailoverInfoYARPCRequest                    = &GetFailoverInfoRequest{}
 	emptyHistoryAPIServiceGetFailoverInfoYARPCResponse                   = &GetFailoverInfoResponse{}
+	emptyHistoryAPIServiceRatelimitUpdateYARPCRequest                    = &RatelimitUpdateRequest{}
+	emptyHistoryAPIServiceRatelimitUpdateYARPCResponse                   = &RatelimitUpdateResponse{}
 )
 
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
-		0x76, 0xe8, 0x19, 0xf1, 0xeb, 0x91, 0x1c, 0x92, 0x25, 0x7e, 0x0c, 0x87, 0x12, 0x45, 0xb6, 0x2d,
-		0x8b, 0x96, 0x57, 0x43, 0x8b, 0xb2, 0xf5, 0x65, 0x79, 0xb5, 0x12, 0x29, 0xc9, 0xe3, 0xe8, 0xb3,
-		0xc9, 0x95, 0x93, 0x20, 0x71, 0x6f, 0x73, 0xba, 0x86, 0xec, 0xa8, 0xa7, 0x7b, 0xd4, 0xdd, 0x43,
-		0x6a, 0x7c, 0x08, 0x1c, 0x6c, 0x10, 0x60, 0x17, 0x41, 0x76, 0xb3, 0xd8, 0x04, 0x01, 0x02, 0x04,
-		0x08, 0x36, 0xc0, 0x62, 0x8d, 0xdc, 0x92, 0x5b, 0x90, 0x53, 0x80, 0x20, 0x7f, 0x21, 0xa7, 0x5c,
-		0xbc, 0x87, 0x04, 0xc8, 0x29, 0x7b, 0x0e, 0x82, 0xfa, 0xe8, 0x9e, 0xfe, 0xa8, 0xae, 0x1e, 0x92,
-		0x41, 0xe4, 0x75, 0x7c, 0xe3, 0x54, 0xd5, 0x7b, 0xf5, 0xea, 0xd5, 0x7b, 0xaf, 0xde, 0x57, 0x13,
-		0xce, 0x77, 0x77, 0xb1, 0xb7, 0xde, 0x34, 0x4c, 0xec, 0x34, 0xf1, 0xfa, 0xbe, 0xe5, 0x07, 0xae,
-		0xd7, 0x5b, 0x3f, 0xb8, 0xbc, 0xee, 0x63, 0xef, 0xc0, 0x6a, 0xe2, 0x7a, 0xc7, 0x73, 0x03, 0x17,
-		0x2d, 0x90, 0x65, 0x75, 0xbe, 0xac, 0xce, 0x97, 0xd5, 0x0f, 0x2e, 0xd7, 0x96, 0xf7, 0x5c, 0x77,
-		0xcf, 0xc6, 0xeb, 0x74, 0xd9, 0x6e, 0xb7, 0xb5, 0x6e, 0x76, 0x3d, 0x23, 0xb0, 0x5c, 0x87, 0x01,
-		0xd6, 0xce, 0xa5, 0xe7, 0x03, 0xab, 0x8d, 0xfd, 0xc0, 0x68, 0x77, 0xf8, 0x82, 0x0c, 0x82, 0x43,
-		0xcf, 0xe8, 0x74, 0xb0, 0xe7, 0xf3, 0xf9, 0x95, 0x04, 0x81, 0x46, 0xc7, 0x22, 0xc4, 0x35, 0xdd,
-		0x76, 0x3b, 0xda, 0x62, 0x55, 0xb4, 0x22, 0x24, 0x91, 0x53, 0x21, 0x5a, 0xf2, 0xb2, 0x8b, 0xa3,
-		0x05, 0xaa, 0x68, 0x41, 0x60, 0xf8, 0x2f, 0x6c, 0xcb, 0x0f, 0x64, 0x6b, 0x0e, 0x5d, 0xef, 0x45,
-		0xcb, 0x76, 0x0f, 0xf9, 0x9a, 0x8b, 0xa2, 0x35, 0x9c, 0x95, 0x7a, 0x6a, 0xed, 0x5a, 0xd1, 0x5a,
-		0xec, 0xf1, 0x95, 0x6f, 0x24, 0x57, 0x9a, 0x6d, 0xcb, 0xa1, 0x5c, 0xb0, 0xbb, 0x7e, 0x50, 0xb4,
-		0x28, 0xc9, 0x88, 0x55, 0xf1, 0xa2, 0x97, 0x5d, 0xdc, 0xe5, 0x57, 0x5d, 0xbb, 0x20, 0x5e, 0xe2,
-		0xe1, 0x8e, 0x6d, 0x35, 0xe3, 0x57, 0xfb, 0x66, 0x62, 0xa1, 0xbf, 0x6f, 0x78, 0xd8, 0xcc, 0xee,
-		0x78, 0x3e, 0x67, 0x55, 0x92, 0x19, 0xea, 0x97, 0xc3, 0x70, 0x76, 0x3b, 0x30, 0xbc, 0xe0, 0x13,
-		0x3e, 0x7e, 0xef, 0x15, 0x6e, 0x76, 0xc9, 0x6e, 0x1a, 0x7e, 0xd9, 0xc5, 0x7e, 0x80, 0x1e, 0xc2,
-		0x88, 0xc7, 0xfe, 0xac, 0x2a, 0x2b, 0xca, 0xda, 0xf8, 0xc6, 0x46, 0x3d, 0x21, 0x94, 0x46, 0xc7,
-		0xaa, 0x1f, 0x5c, 0xae, 0x4b, 0x91, 0x68, 0x21, 0x0a, 0xb4, 0x04, 0x63, 0xa6, 0xdb, 0x36, 0x2c,
-		0x47, 0xb7, 0xcc, 0x6a, 0x69, 0x45, 0x59, 0x1b, 0xd3, 0x46, 0xd9, 0x40, 0xc3, 0x44, 0xbf, 0x03,
-		0x73, 0x1d, 0xc3, 0xc3, 0x4e, 0xa0, 0xe3, 0x10, 0x81, 0x6e, 0x39, 0x2d, 0xb7, 0x5a, 0xa6, 0x1b,
-		0xaf, 0x09, 0x37, 0x7e, 0x4a, 0x21, 0xa2, 0x1d, 0x1b, 0x4e, 0xcb, 0xd5, 0x4e, 0x77, 0xb2, 0x83,
-		0xa8, 0x0a, 0x23, 0x46, 0x10, 0xe0, 0x76, 0x27, 0xa8, 0x9e, 0x5a, 0x51, 0xd6, 0x86, 0xb4, 0xf0,
-		0x27, 0xda, 0x84, 0x29, 0xfc, 0xaa, 0x63, 0x31, 0x05, 0xd2, 0x89, 0xa6, 0x54, 0x87, 0xe8, 0x8e,
-		0xb5, 0x3a, 0xd3, 0x92, 0x7a, 0xa8, 0x25, 0xf5, 0x9d, 0x50, 0x8d, 0xb4, 0x4a, 0x1f, 0x84, 0x0c,
-		0xa2, 0x16, 0x2c, 0x36, 0x5d, 0x27, 0xb0, 0x9c, 0x2e, 0xd6, 0x0d, 0x5f, 0x77, 0xf0, 0xa1, 0x6e,
-		0x39, 0x56, 0x60, 0x19, 0x81, 0xeb, 0x55, 0x87, 0x57, 0x94, 0xb5, 0xca, 0xc6, 0x3b, 0xc2, 0x03,
-		0x6c, 0x72, 0xa8, 0x3b, 0xfe, 0x63, 0x7c, 0xd8, 0x08, 0x41, 0xb4, 0xf9, 0xa6, 0x70, 0x1c, 0x35,
-		0x60, 0x26, 0x9c, 0x31, 0xf5, 0x96, 0x61, 0xd9, 0x5d, 0x0f, 0x57, 0x47, 0x28, 0xb9, 0x67, 0x84,
-		0xf8, 0xef, 0xb3, 0x35, 0xda, 0x74, 0x04, 0xc6, 0x47, 0x90, 0x06, 0xf3, 0xb6, 0xe1, 0x07, 0x7a,
-		0xd3, 0x6d, 0x77, 0x6c, 0x4c, 0x0f, 0xef, 0x61, 0xbf, 0x6b, 0x07, 0xd5, 0x51, 0x09, 0xbe, 0xa7,
-		0x46, 0xcf, 0x76, 0x0d, 0x53, 0x9b, 0x25, 0xb0, 0x9b, 0x11, 0xa8, 0x46, 0x21, 0xd1, 0x6f, 0xc2,
-		0x52, 0xcb, 0xf2, 0xfc, 0x40, 0x37, 0x71, 0xd3, 0xf2, 0x29, 0x3f, 0x0d, 0xff, 0x85, 0xbe, 0x6b,
-		0x34, 0x5f, 0xb8, 0xad, 0x56, 0x75, 0x8c, 0x22, 0x5e, 0xcc, 0xf0, 0x75, 0x8b, 0x9b, 0x2f, 0xad,
-		0x4a, 0xa1, 0xb7, 0x38, 0xf0, 0x8e, 0xe1, 0xbf, 0xb8, 0xcb, 0x40, 0xd1, 0x01, 0x4c, 0x77, 0x0c,
-		0x2f, 0xb0, 0x28, 0x9d, 0x4d, 0xd7, 0x69, 0x59, 0x7b, 0x55, 0x58, 0x29, 0xaf, 0x8d, 0x6f, 0xfc,
-		0x46, 0x3d, 0xc7, 0x4c, 0xca, 0xa5, 0x92, 0x88, 0x0e, 0x43, 0xb7, 0x49, 0xb1, 0xdd, 0x73, 0x02,
-		0xaf, 0xa7, 0x4d, 0x75, 0x92, 0xa3, 0xb5, 0xbb, 0x30, 0x2b, 0x5a, 0x88, 0xa6, 0xa1, 0xfc, 0x02,
-		0xf7, 0xa8, 0x52, 0x8c, 0x69, 0xe4, 0x4f, 0x34, 0x0b, 0x43, 0x07, 0x86, 0xdd, 0xc5, 0x5c, 0xb0,
-		0xd9, 0x8f, 0x9b, 0xa5, 0xeb, 0x8a, 0x7a, 0x0d, 0x96, 0xf3, 0x48, 0xf1, 0x3b, 0xae, 0xe3, 0x63,
-		0x34, 0x07, 0xc3, 0x5e, 0x97, 0x6a, 0x05, 0x43, 0x38, 0xe4, 0x75, 0x9d, 0x86, 0xa9, 0xfe, 0x4d,
-		0x09, 0x96, 0xb7, 0xad, 0x3d, 0xc7, 0xb0, 0x73, 0x15, 0xf4, 0x51, 0x5a, 0x41, 0xaf, 0x88, 0x15,
-		0x54, 0x8a, 0x65, 0x40, 0x0d, 0x6d, 0xc1, 0x12, 0x7e, 0x15, 0x60, 0xcf, 0x31, 0xec, 0xc8, 0xac,
-		0xf6, 0x95, 0x95, 0xeb, 0xe9, 0x5b, 0xc2, 0xfd, 0xb3, 0x3b, 0x2f, 0x86, 0xa8, 0x32, 0x53, 0xa8,
-		0x0e, 0xa7, 0x9b, 0xfb, 0x96, 0x6d, 0xf6, 0x37, 0x71, 0x1d, 0xbb, 0x47, 0xf5, 0x76, 0x54, 0x9b,
-		0xa1, 0x53, 0x21, 0xd0, 0x13, 0xc7, 0xee, 0xa9, 0xab, 0x70, 0x2e, 0xf7, 0x7c, 0x8c, 0xc1, 0xea,
-		0x2f, 0x4b, 0x70, 0x81, 0xaf, 0xb1, 0x82, 0x7d, 0xb9, 0xcd, 0x7b, 0x9e, 0x66, 0xe9, 0x2d, 0x19,
-		0x4b, 0x8b, 0xd0, 0x0d, 0xc8, 0xdb, 0xcf, 0x15, 0x81, 0x80, 0x97, 0xa9, 0x80, 0x7f, 0x37, 0x5f,
-		0xc0, 0x07, 0x23, 0xe1, 0xff, 0x50, 0xd4, 0xef, 0xc0, 0x5a, 0x31, 0x51, 0x72, 0xa1, 0xff, 0xa1,
-		0x02, 0x67, 0x35, 0xec, 0xe3, 0x13, 0x3f, 0x4a, 0x52, 0x24, 0x83, 0x5d, 0x0b, 0x51, 0xdd, 0x3c,
-		0x34, 0xf2, 0x53, 0x7c, 0x51, 0x82, 0xd5, 0x1d, 0xec, 0xb5, 0x2d, 0xc7, 0x08, 0x70, 0xee, 0x49,
-		0x9e, 0xa6, 0x4f, 0x72, 0x55, 0x78, 0x92, 0x42, 0x44, 0xbf, 0xe6, 0x0a, 0xfc, 0x26, 0xa8, 0xb2,
-		0x23, 0x72, 0x1d, 0xfe, 0xb1, 0x02, 0x2b, 0x5b, 0xd8, 0x6f, 0x7a, 0xd6, 0x6e, 0x3e, 0x47, 0x9f,
-		0xa4, 0x39, 0xfa, 0xbe, 0xf0, 0x38, 0x45, 0x78, 0x06, 0x14, 0x8f, 0xff, 0x2e, 0xc3, 0xaa, 0x04,
-		0x15, 0x17, 0x11, 0x1b, 0x16, 0xfa, 0x2e, 0x0d, 0x53, 0x6d, 0xfe, 0xe0, 0x49, 0x6d, 0x76, 0x06,
-		0xe1, 0x66, 0x1c, 0x54, 0x9b, 0xc7, 0xc2, 0x71, 0xb4, 0x0b, 0x0b, 0xd9, 0xbb, 0x65, 0x9e, 0x54,
-		0x89, 0xee, 0x76, 0x71, 0xb0, 0xdd, 0xa8, 0x2f, 0x35, 0x77, 0x28, 0x1a, 0x46, 0x9f, 0x00, 0xea,
-		0x60, 0xc7, 0xb4, 0x9c, 0x3d, 0xdd, 0x68, 0x06, 0xd6, 0x81, 0x15, 0x58, 0xd8, 0xe7, 0xe6, 0x2a,
-		0xc7, 0x51, 0x63, 0xcb, 0xef, 0xb0, 0xd5, 0x3d, 0x8a, 0x7c, 0xa6, 0x93, 0x18, 0xb4, 0xb0, 0x8f,
-		0x7e, 0x0b, 0xa6, 0x43, 0xc4, 0x54, 0x4c, 0x3c, 0xec, 0x54, 0x4f, 0x51, 0xb4, 0x75, 0x19, 0xda,
-		0x4d, 0xb2, 0x36, 0x49, 0xf9, 0x54, 0x27, 0x36, 0xe5, 0x61, 0x07, 0x6d, 0xf7, 0x51, 0x87, 0xde,
-		0x09, 0x77, 0xf4, 0xa4, 0x14, 0x87, 0xce, 0x48, 0x02, 0x69, 0x38, 0xa8, 0xbe, 0x82, 0xd9, 0x67,
-		0x24, 0xa2, 0x09, 0xb9, 0x17, 0x8a, 0xe1, 0x66, 0x5a, 0x0c, 0xdf, 0x16, 0xee, 0x21, 0x82, 0x1d,
-		0x50, 0xf4, 0x7e, 0xa6, 0xc0, 0x5c, 0x0a, 0x9c, 0x8b, 0xdb, 0x6d, 0x98, 0xa0, 0x51, 0x56, 0xe8,
-		0xce, 0x29, 0x03, 0xb8, 0x73, 0xe3, 0x14, 0x82, 0x7b, 0x71, 0x0d, 0xa8, 0x84, 0x08, 0x7e, 0x0f,
-		0x37, 0x03, 0x6c, 0x72, 0xc1, 0x51, 0xf3, 0xcf, 0xa0, 0xf1, 0x95, 0xda, 0xe4, 0xcb, 0xf8, 0x4f,
-		0xf5, 0x0f, 0x15, 0xa8, 0x51, 0x03, 0xba, 0x1d, 0x58, 0xcd, 0x17, 0x3d, 0xe2, 0xd1, 0x3d, 0xb4,
-		0xfc, 0x20, 0x64, 0x53, 0x23, 0xcd, 0xa6, 0xf5, 0x7c, 0x4b, 0x2e, 0xc4, 0x30, 0x20, 0xb3, 0xce,
-		0xc2, 0x92, 0x10, 0x07, 0xb7, 0x2c, 0xff, 0xa5, 0xc0, 0xfc, 0x03, 0x1c, 0x3c, 0xea, 0x06, 0xc6,
-		0xae, 0x8d, 0xb7, 0x03, 0x23, 0xc0, 0x9a, 0x08, 0xad, 0x92, 0xb2, 0xa7, 0xdf, 0x05, 0x24, 0x30,
-		0xa3, 0xa5, 0x23, 0x99, 0xd1, 0x99, 0x8c, 0x86, 0xa1, 0x2b, 0x30, 0x8f, 0x5f, 0x75, 0x28, 0x03,
-		0x75, 0x07, 0xbf, 0x0a, 0x74, 0x7c, 0x40, 0xc2, 0x22, 0xcb, 0xa4, 0x16, 0xba, 0xac, 0x9d, 0x0e,
-		0x67, 0x1f, 0xe3, 0x57, 0xc1, 0x3d, 0x32, 0xd7, 0x30, 0xd1, 0xbb, 0x30, 0xdb, 0xec, 0x7a, 0x34,
-		0x7e, 0xda, 0xf5, 0x0c, 0xa7, 0xb9, 0xaf, 0x07, 0xee, 0x0b, 0xaa, 0x3d, 0xca, 0xda, 0x84, 0x86,
-		0xf8, 0xdc, 0x5d, 0x3a, 0xb5, 0x43, 0x66, 0xd4, 0x9f, 0x8e, 0xc1, 0x42, 0xe6, 0xd4, 0x5c, 0x86,
-		0xc4, 0x27, 0x53, 0x4e, 0x7a, 0xb2, 0xfb, 0x30, 0x19, 0xa1, 0x0d, 0x7a, 0x1d, 0xcc, 0x79, 0xb5,
-		0x2a, 0xc5, 0xb8, 0xd3, 0xeb, 0x60, 0x6d, 0xe2, 0x30, 0xf6, 0x0b, 0xa9, 0x30, 0x29, 0x62, 0xcc,
-		0xb8, 0x13, 0x63, 0xc8, 0x73, 0x58, 0xec, 0x78, 0xf8, 0xc0, 0x72, 0xbb, 0xbe, 0xee, 0x13, 0x4f,
-		0x04, 0x9b, 0xfd, 0xf5, 0xa7, 0xe8, 0xbe, 0x4b, 0x99, 0x48, 0xa4, 0xe1, 0x04, 0x57, 0xdf, 0x7b,
-		0x4e, 0xdc, 0x19, 0x6d, 0x3e, 0x84, 0xde, 0x66, 0xc0, 0x21, 0xde, 0x4b, 0x70, 0x9a, 0xc6, 0x4d,
-		0x2c, 0xd0, 0x89, 0x30, 0x0e, 0x51, 0x0a, 0xa6, 0xc9, 0xd4, 0x7d, 0x32, 0x13, 0x2e, 0xbf, 0x09,
-		0x63, 0x34, 0x06, 0xb2, 0x2d, 0x3f, 0xa0, 0x91, 0xe0, 0xf8, 0xc6, 0x59, 0xf1, 0x23, 0x1f, 0x4a,
-		0xe5, 0x68, 0xc0, 0xff, 0x42, 0x0f, 0x60, 0xda, 0xa7, 0x12, 0xab, 0xf7, 0x51, 0x8c, 0x0c, 0x82,
-		0xa2, 0xe2, 0x27, 0x04, 0x1d, 0xbd, 0x07, 0xf3, 0x4d, 0xdb, 0x22, 0x94, 0xda, 0xd6, 0xae, 0x67,
-		0x78, 0x3d, 0xfd, 0x00, 0x7b, 0xd4, 0x02, 0x8e, 0x52, 0x91, 0x9e, 0x65, 0xb3, 0x0f, 0xd9, 0xe4,
-		0x73, 0x36, 0x17, 0x83, 0x6a, 0x61, 0x23, 0xe8, 0x7a, 0x38, 0x82, 0x1a, 0x8b, 0x43, 0xdd, 0x67,
-		0x93, 0x21, 0xd4, 0x39, 0x18, 0xe7, 0x50, 0x56, 0xbb, 0x63, 0x57, 0x81, 0x2e, 0x05, 0x36, 0xd4,
-		0x68, 0x77, 0x6c, 0xe4, 0xc3, 0xc5, 0xf4, 0xa9, 0x74, 0xbf, 0xb9, 0x8f, 0xcd, 0xae, 0x8d, 0xf5,
-		0xc0, 0x65, 0x97, 0x45, 0x03, 0x71, 0xb7, 0x1b, 0x54, 0xc7, 0x8b, 0x62, 0xc6, 0x37, 0x93, 0x67,
-		0xdd, 0xe6, 0x98, 0x76, 0x5c, 0x7a, 0x6f, 0x3b, 0x0c, 0x0d, 0x71, 0x49, 0xd8, 0x55, 0x11, 0xe7,
-		0xb9, 0x7f, 0x90, 0x09, 0x9a, 0x0b, 0x98, 0xa1, 0x53, 0xdb, 0x64, 0x26, 0x3c, 0x45, 0x9e, 0x3a,
-		0x4d, 0xe6, 0xa9, 0x13, 0x7a, 0x08, 0x95, 0x48, 0xb6, 0x7d, 0xa2, 0x4c, 0xd5, 0x0a, 0x8d, 0xfb,
-		0xcf, 0x27, 0xaf, 0x8a, 0x25, 0x63, 0xe2, 0xf2, 0xcd, 0x34, 0x2f, 0x52, 0x0c, 0xfa, 0x13, 0x35,
-		0x61, 0x36, 0xc2, 0xd6, 0xb4, 0x5d, 0x1f, 0x73, 0x9c, 0x53, 0x14, 0xe7, 0xe5, 0x01, 0x1d, 0x06,
-		0x02, 0x48, 0xf0, 0x75, 0x7d, 0x2d, 0xd2, 0xe7, 0x68, 0x90, 0x68, 0xf9, 0x0c, 0x67, 0x84, 0xce,
-		0xa2, 0x0a, 0xf2, 0x8a, 0x4f, 0x8b, 0xde, 0xc4, 0x3e, 0xd5, 0x9c, 0x41, 0x1f, 0x85, 0xeb, 0xb5,
-		0xe9, 0x83, 0xd4, 0x08, 0xba, 0x05, 0x4b, 0x16, 0xd1, 0xb9, 0xd4, 0x1d, 0x63, 0x87, 0xd8, 0x19,
-		0xb3, 0x3a, 0x43, 0xdd, 0xc0, 0x05, 0xcb, 0x4f, 0x5a, 0xe3, 0x7b, 0x6c, 0x5a, 0xfd, 0x95, 0x02,
-		0x0b, 0x4f, 0x5d, 0xdb, 0xfe, 0x7f, 0x66, 0x8d, 0x7f, 0x3e, 0x0a, 0xd5, 0xec, 0xb1, 0xbf, 0x31,
-		0xc7, 0xdf, 0x98, 0xe3, 0xaf, 0xa3, 0x39, 0xce, 0xd3, 0x8f, 0x89, 0x5c, 0xf3, 0x2a, 0xb4, 0x55,
-		0x93, 0x27, 0xb6, 0x55, 0xbf, 0x7e, 0x56, 0x5b, 0xfd, 0xa7, 0x12, 0xac, 0x68, 0xb8, 0xe9, 0x7a,
-		0x66, 0x3c, 0x51, 0xca, 0xd5, 0xe2, 0x75, 0x5a, 0xca, 0x73, 0x30, 0x1e, 0x09, 0x4e, 0x64, 0x04,
-		0x20, 0x1c, 0x6a, 0x98, 0x68, 0x01, 0x46, 0xa8, 0x8c, 0x71, 0x8d, 0x2f, 0x6b, 0xc3, 0xe4, 0x67,
-		0xc3, 0x44, 0x67, 0x01, 0xb8, 0x1f, 0x1f, 0xea, 0xee, 0x98, 0x36, 0xc6, 0x47, 0x1a, 0x26, 0xd2,
-		0x60, 0xa2, 0xe3, 0xda, 0xb6, 0x1e, 0xc6, 0x0a, 0xc3, 0x92, 0x58, 0x81, 0xd8, 0xd0, 0xfb, 0xae,
-		0x17, 0x67, 0x4d, 0x18, 0x2b, 0x8c, 0x13, 0x24, 0xfc, 0x87, 0xfa, 0x6f, 0x23, 0xb0, 0x2a, 0xe1,
-		0x22, 0x37, 0xbc, 0x19, 0x0b, 0xa9, 0x1c, 0xcf, 0x42, 0x4a, 0xad, 0x5f, 0xe9, 0xf8, 0xd6, 0xef,
-		0x5b, 0x80, 0x42, 0xfe, 0x9a, 0x69, 0xf3, 0x3b, 0x1d, 0xcd, 0x84, 0xab, 0xd7, 0x88, 0x01, 0x13,
-		0x98, 0xde, 0x32, 0xb1, 0x50, 0x09, 0xbc, 0x19, 0x8b, 0x3e, 0x94, 0xb5, 0xe8, 0xb1, 0x92, 0xca,
-		0x70, 0xb2, 0xa4, 0x72, 0x1d, 0xaa, 0xdc, 0xa4, 0xf4, 0x13, 0x10, 0xe1, 0xeb, 0x3f, 0x42, 0x5f,
-		0xff, 0x79, 0x36, 0x1f, 0xc9, 0x0e, 0x7f, 0xfc, 0x91, 0x06, 0x93, 0x51, 0xe9, 0x80, 0xa6, 0x2c,
-		0x58, 0x2d, 0xe2, 0x52, 0x9e, 0x36, 0xee, 0x78, 0x86, 0xe3, 0x13, 0x53, 0x96, 0x08, 0xd3, 0x27,
-		0xcc, 0xd8, 0x2f, 0xf4, 0x29, 0x9c, 0x11, 0x24, 0x44, 0xfa, 0x26, 0x7c, 0x6c, 0x10, 0x13, 0xbe,
-		0x98, 0x11, 0xf7, 0xc8, 0x9a, 0xe7, 0xb8, 0x96, 0x90, 0xe7, 0x5a, 0xae, 0xc2, 0x44, 0xc2, 0xe6,
-		0x8d, 0x53, 0x9b, 0x37, 0xbe, 0x1b, 0x33, 0x76, 0x77, 0xa0, 0xd2, 0xbf, 0x56, 0x5a, 0x92, 0x9a,
-		0x28, 0x2c, 0x49, 0x4d, 0x46, 0x10, 0xb4, 0x22, 0xf5, 0x21, 0x4c, 0x84, 0x77, 0x4d, 0x11, 0x4c,
-		0x16, 0x22, 0x18, 0xe7, 0xeb, 0x29, 0xb8, 0x01, 0x23, 0x24, 0x92, 0x27, 0x46, 0xb6, 0x42, 0xf3,
-		0x2f, 0x0f, 0x72, 0xb3, 0xd0, 0x85, 0x5a, 0x44, 0x53, 0x04, 0x16, 0xf6, 0x59, 0xde, 0x39, 0xc4,
-		0x5b, 0xfb, 0x14, 0x26, 0xe2, 0x13, 0x82, 0x3c, 0xf3, 0xf5, 0x78, 0x9e, 0x39, 0x2f, 0xff, 0x10,
-		0x6a, 0x1d, 0xcb, 0x43, 0xc4, 0x72, 0xd1, 0x7d, 0x3b, 0x19, 0x66, 0x9d, 0xbe, 0xb1, 0x93, 0x19,
-		0x3b, 0x19, 0x67, 0x8d, 0xd0, 0x4e, 0x7e, 0x59, 0x0e, 0xed, 0xa4, 0x90, 0x8b, 0xdc, 0x4e, 0x7e,
-		0x0c, 0x53, 0x29, 0x3b, 0x24, 0xb5, 0x94, 0xec, 0xfd, 0xed, 0x51, 0x4b, 0xa2, 0x55, 0x92, 0x76,
-		0x2a, 0x23, 0xb9, 0xa5, 0xa3, 0x49, 0x6e, 0xcc, 0x2c, 0x95, 0x93, 0x66, 0xe9, 0x53, 0x58, 0x4e,
-		0x6a, 0x95, 0xee, 0xb6, 0xf4, 0x60, 0xdf, 0xf2, 0xf5, 0x78, 0x69, 0x58, 0xbe, 0x55, 0x2d, 0xa1,
-		0x65, 0x4f, 0x5a, 0x3b, 0xfb, 0x96, 0x7f, 0x87, 0xe3, 0x6f, 0xc0, 0xcc, 0x3e, 0x36, 0xbc, 0x60,
-		0x17, 0x1b, 0x81, 0x6e, 0xe2, 0xc0, 0xb0, 0x6c, 0x9f, 0xa7, 0x18, 0xe5, 0xd9, 0xb7, 0xe9, 0x08,
-		0x6c, 0x8b, 0x41, 0x65, 0xdf, 0x9d, 0xe1, 0xe3, 0xbd, 0x3b, 0x17, 0x60, 0x2a, 0xc2, 0xc3, 0xc4,
-		0x9a, 0x1a, 0xe0, 0x31, 0x2d, 0xf2, 0x7a, 0xb6, 0xe8, 0xa8, 0xfa, 0xe7, 0x0a, 0xbc, 0xc1, 0x6e,
-		0x33, 0xa1, 0xc9, 0xbc, 0xc2, 0xdb, 0xd7, 0x17, 0x2d, 0x9d, 0xb1, 0xbb, 0x9e, 0x97, 0xb1, 0x2b,
-		0x42, 0x35, 0x60, 0xea, 0xee, 0xef, 0xca, 0xf0, 0xa6, 0x1c, 0x1b, 0x17, 0x41, 0xdc, 0x7f, 0xdc,
-		0x3c, 0x3e, 0xc6, 0x49, 0xbc, 0x79, 0x7c, 0xd3, 0xa5, 0x4d, 0xf9, 0x29, 0x49, 0xff, 0x99, 0x02,
-		0xcb, 0xfd, 0x9c, 0x37, 0x71, 0x90, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7, 0xdd, 0xa6,
-		0x61, 0xdb, 0xbd, 0x6a, 0x89, 0x1a, 0xcc, 0x4f, 0x25, 0xbb, 0x16, 0x1f, 0xa7, 0xde, 0x4f, 0x8a,
-		0xef, 0xb8, 0x5b, 0x7c, 0x87, 0x87, 0x6c, 0x03, 0x66, 0x47, 0x97, 0x8c, 0xfc, 0x15, 0xb5, 0xdf,
-		0x87, 0x95, 0x22, 0x04, 0x02, 0x7b, 0xbb, 0x95, 0xb4, 0xb7, 0xe2, 0x94, 0x7b, 0x68, 0x06, 0x28,
-		0xae, 0x10, 0x31, 0x7d, 0x76, 0x63, 0xb6, 0xf7, 0xc7, 0x0a, 0xb1, 0xbd, 0x99, 0x63, 0xde, 0x37,
-		0x2c, 0xbb, 0x2f, 0x4b, 0x03, 0xd6, 0x6a, 0x8a, 0xf0, 0x0c, 0x28, 0x48, 0x6f, 0x10, 0x3b, 0x96,
-		0x8b, 0x89, 0x67, 0x82, 0x7f, 0xaa, 0x80, 0x9a, 0xb5, 0x76, 0x1f, 0x85, 0xea, 0x19, 0x52, 0xfe,
-		0x2c, 0x4d, 0xf9, 0xb5, 0x1c, 0xca, 0x8b, 0x30, 0x0d, 0x48, 0xfb, 0x53, 0xa2, 0x9c, 0x12, 0x5c,
-		0x5c, 0x36, 0xdf, 0x86, 0xe9, 0xa6, 0xe1, 0x34, 0x71, 0xf4, 0x02, 0x60, 0xf6, 0xa6, 0x8d, 0x6a,
-		0x53, 0x6c, 0x5c, 0x0b, 0x87, 0xe3, 0xfa, 0x1e, 0xc7, 0x79, 0x42, 0x7d, 0x97, 0xa1, 0x1a, 0xf0,
-		0xa8, 0x6f, 0x45, 0xea, 0x9e, 0x83, 0x2c, 0x56, 0x0d, 0x14, 0x2c, 0x3c, 0x89, 0x84, 0xe5, 0xe2,
-		0x39, 0xb2, 0x84, 0x89, 0x30, 0x25, 0x24, 0x2c, 0x7b, 0x40, 0x7a, 0x3f, 0x7d, 0xca, 0x07, 0x96,
-		0xb0, 0x22, 0x4c, 0x03, 0xd2, 0x7e, 0x5e, 0x2c, 0x0e, 0x11, 0x2e, 0x4e, 0xfd, 0xdf, 0x2b, 0x70,
-		0x4e, 0xc3, 0x6d, 0xf7, 0x00, 0xb3, 0x32, 0xff, 0x57, 0x25, 0x49, 0x97, 0x74, 0x8c, 0xca, 0x29,
-		0xc7, 0x48, 0x55, 0x89, 0xac, 0xe4, 0x51, 0xcd, 0x8f, 0xf6, 0x0f, 0x25, 0x38, 0xcf, 0x8f, 0xc0,
-		0x8e, 0x9d, 0x5b, 0x63, 0x96, 0x1e, 0xd0, 0x80, 0x4a, 0x52, 0x07, 0xf9, 0xe1, 0x6e, 0xe6, 0xdc,
-		0xdf, 0x00, 0x1b, 0x6a, 0x93, 0x09, 0xed, 0x45, 0xbb, 0xb0, 0x10, 0x95, 0xf1, 0x85, 0xbd, 0x72,
-		0xe2, 0x0a, 0xef, 0x3d, 0x0e, 0x93, 0xaa, 0xf0, 0x62, 0xd1, 0xf0, 0x91, 0x4b, 0xf8, 0x6b, 0xf0,
-		0x56, 0xd1, 0x59, 0x38, 0x9f, 0xff, 0x51, 0x81, 0xa5, 0x30, 0x2b, 0x24, 0x88, 0xd2, 0x5f, 0x8b,
-		0xf8, 0x5c, 0x84, 0x19, 0xcb, 0xd7, 0x93, 0xad, 0x6b, 0x94, 0x97, 0xa3, 0xda, 0x94, 0xe5, 0xdf,
-		0x8f, 0x37, 0xa5, 0xa9, 0xcb, 0x70, 0x46, 0x4c, 0x3e, 0x3f, 0xdf, 0x97, 0x25, 0x62, 0xc1, 0x88,
-		0xb1, 0x4e, 0x56, 0xa5, 0x33, 0xa6, 0xf5, 0x75, 0x1c, 0x74, 0x15, 0x26, 0x78, 0x5f, 0x22, 0x36,
-		0x63, 0x89, 0xda, 0x68, 0xac, 0x61, 0xa2, 0x4f, 0xe0, 0x74, 0x33, 0x24, 0x35, 0xb6, 0xf5, 0xa9,
-		0x23, 0x6d, 0x8d, 0x22, 0x14, 0xfd, 0xbd, 0x1f, 0xc2, 0x74, 0xac, 0xd7, 0x90, 0x05, 0x09, 0x43,
-		0x83, 0x06, 0x09, 0x53, 0x7d, 0x50, 0x3a, 0xa0, 0x5e, 0x20, 0xda, 0x2a, 0xe5, 0x32, 0xbf, 0x8f,
-		0x7f, 0x2f, 0x41, 0x55, 0xe3, 0x7d, 0xb4, 0x98, 0xc2, 0xfa, 0xcf, 0x37, 0x5e, 0xe7, 0x1d, 0xfc,
-		0x2e, 0xcc, 0x25, 0x33, 0x99, 0x3d, 0xdd, 0x0a, 0x70, 0x3b, 0xec, 0x9f, 0x48, 0x77, 0x0a, 0x98,
-		0x6d, 0xcb, 0xc9, 0x24, 0x33, 0x7b, 0x8d, 0x00, 0xb7, 0xb5, 0xd3, 0x07, 0x99, 0x31, 0x1f, 0xbd,
-		0x0f, 0xc3, 0x94, 0xb7, 0x3e, 0xbf, 0x32, 0x71, 0x62, 0x63, 0xcb, 0x08, 0x8c, 0xbb, 0xb6, 0xbb,
-		0xab, 0xf1, 0xc5, 0x68, 0x13, 0x2a, 0x0e, 0x3e, 0xd4, 0xbd, 0x2e, 0xbf, 0x9a, 0x30, 0x72, 0x29,
-		0x00, 0x9f, 0x70, 0xf0, 0xa1, 0xd6, 0x65, 0x77, 0xe2, 0xab, 0x4b, 0xb0, 0x28, 0x60, 0x35, 0xbf,
-		0x88, 0x1f, 0x2a, 0x30, 0xbf, 0xdd, 0x73, 0x9a, 0xdb, 0xfb, 0x86, 0x67, 0xf2, 0xfc, 0x26, 0xbf,
-		0x86, 0xf3, 0x50, 0xf1, 0xdd, 0xae, 0xd7, 0xc4, 0x3a, 0x6f, 0xaf, 0xe6, 0x77, 0x31, 0xc9, 0x46,
-		0x37, 0xd9, 0x20, 0x5a, 0x84, 0x51, 0x9f, 0x00, 0x87, 0x0f, 0xd8, 0x90, 0x36, 0x42, 0x7f, 0x37,
-		0x4c, 0x54, 0x87, 0x53, 0x34, 0x58, 0x2c, 0x17, 0x46, 0x70, 0x74, 0x9d, 0xba, 0x08, 0x0b, 0x19,
-		0x5a, 0x38, 0x9d, 0xff, 0x32, 0x04, 0xa7, 0xc9, 0x5c, 0xf8, 0x10, 0xbe, 0x4e, 0x59, 0xa9, 0xc2,
-		0x48, 0x98, 0x4f, 0x62, 0xaa, 0x1a, 0xfe, 0x24, 0x9a, 0xdc, 0x0f, 0x66, 0xa3, 0x44, 0x41, 0x94,
-		0x58, 0x20, 0x3c, 0xc9, 0x66, 0x91, 0x86, 0x8e, 0x9a, 0x45, 0x3a, 0x0b, 0x10, 0x06, 0x55, 0x96,
-		0x49, 0x83, 0xd0, 0xb2, 0x36, 0xc6, 0x47, 0x1a, 0x66, 0x26, 0x54, 0x1f, 0x39, 0x5a, 0xa8, 0xfe,
-		0x31, 0xaf, 0xdd, 0xf4, 0xa3, 0x66, 0x8a, 0x65, 0xb4, 0x10, 0xcb, 0x0c, 0x01, 0x8b, 0xfc, 0x5f,
-		0x8a, 0xeb, 0x2a, 0x8c, 0x84, 0x21, 0xf7, 0xd8, 0x00, 0x21, 0x77, 0xb8, 0x38, 0x9e, 0x2e, 0x80,
-		0x64, 0xba, 0xe0, 0x36, 0x4c, 0xb0, 0xca, 0x12, 0x6f, 0xb3, 0x1e, 0x1f, 0xa0, 0xcd, 0x7a, 0x9c,
-		0x16, 0x9c, 0x78, 0x87, 0xf5, 0xbb, 0x40, 0xbb, 0xa4, 0xf9, 0x67, 0x05, 0xba, 0x65, 0x62, 0x27,
-		0xb0, 0x82, 0x1e, 0xcd, 0xe5, 0x8d, 0x69, 0x88, 0xcc, 0x7d, 0x42, 0xa7, 0x1a, 0x7c, 0x06, 0x3d,
-		0x86, 0xa9, 0x94, 0x69, 0xe0, 0x79, 0xbb, 0xf3, 0x03, 0x19, 0x05, 0xad, 0x92, 0x34, 0x08, 0xea,
-		0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x2a, 0xb0, 0x14, 0xf6, 0xad, 0x7d, 0x45, 0x5c,
-		0x38, 0xf5, 0x4f, 0x14, 0x38, 0x23, 0xa6, 0x89, 0x47, 0x37, 0x57, 0x60, 0xbe, 0xcd, 0xc6, 0x59,
-		0x55, 0x45, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7, 0xf0, 0x74, 0x3b, 0x06, 0xd5, 0x70,
-		0x36, 0xc9, 0x14, 0xba, 0x01, 0x8b, 0x19, 0x20, 0xd3, 0x08, 0x8c, 0x5d, 0xc3, 0x0f, 0xdb, 0x57,
-		0xe7, 0x93, 0x70, 0x5b, 0x7c, 0x56, 0x3d, 0x03, 0xb5, 0x90, 0x1e, 0xce, 0xcf, 0x8f, 0xdc, 0xa8,
-		0xf1, 0x48, 0xfd, 0x83, 0x52, 0x9f, 0x85, 0x89, 0x69, 0x4e, 0xed, 0x1a, 0x4c, 0x3b, 0xdd, 0xf6,
-		0x2e, 0xf6, 0x74, 0xb7, 0xa5, 0x53, 0x2b, 0xe5, 0x53, 0x3a, 0x87, 0xb4, 0x0a, 0x1b, 0x7f, 0xd2,
-		0xa2, 0xc6, 0xc7, 0x27, 0xcc, 0x0e, 0xad, 0x9a, 0x4f, 0x73, 0x07, 0x43, 0xda, 0x28, 0x37, 0x6b,
-		0x3e, 0x6a, 0xc0, 0x04, 0xbf, 0x09, 0x76, 0x54, 0x71, 0x8f, 0x66, 0x28, 0x0e, 0x2c, 0x99, 0x43,
-		0x4f, 0x4e, 0x9d, 0xbb, 0x71, 0xb3, 0x3f, 0x80, 0xae, 0xc2, 0x02, 0xdb, 0xa7, 0xe9, 0x3a, 0x81,
-		0xe7, 0xda, 0x36, 0xf6, 0x28, 0x4f, 0xba, 0xec, 0xa5, 0x18, 0xd3, 0xe6, 0xe8, 0xf4, 0x66, 0x34,
-		0xcb, 0xec, 0x22, 0xd5, 0x10, 0xd3, 0xf4, 0xb0, 0xef, 0xf3, 0x8c, 0x63, 0xf8, 0x53, 0xad, 0xc3,
-		0x0c, 0xab, 0x4b, 0x11, 0xb8, 0x50, 0x76, 0xe2, 0x46, 0x5a, 0x49, 0x18, 0x69, 0x75, 0x16, 0x50,
-		0x7c, 0x3d, 0x17, 0xc6, 0xff, 0x54, 0x60, 0x86, 0x79, 0xe7, 0x71, 0x37, 0x30, 0x1f, 0x0d, 0xba,
-		0xc5, 0x6b, 0xb8, 0x51, 0xc9, 0xba, 0xb2, 0x71, 0x2e, 0x87, 0x21, 0x04, 0x23, 0x4d, 0x8b, 0xd1,
-		0x2a, 0x2e, 0x4d, 0x89, 0xc5, 0x92, 0xab, 0xe5, 0x44, 0x72, 0x75, 0x13, 0xa6, 0x0e, 0x2c, 0xdf,
-		0xda, 0xb5, 0x6c, 0x2b, 0xe8, 0x31, 0x4b, 0x54, 0x9c, 0x0f, 0xac, 0xf4, 0x41, 0xa8, 0x19, 0x5a,
-		0x85, 0x09, 0xfe, 0x84, 0xe9, 0x8e, 0xc1, 0x2d, 0xee, 0x98, 0x36, 0xce, 0xc7, 0x1e, 0x1b, 0x6d,
-		0x4c, 0xb8, 0x10, 0x3f, 0x2e, 0xe7, 0xc2, 0x8f, 0x28, 0x17, 0x7c, 0x1c, 0x3c, 0xeb, 0xe2, 0x2e,
-		0x1e, 0x80, 0x0b, 0xe9, 0x9d, 0x4a, 0x99, 0x9d, 0x92, 0x8c, 0x2a, 0x1f, 0x91, 0x51, 0x8c, 0xce,
-		0x3e, 0x41, 0x9c, 0xce, 0x9f, 0x28, 0x30, 0x1b, 0xca, 0xfd, 0x57, 0x86, 0xd4, 0x27, 0x30, 0x97,
-		0xa2, 0x89, 0x6b, 0xe1, 0x55, 0x58, 0xe8, 0x78, 0x6e, 0x13, 0xfb, 0xbe, 0xe5, 0xec, 0xe9, 0xf4,
-		0x8b, 0x2b, 0x66, 0x07, 0x88, 0x32, 0x96, 0x89, 0xcc, 0xf7, 0xa7, 0x29, 0x24, 0x35, 0x02, 0xbe,
-		0xfa, 0x7d, 0x05, 0xce, 0x3e, 0xc0, 0x81, 0xd6, 0xff, 0xfe, 0xea, 0x11, 0xf6, 0x7d, 0x63, 0x0f,
-		0x47, 0x2e, 0xcb, 0x6d, 0x18, 0xa6, 0xe5, 0x1b, 0x86, 0x68, 0x7c, 0xe3, 0x42, 0x0e, 0xb5, 0x31,
-		0x14, 0xb4, 0xb6, 0xa3, 0x71, 0xb0, 0x01, 0x98, 0x42, 0x6c, 0xcc, 0x72, 0x1e, 0x15, 0xfc, 0x80,
-		0x2f, 0xa1, 0xc2, 0xb8, 0xde, 0xe6, 0x33, 0x9c, 0x9c, 0x8f, 0x73, 0xb3, 0x8f, 0x72, 0x84, 0x75,
-		0xaa, 0x9b, 0xe1, 0x28, 0xcb, 0x34, 0x4e, 0xfa, 0xf1, 0xb1, 0x9a, 0x0d, 0x28, 0xbb, 0x28, 0x9e,
-		0x4d, 0x1c, 0x62, 0xd9, 0xc4, 0xef, 0x24, 0xb3, 0x89, 0x17, 0x8b, 0x19, 0x14, 0x11, 0x13, 0xcb,
-		0x24, 0xb6, 0x61, 0xe5, 0x01, 0x0e, 0xb6, 0x1e, 0x3e, 0x93, 0xdc, 0x45, 0x03, 0x80, 0xa9, 0xb4,
-		0xd3, 0x72, 0x43, 0x06, 0x0c, 0xb0, 0x1d, 0x11, 0x24, 0x6a, 0x26, 0xa9, 0xe8, 0x91, 0xbf, 0x7c,
-		0xf5, 0x15, 0xac, 0x4a, 0xb6, 0xe3, 0x4c, 0xdf, 0x86, 0x99, 0xd8, 0x97, 0x79, 0xb4, 0x94, 0x18,
-		0x6e, 0xfb, 0xd6, 0x60, 0xdb, 0x6a, 0xd3, 0x5e, 0x72, 0xc0, 0x57, 0xff, 0x55, 0x81, 0x59, 0x0d,
-		0x1b, 0x9d, 0x8e, 0xcd, 0x42, 0x9e, 0xe8, 0x74, 0xf3, 0x30, 0xcc, 0x53, 0xf7, 0xec, 0x9d, 0xe3,
-		0xbf, 0xe4, 0xad, 0xfe, 0xe2, 0x47, 0xba, 0x7c, 0x52, 0x7f, 0xf4, 0x78, 0xc1, 0x85, 0xba, 0x00,
-		0x73, 0xa9, 0xa3, 0x71, 0x6b, 0xf2, 0x0b, 0x05, 0x96, 0x34, 0xdc, 0xf2, 0xb0, 0xbf, 0x1f, 0x55,
-		0x31, 0x08, 0x37, 0xbe, 0x82, 0x67, 0x27, 0x81, 0xbf, 0x98, 0x54, 0x7e, 0x96, 0x1b, 0xb0, 0xb0,
-		0xe9, 0x76, 0x1d, 0x22, 0x3c, 0x69, 0x01, 0x5d, 0x06, 0x68, 0xb9, 0x5e, 0x13, 0xdf, 0xc7, 0x41,
-		0x73, 0x9f, 0xa7, 0x64, 0x63, 0x23, 0xaa, 0x01, 0xd5, 0x2c, 0x28, 0x17, 0xb6, 0x7b, 0x30, 0x82,
-		0x9d, 0x80, 0x56, 0x62, 0x99, 0x88, 0xbd, 0x93, 0x23, 0x62, 0xdc, 0x0b, 0xd9, 0x7a, 0xf8, 0x8c,
-		0xe2, 0xe2, 0xd5, 0x56, 0x0e, 0xab, 0xfe, 0xa2, 0x04, 0xf3, 0x1a, 0x36, 0x4c, 0x01, 0x75, 0x1b,
-		0x70, 0x2a, 0xea, 0x6d, 0xa8, 0x6c, 0x2c, 0xe7, 0xf9, 0x16, 0x0f, 0x9f, 0x51, 0xab, 0x4b, 0xd7,
-		0xca, 0x42, 0xb1, 0x6c, 0x30, 0x57, 0x16, 0x05, 0x73, 0x3b, 0x50, 0xb5, 0x1c, 0xb2, 0xc2, 0x3a,
-		0xc0, 0x3a, 0x76, 0x22, 0x0b, 0x36, 0x60, 0x3f, 0xd8, 0x5c, 0x04, 0x7c, 0xcf, 0x09, 0x4d, 0x51,
-		0xc3, 0x24, 0x82, 0xd1, 0x21, 0x48, 0x7c, 0xeb, 0x33, 0xf6, 0xf8, 0x0e, 0x69, 0xa3, 0x64, 0x60,
-		0xdb, 0xfa, 0x0c, 0xa3, 0xb7, 0x60, 0x8a, 0x76, 0x35, 0xd0, 0x15, 0xac, 0xf8, 0x3e, 0x4c, 0x8b,
-		0xef, 0xb4, 0xd9, 0xe1, 0xa9, 0xb1, 0x87, 0x59, 0x2f, 0xde, 0xdf, 0x96, 0x60, 0x21, 0xc3, 0x2b,
-		0x7e, 0x1d, 0xc7, 0x61, 0x96, 0xd0, 0x5e, 0x94, 0x4e, 0x66, 0x2f, 0xd0, 0xf7, 0x60, 0x3e, 0x83,
-		0x34, 0x4c, 0x02, 0x1e, 0xd5, 0x00, 0xce, 0xa6, 0xb1, 0xd3, 0x1c, 0xa0, 0x80, 0x5d, 0xa7, 0x44,
-		0xec, 0xfa, 0xa5, 0x02, 0x0b, 0x4f, 0xbb, 0xde, 0x1e, 0xfe, 0x7a, 0xcb, 0x96, 0x5a, 0x83, 0x6a,
-		0xf6, 0x98, 0x5c, 0xf9, 0xbf, 0x28, 0xc1, 0xc2, 0x23, 0xfc, 0xb5, 0xe7, 0xc1, 0xff, 0x8e, 0x7e,
-		0xdd, 0x85, 0x6a, 0x96, 0x57, 0x5c, 0xbf, 0x04, 0x38, 0x14, 0x11, 0x8e, 0xcf, 0x15, 0x38, 0xf3,
-		0xd8, 0x0d, 0xac, 0x56, 0x8f, 0x84, 0xdb, 0xee, 0x01, 0xf6, 0x1e, 0x19, 0x24, 0x96, 0x8e, 0xb8,
-		0xfe, 0x3d, 0x98, 0x6f, 0xf1, 0x19, 0xbd, 0x4d, 0xa7, 0xf4, 0x84, 0xc3, 0x96, 0xa7, 0x1f, 0x49,
-		0x74, 0xcc, 0x67, 0x9b, 0x6d, 0x65, 0x07, 0x7d, 0xf5, 0x1c, 0x9c, 0xcd, 0xa1, 0x80, 0x0b, 0x85,
-		0x01, 0x4b, 0x0f, 0x70, 0xb0, 0xe9, 0xb9, 0xbe, 0xcf, 0x6f, 0x25, 0xf1, 0xb8, 0x25, 0x02, 0x3f,
-		0x25, 0x15, 0xf8, 0x9d, 0x87, 0x4a, 0x60, 0x78, 0x7b, 0x38, 0x88, 0x6e, 0x99, 0x3d, 0x73, 0x93,
-		0x6c, 0x94, 0xe3, 0x53, 0x7f, 0x55, 0x86, 0x33, 0xe2, 0x3d, 0x38, 0x3f, 0xdb, 0x04, 0x0f, 0x31,
-		0x0d, 0xbb, 0x3d, 0x16, 0x86, 0xf2, 0xe3, 0x3f, 0x90, 0x39, 0x88, 0xb9, 0xe8, 0xa8, 0xf3, 0xed,
-		0xdf, 0xed, 0x51, 0x07, 0x90, 0xbd, 0x30, 0x13, 0x41, 0x6c, 0x08, 0x7d, 0xae, 0xc0, 0x5c, 0x8b,
-		0x56, 0xbc, 0xf4, 0xa6, 0xd1, 0xf5, 0x71, 0x7f, 0x5b, 0x66, 0xef, 0x1e, 0x1d, 0x6f, 0x5b, 0x56,
-		0x44, 0xdb, 0x24, 0x18, 0x13, 0x9b, 0xa3, 0x56, 0x66, 0xa2, 0xd6, 0x81, 0x99, 0x0c, 0x95, 0x02,
-		0xf7, 0xf4, 0x5e, 0xd2, 0x3d, 0x5d, 0xcf, 0x11, 0x87, 0x34, 0x4d, 0xfc, 0xf2, 0xe2, 0x3e, 0x6a,
-		0xad, 0x03, 0x0b, 0x39, 0x04, 0x0a, 0xf6, 0xbd, 0x1d, 0xdf, 0xb7, 0x92, 0x9b, 0xee, 0x7d, 0x80,
-		0x83, 0x7e, 0xf5, 0x90, 0xe2, 0x8d, 0x7b, 0xc5, 0xff, 0xa1, 0xc0, 0x1a, 0xaf, 0xd7, 0x65, 0x98,
-		0x96, 0x29, 0x34, 0x48, 0x22, 0xb3, 0xc1, 0xa4, 0x0c, 0x3d, 0x67, 0x42, 0x14, 0x35, 0x56, 0x84,
-		0xb9, 0xea, 0xc1, 0x99, 0xc6, 0xdb, 0x29, 0x26, 0x83, 0xd8, 0x2f, 0x1f, 0xbd, 0x09, 0x93, 0x2d,
-		0xe2, 0x00, 0x3d, 0xc6, 0xcc, 0x97, 0xe2, 0xf5, 0xa5, 0xe4, 0xa0, 0xea, 0xc1, 0xdb, 0x03, 0x9c,
-		0x35, 0x72, 0x97, 0x86, 0x42, 0x7f, 0xfc, 0x78, 0xd7, 0x4a, 0xa1, 0xd5, 0xf7, 0xe9, 0x17, 0x61,
-		0xa1, 0x62, 0xd3, 0x47, 0x72, 0x80, 0xdc, 0x98, 0x1a, 0xd0, 0x4f, 0xaa, 0x92, 0x60, 0x91, 0xe3,
-		0x30, 0xd7, 0xaf, 0xab, 0x84, 0x89, 0x98, 0x2e, 0x6f, 0x94, 0x1a, 0xd2, 0xfa, 0x45, 0x97, 0x6d,
-		0x96, 0x85, 0xe9, 0x3a, 0x34, 0x2f, 0x1e, 0x7e, 0xb3, 0xc8, 0x53, 0x48, 0x2c, 0x3f, 0x34, 0xc9,
-		0x47, 0x59, 0x06, 0x69, 0xe3, 0x9f, 0x2f, 0x01, 0x70, 0xef, 0xef, 0xce, 0xd3, 0x06, 0xfa, 0x81,
-		0x02, 0xf3, 0xe2, 0x6f, 0xaf, 0xd1, 0xd5, 0xe3, 0xfd, 0xb3, 0x84, 0xda, 0xb5, 0x23, 0xc3, 0xf1,
-		0x53, 0xff, 0xb1, 0x02, 0x0b, 0x39, 0x1f, 0xe7, 0xa3, 0x6b, 0x45, 0x1f, 0xb6, 0xe7, 0x51, 0x73,
-		0xfd, 0xe8, 0x80, 0x9c, 0x9c, 0x9f, 0x2b, 0xb0, 0x52, 0xf4, 0x81, 0x3a, 0xfa, 0xce, 0x49, 0x3f,
-		0xb8, 0xaf, 0xdd, 0x39, 0x01, 0x06, 0x4e, 0x29, 0xb9, 0x44, 0xf1, 0xa7, 0xe7, 0x92, 0x4b, 0x94,
-		0x7e, 0xf2, 0x2e, 0xb9, 0xc4, 0x82, 0x6f, 0xdc, 0xff, 0x4c, 0x81, 0x5a, 0xfe, 0x07, 0xda, 0x28,
-		0xbf, 0xbf, 0xaa, 0xf0, 0xc3, 0xf5, 0xda, 0x07, 0xc7, 0x82, 0xe5, 0x74, 0xfd, 0x44, 0x81, 0xc5,
-		0xdc, 0xcf, 0xaf, 0xd1, 0x8d, 0x5c, 0xd4, 0x45, 0x5f, 0x7f, 0xd7, 0x6e, 0x1e, 0x07, 0x94, 0x13,
-		0xe5, 0xc0, 0x64, 0xe2, 0xbb, 0x5c, 0x74, 0x29, 0x17, 0x99, 0xe8, 0xf3, 0xdf, 0x5a, 0x7d, 0xd0,
-		0xe5, 0x7c, 0xbf, 0xcf, 0x15, 0x38, 0x2d, 0xf8, 0xb8, 0x15, 0x5d, 0x91, 0xdf, 0xb6, 0xf0, 0x73,
-		0xda, 0xda, 0x7b, 0x47, 0x03, 0xe2, 0x24, 0x04, 0x30, 0x95, 0xfa, 0x90, 0x14, 0xad, 0xcb, 0xde,
-		0x79, 0x41, 0xc9, 0xa1, 0xf6, 0xee, 0xe0, 0x00, 0x7c, 0xd7, 0x43, 0x98, 0x4e, 0x7f, 0x30, 0x85,
-		0xf2, 0xb1, 0xe4, 0x7c, 0x52, 0x56, 0xbb, 0x7c, 0x04, 0x88, 0x98, 0xd8, 0xe5, 0x76, 0x0e, 0x4a,
-		0xc4, 0xae, 0xe8, 0xa3, 0x8d, 0xda, 0x09, 0x1a, 0x15, 0xd1, 0x5f, 0x2a, 0x70, 0x46, 0xd6, 0x58,
-		0x88, 0x6e, 0x1d, 0xb3, 0x1f, 0x91, 0x91, 0xf6, 0xe1, 0x89, 0xba, 0x19, 0x39, 0xcb, 0x72, 0xba,
-		0xef, 0xa4, 0x2c, 0x93, 0xf7, 0xfe, 0x49, 0x59, 0x56, 0xd0, 0xec, 0x17, 0xbb, 0x47, 0x41, 0x6b,
-		0x73, 0xe1, 0x3d, 0xe6, 0x37, 0x95, 0x17, 0xde, 0xa3, 0xac, 0x93, 0x3a, 0x76, 0x8f, 0xc2, 0x06,
-		0xb8, 0xe2, 0x7b, 0x94, 0x35, 0xe1, 0x15, 0xdf, 0xa3, 0xb4, 0xeb, 0x2e, 0x7e, 0x8f, 0xd9, 0x1e,
-		0xb7, 0xe2, 0x7b, 0xcc, 0xed, 0xb0, 0x2b, 0xbe, 0xc7, 0xfc, 0x96, 0x3a, 0xf4, 0x17, 0x34, 0x89,
-		0x98, 0xdb, 0xbc, 0x86, 0x3e, 0x38, 0xd2, 0x99, 0x93, 0xed, 0x73, 0xb5, 0x5b, 0xc7, 0x03, 0x4e,
-		0x90, 0x96, 0xdb, 0xb9, 0x29, 0x25, 0xad, 0xa8, 0x77, 0x54, 0x4a, 0x5a, 0x71, 0xb3, 0xe8, 0x5f,
-		0x2b, 0xb0, 0x2c, 0x6f, 0xd9, 0x42, 0xdf, 0x96, 0x6c, 0x30, 0x40, 0xdf, 0x5a, 0xed, 0xf6, 0xb1,
-		0xe1, 0x39, 0x8d, 0x3f, 0x52, 0xa0, 0x9a, 0xd7, 0xb8, 0x87, 0xae, 0x4b, 0xb0, 0x4b, 0x3b, 0x14,
-		0x6b, 0x37, 0x8e, 0x01, 0xc9, 0x29, 0xfa, 0xbe, 0x02, 0xb3, 0xa2, 0xf6, 0x2f, 0x94, 0xff, 0x72,
-		0x4a, 0x9a, 0xdd, 0x6a, 0xef, 0x1f, 0x11, 0x8a, 0x53, 0xf1, 0x57, 0xf4, 0x7f, 0x24, 0x49, 0xba,
-		0x9f, 0xd0, 0x87, 0x05, 0xb2, 0x21, 0xef, 0x4d, 0xab, 0x7d, 0xfb, 0xb8, 0xe0, 0x9c, 0xc0, 0xcf,
-		0x60, 0x26, 0xd3, 0x08, 0x84, 0x2e, 0x4b, 0x90, 0x8a, 0xfb, 0xb3, 0x6a, 0x1b, 0x47, 0x01, 0xe9,
-		0x7b, 0x23, 0xa9, 0xd6, 0x1e, 0x89, 0x37, 0x22, 0x6e, 0x48, 0x92, 0x78, 0x23, 0x39, 0x5d, 0x43,
-		0xe8, 0x05, 0x4c, 0xc4, 0x5b, 0x2d, 0xd0, 0xb7, 0xa4, 0x18, 0x52, 0xbd, 0x45, 0xb5, 0x4b, 0x03,
-		0xae, 0x8e, 0x49, 0xa1, 0xa8, 0x57, 0x42, 0x22, 0x85, 0x92, 0x76, 0x0f, 0x89, 0x14, 0x4a, 0x1b,
-		0x32, 0x88, 0xe7, 0x29, 0x68, 0x81, 0x90, 0x78, 0x9e, 0xf9, 0xfd, 0x14, 0xb5, 0xf7, 0x8e, 0x06,
-		0x14, 0x7d, 0xf4, 0x01, 0xfd, 0x8e, 0x02, 0x74, 0x31, 0x17, 0x47, 0xa6, 0x4d, 0xa1, 0xf6, 0xce,
-		0x40, 0x6b, 0xfb, 0xdb, 0xf4, 0x4b, 0xf6, 0x92, 0x6d, 0x32, 0x6d, 0x0c, 0x92, 0x6d, 0xb2, 0x3d,
-		0x00, 0x6c, 0x9b, 0xb0, 0xe2, 0x2e, 0xdd, 0x26, 0xd5, 0x27, 0x20, 0xdd, 0x26, 0x5d, 0xc2, 0x27,
-		0x11, 0x4a, 0xa2, 0x5a, 0x2e, 0x89, 0x50, 0x44, 0x95, 0x7e, 0x49, 0x84, 0x22, 0x2e, 0xc2, 0xff,
-		0x80, 0xfd, 0x7b, 0x1d, 0x41, 0x45, 0x55, 0x12, 0xca, 0x4a, 0xab, 0xef, 0x92, 0x50, 0xb6, 0xa0,
-		0x5e, 0x4e, 0x1c, 0x98, 0xdc, 0x02, 0xaf, 0xc4, 0x81, 0x29, 0xaa, 0x41, 0x4b, 0x1c, 0x98, 0xe2,
-		0x7a, 0xb2, 0x03, 0x93, 0x89, 0xf2, 0xa8, 0xe4, 0x42, 0x44, 0x15, 0x62, 0xc9, 0x85, 0x08, 0xab,
-		0xae, 0xd4, 0x7c, 0x88, 0x4a, 0x99, 0x48, 0x16, 0xfe, 0xe5, 0x16, 0x69, 0x25, 0xe6, 0x43, 0x56,
-		0x2f, 0x25, 0xf1, 0x5b, 0xba, 0xe8, 0x29, 0x89, 0xdf, 0x72, 0x4a, 0xab, 0x92, 0xf8, 0x2d, 0xb7,
-		0xa2, 0x1a, 0xc0, 0x54, 0xaa, 0xba, 0x27, 0x79, 0x20, 0xc4, 0x35, 0x53, 0xc9, 0x03, 0x91, 0x57,
-		0x38, 0x24, 0xe1, 0x6a, 0xaa, 0x7a, 0x24, 0x0b, 0x57, 0xc5, 0xf5, 0x34, 0x59, 0xb8, 0x9a, 0x53,
-		0x9a, 0x22, 0x1b, 0xa7, 0xab, 0x2d, 0x92, 0x8d, 0x73, 0x8a, 0x58, 0x92, 0x8d, 0x73, 0x4b, 0x39,
-		0x7f, 0xa4, 0xc0, 0x9c, 0xb0, 0x40, 0x82, 0xf2, 0x25, 0x46, 0x56, 0xd2, 0xa9, 0x5d, 0x3d, 0x2a,
-		0x58, 0x4c, 0xde, 0x45, 0xe5, 0x05, 0x89, 0xbc, 0x4b, 0xea, 0x36, 0x12, 0x79, 0x97, 0x56, 0x62,
-		0xbe, 0x50, 0xa2, 0xef, 0x83, 0xf2, 0xf3, 0xd8, 0xe8, 0x4e, 0x51, 0xbc, 0x51, 0x98, 0xef, 0xaf,
-		0xdd, 0x3d, 0x09, 0x8a, 0x44, 0x4a, 0x27, 0x9e, 0xc8, 0x96, 0xa7, 0x74, 0x04, 0x99, 0x72, 0x79,
-		0x4a, 0x47, 0x94, 0x23, 0xbf, 0x7b, 0xe3, 0xb7, 0xaf, 0xed, 0x59, 0xc1, 0x7e, 0x77, 0xb7, 0xde,
-		0x74, 0xdb, 0xeb, 0x89, 0x7f, 0x62, 0x5d, 0xdf, 0xc3, 0x0e, 0xfb, 0x7f, 0xe5, 0xb1, 0x7f, 0x98,
-		0xfe, 0x01, 0xff, 0xf3, 0xe0, 0xf2, 0xee, 0x30, 0x9d, 0xbb, 0xf2, 0x3f, 0x01, 0x00, 0x00, 0xff,
-		0xff, 0x81, 0x6d, 0x60, 0xcc, 0x5c, 0x5d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x4d, 0x6f, 0x1c, 0x47,
+		0x76, 0x68, 0x52, 0xfc, 0x7a, 0xfc, 0x2e, 0xf1, 0x63, 0x34, 0xd4, 0x07, 0xd9, 0xb6, 0x6c, 0x5a,
+		0x5e, 0x0f, 0x2d, 0xda, 0x96, 0x65, 0xd9, 0x5e, 0xaf, 0x44, 0x4a, 0xf2, 0x38, 0x92, 0x2c, 0x35,
+		0x69, 0x29, 0x09, 0x12, 0xf7, 0x36, 0xbb, 0x6b, 0xc8, 0x0e, 0x7b, 0xba, 0x47, 0xdd, 0x3d, 0xa4,
+		0xc6, 0x87, 0xc0, 0xc1, 0x06, 0x01, 0x76, 0x11, 0x64, 0x37, 0x8b, 0x4d, 0x10, 0x20, 0x40, 0x80,
+		0x60, 0x03, 0x2c, 0xd6, 0x08, 0x72, 0x49, 0x6e, 0x49, 0x4e, 0xb9, 0x04, 0xc8, 0x2f, 0xc8, 0x29,
+		0x17, 0xef, 0x21, 0x01, 0x72, 0xca, 0x9e, 0x83, 0xa0, 0x3e, 0xfa, 0xbb, 0xba, 0x7a, 0x86, 0x0c,
+		0x22, 0xaf, 0xe3, 0x1b, 0xa7, 0xaa, 0xde, 0xab, 0x57, 0xaf, 0xde, 0x7b, 0xfd, 0xbe, 0xba, 0x09,
+		0x97, 0xbb, 0x7b, 0xd8, 0xdf, 0x30, 0x0d, 0x0b, 0xbb, 0x26, 0xde, 0x38, 0xb0, 0x83, 0xd0, 0xf3,
+		0x7b, 0x1b, 0x47, 0x57, 0x37, 0x02, 0xec, 0x1f, 0xd9, 0x26, 0x6e, 0x74, 0x7c, 0x2f, 0xf4, 0xd0,
+		0x32, 0x59, 0xd6, 0xe0, 0xcb, 0x1a, 0x7c, 0x59, 0xe3, 0xe8, 0x6a, 0xfd, 0xe2, 0xbe, 0xe7, 0xed,
+		0x3b, 0x78, 0x83, 0x2e, 0xdb, 0xeb, 0xb6, 0x36, 0xac, 0xae, 0x6f, 0x84, 0xb6, 0xe7, 0x32, 0xc0,
+		0xfa, 0xa5, 0xfc, 0x7c, 0x68, 0xb7, 0x71, 0x10, 0x1a, 0xed, 0x0e, 0x5f, 0x50, 0x40, 0x70, 0xec,
+		0x1b, 0x9d, 0x0e, 0xf6, 0x03, 0x3e, 0xbf, 0x9a, 0x21, 0xd0, 0xe8, 0xd8, 0x84, 0x38, 0xd3, 0x6b,
+		0xb7, 0xe3, 0x2d, 0xd6, 0x44, 0x2b, 0x22, 0x12, 0x39, 0x15, 0xa2, 0x25, 0x4f, 0xbb, 0x38, 0x5e,
+		0xa0, 0x8a, 0x16, 0x84, 0x46, 0x70, 0xe8, 0xd8, 0x41, 0x28, 0x5b, 0x73, 0xec, 0xf9, 0x87, 0x2d,
+		0xc7, 0x3b, 0xe6, 0x6b, 0xae, 0x88, 0xd6, 0x70, 0x56, 0xea, 0xb9, 0xb5, 0xeb, 0x55, 0x6b, 0xb1,
+		0xcf, 0x57, 0xbe, 0x90, 0x5d, 0x69, 0xb5, 0x6d, 0x97, 0x72, 0xc1, 0xe9, 0x06, 0x61, 0xd5, 0xa2,
+		0x2c, 0x23, 0xd6, 0xc4, 0x8b, 0x9e, 0x76, 0x71, 0x97, 0x5f, 0x75, 0xfd, 0x65, 0xf1, 0x12, 0x1f,
+		0x77, 0x1c, 0xdb, 0x4c, 0x5f, 0xed, 0x8b, 0x99, 0x85, 0xc1, 0x81, 0xe1, 0x63, 0xab, 0xb8, 0xe3,
+		0xe5, 0x92, 0x55, 0x59, 0x66, 0xa8, 0x5f, 0x8e, 0xc2, 0x85, 0x9d, 0xd0, 0xf0, 0xc3, 0x27, 0x7c,
+		0xfc, 0xf6, 0x33, 0x6c, 0x76, 0xc9, 0x6e, 0x1a, 0x7e, 0xda, 0xc5, 0x41, 0x88, 0xee, 0xc1, 0x98,
+		0xcf, 0xfe, 0xac, 0x29, 0xab, 0xca, 0xfa, 0xe4, 0xe6, 0x66, 0x23, 0x23, 0x94, 0x46, 0xc7, 0x6e,
+		0x1c, 0x5d, 0x6d, 0x48, 0x91, 0x68, 0x11, 0x0a, 0xb4, 0x02, 0x13, 0x96, 0xd7, 0x36, 0x6c, 0x57,
+		0xb7, 0xad, 0xda, 0xd0, 0xaa, 0xb2, 0x3e, 0xa1, 0x8d, 0xb3, 0x81, 0xa6, 0x85, 0x7e, 0x0b, 0x16,
+		0x3b, 0x86, 0x8f, 0xdd, 0x50, 0xc7, 0x11, 0x02, 0xdd, 0x76, 0x5b, 0x5e, 0x6d, 0x98, 0x6e, 0xbc,
+		0x2e, 0xdc, 0xf8, 0x21, 0x85, 0x88, 0x77, 0x6c, 0xba, 0x2d, 0x4f, 0x3b, 0xdb, 0x29, 0x0e, 0xa2,
+		0x1a, 0x8c, 0x19, 0x61, 0x88, 0xdb, 0x9d, 0xb0, 0x76, 0x66, 0x55, 0x59, 0x1f, 0xd1, 0xa2, 0x9f,
+		0x68, 0x0b, 0x66, 0xf1, 0xb3, 0x8e, 0xcd, 0x14, 0x48, 0x27, 0x9a, 0x52, 0x1b, 0xa1, 0x3b, 0xd6,
+		0x1b, 0x4c, 0x4b, 0x1a, 0x91, 0x96, 0x34, 0x76, 0x23, 0x35, 0xd2, 0x66, 0x12, 0x10, 0x32, 0x88,
+		0x5a, 0x70, 0xce, 0xf4, 0xdc, 0xd0, 0x76, 0xbb, 0x58, 0x37, 0x02, 0xdd, 0xc5, 0xc7, 0xba, 0xed,
+		0xda, 0xa1, 0x6d, 0x84, 0x9e, 0x5f, 0x1b, 0x5d, 0x55, 0xd6, 0x67, 0x36, 0x5f, 0x15, 0x1e, 0x60,
+		0x8b, 0x43, 0xdd, 0x0c, 0x1e, 0xe0, 0xe3, 0x66, 0x04, 0xa2, 0x2d, 0x99, 0xc2, 0x71, 0xd4, 0x84,
+		0xf9, 0x68, 0xc6, 0xd2, 0x5b, 0x86, 0xed, 0x74, 0x7d, 0x5c, 0x1b, 0xa3, 0xe4, 0x9e, 0x17, 0xe2,
+		0xbf, 0xc3, 0xd6, 0x68, 0x73, 0x31, 0x18, 0x1f, 0x41, 0x1a, 0x2c, 0x39, 0x46, 0x10, 0xea, 0xa6,
+		0xd7, 0xee, 0x38, 0x98, 0x1e, 0xde, 0xc7, 0x41, 0xd7, 0x09, 0x6b, 0xe3, 0x12, 0x7c, 0x0f, 0x8d,
+		0x9e, 0xe3, 0x19, 0x96, 0xb6, 0x40, 0x60, 0xb7, 0x62, 0x50, 0x8d, 0x42, 0xa2, 0x5f, 0x87, 0x95,
+		0x96, 0xed, 0x07, 0xa1, 0x6e, 0x61, 0xd3, 0x0e, 0x28, 0x3f, 0x8d, 0xe0, 0x50, 0xdf, 0x33, 0xcc,
+		0x43, 0xaf, 0xd5, 0xaa, 0x4d, 0x50, 0xc4, 0xe7, 0x0a, 0x7c, 0xdd, 0xe6, 0xe6, 0x4b, 0xab, 0x51,
+		0xe8, 0x6d, 0x0e, 0xbc, 0x6b, 0x04, 0x87, 0xb7, 0x18, 0x28, 0x3a, 0x82, 0xb9, 0x8e, 0xe1, 0x87,
+		0x36, 0xa5, 0xd3, 0xf4, 0xdc, 0x96, 0xbd, 0x5f, 0x83, 0xd5, 0xe1, 0xf5, 0xc9, 0xcd, 0x5f, 0x6b,
+		0x94, 0x98, 0x49, 0xb9, 0x54, 0x12, 0xd1, 0x61, 0xe8, 0xb6, 0x28, 0xb6, 0xdb, 0x6e, 0xe8, 0xf7,
+		0xb4, 0xd9, 0x4e, 0x76, 0xb4, 0x7e, 0x0b, 0x16, 0x44, 0x0b, 0xd1, 0x1c, 0x0c, 0x1f, 0xe2, 0x1e,
+		0x55, 0x8a, 0x09, 0x8d, 0xfc, 0x89, 0x16, 0x60, 0xe4, 0xc8, 0x70, 0xba, 0x98, 0x0b, 0x36, 0xfb,
+		0x71, 0x63, 0xe8, 0xba, 0xa2, 0xbe, 0x0d, 0x17, 0xcb, 0x48, 0x09, 0x3a, 0x9e, 0x1b, 0x60, 0xb4,
+		0x08, 0xa3, 0x7e, 0x97, 0x6a, 0x05, 0x43, 0x38, 0xe2, 0x77, 0xdd, 0xa6, 0xa5, 0xfe, 0xd5, 0x10,
+		0x5c, 0xdc, 0xb1, 0xf7, 0x5d, 0xc3, 0x29, 0x55, 0xd0, 0xfb, 0x79, 0x05, 0x7d, 0x43, 0xac, 0xa0,
+		0x52, 0x2c, 0x7d, 0x6a, 0x68, 0x0b, 0x56, 0xf0, 0xb3, 0x10, 0xfb, 0xae, 0xe1, 0xc4, 0x66, 0x35,
+		0x51, 0x56, 0xae, 0xa7, 0x2f, 0x09, 0xf7, 0x2f, 0xee, 0x7c, 0x2e, 0x42, 0x55, 0x98, 0x42, 0x0d,
+		0x38, 0x6b, 0x1e, 0xd8, 0x8e, 0x95, 0x6c, 0xe2, 0xb9, 0x4e, 0x8f, 0xea, 0xed, 0xb8, 0x36, 0x4f,
+		0xa7, 0x22, 0xa0, 0x8f, 0x5d, 0xa7, 0xa7, 0xae, 0xc1, 0xa5, 0xd2, 0xf3, 0x31, 0x06, 0xab, 0xbf,
+		0x18, 0x82, 0x97, 0xf9, 0x1a, 0x3b, 0x3c, 0x90, 0xdb, 0xbc, 0xc7, 0x79, 0x96, 0xbe, 0x27, 0x63,
+		0x69, 0x15, 0xba, 0x3e, 0x79, 0xfb, 0xb9, 0x22, 0x10, 0xf0, 0x61, 0x2a, 0xe0, 0x9f, 0x94, 0x0b,
+		0x78, 0x7f, 0x24, 0xfc, 0x1f, 0x8a, 0xfa, 0x4d, 0x58, 0xaf, 0x26, 0x4a, 0x2e, 0xf4, 0x3f, 0x50,
+		0xe0, 0x82, 0x86, 0x03, 0x7c, 0xea, 0x87, 0x92, 0x14, 0x49, 0x7f, 0xd7, 0x42, 0x54, 0xb7, 0x0c,
+		0x8d, 0xfc, 0x14, 0x5f, 0x0c, 0xc1, 0xda, 0x2e, 0xf6, 0xdb, 0xb6, 0x6b, 0x84, 0xb8, 0xf4, 0x24,
+		0x0f, 0xf3, 0x27, 0xb9, 0x26, 0x3c, 0x49, 0x25, 0xa2, 0x5f, 0x71, 0x05, 0x7e, 0x11, 0x54, 0xd9,
+		0x11, 0xb9, 0x0e, 0xff, 0x48, 0x81, 0xd5, 0x6d, 0x1c, 0x98, 0xbe, 0xbd, 0x57, 0xce, 0xd1, 0x8f,
+		0xf3, 0x1c, 0x7d, 0x4b, 0x78, 0x9c, 0x2a, 0x3c, 0x7d, 0x8a, 0xc7, 0x7f, 0x0f, 0xc3, 0x9a, 0x04,
+		0x15, 0x17, 0x11, 0x07, 0x96, 0x13, 0x97, 0x86, 0xa9, 0x36, 0x7f, 0xe0, 0x49, 0x6d, 0x76, 0x01,
+		0xe1, 0x56, 0x1a, 0x54, 0x5b, 0xc2, 0xc2, 0x71, 0xb4, 0x07, 0xcb, 0xc5, 0xbb, 0x65, 0x9e, 0xd4,
+		0x10, 0xdd, 0xed, 0x4a, 0x7f, 0xbb, 0x51, 0x5f, 0x6a, 0xf1, 0x58, 0x34, 0x8c, 0x9e, 0x00, 0xea,
+		0x60, 0xd7, 0xb2, 0xdd, 0x7d, 0xdd, 0x30, 0x43, 0xfb, 0xc8, 0x0e, 0x6d, 0x1c, 0x70, 0x73, 0x55,
+		0xe2, 0xa8, 0xb1, 0xe5, 0x37, 0xd9, 0xea, 0x1e, 0x45, 0x3e, 0xdf, 0xc9, 0x0c, 0xda, 0x38, 0x40,
+		0xbf, 0x01, 0x73, 0x11, 0x62, 0x2a, 0x26, 0x3e, 0x76, 0x6b, 0x67, 0x28, 0xda, 0x86, 0x0c, 0xed,
+		0x16, 0x59, 0x9b, 0xa5, 0x7c, 0xb6, 0x93, 0x9a, 0xf2, 0xb1, 0x8b, 0x76, 0x12, 0xd4, 0x91, 0x77,
+		0xc2, 0x1d, 0x3d, 0x29, 0xc5, 0x91, 0x33, 0x92, 0x41, 0x1a, 0x0d, 0xaa, 0xcf, 0x60, 0xe1, 0x11,
+		0x89, 0x68, 0x22, 0xee, 0x45, 0x62, 0xb8, 0x95, 0x17, 0xc3, 0x57, 0x84, 0x7b, 0x88, 0x60, 0xfb,
+		0x14, 0xbd, 0x9f, 0x2a, 0xb0, 0x98, 0x03, 0xe7, 0xe2, 0xf6, 0x01, 0x4c, 0xd1, 0x28, 0x2b, 0x72,
+		0xe7, 0x94, 0x3e, 0xdc, 0xb9, 0x49, 0x0a, 0xc1, 0xbd, 0xb8, 0x26, 0xcc, 0x44, 0x08, 0x7e, 0x07,
+		0x9b, 0x21, 0xb6, 0xb8, 0xe0, 0xa8, 0xe5, 0x67, 0xd0, 0xf8, 0x4a, 0x6d, 0xfa, 0x69, 0xfa, 0xa7,
+		0xfa, 0xfb, 0x0a, 0xd4, 0xa9, 0x01, 0xdd, 0x09, 0x6d, 0xf3, 0xb0, 0x47, 0x3c, 0xba, 0x7b, 0x76,
+		0x10, 0x46, 0x6c, 0x6a, 0xe6, 0xd9, 0xb4, 0x51, 0x6e, 0xc9, 0x85, 0x18, 0xfa, 0x64, 0xd6, 0x05,
+		0x58, 0x11, 0xe2, 0xe0, 0x96, 0xe5, 0xbf, 0x14, 0x58, 0xba, 0x8b, 0xc3, 0xfb, 0xdd, 0xd0, 0xd8,
+		0x73, 0xf0, 0x4e, 0x68, 0x84, 0x58, 0x13, 0xa1, 0x55, 0x72, 0xf6, 0xf4, 0x13, 0x40, 0x02, 0x33,
+		0x3a, 0x34, 0x90, 0x19, 0x9d, 0x2f, 0x68, 0x18, 0x7a, 0x03, 0x96, 0xf0, 0xb3, 0x0e, 0x65, 0xa0,
+		0xee, 0xe2, 0x67, 0xa1, 0x8e, 0x8f, 0x48, 0x58, 0x64, 0x5b, 0xd4, 0x42, 0x0f, 0x6b, 0x67, 0xa3,
+		0xd9, 0x07, 0xf8, 0x59, 0x78, 0x9b, 0xcc, 0x35, 0x2d, 0xf4, 0x3a, 0x2c, 0x98, 0x5d, 0x9f, 0xc6,
+		0x4f, 0x7b, 0xbe, 0xe1, 0x9a, 0x07, 0x7a, 0xe8, 0x1d, 0x52, 0xed, 0x51, 0xd6, 0xa7, 0x34, 0xc4,
+		0xe7, 0x6e, 0xd1, 0xa9, 0x5d, 0x32, 0xa3, 0xfe, 0x64, 0x02, 0x96, 0x0b, 0xa7, 0xe6, 0x32, 0x24,
+		0x3e, 0x99, 0x72, 0xda, 0x93, 0xdd, 0x81, 0xe9, 0x18, 0x6d, 0xd8, 0xeb, 0x60, 0xce, 0xab, 0x35,
+		0x29, 0xc6, 0xdd, 0x5e, 0x07, 0x6b, 0x53, 0xc7, 0xa9, 0x5f, 0x48, 0x85, 0x69, 0x11, 0x63, 0x26,
+		0xdd, 0x14, 0x43, 0x1e, 0xc3, 0xb9, 0x8e, 0x8f, 0x8f, 0x6c, 0xaf, 0x1b, 0xe8, 0x01, 0xf1, 0x44,
+		0xb0, 0x95, 0xac, 0x3f, 0x43, 0xf7, 0x5d, 0x29, 0x44, 0x22, 0x4d, 0x37, 0xbc, 0xf6, 0xe6, 0x63,
+		0xe2, 0xce, 0x68, 0x4b, 0x11, 0xf4, 0x0e, 0x03, 0x8e, 0xf0, 0xbe, 0x06, 0x67, 0x69, 0xdc, 0xc4,
+		0x02, 0x9d, 0x18, 0xe3, 0x08, 0xa5, 0x60, 0x8e, 0x4c, 0xdd, 0x21, 0x33, 0xd1, 0xf2, 0x1b, 0x30,
+		0x41, 0x63, 0x20, 0xc7, 0x0e, 0x42, 0x1a, 0x09, 0x4e, 0x6e, 0x5e, 0x10, 0x3f, 0xe4, 0x23, 0xa9,
+		0x1c, 0x0f, 0xf9, 0x5f, 0xe8, 0x2e, 0xcc, 0x05, 0x54, 0x62, 0xf5, 0x04, 0xc5, 0x58, 0x3f, 0x28,
+		0x66, 0x82, 0x8c, 0xa0, 0xa3, 0x37, 0x61, 0xc9, 0x74, 0x6c, 0x42, 0xa9, 0x63, 0xef, 0xf9, 0x86,
+		0xdf, 0xd3, 0x8f, 0xb0, 0x4f, 0x2d, 0xe0, 0x38, 0x15, 0xe9, 0x05, 0x36, 0x7b, 0x8f, 0x4d, 0x3e,
+		0x66, 0x73, 0x29, 0xa8, 0x16, 0x36, 0xc2, 0xae, 0x8f, 0x63, 0xa8, 0x89, 0x34, 0xd4, 0x1d, 0x36,
+		0x19, 0x41, 0x5d, 0x82, 0x49, 0x0e, 0x65, 0xb7, 0x3b, 0x4e, 0x0d, 0xe8, 0x52, 0x60, 0x43, 0xcd,
+		0x76, 0xc7, 0x41, 0x01, 0x5c, 0xc9, 0x9f, 0x4a, 0x0f, 0xcc, 0x03, 0x6c, 0x75, 0x1d, 0xac, 0x87,
+		0x1e, 0xbb, 0x2c, 0x1a, 0x88, 0x7b, 0xdd, 0xb0, 0x36, 0x59, 0x15, 0x33, 0xbe, 0x98, 0x3d, 0xeb,
+		0x0e, 0xc7, 0xb4, 0xeb, 0xd1, 0x7b, 0xdb, 0x65, 0x68, 0x88, 0x4b, 0xc2, 0xae, 0x8a, 0x38, 0xcf,
+		0xc9, 0x41, 0xa6, 0x68, 0x2e, 0x60, 0x9e, 0x4e, 0xed, 0x90, 0x99, 0xe8, 0x14, 0x65, 0xea, 0x34,
+		0x5d, 0xa6, 0x4e, 0xe8, 0x1e, 0xcc, 0xc4, 0xb2, 0x1d, 0x10, 0x65, 0xaa, 0xcd, 0xd0, 0xb8, 0xff,
+		0x72, 0xf6, 0xaa, 0x58, 0x32, 0x26, 0x2d, 0xdf, 0x4c, 0xf3, 0x62, 0xc5, 0xa0, 0x3f, 0x91, 0x09,
+		0x0b, 0x31, 0x36, 0xd3, 0xf1, 0x02, 0xcc, 0x71, 0xce, 0x52, 0x9c, 0x57, 0xfb, 0x74, 0x18, 0x08,
+		0x20, 0xc1, 0xd7, 0x0d, 0xb4, 0x58, 0x9f, 0xe3, 0x41, 0xa2, 0xe5, 0xf3, 0x9c, 0x11, 0x3a, 0x8b,
+		0x2a, 0xc8, 0x53, 0x7c, 0x4e, 0xf4, 0x4c, 0x4c, 0xa8, 0xe6, 0x0c, 0xfa, 0x30, 0x5a, 0xaf, 0xcd,
+		0x1d, 0xe5, 0x46, 0xd0, 0x7b, 0xb0, 0x62, 0x13, 0x9d, 0xcb, 0xdd, 0x31, 0x76, 0x89, 0x9d, 0xb1,
+		0x6a, 0xf3, 0xd4, 0x0d, 0x5c, 0xb6, 0x83, 0xac, 0x35, 0xbe, 0xcd, 0xa6, 0xd5, 0x5f, 0x2a, 0xb0,
+		0xfc, 0xd0, 0x73, 0x9c, 0xff, 0x67, 0xd6, 0xf8, 0x67, 0xe3, 0x50, 0x2b, 0x1e, 0xfb, 0x1b, 0x73,
+		0xfc, 0x8d, 0x39, 0xfe, 0x3a, 0x9a, 0xe3, 0x32, 0xfd, 0x98, 0x2a, 0x35, 0xaf, 0x42, 0x5b, 0x35,
+		0x7d, 0x6a, 0x5b, 0xf5, 0xab, 0x67, 0xb5, 0xd5, 0x7f, 0x1a, 0x82, 0x55, 0x0d, 0x9b, 0x9e, 0x6f,
+		0xa5, 0x13, 0xa5, 0x5c, 0x2d, 0x9e, 0xa7, 0xa5, 0xbc, 0x04, 0x93, 0xb1, 0xe0, 0xc4, 0x46, 0x00,
+		0xa2, 0xa1, 0xa6, 0x85, 0x96, 0x61, 0x8c, 0xca, 0x18, 0xd7, 0xf8, 0x61, 0x6d, 0x94, 0xfc, 0x6c,
+		0x5a, 0xe8, 0x02, 0x00, 0xf7, 0xe3, 0x23, 0xdd, 0x9d, 0xd0, 0x26, 0xf8, 0x48, 0xd3, 0x42, 0x1a,
+		0x4c, 0x75, 0x3c, 0xc7, 0xd1, 0xa3, 0x58, 0x61, 0x54, 0x12, 0x2b, 0x10, 0x1b, 0x7a, 0xc7, 0xf3,
+		0xd3, 0xac, 0x89, 0x62, 0x85, 0x49, 0x82, 0x84, 0xff, 0x50, 0xff, 0x6d, 0x0c, 0xd6, 0x24, 0x5c,
+		0xe4, 0x86, 0xb7, 0x60, 0x21, 0x95, 0x93, 0x59, 0x48, 0xa9, 0xf5, 0x1b, 0x3a, 0xb9, 0xf5, 0xfb,
+		0x16, 0xa0, 0x88, 0xbf, 0x56, 0xde, 0xfc, 0xce, 0xc5, 0x33, 0xd1, 0xea, 0x75, 0x62, 0xc0, 0x04,
+		0xa6, 0x77, 0x98, 0x58, 0xa8, 0x0c, 0xde, 0x82, 0x45, 0x1f, 0x29, 0x5a, 0xf4, 0x54, 0x49, 0x65,
+		0x34, 0x5b, 0x52, 0xb9, 0x0e, 0x35, 0x6e, 0x52, 0x92, 0x04, 0x44, 0xf4, 0xf4, 0x1f, 0xa3, 0x4f,
+		0xff, 0x25, 0x36, 0x1f, 0xcb, 0x0e, 0x7f, 0xf8, 0x23, 0x0d, 0xa6, 0xe3, 0xd2, 0x01, 0x4d, 0x59,
+		0xb0, 0x5a, 0xc4, 0x6b, 0x65, 0xda, 0xb8, 0xeb, 0x1b, 0x6e, 0x40, 0x4c, 0x59, 0x26, 0x4c, 0x9f,
+		0xb2, 0x52, 0xbf, 0xd0, 0xa7, 0x70, 0x5e, 0x90, 0x10, 0x49, 0x4c, 0xf8, 0x44, 0x3f, 0x26, 0xfc,
+		0x5c, 0x41, 0xdc, 0x63, 0x6b, 0x5e, 0xe2, 0x5a, 0x42, 0x99, 0x6b, 0xb9, 0x06, 0x53, 0x19, 0x9b,
+		0x37, 0x49, 0x6d, 0xde, 0xe4, 0x5e, 0xca, 0xd8, 0xdd, 0x84, 0x99, 0xe4, 0x5a, 0x69, 0x49, 0x6a,
+		0xaa, 0xb2, 0x24, 0x35, 0x1d, 0x43, 0xd0, 0x8a, 0xd4, 0xfb, 0x30, 0x15, 0xdd, 0x35, 0x45, 0x30,
+		0x5d, 0x89, 0x60, 0x92, 0xaf, 0xa7, 0xe0, 0x06, 0x8c, 0x91, 0x48, 0x9e, 0x18, 0xd9, 0x19, 0x9a,
+		0x7f, 0xb9, 0x5b, 0x9a, 0x85, 0xae, 0xd4, 0x22, 0x9a, 0x22, 0xb0, 0x71, 0xc0, 0xf2, 0xce, 0x11,
+		0xde, 0xfa, 0xa7, 0x30, 0x95, 0x9e, 0x10, 0xe4, 0x99, 0xaf, 0xa7, 0xf3, 0xcc, 0x65, 0xf9, 0x87,
+		0x48, 0xeb, 0x58, 0x1e, 0x22, 0x95, 0x8b, 0x4e, 0xec, 0x64, 0x94, 0x75, 0xfa, 0xc6, 0x4e, 0x16,
+		0xec, 0x64, 0x9a, 0x35, 0x42, 0x3b, 0xf9, 0xe5, 0x70, 0x64, 0x27, 0x85, 0x5c, 0xe4, 0x76, 0xf2,
+		0x23, 0x98, 0xcd, 0xd9, 0x21, 0xa9, 0xa5, 0x64, 0xcf, 0xdf, 0x1e, 0xb5, 0x24, 0xda, 0x4c, 0xd6,
+		0x4e, 0x15, 0x24, 0x77, 0x68, 0x30, 0xc9, 0x4d, 0x99, 0xa5, 0xe1, 0xac, 0x59, 0xfa, 0x14, 0x2e,
+		0x66, 0xb5, 0x4a, 0xf7, 0x5a, 0x7a, 0x78, 0x60, 0x07, 0x7a, 0xba, 0x34, 0x2c, 0xdf, 0xaa, 0x9e,
+		0xd1, 0xb2, 0x8f, 0x5b, 0xbb, 0x07, 0x76, 0x70, 0x93, 0xe3, 0x6f, 0xc2, 0xfc, 0x01, 0x36, 0xfc,
+		0x70, 0x0f, 0x1b, 0xa1, 0x6e, 0xe1, 0xd0, 0xb0, 0x9d, 0x80, 0xa7, 0x18, 0xe5, 0xd9, 0xb7, 0xb9,
+		0x18, 0x6c, 0x9b, 0x41, 0x15, 0x9f, 0x3b, 0xa3, 0x27, 0x7b, 0xee, 0xbc, 0x0c, 0xb3, 0x31, 0x1e,
+		0x26, 0xd6, 0xd4, 0x00, 0x4f, 0x68, 0xb1, 0xd7, 0xb3, 0x4d, 0x47, 0xd5, 0x3f, 0x55, 0xe0, 0x05,
+		0x76, 0x9b, 0x19, 0x4d, 0xe6, 0x15, 0xde, 0x44, 0x5f, 0xb4, 0x7c, 0xc6, 0xee, 0x7a, 0x59, 0xc6,
+		0xae, 0x0a, 0x55, 0x9f, 0xa9, 0xbb, 0xbf, 0x1d, 0x86, 0x17, 0xe5, 0xd8, 0xb8, 0x08, 0xe2, 0xe4,
+		0xe1, 0xe6, 0xf3, 0x31, 0x4e, 0xe2, 0x8d, 0x93, 0x9b, 0x2e, 0x6d, 0x36, 0xc8, 0x49, 0xfa, 0x4f,
+		0x15, 0xb8, 0x98, 0xe4, 0xbc, 0x89, 0x83, 0x6c, 0xd9, 0x41, 0xc7, 0x08, 0xcd, 0x03, 0xdd, 0xf1,
+		0x4c, 0xc3, 0x71, 0x7a, 0xb5, 0x21, 0x6a, 0x30, 0x3f, 0x95, 0xec, 0x5a, 0x7d, 0x9c, 0x46, 0x92,
+		0x14, 0xdf, 0xf5, 0xb6, 0xf9, 0x0e, 0xf7, 0xd8, 0x06, 0xcc, 0x8e, 0xae, 0x18, 0xe5, 0x2b, 0xea,
+		0xbf, 0x0b, 0xab, 0x55, 0x08, 0x04, 0xf6, 0x76, 0x3b, 0x6b, 0x6f, 0xc5, 0x29, 0xf7, 0xc8, 0x0c,
+		0x50, 0x5c, 0x11, 0x62, 0xfa, 0xd8, 0x4d, 0xd9, 0xde, 0x1f, 0x29, 0xc4, 0xf6, 0x16, 0x8e, 0x79,
+		0xc7, 0xb0, 0x9d, 0x44, 0x96, 0xfa, 0xac, 0xd5, 0x54, 0xe1, 0xe9, 0x53, 0x90, 0x5e, 0x20, 0x76,
+		0xac, 0x14, 0x13, 0xcf, 0x04, 0xff, 0x44, 0x01, 0xb5, 0x68, 0xed, 0x3e, 0x8c, 0xd4, 0x33, 0xa2,
+		0xfc, 0x51, 0x9e, 0xf2, 0xb7, 0x4b, 0x28, 0xaf, 0xc2, 0xd4, 0x27, 0xed, 0x0f, 0x89, 0x72, 0x4a,
+		0x70, 0x71, 0xd9, 0x7c, 0x05, 0xe6, 0x4c, 0xc3, 0x35, 0x71, 0xfc, 0x04, 0xc0, 0xec, 0x99, 0x36,
+		0xae, 0xcd, 0xb2, 0x71, 0x2d, 0x1a, 0x4e, 0xeb, 0x7b, 0x1a, 0xe7, 0x29, 0xf5, 0x5d, 0x86, 0xaa,
+		0xcf, 0xa3, 0xbe, 0x14, 0xab, 0x7b, 0x09, 0xb2, 0x54, 0x35, 0x50, 0xb0, 0xf0, 0x34, 0x12, 0x56,
+		0x8a, 0x67, 0x60, 0x09, 0x13, 0x61, 0xca, 0x48, 0x58, 0xf1, 0x80, 0xf4, 0x7e, 0x12, 0xca, 0xfb,
+		0x96, 0xb0, 0x2a, 0x4c, 0x7d, 0xd2, 0x7e, 0x59, 0x2c, 0x0e, 0x31, 0x2e, 0x4e, 0xfd, 0xdf, 0x29,
+		0x70, 0x49, 0xc3, 0x6d, 0xef, 0x08, 0xb3, 0x32, 0xff, 0x57, 0x25, 0x49, 0x97, 0x75, 0x8c, 0x86,
+		0x73, 0x8e, 0x91, 0xaa, 0x12, 0x59, 0x29, 0xa3, 0x9a, 0x1f, 0xed, 0xef, 0x87, 0xe0, 0x32, 0x3f,
+		0x02, 0x3b, 0x76, 0x69, 0x8d, 0x59, 0x7a, 0x40, 0x03, 0x66, 0xb2, 0x3a, 0xc8, 0x0f, 0x77, 0xa3,
+		0xe4, 0xfe, 0xfa, 0xd8, 0x50, 0x9b, 0xce, 0x68, 0x2f, 0xda, 0x83, 0xe5, 0xb8, 0x8c, 0x2f, 0xec,
+		0x95, 0x13, 0x57, 0x78, 0x6f, 0x73, 0x98, 0x5c, 0x85, 0x17, 0x8b, 0x86, 0x07, 0x2e, 0xe1, 0xaf,
+		0xc3, 0x4b, 0x55, 0x67, 0xe1, 0x7c, 0xfe, 0x47, 0x05, 0x56, 0xa2, 0xac, 0x90, 0x20, 0x4a, 0x7f,
+		0x2e, 0xe2, 0x73, 0x05, 0xe6, 0xed, 0x40, 0xcf, 0xb6, 0xae, 0x51, 0x5e, 0x8e, 0x6b, 0xb3, 0x76,
+		0x70, 0x27, 0xdd, 0x94, 0xa6, 0x5e, 0x84, 0xf3, 0x62, 0xf2, 0xf9, 0xf9, 0xbe, 0x1c, 0x22, 0x16,
+		0x8c, 0x18, 0xeb, 0x6c, 0x55, 0xba, 0x60, 0x5a, 0x9f, 0xc7, 0x41, 0xd7, 0x60, 0x8a, 0xf7, 0x25,
+		0x62, 0x2b, 0x95, 0xa8, 0x8d, 0xc7, 0x9a, 0x16, 0x7a, 0x02, 0x67, 0xcd, 0x88, 0xd4, 0xd4, 0xd6,
+		0x67, 0x06, 0xda, 0x1a, 0xc5, 0x28, 0x92, 0xbd, 0xef, 0xc1, 0x5c, 0xaa, 0xd7, 0x90, 0x05, 0x09,
+		0x23, 0xfd, 0x06, 0x09, 0xb3, 0x09, 0x28, 0x1d, 0x50, 0x5f, 0x26, 0xda, 0x2a, 0xe5, 0x32, 0xbf,
+		0x8f, 0x7f, 0x1f, 0x82, 0x9a, 0xc6, 0xfb, 0x68, 0x31, 0x85, 0x0d, 0x1e, 0x6f, 0x3e, 0xcf, 0x3b,
+		0xf8, 0x6d, 0x58, 0xcc, 0x66, 0x32, 0x7b, 0xba, 0x1d, 0xe2, 0x76, 0xd4, 0x3f, 0x91, 0xef, 0x14,
+		0xb0, 0xda, 0xb6, 0x5b, 0x48, 0x66, 0xf6, 0x9a, 0x21, 0x6e, 0x6b, 0x67, 0x8f, 0x0a, 0x63, 0x01,
+		0x7a, 0x0b, 0x46, 0x29, 0x6f, 0x03, 0x7e, 0x65, 0xe2, 0xc4, 0xc6, 0xb6, 0x11, 0x1a, 0xb7, 0x1c,
+		0x6f, 0x4f, 0xe3, 0x8b, 0xd1, 0x16, 0xcc, 0xb8, 0xf8, 0x58, 0xf7, 0xbb, 0xfc, 0x6a, 0xa2, 0xc8,
+		0xa5, 0x02, 0x7c, 0xca, 0xc5, 0xc7, 0x5a, 0x97, 0xdd, 0x49, 0xa0, 0xae, 0xc0, 0x39, 0x01, 0xab,
+		0xf9, 0x45, 0xfc, 0x40, 0x81, 0xa5, 0x9d, 0x9e, 0x6b, 0xee, 0x1c, 0x18, 0xbe, 0xc5, 0xf3, 0x9b,
+		0xfc, 0x1a, 0x2e, 0xc3, 0x4c, 0xe0, 0x75, 0x7d, 0x13, 0xeb, 0xbc, 0xbd, 0x9a, 0xdf, 0xc5, 0x34,
+		0x1b, 0xdd, 0x62, 0x83, 0xe8, 0x1c, 0x8c, 0x07, 0x04, 0x38, 0x7a, 0x80, 0x8d, 0x68, 0x63, 0xf4,
+		0x77, 0xd3, 0x42, 0x0d, 0x38, 0x43, 0x83, 0xc5, 0xe1, 0xca, 0x08, 0x8e, 0xae, 0x53, 0xcf, 0xc1,
+		0x72, 0x81, 0x16, 0x4e, 0xe7, 0x3f, 0x8f, 0xc0, 0x59, 0x32, 0x17, 0x3d, 0x08, 0x9f, 0xa7, 0xac,
+		0xd4, 0x60, 0x2c, 0xca, 0x27, 0x31, 0x55, 0x8d, 0x7e, 0x12, 0x4d, 0x4e, 0x82, 0xd9, 0x38, 0x51,
+		0x10, 0x27, 0x16, 0x08, 0x4f, 0x8a, 0x59, 0xa4, 0x91, 0x41, 0xb3, 0x48, 0x17, 0x00, 0xa2, 0xa0,
+		0xca, 0xb6, 0x68, 0x10, 0x3a, 0xac, 0x4d, 0xf0, 0x91, 0xa6, 0x55, 0x08, 0xd5, 0xc7, 0x06, 0x0b,
+		0xd5, 0x3f, 0xe2, 0xb5, 0x9b, 0x24, 0x6a, 0xa6, 0x58, 0xc6, 0x2b, 0xb1, 0xcc, 0x13, 0xb0, 0xd8,
+		0xff, 0xa5, 0xb8, 0xae, 0xc1, 0x58, 0x14, 0x72, 0x4f, 0xf4, 0x11, 0x72, 0x47, 0x8b, 0xd3, 0xe9,
+		0x02, 0xc8, 0xa6, 0x0b, 0x3e, 0x80, 0x29, 0x56, 0x59, 0xe2, 0x6d, 0xd6, 0x93, 0x7d, 0xb4, 0x59,
+		0x4f, 0xd2, 0x82, 0x13, 0xef, 0xb0, 0x7e, 0x1d, 0x68, 0x97, 0x34, 0x7f, 0xad, 0x40, 0xb7, 0x2d,
+		0xec, 0x86, 0x76, 0xd8, 0xa3, 0xb9, 0xbc, 0x09, 0x0d, 0x91, 0xb9, 0x27, 0x74, 0xaa, 0xc9, 0x67,
+		0xd0, 0x03, 0x98, 0xcd, 0x99, 0x06, 0x9e, 0xb7, 0xbb, 0xdc, 0x97, 0x51, 0xd0, 0x66, 0xb2, 0x06,
+		0x41, 0x5d, 0x82, 0x85, 0xac, 0x24, 0x73, 0x11, 0xff, 0x63, 0x05, 0x56, 0xa2, 0xbe, 0xb5, 0xaf,
+		0x88, 0x0b, 0xa7, 0xfe, 0x91, 0x02, 0xe7, 0xc5, 0x34, 0xf1, 0xe8, 0xe6, 0x0d, 0x58, 0x6a, 0xb3,
+		0x71, 0x56, 0x55, 0xd1, 0x6d, 0x57, 0x37, 0x0d, 0xf3, 0x00, 0x73, 0x0a, 0xcf, 0xb6, 0x53, 0x50,
+		0x4d, 0x77, 0x8b, 0x4c, 0xa1, 0x77, 0xe0, 0x5c, 0x01, 0xc8, 0x32, 0x42, 0x63, 0xcf, 0x08, 0xa2,
+		0xf6, 0xd5, 0xa5, 0x2c, 0xdc, 0x36, 0x9f, 0x55, 0xcf, 0x43, 0x3d, 0xa2, 0x87, 0xf3, 0xf3, 0x43,
+		0x2f, 0x6e, 0x3c, 0x52, 0x7f, 0x6f, 0x28, 0x61, 0x61, 0x66, 0x9a, 0x53, 0xbb, 0x0e, 0x73, 0x6e,
+		0xb7, 0xbd, 0x87, 0x7d, 0xdd, 0x6b, 0xe9, 0xd4, 0x4a, 0x05, 0x94, 0xce, 0x11, 0x6d, 0x86, 0x8d,
+		0x7f, 0xdc, 0xa2, 0xc6, 0x27, 0x20, 0xcc, 0x8e, 0xac, 0x5a, 0x40, 0x73, 0x07, 0x23, 0xda, 0x38,
+		0x37, 0x6b, 0x01, 0x6a, 0xc2, 0x14, 0xbf, 0x09, 0x76, 0x54, 0x71, 0x8f, 0x66, 0x24, 0x0e, 0x2c,
+		0x99, 0x43, 0x4f, 0x4e, 0x9d, 0xbb, 0x49, 0x2b, 0x19, 0x40, 0xd7, 0x60, 0x99, 0xed, 0x63, 0x7a,
+		0x6e, 0xe8, 0x7b, 0x8e, 0x83, 0x7d, 0xca, 0x93, 0x2e, 0x7b, 0x52, 0x4c, 0x68, 0x8b, 0x74, 0x7a,
+		0x2b, 0x9e, 0x65, 0x76, 0x91, 0x6a, 0x88, 0x65, 0xf9, 0x38, 0x08, 0x78, 0xc6, 0x31, 0xfa, 0xa9,
+		0x36, 0x60, 0x9e, 0xd5, 0xa5, 0x08, 0x5c, 0x24, 0x3b, 0x69, 0x23, 0xad, 0x64, 0x8c, 0xb4, 0xba,
+		0x00, 0x28, 0xbd, 0x9e, 0x0b, 0xe3, 0x7f, 0x2a, 0x30, 0xcf, 0xbc, 0xf3, 0xb4, 0x1b, 0x58, 0x8e,
+		0x06, 0xbd, 0xc7, 0x6b, 0xb8, 0x71, 0xc9, 0x7a, 0x66, 0xf3, 0x52, 0x09, 0x43, 0x08, 0x46, 0x9a,
+		0x16, 0xa3, 0x55, 0x5c, 0x9a, 0x12, 0x4b, 0x25, 0x57, 0x87, 0x33, 0xc9, 0xd5, 0x2d, 0x98, 0x3d,
+		0xb2, 0x03, 0x7b, 0xcf, 0x76, 0xec, 0xb0, 0xc7, 0x2c, 0x51, 0x75, 0x3e, 0x70, 0x26, 0x01, 0xa1,
+		0x66, 0x68, 0x0d, 0xa6, 0xf8, 0x23, 0x4c, 0x77, 0x0d, 0x6e, 0x71, 0x27, 0xb4, 0x49, 0x3e, 0xf6,
+		0xc0, 0x68, 0x63, 0xc2, 0x85, 0xf4, 0x71, 0x39, 0x17, 0x7e, 0x48, 0xb9, 0x10, 0xe0, 0xf0, 0x51,
+		0x17, 0x77, 0x71, 0x1f, 0x5c, 0xc8, 0xef, 0x34, 0x54, 0xd8, 0x29, 0xcb, 0xa8, 0xe1, 0x01, 0x19,
+		0xc5, 0xe8, 0x4c, 0x08, 0xe2, 0x74, 0xfe, 0x58, 0x81, 0x85, 0x48, 0xee, 0xbf, 0x32, 0xa4, 0x7e,
+		0x0c, 0x8b, 0x39, 0x9a, 0xb8, 0x16, 0x5e, 0x83, 0xe5, 0x8e, 0xef, 0x99, 0x38, 0x08, 0x6c, 0x77,
+		0x5f, 0xa7, 0x6f, 0x5c, 0x31, 0x3b, 0x40, 0x94, 0x71, 0x98, 0xc8, 0x7c, 0x32, 0x4d, 0x21, 0xa9,
+		0x11, 0x08, 0xd4, 0xef, 0x29, 0x70, 0xe1, 0x2e, 0x0e, 0xb5, 0xe4, 0xfd, 0xab, 0xfb, 0x38, 0x08,
+		0x8c, 0x7d, 0x1c, 0xbb, 0x2c, 0x1f, 0xc0, 0x28, 0x2d, 0xdf, 0x30, 0x44, 0x93, 0x9b, 0x2f, 0x97,
+		0x50, 0x9b, 0x42, 0x41, 0x6b, 0x3b, 0x1a, 0x07, 0xeb, 0x83, 0x29, 0xc4, 0xc6, 0x5c, 0x2c, 0xa3,
+		0x82, 0x1f, 0xf0, 0x29, 0xcc, 0x30, 0xae, 0xb7, 0xf9, 0x0c, 0x27, 0xe7, 0xa3, 0xd2, 0xec, 0xa3,
+		0x1c, 0x61, 0x83, 0xea, 0x66, 0x34, 0xca, 0x32, 0x8d, 0xd3, 0x41, 0x7a, 0xac, 0xee, 0x00, 0x2a,
+		0x2e, 0x4a, 0x67, 0x13, 0x47, 0x58, 0x36, 0xf1, 0x3b, 0xd9, 0x6c, 0xe2, 0x95, 0x6a, 0x06, 0xc5,
+		0xc4, 0xa4, 0x32, 0x89, 0x6d, 0x58, 0xbd, 0x8b, 0xc3, 0xed, 0x7b, 0x8f, 0x24, 0x77, 0xd1, 0x04,
+		0x60, 0x2a, 0xed, 0xb6, 0xbc, 0x88, 0x01, 0x7d, 0x6c, 0x47, 0x04, 0x89, 0x9a, 0x49, 0x2a, 0x7a,
+		0xe4, 0xaf, 0x40, 0x7d, 0x06, 0x6b, 0x92, 0xed, 0x38, 0xd3, 0x77, 0x60, 0x3e, 0xf5, 0x66, 0x1e,
+		0x2d, 0x25, 0x46, 0xdb, 0xbe, 0xd4, 0xdf, 0xb6, 0xda, 0x9c, 0x9f, 0x1d, 0x08, 0xd4, 0x7f, 0x55,
+		0x60, 0x41, 0xc3, 0x46, 0xa7, 0xe3, 0xb0, 0x90, 0x27, 0x3e, 0xdd, 0x12, 0x8c, 0xf2, 0xd4, 0x3d,
+		0x7b, 0xce, 0xf1, 0x5f, 0xf2, 0x56, 0x7f, 0xf1, 0x43, 0x7a, 0xf8, 0xb4, 0xfe, 0xe8, 0xc9, 0x82,
+		0x0b, 0x75, 0x19, 0x16, 0x73, 0x47, 0xe3, 0xd6, 0xe4, 0xe7, 0x0a, 0xac, 0x68, 0xb8, 0xe5, 0xe3,
+		0xe0, 0x20, 0xae, 0x62, 0x10, 0x6e, 0x7c, 0x05, 0xcf, 0x4e, 0x02, 0x7f, 0x31, 0xa9, 0x49, 0x66,
+		0xef, 0xa2, 0x86, 0x69, 0x11, 0xf8, 0xa6, 0x6f, 0x1e, 0xd8, 0x47, 0xd8, 0xca, 0xb7, 0x85, 0x3f,
+		0x0f, 0xbf, 0x6a, 0x0d, 0x2e, 0x95, 0x52, 0xc5, 0x29, 0x7f, 0x07, 0x96, 0xb7, 0xbc, 0xae, 0x4b,
+		0xc4, 0x3e, 0xaf, 0x5a, 0x17, 0x01, 0x5a, 0x9e, 0x6f, 0xe2, 0x3b, 0x38, 0x34, 0x0f, 0x78, 0x32,
+		0x39, 0x35, 0xa2, 0x1a, 0x50, 0x2b, 0x82, 0x72, 0x35, 0xb9, 0x0d, 0x63, 0xd8, 0x0d, 0x69, 0x0d,
+		0x99, 0x29, 0xc7, 0xab, 0x25, 0xca, 0xc1, 0xfd, 0xa7, 0xed, 0x7b, 0x8f, 0x28, 0x2e, 0x5e, 0x27,
+		0xe6, 0xb0, 0xea, 0xcf, 0x87, 0x60, 0x49, 0xc3, 0x86, 0x25, 0xa0, 0x6e, 0x13, 0xce, 0xc4, 0x5d,
+		0x19, 0x33, 0x9b, 0x17, 0xcb, 0xbc, 0xa2, 0x7b, 0x8f, 0xe8, 0xf3, 0x82, 0xae, 0x95, 0x05, 0x91,
+		0xc5, 0x30, 0x74, 0x58, 0x14, 0x86, 0xee, 0x42, 0xcd, 0x76, 0xc9, 0x0a, 0xfb, 0x08, 0xeb, 0xd8,
+		0x8d, 0x6d, 0x6f, 0x9f, 0x9d, 0x6c, 0x8b, 0x31, 0xf0, 0x6d, 0x37, 0x32, 0xa2, 0x4d, 0x8b, 0xc8,
+		0x46, 0x87, 0x20, 0x09, 0xec, 0xcf, 0x98, 0xdb, 0x30, 0xa2, 0x8d, 0x93, 0x81, 0x1d, 0xfb, 0x33,
+		0x8c, 0x5e, 0x82, 0x59, 0xda, 0x8f, 0x41, 0x57, 0xb0, 0xb6, 0x81, 0x51, 0xda, 0x36, 0x40, 0xdb,
+		0x34, 0x1e, 0x1a, 0xfb, 0x98, 0x75, 0x11, 0xfe, 0xf5, 0x10, 0x2c, 0x17, 0x78, 0xc5, 0xaf, 0xe3,
+		0x24, 0xcc, 0x12, 0x5a, 0xba, 0xa1, 0xd3, 0x59, 0x3a, 0xf4, 0x5d, 0x58, 0x2a, 0x20, 0x8d, 0xd2,
+		0x97, 0x83, 0x9a, 0xee, 0x85, 0x3c, 0x76, 0x9a, 0xbd, 0x14, 0xb0, 0xeb, 0x8c, 0x88, 0x5d, 0xbf,
+		0x50, 0x60, 0xf9, 0x61, 0xd7, 0xdf, 0xc7, 0x5f, 0x6f, 0xd9, 0x52, 0xeb, 0x50, 0x2b, 0x1e, 0x93,
+		0x2b, 0xff, 0x17, 0x43, 0xb0, 0x7c, 0x1f, 0x7f, 0xed, 0x79, 0xf0, 0xbf, 0xa3, 0x5f, 0xb7, 0xa0,
+		0x56, 0xe4, 0x15, 0xd7, 0x2f, 0x01, 0x0e, 0x45, 0x84, 0xe3, 0x73, 0x05, 0xce, 0x3f, 0xf0, 0x42,
+		0xbb, 0xd5, 0xbb, 0x63, 0xd8, 0x8e, 0x77, 0x84, 0xfd, 0xfb, 0x86, 0x7f, 0x88, 0xfd, 0x98, 0xeb,
+		0xdf, 0x85, 0xa5, 0x16, 0x9f, 0xd1, 0xdb, 0x74, 0x4a, 0xcf, 0xb8, 0x9a, 0x65, 0xfa, 0x91, 0x45,
+		0xc7, 0xbc, 0xcd, 0x85, 0x56, 0x71, 0x30, 0x50, 0x2f, 0xc1, 0x85, 0x12, 0x0a, 0xb8, 0x50, 0x18,
+		0xb0, 0x72, 0x17, 0x87, 0x5b, 0xbe, 0x17, 0x04, 0xfc, 0x56, 0x32, 0x8f, 0xe5, 0x4c, 0xc8, 0xaa,
+		0xe4, 0x42, 0xd6, 0xcb, 0x30, 0x13, 0x1a, 0xfe, 0x3e, 0x0e, 0xe3, 0x5b, 0x66, 0x0f, 0xe8, 0x69,
+		0x36, 0xca, 0xf1, 0xa9, 0xbf, 0x1c, 0x86, 0xf3, 0xe2, 0x3d, 0x38, 0x3f, 0xdb, 0x04, 0x0f, 0x31,
+		0x0d, 0x7b, 0x3d, 0x16, 0x40, 0xf3, 0xe3, 0xdf, 0x95, 0xb9, 0xb6, 0xa5, 0xe8, 0x68, 0xd8, 0x10,
+		0xdc, 0xea, 0x51, 0xd7, 0x95, 0x3d, 0x61, 0xa6, 0xc2, 0xd4, 0x10, 0xfa, 0x5c, 0x81, 0xc5, 0x16,
+		0xad, 0xd5, 0xe9, 0xa6, 0xd1, 0x0d, 0x70, 0xb2, 0x2d, 0xb3, 0x77, 0xf7, 0x4f, 0xb6, 0x2d, 0x2b,
+		0xff, 0x6d, 0x11, 0x8c, 0x99, 0xcd, 0x51, 0xab, 0x30, 0x51, 0xef, 0xc0, 0x7c, 0x81, 0x4a, 0x81,
+		0x63, 0x7d, 0x3b, 0xeb, 0x58, 0x6f, 0x94, 0x88, 0x43, 0x9e, 0x26, 0x7e, 0x79, 0x69, 0xef, 0xba,
+		0xde, 0x81, 0xe5, 0x12, 0x02, 0x05, 0xfb, 0x7e, 0x90, 0xde, 0x77, 0xa6, 0x34, 0x51, 0x7d, 0x17,
+		0x87, 0x49, 0xdd, 0x93, 0xe2, 0x4d, 0xfb, 0xf3, 0xff, 0xa1, 0xc0, 0x3a, 0xaf, 0x34, 0x16, 0x98,
+		0x56, 0x28, 0x91, 0x48, 0x62, 0xca, 0xfe, 0xa4, 0x0c, 0x3d, 0x66, 0x42, 0x14, 0xb7, 0x84, 0x44,
+		0x59, 0xf6, 0xfe, 0x99, 0xc6, 0x1b, 0x41, 0xa6, 0xc3, 0xd4, 0xaf, 0x00, 0xbd, 0x08, 0xd3, 0x2d,
+		0xe2, 0x00, 0x3d, 0xc0, 0xcc, 0x0b, 0xe4, 0x95, 0xb1, 0xec, 0xa0, 0xea, 0xc3, 0x2b, 0x7d, 0x9c,
+		0x35, 0x76, 0x97, 0x46, 0xa2, 0x48, 0xe2, 0x64, 0xd7, 0x4a, 0xa1, 0xd5, 0xb7, 0xe8, 0xbb, 0x6c,
+		0x91, 0x62, 0xd3, 0x87, 0x64, 0x1f, 0xde, 0xa7, 0x1a, 0xd2, 0x97, 0xc1, 0xb2, 0x60, 0xb1, 0xe3,
+		0xb0, 0x98, 0x54, 0x84, 0xa2, 0x14, 0x52, 0x97, 0xb7, 0x78, 0x8d, 0x68, 0x49, 0xb9, 0x68, 0x87,
+		0xe5, 0x8f, 0xba, 0x2e, 0xcd, 0xe8, 0x47, 0x6f, 0x5b, 0xf2, 0xe4, 0x17, 0xcb, 0x6c, 0x4d, 0xf3,
+		0x51, 0x96, 0xfb, 0x52, 0xff, 0x41, 0x81, 0x25, 0xcd, 0x08, 0xb1, 0x63, 0xb7, 0xed, 0xf0, 0x93,
+		0x8e, 0x95, 0xca, 0x41, 0x2e, 0xc1, 0xa8, 0x69, 0x38, 0x4e, 0x5c, 0x0b, 0xe0, 0xbf, 0xd0, 0x43,
+		0x18, 0xe9, 0x12, 0xc3, 0xcb, 0xd5, 0x52, 0xd2, 0xdc, 0x23, 0xc4, 0xdb, 0xf8, 0x84, 0x00, 0x33,
+		0x1d, 0x64, 0x88, 0xea, 0xd7, 0x01, 0x92, 0xc1, 0xaa, 0xd7, 0xdd, 0x95, 0xb4, 0x30, 0xff, 0x8d,
+		0x02, 0xcb, 0x85, 0x6d, 0x38, 0xd7, 0x9e, 0xc0, 0xd8, 0x31, 0xb6, 0xf7, 0x0f, 0xc2, 0xc8, 0x6c,
+		0xbf, 0xdf, 0x3f, 0xa5, 0xdc, 0x76, 0x3c, 0x61, 0xf0, 0xdc, 0x1f, 0xe6, 0xd8, 0xea, 0x37, 0x60,
+		0x2a, 0x3d, 0x31, 0x08, 0xc1, 0x9b, 0xff, 0xb2, 0x01, 0xc0, 0xbd, 0xed, 0x9b, 0x0f, 0x9b, 0xe8,
+		0xfb, 0x0a, 0x2c, 0x89, 0xdf, 0xd2, 0x47, 0xd7, 0x4e, 0xf6, 0x59, 0x8d, 0xfa, 0xdb, 0x03, 0xc3,
+		0x71, 0x7e, 0xfd, 0xa1, 0x02, 0xcb, 0x25, 0x9f, 0x71, 0x40, 0x6f, 0x57, 0x7d, 0x02, 0xa1, 0x8c,
+		0x9a, 0xeb, 0x83, 0x03, 0x72, 0x72, 0x7e, 0xa6, 0xc0, 0x6a, 0xd5, 0xa7, 0x0c, 0xd0, 0x77, 0x4e,
+		0xfb, 0x69, 0x86, 0xfa, 0xcd, 0x53, 0x60, 0xe0, 0x94, 0x92, 0x4b, 0x14, 0x7f, 0xa4, 0x40, 0x72,
+		0x89, 0xd2, 0x8f, 0x23, 0x48, 0x2e, 0xb1, 0xe2, 0x6b, 0x08, 0x7f, 0xa2, 0x40, 0xbd, 0xfc, 0x55,
+		0x7e, 0x54, 0xae, 0xac, 0x95, 0x9f, 0x38, 0xa8, 0xbf, 0x7b, 0x22, 0x58, 0x4e, 0xd7, 0x8f, 0x15,
+		0x38, 0x57, 0xfa, 0xa2, 0x3e, 0x7a, 0xa7, 0x14, 0x75, 0xd5, 0x77, 0x02, 0xea, 0x37, 0x4e, 0x02,
+		0xca, 0x89, 0x72, 0x61, 0x3a, 0xf3, 0x06, 0x37, 0x7a, 0xad, 0x14, 0x99, 0xe8, 0x45, 0xf1, 0x7a,
+		0xa3, 0xdf, 0xe5, 0x7c, 0xbf, 0xcf, 0x15, 0x38, 0x2b, 0x78, 0x0d, 0x1a, 0xbd, 0x21, 0xbf, 0x6d,
+		0xe1, 0x8b, 0xd7, 0xf5, 0x37, 0x07, 0x03, 0xe2, 0x24, 0x84, 0x30, 0x9b, 0x7b, 0xe5, 0x18, 0x6d,
+		0xc8, 0xfc, 0x2a, 0x41, 0x71, 0xaa, 0xfe, 0x7a, 0xff, 0x00, 0x7c, 0xd7, 0x63, 0x98, 0xcb, 0xbf,
+		0x5a, 0x87, 0xca, 0xb1, 0x94, 0xbc, 0x7c, 0x58, 0xbf, 0x3a, 0x00, 0x44, 0x4a, 0xec, 0x4a, 0x7b,
+		0x4c, 0x25, 0x62, 0x57, 0xf5, 0x7a, 0x4f, 0xfd, 0x14, 0x2d, 0xad, 0xe8, 0xcf, 0x15, 0x38, 0x2f,
+		0x6b, 0x41, 0x45, 0xef, 0x9d, 0xb0, 0x73, 0x95, 0x91, 0xf6, 0xfe, 0xa9, 0xfa, 0x5e, 0x39, 0xcb,
+		0x4a, 0xfa, 0x34, 0xa5, 0x2c, 0x93, 0x77, 0x89, 0x4a, 0x59, 0x56, 0xd1, 0x16, 0x9a, 0xba, 0x47,
+		0x41, 0x13, 0x7c, 0xe5, 0x3d, 0x96, 0xbf, 0x7e, 0x50, 0x79, 0x8f, 0xb2, 0x9e, 0xfb, 0xd4, 0x3d,
+		0x0a, 0x5b, 0x25, 0xab, 0xef, 0x51, 0xd6, 0xae, 0x59, 0x7d, 0x8f, 0xd2, 0xfe, 0xcc, 0xf4, 0x3d,
+		0x16, 0xbb, 0x21, 0xab, 0xef, 0xb1, 0xb4, 0x17, 0xb3, 0xfa, 0x1e, 0xcb, 0x9b, 0x2f, 0xd1, 0x9f,
+		0xd1, 0x74, 0x73, 0x69, 0x9b, 0x23, 0x7a, 0x77, 0xa0, 0x33, 0x67, 0x1b, 0x2d, 0xeb, 0xef, 0x9d,
+		0x0c, 0x38, 0x43, 0x5a, 0x69, 0x8f, 0xaf, 0x94, 0xb4, 0xaa, 0x2e, 0x63, 0x29, 0x69, 0xd5, 0x6d,
+		0xc5, 0x7f, 0x49, 0x13, 0xdb, 0xb2, 0xe6, 0x3e, 0xf4, 0x6d, 0xc9, 0x06, 0x7d, 0x74, 0x38, 0xd6,
+		0x3f, 0x38, 0x31, 0x3c, 0xa7, 0xf1, 0x87, 0x0a, 0xd4, 0xca, 0x5a, 0x3c, 0xd1, 0x75, 0x09, 0x76,
+		0x69, 0x2f, 0x6b, 0xfd, 0x9d, 0x13, 0x40, 0x72, 0x8a, 0xbe, 0xa7, 0xc0, 0x82, 0xa8, 0x51, 0x10,
+		0x95, 0x3f, 0x39, 0x25, 0x6d, 0x91, 0xf5, 0xb7, 0x06, 0x84, 0xe2, 0x54, 0xfc, 0x05, 0xfd, 0x9a,
+		0x96, 0xa4, 0x4f, 0x0e, 0xbd, 0x5f, 0x21, 0x1b, 0xf2, 0x2e, 0xc6, 0xfa, 0xb7, 0x4f, 0x0a, 0xce,
+		0x09, 0xfc, 0x0c, 0xe6, 0x0b, 0x2d, 0x63, 0xe8, 0xaa, 0x04, 0xa9, 0xb8, 0x93, 0xaf, 0xbe, 0x39,
+		0x08, 0x48, 0xe2, 0x8d, 0xe4, 0x9a, 0xc0, 0x24, 0xde, 0x88, 0xb8, 0x75, 0x4d, 0xe2, 0x8d, 0x94,
+		0xf4, 0x97, 0xa1, 0x43, 0x98, 0x4a, 0x37, 0xe5, 0xa0, 0x6f, 0x49, 0x31, 0xe4, 0xba, 0xd0, 0xea,
+		0xaf, 0xf5, 0xb9, 0x3a, 0x25, 0x85, 0xa2, 0xae, 0x1a, 0x89, 0x14, 0x4a, 0x1a, 0x83, 0x24, 0x52,
+		0x28, 0x6d, 0xdd, 0x21, 0x9e, 0xa7, 0xa0, 0x59, 0x46, 0xe2, 0x79, 0x96, 0x77, 0xde, 0xd4, 0xdf,
+		0x1c, 0x0c, 0x28, 0x7e, 0x3d, 0x08, 0x92, 0xde, 0x13, 0x74, 0xa5, 0x14, 0x47, 0xa1, 0xa1, 0xa5,
+		0xfe, 0x6a, 0x5f, 0x6b, 0x93, 0x6d, 0x92, 0xe6, 0x0e, 0xc9, 0x36, 0x85, 0x86, 0x17, 0xc9, 0x36,
+		0xc5, 0x6e, 0x11, 0xb6, 0x4d, 0xd4, 0x9b, 0x21, 0xdd, 0x26, 0xd7, 0x51, 0x22, 0xdd, 0x26, 0xdf,
+		0xec, 0x41, 0x22, 0x94, 0x4c, 0x5f, 0x85, 0x24, 0x42, 0x11, 0xf5, 0x84, 0x48, 0x22, 0x14, 0x71,
+		0xbb, 0xc6, 0xf7, 0xd9, 0x87, 0x98, 0x04, 0xb5, 0x77, 0x49, 0x28, 0x2b, 0xed, 0xd3, 0x90, 0x84,
+		0xb2, 0x15, 0x9d, 0x15, 0xc4, 0x81, 0x29, 0x6d, 0x05, 0x90, 0x38, 0x30, 0x55, 0xdd, 0x0a, 0x12,
+		0x07, 0xa6, 0xba, 0xf3, 0xc0, 0x85, 0xe9, 0x4c, 0x21, 0x5d, 0x72, 0x21, 0xa2, 0x5e, 0x02, 0xc9,
+		0x85, 0x08, 0xeb, 0xf3, 0xd4, 0x7c, 0x88, 0x8a, 0xde, 0x48, 0x16, 0xfe, 0x95, 0x96, 0xf3, 0x25,
+		0xe6, 0x43, 0x56, 0x59, 0xa7, 0xa9, 0xa1, 0x92, 0x1a, 0x36, 0x92, 0xa6, 0x2a, 0x24, 0xb5, 0xf8,
+		0xfa, 0xf5, 0xc1, 0x01, 0x93, 0x70, 0x32, 0x5f, 0xf3, 0x96, 0x84, 0x93, 0x25, 0x95, 0x75, 0x49,
+		0x38, 0x59, 0x5a, 0x50, 0x0f, 0x61, 0x36, 0x57, 0xdc, 0x95, 0x3c, 0xaf, 0xc4, 0x25, 0x73, 0xc9,
+		0xf3, 0xaa, 0xac, 0x6e, 0x4c, 0xa2, 0xe7, 0x5c, 0xf1, 0x50, 0x16, 0x3d, 0x8b, 0xcb, 0xa9, 0xb2,
+		0xe8, 0xb9, 0xa4, 0x32, 0x49, 0x36, 0xce, 0x17, 0xdb, 0x24, 0x1b, 0x97, 0xd4, 0x30, 0x25, 0x1b,
+		0x97, 0x56, 0xf2, 0xfe, 0x40, 0x81, 0x45, 0x61, 0x7d, 0x0c, 0x95, 0x0b, 0xb0, 0xac, 0xa2, 0x57,
+		0xbf, 0x36, 0x28, 0x58, 0x4a, 0xfd, 0x44, 0xd5, 0x25, 0x89, 0xfa, 0x49, 0xca, 0x76, 0x12, 0xf5,
+		0x93, 0x16, 0xe2, 0xbe, 0x50, 0xe2, 0x17, 0xdb, 0xca, 0xcb, 0x18, 0xe8, 0x66, 0x55, 0xf8, 0x53,
+		0x59, 0xee, 0xa9, 0xdf, 0x3a, 0x0d, 0x8a, 0x4c, 0x86, 0x29, 0x5d, 0xc7, 0x90, 0x67, 0x98, 0x04,
+		0x85, 0x12, 0x79, 0x86, 0x49, 0x58, 0x22, 0x21, 0x9a, 0x99, 0x4d, 0xe2, 0xcb, 0x34, 0x53, 0x58,
+		0x98, 0x90, 0x69, 0xa6, 0xb8, 0x3e, 0x70, 0xeb, 0x9d, 0xdf, 0x7c, 0x7b, 0xdf, 0x0e, 0x0f, 0xba,
+		0x7b, 0x0d, 0xd3, 0x6b, 0x6f, 0x64, 0xbe, 0xf9, 0xde, 0xd8, 0xc7, 0x2e, 0xfb, 0xbc, 0x7f, 0xea,
+		0xff, 0x0b, 0xbc, 0xcb, 0xff, 0x3c, 0xba, 0xba, 0x37, 0x4a, 0xe7, 0xde, 0xf8, 0x9f, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x4b, 0x68, 0x45, 0x78, 0x8b, 0x60, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -3895,70 +4005,76 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	},
 	// uber/cadence/api/v1/domain.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5d, 0x6f, 0xdb, 0x36,
-		0x14, 0x9d, 0xec, 0x26, 0x73, 0xae, 0x1c, 0xc7, 0x65, 0x9a, 0x46, 0xf1, 0x86, 0x45, 0x4d, 0x51,
-		0xc0, 0x2b, 0x30, 0xb9, 0xf1, 0x86, 0xad, 0xdd, 0xb0, 0x07, 0xc7, 0x52, 0x33, 0x0f, 0x59, 0x16,
-		0xc8, 0x6e, 0x1e, 0x96, 0x07, 0x81, 0x96, 0x68, 0x9b, 0xa8, 0x2c, 0x0a, 0x94, 0xe4, 0x34, 0x6f,
-		0xc3, 0x7e, 0xd6, 0x1e, 0xf7, 0x97, 0xf6, 0x07, 0x06, 0x51, 0x94, 0xe2, 0x0f, 0x21, 0xd9, 0x9b,
-		0x78, 0xef, 0x39, 0x87, 0x87, 0x97, 0x97, 0xa4, 0x40, 0x4f, 0xc6, 0x84, 0x77, 0x5c, 0xec, 0x91,
-		0xc0, 0x25, 0x1d, 0x1c, 0xd2, 0xce, 0xe2, 0xb4, 0xe3, 0xb1, 0x39, 0xa6, 0x81, 0x11, 0x72, 0x16,
-		0x33, 0xb4, 0x9f, 0x22, 0x0c, 0x89, 0x30, 0x70, 0x48, 0x8d, 0xc5, 0x69, 0xeb, 0xab, 0x29, 0x63,
-		0x53, 0x9f, 0x74, 0x04, 0x64, 0x9c, 0x4c, 0x3a, 0x5e, 0xc2, 0x71, 0x4c, 0x99, 0x24, 0xb5, 0x8e,
-		0xd7, 0xf3, 0x31, 0x9d, 0x93, 0x28, 0xc6, 0xf3, 0x50, 0x02, 0x4a, 0xe7, 0x75, 0xd9, 0x7c, 0x9e,
-		0x4b, 0x9c, 0xfc, 0x5b, 0x83, 0x6d, 0x53, 0x18, 0x41, 0x0d, 0xa8, 0x50, 0x4f, 0x53, 0x74, 0xa5,
-		0xbd, 0x63, 0x57, 0xa8, 0x87, 0x10, 0x3c, 0x09, 0xf0, 0x9c, 0x68, 0x15, 0x11, 0x11, 0xdf, 0xe8,
-		0x1d, 0x6c, 0x47, 0x31, 0x8e, 0x93, 0x48, 0xab, 0xea, 0x4a, 0xbb, 0xd1, 0x7d, 0x61, 0x94, 0xf8,
-		0x36, 0x32, 0xc1, 0xa1, 0x00, 0xda, 0x92, 0x80, 0x74, 0x50, 0x3d, 0x12, 0xb9, 0x9c, 0x86, 0xe9,
-		0x0a, 0xb4, 0x27, 0x42, 0x75, 0x39, 0x84, 0x8e, 0x41, 0x65, 0xb7, 0x01, 0xe1, 0x0e, 0x99, 0x63,
-		0xea, 0x6b, 0x5b, 0x02, 0x01, 0x22, 0x64, 0xa5, 0x11, 0xf4, 0x0e, 0x9e, 0x78, 0x38, 0xc6, 0xda,
-		0xb6, 0x5e, 0x6d, 0xab, 0xdd, 0x57, 0x0f, 0xcc, 0x6d, 0x98, 0x38, 0xc6, 0x56, 0x10, 0xf3, 0x3b,
-		0x5b, 0x50, 0xd0, 0x0c, 0x5e, 0xde, 0x32, 0xfe, 0x71, 0xe2, 0xb3, 0x5b, 0x87, 0x7c, 0x22, 0x6e,
-		0x92, 0xce, 0xe8, 0x70, 0x12, 0x93, 0x40, 0x7c, 0x85, 0x84, 0x53, 0xe6, 0x69, 0x9f, 0xeb, 0x4a,
-		0x5b, 0xed, 0x1e, 0x19, 0x59, 0x61, 0x8d, 0xbc, 0xb0, 0x86, 0x29, 0x0b, 0x6f, 0xeb, 0xb9, 0x8a,
-		0x95, 0x8b, 0xd8, 0xb9, 0xc6, 0x95, 0x90, 0x40, 0x7d, 0xa8, 0x8f, 0xb1, 0xe7, 0x8c, 0x69, 0x80,
-		0x39, 0x25, 0x91, 0x56, 0x13, 0x92, 0x7a, 0xa9, 0xd9, 0x33, 0xec, 0x9d, 0x49, 0x9c, 0xad, 0x8e,
-		0xef, 0x07, 0xe8, 0x06, 0x0e, 0x67, 0x34, 0x8a, 0x19, 0xbf, 0x73, 0x30, 0x77, 0x67, 0x74, 0x81,
-		0x7d, 0x47, 0x16, 0x7e, 0x47, 0x14, 0xfe, 0x65, 0xa9, 0x5e, 0x4f, 0x62, 0x65, 0xe9, 0x0f, 0xa4,
-		0xc6, 0x6a, 0x18, 0xbd, 0x81, 0x67, 0x1b, 0xe2, 0x09, 0xa7, 0x1a, 0x88, 0x82, 0xa3, 0x35, 0xd2,
-		0x07, 0x4e, 0x11, 0x86, 0xd6, 0x82, 0x46, 0x74, 0x4c, 0x7d, 0x1a, 0x6f, 0x3a, 0x52, 0xff, 0xbf,
-		0x23, 0xed, 0x5e, 0x66, 0xcd, 0xd4, 0xf7, 0x70, 0x58, 0x36, 0x45, 0xea, 0xab, 0x2e, 0x7c, 0x1d,
-		0x6c, 0x52, 0x53, 0x6b, 0x06, 0xec, 0x63, 0x37, 0xa6, 0x0b, 0xe2, 0xb8, 0x7e, 0x12, 0xc5, 0x84,
-		0x3b, 0xa2, 0x69, 0x77, 0x05, 0xe7, 0x69, 0x96, 0xea, 0x67, 0x99, 0xcb, 0xb4, 0x83, 0xaf, 0xa0,
-		0x26, 0x81, 0x91, 0xd6, 0x10, 0x7d, 0xf4, 0x5d, 0xa9, 0x71, 0xc9, 0xb1, 0x49, 0xe8, 0x53, 0x57,
-		0xec, 0x7d, 0x9f, 0x05, 0x13, 0x3a, 0xcd, 0x1b, 0xa1, 0x50, 0x41, 0x5f, 0x43, 0x73, 0x82, 0xa9,
-		0xcf, 0x16, 0x84, 0x3b, 0x0b, 0xc2, 0xa3, 0xb4, 0xbb, 0xf7, 0x74, 0xa5, 0x5d, 0xb5, 0xf7, 0xf2,
-		0xf8, 0x75, 0x16, 0x46, 0x6d, 0x68, 0xd2, 0xc8, 0x99, 0xfa, 0x6c, 0x8c, 0x7d, 0x27, 0x3b, 0xff,
-		0x5a, 0x53, 0x57, 0xda, 0x35, 0xbb, 0x41, 0xa3, 0x73, 0x11, 0x96, 0x87, 0xf1, 0x3d, 0xec, 0x16,
-		0xa2, 0x34, 0x98, 0x30, 0xed, 0xa9, 0x68, 0xa3, 0xf2, 0xf3, 0xf6, 0x5e, 0x22, 0x07, 0xc1, 0x84,
-		0xd9, 0xf5, 0xc9, 0xd2, 0x08, 0xdd, 0xa4, 0x33, 0x32, 0x5f, 0x78, 0x76, 0xa6, 0x9c, 0x25, 0x61,
-		0xa4, 0x21, 0x21, 0xf5, 0xa6, 0x54, 0x6a, 0x90, 0x83, 0xcf, 0x53, 0xec, 0xea, 0x92, 0xf7, 0xe8,
-		0x4a, 0x32, 0x6a, 0xfd, 0x00, 0x3b, 0xc5, 0x39, 0x43, 0x4d, 0xa8, 0x7e, 0x24, 0x77, 0xf2, 0xfe,
-		0x48, 0x3f, 0xd1, 0x33, 0xd8, 0x5a, 0x60, 0x3f, 0xc9, 0x6f, 0x90, 0x6c, 0xf0, 0x63, 0xe5, 0xad,
-		0x72, 0x62, 0xc2, 0xf1, 0x23, 0xf5, 0x45, 0x2f, 0xa0, 0xbe, 0xb2, 0xa1, 0x99, 0xae, 0xea, 0xde,
-		0x6f, 0xe5, 0xc9, 0xdf, 0x0a, 0xa8, 0x4b, 0x27, 0x08, 0xfd, 0x0a, 0xb5, 0xe2, 0xd4, 0x29, 0x62,
-		0x6b, 0x8d, 0xc7, 0x4e, 0x9d, 0x91, 0x7f, 0x64, 0x77, 0x45, 0xc1, 0x6f, 0x39, 0xb0, 0xbb, 0x92,
-		0x2a, 0x59, 0xde, 0xdb, 0xe5, 0xe5, 0xa9, 0xdd, 0x93, 0x07, 0xe7, 0xba, 0x13, 0x7b, 0xb3, 0x54,
-		0x82, 0xbf, 0x14, 0xd8, 0x5d, 0x49, 0xa2, 0xe7, 0xb0, 0xcd, 0x09, 0x8e, 0x58, 0x20, 0x27, 0x91,
-		0x23, 0xd4, 0x82, 0x1a, 0x0b, 0x09, 0xc7, 0x31, 0xe3, 0xb2, 0x92, 0xc5, 0x18, 0xfd, 0x0c, 0x75,
-		0x97, 0x13, 0x1c, 0x13, 0xcf, 0x49, 0xef, 0x7e, 0x71, 0x2b, 0xab, 0xdd, 0xd6, 0xc6, 0xfd, 0x35,
-		0xca, 0x1f, 0x06, 0x5b, 0x95, 0xf8, 0x34, 0x72, 0xf2, 0x4f, 0x05, 0xea, 0xcb, 0xcd, 0x53, 0xda,
-		0xcb, 0x4a, 0x79, 0x2f, 0x8f, 0x40, 0x2b, 0xa0, 0x51, 0x8c, 0x79, 0xec, 0x14, 0xaf, 0x8f, 0xac,
-		0xc8, 0x43, 0x36, 0x9e, 0xe7, 0xdc, 0x61, 0x4a, 0x2d, 0xe2, 0xe8, 0x1a, 0x8e, 0x0a, 0x55, 0xf2,
-		0x29, 0xa4, 0x9c, 0x2c, 0xc9, 0x3e, 0xbe, 0xba, 0xc3, 0x9c, 0x6c, 0x09, 0xee, 0xbd, 0x6e, 0x17,
-		0x0e, 0x5c, 0x36, 0x0f, 0x7d, 0x92, 0x96, 0x2a, 0x9a, 0x61, 0xee, 0x39, 0x2e, 0x4b, 0x82, 0x58,
-		0xbc, 0x43, 0x5b, 0xf6, 0x7e, 0x91, 0x1c, 0xa6, 0xb9, 0x7e, 0x9a, 0x42, 0xaf, 0xa0, 0x11, 0x92,
-		0xc0, 0xa3, 0xc1, 0x34, 0x63, 0x44, 0xda, 0x96, 0x5e, 0x6d, 0x6f, 0xd9, 0xbb, 0x32, 0x2a, 0xa0,
-		0xd1, 0xeb, 0x3f, 0x15, 0xa8, 0x2f, 0xbf, 0x78, 0xe8, 0x08, 0x0e, 0xcc, 0xdf, 0x7f, 0xeb, 0x0d,
-		0x2e, 0x9d, 0xe1, 0xa8, 0x37, 0xfa, 0x30, 0x74, 0x06, 0x97, 0xd7, 0xbd, 0x8b, 0x81, 0xd9, 0xfc,
-		0x0c, 0x7d, 0x09, 0xda, 0x6a, 0xca, 0xb6, 0xce, 0x07, 0xc3, 0x91, 0x65, 0x5b, 0x66, 0x53, 0xd9,
-		0xcc, 0x9a, 0xd6, 0x95, 0x6d, 0xf5, 0x7b, 0x23, 0xcb, 0x6c, 0x56, 0x36, 0x65, 0x4d, 0xeb, 0xc2,
-		0x4a, 0x53, 0xd5, 0xd7, 0x33, 0x68, 0xac, 0x5d, 0xa7, 0x5f, 0xc0, 0x61, 0xcf, 0xee, 0xff, 0x32,
-		0xb8, 0xee, 0x5d, 0x94, 0xba, 0x58, 0x4f, 0x9a, 0x83, 0x61, 0xef, 0xec, 0x42, 0xb8, 0x28, 0xa1,
-		0x5a, 0x97, 0x59, 0xb2, 0x72, 0x76, 0x03, 0x87, 0x2e, 0x9b, 0x97, 0xb5, 0xfa, 0x99, 0x9a, 0x15,
-		0xe1, 0x2a, 0xdd, 0x95, 0x2b, 0xe5, 0x8f, 0xd3, 0x29, 0x8d, 0x67, 0xc9, 0xd8, 0x70, 0xd9, 0xbc,
-		0xb3, 0xfc, 0x1b, 0xf2, 0x0d, 0xf5, 0xfc, 0xce, 0x94, 0x65, 0x3f, 0x2d, 0xf2, 0x9f, 0xe4, 0x27,
-		0x1c, 0xd2, 0xc5, 0xe9, 0x78, 0x5b, 0xc4, 0xbe, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x82,
-		0x68, 0x92, 0x2f, 0x09, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x73, 0xda, 0x46,
+		0x10, 0xae, 0xc0, 0x76, 0xed, 0x15, 0x10, 0x72, 0x8e, 0x83, 0x42, 0x33, 0x35, 0x21, 0x93, 0x19,
+		0x9a, 0x99, 0x8a, 0x98, 0x74, 0xda, 0xa4, 0x9d, 0x3e, 0x00, 0x52, 0x52, 0x3a, 0x8e, 0xe3, 0x11,
+		0xc4, 0x9d, 0x69, 0x1e, 0xd4, 0x43, 0x3a, 0xe0, 0x26, 0x42, 0xa7, 0x39, 0x09, 0x1c, 0xbf, 0x75,
+		0xfa, 0xb3, 0xf2, 0xd8, 0x5f, 0xd6, 0xd1, 0xe9, 0x04, 0x02, 0x34, 0x76, 0xdf, 0x74, 0xbb, 0xdf,
+		0x7e, 0xfb, 0xdd, 0xde, 0xee, 0x9d, 0xa0, 0xb1, 0x18, 0x13, 0xde, 0x76, 0xb0, 0x4b, 0x7c, 0x87,
+		0xb4, 0x71, 0x40, 0xdb, 0xcb, 0xb3, 0xb6, 0xcb, 0xe6, 0x98, 0xfa, 0x7a, 0xc0, 0x59, 0xc4, 0xd0,
+		0x71, 0x8c, 0xd0, 0x25, 0x42, 0xc7, 0x01, 0xd5, 0x97, 0x67, 0xf5, 0x6f, 0xa7, 0x8c, 0x4d, 0x3d,
+		0xd2, 0x16, 0x90, 0xf1, 0x62, 0xd2, 0x76, 0x17, 0x1c, 0x47, 0x94, 0xc9, 0xa0, 0xfa, 0xe9, 0xb6,
+		0x3f, 0xa2, 0x73, 0x12, 0x46, 0x78, 0x1e, 0x48, 0x40, 0x6e, 0x5e, 0x87, 0xcd, 0xe7, 0x29, 0x45,
+		0xf3, 0xcb, 0x11, 0x1c, 0x18, 0x42, 0x08, 0xaa, 0x40, 0x81, 0xba, 0x9a, 0xd2, 0x50, 0x5a, 0x47,
+		0x56, 0x81, 0xba, 0x08, 0xc1, 0x9e, 0x8f, 0xe7, 0x44, 0x2b, 0x08, 0x8b, 0xf8, 0x46, 0xaf, 0xe1,
+		0x20, 0x8c, 0x70, 0xb4, 0x08, 0xb5, 0x62, 0x43, 0x69, 0x55, 0x3a, 0x4f, 0xf4, 0x1c, 0xdd, 0x7a,
+		0x42, 0x38, 0x14, 0x40, 0x4b, 0x06, 0xa0, 0x06, 0xa8, 0x2e, 0x09, 0x1d, 0x4e, 0x83, 0x78, 0x07,
+		0xda, 0x9e, 0x60, 0xcd, 0x9a, 0xd0, 0x29, 0xa8, 0xec, 0xda, 0x27, 0xdc, 0x26, 0x73, 0x4c, 0x3d,
+		0x6d, 0x5f, 0x20, 0x40, 0x98, 0xcc, 0xd8, 0x82, 0x5e, 0xc3, 0x9e, 0x8b, 0x23, 0xac, 0x1d, 0x34,
+		0x8a, 0x2d, 0xb5, 0xf3, 0xec, 0x96, 0xdc, 0xba, 0x81, 0x23, 0x6c, 0xfa, 0x11, 0xbf, 0xb1, 0x44,
+		0x08, 0x9a, 0xc1, 0xd3, 0x6b, 0xc6, 0x3f, 0x4d, 0x3c, 0x76, 0x6d, 0x93, 0xcf, 0xc4, 0x59, 0xc4,
+		0x19, 0x6d, 0x4e, 0x22, 0xe2, 0x8b, 0xaf, 0x80, 0x70, 0xca, 0x5c, 0xed, 0xeb, 0x86, 0xd2, 0x52,
+		0x3b, 0x8f, 0xf4, 0xa4, 0xb0, 0x7a, 0x5a, 0x58, 0xdd, 0x90, 0x85, 0xb7, 0x1a, 0x29, 0x8b, 0x99,
+		0x92, 0x58, 0x29, 0xc7, 0xa5, 0xa0, 0x40, 0x7d, 0x28, 0x8d, 0xb1, 0x6b, 0x8f, 0xa9, 0x8f, 0x39,
+		0x25, 0xa1, 0x76, 0x28, 0x28, 0x1b, 0xb9, 0x62, 0x7b, 0xd8, 0xed, 0x49, 0x9c, 0xa5, 0x8e, 0xd7,
+		0x0b, 0xf4, 0x11, 0x6a, 0x33, 0x1a, 0x46, 0x8c, 0xdf, 0xd8, 0x98, 0x3b, 0x33, 0xba, 0xc4, 0x9e,
+		0x2d, 0x0b, 0x7f, 0x24, 0x0a, 0xff, 0x34, 0x97, 0xaf, 0x2b, 0xb1, 0xb2, 0xf4, 0x27, 0x92, 0x63,
+		0xd3, 0x8c, 0x5e, 0xc0, 0x83, 0x1d, 0xf2, 0x05, 0xa7, 0x1a, 0x88, 0x82, 0xa3, 0xad, 0xa0, 0x0f,
+		0x9c, 0x22, 0x0c, 0xf5, 0x25, 0x0d, 0xe9, 0x98, 0x7a, 0x34, 0xda, 0x55, 0xa4, 0xfe, 0x7f, 0x45,
+		0xda, 0x9a, 0x66, 0x4b, 0xd4, 0x8f, 0x50, 0xcb, 0x4b, 0x11, 0xeb, 0x2a, 0x09, 0x5d, 0x27, 0xbb,
+		0xa1, 0xb1, 0x34, 0x1d, 0x8e, 0xb1, 0x13, 0xd1, 0x25, 0xb1, 0x1d, 0x6f, 0x11, 0x46, 0x84, 0xdb,
+		0xa2, 0x69, 0xcb, 0x22, 0xe6, 0x7e, 0xe2, 0xea, 0x27, 0x9e, 0x8b, 0xb8, 0x83, 0x2f, 0xe1, 0x50,
+		0x02, 0x43, 0xad, 0x22, 0xfa, 0xe8, 0x87, 0x5c, 0xe1, 0x32, 0xc6, 0x22, 0x81, 0x47, 0x1d, 0x71,
+		0xf6, 0x7d, 0xe6, 0x4f, 0xe8, 0x34, 0x6d, 0x84, 0x15, 0x0b, 0xfa, 0x0e, 0xaa, 0x13, 0x4c, 0x3d,
+		0xb6, 0x24, 0xdc, 0x5e, 0x12, 0x1e, 0xc6, 0xdd, 0x7d, 0xaf, 0xa1, 0xb4, 0x8a, 0xd6, 0xbd, 0xd4,
+		0x7e, 0x95, 0x98, 0x51, 0x0b, 0xaa, 0x34, 0xb4, 0xa7, 0x1e, 0x1b, 0x63, 0xcf, 0x4e, 0xe6, 0x5f,
+		0xab, 0x36, 0x94, 0xd6, 0xa1, 0x55, 0xa1, 0xe1, 0x5b, 0x61, 0x96, 0xc3, 0xf8, 0x06, 0xca, 0x2b,
+		0x52, 0xea, 0x4f, 0x98, 0x76, 0x5f, 0xb4, 0x51, 0xfe, 0xbc, 0xbd, 0x91, 0xc8, 0x81, 0x3f, 0x61,
+		0x56, 0x69, 0x92, 0x59, 0xa1, 0x8f, 0x71, 0x46, 0xe6, 0x09, 0xcd, 0xf6, 0x94, 0xb3, 0x45, 0x10,
+		0x6a, 0x48, 0x50, 0xbd, 0xc8, 0xa5, 0x1a, 0xa4, 0xe0, 0xb7, 0x31, 0x76, 0x73, 0xcb, 0xf7, 0xe8,
+		0x86, 0x33, 0x44, 0x26, 0x94, 0x39, 0xf3, 0x48, 0xdc, 0xeb, 0x2e, 0xf5, 0xa7, 0xa1, 0x76, 0x2c,
+		0x0a, 0x9a, 0xdf, 0xeb, 0x16, 0xf3, 0x48, 0x2f, 0x01, 0x5a, 0x25, 0xbe, 0x5e, 0x84, 0xf5, 0x9f,
+		0xe0, 0x68, 0x35, 0xae, 0xa8, 0x0a, 0xc5, 0x4f, 0xe4, 0x46, 0x5e, 0x43, 0xf1, 0x27, 0x7a, 0x00,
+		0xfb, 0x4b, 0xec, 0x2d, 0xd2, 0x8b, 0x28, 0x59, 0xfc, 0x5c, 0x78, 0xa5, 0x34, 0x0d, 0x38, 0xbd,
+		0xe3, 0x98, 0xd0, 0x13, 0x28, 0x6d, 0xf4, 0x45, 0xc2, 0xab, 0x3a, 0xeb, 0x8e, 0x68, 0x7e, 0x51,
+		0x40, 0xcd, 0x0c, 0x22, 0xfa, 0x1d, 0x0e, 0x57, 0xc3, 0xab, 0x88, 0x0d, 0xe9, 0x77, 0x0d, 0xaf,
+		0x9e, 0x7e, 0x24, 0x57, 0xce, 0x2a, 0xbe, 0x6e, 0x43, 0x79, 0xc3, 0x95, 0xb3, 0xbd, 0x57, 0xd9,
+		0xed, 0xa9, 0x9d, 0xe6, 0xad, 0xb9, 0x6e, 0xc4, 0x11, 0x67, 0x4a, 0xf0, 0x8f, 0x02, 0xe5, 0x0d,
+		0x27, 0x7a, 0x08, 0x07, 0x9c, 0xe0, 0x90, 0xf9, 0x32, 0x89, 0x5c, 0xa1, 0x3a, 0x1c, 0xb2, 0x80,
+		0x70, 0x1c, 0x31, 0x2e, 0x2b, 0xb9, 0x5a, 0xa3, 0x5f, 0xa1, 0xe4, 0x70, 0x82, 0x23, 0xe2, 0xda,
+		0xf1, 0x13, 0x22, 0x2e, 0x77, 0xb5, 0x53, 0xdf, 0xb9, 0x06, 0x47, 0xe9, 0xfb, 0x62, 0xa9, 0x12,
+		0x1f, 0x5b, 0x9a, 0xff, 0x16, 0xa0, 0x94, 0xed, 0xc1, 0xdc, 0x91, 0x50, 0xf2, 0x47, 0x62, 0x04,
+		0xda, 0x0a, 0x1a, 0x46, 0x98, 0x47, 0xf6, 0xea, 0x11, 0x93, 0x15, 0xb9, 0x4d, 0xc6, 0xc3, 0x34,
+		0x76, 0x18, 0x87, 0xae, 0xec, 0xe8, 0x0a, 0x1e, 0xad, 0x58, 0xc9, 0xe7, 0x80, 0x72, 0x92, 0xa1,
+		0xbd, 0x7b, 0x77, 0xb5, 0x34, 0xd8, 0x14, 0xb1, 0x6b, 0xde, 0x0e, 0x9c, 0x38, 0x6c, 0x1e, 0x78,
+		0x24, 0x2e, 0x55, 0x38, 0xc3, 0xdc, 0xb5, 0x1d, 0xb6, 0xf0, 0x23, 0xf1, 0x9c, 0xed, 0x5b, 0xc7,
+		0x2b, 0xe7, 0x30, 0xf6, 0xf5, 0x63, 0x17, 0x7a, 0x06, 0x95, 0x80, 0x88, 0x56, 0x4f, 0x22, 0x42,
+		0x6d, 0xbf, 0x51, 0x6c, 0xed, 0x5b, 0x65, 0x69, 0x15, 0xd0, 0xb0, 0xf9, 0x17, 0xa8, 0x99, 0x11,
+		0x41, 0x8f, 0xe1, 0x28, 0xe0, 0xd4, 0x77, 0x68, 0x80, 0x3d, 0x79, 0x92, 0x6b, 0x03, 0x7a, 0x09,
+		0x7b, 0xf1, 0x08, 0x89, 0x0a, 0x55, 0x3a, 0xa7, 0xb7, 0xbc, 0x84, 0x31, 0xa7, 0x25, 0xc0, 0xcf,
+		0xff, 0x56, 0xa0, 0x94, 0x7d, 0x9a, 0xd1, 0x23, 0x38, 0x31, 0xde, 0xbf, 0xeb, 0x0e, 0x2e, 0xec,
+		0xe1, 0xa8, 0x3b, 0xfa, 0x30, 0xb4, 0x07, 0x17, 0x57, 0xdd, 0xf3, 0x81, 0x51, 0xfd, 0x0a, 0x3d,
+		0x06, 0x6d, 0xd3, 0x65, 0x99, 0x6f, 0x07, 0xc3, 0x91, 0x69, 0x99, 0x46, 0x55, 0xd9, 0xf5, 0x1a,
+		0xe6, 0xa5, 0x65, 0xf6, 0xbb, 0x23, 0xd3, 0xa8, 0x16, 0x76, 0x69, 0x0d, 0xf3, 0xdc, 0x8c, 0x5d,
+		0xc5, 0xe7, 0x33, 0xa8, 0x6c, 0xdd, 0xfb, 0xdf, 0x40, 0xad, 0x6b, 0xf5, 0x7f, 0x1b, 0x5c, 0x75,
+		0xcf, 0x73, 0x55, 0x6c, 0x3b, 0x8d, 0xc1, 0xb0, 0xdb, 0x3b, 0x17, 0x2a, 0x72, 0x42, 0xcd, 0x8b,
+		0xc4, 0x59, 0x78, 0x4e, 0x01, 0xd6, 0x05, 0x40, 0x35, 0x38, 0x96, 0x92, 0xac, 0xf7, 0xe7, 0x66,
+		0x26, 0xc3, 0x03, 0xa8, 0x66, 0x1d, 0x96, 0xd9, 0x8d, 0x99, 0x4f, 0xe0, 0x7e, 0xd6, 0xfa, 0x87,
+		0x35, 0x18, 0x99, 0xd5, 0xc2, 0xb6, 0xb9, 0x6b, 0xbc, 0x1b, 0x5c, 0x54, 0x8b, 0xbd, 0x8f, 0x50,
+		0x73, 0xd8, 0x3c, 0xef, 0x0c, 0x7a, 0x6a, 0xa2, 0xe1, 0x32, 0x6e, 0xb1, 0x4b, 0xe5, 0xcf, 0xb3,
+		0x29, 0x8d, 0x66, 0x8b, 0xb1, 0xee, 0xb0, 0x79, 0x3b, 0xfb, 0x6b, 0xf6, 0x3d, 0x75, 0xbd, 0xf6,
+		0x94, 0x25, 0x3f, 0x72, 0xf2, 0x3f, 0xed, 0x17, 0x1c, 0xd0, 0xe5, 0xd9, 0xf8, 0x40, 0xd8, 0x5e,
+		0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x97, 0xb6, 0xa5, 0x4e, 0x43, 0x0a, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -558,6 +558,8 @@ type AddDecisionTaskRequest struct {
 	Source                 v11.TaskSource        `protobuf:"varint,6,opt,name=source,proto3,enum=uber.cadence.shared.v1.TaskSource" json:"source,omitempty"`
 	ForwardedFrom          string                `protobuf:"bytes,7,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	PartitionConfig        map[string]string     `protobuf:"bytes,8,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Priority               int32                 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	WorkflowType           *v1.WorkflowType      `protobuf:"bytes,10,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
 	XXX_unrecognized       []byte                `json:"-"`
 	XXX_sizecache          int32                 `json:"-"`
@@ -652,6 +654,20 @@ func (m *AddDecisionTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddDecisionTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddDecisionTaskRequest) GetWorkflowType() *v1.WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
 type AddDecisionTaskResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -702,6 +718,8 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom            string                    `protobuf:"bytes,8,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	ActivityTaskDispatchInfo *ActivityTaskDispatchInfo `protobuf:"bytes,9,opt,name=activityTaskDispatchInfo,proto3" json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig          map[string]string         `protobuf:"bytes,10,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Priority                 int32                     `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	ActivityType             *v1.ActivityType          `protobuf:"bytes,12,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{}                  `json:"-"`
 	XXX_unrecognized         []byte                    `json:"-"`
 	XXX_sizecache            int32                     `json:"-"`
@@ -810,6 +828,20 @@ func (m *AddActivityTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddActivityTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddActivityTaskRequest) GetActivityType() *v1.ActivityType {
+	if m != nil {
+		return m.ActivityType
+	}
+	return nil
+}
+
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent             *v1.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
 	StartedTime                *types.Timestamp `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
@@ -1557,6 +1589,179 @@ func (m *GetTaskListsByDomainRequest) GetDomain() string {
 	return ""
 }
 
+type TaskListPartitionConfig struct {
+	Version              int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	NumReadPartitions    int32    `protobuf:"varint,2,opt,name=num_read_partitions,json=numReadPartitions,proto3" json:"num_read_partitions,omitempty"`
+	NumWritePartitions   int32    `protobuf:"varint,3,opt,name=num_write_partitions,json=numWritePartitions,proto3" json:"num_write_partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskListPartitionConfig) Reset()         { *m = TaskListPartitionConfig{} }
+func (m *TaskListPartitionConfig) String() string { return proto.CompactTextString(m) }
+func (*TaskListPartitionConfig) ProtoMessage()    {}
+func (*TaskListPartitionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{20}
+}
+func (m *TaskListPartitionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListPartitionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListPartitionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskListPartitionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListPartitionConfig.Merge(m, src)
+}
+func (m *TaskListPartitionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListPartitionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListPartitionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListPartitionConfig proto.InternalMessageInfo
+
+func (m *TaskListPartitionConfig) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TaskListPartitionConfig) GetNumReadPartitions() int32 {
+	if m != nil {
+		return m.NumReadPartitions
+	}
+	return 0
+}
+
+func (m *TaskListPartitionConfig) GetNumWritePartitions() int32 {
+	if m != nil {
+		return m.NumWritePartitions
+	}
+	return 0
+}
+
+type GetTaskListPartitionConfigRequest struct {
+	DomainId             string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetTaskListPartitionConfigRequest) Reset()         { *m = GetTaskListPartitionConfigRequest{} }
+func (m *GetTaskListPartitionConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTaskListPartitionConfigRequest) ProtoMessage()    {}
+func (*GetTaskListPartitionConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{21}
+}
+func (m *GetTaskListPartitionConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskListPartitionConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskListPartitionConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTaskListPartitionConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskListPartitionConfigRequest.Merge(m, src)
+}
+func (m *GetTaskListPartitionConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskListPartitionConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskListPartitionConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskListPartitionConfigRequest proto.InternalMessageInfo
+
+func (m *GetTaskListPartitionConfigRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *GetTaskListPartitionConfigRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *GetTaskListPartitionConfigRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+type GetTaskListPartitionConfigResponse struct {
+	PartitionConfig      *TaskListPartitionConfig `protobuf:"bytes,1,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetTaskListPartitionConfigResponse) Reset()         { *m = GetTaskListPartitionConfigResponse{} }
+func (m *GetTaskListPartitionConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTaskListPartitionConfigResponse) ProtoMessage()    {}
+func (*GetTaskListPartitionConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{22}
+}
+func (m *GetTaskListPartitionConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskListPartitionConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskListPartitionConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTaskListPartitionConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskListPartitionConfigResponse.Merge(m, src)
+}
+func (m *GetTaskListPartitionConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskListPartitionConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskListPartitionConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskListPartitionConfigResponse proto.InternalMessageInfo
+
+func (m *GetTaskListPartitionConfigResponse) GetPartitionConfig() *TaskListPartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
 type GetTaskListsByDomainResponse struct {
 	DecisionTaskListMap  map[string]*DescribeTaskListResponse `protobuf:"bytes,1,rep,name=decision_task_list_map,json=decisionTaskListMap,proto3" json:"decision_task_list_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ActivityTaskListMap  map[string]*DescribeTaskListResponse `protobuf:"bytes,2,rep,name=activity_task_list_map,json=activityTaskListMap,proto3" json:"activity_task_list_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -1569,7 +1774,7 @@ func (m *GetTaskListsByDomainResponse) Reset()         { *m = GetTaskListsByDoma
 func (m *GetTaskListsByDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainResponse) ProtoMessage()    {}
 func (*GetTaskListsByDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{20}
+	return fileDescriptor_826e827d3aabf7fc, []int{23}
 }
 func (m *GetTaskListsByDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1636,6 +1841,9 @@ func init() {
 	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsRequest")
 	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsResponse")
 	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainRequest")
+	proto.RegisterType((*TaskListPartitionConfig)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig")
+	proto.RegisterType((*GetTaskListPartitionConfigRequest)(nil), "uber.cadence.matching.v1.GetTaskListPartitionConfigRequest")
+	proto.RegisterType((*GetTaskListPartitionConfigResponse)(nil), "uber.cadence.matching.v1.GetTaskListPartitionConfigResponse")
 	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse")
 	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.ActivityTaskListMapEntry")
 	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.DecisionTaskListMapEntry")
@@ -1646,137 +1854,147 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2081 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0xc6, 0xea, 0x46, 0xf1, 0x50, 0xa2, 0xe4, 0xb1, 0xa3, 0xac, 0x28, 0xeb, 0x62, 0xa6, 0x49,
-	0xd4, 0x22, 0x5d, 0x45, 0x4a, 0xec, 0x3a, 0x0e, 0x8a, 0x42, 0xd6, 0xc5, 0x66, 0x51, 0xd7, 0xce,
-	0x5a, 0x75, 0x81, 0xa2, 0xf0, 0x62, 0xb4, 0x3b, 0x12, 0xb7, 0x22, 0x77, 0xd7, 0x3b, 0x43, 0x2a,
-	0xec, 0x43, 0x1f, 0x8a, 0xb4, 0x28, 0x90, 0xd7, 0xfe, 0x83, 0xe6, 0xb1, 0x3f, 0xa4, 0x8f, 0x7d,
-	0x2c, 0x10, 0x14, 0x28, 0x0c, 0xf4, 0x07, 0xb4, 0xbf, 0xa0, 0x98, 0xcb, 0x2e, 0x77, 0xc9, 0xe1,
-	0x4d, 0x72, 0x92, 0xbe, 0x71, 0x67, 0xce, 0xf9, 0xce, 0x99, 0x73, 0x9f, 0x91, 0xe0, 0xbd, 0xd6,
-	0x29, 0x89, 0x77, 0x5c, 0xec, 0x91, 0xc0, 0x25, 0x3b, 0x4d, 0xcc, 0xdc, 0xba, 0x1f, 0x9c, 0xef,
-	0xb4, 0x77, 0x77, 0x28, 0x89, 0xdb, 0xbe, 0x4b, 0xac, 0x28, 0x0e, 0x59, 0x88, 0x4c, 0x4e, 0x67,
-	0x29, 0x3a, 0x2b, 0xa1, 0xb3, 0xda, 0xbb, 0x95, 0x8d, 0xf3, 0x30, 0x3c, 0x6f, 0x90, 0x1d, 0x41,
-	0x77, 0xda, 0x3a, 0xdb, 0xf1, 0x5a, 0x31, 0x66, 0x7e, 0x18, 0x48, 0xce, 0xca, 0x66, 0xef, 0x3e,
-	0xf3, 0x9b, 0x84, 0x32, 0xdc, 0x8c, 0x14, 0x41, 0x1f, 0xc0, 0x65, 0x8c, 0xa3, 0x88, 0xc4, 0x54,
-	0xed, 0x6f, 0xe5, 0x54, 0xc4, 0x91, 0xcf, 0xb5, 0x73, 0xc3, 0x66, 0xb3, 0x2b, 0x42, 0x47, 0xf1,
-	0xaa, 0x45, 0xe2, 0x8e, 0x22, 0xa8, 0xea, 0x08, 0x18, 0xa6, 0x17, 0x0d, 0x9f, 0x32, 0x45, 0xb3,
-	0xad, 0xa3, 0x51, 0x46, 0x70, 0x2e, 0xc3, 0xf8, 0x82, 0xc4, 0x8a, 0xf2, 0x07, 0xa3, 0x28, 0xcf,
-	0x1a, 0xe1, 0xa5, 0xa2, 0xbd, 0xa3, 0xa3, 0xad, 0xfb, 0x94, 0x85, 0xa9, 0x72, 0xdf, 0xcb, 0x91,
-	0xd0, 0x3a, 0x8e, 0x89, 0xd7, 0x4f, 0xf5, 0xee, 0x00, 0xaa, 0xfc, 0x29, 0xaa, 0xff, 0x31, 0xa0,
-	0xf2, 0x2c, 0x6c, 0x34, 0x8e, 0xc3, 0xf8, 0x90, 0xb8, 0x3e, 0xf5, 0xc3, 0xe0, 0x04, 0xd3, 0x0b,
-	0x9b, 0xbc, 0x6a, 0x11, 0xca, 0x50, 0x0d, 0x0a, 0xb1, 0xfc, 0x69, 0x1a, 0x5b, 0xc6, 0x76, 0x69,
-	0x6f, 0xc7, 0xca, 0x39, 0x16, 0x47, 0xbe, 0xd5, 0xde, 0xb5, 0x06, 0x23, 0xd8, 0x09, 0x3f, 0x5a,
-	0x83, 0xa2, 0x17, 0x36, 0xb1, 0x1f, 0x38, 0xbe, 0x67, 0x4e, 0x6d, 0x19, 0xdb, 0x45, 0x7b, 0x5e,
-	0x2e, 0xd4, 0x3c, 0xbe, 0x19, 0x85, 0x8d, 0x06, 0x89, 0xf9, 0xe6, 0xb4, 0xdc, 0x94, 0x0b, 0x35,
-	0x0f, 0xbd, 0x0b, 0xe5, 0xb3, 0x30, 0xbe, 0xc4, 0xb1, 0x47, 0x3c, 0xe7, 0x2c, 0x0e, 0x9b, 0xe6,
-	0x8c, 0xa0, 0x58, 0x4c, 0x57, 0x8f, 0xe3, 0xb0, 0x89, 0xde, 0x87, 0x25, 0x9f, 0x86, 0x0d, 0x11,
-	0x4b, 0xce, 0x79, 0x1c, 0xb6, 0x22, 0x73, 0x56, 0xd0, 0x95, 0xd3, 0xe5, 0x47, 0x7c, 0xb5, 0xfa,
-	0x45, 0x11, 0xd6, 0xb4, 0x1a, 0xd3, 0x28, 0x0c, 0x28, 0x41, 0xeb, 0x00, 0xdc, 0x4a, 0x0e, 0x0b,
-	0x2f, 0x48, 0x20, 0xce, 0xbd, 0x60, 0x17, 0xf9, 0xca, 0x09, 0x5f, 0x40, 0xbf, 0x00, 0x94, 0x38,
-	0xcd, 0x21, 0x9f, 0x13, 0xb7, 0xc5, 0x91, 0xc5, 0x89, 0x4a, 0x7b, 0xef, 0x69, 0xcd, 0xf3, 0x4b,
-	0x45, 0x7e, 0x94, 0x50, 0xdb, 0x37, 0x2e, 0x7b, 0x97, 0xd0, 0x31, 0x2c, 0xa6, 0xb0, 0xac, 0x13,
-	0x11, 0x61, 0x86, 0xd2, 0xde, 0x9d, 0xa1, 0x88, 0x27, 0x9d, 0x88, 0xd8, 0x0b, 0x97, 0x99, 0x2f,
-	0xf4, 0x02, 0x56, 0xa3, 0x98, 0xb4, 0xfd, 0xb0, 0x45, 0x1d, 0xca, 0x70, 0xcc, 0x88, 0xe7, 0x90,
-	0x36, 0x09, 0x18, 0x37, 0xed, 0x8c, 0xc0, 0x5c, 0xb3, 0x64, 0x0a, 0x59, 0x49, 0x0a, 0x59, 0xb5,
-	0x80, 0xdd, 0xfb, 0xf8, 0x05, 0x6e, 0xb4, 0x88, 0xbd, 0x92, 0x70, 0x3f, 0x97, 0xcc, 0x47, 0x9c,
-	0xb7, 0xe6, 0xa1, 0x6d, 0x58, 0xee, 0x83, 0xe3, 0xf6, 0x9d, 0xb6, 0xcb, 0x34, 0x4f, 0x69, 0x42,
-	0x01, 0x33, 0x46, 0x9a, 0x11, 0x33, 0xe7, 0xb6, 0x8c, 0xed, 0x59, 0x3b, 0xf9, 0x44, 0x55, 0x58,
-	0x0c, 0xc8, 0xe7, 0xac, 0x0b, 0x50, 0x10, 0x00, 0x25, 0xbe, 0x98, 0x70, 0x7f, 0x00, 0xe8, 0x14,
-	0xbb, 0x17, 0x8d, 0xf0, 0xdc, 0x71, 0xc3, 0x56, 0xc0, 0x9c, 0xba, 0x1f, 0x30, 0x73, 0x5e, 0x10,
-	0x2e, 0xab, 0x9d, 0x03, 0xbe, 0xf1, 0xd8, 0x0f, 0x18, 0xba, 0x0f, 0x26, 0x65, 0xbe, 0x7b, 0xd1,
-	0xe9, 0xba, 0xc2, 0x21, 0x01, 0x3e, 0x6d, 0x10, 0xcf, 0x2c, 0x6e, 0x19, 0xdb, 0xf3, 0xf6, 0x8a,
-	0xdc, 0x4f, 0x0d, 0x7d, 0x24, 0x77, 0xd1, 0x7d, 0x98, 0x15, 0x29, 0x6f, 0x82, 0xb0, 0x49, 0x75,
-	0xa8, 0x9d, 0x3f, 0xe3, 0x94, 0xb6, 0x64, 0x40, 0x36, 0x2c, 0x7a, 0x2a, 0x6e, 0x1c, 0x3f, 0x38,
-	0x0b, 0xcd, 0x92, 0x40, 0xf8, 0x61, 0x1e, 0x41, 0xa6, 0x1c, 0x07, 0x39, 0x89, 0x71, 0x40, 0x7d,
-	0x12, 0xb0, 0x24, 0xda, 0x6a, 0xc1, 0x59, 0x68, 0x2f, 0x78, 0x99, 0x2f, 0xf4, 0x12, 0x6e, 0xf7,
-	0x07, 0x95, 0x23, 0xc2, 0x90, 0x67, 0xab, 0xb9, 0x20, 0x44, 0xac, 0x6b, 0x95, 0xe4, 0xc1, 0xfb,
-	0x33, 0x9f, 0x32, 0x7b, 0xb5, 0x2f, 0xaa, 0x92, 0x2d, 0x64, 0xc1, 0x4d, 0x69, 0x74, 0x5e, 0x23,
-	0x88, 0xd3, 0x26, 0x31, 0x17, 0x6d, 0x2e, 0x0a, 0xff, 0xdc, 0x10, 0x5b, 0xcf, 0xf9, 0xce, 0x0b,
-	0xb9, 0x81, 0xee, 0xc0, 0xc2, 0x69, 0x8c, 0x03, 0xb7, 0xae, 0xb2, 0xa0, 0x2c, 0xb2, 0xa0, 0x24,
-	0xd7, 0x64, 0x1e, 0xec, 0x43, 0x99, 0xba, 0x75, 0xe2, 0xb5, 0x1a, 0xc4, 0x73, 0x78, 0x91, 0x36,
-	0x97, 0x84, 0x92, 0x95, 0xbe, 0xe8, 0x3a, 0x49, 0x2a, 0xb8, 0xbd, 0x98, 0x72, 0xf0, 0x35, 0xf4,
-	0x63, 0x58, 0x48, 0x62, 0x4a, 0x00, 0x2c, 0x8f, 0x04, 0x28, 0x29, 0x7a, 0xc1, 0xfe, 0x6b, 0x28,
-	0x70, 0x8f, 0xf8, 0x84, 0x9a, 0x37, 0xb6, 0xa6, 0xb7, 0x4b, 0x7b, 0x0f, 0xad, 0x41, 0x6d, 0xc7,
-	0x1a, 0x92, 0xf0, 0xd6, 0x67, 0x12, 0xe4, 0x28, 0x60, 0x71, 0xc7, 0x4e, 0x20, 0x2b, 0x2f, 0x61,
-	0x21, 0xbb, 0x81, 0x96, 0x61, 0xfa, 0x82, 0x74, 0x44, 0x3d, 0x28, 0xda, 0xfc, 0x27, 0x0f, 0xa1,
-	0x36, 0xcf, 0x19, 0x95, 0xfc, 0x63, 0x85, 0x90, 0x60, 0x78, 0x30, 0x75, 0xdf, 0xc8, 0x96, 0xde,
-	0x7d, 0x97, 0xf9, 0x6d, 0x9f, 0x75, 0xae, 0x5e, 0x7a, 0x35, 0x08, 0xff, 0x8f, 0xa5, 0xf7, 0xcb,
-	0xf9, 0xb4, 0xf4, 0xe6, 0x35, 0xfe, 0x4e, 0x4b, 0xef, 0x26, 0x94, 0xb0, 0xd2, 0xa6, 0x6b, 0x04,
-	0x48, 0x96, 0x6a, 0x1e, 0xaf, 0xcd, 0x29, 0x81, 0xa8, 0xcd, 0x33, 0x43, 0x6a, 0x73, 0x7a, 0x30,
-	0x51, 0x9b, 0x71, 0xe6, 0x0b, 0xed, 0xc1, 0xac, 0x1f, 0x44, 0x2d, 0x26, 0xac, 0x53, 0xda, 0xbb,
-	0xad, 0xf7, 0x28, 0xee, 0x34, 0x42, 0xec, 0xd9, 0x92, 0x54, 0x93, 0x66, 0x73, 0xd7, 0x4d, 0xb3,
-	0xc2, 0x64, 0x69, 0x76, 0x02, 0xab, 0x09, 0x9e, 0xc3, 0x42, 0xc7, 0x6d, 0x84, 0x94, 0x08, 0xa0,
-	0xb0, 0x25, 0x0b, 0x73, 0x69, 0x6f, 0xb5, 0x0f, 0xeb, 0x50, 0x4d, 0x75, 0xf6, 0x4a, 0xc2, 0x7b,
-	0x12, 0x1e, 0x70, 0xce, 0x13, 0xc9, 0x88, 0x7e, 0x0e, 0x2b, 0x42, 0x48, 0x3f, 0x64, 0x71, 0x14,
-	0xe4, 0x4d, 0xc1, 0xd8, 0x83, 0x77, 0x0c, 0x37, 0xea, 0x04, 0xc7, 0xec, 0x94, 0x60, 0x96, 0x42,
-	0xc1, 0x28, 0xa8, 0xe5, 0x94, 0x27, 0xc1, 0xc9, 0x74, 0xaf, 0x52, 0xbe, 0x7b, 0xbd, 0x84, 0x8d,
-	0xbc, 0x27, 0x9c, 0xf0, 0xcc, 0x61, 0x75, 0x9f, 0x3a, 0x09, 0xc3, 0xc2, 0x48, 0xc3, 0x56, 0x72,
-	0x9e, 0x79, 0x7a, 0x76, 0x52, 0xf7, 0xe9, 0xbe, 0xc2, 0xaf, 0x65, 0x4f, 0xe0, 0x11, 0x86, 0xfd,
-	0x06, 0x15, 0x15, 0x7a, 0x54, 0xa4, 0x74, 0x0f, 0x71, 0x28, 0xb9, 0xfa, 0x87, 0x89, 0xf2, 0xd5,
-	0x86, 0x89, 0xf7, 0x61, 0x29, 0xc5, 0x91, 0x15, 0x43, 0x14, 0xf9, 0xa2, 0x5d, 0x4e, 0x96, 0x0f,
-	0xc5, 0x2a, 0xfa, 0x08, 0xe6, 0xea, 0x04, 0x7b, 0x24, 0x56, 0x35, 0x7c, 0x4d, 0x2b, 0xe9, 0xb1,
-	0x20, 0xb1, 0x15, 0x69, 0xf5, 0x1f, 0x33, 0xb0, 0xb2, 0xef, 0x79, 0xba, 0xc1, 0x33, 0x57, 0xb2,
-	0x8c, 0x9e, 0x92, 0xf5, 0x0d, 0x95, 0x81, 0x07, 0x50, 0xec, 0x36, 0xdc, 0xe9, 0x71, 0x1a, 0xee,
-	0x3c, 0x4b, 0xfa, 0xeb, 0x26, 0x94, 0xd2, 0x1c, 0x51, 0x73, 0xd6, 0xb4, 0x0d, 0xc9, 0x52, 0xcd,
-	0xeb, 0x4d, 0x22, 0x15, 0xfa, 0x2a, 0x4c, 0x67, 0x27, 0x48, 0x22, 0x31, 0x96, 0x25, 0xc1, 0xfa,
-	0x00, 0xe6, 0x68, 0xd8, 0x8a, 0x5d, 0x59, 0x14, 0xca, 0xbd, 0x2d, 0x28, 0x33, 0x83, 0x60, 0x7a,
-	0xf1, 0x5c, 0x50, 0xda, 0x8a, 0x43, 0x53, 0xdb, 0x0b, 0xba, 0xda, 0x1e, 0xc1, 0x72, 0x84, 0x63,
-	0xe6, 0x8b, 0xda, 0xee, 0x86, 0xc1, 0x99, 0x7f, 0x6e, 0xce, 0x8b, 0x6e, 0x7b, 0x34, 0xb8, 0xdb,
-	0xea, 0xbd, 0x6a, 0x3d, 0x4b, 0x80, 0x0e, 0x04, 0x8e, 0x6c, 0xb8, 0x4b, 0x51, 0x7e, 0xb5, 0xf2,
-	0x10, 0x6e, 0xe9, 0x08, 0x35, 0x0d, 0xf8, 0x56, 0xb6, 0x01, 0x17, 0xb3, 0xcd, 0x75, 0x15, 0xde,
-	0xee, 0xd3, 0x41, 0xf6, 0x98, 0xea, 0x7f, 0x67, 0x45, 0xd4, 0xe9, 0x7a, 0xee, 0x77, 0x11, 0x75,
-	0x7c, 0xae, 0x16, 0x0e, 0x71, 0xba, 0xa2, 0x65, 0x07, 0x2a, 0xcb, 0xf5, 0xc3, 0x44, 0x81, 0x5c,
-	0x7c, 0xce, 0x5c, 0x2b, 0x3e, 0x67, 0x27, 0x8b, 0xcf, 0xb9, 0xeb, 0xc7, 0x67, 0xe1, 0x0d, 0xc4,
-	0xe7, 0xbc, 0x2e, 0x3e, 0x03, 0x30, 0x71, 0xc6, 0x95, 0x87, 0x3e, 0x8d, 0x78, 0x20, 0xf2, 0xa9,
-	0x5a, 0x75, 0x92, 0xbd, 0x21, 0x71, 0x3a, 0x80, 0xd3, 0x1e, 0x88, 0xa9, 0xcd, 0x07, 0x18, 0x23,
-	0x1f, 0x34, 0xf1, 0xf6, 0x2d, 0xe6, 0xc3, 0xd7, 0xd3, 0x60, 0x0e, 0x3a, 0x2c, 0xfa, 0x29, 0x2c,
-	0x75, 0x1b, 0x9b, 0xb8, 0x0b, 0xa8, 0x91, 0x53, 0xdf, 0x2f, 0x1e, 0xcb, 0x87, 0x06, 0x71, 0x61,
-	0xb3, 0xbb, 0xc3, 0x89, 0xf8, 0xee, 0x9b, 0x35, 0xa6, 0x26, 0x9b, 0x35, 0x32, 0xdd, 0x77, 0x7a,
-	0xd2, 0xee, 0x3b, 0xf3, 0xe6, 0xbb, 0xef, 0xec, 0x9b, 0xe9, 0xbe, 0x73, 0x6f, 0xac, 0xfb, 0x16,
-	0x74, 0xdd, 0x57, 0x55, 0x3b, 0xdd, 0x44, 0x5d, 0xfd, 0xda, 0x80, 0x5b, 0xe2, 0xea, 0x91, 0xc8,
-	0x49, 0x6a, 0xdd, 0x41, 0xef, 0xfd, 0xe2, 0xfb, 0x5a, 0xf5, 0x74, 0xbc, 0x63, 0xde, 0x2c, 0xae,
-	0xd3, 0x4f, 0xc7, 0xbb, 0x78, 0x54, 0xff, 0x62, 0xc0, 0x5b, 0x3d, 0x1a, 0xaa, 0x9b, 0xc4, 0x4f,
-	0x60, 0x41, 0xdc, 0xd6, 0x9d, 0x98, 0xd0, 0x56, 0x23, 0x39, 0xe3, 0x70, 0x4f, 0x96, 0x04, 0x87,
-	0x2d, 0x18, 0x50, 0x0d, 0xca, 0x09, 0xc0, 0x6f, 0x88, 0xcb, 0x88, 0x37, 0xf4, 0x96, 0x27, 0x6f,
-	0x77, 0x8a, 0xd2, 0x5e, 0x7c, 0x95, 0xfd, 0xac, 0xfe, 0xdb, 0x80, 0x2d, 0xa9, 0x98, 0x27, 0xe8,
-	0xf8, 0x79, 0x0f, 0xc2, 0x66, 0xd4, 0x20, 0x9c, 0x58, 0x99, 0xf2, 0x69, 0xaf, 0x3f, 0xee, 0x6a,
-	0x05, 0x8d, 0xc2, 0xf9, 0x16, 0x7c, 0xf3, 0x36, 0x14, 0x04, 0xaf, 0x9a, 0x73, 0x8a, 0xf6, 0x1c,
-	0xff, 0xac, 0x79, 0xd5, 0x77, 0xe0, 0xce, 0x10, 0xf5, 0x54, 0x40, 0xfe, 0xd3, 0x80, 0xdb, 0x07,
-	0x38, 0x70, 0x49, 0xe3, 0x69, 0x8b, 0x51, 0x86, 0x03, 0xcf, 0x0f, 0xce, 0xf9, 0x9d, 0x70, 0xac,
-	0x26, 0x9c, 0xbb, 0xad, 0x4e, 0xf5, 0xdc, 0x56, 0x1f, 0x41, 0x39, 0x3d, 0x54, 0xf7, 0x0d, 0xad,
-	0x3c, 0x20, 0xf1, 0x92, 0x93, 0xc9, 0xc4, 0x63, 0x99, 0xaf, 0xeb, 0x74, 0xda, 0xea, 0x26, 0xac,
-	0x0f, 0x38, 0x9e, 0x32, 0xc0, 0xef, 0xe0, 0xed, 0x43, 0x42, 0xdd, 0xd8, 0x3f, 0x25, 0x29, 0xbb,
-	0x3a, 0xfa, 0x71, 0x6f, 0x0c, 0x7c, 0xa0, 0x95, 0x3a, 0x80, 0x7d, 0x3c, 0xd7, 0x57, 0xbf, 0x32,
-	0xc0, 0xec, 0x47, 0x50, 0x69, 0xf3, 0x09, 0x14, 0xa4, 0x39, 0xa9, 0x69, 0x88, 0xa6, 0xb6, 0x39,
-	0xf0, 0xd5, 0x81, 0xc4, 0xa2, 0x53, 0x26, 0xf4, 0xe8, 0x09, 0x2c, 0x77, 0xad, 0x4f, 0x19, 0x66,
-	0x2d, 0xaa, 0x52, 0xe6, 0x9d, 0xa1, 0xb6, 0x7b, 0x2e, 0x48, 0xed, 0x32, 0xcb, 0x7d, 0x57, 0x29,
-	0xac, 0x0b, 0x7f, 0xa8, 0xd5, 0xb4, 0x03, 0xd2, 0xc4, 0x58, 0x2b, 0x30, 0xa7, 0x8a, 0xa2, 0x0c,
-	0x12, 0xf5, 0x95, 0x77, 0xde, 0xd4, 0x64, 0xce, 0xfb, 0xe3, 0x14, 0x6c, 0x0c, 0x92, 0xaa, 0x2c,
-	0xf4, 0x0a, 0xd6, 0xbb, 0x6f, 0x01, 0xe9, 0x79, 0xd3, 0x9e, 0x9d, 0xd8, 0xcd, 0x1a, 0x2a, 0x32,
-	0xc5, 0x7d, 0x42, 0x18, 0xf6, 0x30, 0xc3, 0x76, 0x25, 0x3b, 0x70, 0xe4, 0x45, 0x73, 0x91, 0xe9,
-	0x83, 0xa3, 0x56, 0xe4, 0xd4, 0xd5, 0x44, 0x7a, 0x99, 0xf1, 0x38, 0x2f, 0xb2, 0x7a, 0x17, 0xd6,
-	0x1e, 0x91, 0xd4, 0x0c, 0xf4, 0x61, 0x47, 0x76, 0x9a, 0x11, 0xb6, 0xaf, 0x7e, 0x35, 0x03, 0xb7,
-	0xf5, 0x7c, 0xca, 0x7a, 0x5f, 0x18, 0xb0, 0xa2, 0x39, 0x4b, 0x13, 0x47, 0xca, 0x6e, 0x4f, 0x07,
-	0x0f, 0x51, 0xc3, 0x80, 0xad, 0xc3, 0x9e, 0xb3, 0x3c, 0xc1, 0x91, 0x1c, 0xa7, 0x6e, 0x7a, 0xfd,
-	0x3b, 0x42, 0x0d, 0x8d, 0x17, 0xb9, 0x1a, 0x53, 0xd7, 0x52, 0x63, 0xbf, 0xc7, 0x8b, 0x5d, 0x35,
-	0x70, 0xff, 0x4e, 0xe5, 0xb7, 0x3c, 0x13, 0xf5, 0x7a, 0x6b, 0xa6, 0xbb, 0xc7, 0xf9, 0xe7, 0xc6,
-	0x21, 0x63, 0xed, 0xa0, 0xf4, 0xce, 0x4c, 0x84, 0x5c, 0xf6, 0x20, 0x65, 0xbf, 0x69, 0xd9, 0x7b,
-	0x7f, 0x05, 0x28, 0x3d, 0x51, 0x3c, 0xfb, 0xcf, 0x6a, 0xe8, 0xf7, 0x06, 0xdc, 0xd4, 0x3c, 0xd0,
-	0xa2, 0x8f, 0x27, 0x7c, 0xcf, 0x15, 0xc1, 0x59, 0xb9, 0x7b, 0xa5, 0x57, 0xe0, 0xac, 0x12, 0x59,
-	0xc3, 0x8c, 0xa1, 0x84, 0x66, 0xb4, 0x1f, 0x43, 0x09, 0xed, 0x03, 0x68, 0x1b, 0x96, 0x7a, 0xee,
-	0xad, 0xe8, 0xc3, 0x49, 0xaf, 0xd9, 0x95, 0xdd, 0x09, 0x38, 0x72, 0x72, 0x73, 0xe7, 0xfe, 0x70,
-	0xd2, 0xeb, 0xcc, 0x08, 0xb9, 0xda, 0xf3, 0x46, 0xb0, 0x98, 0x9b, 0xdf, 0x90, 0x35, 0x18, 0x43,
-	0x37, 0x8a, 0x56, 0x76, 0xc6, 0xa6, 0x57, 0x12, 0xff, 0x6c, 0xc0, 0xea, 0xc0, 0x29, 0x05, 0x3d,
-	0x18, 0x0c, 0x37, 0x6a, 0xf2, 0xaa, 0x7c, 0x7a, 0x25, 0x5e, 0xa5, 0xd6, 0x9f, 0x0c, 0x78, 0x4b,
-	0x3b, 0x37, 0xa0, 0x7b, 0x83, 0x61, 0x87, 0xcd, 0x51, 0x95, 0x1f, 0x4d, 0xcc, 0xa7, 0x54, 0xe9,
-	0xc0, 0x72, 0x6f, 0x12, 0xa3, 0xdd, 0x49, 0x12, 0x5e, 0xca, 0xbf, 0x42, 0x8d, 0x40, 0x5f, 0x1a,
-	0xb0, 0xa2, 0xef, 0xbf, 0x68, 0xc8, 0x71, 0x86, 0xce, 0x09, 0x95, 0xfb, 0x93, 0x33, 0x2a, 0x6d,
-	0xfe, 0x60, 0xc0, 0x2d, 0x5d, 0xb5, 0x47, 0x77, 0x27, 0xed, 0x0e, 0x52, 0x93, 0x7b, 0x57, 0x6b,
-	0x2a, 0x0f, 0x1f, 0xfd, 0xed, 0xf5, 0x86, 0xf1, 0xf7, 0xd7, 0x1b, 0xc6, 0xbf, 0x5e, 0x6f, 0x18,
-	0xbf, 0xfa, 0xe4, 0xdc, 0x67, 0xf5, 0xd6, 0xa9, 0xe5, 0x86, 0xcd, 0x9d, 0xdc, 0x1f, 0xf9, 0xad,
-	0x73, 0x12, 0xc8, 0xff, 0x8a, 0xc8, 0xfe, 0x63, 0xc6, 0xa7, 0xc9, 0xef, 0xf6, 0xee, 0xe9, 0x9c,
-	0xd8, 0xfd, 0xe8, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0xfe, 0xdb, 0x7a, 0xc6, 0x21, 0x00,
+	// 2241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6e, 0xdb, 0xd6,
+	0x1d, 0x07, 0xfd, 0x25, 0xeb, 0x2f, 0x5b, 0x76, 0x4e, 0x52, 0x87, 0x56, 0x12, 0xc7, 0x51, 0xd7,
+	0xd6, 0x1b, 0x3a, 0x3a, 0x76, 0x9b, 0x2c, 0x4d, 0x36, 0x0c, 0x8e, 0x1d, 0x27, 0x1a, 0x96, 0x25,
+	0x65, 0xbc, 0x14, 0x18, 0x8a, 0x10, 0xc7, 0xe4, 0xb1, 0xc5, 0x59, 0x22, 0x19, 0xf2, 0x50, 0xae,
+	0x76, 0xb1, 0x8b, 0xa2, 0x1b, 0x06, 0xf4, 0x76, 0xc3, 0x1e, 0x60, 0x7d, 0x8c, 0xdd, 0x6e, 0xd8,
+	0xe5, 0xae, 0x76, 0x53, 0x0c, 0x18, 0x02, 0xec, 0x01, 0xf6, 0x06, 0xc3, 0xf9, 0x20, 0x45, 0x4a,
+	0x87, 0xb2, 0x24, 0xbb, 0xeb, 0xee, 0x7c, 0xce, 0xf9, 0x7f, 0x9f, 0xff, 0xc7, 0xef, 0xd0, 0x82,
+	0x77, 0xe3, 0x43, 0x12, 0x6e, 0xda, 0xd8, 0x21, 0x9e, 0x4d, 0x36, 0xdb, 0x98, 0xda, 0x4d, 0xd7,
+	0x3b, 0xde, 0xec, 0x6c, 0x6d, 0x46, 0x24, 0xec, 0xb8, 0x36, 0x31, 0x82, 0xd0, 0xa7, 0x3e, 0xd2,
+	0x19, 0x9d, 0x21, 0xe9, 0x8c, 0x84, 0xce, 0xe8, 0x6c, 0xd5, 0xd6, 0x8e, 0x7d, 0xff, 0xb8, 0x45,
+	0x36, 0x39, 0xdd, 0x61, 0x7c, 0xb4, 0xe9, 0xc4, 0x21, 0xa6, 0xae, 0xef, 0x09, 0xce, 0xda, 0xcd,
+	0xfe, 0x73, 0xea, 0xb6, 0x49, 0x44, 0x71, 0x3b, 0x90, 0x04, 0x03, 0x02, 0x4e, 0x43, 0x1c, 0x04,
+	0x24, 0x8c, 0xe4, 0xf9, 0x7a, 0xce, 0x44, 0x1c, 0xb8, 0xcc, 0x3a, 0xdb, 0x6f, 0xb7, 0x7b, 0x2a,
+	0x54, 0x14, 0xaf, 0x63, 0x12, 0x76, 0x25, 0x41, 0x5d, 0x45, 0x40, 0x71, 0x74, 0xd2, 0x72, 0x23,
+	0x2a, 0x69, 0x36, 0x54, 0x34, 0x32, 0x08, 0xd6, 0xa9, 0x1f, 0x9e, 0x90, 0x50, 0x52, 0x7e, 0xef,
+	0x2c, 0xca, 0xa3, 0x96, 0x7f, 0x2a, 0x69, 0x6f, 0xa9, 0x68, 0x9b, 0x6e, 0x44, 0xfd, 0xd4, 0xb8,
+	0xef, 0xe4, 0x48, 0xa2, 0x26, 0x0e, 0x89, 0x33, 0x48, 0xf5, 0x4e, 0x01, 0x55, 0xde, 0x8b, 0xfa,
+	0x7f, 0x34, 0xa8, 0x3d, 0xf7, 0x5b, 0xad, 0x7d, 0x3f, 0xdc, 0x23, 0xb6, 0x1b, 0xb9, 0xbe, 0x77,
+	0x80, 0xa3, 0x13, 0x93, 0xbc, 0x8e, 0x49, 0x44, 0x51, 0x03, 0x4a, 0xa1, 0xf8, 0x53, 0xd7, 0xd6,
+	0xb5, 0x8d, 0xca, 0xf6, 0xa6, 0x91, 0xbb, 0x58, 0x1c, 0xb8, 0x46, 0x67, 0xcb, 0x28, 0x96, 0x60,
+	0x26, 0xfc, 0xe8, 0x1a, 0x94, 0x1d, 0xbf, 0x8d, 0x5d, 0xcf, 0x72, 0x1d, 0x7d, 0x6a, 0x5d, 0xdb,
+	0x28, 0x9b, 0xf3, 0x62, 0xa3, 0xe1, 0xb0, 0xc3, 0xc0, 0x6f, 0xb5, 0x48, 0xc8, 0x0e, 0xa7, 0xc5,
+	0xa1, 0xd8, 0x68, 0x38, 0xe8, 0x1d, 0xa8, 0x1e, 0xf9, 0xe1, 0x29, 0x0e, 0x1d, 0xe2, 0x58, 0x47,
+	0xa1, 0xdf, 0xd6, 0x67, 0x38, 0xc5, 0x62, 0xba, 0xbb, 0x1f, 0xfa, 0x6d, 0xf4, 0x1e, 0x2c, 0xb9,
+	0x91, 0xdf, 0xe2, 0xb9, 0x64, 0x1d, 0x87, 0x7e, 0x1c, 0xe8, 0xb3, 0x9c, 0xae, 0x9a, 0x6e, 0x3f,
+	0x66, 0xbb, 0xf5, 0x2f, 0xca, 0x70, 0x4d, 0x69, 0x71, 0x14, 0xf8, 0x5e, 0x44, 0xd0, 0x0d, 0x00,
+	0x16, 0x25, 0x8b, 0xfa, 0x27, 0xc4, 0xe3, 0x7e, 0x2f, 0x98, 0x65, 0xb6, 0x73, 0xc0, 0x36, 0xd0,
+	0xcf, 0x01, 0x25, 0x97, 0x66, 0x91, 0xcf, 0x88, 0x1d, 0x33, 0xc9, 0xdc, 0xa3, 0xca, 0xf6, 0xbb,
+	0xca, 0xf0, 0x7c, 0x22, 0xc9, 0x1f, 0x25, 0xd4, 0xe6, 0xa5, 0xd3, 0xfe, 0x2d, 0xb4, 0x0f, 0x8b,
+	0xa9, 0x58, 0xda, 0x0d, 0x08, 0x0f, 0x43, 0x65, 0xfb, 0xd6, 0x50, 0x89, 0x07, 0xdd, 0x80, 0x98,
+	0x0b, 0xa7, 0x99, 0x15, 0x7a, 0x09, 0xab, 0x41, 0x48, 0x3a, 0xae, 0x1f, 0x47, 0x56, 0x44, 0x71,
+	0x48, 0x89, 0x63, 0x91, 0x0e, 0xf1, 0x28, 0x0b, 0xed, 0x0c, 0x97, 0x79, 0xcd, 0x10, 0x25, 0x64,
+	0x24, 0x25, 0x64, 0x34, 0x3c, 0x7a, 0xf7, 0xc3, 0x97, 0xb8, 0x15, 0x13, 0x73, 0x25, 0xe1, 0x7e,
+	0x21, 0x98, 0x1f, 0x31, 0xde, 0x86, 0x83, 0x36, 0x60, 0x79, 0x40, 0x1c, 0x8b, 0xef, 0xb4, 0x59,
+	0x8d, 0xf2, 0x94, 0x3a, 0x94, 0x30, 0xa5, 0xa4, 0x1d, 0x50, 0x7d, 0x6e, 0x5d, 0xdb, 0x98, 0x35,
+	0x93, 0x25, 0xaa, 0xc3, 0xa2, 0x47, 0x3e, 0xa3, 0x3d, 0x01, 0x25, 0x2e, 0xa0, 0xc2, 0x36, 0x13,
+	0xee, 0xf7, 0x01, 0x1d, 0x62, 0xfb, 0xa4, 0xe5, 0x1f, 0x5b, 0xb6, 0x1f, 0x7b, 0xd4, 0x6a, 0xba,
+	0x1e, 0xd5, 0xe7, 0x39, 0xe1, 0xb2, 0x3c, 0xd9, 0x65, 0x07, 0x4f, 0x5c, 0x8f, 0xa2, 0x7b, 0xa0,
+	0x47, 0xd4, 0xb5, 0x4f, 0xba, 0xbd, 0xab, 0xb0, 0x88, 0x87, 0x0f, 0x5b, 0xc4, 0xd1, 0xcb, 0xeb,
+	0xda, 0xc6, 0xbc, 0xb9, 0x22, 0xce, 0xd3, 0x40, 0x3f, 0x12, 0xa7, 0xe8, 0x1e, 0xcc, 0xf2, 0x92,
+	0xd7, 0x81, 0xc7, 0xa4, 0x3e, 0x34, 0xce, 0x1f, 0x33, 0x4a, 0x53, 0x30, 0x20, 0x13, 0x16, 0x1d,
+	0x99, 0x37, 0x96, 0xeb, 0x1d, 0xf9, 0x7a, 0x85, 0x4b, 0xf8, 0x7e, 0x5e, 0x82, 0x28, 0x39, 0x26,
+	0xe4, 0x20, 0xc4, 0x5e, 0xe4, 0x12, 0x8f, 0x26, 0xd9, 0xd6, 0xf0, 0x8e, 0x7c, 0x73, 0xc1, 0xc9,
+	0xac, 0xd0, 0x2b, 0xb8, 0x3e, 0x98, 0x54, 0x16, 0x4f, 0x43, 0x56, 0xad, 0xfa, 0x02, 0x57, 0x71,
+	0x43, 0x69, 0x24, 0x4b, 0xde, 0x9f, 0xba, 0x11, 0x35, 0x57, 0x07, 0xb2, 0x2a, 0x39, 0x42, 0x06,
+	0x5c, 0x16, 0x41, 0x67, 0x3d, 0x82, 0x58, 0x1d, 0x12, 0x32, 0xd5, 0xfa, 0x22, 0xbf, 0x9f, 0x4b,
+	0xfc, 0xe8, 0x05, 0x3b, 0x79, 0x29, 0x0e, 0xd0, 0x2d, 0x58, 0x38, 0x0c, 0xb1, 0x67, 0x37, 0x65,
+	0x15, 0x54, 0x79, 0x15, 0x54, 0xc4, 0x9e, 0xa8, 0x83, 0x1d, 0xa8, 0x46, 0x76, 0x93, 0x38, 0x71,
+	0x8b, 0x38, 0x16, 0x6b, 0xd2, 0xfa, 0x12, 0x37, 0xb2, 0x36, 0x90, 0x5d, 0x07, 0x49, 0x07, 0x37,
+	0x17, 0x53, 0x0e, 0xb6, 0x87, 0x7e, 0x04, 0x0b, 0x49, 0x4e, 0x71, 0x01, 0xcb, 0x67, 0x0a, 0xa8,
+	0x48, 0x7a, 0xce, 0xfe, 0x29, 0x94, 0xd8, 0x8d, 0xb8, 0x24, 0xd2, 0x2f, 0xad, 0x4f, 0x6f, 0x54,
+	0xb6, 0x1f, 0x1a, 0x45, 0x63, 0xc7, 0x18, 0x52, 0xf0, 0xc6, 0xc7, 0x42, 0xc8, 0x23, 0x8f, 0x86,
+	0x5d, 0x33, 0x11, 0x59, 0x7b, 0x05, 0x0b, 0xd9, 0x03, 0xb4, 0x0c, 0xd3, 0x27, 0xa4, 0xcb, 0xfb,
+	0x41, 0xd9, 0x64, 0x7f, 0xb2, 0x14, 0xea, 0xb0, 0x9a, 0x91, 0xc5, 0x3f, 0x52, 0x0a, 0x71, 0x86,
+	0xfb, 0x53, 0xf7, 0xb4, 0x6c, 0xeb, 0xdd, 0xb1, 0xa9, 0xdb, 0x71, 0x69, 0x77, 0xf2, 0xd6, 0xab,
+	0x90, 0xf0, 0xff, 0xd8, 0x7a, 0xbf, 0x9c, 0x4f, 0x5b, 0x6f, 0xde, 0xe2, 0x6f, 0xb5, 0xf5, 0xde,
+	0x84, 0x0a, 0x96, 0xd6, 0xf4, 0x82, 0x00, 0xc9, 0x56, 0xc3, 0x61, 0xbd, 0x39, 0x25, 0xe0, 0xbd,
+	0x79, 0x66, 0x48, 0x6f, 0x4e, 0x1d, 0xe3, 0xbd, 0x19, 0x67, 0x56, 0x68, 0x1b, 0x66, 0x5d, 0x2f,
+	0x88, 0x29, 0x8f, 0x4e, 0x65, 0xfb, 0xba, 0xfa, 0x46, 0x71, 0xb7, 0xe5, 0x63, 0xc7, 0x14, 0xa4,
+	0x8a, 0x32, 0x9b, 0x3b, 0x6f, 0x99, 0x95, 0xc6, 0x2b, 0xb3, 0x03, 0x58, 0x4d, 0xe4, 0x59, 0xd4,
+	0xb7, 0xec, 0x96, 0x1f, 0x11, 0x2e, 0xc8, 0x8f, 0x45, 0x63, 0xae, 0x6c, 0xaf, 0x0e, 0xc8, 0xda,
+	0x93, 0xa8, 0xce, 0x5c, 0x49, 0x78, 0x0f, 0xfc, 0x5d, 0xc6, 0x79, 0x20, 0x18, 0xd1, 0xcf, 0x60,
+	0x85, 0x2b, 0x19, 0x14, 0x59, 0x3e, 0x4b, 0xe4, 0x65, 0xce, 0xd8, 0x27, 0x6f, 0x1f, 0x2e, 0x35,
+	0x09, 0x0e, 0xe9, 0x21, 0xc1, 0x34, 0x15, 0x05, 0x67, 0x89, 0x5a, 0x4e, 0x79, 0x12, 0x39, 0x99,
+	0xe9, 0x55, 0xc9, 0x4f, 0xaf, 0x57, 0xb0, 0x96, 0xbf, 0x09, 0xcb, 0x3f, 0xb2, 0x68, 0xd3, 0x8d,
+	0xac, 0x84, 0x61, 0xe1, 0xcc, 0xc0, 0xd6, 0x72, 0x37, 0xf3, 0xec, 0xe8, 0xa0, 0xe9, 0x46, 0x3b,
+	0x52, 0x7e, 0x23, 0xeb, 0x81, 0x43, 0x28, 0x76, 0x5b, 0x11, 0xef, 0xd0, 0x67, 0x65, 0x4a, 0xcf,
+	0x89, 0x3d, 0xc1, 0x35, 0x08, 0x26, 0xaa, 0x93, 0x81, 0x89, 0xf7, 0x60, 0x29, 0x95, 0x23, 0x3a,
+	0x06, 0x6f, 0xf2, 0x65, 0xb3, 0x9a, 0x6c, 0xef, 0xf1, 0x5d, 0xf4, 0x01, 0xcc, 0x35, 0x09, 0x76,
+	0x48, 0x28, 0x7b, 0xf8, 0x35, 0xa5, 0xa6, 0x27, 0x9c, 0xc4, 0x94, 0xa4, 0xf5, 0x3f, 0xcf, 0xc2,
+	0xca, 0x8e, 0xe3, 0xa8, 0x80, 0x67, 0xae, 0x65, 0x69, 0x7d, 0x2d, 0xeb, 0x1b, 0x6a, 0x03, 0xf7,
+	0xa1, 0xdc, 0x1b, 0xb8, 0xd3, 0xa3, 0x0c, 0xdc, 0x79, 0x9a, 0xcc, 0xd7, 0x9b, 0x50, 0x49, 0x6b,
+	0x44, 0xe2, 0xac, 0x69, 0x13, 0x92, 0xad, 0x86, 0xd3, 0x5f, 0x44, 0x32, 0xf5, 0x65, 0x9a, 0xce,
+	0x8e, 0x51, 0x44, 0x1c, 0x96, 0x25, 0xc9, 0x7a, 0x1f, 0xe6, 0x22, 0x3f, 0x0e, 0x6d, 0xd1, 0x14,
+	0xaa, 0xfd, 0x23, 0x28, 0x83, 0x41, 0x70, 0x74, 0xf2, 0x82, 0x53, 0x9a, 0x92, 0x43, 0xd1, 0xdb,
+	0x4b, 0xaa, 0xde, 0x1e, 0xc0, 0x72, 0x80, 0x43, 0xea, 0xf2, 0xde, 0x6e, 0xfb, 0xde, 0x91, 0x7b,
+	0xac, 0xcf, 0xf3, 0x69, 0xfb, 0xa8, 0x78, 0xda, 0xaa, 0x6f, 0xd5, 0x78, 0x9e, 0x08, 0xda, 0xe5,
+	0x72, 0xc4, 0xc0, 0x5d, 0x0a, 0xf2, 0xbb, 0xa8, 0x06, 0xf3, 0x41, 0xe8, 0xfa, 0xa1, 0x4b, 0xbb,
+	0xbc, 0x17, 0xcc, 0x9a, 0xe9, 0x7a, 0x30, 0xb1, 0x61, 0xa2, 0xc4, 0xae, 0x3d, 0x84, 0x2b, 0x2a,
+	0x63, 0x14, 0x43, 0xfe, 0x4a, 0x76, 0xc8, 0x97, 0xb3, 0x03, 0x7c, 0x15, 0xae, 0x0e, 0xf8, 0x29,
+	0xe6, 0x58, 0xfd, 0x1f, 0x73, 0x3c, 0xb3, 0x55, 0x73, 0xfd, 0xdb, 0xc8, 0x6c, 0x86, 0xdd, 0xf9,
+	0xa5, 0x5b, 0x3d, 0xd5, 0x62, 0xca, 0x55, 0xc5, 0xfe, 0x5e, 0x62, 0x40, 0xae, 0x06, 0x66, 0xce,
+	0x55, 0x03, 0xb3, 0xe3, 0xd5, 0xc0, 0xdc, 0xf9, 0x6b, 0xa0, 0x74, 0x01, 0x35, 0x30, 0xaf, 0xaa,
+	0x01, 0x0f, 0x74, 0x9c, 0xb9, 0xca, 0x3d, 0x37, 0x0a, 0x58, 0xb2, 0x33, 0xe4, 0x2e, 0xa7, 0xd5,
+	0xf6, 0x90, 0x5a, 0x28, 0xe0, 0x34, 0x0b, 0x65, 0x2a, 0x6b, 0x0e, 0x46, 0xa8, 0x39, 0x45, 0xbe,
+	0x4d, 0x50, 0x73, 0x95, 0xc1, 0x9a, 0xcb, 0xa3, 0x9f, 0x85, 0x89, 0xd0, 0xcf, 0x85, 0xd4, 0xdc,
+	0xd7, 0xd3, 0xa0, 0x17, 0x05, 0x14, 0xfd, 0x04, 0x96, 0x7a, 0x03, 0x9a, 0xbf, 0x69, 0x24, 0x74,
+	0x56, 0x9b, 0xfa, 0x44, 0x7c, 0x30, 0xe1, 0x0f, 0x4f, 0xb3, 0x07, 0xb2, 0xf8, 0x7a, 0x00, 0x33,
+	0x4d, 0x8d, 0x87, 0x99, 0x32, 0x28, 0x62, 0x7a, 0x5c, 0x14, 0x31, 0x73, 0xf1, 0x28, 0x62, 0xf6,
+	0x62, 0x50, 0xc4, 0xdc, 0x85, 0xa1, 0x88, 0x92, 0x0a, 0x45, 0xc8, 0x8e, 0xaa, 0x7a, 0x19, 0xd4,
+	0xbf, 0xd6, 0xe0, 0x0a, 0x7f, 0x42, 0x25, 0x7a, 0x92, 0x7e, 0xba, 0xdb, 0xff, 0x4e, 0xfa, 0xae,
+	0xd2, 0x3c, 0x15, 0xef, 0x88, 0x2f, 0xa4, 0xf3, 0xe0, 0x82, 0xd1, 0x1e, 0x50, 0xf5, 0x3f, 0x69,
+	0xf0, 0x56, 0x9f, 0x85, 0xf2, 0x45, 0xf4, 0x63, 0x58, 0xe0, 0x5f, 0x1d, 0xac, 0x90, 0x44, 0x71,
+	0x2b, 0xf1, 0x71, 0xf8, 0x4d, 0x56, 0x38, 0x87, 0xc9, 0x19, 0x50, 0x03, 0xaa, 0x89, 0x80, 0x5f,
+	0x12, 0x9b, 0x12, 0x67, 0xe8, 0x6b, 0x55, 0xbc, 0x52, 0x25, 0xa5, 0xb9, 0xf8, 0x3a, 0xbb, 0xac,
+	0xff, 0x5b, 0x83, 0x75, 0x61, 0x98, 0xc3, 0xe9, 0x98, 0xbf, 0xbb, 0x7e, 0x3b, 0x68, 0x11, 0x46,
+	0x2c, 0x43, 0xf9, 0xac, 0xff, 0x3e, 0xee, 0x28, 0x15, 0x9d, 0x25, 0xe7, 0x7f, 0x70, 0x37, 0x57,
+	0xa1, 0xc4, 0x79, 0x25, 0x5e, 0x2b, 0x9b, 0x73, 0x6c, 0xd9, 0x70, 0xea, 0x6f, 0xc3, 0xad, 0x21,
+	0xe6, 0xc9, 0x84, 0xfc, 0xa7, 0x06, 0xd7, 0x77, 0xb1, 0x67, 0x93, 0xd6, 0xb3, 0x98, 0x46, 0x14,
+	0x7b, 0x8e, 0xeb, 0x1d, 0xb3, 0xb7, 0xed, 0x48, 0x83, 0x3e, 0xf7, 0xea, 0x9e, 0xea, 0x7b, 0x75,
+	0x3f, 0x86, 0x6a, 0xea, 0x54, 0xef, 0x5b, 0x60, 0xb5, 0xa0, 0xf0, 0x12, 0xcf, 0x44, 0xe1, 0xd1,
+	0xcc, 0xea, 0x3c, 0xd3, 0xbc, 0x7e, 0x13, 0x6e, 0x14, 0xb8, 0x27, 0x03, 0xf0, 0x6b, 0xb8, 0xba,
+	0x47, 0x22, 0x3b, 0x74, 0x0f, 0x49, 0xca, 0x2e, 0x5d, 0xdf, 0xef, 0xcf, 0x81, 0xf7, 0x95, 0x5a,
+	0x0b, 0xd8, 0x47, 0xbb, 0xfa, 0xfa, 0x57, 0x1a, 0xe8, 0x83, 0x12, 0x64, 0xd9, 0x7c, 0x04, 0x25,
+	0x11, 0xce, 0x48, 0xd7, 0xf8, 0xe0, 0xbc, 0x59, 0xf8, 0xf5, 0x84, 0x84, 0x7c, 0x1a, 0x27, 0xf4,
+	0xe8, 0x29, 0x2c, 0xf7, 0xa2, 0x1f, 0x51, 0x4c, 0xe3, 0x48, 0x96, 0xcc, 0xdb, 0x43, 0x63, 0xf7,
+	0x82, 0x93, 0x9a, 0x55, 0x9a, 0x5b, 0xd7, 0x23, 0xb8, 0xc1, 0xef, 0x43, 0xee, 0xa6, 0x13, 0x30,
+	0x4a, 0x82, 0xb5, 0x02, 0x73, 0xb2, 0x29, 0x8a, 0x24, 0x91, 0xab, 0xfc, 0xe5, 0x4d, 0x8d, 0x77,
+	0x79, 0xbf, 0x9d, 0x82, 0xb5, 0x22, 0xad, 0x32, 0x42, 0xaf, 0xe1, 0x46, 0x6f, 0xaa, 0xa7, 0xfe,
+	0xa6, 0xb8, 0x20, 0x89, 0x9b, 0x31, 0x54, 0x65, 0x2a, 0xf7, 0x29, 0xa1, 0xd8, 0xc1, 0x14, 0x9b,
+	0xb5, 0x2c, 0xa8, 0xc9, 0xab, 0x66, 0x2a, 0xd3, 0x0f, 0xa7, 0x4a, 0x95, 0x53, 0x93, 0xa9, 0x74,
+	0x32, 0x10, 0x3c, 0xaf, 0xb2, 0x7e, 0x07, 0xae, 0x3d, 0x26, 0x69, 0x18, 0xa2, 0x87, 0x5d, 0x31,
+	0x69, 0xce, 0x88, 0x7d, 0xfd, 0x0f, 0x1a, 0x5c, 0x1d, 0x90, 0x26, 0xa1, 0x92, 0x0e, 0xa5, 0xe4,
+	0xf3, 0xa9, 0xc6, 0x21, 0x6e, 0xb2, 0x44, 0x06, 0x5c, 0xf6, 0xe2, 0xb6, 0x15, 0x12, 0xec, 0xe4,
+	0xbd, 0xe2, 0x1f, 0x59, 0xbd, 0xb8, 0x6d, 0x12, 0xec, 0x64, 0xe2, 0x71, 0x1b, 0xae, 0x30, 0xfa,
+	0xd3, 0xd0, 0xa5, 0x24, 0xcb, 0x20, 0x10, 0x03, 0xf2, 0xe2, 0xf6, 0x27, 0xec, 0x28, 0xe3, 0xce,
+	0x5f, 0x34, 0xb8, 0x95, 0xf1, 0xa7, 0xcf, 0xb4, 0x91, 0x3a, 0xcf, 0x39, 0xd2, 0xea, 0xc2, 0x1a,
+	0x53, 0xfd, 0x73, 0x0d, 0xea, 0xc3, 0xfc, 0x90, 0x39, 0xfa, 0xa9, 0x02, 0x07, 0x8b, 0x86, 0xb2,
+	0x55, 0x8c, 0x83, 0x8b, 0x84, 0xf6, 0x63, 0xde, 0xfa, 0x57, 0x33, 0x70, 0x5d, 0x9d, 0x1c, 0x52,
+	0xfd, 0x17, 0x1a, 0xac, 0x28, 0x12, 0xb6, 0x8d, 0x03, 0x59, 0x1c, 0xcf, 0x8a, 0xad, 0x18, 0x26,
+	0xd8, 0xd8, 0xeb, 0x4b, 0xd8, 0xa7, 0x38, 0x10, 0xb8, 0xfc, 0xb2, 0x33, 0x78, 0xc2, 0xcd, 0x50,
+	0x94, 0x2a, 0x33, 0x63, 0xea, 0x5c, 0x66, 0xec, 0xf4, 0x95, 0x6a, 0xcf, 0x0c, 0x3c, 0x78, 0x52,
+	0xfb, 0x15, 0x6b, 0xb7, 0x6a, 0xbb, 0x15, 0x10, 0xfe, 0x49, 0xfe, 0xdb, 0xf8, 0x90, 0xf7, 0x51,
+	0x51, 0x0f, 0xcf, 0xc0, 0x7e, 0xa6, 0xbb, 0xc8, 0xd8, 0x6f, 0x5a, 0xf7, 0xf6, 0x5f, 0x2b, 0x50,
+	0x79, 0x2a, 0x79, 0x76, 0x9e, 0x37, 0xd0, 0xe7, 0x1a, 0x5c, 0x56, 0xfc, 0x37, 0x01, 0x7d, 0x38,
+	0xe6, 0x3f, 0x1f, 0x78, 0xad, 0xd6, 0xee, 0x4c, 0xf4, 0x2f, 0x8b, 0xac, 0x11, 0xd9, 0xc0, 0x8c,
+	0x60, 0x84, 0xe2, 0x8d, 0x38, 0x82, 0x11, 0xca, 0xaf, 0xf5, 0x1d, 0x58, 0xea, 0xfb, 0x00, 0x82,
+	0x6e, 0x8f, 0xfb, 0x4d, 0xa8, 0xb6, 0x35, 0x06, 0x47, 0x4e, 0x6f, 0xce, 0xef, 0xdb, 0xe3, 0xbe,
+	0x8b, 0xcf, 0xd0, 0xab, 0xf4, 0x37, 0x80, 0xc5, 0x1c, 0x48, 0x47, 0x46, 0xb1, 0x0c, 0xd5, 0x7b,
+	0xa3, 0xb6, 0x39, 0x32, 0xbd, 0xd4, 0xf8, 0x7b, 0x0d, 0x56, 0x0b, 0xa1, 0x28, 0xba, 0x5f, 0x2c,
+	0xee, 0x2c, 0x78, 0x5d, 0x7b, 0x30, 0x11, 0xaf, 0x34, 0xeb, 0x77, 0x1a, 0xbc, 0xa5, 0x04, 0x87,
+	0xe8, 0x6e, 0xb1, 0xd8, 0x61, 0x60, 0xb9, 0xf6, 0x83, 0xb1, 0xf9, 0xa4, 0x29, 0x5d, 0x58, 0xee,
+	0x2f, 0x62, 0xb4, 0x35, 0x4e, 0xc1, 0x0b, 0xfd, 0x13, 0xf4, 0x08, 0xf4, 0xa5, 0x06, 0x2b, 0x6a,
+	0x90, 0x85, 0x86, 0xb8, 0x33, 0x14, 0x0c, 0xd6, 0xee, 0x8d, 0xcf, 0x28, 0xad, 0xf9, 0x8d, 0x06,
+	0x57, 0x54, 0xdd, 0x1e, 0xdd, 0x19, 0x77, 0x3a, 0x08, 0x4b, 0xee, 0x4e, 0x36, 0x54, 0xd0, 0x1f,
+	0x35, 0xa8, 0x15, 0x8f, 0x76, 0xf4, 0x60, 0x24, 0xb1, 0x6a, 0x60, 0x53, 0xfb, 0xe1, 0x64, 0xcc,
+	0xc2, 0xb2, 0x87, 0x8f, 0xff, 0xf6, 0x66, 0x4d, 0xfb, 0xfb, 0x9b, 0x35, 0xed, 0x5f, 0x6f, 0xd6,
+	0xb4, 0x5f, 0x7c, 0x74, 0xec, 0xd2, 0x66, 0x7c, 0x68, 0xd8, 0x7e, 0x7b, 0x33, 0xf7, 0x5b, 0x19,
+	0xe3, 0x98, 0x78, 0xe2, 0xc7, 0x45, 0xd9, 0xdf, 0x37, 0x3d, 0x48, 0xfe, 0xee, 0x6c, 0x1d, 0xce,
+	0xf1, 0xd3, 0x0f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x44, 0x10, 0xc1, 0x0d, 0x25, 0x00,
 	0x00,
 }
 
@@ -2339,6 +2557,23 @@ func (m *AddDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Priority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -2472,6 +2707,23 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ActivityType != nil {
+		{
+			size, err := m.ActivityType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Priority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -3226,7 +3478,7 @@ func (m *GetTaskListsByDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTaskListsByDomainResponse) Marshal() (dAtA []byte, err error) {
+func (m *TaskListPartitionConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3236,12 +3488,12 @@ func (m *GetTaskListsByDomainResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTaskListsByDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskListPartitionConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskListPartitionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3250,83 +3502,215 @@ func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActivityTaskListMap) > 0 {
-		for k := range m.ActivityTaskListMap {
-			v := m.ActivityTaskListMap[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.NumWritePartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumWritePartitions))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.DecisionTaskListMap) > 0 {
-		for k := range m.DecisionTaskListMap {
-			v := m.DecisionTaskListMap[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.NumReadPartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumReadPartitions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetTaskListPartitionConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PollForDecisionTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetTaskListPartitionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskListPartitionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskListPartitionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListPartitionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskListsByDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskListsByDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActivityTaskListMap) > 0 {
+		for k := range m.ActivityTaskListMap {
+			v := m.ActivityTaskListMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DecisionTaskListMap) > 0 {
+		for k := range m.DecisionTaskListMap {
+			v := m.DecisionTaskListMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PollForDecisionTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -3578,6 +3962,13 @@ func (m *AddDecisionTaskRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if m.Priority != 0 {
+		n += 1 + sovService(uint64(m.Priority))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3644,6 +4035,13 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if m.Priority != 0 {
+		n += 1 + sovService(uint64(m.Priority))
+	}
+	if m.ActivityType != nil {
+		l = m.ActivityType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3930,6 +4328,66 @@ func (m *GetTaskListsByDomainRequest) Size() (n int) {
 	return n
 }
 
+func (m *TaskListPartitionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovService(uint64(m.Version))
+	}
+	if m.NumReadPartitions != 0 {
+		n += 1 + sovService(uint64(m.NumReadPartitions))
+	}
+	if m.NumWritePartitions != 0 {
+		n += 1 + sovService(uint64(m.NumWritePartitions))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListPartitionConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListPartitionConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GetTaskListsByDomainResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6021,23 +6479,78 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -6528,6 +7041,61 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityType == nil {
+				m.ActivityType = &v1.ActivityType{}
+			}
+			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8213,6 +8781,339 @@ func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListPartitionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListPartitionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReadPartitions", wireType)
+			}
+			m.NumReadPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReadPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWritePartitions", wireType)
+			}
+			m.NumWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskListPartitionConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskListPartitionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskListPartitionConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskListPartitionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &TaskListPartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,6 +54,7 @@ type MatchingAPIYARPCClient interface {
 	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest, ...yarpc.CallOption) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest, ...yarpc.CallOption) (*GetTaskListsByDomainResponse, error)
+	GetTaskListPartitionConfig(context.Context, *GetTaskListPartitionConfigRequest, ...yarpc.CallOption) (*GetTaskListPartitionConfigResponse, error)
 }
 
 func newMatchingAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) MatchingAPIYARPCClient {
@@ -84,6 +85,7 @@ type MatchingAPIYARPCServer interface {
 	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest) (*GetTaskListsByDomainResponse, error)
+	GetTaskListPartitionConfig(context.Context, *GetTaskListPartitionConfigRequest) (*GetTaskListPartitionConfigResponse, error)
 }
 
 type buildMatchingAPIYARPCProceduresParams struct {
@@ -197,6 +199,16 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "GetTaskListPartitionConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetTaskListPartitionConfig,
+							NewRequest:  newMatchingAPIServiceGetTaskListPartitionConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -435,6 +447,18 @@ func (c *_MatchingAPIYARPCCaller) GetTaskListsByDomain(ctx context.Context, requ
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) GetTaskListPartitionConfig(ctx context.Context, request *GetTaskListPartitionConfigRequest, options ...yarpc.CallOption) (*GetTaskListPartitionConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetTaskListPartitionConfig", request, newMatchingAPIServiceGetTaskListPartitionConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetTaskListPartitionConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceGetTaskListPartitionConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _MatchingAPIYARPCHandler struct {
 	server MatchingAPIYARPCServer
 }
@@ -599,6 +623,22 @@ func (h *_MatchingAPIYARPCHandler) GetTaskListsByDomain(ctx context.Context, req
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) GetTaskListPartitionConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetTaskListPartitionConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetTaskListPartitionConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceGetTaskListPartitionConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetTaskListPartitionConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newMatchingAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
 	return &PollForDecisionTaskRequest{}
 }
@@ -679,161 +719,181 @@ func newMatchingAPIServiceGetTaskListsByDomainYARPCResponse() proto.Message {
 	return &GetTaskListsByDomainResponse{}
 }
 
+func newMatchingAPIServiceGetTaskListPartitionConfigYARPCRequest() proto.Message {
+	return &GetTaskListPartitionConfigRequest{}
+}
+
+func newMatchingAPIServiceGetTaskListPartitionConfigYARPCResponse() proto.Message {
+	return &GetTaskListPartitionConfigResponse{}
+}
+
 var (
-	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest        = &PollForDecisionTaskRequest{}
-	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse       = &PollForDecisionTaskResponse{}
-	emptyMatchingAPIServicePollForActivityTaskYARPCRequest        = &PollForActivityTaskRequest{}
-	emptyMatchingAPIServicePollForActivityTaskYARPCResponse       = &PollForActivityTaskResponse{}
-	emptyMatchingAPIServiceAddDecisionTaskYARPCRequest            = &AddDecisionTaskRequest{}
-	emptyMatchingAPIServiceAddDecisionTaskYARPCResponse           = &AddDecisionTaskResponse{}
-	emptyMatchingAPIServiceAddActivityTaskYARPCRequest            = &AddActivityTaskRequest{}
-	emptyMatchingAPIServiceAddActivityTaskYARPCResponse           = &AddActivityTaskResponse{}
-	emptyMatchingAPIServiceQueryWorkflowYARPCRequest              = &QueryWorkflowRequest{}
-	emptyMatchingAPIServiceQueryWorkflowYARPCResponse             = &QueryWorkflowResponse{}
-	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCRequest  = &RespondQueryTaskCompletedRequest{}
-	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCResponse = &RespondQueryTaskCompletedResponse{}
-	emptyMatchingAPIServiceCancelOutstandingPollYARPCRequest      = &CancelOutstandingPollRequest{}
-	emptyMatchingAPIServiceCancelOutstandingPollYARPCResponse     = &CancelOutstandingPollResponse{}
-	emptyMatchingAPIServiceDescribeTaskListYARPCRequest           = &DescribeTaskListRequest{}
-	emptyMatchingAPIServiceDescribeTaskListYARPCResponse          = &DescribeTaskListResponse{}
-	emptyMatchingAPIServiceListTaskListPartitionsYARPCRequest     = &ListTaskListPartitionsRequest{}
-	emptyMatchingAPIServiceListTaskListPartitionsYARPCResponse    = &ListTaskListPartitionsResponse{}
-	emptyMatchingAPIServiceGetTaskListsByDomainYARPCRequest       = &GetTaskListsByDomainRequest{}
-	emptyMatchingAPIServiceGetTaskListsByDomainYARPCResponse      = &GetTaskListsByDomainResponse{}
+	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest         = &PollForDecisionTaskRequest{}
+	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse        = &PollForDecisionTaskResponse{}
+	emptyMatchingAPIServicePollForActivityTaskYARPCRequest         = &PollForActivityTaskRequest{}
+	emptyMatchingAPIServicePollForActivityTaskYARPCResponse        = &PollForActivityTaskResponse{}
+	emptyMatchingAPIServiceAddDecisionTaskYARPCRequest             = &AddDecisionTaskRequest{}
+	emptyMatchingAPIServiceAddDecisionTaskYARPCResponse            = &AddDecisionTaskResponse{}
+	emptyMatchingAPIServiceAddActivityTaskYARPCRequest             = &AddActivityTaskRequest{}
+	emptyMatchingAPIServiceAddActivityTaskYARPCResponse            = &AddActivityTaskResponse{}
+	emptyMatchingAPIServiceQueryWorkflowYARPCRequest               = &QueryWorkflowRequest{}
+	emptyMatchingAPIServiceQueryWorkflowYARPCResponse              = &QueryWorkflowResponse{}
+	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCRequest   = &RespondQueryTaskCompletedRequest{}
+	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCResponse  = &RespondQueryTaskCompletedResponse{}
+	emptyMatchingAPIServiceCancelOutstandingPollYARPCRequest       = &CancelOutstandingPollRequest{}
+	emptyMatchingAPIServiceCancelOutstandingPollYARPCResponse      = &CancelOutstandingPollResponse{}
+	emptyMatchingAPIServiceDescribeTaskListYARPCRequest            = &DescribeTaskListRequest{}
+	emptyMatchingAPIServiceDescribeTaskListYARPCResponse           = &DescribeTaskListResponse{}
+	emptyMatchingAPIServiceListTaskListPartitionsYARPCRequest      = &ListTaskListPartitionsRequest{}
+	emptyMatchingAPIServiceListTaskListPartitionsYARPCResponse     = &ListTaskListPartitionsResponse{}
+	emptyMatchingAPIServiceGetTaskListsByDomainYARPCRequest        = &GetTaskListsByDomainRequest{}
+	emptyMatchingAPIServiceGetTaskListsByDomainYARPCResponse       = &GetTaskListsByDomainResponse{}
+	emptyMatchingAPIServiceGetTaskListPartitionConfigYARPCRequest  = &GetTaskListPartitionConfigRequest{}
+	emptyMatchingAPIServiceGetTaskListPartitionConfigYARPCResponse = &GetTaskListPartitionConfigResponse{}
 )
 
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
-		0xf5, 0xc7, 0xea, 0x46, 0xf1, 0x50, 0xa2, 0xe4, 0xb1, 0x23, 0xaf, 0xe8, 0x8b, 0x64, 0xe6, 0x9f,
-		0x44, 0xff, 0x22, 0x5d, 0x45, 0x4a, 0xec, 0x3a, 0x36, 0x8a, 0x42, 0xd6, 0xc5, 0x66, 0x51, 0xd7,
-		0xce, 0x5a, 0x75, 0x81, 0xa2, 0xf0, 0x62, 0xb4, 0x3b, 0x12, 0xb7, 0x22, 0x77, 0xd7, 0x3b, 0x43,
-		0x2a, 0xec, 0x43, 0x1f, 0x8a, 0xb4, 0x28, 0x90, 0xd7, 0x7e, 0x83, 0xe6, 0xb1, 0x1f, 0xa8, 0x40,
-		0xd0, 0xc7, 0x7e, 0x80, 0xf6, 0x13, 0x14, 0x73, 0xd9, 0xe5, 0x2e, 0x39, 0xcb, 0x8b, 0xe4, 0x24,
-		0x7d, 0xe3, 0xce, 0x9c, 0xf3, 0x3b, 0x67, 0xce, 0x7d, 0x46, 0x82, 0x0f, 0x3b, 0x27, 0x24, 0xde,
-		0x76, 0xb1, 0x47, 0x02, 0x97, 0x6c, 0xb7, 0x31, 0x73, 0x9b, 0x7e, 0x70, 0xb6, 0xdd, 0xdd, 0xd9,
-		0xa6, 0x24, 0xee, 0xfa, 0x2e, 0xb1, 0xa2, 0x38, 0x64, 0x21, 0x32, 0x39, 0x9d, 0xa5, 0xe8, 0xac,
-		0x84, 0xce, 0xea, 0xee, 0xd4, 0xee, 0x9e, 0x85, 0xe1, 0x59, 0x8b, 0x6c, 0x0b, 0xba, 0x93, 0xce,
-		0xe9, 0xb6, 0xd7, 0x89, 0x31, 0xf3, 0xc3, 0x40, 0x72, 0xd6, 0x36, 0x06, 0xf7, 0x99, 0xdf, 0x26,
-		0x94, 0xe1, 0x76, 0xa4, 0x08, 0x86, 0x00, 0x2e, 0x62, 0x1c, 0x45, 0x24, 0xa6, 0x6a, 0x7f, 0x33,
-		0xa7, 0x22, 0x8e, 0x7c, 0xae, 0x9d, 0x1b, 0xb6, 0xdb, 0x7d, 0x11, 0x3a, 0x8a, 0xb7, 0x1d, 0x12,
-		0xf7, 0x14, 0x41, 0x5d, 0x47, 0xc0, 0x30, 0x3d, 0x6f, 0xf9, 0x94, 0x29, 0x9a, 0x2d, 0x1d, 0x8d,
-		0x32, 0x82, 0x73, 0x11, 0xc6, 0xe7, 0x24, 0x56, 0x94, 0x3f, 0x1a, 0x47, 0x79, 0xda, 0x0a, 0x2f,
-		0x14, 0xed, 0x3d, 0x1d, 0x6d, 0xd3, 0xa7, 0x2c, 0x4c, 0x95, 0xfb, 0xbf, 0x1c, 0x09, 0x6d, 0xe2,
-		0x98, 0x78, 0xc3, 0x54, 0x1f, 0x14, 0x50, 0xe5, 0x4f, 0x51, 0xff, 0xb7, 0x01, 0xb5, 0x97, 0x61,
-		0xab, 0x75, 0x14, 0xc6, 0x07, 0xc4, 0xf5, 0xa9, 0x1f, 0x06, 0xc7, 0x98, 0x9e, 0xdb, 0xe4, 0x6d,
-		0x87, 0x50, 0x86, 0x1a, 0x50, 0x8a, 0xe5, 0x4f, 0xd3, 0xd8, 0x34, 0xb6, 0x2a, 0xbb, 0xdb, 0x56,
-		0xce, 0xb1, 0x38, 0xf2, 0xad, 0xee, 0x8e, 0x55, 0x8c, 0x60, 0x27, 0xfc, 0xe8, 0x16, 0x94, 0xbd,
-		0xb0, 0x8d, 0xfd, 0xc0, 0xf1, 0x3d, 0x73, 0x66, 0xd3, 0xd8, 0x2a, 0xdb, 0x8b, 0x72, 0xa1, 0xe1,
-		0xf1, 0xcd, 0x28, 0x6c, 0xb5, 0x48, 0xcc, 0x37, 0x67, 0xe5, 0xa6, 0x5c, 0x68, 0x78, 0xe8, 0x03,
-		0xa8, 0x9e, 0x86, 0xf1, 0x05, 0x8e, 0x3d, 0xe2, 0x39, 0xa7, 0x71, 0xd8, 0x36, 0xe7, 0x04, 0xc5,
-		0x72, 0xba, 0x7a, 0x14, 0x87, 0x6d, 0xf4, 0x11, 0xac, 0xf8, 0x34, 0x6c, 0x89, 0x58, 0x72, 0xce,
-		0xe2, 0xb0, 0x13, 0x99, 0xf3, 0x82, 0xae, 0x9a, 0x2e, 0x3f, 0xe5, 0xab, 0xf5, 0xaf, 0xca, 0x70,
-		0x4b, 0xab, 0x31, 0x8d, 0xc2, 0x80, 0x12, 0x74, 0x07, 0x80, 0x5b, 0xc9, 0x61, 0xe1, 0x39, 0x09,
-		0xc4, 0xb9, 0x97, 0xec, 0x32, 0x5f, 0x39, 0xe6, 0x0b, 0xe8, 0x57, 0x80, 0x12, 0xa7, 0x39, 0xe4,
-		0x4b, 0xe2, 0x76, 0x38, 0xb2, 0x38, 0x51, 0x65, 0xf7, 0x43, 0xad, 0x79, 0x7e, 0xad, 0xc8, 0x0f,
-		0x13, 0x6a, 0xfb, 0xda, 0xc5, 0xe0, 0x12, 0x3a, 0x82, 0xe5, 0x14, 0x96, 0xf5, 0x22, 0x22, 0xcc,
-		0x50, 0xd9, 0xbd, 0x37, 0x12, 0xf1, 0xb8, 0x17, 0x11, 0x7b, 0xe9, 0x22, 0xf3, 0x85, 0x5e, 0xc3,
-		0x7a, 0x14, 0x93, 0xae, 0x1f, 0x76, 0xa8, 0x43, 0x19, 0x8e, 0x19, 0xf1, 0x1c, 0xd2, 0x25, 0x01,
-		0xe3, 0xa6, 0x9d, 0x13, 0x98, 0xb7, 0x2c, 0x99, 0x42, 0x56, 0x92, 0x42, 0x56, 0x23, 0x60, 0x0f,
-		0x3e, 0x7b, 0x8d, 0x5b, 0x1d, 0x62, 0xaf, 0x25, 0xdc, 0xaf, 0x24, 0xf3, 0x21, 0xe7, 0x6d, 0x78,
-		0x68, 0x0b, 0x56, 0x87, 0xe0, 0xb8, 0x7d, 0x67, 0xed, 0x2a, 0xcd, 0x53, 0x9a, 0x50, 0xc2, 0x8c,
-		0x91, 0x76, 0xc4, 0xcc, 0x85, 0x4d, 0x63, 0x6b, 0xde, 0x4e, 0x3e, 0x51, 0x1d, 0x96, 0x03, 0xf2,
-		0x25, 0xeb, 0x03, 0x94, 0x04, 0x40, 0x85, 0x2f, 0x26, 0xdc, 0x1f, 0x03, 0x3a, 0xc1, 0xee, 0x79,
-		0x2b, 0x3c, 0x73, 0xdc, 0xb0, 0x13, 0x30, 0xa7, 0xe9, 0x07, 0xcc, 0x5c, 0x14, 0x84, 0xab, 0x6a,
-		0x67, 0x9f, 0x6f, 0x3c, 0xf3, 0x03, 0x86, 0x1e, 0x82, 0x49, 0x99, 0xef, 0x9e, 0xf7, 0xfa, 0xae,
-		0x70, 0x48, 0x80, 0x4f, 0x5a, 0xc4, 0x33, 0xcb, 0x9b, 0xc6, 0xd6, 0xa2, 0xbd, 0x26, 0xf7, 0x53,
-		0x43, 0x1f, 0xca, 0x5d, 0xf4, 0x10, 0xe6, 0x45, 0xca, 0x9b, 0x20, 0x6c, 0x52, 0x1f, 0x69, 0xe7,
-		0x2f, 0x38, 0xa5, 0x2d, 0x19, 0x90, 0x0d, 0xcb, 0x9e, 0x8a, 0x1b, 0xc7, 0x0f, 0x4e, 0x43, 0xb3,
-		0x22, 0x10, 0x7e, 0x9c, 0x47, 0x90, 0x29, 0xc7, 0x41, 0x8e, 0x63, 0x1c, 0x50, 0x9f, 0x04, 0x2c,
-		0x89, 0xb6, 0x46, 0x70, 0x1a, 0xda, 0x4b, 0x5e, 0xe6, 0x0b, 0xbd, 0x81, 0xdb, 0xc3, 0x41, 0xe5,
-		0x88, 0x30, 0xe4, 0xd9, 0x6a, 0x2e, 0x09, 0x11, 0x77, 0xb4, 0x4a, 0xf2, 0xe0, 0xfd, 0x85, 0x4f,
-		0x99, 0xbd, 0x3e, 0x14, 0x55, 0xc9, 0x16, 0xb2, 0xe0, 0xba, 0x34, 0x3a, 0xaf, 0x11, 0xc4, 0xe9,
-		0x92, 0x98, 0x8b, 0x36, 0x97, 0x85, 0x7f, 0xae, 0x89, 0xad, 0x57, 0x7c, 0xe7, 0xb5, 0xdc, 0x40,
-		0xf7, 0x60, 0xe9, 0x24, 0xc6, 0x81, 0xdb, 0x54, 0x59, 0x50, 0x15, 0x59, 0x50, 0x91, 0x6b, 0x32,
-		0x0f, 0xf6, 0xa0, 0x4a, 0xdd, 0x26, 0xf1, 0x3a, 0x2d, 0xe2, 0x39, 0xbc, 0x48, 0x9b, 0x2b, 0x42,
-		0xc9, 0xda, 0x50, 0x74, 0x1d, 0x27, 0x15, 0xdc, 0x5e, 0x4e, 0x39, 0xf8, 0x1a, 0xfa, 0x29, 0x2c,
-		0x25, 0x31, 0x25, 0x00, 0x56, 0xc7, 0x02, 0x54, 0x14, 0xbd, 0x60, 0xff, 0x2d, 0x94, 0xb8, 0x47,
-		0x7c, 0x42, 0xcd, 0x6b, 0x9b, 0xb3, 0x5b, 0x95, 0xdd, 0x27, 0x56, 0x51, 0xdb, 0xb1, 0x46, 0x24,
-		0xbc, 0xf5, 0x85, 0x04, 0x39, 0x0c, 0x58, 0xdc, 0xb3, 0x13, 0xc8, 0xda, 0x1b, 0x58, 0xca, 0x6e,
-		0xa0, 0x55, 0x98, 0x3d, 0x27, 0x3d, 0x51, 0x0f, 0xca, 0x36, 0xff, 0xc9, 0x43, 0xa8, 0xcb, 0x73,
-		0x46, 0x25, 0xff, 0x44, 0x21, 0x24, 0x18, 0x1e, 0xcd, 0x3c, 0x34, 0xb2, 0xa5, 0x77, 0xcf, 0x65,
-		0x7e, 0xd7, 0x67, 0xbd, 0xcb, 0x97, 0x5e, 0x0d, 0xc2, 0xff, 0x62, 0xe9, 0xfd, 0x7a, 0x31, 0x2d,
-		0xbd, 0x79, 0x8d, 0x7f, 0xd0, 0xd2, 0xbb, 0x01, 0x15, 0xac, 0xb4, 0xe9, 0x1b, 0x01, 0x92, 0xa5,
-		0x86, 0xc7, 0x6b, 0x73, 0x4a, 0x20, 0x6a, 0xf3, 0xdc, 0x88, 0xda, 0x9c, 0x1e, 0x4c, 0xd4, 0x66,
-		0x9c, 0xf9, 0x42, 0xbb, 0x30, 0xef, 0x07, 0x51, 0x87, 0x09, 0xeb, 0x54, 0x76, 0x6f, 0xeb, 0x3d,
-		0x8a, 0x7b, 0xad, 0x10, 0x7b, 0xb6, 0x24, 0xd5, 0xa4, 0xd9, 0xc2, 0x55, 0xd3, 0xac, 0x34, 0x5d,
-		0x9a, 0x1d, 0xc3, 0x7a, 0x82, 0xe7, 0xb0, 0xd0, 0x71, 0x5b, 0x21, 0x25, 0x02, 0x28, 0xec, 0xc8,
-		0xc2, 0x5c, 0xd9, 0x5d, 0x1f, 0xc2, 0x3a, 0x50, 0x53, 0x9d, 0xbd, 0x96, 0xf0, 0x1e, 0x87, 0xfb,
-		0x9c, 0xf3, 0x58, 0x32, 0xa2, 0x5f, 0xc2, 0x9a, 0x10, 0x32, 0x0c, 0x59, 0x1e, 0x07, 0x79, 0x5d,
-		0x30, 0x0e, 0xe0, 0x1d, 0xc1, 0xb5, 0x26, 0xc1, 0x31, 0x3b, 0x21, 0x98, 0xa5, 0x50, 0x30, 0x0e,
-		0x6a, 0x35, 0xe5, 0x49, 0x70, 0x32, 0xdd, 0xab, 0x92, 0xef, 0x5e, 0x6f, 0xe0, 0x6e, 0xde, 0x13,
-		0x4e, 0x78, 0xea, 0xb0, 0xa6, 0x4f, 0x9d, 0x84, 0x61, 0x69, 0xac, 0x61, 0x6b, 0x39, 0xcf, 0xbc,
-		0x38, 0x3d, 0x6e, 0xfa, 0x74, 0x4f, 0xe1, 0x37, 0xb2, 0x27, 0xf0, 0x08, 0xc3, 0x7e, 0x8b, 0x8a,
-		0x0a, 0x3d, 0x2e, 0x52, 0xfa, 0x87, 0x38, 0x90, 0x5c, 0xc3, 0xc3, 0x44, 0xf5, 0x72, 0xc3, 0xc4,
-		0x47, 0xb0, 0x92, 0xe2, 0xc8, 0x8a, 0x21, 0x8a, 0x7c, 0xd9, 0xae, 0x26, 0xcb, 0x07, 0x62, 0x15,
-		0x7d, 0x0a, 0x0b, 0x4d, 0x82, 0x3d, 0x12, 0xab, 0x1a, 0x7e, 0x4b, 0x2b, 0xe9, 0x99, 0x20, 0xb1,
-		0x15, 0x69, 0xfd, 0x1f, 0x73, 0xb0, 0xb6, 0xe7, 0x79, 0xba, 0xc1, 0x33, 0x57, 0xb2, 0x8c, 0x81,
-		0x92, 0xf5, 0x1d, 0x95, 0x81, 0x47, 0x50, 0xee, 0x37, 0xdc, 0xd9, 0x49, 0x1a, 0xee, 0x22, 0x4b,
-		0xfa, 0xeb, 0x06, 0x54, 0xd2, 0x1c, 0x51, 0x73, 0xd6, 0xac, 0x0d, 0xc9, 0x52, 0xc3, 0x1b, 0x4c,
-		0x22, 0x15, 0xfa, 0x2a, 0x4c, 0xe7, 0xa7, 0x48, 0x22, 0x31, 0x96, 0x25, 0xc1, 0xfa, 0x08, 0x16,
-		0x68, 0xd8, 0x89, 0x5d, 0x59, 0x14, 0xaa, 0x83, 0x2d, 0x28, 0x33, 0x83, 0x60, 0x7a, 0xfe, 0x4a,
-		0x50, 0xda, 0x8a, 0x43, 0x53, 0xdb, 0x4b, 0xba, 0xda, 0x1e, 0xc1, 0x6a, 0x84, 0x63, 0xe6, 0x8b,
-		0xda, 0xee, 0x86, 0xc1, 0xa9, 0x7f, 0x66, 0x2e, 0x8a, 0x6e, 0x7b, 0x58, 0xdc, 0x6d, 0xf5, 0x5e,
-		0xb5, 0x5e, 0x26, 0x40, 0xfb, 0x02, 0x47, 0x36, 0xdc, 0x95, 0x28, 0xbf, 0x5a, 0x7b, 0x02, 0x37,
-		0x74, 0x84, 0x9a, 0x06, 0x7c, 0x23, 0xdb, 0x80, 0xcb, 0xd9, 0xe6, 0xba, 0x0e, 0x37, 0x87, 0x74,
-		0x90, 0x3d, 0xa6, 0xfe, 0x9f, 0x79, 0x11, 0x75, 0xba, 0x9e, 0xfb, 0x43, 0x44, 0x1d, 0x9f, 0xab,
-		0x85, 0x43, 0x9c, 0xbe, 0x68, 0xd9, 0x81, 0xaa, 0x72, 0xfd, 0x20, 0x51, 0x20, 0x17, 0x9f, 0x73,
-		0x57, 0x8a, 0xcf, 0xf9, 0xe9, 0xe2, 0x73, 0xe1, 0xea, 0xf1, 0x59, 0x7a, 0x07, 0xf1, 0xb9, 0xa8,
-		0x8b, 0xcf, 0x00, 0x4c, 0x9c, 0x71, 0xe5, 0x81, 0x4f, 0x23, 0x1e, 0x88, 0x7c, 0xaa, 0x56, 0x9d,
-		0x64, 0x77, 0x44, 0x9c, 0x16, 0x70, 0xda, 0x85, 0x98, 0xda, 0x7c, 0x80, 0x09, 0xf2, 0x41, 0x13,
-		0x6f, 0xdf, 0x63, 0x3e, 0x7c, 0x3b, 0x0b, 0x66, 0xd1, 0x61, 0xd1, 0xcf, 0x61, 0xa5, 0xdf, 0xd8,
-		0xc4, 0x5d, 0x40, 0x8d, 0x9c, 0xfa, 0x7e, 0xf1, 0x4c, 0x3e, 0x34, 0x88, 0x0b, 0x9b, 0xdd, 0x1f,
-		0x4e, 0xc4, 0xf7, 0xd0, 0xac, 0x31, 0x33, 0xdd, 0xac, 0x91, 0xe9, 0xbe, 0xb3, 0xd3, 0x76, 0xdf,
-		0xb9, 0x77, 0xdf, 0x7d, 0xe7, 0xdf, 0x4d, 0xf7, 0x5d, 0x78, 0x67, 0xdd, 0xb7, 0xa4, 0xeb, 0xbe,
-		0xaa, 0xda, 0xe9, 0x26, 0xea, 0xfa, 0xb7, 0x06, 0xdc, 0x10, 0x57, 0x8f, 0x44, 0x4e, 0x52, 0xeb,
-		0xf6, 0x07, 0xef, 0x17, 0xff, 0xaf, 0x55, 0x4f, 0xc7, 0x3b, 0xe1, 0xcd, 0xe2, 0x2a, 0xfd, 0x74,
-		0xb2, 0x8b, 0x47, 0xfd, 0x6f, 0x06, 0xbc, 0x37, 0xa0, 0xa1, 0xba, 0x49, 0xfc, 0x0c, 0x96, 0xc4,
-		0x6d, 0xdd, 0x89, 0x09, 0xed, 0xb4, 0x92, 0x33, 0x8e, 0xf6, 0x64, 0x45, 0x70, 0xd8, 0x82, 0x01,
-		0x35, 0xa0, 0x9a, 0x00, 0xfc, 0x8e, 0xb8, 0x8c, 0x78, 0x23, 0x6f, 0x79, 0xf2, 0x76, 0xa7, 0x28,
-		0xed, 0xe5, 0xb7, 0xd9, 0xcf, 0xfa, 0xbf, 0x0c, 0xd8, 0x94, 0x8a, 0x79, 0x82, 0x8e, 0x9f, 0x77,
-		0x3f, 0x6c, 0x47, 0x2d, 0xc2, 0x89, 0x95, 0x29, 0x5f, 0x0c, 0xfa, 0xe3, 0xbe, 0x56, 0xd0, 0x38,
-		0x9c, 0xef, 0xc1, 0x37, 0x37, 0xa1, 0x24, 0x78, 0xd5, 0x9c, 0x53, 0xb6, 0x17, 0xf8, 0x67, 0xc3,
-		0xab, 0xbf, 0x0f, 0xf7, 0x46, 0xa8, 0xa7, 0x02, 0xf2, 0x9f, 0x06, 0xdc, 0xde, 0xc7, 0x81, 0x4b,
-		0x5a, 0x2f, 0x3a, 0x8c, 0x32, 0x1c, 0x78, 0x7e, 0x70, 0xc6, 0xef, 0x84, 0x13, 0x35, 0xe1, 0xdc,
-		0x6d, 0x75, 0x66, 0xe0, 0xb6, 0xfa, 0x14, 0xaa, 0xe9, 0xa1, 0xfa, 0x6f, 0x68, 0xd5, 0x82, 0xc4,
-		0x4b, 0x4e, 0x26, 0x13, 0x8f, 0x65, 0xbe, 0xae, 0xd2, 0x69, 0xeb, 0x1b, 0x70, 0xa7, 0xe0, 0x78,
-		0xca, 0x00, 0x7f, 0x80, 0x9b, 0x07, 0x84, 0xba, 0xb1, 0x7f, 0x42, 0x52, 0x76, 0x75, 0xf4, 0xa3,
-		0xc1, 0x18, 0xf8, 0x58, 0x2b, 0xb5, 0x80, 0x7d, 0x32, 0xd7, 0xd7, 0xbf, 0x31, 0xc0, 0x1c, 0x46,
-		0x50, 0x69, 0xf3, 0x39, 0x94, 0xa4, 0x39, 0xa9, 0x69, 0x88, 0xa6, 0xb6, 0x51, 0xf8, 0xea, 0x40,
-		0x62, 0xd1, 0x29, 0x13, 0x7a, 0xf4, 0x1c, 0x56, 0xfb, 0xd6, 0xa7, 0x0c, 0xb3, 0x0e, 0x55, 0x29,
-		0xf3, 0xfe, 0x48, 0xdb, 0xbd, 0x12, 0xa4, 0x76, 0x95, 0xe5, 0xbe, 0xeb, 0x14, 0xee, 0x08, 0x7f,
-		0xa8, 0xd5, 0xb4, 0x03, 0xd2, 0xc4, 0x58, 0x6b, 0xb0, 0xa0, 0x8a, 0xa2, 0x0c, 0x12, 0xf5, 0x95,
-		0x77, 0xde, 0xcc, 0x74, 0xce, 0xfb, 0xf3, 0x0c, 0xdc, 0x2d, 0x92, 0xaa, 0x2c, 0xf4, 0x16, 0xee,
-		0xf4, 0xdf, 0x02, 0xd2, 0xf3, 0xa6, 0x3d, 0x3b, 0xb1, 0x9b, 0x35, 0x52, 0x64, 0x8a, 0xfb, 0x9c,
-		0x30, 0xec, 0x61, 0x86, 0xed, 0x5a, 0x76, 0xe0, 0xc8, 0x8b, 0xe6, 0x22, 0xd3, 0x07, 0x47, 0xad,
-		0xc8, 0x99, 0xcb, 0x89, 0xf4, 0x32, 0xe3, 0x71, 0x5e, 0x64, 0xfd, 0x3e, 0xdc, 0x7a, 0x4a, 0x52,
-		0x33, 0xd0, 0x27, 0x3d, 0xd9, 0x69, 0xc6, 0xd8, 0xbe, 0xfe, 0xcd, 0x1c, 0xdc, 0xd6, 0xf3, 0x29,
-		0xeb, 0x7d, 0x65, 0xc0, 0x9a, 0xe6, 0x2c, 0x6d, 0x1c, 0x29, 0xbb, 0xbd, 0x28, 0x1e, 0xa2, 0x46,
-		0x01, 0x5b, 0x07, 0x03, 0x67, 0x79, 0x8e, 0x23, 0x39, 0x4e, 0x5d, 0xf7, 0x86, 0x77, 0x84, 0x1a,
-		0x1a, 0x2f, 0x72, 0x35, 0x66, 0xae, 0xa4, 0xc6, 0xde, 0x80, 0x17, 0xfb, 0x6a, 0xe0, 0xe1, 0x9d,
-		0xda, 0xef, 0x79, 0x26, 0xea, 0xf5, 0xd6, 0x4c, 0x77, 0xcf, 0xf2, 0xcf, 0x8d, 0x23, 0xc6, 0xda,
-		0xa2, 0xf4, 0xce, 0x4c, 0x84, 0x5c, 0x76, 0x91, 0xb2, 0xdf, 0xb5, 0xec, 0xdd, 0xbf, 0x03, 0x54,
-		0x9e, 0x2b, 0x9e, 0xbd, 0x97, 0x0d, 0xf4, 0x47, 0x03, 0xae, 0x6b, 0x1e, 0x68, 0xd1, 0x67, 0x53,
-		0xbe, 0xe7, 0x8a, 0xe0, 0xac, 0xdd, 0xbf, 0xd4, 0x2b, 0x70, 0x56, 0x89, 0xac, 0x61, 0x26, 0x50,
-		0x42, 0x33, 0xda, 0x4f, 0xa0, 0x84, 0xf6, 0x01, 0xb4, 0x0b, 0x2b, 0x03, 0xf7, 0x56, 0xf4, 0xc9,
-		0xb4, 0xd7, 0xec, 0xda, 0xce, 0x14, 0x1c, 0x39, 0xb9, 0xb9, 0x73, 0x7f, 0x32, 0xed, 0x75, 0x66,
-		0x8c, 0x5c, 0xed, 0x79, 0x23, 0x58, 0xce, 0xcd, 0x6f, 0xc8, 0x2a, 0xc6, 0xd0, 0x8d, 0xa2, 0xb5,
-		0xed, 0x89, 0xe9, 0x95, 0xc4, 0xbf, 0x1a, 0xb0, 0x5e, 0x38, 0xa5, 0xa0, 0x47, 0xc5, 0x70, 0xe3,
-		0x26, 0xaf, 0xda, 0xe3, 0x4b, 0xf1, 0x2a, 0xb5, 0xfe, 0x62, 0xc0, 0x7b, 0xda, 0xb9, 0x01, 0x3d,
-		0x28, 0x86, 0x1d, 0x35, 0x47, 0xd5, 0x7e, 0x32, 0x35, 0x9f, 0x52, 0xa5, 0x07, 0xab, 0x83, 0x49,
-		0x8c, 0x76, 0xa6, 0x49, 0x78, 0x29, 0xff, 0x12, 0x35, 0x02, 0x7d, 0x6d, 0xc0, 0x9a, 0xbe, 0xff,
-		0xa2, 0x11, 0xc7, 0x19, 0x39, 0x27, 0xd4, 0x1e, 0x4e, 0xcf, 0xa8, 0xb4, 0xf9, 0x93, 0x01, 0x37,
-		0x74, 0xd5, 0x1e, 0xdd, 0x9f, 0xb6, 0x3b, 0x48, 0x4d, 0x1e, 0x5c, 0xae, 0xa9, 0x3c, 0x79, 0xfc,
-		0x9b, 0xcf, 0xcf, 0x7c, 0xd6, 0xec, 0x9c, 0x58, 0x6e, 0xd8, 0xde, 0xce, 0xfd, 0x61, 0xdf, 0x3a,
-		0x23, 0x81, 0xfc, 0x4f, 0x88, 0xec, 0x3f, 0x63, 0x3c, 0x4e, 0x7e, 0x77, 0x77, 0x4e, 0x16, 0xc4,
-		0xee, 0xa7, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x7d, 0xcb, 0x81, 0xba, 0x21, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdb, 0x72, 0xdb, 0xc6,
+		0xf9, 0x1f, 0xe8, 0x44, 0xf1, 0x23, 0x45, 0xc9, 0x6b, 0x47, 0x86, 0xe8, 0x93, 0xcc, 0xfc, 0x93,
+		0xe8, 0xdf, 0x49, 0x21, 0x4b, 0x89, 0x5d, 0xc7, 0x6e, 0xa7, 0x23, 0x4b, 0x3e, 0xb0, 0x53, 0xd7,
+		0x0e, 0xac, 0x3a, 0x33, 0x9d, 0x8c, 0x31, 0x2b, 0x60, 0x25, 0xa2, 0x22, 0x01, 0x18, 0x58, 0x50,
+		0x61, 0x2f, 0x7a, 0x91, 0x49, 0x3b, 0x9d, 0xc9, 0x6d, 0x3b, 0x7d, 0x80, 0xe6, 0x31, 0x7a, 0xdb,
+		0x3e, 0x42, 0x6f, 0x32, 0xbd, 0xec, 0x03, 0xf4, 0x0d, 0x3a, 0x7b, 0x00, 0x08, 0x90, 0x0b, 0x9e,
+		0xa4, 0x34, 0xbd, 0xd3, 0xee, 0x7e, 0xe7, 0xfd, 0x0e, 0xbf, 0x85, 0x08, 0xef, 0xc7, 0x47, 0x24,
+		0xdc, 0xb6, 0xb1, 0x43, 0x3c, 0x9b, 0x6c, 0x77, 0x30, 0xb5, 0x5b, 0xae, 0x77, 0xb2, 0xdd, 0xdd,
+		0xd9, 0x8e, 0x48, 0xd8, 0x75, 0x6d, 0x62, 0x04, 0xa1, 0x4f, 0x7d, 0xa4, 0x33, 0x3a, 0x43, 0xd2,
+		0x19, 0x09, 0x9d, 0xd1, 0xdd, 0xa9, 0xdf, 0x3c, 0xf1, 0xfd, 0x93, 0x36, 0xd9, 0xe6, 0x74, 0x47,
+		0xf1, 0xf1, 0xb6, 0x13, 0x87, 0x98, 0xba, 0xbe, 0x27, 0x38, 0xeb, 0xb7, 0x06, 0xcf, 0xa9, 0xdb,
+		0x21, 0x11, 0xc5, 0x9d, 0x40, 0x12, 0x0c, 0x09, 0x38, 0x0b, 0x71, 0x10, 0x90, 0x30, 0x92, 0xe7,
+		0x9b, 0x39, 0x13, 0x71, 0xe0, 0x32, 0xeb, 0x6c, 0xbf, 0xd3, 0xe9, 0xab, 0x50, 0x51, 0xbc, 0x8d,
+		0x49, 0xd8, 0x93, 0x04, 0x0d, 0x15, 0x01, 0xc5, 0xd1, 0x69, 0xdb, 0x8d, 0xa8, 0xa4, 0xd9, 0x52,
+		0xd1, 0xc8, 0x20, 0x58, 0x67, 0x7e, 0x78, 0x4a, 0x42, 0x49, 0xf9, 0x83, 0x71, 0x94, 0xc7, 0x6d,
+		0xff, 0x4c, 0xd2, 0xde, 0x56, 0xd1, 0xb6, 0xdc, 0x88, 0xfa, 0xa9, 0x71, 0xff, 0x97, 0x23, 0x89,
+		0x5a, 0x38, 0x24, 0xce, 0x30, 0xd5, 0x7b, 0x05, 0x54, 0x79, 0x2f, 0x1a, 0xff, 0xd6, 0xa0, 0xfe,
+		0xd2, 0x6f, 0xb7, 0x9f, 0xf8, 0xe1, 0x01, 0xb1, 0xdd, 0xc8, 0xf5, 0xbd, 0x43, 0x1c, 0x9d, 0x9a,
+		0xe4, 0x6d, 0x4c, 0x22, 0x8a, 0x9a, 0x50, 0x0a, 0xc5, 0x9f, 0xba, 0xb6, 0xa9, 0x6d, 0x55, 0x76,
+		0xb7, 0x8d, 0xdc, 0xc5, 0xe2, 0xc0, 0x35, 0xba, 0x3b, 0x46, 0xb1, 0x04, 0x33, 0xe1, 0x47, 0xd7,
+		0xa0, 0xec, 0xf8, 0x1d, 0xec, 0x7a, 0x96, 0xeb, 0xe8, 0x73, 0x9b, 0xda, 0x56, 0xd9, 0x5c, 0x16,
+		0x1b, 0x4d, 0x87, 0x1d, 0x06, 0x7e, 0xbb, 0x4d, 0x42, 0x76, 0x38, 0x2f, 0x0e, 0xc5, 0x46, 0xd3,
+		0x41, 0xef, 0x41, 0xed, 0xd8, 0x0f, 0xcf, 0x70, 0xe8, 0x10, 0xc7, 0x3a, 0x0e, 0xfd, 0x8e, 0xbe,
+		0xc0, 0x29, 0x56, 0xd2, 0xdd, 0x27, 0xa1, 0xdf, 0x41, 0x1f, 0xc0, 0xaa, 0x1b, 0xf9, 0x6d, 0x9e,
+		0x4b, 0xd6, 0x49, 0xe8, 0xc7, 0x81, 0xbe, 0xc8, 0xe9, 0x6a, 0xe9, 0xf6, 0x53, 0xb6, 0xdb, 0xf8,
+		0xaa, 0x0c, 0xd7, 0x94, 0x16, 0x47, 0x81, 0xef, 0x45, 0x04, 0xdd, 0x00, 0x60, 0x51, 0xb2, 0xa8,
+		0x7f, 0x4a, 0x3c, 0xee, 0x77, 0xd5, 0x2c, 0xb3, 0x9d, 0x43, 0xb6, 0x81, 0x7e, 0x09, 0x28, 0xb9,
+		0x34, 0x8b, 0x7c, 0x41, 0xec, 0x98, 0x49, 0xe6, 0x1e, 0x55, 0x76, 0xdf, 0x57, 0x86, 0xe7, 0x33,
+		0x49, 0xfe, 0x38, 0xa1, 0x36, 0x2f, 0x9d, 0x0d, 0x6e, 0xa1, 0x27, 0xb0, 0x92, 0x8a, 0xa5, 0xbd,
+		0x80, 0xf0, 0x30, 0x54, 0x76, 0x6f, 0x8f, 0x94, 0x78, 0xd8, 0x0b, 0x88, 0x59, 0x3d, 0xcb, 0xac,
+		0xd0, 0x6b, 0xd8, 0x08, 0x42, 0xd2, 0x75, 0xfd, 0x38, 0xb2, 0x22, 0x8a, 0x43, 0x4a, 0x1c, 0x8b,
+		0x74, 0x89, 0x47, 0x59, 0x68, 0x17, 0xb8, 0xcc, 0x6b, 0x86, 0x28, 0x21, 0x23, 0x29, 0x21, 0xa3,
+		0xe9, 0xd1, 0x7b, 0x1f, 0xbf, 0xc6, 0xed, 0x98, 0x98, 0xeb, 0x09, 0xf7, 0x2b, 0xc1, 0xfc, 0x98,
+		0xf1, 0x36, 0x1d, 0xb4, 0x05, 0x6b, 0x43, 0xe2, 0x58, 0x7c, 0xe7, 0xcd, 0x5a, 0x94, 0xa7, 0xd4,
+		0xa1, 0x84, 0x29, 0x25, 0x9d, 0x80, 0xea, 0x4b, 0x9b, 0xda, 0xd6, 0xa2, 0x99, 0x2c, 0x51, 0x03,
+		0x56, 0x3c, 0xf2, 0x05, 0xed, 0x0b, 0x28, 0x71, 0x01, 0x15, 0xb6, 0x99, 0x70, 0x7f, 0x08, 0xe8,
+		0x08, 0xdb, 0xa7, 0x6d, 0xff, 0xc4, 0xb2, 0xfd, 0xd8, 0xa3, 0x56, 0xcb, 0xf5, 0xa8, 0xbe, 0xcc,
+		0x09, 0xd7, 0xe4, 0xc9, 0x3e, 0x3b, 0x78, 0xe6, 0x7a, 0x14, 0xdd, 0x07, 0x3d, 0xa2, 0xae, 0x7d,
+		0xda, 0xeb, 0x5f, 0x85, 0x45, 0x3c, 0x7c, 0xd4, 0x26, 0x8e, 0x5e, 0xde, 0xd4, 0xb6, 0x96, 0xcd,
+		0x75, 0x71, 0x9e, 0x06, 0xfa, 0xb1, 0x38, 0x45, 0xf7, 0x61, 0x91, 0x97, 0xbc, 0x0e, 0x3c, 0x26,
+		0x8d, 0x91, 0x71, 0xfe, 0x94, 0x51, 0x9a, 0x82, 0x01, 0x99, 0xb0, 0xe2, 0xc8, 0xbc, 0xb1, 0x5c,
+		0xef, 0xd8, 0xd7, 0x2b, 0x5c, 0xc2, 0x0f, 0xf3, 0x12, 0x44, 0xc9, 0x31, 0x21, 0x87, 0x21, 0xf6,
+		0x22, 0x97, 0x78, 0x34, 0xc9, 0xb6, 0xa6, 0x77, 0xec, 0x9b, 0x55, 0x27, 0xb3, 0x42, 0x6f, 0xe0,
+		0xfa, 0x70, 0x52, 0x59, 0x3c, 0x0d, 0x59, 0xb5, 0xea, 0x55, 0xae, 0xe2, 0x86, 0xd2, 0x48, 0x96,
+		0xbc, 0x3f, 0x77, 0x23, 0x6a, 0x6e, 0x0c, 0x65, 0x55, 0x72, 0x84, 0x0c, 0xb8, 0x2c, 0x82, 0xce,
+		0x7a, 0x04, 0xb1, 0xba, 0x24, 0x64, 0xaa, 0xf5, 0x15, 0x7e, 0x3f, 0x97, 0xf8, 0xd1, 0x2b, 0x76,
+		0xf2, 0x5a, 0x1c, 0xa0, 0xdb, 0x50, 0x3d, 0x0a, 0xb1, 0x67, 0xb7, 0x64, 0x15, 0xd4, 0x78, 0x15,
+		0x54, 0xc4, 0x9e, 0xa8, 0x83, 0x3d, 0xa8, 0x45, 0x76, 0x8b, 0x38, 0x71, 0x9b, 0x38, 0x16, 0x6b,
+		0xd2, 0xfa, 0x2a, 0x37, 0xb2, 0x3e, 0x94, 0x5d, 0x87, 0x49, 0x07, 0x37, 0x57, 0x52, 0x0e, 0xb6,
+		0x87, 0x7e, 0x02, 0xd5, 0x24, 0xa7, 0xb8, 0x80, 0xb5, 0xb1, 0x02, 0x2a, 0x92, 0x9e, 0xb3, 0x7f,
+		0x0e, 0x25, 0x76, 0x23, 0x2e, 0x89, 0xf4, 0x4b, 0x9b, 0xf3, 0x5b, 0x95, 0xdd, 0x47, 0x46, 0xd1,
+		0xd8, 0x31, 0x46, 0x14, 0xbc, 0xf1, 0xa9, 0x10, 0xf2, 0xd8, 0xa3, 0x61, 0xcf, 0x4c, 0x44, 0xd6,
+		0xdf, 0x40, 0x35, 0x7b, 0x80, 0xd6, 0x60, 0xfe, 0x94, 0xf4, 0x78, 0x3f, 0x28, 0x9b, 0xec, 0x4f,
+		0x96, 0x42, 0x5d, 0x56, 0x33, 0xb2, 0xf8, 0x27, 0x4a, 0x21, 0xce, 0xf0, 0x60, 0xee, 0xbe, 0x96,
+		0x6d, 0xbd, 0x7b, 0x36, 0x75, 0xbb, 0x2e, 0xed, 0xcd, 0xde, 0x7a, 0x15, 0x12, 0xfe, 0x17, 0x5b,
+		0xef, 0xd7, 0xcb, 0x69, 0xeb, 0xcd, 0x5b, 0xfc, 0xbd, 0xb6, 0xde, 0x5b, 0x50, 0xc1, 0xd2, 0x9a,
+		0x7e, 0x10, 0x20, 0xd9, 0x6a, 0x3a, 0xac, 0x37, 0xa7, 0x04, 0xbc, 0x37, 0x2f, 0x8c, 0xe8, 0xcd,
+		0xa9, 0x63, 0xbc, 0x37, 0xe3, 0xcc, 0x0a, 0xed, 0xc2, 0xa2, 0xeb, 0x05, 0x31, 0xe5, 0xd1, 0xa9,
+		0xec, 0x5e, 0x57, 0xdf, 0x28, 0xee, 0xb5, 0x7d, 0xec, 0x98, 0x82, 0x54, 0x51, 0x66, 0x4b, 0xe7,
+		0x2d, 0xb3, 0xd2, 0x74, 0x65, 0x76, 0x08, 0x1b, 0x89, 0x3c, 0x8b, 0xfa, 0x96, 0xdd, 0xf6, 0x23,
+		0xc2, 0x05, 0xf9, 0xb1, 0x68, 0xcc, 0x95, 0xdd, 0x8d, 0x21, 0x59, 0x07, 0x12, 0xd5, 0x99, 0xeb,
+		0x09, 0xef, 0xa1, 0xbf, 0xcf, 0x38, 0x0f, 0x05, 0x23, 0xfa, 0x05, 0xac, 0x73, 0x25, 0xc3, 0x22,
+		0xcb, 0xe3, 0x44, 0x5e, 0xe6, 0x8c, 0x03, 0xf2, 0x9e, 0xc0, 0xa5, 0x16, 0xc1, 0x21, 0x3d, 0x22,
+		0x98, 0xa6, 0xa2, 0x60, 0x9c, 0xa8, 0xb5, 0x94, 0x27, 0x91, 0x93, 0x99, 0x5e, 0x95, 0xfc, 0xf4,
+		0x7a, 0x03, 0x37, 0xf3, 0x37, 0x61, 0xf9, 0xc7, 0x16, 0x6d, 0xb9, 0x91, 0x95, 0x30, 0x54, 0xc7,
+		0x06, 0xb6, 0x9e, 0xbb, 0x99, 0x17, 0xc7, 0x87, 0x2d, 0x37, 0xda, 0x93, 0xf2, 0x9b, 0x59, 0x0f,
+		0x1c, 0x42, 0xb1, 0xdb, 0x8e, 0x78, 0x87, 0x1e, 0x97, 0x29, 0x7d, 0x27, 0x0e, 0x04, 0xd7, 0x30,
+		0x98, 0xa8, 0xcd, 0x06, 0x26, 0x3e, 0x80, 0xd5, 0x54, 0x8e, 0xe8, 0x18, 0xbc, 0xc9, 0x97, 0xcd,
+		0x5a, 0xb2, 0x7d, 0xc0, 0x77, 0xd1, 0x47, 0xb0, 0xd4, 0x22, 0xd8, 0x21, 0xa1, 0xec, 0xe1, 0xd7,
+		0x94, 0x9a, 0x9e, 0x71, 0x12, 0x53, 0x92, 0x36, 0xfe, 0xba, 0x08, 0xeb, 0x7b, 0x8e, 0xa3, 0x02,
+		0x9e, 0xb9, 0x96, 0xa5, 0x0d, 0xb4, 0xac, 0xef, 0xa8, 0x0d, 0x3c, 0x80, 0x72, 0x7f, 0xe0, 0xce,
+		0x4f, 0x32, 0x70, 0x97, 0x69, 0x32, 0x5f, 0x6f, 0x41, 0x25, 0xad, 0x11, 0x89, 0xb3, 0xe6, 0x4d,
+		0x48, 0xb6, 0x9a, 0xce, 0x60, 0x11, 0xc9, 0xd4, 0x97, 0x69, 0xba, 0x38, 0x45, 0x11, 0x71, 0x58,
+		0x96, 0x24, 0xeb, 0x03, 0x58, 0x8a, 0xfc, 0x38, 0xb4, 0x45, 0x53, 0xa8, 0x0d, 0x8e, 0xa0, 0x0c,
+		0x06, 0xc1, 0xd1, 0xe9, 0x2b, 0x4e, 0x69, 0x4a, 0x0e, 0x45, 0x6f, 0x2f, 0xa9, 0x7a, 0x7b, 0x00,
+		0x6b, 0x01, 0x0e, 0xa9, 0xcb, 0x7b, 0xbb, 0xed, 0x7b, 0xc7, 0xee, 0x89, 0xbe, 0xcc, 0xa7, 0xed,
+		0xe3, 0xe2, 0x69, 0xab, 0xbe, 0x55, 0xe3, 0x65, 0x22, 0x68, 0x9f, 0xcb, 0x11, 0x03, 0x77, 0x35,
+		0xc8, 0xef, 0xa2, 0x3a, 0x2c, 0x07, 0xa1, 0xeb, 0x87, 0x2e, 0xed, 0xf1, 0x5e, 0xb0, 0x68, 0xa6,
+		0xeb, 0xe1, 0xc4, 0x86, 0x99, 0x12, 0xbb, 0xfe, 0x08, 0xae, 0xa8, 0x8c, 0x51, 0x0c, 0xf9, 0x2b,
+		0xd9, 0x21, 0x5f, 0xce, 0x0e, 0xf0, 0x0d, 0xb8, 0x3a, 0xe4, 0xa7, 0x98, 0x63, 0x8d, 0x7f, 0x2c,
+		0xf1, 0xcc, 0x56, 0xcd, 0xf5, 0xef, 0x23, 0xb3, 0x19, 0x76, 0xe7, 0x97, 0x6e, 0xf5, 0x55, 0x8b,
+		0x29, 0x57, 0x13, 0xfb, 0x07, 0x89, 0x01, 0xb9, 0x1a, 0x58, 0x38, 0x57, 0x0d, 0x2c, 0x4e, 0x57,
+		0x03, 0x4b, 0xe7, 0xaf, 0x81, 0xd2, 0x05, 0xd4, 0xc0, 0xb2, 0xaa, 0x06, 0x3c, 0xd0, 0x71, 0xe6,
+		0x2a, 0x0f, 0xdc, 0x28, 0x60, 0xc9, 0xce, 0x90, 0xbb, 0x9c, 0x56, 0xbb, 0x23, 0x6a, 0xa1, 0x80,
+		0xd3, 0x2c, 0x94, 0xa9, 0xac, 0x39, 0x98, 0xa0, 0xe6, 0x14, 0xf9, 0x36, 0x43, 0xcd, 0x55, 0x86,
+		0x6b, 0x2e, 0x8f, 0x7e, 0xaa, 0x33, 0xa1, 0x9f, 0x0b, 0xa9, 0xb9, 0x6f, 0xe7, 0x41, 0x2f, 0x0a,
+		0x28, 0xfa, 0x19, 0xac, 0xf6, 0x07, 0x34, 0x7f, 0xd3, 0x48, 0xe8, 0xac, 0x36, 0xf5, 0x99, 0xf8,
+		0x60, 0xc2, 0x1f, 0x9e, 0x66, 0x1f, 0x64, 0xf1, 0xf5, 0x10, 0x66, 0x9a, 0x9b, 0x0e, 0x33, 0x65,
+		0x50, 0xc4, 0xfc, 0xb4, 0x28, 0x62, 0xe1, 0xe2, 0x51, 0xc4, 0xe2, 0xc5, 0xa0, 0x88, 0xa5, 0x0b,
+		0x43, 0x11, 0x25, 0x15, 0x8a, 0x90, 0x1d, 0x55, 0xf5, 0x32, 0x68, 0x7c, 0xab, 0xc1, 0x15, 0xfe,
+		0x84, 0x4a, 0xf4, 0x24, 0xfd, 0x74, 0x7f, 0xf0, 0x9d, 0xf4, 0xff, 0x4a, 0xf3, 0x54, 0xbc, 0x13,
+		0xbe, 0x90, 0xce, 0x83, 0x0b, 0x26, 0x7b, 0x40, 0x35, 0xfe, 0xa2, 0xc1, 0x3b, 0x03, 0x16, 0xca,
+		0x17, 0xd1, 0x4f, 0xa1, 0xca, 0xbf, 0x3a, 0x58, 0x21, 0x89, 0xe2, 0x76, 0xe2, 0xe3, 0xe8, 0x9b,
+		0xac, 0x70, 0x0e, 0x93, 0x33, 0xa0, 0x26, 0xd4, 0x12, 0x01, 0xbf, 0x26, 0x36, 0x25, 0xce, 0xc8,
+		0xd7, 0xaa, 0x78, 0xa5, 0x4a, 0x4a, 0x73, 0xe5, 0x6d, 0x76, 0xd9, 0xf8, 0x97, 0x06, 0x9b, 0xc2,
+		0x30, 0x87, 0xd3, 0x31, 0x7f, 0xf7, 0xfd, 0x4e, 0xd0, 0x26, 0x8c, 0x58, 0x86, 0xf2, 0xc5, 0xe0,
+		0x7d, 0xdc, 0x55, 0x2a, 0x1a, 0x27, 0xe7, 0xbf, 0x70, 0x37, 0x57, 0xa1, 0xc4, 0x79, 0x25, 0x5e,
+		0x2b, 0x9b, 0x4b, 0x6c, 0xd9, 0x74, 0x1a, 0xef, 0xc2, 0xed, 0x11, 0xe6, 0xc9, 0x84, 0xfc, 0xa7,
+		0x06, 0xd7, 0xf7, 0xb1, 0x67, 0x93, 0xf6, 0x8b, 0x98, 0x46, 0x14, 0x7b, 0x8e, 0xeb, 0x9d, 0xb0,
+		0xb7, 0xed, 0x44, 0x83, 0x3e, 0xf7, 0xea, 0x9e, 0x1b, 0x78, 0x75, 0x3f, 0x85, 0x5a, 0xea, 0x54,
+		0xff, 0x5b, 0x60, 0xad, 0xa0, 0xf0, 0x12, 0xcf, 0x44, 0xe1, 0xd1, 0xcc, 0xea, 0x3c, 0xd3, 0xbc,
+		0x71, 0x0b, 0x6e, 0x14, 0xb8, 0x27, 0x03, 0xf0, 0x5b, 0xb8, 0x7a, 0x40, 0x22, 0x3b, 0x74, 0x8f,
+		0x48, 0xca, 0x2e, 0x5d, 0x7f, 0x32, 0x98, 0x03, 0x1f, 0x2a, 0xb5, 0x16, 0xb0, 0x4f, 0x76, 0xf5,
+		0x8d, 0x6f, 0x34, 0xd0, 0x87, 0x25, 0xc8, 0xb2, 0xf9, 0x04, 0x4a, 0x22, 0x9c, 0x91, 0xae, 0xf1,
+		0xc1, 0x79, 0xab, 0xf0, 0xeb, 0x09, 0x09, 0xf9, 0x34, 0x4e, 0xe8, 0xd1, 0x73, 0x58, 0xeb, 0x47,
+		0x3f, 0xa2, 0x98, 0xc6, 0x91, 0x2c, 0x99, 0x77, 0x47, 0xc6, 0xee, 0x15, 0x27, 0x35, 0x6b, 0x34,
+		0xb7, 0x6e, 0x44, 0x70, 0x83, 0xdf, 0x87, 0xdc, 0x4d, 0x27, 0x60, 0x94, 0x04, 0x6b, 0x1d, 0x96,
+		0x64, 0x53, 0x14, 0x49, 0x22, 0x57, 0xf9, 0xcb, 0x9b, 0x9b, 0xee, 0xf2, 0x7e, 0x3f, 0x07, 0x37,
+		0x8b, 0xb4, 0xca, 0x08, 0xbd, 0x85, 0x1b, 0xfd, 0xa9, 0x9e, 0xfa, 0x9b, 0xe2, 0x82, 0x24, 0x6e,
+		0xc6, 0x48, 0x95, 0xa9, 0xdc, 0xe7, 0x84, 0x62, 0x07, 0x53, 0x6c, 0xd6, 0xb3, 0xa0, 0x26, 0xaf,
+		0x9a, 0xa9, 0x4c, 0x3f, 0x9c, 0x2a, 0x55, 0xce, 0xcd, 0xa6, 0xd2, 0xc9, 0x40, 0xf0, 0xbc, 0xca,
+		0xc6, 0x5d, 0xb8, 0xf6, 0x94, 0xa4, 0x61, 0x88, 0x1e, 0xf5, 0xc4, 0xa4, 0x19, 0x13, 0xfb, 0xc6,
+		0x9f, 0x34, 0xb8, 0x3a, 0x24, 0x4d, 0x42, 0x25, 0x1d, 0x4a, 0xc9, 0xe7, 0x53, 0x8d, 0x43, 0xdc,
+		0x64, 0x89, 0x0c, 0xb8, 0xec, 0xc5, 0x1d, 0x2b, 0x24, 0xd8, 0xc9, 0x7b, 0xc5, 0x3f, 0xb2, 0x7a,
+		0x71, 0xc7, 0x24, 0xd8, 0xc9, 0xc4, 0xe3, 0x0e, 0x5c, 0x61, 0xf4, 0x67, 0xa1, 0x4b, 0x49, 0x96,
+		0x41, 0x20, 0x06, 0xe4, 0xc5, 0x9d, 0xcf, 0xd8, 0x51, 0xc6, 0x9d, 0xbf, 0x69, 0x70, 0x3b, 0xe3,
+		0xcf, 0x80, 0x69, 0x13, 0x75, 0x9e, 0x73, 0xa4, 0xd5, 0x85, 0x35, 0xa6, 0xc6, 0x97, 0x1a, 0x34,
+		0x46, 0xf9, 0x21, 0x73, 0xf4, 0x73, 0x05, 0x0e, 0x16, 0x0d, 0x65, 0xa7, 0x18, 0x07, 0x17, 0x09,
+		0x1d, 0xc4, 0xbc, 0x8d, 0x6f, 0x16, 0xe0, 0xba, 0x3a, 0x39, 0xa4, 0xfa, 0xaf, 0x34, 0x58, 0x57,
+		0x24, 0x6c, 0x07, 0x07, 0xb2, 0x38, 0x5e, 0x14, 0x5b, 0x31, 0x4a, 0xb0, 0x71, 0x30, 0x90, 0xb0,
+		0xcf, 0x71, 0x20, 0x70, 0xf9, 0x65, 0x67, 0xf8, 0x84, 0x9b, 0xa1, 0x28, 0x55, 0x66, 0xc6, 0xdc,
+		0xb9, 0xcc, 0xd8, 0x1b, 0x28, 0xd5, 0xbe, 0x19, 0x78, 0xf8, 0xa4, 0xfe, 0x1b, 0xd6, 0x6e, 0xd5,
+		0x76, 0x2b, 0x20, 0xfc, 0xb3, 0xfc, 0xb7, 0xf1, 0x11, 0xef, 0xa3, 0xa2, 0x1e, 0x9e, 0x81, 0xfd,
+		0x4c, 0x77, 0x91, 0xb1, 0xdf, 0xb5, 0xee, 0xdd, 0xbf, 0x57, 0xa0, 0xf2, 0x5c, 0xf2, 0xec, 0xbd,
+		0x6c, 0xa2, 0x2f, 0x35, 0xb8, 0xac, 0xf8, 0x6f, 0x02, 0xfa, 0x78, 0xca, 0x7f, 0x3e, 0xf0, 0x5a,
+		0xad, 0xdf, 0x9d, 0xe9, 0x5f, 0x16, 0x59, 0x23, 0xb2, 0x81, 0x99, 0xc0, 0x08, 0xc5, 0x1b, 0x71,
+		0x02, 0x23, 0x94, 0x5f, 0xeb, 0xbb, 0xb0, 0x3a, 0xf0, 0x01, 0x04, 0xdd, 0x99, 0xf6, 0x9b, 0x50,
+		0x7d, 0x67, 0x0a, 0x8e, 0x9c, 0xde, 0x9c, 0xdf, 0x77, 0xa6, 0x7d, 0x17, 0x8f, 0xd1, 0xab, 0xf4,
+		0x37, 0x80, 0x95, 0x1c, 0x48, 0x47, 0x46, 0xb1, 0x0c, 0xd5, 0x7b, 0xa3, 0xbe, 0x3d, 0x31, 0xbd,
+		0xd4, 0xf8, 0x47, 0x0d, 0x36, 0x0a, 0xa1, 0x28, 0x7a, 0x50, 0x2c, 0x6e, 0x1c, 0xbc, 0xae, 0x3f,
+		0x9c, 0x89, 0x57, 0x9a, 0xf5, 0x07, 0x0d, 0xde, 0x51, 0x82, 0x43, 0x74, 0xaf, 0x58, 0xec, 0x28,
+		0xb0, 0x5c, 0xff, 0xd1, 0xd4, 0x7c, 0xd2, 0x94, 0x1e, 0xac, 0x0d, 0x16, 0x31, 0xda, 0x99, 0xa6,
+		0xe0, 0x85, 0xfe, 0x19, 0x7a, 0x04, 0xfa, 0x5a, 0x83, 0x75, 0x35, 0xc8, 0x42, 0x23, 0xdc, 0x19,
+		0x09, 0x06, 0xeb, 0xf7, 0xa7, 0x67, 0x94, 0xd6, 0xfc, 0x4e, 0x83, 0x2b, 0xaa, 0x6e, 0x8f, 0xee,
+		0x4e, 0x3b, 0x1d, 0x84, 0x25, 0xf7, 0x66, 0x1b, 0x2a, 0xe8, 0xcf, 0x1a, 0xd4, 0x8b, 0x47, 0x3b,
+		0x7a, 0x38, 0x91, 0x58, 0x35, 0xb0, 0xa9, 0xff, 0x78, 0x36, 0x66, 0x61, 0xd9, 0xa3, 0x87, 0xbf,
+		0xfa, 0xe4, 0xc4, 0xa5, 0xad, 0xf8, 0xc8, 0xb0, 0xfd, 0xce, 0x76, 0xee, 0xf7, 0x31, 0xc6, 0x09,
+		0xf1, 0xc4, 0x0f, 0x8a, 0xb2, 0xbf, 0x69, 0x7a, 0x98, 0xfc, 0xdd, 0xdd, 0x39, 0x5a, 0xe2, 0xa7,
+		0x1f, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0x46, 0xb9, 0xbd, 0x2f, 0x01, 0x25, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -343,6 +343,16 @@ func (c *clientImpl) MaintainCorruptWorkflow(
 	return c.client.MaintainCorruptWorkflow(ctx, request, opts...)
 }
 
+func (c *clientImpl) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.RestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return c.client.RestoreArchivedWorkflow(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListDynamicConfig(
 	ctx context.Context,
 	request *types.ListDynamicConfigRequest,
@@ -353,6 +363,26 @@ func (c *clientImpl) ListDynamicConfig(
 	return c.client.ListDynamicConfig(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *types.ListDynamicConfigHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDynamicConfigHistoryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDynamicConfigHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) RollbackDynamicConfig(
+	ctx context.Context,
+	request *types.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) error {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RollbackDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetGlobalIsolationGroups(
 	ctx context.Context,
 	request *types.GetGlobalIsolationGroupsRequest,
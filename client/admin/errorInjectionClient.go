@@ -747,6 +747,31 @@ func (c *errorInjectionClient) MaintainCorruptWorkflow(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.RestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.RestoreArchivedWorkflow(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationRestoreArchivedWorkflow,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
 func (c *errorInjectionClient) ListDynamicConfig(
 	ctx context.Context,
 	request *types.ListDynamicConfigRequest,
@@ -773,6 +798,57 @@ func (c *errorInjectionClient) ListDynamicConfig(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *types.ListDynamicConfigHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDynamicConfigHistoryResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.ListDynamicConfigHistoryResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.ListDynamicConfigHistory(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationListDynamicConfigHistory,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) RollbackDynamicConfig(
+	ctx context.Context,
+	request *types.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.RollbackDynamicConfig(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationRollbackDynamicConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
 func (c *errorInjectionClient) GetGlobalIsolationGroups(
 	ctx context.Context,
 	request *types.GetGlobalIsolationGroupsRequest,
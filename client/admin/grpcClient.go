@@ -173,11 +173,26 @@ func (g grpcClient) MaintainCorruptWorkflow(ctx context.Context, request *types.
 	return proto.ToAdminMaintainWorkflowResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) RestoreArchivedWorkflow(ctx context.Context, request *types.RestoreArchivedWorkflowRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RestoreArchivedWorkflow(ctx, proto.FromAdminRestoreArchivedWorkflowRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) ListDynamicConfig(ctx context.Context, request *types.ListDynamicConfigRequest, opts ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
 	response, err := g.c.ListDynamicConfig(ctx, proto.FromListDynamicConfigRequest(request), opts...)
 	return proto.ToListDynamicConfigResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) ListDynamicConfigHistory(ctx context.Context, request *types.ListDynamicConfigHistoryRequest, opts ...yarpc.CallOption) (*types.ListDynamicConfigHistoryResponse, error) {
+	response, err := g.c.ListDynamicConfigHistory(ctx, proto.FromListDynamicConfigHistoryRequest(request), opts...)
+	return proto.ToListDynamicConfigHistoryResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) RollbackDynamicConfig(ctx context.Context, request *types.RollbackDynamicConfigRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RollbackDynamicConfig(ctx, proto.FromRollbackDynamicConfigRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) GetGlobalIsolationGroups(ctx context.Context, request *types.GetGlobalIsolationGroupsRequest, opts ...yarpc.CallOption) (*types.GetGlobalIsolationGroupsResponse, error) {
 	response, err := g.c.GetGlobalIsolationGroups(ctx, proto.FromGetGlobalIsolationGroupsRequest(request), opts...)
 	return proto.ToGetGlobalIsolationGroupsResponse(response), proto.ToError(err)
@@ -417,6 +417,26 @@ func (mr *MockClientMockRecorder) ListDynamicConfig(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockClient)(nil).ListDynamicConfig), varargs...)
 }
 
+// ListDynamicConfigHistory mocks base method.
+func (m *MockClient) ListDynamicConfigHistory(arg0 context.Context, arg1 *types.ListDynamicConfigHistoryRequest, arg2 ...yarpc.CallOption) (*types.ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", varargs...)
+	ret0, _ := ret[0].(*types.ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockClientMockRecorder) ListDynamicConfigHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockClient)(nil).ListDynamicConfigHistory), varargs...)
+}
+
 // MaintainCorruptWorkflow mocks base method.
 func (m *MockClient) MaintainCorruptWorkflow(arg0 context.Context, arg1 *types.AdminMaintainWorkflowRequest, arg2 ...yarpc.CallOption) (*types.AdminMaintainWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -611,6 +631,25 @@ func (mr *MockClientMockRecorder) RespondCrossClusterTasksCompleted(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondCrossClusterTasksCompleted", reflect.TypeOf((*MockClient)(nil).RespondCrossClusterTasksCompleted), varargs...)
 }
 
+// RestoreArchivedWorkflow mocks base method.
+func (m *MockClient) RestoreArchivedWorkflow(arg0 context.Context, arg1 *types.RestoreArchivedWorkflowRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflow", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreArchivedWorkflow indicates an expected call of RestoreArchivedWorkflow.
+func (mr *MockClientMockRecorder) RestoreArchivedWorkflow(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflow", reflect.TypeOf((*MockClient)(nil).RestoreArchivedWorkflow), varargs...)
+}
+
 // RestoreDynamicConfig mocks base method.
 func (m *MockClient) RestoreDynamicConfig(arg0 context.Context, arg1 *types.RestoreDynamicConfigRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -630,6 +669,25 @@ func (mr *MockClientMockRecorder) RestoreDynamicConfig(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDynamicConfig", reflect.TypeOf((*MockClient)(nil).RestoreDynamicConfig), varargs...)
 }
 
+// RollbackDynamicConfig mocks base method.
+func (m *MockClient) RollbackDynamicConfig(arg0 context.Context, arg1 *types.RollbackDynamicConfigRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackDynamicConfig", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackDynamicConfig indicates an expected call of RollbackDynamicConfig.
+func (mr *MockClientMockRecorder) RollbackDynamicConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDynamicConfig", reflect.TypeOf((*MockClient)(nil).RollbackDynamicConfig), varargs...)
+}
+
 // UpdateDomainIsolationGroups mocks base method.
 func (m *MockClient) UpdateDomainIsolationGroups(ctx context.Context, request *types.UpdateDomainIsolationGroupsRequest, opts ...yarpc.CallOption) (*types.UpdateDomainIsolationGroupsResponse, error) {
 	m.ctrl.T.Helper()
@@ -513,6 +513,23 @@ func (c *metricClient) MaintainCorruptWorkflow(
 	return resp, err
 }
 
+func (c *metricClient) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.RestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.AdminClientRestoreArchivedWorkflowScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRestoreArchivedWorkflowScope, metrics.CadenceClientLatency)
+	err := c.client.RestoreArchivedWorkflow(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRestoreArchivedWorkflowScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) ListDynamicConfig(
 	ctx context.Context,
 	request *types.ListDynamicConfigRequest,
@@ -530,6 +547,40 @@ func (c *metricClient) ListDynamicConfig(
 	return resp, err
 }
 
+func (c *metricClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *types.ListDynamicConfigHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDynamicConfigHistoryResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigHistoryScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigHistoryScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListDynamicConfigHistory(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigHistoryScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RollbackDynamicConfig(
+	ctx context.Context,
+	request *types.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.AdminClientRollbackDynamicConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRollbackDynamicConfigScope, metrics.CadenceClientLatency)
+	err := c.client.RollbackDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRollbackDynamicConfigScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) GetGlobalIsolationGroups(ctx context.Context, request *types.GetGlobalIsolationGroupsRequest, opts ...yarpc.CallOption) (*types.GetGlobalIsolationGroupsResponse, error) {
 	c.metricsClient.IncCounter(metrics.AdminClientGetGlobalIsolationGroupsScope, metrics.CadenceClientRequests)
 	sw := c.metricsClient.StartTimer(metrics.AdminClientGetGlobalIsolationGroupsScope, metrics.CadenceClientLatency)
@@ -429,6 +429,17 @@ func (c *retryableClient) MaintainCorruptWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.RestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+	op := func() error {
+		return c.client.RestoreArchivedWorkflow(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) ListDynamicConfig(
 	ctx context.Context,
 	request *types.ListDynamicConfigRequest,
@@ -444,6 +455,32 @@ func (c *retryableClient) ListDynamicConfig(
 	return resp, err
 }
 
+func (c *retryableClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *types.ListDynamicConfigHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDynamicConfigHistoryResponse, error) {
+	var resp *types.ListDynamicConfigHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDynamicConfigHistory(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) RollbackDynamicConfig(
+	ctx context.Context,
+	request *types.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) error {
+	op := func() error {
+		return c.client.RollbackDynamicConfig(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) GetGlobalIsolationGroups(
 	ctx context.Context,
 	request *types.GetGlobalIsolationGroupsRequest,
@@ -173,11 +173,26 @@ func (t thriftClient) MaintainCorruptWorkflow(ctx context.Context, request *type
 	return thrift.ToAdminMaintainWorkflowResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) RestoreArchivedWorkflow(ctx context.Context, request *types.RestoreArchivedWorkflowRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RestoreArchivedWorkflow(ctx, thrift.FromRestoreArchivedWorkflowRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) ListDynamicConfig(ctx context.Context, request *types.ListDynamicConfigRequest, opts ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
 	response, err := t.c.ListDynamicConfig(ctx, thrift.FromListDynamicConfigRequest(request), opts...)
 	return thrift.ToListDynamicConfigResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) ListDynamicConfigHistory(ctx context.Context, request *types.ListDynamicConfigHistoryRequest, opts ...yarpc.CallOption) (*types.ListDynamicConfigHistoryResponse, error) {
+	response, err := t.c.ListDynamicConfigHistory(ctx, thrift.FromListDynamicConfigHistoryRequest(request), opts...)
+	return thrift.ToListDynamicConfigHistoryResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) RollbackDynamicConfig(ctx context.Context, request *types.RollbackDynamicConfigRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RollbackDynamicConfig(ctx, thrift.FromRollbackDynamicConfigRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) GetGlobalIsolationGroups(ctx context.Context, request *types.GetGlobalIsolationGroupsRequest, opts ...yarpc.CallOption) (*types.GetGlobalIsolationGroupsResponse, error) {
 	res, err := t.c.GetGlobalIsolationGroups(ctx, thrift.FromGetGlobalIsolationGroupsRequest(request), opts...)
 	return thrift.ToGetGlobalIsolationGroupsResponse(res), thrift.ToError(err)
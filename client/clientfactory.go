@@ -154,7 +154,11 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 		longPollTimeout,
 		rawClient,
 		peerResolver,
-		matching.NewLoadBalancer(domainIDToName, cf.dynConfig),
+		matching.NewLoadBalancer(
+			domainIDToName,
+			cf.dynConfig,
+			matching.NewPartitionConfigProvider(rawClient, peerResolver, domainIDToName, cf.dynConfig, cf.logger),
+		),
 	)
 	if errorRate := cf.dynConfig.GetFloat64Property(dynamicconfig.MatchingErrorInjectionRate)(); errorRate != 0 {
 		client = matching.NewErrorInjectionClient(client, errorRate, cf.logger)
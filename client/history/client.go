@@ -1022,6 +1022,24 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *clientImpl) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.HistoryRestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.RestoreArchivedWorkflow(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
@@ -1159,6 +1177,63 @@ func (c *clientImpl) GetFailoverInfo(
 	return c.client.GetFailoverInfo(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
 }
 
+func (c *clientImpl) RatelimitUpdate(
+	ctx context.Context,
+	request *types.RatelimitUpdateRequest,
+	opts ...yarpc.CallOption,
+) (*types.RatelimitUpdateResponse, error) {
+	requestsByPeer := make(map[string]*types.RatelimitUpdateRequest)
+	for key, usage := range request.GetUsage() {
+		peer, err := c.peerResolver.FromRatelimitKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestsByPeer[peer]; !ok {
+			requestsByPeer[peer] = &types.RatelimitUpdateRequest{
+				Caller: request.Caller,
+				Usage:  make(map[string]float64),
+			}
+		}
+		requestsByPeer[peer].Usage[key] = usage
+	}
+
+	g := &errgroup.Group{}
+	var responseMutex sync.Mutex
+	response := &types.RatelimitUpdateResponse{
+		Weights: make(map[string]float64, len(request.GetUsage())),
+	}
+
+	for peer, req := range requestsByPeer {
+		peer, req := peer, req
+		g.Go(func() (e error) {
+			defer func() { log.CapturePanic(recover(), c.logger, &e) }()
+
+			ctx, cancel := c.createContext(ctx)
+			defer cancel()
+			resp, err := c.client.RatelimitUpdate(ctx, req, append(opts, yarpc.WithShardKey(peer))...)
+			if err != nil {
+				// keys of an unavailable aggregator are left out of the response,
+				// callers keep their last known weights for them until those expire
+				c.logger.Warn("Failed to update global ratelimits", tag.Error(err), tag.Address(peer))
+				return nil
+			}
+
+			responseMutex.Lock()
+			defer responseMutex.Unlock()
+			for key, weight := range resp.GetWeights() {
+				response.Weights[key] = weight
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
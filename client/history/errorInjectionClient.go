@@ -1052,6 +1052,31 @@ func (c *errorInjectionClient) RefreshWorkflowTasks(
 	return clientErr
 }
 
+func (c *errorInjectionClient) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.HistoryRestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.RestoreArchivedWorkflow(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.HistoryClientOperationRestoreArchivedWorkflow,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
 func (c *errorInjectionClient) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
@@ -1154,3 +1179,29 @@ func (c *errorInjectionClient) GetFailoverInfo(
 	}
 	return resp, clientErr
 }
+
+func (c *errorInjectionClient) RatelimitUpdate(
+	ctx context.Context,
+	request *types.RatelimitUpdateRequest,
+	opts ...yarpc.CallOption,
+) (*types.RatelimitUpdateResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.RatelimitUpdateResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.RatelimitUpdate(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.HistoryClientOperationRatelimitUpdate,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
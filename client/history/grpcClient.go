@@ -148,6 +148,11 @@ func (g grpcClient) RefreshWorkflowTasks(ctx context.Context, request *types.His
 	return proto.ToError(err)
 }
 
+func (g grpcClient) RestoreArchivedWorkflow(ctx context.Context, request *types.HistoryRestoreArchivedWorkflowRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RestoreArchivedWorkflow(ctx, proto.FromHistoryRestoreArchivedWorkflowRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest, opts ...yarpc.CallOption) error {
 	_, err := g.c.RemoveSignalMutableState(ctx, proto.FromHistoryRemoveSignalMutableStateRequest(request), opts...)
 	return proto.ToError(err)
@@ -252,3 +257,8 @@ func (g grpcClient) GetFailoverInfo(ctx context.Context, request *types.GetFailo
 	response, err := g.c.GetFailoverInfo(ctx, proto.FromHistoryGetFailoverInfoRequest(request), opts...)
 	return proto.ToHistoryGetFailoverInfoResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) RatelimitUpdate(ctx context.Context, request *types.RatelimitUpdateRequest, opts ...yarpc.CallOption) (*types.RatelimitUpdateResponse, error) {
+	response, err := g.c.RatelimitUpdate(ctx, proto.FromHistoryRatelimitUpdateRequest(request), opts...)
+	return proto.ToHistoryRatelimitUpdateResponse(response), proto.ToError(err)
+}
@@ -376,6 +376,26 @@ func (mr *MockClientMockRecorder) QueryWorkflow(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockClient)(nil).QueryWorkflow), varargs...)
 }
 
+// RatelimitUpdate mocks base method.
+func (m *MockClient) RatelimitUpdate(arg0 context.Context, arg1 *types.RatelimitUpdateRequest, arg2 ...yarpc.CallOption) (*types.RatelimitUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RatelimitUpdate", varargs...)
+	ret0, _ := ret[0].(*types.RatelimitUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RatelimitUpdate indicates an expected call of RatelimitUpdate.
+func (mr *MockClientMockRecorder) RatelimitUpdate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RatelimitUpdate", reflect.TypeOf((*MockClient)(nil).RatelimitUpdate), varargs...)
+}
+
 // ReadDLQMessages mocks base method.
 func (m *MockClient) ReadDLQMessages(arg0 context.Context, arg1 *types.ReadDLQMessagesRequest, arg2 ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -764,6 +784,25 @@ func (mr *MockClientMockRecorder) RespondDecisionTaskFailed(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDecisionTaskFailed", reflect.TypeOf((*MockClient)(nil).RespondDecisionTaskFailed), varargs...)
 }
 
+// RestoreArchivedWorkflow mocks base method.
+func (m *MockClient) RestoreArchivedWorkflow(arg0 context.Context, arg1 *types.HistoryRestoreArchivedWorkflowRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflow", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreArchivedWorkflow indicates an expected call of RestoreArchivedWorkflow.
+func (mr *MockClientMockRecorder) RestoreArchivedWorkflow(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflow", reflect.TypeOf((*MockClient)(nil).RestoreArchivedWorkflow), varargs...)
+}
+
 // ScheduleDecisionTask mocks base method.
 func (m *MockClient) ScheduleDecisionTask(arg0 context.Context, arg1 *types.ScheduleDecisionTaskRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -740,6 +740,23 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *metricClient) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.HistoryRestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientRestoreArchivedWorkflowScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRestoreArchivedWorkflowScope, metrics.CadenceClientLatency)
+	err := c.client.RestoreArchivedWorkflow(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRestoreArchivedWorkflowScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
@@ -810,3 +827,21 @@ func (c *metricClient) GetFailoverInfo(
 
 	return resp, err
 }
+
+func (c *metricClient) RatelimitUpdate(
+	ctx context.Context,
+	request *types.RatelimitUpdateRequest,
+	opts ...yarpc.CallOption,
+) (*types.RatelimitUpdateResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientRatelimitUpdateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRatelimitUpdateScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RatelimitUpdate(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRatelimitUpdateScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
@@ -73,6 +73,18 @@ func (pr PeerResolver) FromShardID(shardID int) (string, error) {
 	return peer, common.ToServiceTransientError(err)
 }
 
+// FromRatelimitKey resolves the history peer aggregating the usage of a given global rate limit key.
+// The key is looked up on the history hash ring directly, so every caller elects the same aggregator
+// and the ownership of a key only moves when the history ring changes.
+func (pr PeerResolver) FromRatelimitKey(key string) (string, error) {
+	host, err := pr.resolver.Lookup(service.History, key)
+	if err != nil {
+		return "", common.ToServiceTransientError(err)
+	}
+	peer, err := host.GetNamedAddress(pr.namedPort)
+	return peer, common.ToServiceTransientError(err)
+}
+
 // FromHostAddress resolves the final history peer responsible for the given host address.
 // The address is formed by adding port for specified transport
 func (pr PeerResolver) FromHostAddress(hostAddress string) (string, error) {
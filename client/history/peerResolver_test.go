@@ -56,6 +56,13 @@ func TestPeerResolver(t *testing.T) {
 			membership.PortMap{membership.PortTchannel: 1235}),
 		nil)
 
+	serviceResolver.EXPECT().Lookup(service.History, "ratelimitKey").Return(
+		membership.NewDetailedHostInfo(
+			"ratelimitHost:123",
+			"ratelimit_123",
+			membership.PortMap{membership.PortTchannel: 1236}),
+		nil)
+
 	serviceResolver.EXPECT().LookupByAddress(service.History, "invalid address").Return(
 		membership.HostInfo{},
 		errors.New("host not found"),
@@ -80,6 +87,10 @@ func TestPeerResolver(t *testing.T) {
 	_, err = r.FromShardID(11)
 	assert.Error(t, err)
 
+	peer, err = r.FromRatelimitKey("ratelimitKey")
+	assert.NoError(t, err)
+	assert.Equal(t, "ratelimitHost:1236", peer)
+
 	_, err = r.FromHostAddress("invalid address")
 	assert.Error(t, err)
 
@@ -641,6 +641,19 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *retryableClient) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.HistoryRestoreArchivedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RestoreArchivedWorkflow(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
@@ -701,3 +714,19 @@ func (c *retryableClient) GetFailoverInfo(
 	err := c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *retryableClient) RatelimitUpdate(
+	ctx context.Context,
+	request *types.RatelimitUpdateRequest,
+	opts ...yarpc.CallOption,
+) (*types.RatelimitUpdateResponse, error) {
+	var resp *types.RatelimitUpdateResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RatelimitUpdate(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
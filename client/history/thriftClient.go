@@ -148,6 +148,11 @@ func (t thriftClient) RefreshWorkflowTasks(ctx context.Context, request *types.H
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) RestoreArchivedWorkflow(ctx context.Context, request *types.HistoryRestoreArchivedWorkflowRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RestoreArchivedWorkflow(ctx, thrift.FromHistoryRestoreArchivedWorkflowRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest, opts ...yarpc.CallOption) error {
 	err := t.c.RemoveSignalMutableState(ctx, thrift.FromRemoveSignalMutableStateRequest(request), opts...)
 	return thrift.ToError(err)
@@ -252,3 +257,8 @@ func (t thriftClient) GetFailoverInfo(ctx context.Context, request *types.GetFai
 	response, err := t.c.GetFailoverInfo(ctx, thrift.FromGetFailoverInfoRequest(request), opts...)
 	return thrift.ToGetFailoverInfoResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) RatelimitUpdate(ctx context.Context, request *types.RatelimitUpdateRequest, opts ...yarpc.CallOption) (*types.RatelimitUpdateResponse, error) {
+	response, err := t.c.RatelimitUpdate(ctx, thrift.FromRatelimitUpdateRequest(request), opts...)
+	return thrift.ToRatelimitUpdateResponse(response), thrift.ToError(err)
+}
@@ -226,6 +226,20 @@ func (c *clientImpl) ListTaskListPartitions(
 	return c.client.ListTaskListPartitions(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
 }
 
+func (c *clientImpl) GetTaskListPartitionConfig(
+	ctx context.Context,
+	request *types.MatchingGetTaskListPartitionConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingGetTaskListPartitionConfigResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.TaskList.GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetTaskListPartitionConfig(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
 func (c *clientImpl) GetTaskListsByDomain(
 	ctx context.Context,
 	request *types.GetTaskListsByDomainRequest,
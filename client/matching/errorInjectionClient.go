@@ -311,3 +311,29 @@ func (c *errorInjectionClient) GetTaskListsByDomain(
 	}
 	return resp, clientErr
 }
+
+func (c *errorInjectionClient) GetTaskListPartitionConfig(
+	ctx context.Context,
+	request *types.MatchingGetTaskListPartitionConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingGetTaskListPartitionConfigResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.MatchingGetTaskListPartitionConfigResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.GetTaskListPartitionConfig(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.MatchingClientOperationGetTaskListPartitionConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
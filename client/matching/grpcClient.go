@@ -63,6 +63,11 @@ func (g grpcClient) ListTaskListPartitions(ctx context.Context, request *types.M
 	return proto.ToMatchingListTaskListPartitionsResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) GetTaskListPartitionConfig(ctx context.Context, request *types.MatchingGetTaskListPartitionConfigRequest, opts ...yarpc.CallOption) (*types.MatchingGetTaskListPartitionConfigResponse, error) {
+	response, err := g.c.GetTaskListPartitionConfig(ctx, proto.FromMatchingGetTaskListPartitionConfigRequest(request), opts...)
+	return proto.ToMatchingGetTaskListPartitionConfigResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) GetTaskListsByDomain(ctx context.Context, request *types.GetTaskListsByDomainRequest, opts ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, proto.FromMatchingGetTaskListsByDomainRequest(request), opts...)
 	return proto.ToMatchingGetTaskListsByDomainResponse(response), proto.ToError(err)
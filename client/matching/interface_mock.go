@@ -136,6 +136,26 @@ func (mr *MockClientMockRecorder) DescribeTaskList(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockClient)(nil).DescribeTaskList), varargs...)
 }
 
+// GetTaskListPartitionConfig mocks base method.
+func (m *MockClient) GetTaskListPartitionConfig(arg0 context.Context, arg1 *types.MatchingGetTaskListPartitionConfigRequest, arg2 ...yarpc.CallOption) (*types.MatchingGetTaskListPartitionConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskListPartitionConfig", varargs...)
+	ret0, _ := ret[0].(*types.MatchingGetTaskListPartitionConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListPartitionConfig indicates an expected call of GetTaskListPartitionConfig.
+func (mr *MockClientMockRecorder) GetTaskListPartitionConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListPartitionConfig", reflect.TypeOf((*MockClient)(nil).GetTaskListPartitionConfig), varargs...)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockClient) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
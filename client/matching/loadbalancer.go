@@ -60,22 +60,27 @@ type (
 	}
 
 	defaultLoadBalancer struct {
-		nReadPartitions  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
-		nWritePartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
-		domainIDToName   func(string) (string, error)
+		nReadPartitions         dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		nWritePartitions        dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		domainIDToName          func(string) (string, error)
+		partitionConfigProvider PartitionConfigProvider
 	}
 )
 
 // NewLoadBalancer returns an instance of matching load balancer that
-// can help distribute api calls across task list partitions
+// can help distribute api calls across task list partitions. When the
+// partition config provider knows the partition config of a task list,
+// it takes precedence over the number of partitions in dynamic config
 func NewLoadBalancer(
 	domainIDToName func(string) (string, error),
 	dc *dynamicconfig.Collection,
+	partitionConfigProvider PartitionConfigProvider,
 ) LoadBalancer {
 	return &defaultLoadBalancer{
-		domainIDToName:   domainIDToName,
-		nReadPartitions:  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
-		nWritePartitions: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions),
+		domainIDToName:          domainIDToName,
+		nReadPartitions:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
+		nWritePartitions:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions),
+		partitionConfigProvider: partitionConfigProvider,
 	}
 }
 
@@ -85,6 +90,9 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	taskListType int,
 	forwardedFrom string,
 ) string {
+	if config := lb.getPartitionConfig(domainID, taskList, taskListType); config != nil {
+		return lb.pickPartition(taskList, forwardedFrom, int(common.MinInt32(config.NumWritePartitions, config.NumReadPartitions)))
+	}
 	domainName, err := lb.domainIDToName(domainID)
 	if err != nil {
 		return taskList.GetName()
@@ -105,6 +113,9 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	taskListType int,
 	forwardedFrom string,
 ) string {
+	if config := lb.getPartitionConfig(domainID, taskList, taskListType); config != nil {
+		return lb.pickPartition(taskList, forwardedFrom, int(config.NumReadPartitions))
+	}
 	domainName, err := lb.domainIDToName(domainID)
 	if err != nil {
 		return taskList.GetName()
@@ -114,6 +125,17 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 
 }
 
+func (lb *defaultLoadBalancer) getPartitionConfig(
+	domainID string,
+	taskList types.TaskList,
+	taskListType int,
+) *types.TaskListPartitionConfig {
+	if lb.partitionConfigProvider == nil {
+		return nil
+	}
+	return lb.partitionConfigProvider.GetPartitionConfig(domainID, taskList, taskListType)
+}
+
 func (lb *defaultLoadBalancer) pickPartition(
 	taskList types.TaskList,
 	forwardedFrom string,
@@ -251,3 +251,35 @@ func Test_defaultLoadBalancer_pickPartition(t *testing.T) {
 		})
 	}
 }
+
+type staticPartitionConfigProvider struct {
+	config *types.TaskListPartitionConfig
+}
+
+func (p *staticPartitionConfigProvider) GetPartitionConfig(string, types.TaskList, int) *types.TaskListPartitionConfig {
+	return p.config
+}
+
+func Test_defaultLoadBalancer_PartitionConfigProvider(t *testing.T) {
+	lb := &defaultLoadBalancer{
+		nReadPartitions:  func(string, string, int) int { return 1 },
+		nWritePartitions: func(string, string, int) int { return 1 },
+		domainIDToName:   func(string) (string, error) { return "domainName", nil },
+		partitionConfigProvider: &staticPartitionConfigProvider{
+			config: &types.TaskListPartitionConfig{Version: 1, NumReadPartitions: 3, NumWritePartitions: 2},
+		},
+	}
+	taskList := types.TaskList{Name: "taskListName1"}
+	readPartitions := map[string]struct{}{}
+	writePartitions := map[string]struct{}{}
+	for i := 0; i < 200; i++ {
+		readPartitions[lb.PickReadPartition("domainID", taskList, 0, "")] = struct{}{}
+		writePartitions[lb.PickWritePartition("domainID", taskList, 0, "")] = struct{}{}
+	}
+	assert.Len(t, readPartitions, 3)
+	assert.Len(t, writePartitions, 2)
+	assert.NotContains(t, writePartitions, "/__cadence_sys/taskListName1/2")
+
+	lb.partitionConfigProvider = &staticPartitionConfigProvider{}
+	assert.Equal(t, "taskListName1", lb.PickWritePartition("domainID", taskList, 0, ""))
+}
@@ -260,6 +260,24 @@ func (c *metricClient) GetTaskListsByDomain(
 	return resp, err
 }
 
+func (c *metricClient) GetTaskListPartitionConfig(
+	ctx context.Context,
+	request *types.MatchingGetTaskListPartitionConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingGetTaskListPartitionConfigResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientGetTaskListPartitionConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientGetTaskListPartitionConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetTaskListPartitionConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientGetTaskListPartitionConfigScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) emitForwardedFromStats(
 	scope int,
 	forwardedFrom string,
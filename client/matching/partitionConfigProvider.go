@@ -0,0 +1,194 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	partitionConfigCacheMaxCount      = 10000
+	partitionConfigRefreshCallTimeout = 5 * time.Second
+)
+
+type (
+	// PartitionConfigProvider is the interface for implementers of
+	// component that provides the partition config of task lists
+	// whose partitions are scaled automatically by matching
+	PartitionConfigProvider interface {
+		// GetPartitionConfig returns the partition config persisted by the root
+		// partition of the task list. It returns nil when the number of partitions
+		// of the task list should be taken from dynamic config.
+		GetPartitionConfig(
+			domainID string,
+			taskList types.TaskList,
+			taskListType int,
+		) *types.TaskListPartitionConfig
+	}
+
+	partitionConfigProviderImpl struct {
+		client               Client
+		peerResolver         PeerResolver
+		domainIDToName       func(string) (string, error)
+		enableAdaptiveScaler dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		refreshInterval      dynamicconfig.DurationPropertyFn
+		timeSource           clock.TimeSource
+		logger               log.Logger
+		cache                cache.Cache
+	}
+
+	partitionConfigCacheKey struct {
+		domainID     string
+		taskListName string
+		taskListType int
+	}
+
+	partitionConfigCacheEntry struct {
+		sync.Mutex
+		config      *types.TaskListPartitionConfig
+		lastRefresh time.Time
+		refreshing  bool
+	}
+)
+
+// NewPartitionConfigProvider returns an instance of partition config provider
+// which caches the partition config of task lists and refreshes it in the background
+// by calling the matching host that owns the root partition of the task list
+func NewPartitionConfigProvider(
+	client Client,
+	peerResolver PeerResolver,
+	domainIDToName func(string) (string, error),
+	dc *dynamicconfig.Collection,
+	logger log.Logger,
+) PartitionConfigProvider {
+	return &partitionConfigProviderImpl{
+		client:               client,
+		peerResolver:         peerResolver,
+		domainIDToName:       domainIDToName,
+		enableAdaptiveScaler: dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableAdaptiveScaler),
+		refreshInterval:      dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval),
+		timeSource:           clock.NewRealTimeSource(),
+		logger:               logger,
+		cache: cache.New(&cache.Options{
+			InitialCapacity: 32,
+			MaxCount:        partitionConfigCacheMaxCount,
+		}),
+	}
+}
+
+func (p *partitionConfigProviderImpl) GetPartitionConfig(
+	domainID string,
+	taskList types.TaskList,
+	taskListType int,
+) *types.TaskListPartitionConfig {
+	if taskList.GetKind() == types.TaskListKindSticky || strings.HasPrefix(taskList.GetName(), common.ReservedTaskListPrefix) {
+		return nil
+	}
+	domainName, err := p.domainIDToName(domainID)
+	if err != nil {
+		return nil
+	}
+	if !p.enableAdaptiveScaler(domainName, taskList.GetName(), taskListType) {
+		return nil
+	}
+
+	key := partitionConfigCacheKey{
+		domainID:     domainID,
+		taskListName: taskList.GetName(),
+		taskListType: taskListType,
+	}
+	value, err := p.cache.PutIfNotExist(key, &partitionConfigCacheEntry{})
+	if err != nil {
+		return nil
+	}
+	entry := value.(*partitionConfigCacheEntry)
+	config, shouldRefresh := entry.get(p.timeSource.Now(), p.refreshInterval())
+	if shouldRefresh {
+		go p.refresh(key, entry)
+	}
+	return config
+}
+
+func (p *partitionConfigProviderImpl) refresh(key partitionConfigCacheKey, entry *partitionConfigCacheEntry) {
+	config, err := p.fetch(key)
+	if err != nil {
+		p.logger.Warn("Failed to refresh task list partition config",
+			tag.WorkflowDomainID(key.domainID),
+			tag.WorkflowTaskListName(key.taskListName),
+			tag.WorkflowTaskListType(key.taskListType),
+			tag.Error(err),
+		)
+	}
+	entry.update(p.timeSource.Now(), config, err == nil)
+}
+
+func (p *partitionConfigProviderImpl) fetch(key partitionConfigCacheKey) (*types.TaskListPartitionConfig, error) {
+	peer, err := p.peerResolver.FromTaskList(key.taskListName)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), partitionConfigRefreshCallTimeout)
+	defer cancel()
+	resp, err := p.client.GetTaskListPartitionConfig(ctx, &types.MatchingGetTaskListPartitionConfigRequest{
+		DomainUUID:   key.domainID,
+		TaskList:     &types.TaskList{Name: key.taskListName, Kind: types.TaskListKindNormal.Ptr()},
+		TaskListType: types.TaskListType(key.taskListType).Ptr(),
+	}, yarpc.WithShardKey(peer))
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPartitionConfig(), nil
+}
+
+// get returns the cached config and whether the caller is responsible for refreshing it
+func (e *partitionConfigCacheEntry) get(now time.Time, refreshInterval time.Duration) (*types.TaskListPartitionConfig, bool) {
+	e.Lock()
+	defer e.Unlock()
+	if e.refreshing || now.Sub(e.lastRefresh) < refreshInterval {
+		return e.config, false
+	}
+	e.refreshing = true
+	return e.config, true
+}
+
+// update records the result of a refresh, a failed refresh keeps serving the last known config
+func (e *partitionConfigCacheEntry) update(now time.Time, config *types.TaskListPartitionConfig, succeeded bool) {
+	e.Lock()
+	defer e.Unlock()
+	e.refreshing = false
+	e.lastRefresh = now
+	if succeeded && (config == nil || e.config == nil || config.Version >= e.config.Version) {
+		e.config = config
+	}
+}
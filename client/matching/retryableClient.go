@@ -203,3 +203,20 @@ func (c *retryableClient) GetTaskListsByDomain(
 	err := c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *retryableClient) GetTaskListPartitionConfig(
+	ctx context.Context,
+	request *types.MatchingGetTaskListPartitionConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingGetTaskListPartitionConfigResponse, error) {
+
+	var resp *types.MatchingGetTaskListPartitionConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetTaskListPartitionConfig(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
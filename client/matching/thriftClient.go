@@ -84,6 +84,15 @@ func (t thriftClient) ListTaskListPartitions(
 	return thrift.ToListTaskListPartitionsResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) GetTaskListPartitionConfig(
+	ctx context.Context,
+	request *types.MatchingGetTaskListPartitionConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingGetTaskListPartitionConfigResponse, error) {
+	response, err := t.c.GetTaskListPartitionConfig(ctx, thrift.FromMatchingGetTaskListPartitionConfigRequest(request), opts...)
+	return thrift.ToMatchingGetTaskListPartitionConfigResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) GetTaskListsByDomain(
 	ctx context.Context,
 	request *types.GetTaskListsByDomainRequest,
@@ -34,7 +34,10 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/blobstore/gcloud"
+	"github.com/uber/cadence/common/blobstore/s3store"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -116,10 +119,13 @@ func (s *server) startService() common.Daemon {
 
 	params.PersistenceConfig = s.cfg.Persistence
 
+	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger, params.Name)
+	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
+
 	err = nil
 	if s.cfg.DynamicConfig.Client == "" {
 		params.Logger.Warn("falling back to legacy file based dynamicClientConfig")
-		params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger, s.doneC)
+		params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger, params.MetricsClient, s.doneC)
 	} else {
 		switch s.cfg.DynamicConfig.Client {
 		case dynamicconfig.ConfigStoreClient:
@@ -132,7 +138,7 @@ func (s *server) startService() common.Daemon {
 			)
 		case dynamicconfig.FileBasedClient:
 			params.Logger.Info("initialising File Based dynamic config client")
-			params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfig.FileBased, params.Logger, s.doneC)
+			params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfig.FileBased, params.Logger, params.MetricsClient, s.doneC)
 		default:
 			params.Logger.Info("initialising NOP dynamic config client")
 			params.DynamicConfig = dynamicconfig.NewNopClient()
@@ -151,8 +157,6 @@ func (s *server) startService() common.Daemon {
 		dynamicconfig.ClusterNameFilter(clusterGroupMetadata.CurrentClusterName),
 	)
 
-	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger, params.Name)
-
 	rpcParams, err := rpc.NewParams(params.Name, s.cfg, dc)
 	if err != nil {
 		log.Fatalf("error creating rpc factory params: %v", err)
@@ -190,8 +194,6 @@ func (s *server) startService() common.Daemon {
 
 	params.ClusterRedirectionPolicy = s.cfg.ClusterGroupMetadata.ClusterRedirectionPolicy
 
-	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
-
 	params.ClusterMetadata = cluster.NewMetadata(
 		clusterGroupMetadata.FailoverVersionIncrement,
 		clusterGroupMetadata.PrimaryClusterName,
@@ -206,7 +208,8 @@ func (s *server) startService() common.Daemon {
 		dynamicconfig.AdvancedVisibilityWritingMode,
 	)()
 	isAdvancedVisEnabled := common.IsAdvancedVisibilityWritingEnabled(advancedVisMode, params.PersistenceConfig.IsAdvancedVisibilityConfigExist())
-	if isAdvancedVisEnabled {
+	isKafkaAuditEnabled := s.cfg.Authorization.Audit.Enable && s.cfg.Authorization.Audit.Kafka != nil
+	if isAdvancedVisEnabled || isKafkaAuditEnabled {
 		params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, isAdvancedVisEnabled)
 	} else {
 		params.MessagingClient = nil
@@ -260,9 +263,9 @@ func (s *server) startService() common.Daemon {
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit)
 	params.PersistenceConfig.ErrorInjectionRate = dc.GetFloat64Property(dynamicconfig.PersistenceErrorInjectionRate)
 	params.AuthorizationConfig = s.cfg.Authorization
-	params.BlobstoreClient, err = filestore.NewFilestoreClient(s.cfg.Blobstore.Filestore)
+	params.BlobstoreClient, err = newBlobstoreClient(&s.cfg.Blobstore)
 	if err != nil {
-		log.Printf("failed to create file blobstore client, will continue startup without it: %v", err)
+		log.Printf("failed to create blobstore client, will continue startup without it: %v", err)
 		params.BlobstoreClient = nil
 	}
 
@@ -289,6 +292,18 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// newBlobstoreClient creates the client of the configured blobstore, filestore is used when no object storage is configured
+func newBlobstoreClient(cfg *config.Blobstore) (blobstore.Client, error) {
+	switch {
+	case cfg.S3store != nil:
+		return s3store.NewS3Client(cfg.S3store)
+	case cfg.Gstorage != nil:
+		return gcloud.NewGcloudClient(cfg.Gstorage)
+	default:
+		return filestore.NewFilestoreClient(cfg.Filestore)
+	}
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
@@ -57,6 +57,12 @@ var (
 	ErrInvalidGetHistoryRequest = errors.New("get archived history request is invalid")
 	// ErrInvalidQueryVisibilityRequest is the error for invalid Query Visibility request
 	ErrInvalidQueryVisibilityRequest = errors.New("query visiblity request is invalid")
+	// ErrInvalidDeleteHistoryRequest is the error for invalid DeleteHistory request
+	ErrInvalidDeleteHistoryRequest = errors.New("delete archived history request is invalid")
+	// ErrInvalidListVisibilityRequest is the error for invalid List Visibility request
+	ErrInvalidListVisibilityRequest = errors.New("list visibility request is invalid")
+	// ErrInvalidDeleteVisibilityRequest is the error for invalid Delete Visibility request
+	ErrInvalidDeleteVisibilityRequest = errors.New("delete visibility request is invalid")
 	// ErrNextPageTokenCorrupted is the error for corrupted GetHistory token
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
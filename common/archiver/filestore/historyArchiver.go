@@ -22,7 +22,8 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(domainID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories stored in that file are encoded in JSON format, which
+// can optionally be compressed and encrypted based on the archiver config.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -72,6 +73,7 @@ type (
 		container *archiver.HistoryBootstrapContainer
 		fileMode  os.FileMode
 		dirMode   os.FileMode
+		codec     *historyCodec
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -104,10 +106,15 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	codec, err := newHistoryCodec(config)
+	if err != nil {
+		return nil, err
+	}
 	return &historyArchiver{
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		codec:           codec,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -177,6 +184,11 @@ func (h *historyArchiver) Archive(
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 		return err
 	}
+	encodedHistoryBatches, err = h.codec.encode(encodedHistoryBatches)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
 
 	dirPath := URI.Path()
 	if err = util.MkdirAll(dirPath, h.dirMode); err != nil {
@@ -251,6 +263,10 @@ func (h *historyArchiver) Get(
 	if err != nil {
 		return nil, &types.InternalServiceError{Message: err.Error()}
 	}
+	encodedHistoryBatches, err = h.codec.decode(encodedHistoryBatches)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
 
 	historyBatches, err := decodeHistoryBatches(encodedHistoryBatches)
 	if err != nil {
@@ -282,6 +298,45 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteHistoryRequest.Error()}
+	}
+
+	dirPath := URI.Path()
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil
+	}
+
+	// the separator is included so that histories of other runs sharing the same prefix are not matched
+	prefix := constructHistoryFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID) + "_"
+	filenames, err := util.ListFilesByPrefix(dirPath, prefix)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	for _, filename := range filenames {
+		if _, err := extractCloseFailoverVersion(filename); err != nil {
+			continue
+		}
+		if err := util.DeleteFile(path.Join(dirPath, filename)); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
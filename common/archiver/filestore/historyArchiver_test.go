@@ -21,7 +21,9 @@
 package filestore
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
 	"io/ioutil"
 	"os"
@@ -563,6 +565,120 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_CompressedAndEncrypted() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestArchiveAndGet")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	keyFile := path.Join(dir, "key")
+	s.NoError(ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))), testFileMode))
+
+	historyArchiver, err := newHistoryArchiver(s.container, &config.FilestoreArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: CompressionZstd,
+		Encryption: &config.FilestoreArchiverEncryption{
+			KeyID:   "test-key",
+			KeyFile: keyFile,
+		},
+	}, historyIterator)
+	s.NoError(err)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	expectedFilename := constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	content, err := util.ReadFile(path.Join(dir, expectedFilename))
+	s.NoError(err)
+	_, err = decodeHistoryBatches(content)
+	s.Error(err)
+
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	// histories archived before compression and encryption are enabled can still be read
+	URI, err = archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	getRequest.CloseFailoverVersion = common.Int64Ptr(1)
+	response, err = historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.Equal(s.historyBatchesV1, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	err = historyArchiver.Delete(context.Background(), URI, &archiver.DeleteHistoryRequest{
+		DomainID: testDomainID,
+		RunID:    testRunID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDelete_Success() {
+	dir := s.T().TempDir()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	data, err := encode(s.historyBatchesV100)
+	s.NoError(err)
+	filenames := []string{
+		constructHistoryFilename(testDomainID, testWorkflowID, testRunID, 1),
+		constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion),
+		constructHistoryFilename(testDomainID, testWorkflowID, "another run ID", testCloseFailoverVersion),
+	}
+	for _, filename := range filenames {
+		s.NoError(util.WriteFile(path.Join(dir, filename), data, testFileMode))
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+	remaining, err := util.ListFiles(dir)
+	s.NoError(err)
+	s.Equal([]string{filenames[2]}, remaining)
+
+	// deleting a history which doesn't exist is not an error
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -0,0 +1,304 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	// CompressionNone keeps archived histories uncompressed
+	CompressionNone = "none"
+	// CompressionGzip compresses archived histories with gzip
+	CompressionGzip = "gzip"
+	// CompressionZstd compresses archived histories with zstd
+	CompressionZstd = "zstd"
+
+	// historyEnvelopeMagic starts every history file that is compressed or encrypted,
+	// while a history file of plain json always starts with '['
+	historyEnvelopeMagic = "\x00cdnchist1"
+
+	encryptionKeySize = 32
+)
+
+var (
+	errInvalidCompression    = errors.New("invalid compression")
+	errInvalidEncryptionKey  = errors.New("invalid encryption key")
+	errCorruptedHistoryFile  = errors.New("corrupted history file")
+	errEncryptionKeyNotFound = errors.New("encryption key of history file not found")
+)
+
+type (
+	// historyCodec converts the encoded history batches to the content of history files and back.
+	// Histories are first compressed and then encrypted, when neither is configured the plain json is written as before.
+	historyCodec struct {
+		compression string
+		// keyID is the id of the key to encrypt new histories, empty if they are not encrypted
+		keyID string
+		// keys are all the known key-encryption keys by id
+		keys map[string][]byte
+
+		zstdEncoder *zstd.Encoder
+		zstdDecoder *zstd.Decoder
+	}
+
+	// historyEnvelopeHeader describes how the payload of a history file is encoded
+	historyEnvelopeHeader struct {
+		Compression string `json:"compression,omitempty"`
+		KeyID       string `json:"keyID,omitempty"`
+		// EncryptedDataKey is the data key of the payload encrypted by the key-encryption key
+		EncryptedDataKey []byte `json:"encryptedDataKey,omitempty"`
+	}
+)
+
+func newHistoryCodec(cfg *config.FilestoreArchiver) (*historyCodec, error) {
+	codec := &historyCodec{
+		compression: cfg.Compression,
+		keys:        make(map[string][]byte),
+	}
+	switch codec.compression {
+	case "":
+		codec.compression = CompressionNone
+	case CompressionNone, CompressionGzip, CompressionZstd:
+	default:
+		return nil, errInvalidCompression
+	}
+
+	if cfg.Encryption != nil {
+		if cfg.Encryption.KeyID == "" {
+			return nil, errInvalidEncryptionKey
+		}
+		key, err := readEncryptionKey(cfg.Encryption.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		codec.keyID = cfg.Encryption.KeyID
+		codec.keys[codec.keyID] = key
+		for keyID, keyFile := range cfg.Encryption.PreviousKeyFiles {
+			if keyID == codec.keyID {
+				continue
+			}
+			key, err := readEncryptionKey(keyFile)
+			if err != nil {
+				return nil, err
+			}
+			codec.keys[keyID] = key
+		}
+	}
+
+	var err error
+	if codec.zstdEncoder, err = zstd.NewWriter(nil); err != nil {
+		return nil, err
+	}
+	if codec.zstdDecoder, err = zstd.NewReader(nil); err != nil {
+		return nil, err
+	}
+	return codec, nil
+}
+
+func (c *historyCodec) encode(data []byte) ([]byte, error) {
+	if c.compression == CompressionNone && c.keyID == "" {
+		return data, nil
+	}
+
+	header := historyEnvelopeHeader{}
+	payload := data
+	var err error
+	if c.compression != CompressionNone {
+		header.Compression = c.compression
+		if payload, err = c.compress(payload); err != nil {
+			return nil, err
+		}
+	}
+
+	var dataKey []byte
+	if c.keyID != "" {
+		dataKey = make([]byte, encryptionKeySize)
+		if _, err := rand.Read(dataKey); err != nil {
+			return nil, err
+		}
+		header.KeyID = c.keyID
+		if header.EncryptedDataKey, err = encrypt(c.keys[c.keyID], dataKey, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	if dataKey != nil {
+		// the header is authenticated along with the payload, so it can't be altered without being noticed
+		if payload, err = encrypt(dataKey, payload, headerBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(historyEnvelopeMagic)
+	headerLen := make([]byte, 4)
+	binary.BigEndian.PutUint32(headerLen, uint32(len(headerBytes)))
+	buf.Write(headerLen)
+	buf.Write(headerBytes)
+	buf.Write(payload)
+	return buf.Bytes(), nil
+}
+
+func (c *historyCodec) decode(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, []byte(historyEnvelopeMagic)) {
+		// history files written before compression and encryption were supported
+		return data, nil
+	}
+
+	data = data[len(historyEnvelopeMagic):]
+	if len(data) < 4 {
+		return nil, errCorruptedHistoryFile
+	}
+	headerLen := binary.BigEndian.Uint32(data)
+	data = data[4:]
+	if uint64(len(data)) < uint64(headerLen) {
+		return nil, errCorruptedHistoryFile
+	}
+	headerBytes, payload := data[:headerLen], data[headerLen:]
+	header := historyEnvelopeHeader{}
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, errCorruptedHistoryFile
+	}
+
+	var err error
+	if header.KeyID != "" {
+		key, ok := c.keys[header.KeyID]
+		if !ok {
+			return nil, fmt.Errorf("%v: %v", errEncryptionKeyNotFound, header.KeyID)
+		}
+		dataKey, err := decrypt(key, header.EncryptedDataKey, nil)
+		if err != nil {
+			return nil, err
+		}
+		if payload, err = decrypt(dataKey, payload, headerBytes); err != nil {
+			return nil, err
+		}
+	}
+	if header.Compression != "" {
+		if payload, err = c.decompress(header.Compression, payload); err != nil {
+			return nil, err
+		}
+	}
+	return payload, nil
+}
+
+func (c *historyCodec) compress(data []byte) ([]byte, error) {
+	switch c.compression {
+	case CompressionGzip:
+		buf := &bytes.Buffer{}
+		writer := gzip.NewWriter(buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		return c.zstdEncoder.EncodeAll(data, nil), nil
+	default:
+		return nil, errInvalidCompression
+	}
+}
+
+func (c *historyCodec) decompress(compression string, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	case CompressionZstd:
+		return c.zstdDecoder.DecodeAll(data, nil)
+	default:
+		return nil, errInvalidCompression
+	}
+}
+
+func readEncryptionKey(keyFile string) ([]byte, error) {
+	// This is a path from the server config, excluding it from security scanners
+	// #nosec
+	content, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil || len(key) != encryptionKeySize {
+		return nil, errInvalidEncryptionKey
+	}
+	return key, nil
+}
+
+// encrypt encrypts the plaintext with AES-256-GCM, the random nonce is prepended to the ciphertext
+func encrypt(key []byte, plaintext []byte, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func decrypt(key []byte, ciphertext []byte, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errCorruptedHistoryFile
+	}
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/config"
+)
+
+type historyCodecSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	keyDir string
+	data   []byte
+}
+
+func TestHistoryCodecSuite(t *testing.T) {
+	suite.Run(t, new(historyCodecSuite))
+}
+
+func (s *historyCodecSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.keyDir = s.T().TempDir()
+	s.data = []byte(`[{"events":[{"eventId":1,"version":100},{"eventId":2,"version":100}]}]`)
+}
+
+func (s *historyCodecSuite) TestNewHistoryCodec_InvalidConfig() {
+	_, err := newHistoryCodec(&config.FilestoreArchiver{Compression: "lz4"})
+	s.Equal(errInvalidCompression, err)
+
+	_, err = newHistoryCodec(&config.FilestoreArchiver{Encryption: &config.FilestoreArchiverEncryption{
+		KeyFile: s.writeKeyFile("key1", encryptionKeySize),
+	}})
+	s.Equal(errInvalidEncryptionKey, err)
+
+	_, err = newHistoryCodec(&config.FilestoreArchiver{Encryption: &config.FilestoreArchiverEncryption{
+		KeyID:   "key1",
+		KeyFile: s.writeKeyFile("key1", 16),
+	}})
+	s.Equal(errInvalidEncryptionKey, err)
+
+	_, err = newHistoryCodec(&config.FilestoreArchiver{Encryption: &config.FilestoreArchiverEncryption{
+		KeyID:   "key1",
+		KeyFile: filepath.Join(s.keyDir, "not-exist"),
+	}})
+	s.Error(err)
+}
+
+func (s *historyCodecSuite) TestEncodeDecode() {
+	keyFile := s.writeKeyFile("key1", encryptionKeySize)
+	testCases := map[string]*config.FilestoreArchiver{
+		"plain":            {},
+		"none compression": {Compression: CompressionNone},
+		"gzip":             {Compression: CompressionGzip},
+		"zstd":             {Compression: CompressionZstd},
+		"encrypted": {
+			Encryption: &config.FilestoreArchiverEncryption{KeyID: "key1", KeyFile: keyFile},
+		},
+		"zstd and encrypted": {
+			Compression: CompressionZstd,
+			Encryption:  &config.FilestoreArchiverEncryption{KeyID: "key1", KeyFile: keyFile},
+		},
+	}
+	for name, cfg := range testCases {
+		s.Run(name, func() {
+			codec, err := newHistoryCodec(cfg)
+			s.NoError(err)
+			encoded, err := codec.encode(s.data)
+			s.NoError(err)
+			if cfg.Encryption != nil {
+				s.NotContains(string(encoded), "eventId")
+			}
+			decoded, err := codec.decode(encoded)
+			s.NoError(err)
+			s.Equal(s.data, decoded)
+		})
+	}
+}
+
+func (s *historyCodecSuite) TestEncode_PlainJSONWhenNotConfigured() {
+	codec, err := newHistoryCodec(&config.FilestoreArchiver{})
+	s.NoError(err)
+	encoded, err := codec.encode(s.data)
+	s.NoError(err)
+	s.Equal(s.data, encoded)
+}
+
+func (s *historyCodecSuite) TestDecode_AnyFormat() {
+	keyFile := s.writeKeyFile("key1", encryptionKeySize)
+	writer, err := newHistoryCodec(&config.FilestoreArchiver{
+		Compression: CompressionGzip,
+		Encryption:  &config.FilestoreArchiverEncryption{KeyID: "key1", KeyFile: keyFile},
+	})
+	s.NoError(err)
+	encoded, err := writer.encode(s.data)
+	s.NoError(err)
+
+	// the compression of the reader doesn't matter
+	reader, err := newHistoryCodec(&config.FilestoreArchiver{
+		Compression: CompressionZstd,
+		Encryption:  &config.FilestoreArchiverEncryption{KeyID: "key1", KeyFile: keyFile},
+	})
+	s.NoError(err)
+	decoded, err := reader.decode(encoded)
+	s.NoError(err)
+	s.Equal(s.data, decoded)
+
+	// plain json written before the codec existed
+	decoded, err = reader.decode(s.data)
+	s.NoError(err)
+	s.Equal(s.data, decoded)
+}
+
+func (s *historyCodecSuite) TestDecode_KeyRotation() {
+	oldKeyFile := s.writeKeyFile("key1", encryptionKeySize)
+	newKeyFile := s.writeKeyFile("key2", encryptionKeySize)
+	oldCodec, err := newHistoryCodec(&config.FilestoreArchiver{
+		Encryption: &config.FilestoreArchiverEncryption{KeyID: "key1", KeyFile: oldKeyFile},
+	})
+	s.NoError(err)
+	encoded, err := oldCodec.encode(s.data)
+	s.NoError(err)
+
+	newCodec, err := newHistoryCodec(&config.FilestoreArchiver{
+		Encryption: &config.FilestoreArchiverEncryption{KeyID: "key2", KeyFile: newKeyFile},
+	})
+	s.NoError(err)
+	_, err = newCodec.decode(encoded)
+	s.Error(err)
+
+	newCodec, err = newHistoryCodec(&config.FilestoreArchiver{
+		Encryption: &config.FilestoreArchiverEncryption{
+			KeyID:            "key2",
+			KeyFile:          newKeyFile,
+			PreviousKeyFiles: map[string]string{"key1": oldKeyFile},
+		},
+	})
+	s.NoError(err)
+	decoded, err := newCodec.decode(encoded)
+	s.NoError(err)
+	s.Equal(s.data, decoded)
+}
+
+func (s *historyCodecSuite) TestDecode_Tampered() {
+	codec, err := newHistoryCodec(&config.FilestoreArchiver{
+		Encryption: &config.FilestoreArchiverEncryption{KeyID: "key1", KeyFile: s.writeKeyFile("key1", encryptionKeySize)},
+	})
+	s.NoError(err)
+	encoded, err := codec.encode(s.data)
+	s.NoError(err)
+
+	encoded[len(encoded)-1] ^= 0xff
+	_, err = codec.decode(encoded)
+	s.Error(err)
+
+	_, err = codec.decode(encoded[:len(historyEnvelopeMagic)+2])
+	s.Equal(errCorruptedHistoryFile, err)
+}
+
+func (s *historyCodecSuite) writeKeyFile(name string, size int) string {
+	key := make([]byte, size)
+	_, err := rand.Read(key)
+	s.Require().NoError(err)
+	keyFile := filepath.Join(s.keyDir, name)
+	s.Require().NoError(ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+	return keyFile
+}
@@ -26,7 +26,6 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
-	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -46,233 +45,73 @@ func (s *queryParserSuite) SetupTest() {
 	s.parser = NewQueryParser()
 }
 
-func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
+func (s *queryParserSuite) TestParseCloseTime() {
 	testCases := []struct {
 		query       string
 		expectErr   bool
 		parsedQuery *parsedQuery
 	}{
 		{
-			query:     "WorkflowID = \"random workflowID\"",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
-			},
-		},
-		{
-			query:     "WorkflowID = \"random workflowID\" and WorkflowID = \"random workflowID\"",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
-			},
-		},
-		{
-			query:     "RunID = \"random runID\"",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				runID: common.StringPtr("random runID"),
-			},
-		},
-		{
-			query:     "WorkflowType = \"random typeName\"",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowTypeName: common.StringPtr("random typeName"),
-			},
-		},
-		{
-			query:     "WorkflowID = 'random workflowID'",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
-			},
-		},
-		{
-			query:     "WorkflowType = 'random typeName' and WorkflowType = \"another typeName\"",
+			query:     "CloseTime <= 1000",
 			expectErr: false,
 			parsedQuery: &parsedQuery{
-				emptyResult: true,
+				earliestCloseTime: 0,
+				latestCloseTime:   1000,
 			},
 		},
 		{
-			query:     "WorkflowType = 'random typeName' and (WorkflowID = \"random workflowID\" and RunID='random runID')",
+			query:     "CloseTime < 2000 and CloseTime <= 1000 and CloseTime > 300",
 			expectErr: false,
 			parsedQuery: &parsedQuery{
-				workflowID:       common.StringPtr("random workflowID"),
-				runID:            common.StringPtr("random runID"),
-				workflowTypeName: common.StringPtr("random typeName"),
+				earliestCloseTime: 301,
+				latestCloseTime:   1000,
 			},
 		},
 		{
-			query:     "runID = random workflowID",
-			expectErr: true,
-		},
-		{
-			query:     "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
-			expectErr: true,
-		},
-		{
-			query:     "WorkflowID = \"random workflowID\" or runID = \"random runID\"",
-			expectErr: true,
-		},
-		{
-			query:     "workflowid = \"random workflowID\"",
-			expectErr: true,
-		},
-		{
-			query:     "runID > \"random workflowID\"",
-			expectErr: true,
-		},
-	}
-
-	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
-		if tc.expectErr {
-			s.Error(err)
-			continue
-		}
-		s.NoError(err)
-		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
-		if !tc.parsedQuery.emptyResult {
-			s.Equal(tc.parsedQuery.workflowID, parsedQuery.workflowID)
-			s.Equal(tc.parsedQuery.runID, parsedQuery.runID)
-			s.Equal(tc.parsedQuery.workflowTypeName, parsedQuery.workflowTypeName)
-		}
-	}
-}
-
-func (s *queryParserSuite) TestParseCloseStatus() {
-	testCases := []struct {
-		query       string
-		expectErr   bool
-		parsedQuery *parsedQuery
-	}{
-		{
-			query:     "CloseStatus = \"Completed\"",
+			query:     "CloseTime = 2000 and (CloseTime > 1000 and CloseTime <= 9999)",
 			expectErr: false,
 			parsedQuery: &parsedQuery{
-				closeStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+				earliestCloseTime: 2000,
+				latestCloseTime:   2000,
 			},
 		},
 		{
-			query:     "CloseStatus = 'continuedasnew'",
+			query:     "CloseTime <= \"2019-01-01T11:11:11Z\" and CloseTime >= 1000000",
 			expectErr: false,
 			parsedQuery: &parsedQuery{
-				closeStatus: types.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
+				earliestCloseTime: 1000000,
+				latestCloseTime:   1546341071000000000,
 			},
 		},
 		{
-			query:     "CloseStatus = 'TIMED_OUT'",
+			query:     "CloseTime between 1000 and 2000 and WorkflowID = 'random workflowID'",
 			expectErr: false,
 			parsedQuery: &parsedQuery{
-				closeStatus: types.WorkflowExecutionCloseStatusTimedOut.Ptr(),
+				earliestCloseTime: 1000,
+				latestCloseTime:   2000,
 			},
 		},
 		{
-			query:     "CloseStatus = 'Failed' and CloseStatus = \"Failed\"",
+			query:     "CloseTime = \"2019-01-01T11:11:11Z\" and SearchPrecision = 'Day'",
 			expectErr: false,
 			parsedQuery: &parsedQuery{
-				closeStatus: types.WorkflowExecutionCloseStatusFailed.Ptr(),
+				earliestCloseTime: 1546300800000000000,
+				latestCloseTime:   1546387199999999999,
 			},
 		},
 		{
-			query:     "(CloseStatus = 'Timedout' and CloseStatus = \"canceled\")",
+			query:     "CloseTime > 2000 and CloseTime < 1000",
 			expectErr: false,
 			parsedQuery: &parsedQuery{
 				emptyResult: true,
 			},
 		},
-		{
-			query:     "closeStatus = \"Failed\"",
-			expectErr: true,
-		},
-		{
-			query:     "CloseStatus = \"Failed\" or CloseStatus = \"Failed\"",
-			expectErr: true,
-		},
-		{
-			query:     "CloseStatus = \"unknown\"",
-			expectErr: true,
-		},
-		{
-			query:     "CloseStatus > \"Failed\"",
-			expectErr: true,
-		},
-		{
-			query:     "CloseStatus = 1",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				closeStatus: types.WorkflowExecutionCloseStatusFailed.Ptr(),
-			},
-		},
-		{
-			query:     "CloseStatus = 10",
-			expectErr: true,
-		},
-	}
-
-	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
-		if tc.expectErr {
-			s.Error(err)
-			continue
-		}
-		s.NoError(err)
-		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
-		if !tc.parsedQuery.emptyResult {
-			s.Equal(tc.parsedQuery.closeStatus, parsedQuery.closeStatus)
-		}
-	}
-}
-
-func (s *queryParserSuite) TestParseCloseTime() {
-	testCases := []struct {
-		query       string
-		expectErr   bool
-		parsedQuery *parsedQuery
-	}{
-		{
-			query:     "CloseTime <= 1000",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 0,
-				latestCloseTime:   1000,
-			},
-		},
-		{
-			query:     "CloseTime < 2000 and CloseTime <= 1000 and CloseTime > 300",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 301,
-				latestCloseTime:   1000,
-			},
-		},
-		{
-			query:     "CloseTime = 2000 and (CloseTime > 1000 and CloseTime <= 9999)",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 2000,
-				latestCloseTime:   2000,
-			},
-		},
-		{
-			query:     "CloseTime <= \"2019-01-01T11:11:11Z\" and CloseTime >= 1000000",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 1000000,
-				latestCloseTime:   1546341071000000000,
-			},
-		},
-		{
-			query:     "closeTime = 2000",
-			expectErr: true,
-		},
 		{
 			query:     "CloseTime > \"2019-01-01 00:00:00\"",
 			expectErr: true,
 		},
 		{
-			query:     "CloseStatus > 2000 or CloseStatus < 1000",
+			query:     "CloseTime > 2000 or",
 			expectErr: true,
 		},
 	}
@@ -293,49 +132,42 @@ func (s *queryParserSuite) TestParseCloseTime() {
 }
 
 func (s *queryParserSuite) TestParse() {
+	record := &visibilityRecord{
+		WorkflowID:       "random workflowID",
+		RunID:            "random runID",
+		WorkflowTypeName: "random typeName",
+		CloseTimestamp:   5000,
+		CloseStatus:      types.WorkflowExecutionCloseStatusFailed,
+	}
 	testCases := []struct {
 		query       string
-		expectErr   bool
-		parsedQuery *parsedQuery
+		shouldMatch bool
 	}{
 		{
-			query:     "CloseTime <= \"2019-01-01T11:11:11Z\" and WorkflowID = 'random workflowID'",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 0,
-				latestCloseTime:   1546341071000000000,
-				workflowID:        common.StringPtr("random workflowID"),
-			},
+			query:       "CloseTime <= \"2019-01-01T11:11:11Z\" and WorkflowID = 'random workflowID'",
+			shouldMatch: true,
 		},
 		{
-			query:     "CloseTime > 1999 and CloseTime < 10000 and RunID = 'random runID' and CloseStatus = 'Failed'",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 2000,
-				latestCloseTime:   9999,
-				runID:             common.StringPtr("random runID"),
-				closeStatus:       types.WorkflowExecutionCloseStatusFailed.Ptr(),
-			},
+			query:       "CloseTime > 1999 and CloseTime < 10000 and RunID = 'random runID' and CloseStatus = 'Failed'",
+			shouldMatch: true,
 		},
 		{
-			query:     "CloseTime > 2001 and CloseTime < 10000 and (RunID = 'random runID') and CloseStatus = 'Failed' and (RunID = 'another ID')",
-			expectErr: false,
-			parsedQuery: &parsedQuery{
-				emptyResult: true,
-			},
+			query:       "WorkflowType = 'random typeName' and (WorkflowID = 'another workflowID' or RunID = 'random runID')",
+			shouldMatch: true,
+		},
+		{
+			query:       "CloseStatus = 'Completed' or CloseStatus = 'TimedOut'",
+			shouldMatch: false,
+		},
+		{
+			query:       "CloseTime > 5000 or RunID = 'another runID'",
+			shouldMatch: false,
 		},
 	}
 
 	for _, tc := range testCases {
 		parsedQuery, err := s.parser.Parse(tc.query)
-		if tc.expectErr {
-			s.Error(err)
-			continue
-		}
 		s.NoError(err)
-		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
-		if !tc.parsedQuery.emptyResult {
-			s.Equal(tc.parsedQuery, parsedQuery)
-		}
+		s.Equal(tc.shouldMatch, matchQuery(record, parsedQuery))
 	}
 }
@@ -237,6 +237,98 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+func (v *visibilityArchiver) List(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ListVisibilityRequest,
+) (*archiver.ListVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateListVisibilityRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidListVisibilityRequest.Error()}
+	}
+
+	var token *queryVisibilityToken
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeQueryVisibilityToken(request.NextPageToken)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+	}
+
+	dirPath := path.Join(URI.Path(), request.DomainID)
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return &archiver.ListVisibilityResponse{}, nil
+	}
+
+	filenames, err := util.ListFiles(dirPath)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+	parsedFilenames, err := listExpiredFiles(filenames, request.CloseTimeBefore, token)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	response := &archiver.ListVisibilityResponse{}
+	for _, parsedFilename := range parsedFilenames {
+		encodedRecord, err := util.ReadFile(path.Join(dirPath, parsedFilename.name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		response.Records = append(response.Records, (*archiver.ArchiveVisibilityRequest)(record))
+		if len(response.Records) == request.PageSize {
+			if len(response.Records) < len(parsedFilenames) {
+				encodedToken, err := serializeToken(&queryVisibilityToken{
+					LastCloseTime: record.CloseTimestamp,
+					LastRunID:     record.RunID,
+				})
+				if err != nil {
+					return nil, &types.InternalServiceError{Message: err.Error()}
+				}
+				response.NextPageToken = encodedToken
+			}
+			break
+		}
+	}
+	return response, nil
+}
+
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteVisibilityRequest.Error()}
+	}
+
+	filename := constructVisibilityFilename(request.CloseTimestamp, request.RunID)
+	if err := util.DeleteFile(path.Join(URI.Path(), request.DomainID, filename)); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -251,27 +343,35 @@ type parsedVisFilename struct {
 	hashedRunID string
 }
 
+func parseVisibilityFilename(name string) (*parsedVisFilename, error) {
+	pieces := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '.'
+	})
+	if len(pieces) != 3 {
+		return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+	}
+
+	closeTime, err := strconv.ParseInt(pieces[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+	}
+	return &parsedVisFilename{
+		name:        name,
+		closeTime:   closeTime,
+		hashedRunID: pieces[1],
+	}, nil
+}
+
 // sortAndFilterFiles sort visibility record file names based on close timestamp (desc) and use hashed runID to break ties.
 // if a nextPageToken is give, it only returns filenames that have a smaller close timestamp
 func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]string, error) {
 	var parsedFilenames []*parsedVisFilename
 	for _, name := range filenames {
-		pieces := strings.FieldsFunc(name, func(r rune) bool {
-			return r == '_' || r == '.'
-		})
-		if len(pieces) != 3 {
-			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
-		}
-
-		closeTime, err := strconv.ParseInt(pieces[0], 10, 64)
+		parsedFilename, err := parseVisibilityFilename(name)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+			return nil, err
 		}
-		parsedFilenames = append(parsedFilenames, &parsedVisFilename{
-			name:        name,
-			closeTime:   closeTime,
-			hashedRunID: pieces[1],
-		})
+		parsedFilenames = append(parsedFilenames, parsedFilename)
 	}
 
 	sort.Slice(parsedFilenames, func(i, j int) bool {
@@ -303,23 +403,44 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 	return filteredFilenames, nil
 }
 
-func matchQuery(record *visibilityRecord, query *parsedQuery) bool {
-	if record.CloseTimestamp < query.earliestCloseTime || record.CloseTimestamp > query.latestCloseTime {
-		return false
-	}
-	if query.workflowID != nil && record.WorkflowID != *query.workflowID {
-		return false
-	}
-	if query.runID != nil && record.RunID != *query.runID {
-		return false
+// listExpiredFiles returns the visibility record files closed before the given timestamp, oldest first.
+// Files are ordered by close timestamp and hashed runID so that a token stays valid while files are deleted.
+func listExpiredFiles(filenames []string, closeTimeBefore int64, token *queryVisibilityToken) ([]*parsedVisFilename, error) {
+	var lastHashedRunID string
+	if token != nil {
+		lastHashedRunID = hash(token.LastRunID)
 	}
-	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
-		return false
+
+	var parsedFilenames []*parsedVisFilename
+	for _, name := range filenames {
+		parsedFilename, err := parseVisibilityFilename(name)
+		if err != nil {
+			return nil, err
+		}
+		if parsedFilename.closeTime >= closeTimeBefore {
+			continue
+		}
+		if token != nil && (parsedFilename.closeTime < token.LastCloseTime ||
+			(parsedFilename.closeTime == token.LastCloseTime && parsedFilename.hashedRunID <= lastHashedRunID)) {
+			continue
+		}
+		parsedFilenames = append(parsedFilenames, parsedFilename)
 	}
-	if query.closeStatus != nil && record.CloseStatus != *query.closeStatus {
+
+	sort.Slice(parsedFilenames, func(i, j int) bool {
+		if parsedFilenames[i].closeTime == parsedFilenames[j].closeTime {
+			return parsedFilenames[i].hashedRunID < parsedFilenames[j].hashedRunID
+		}
+		return parsedFilenames[i].closeTime < parsedFilenames[j].closeTime
+	})
+	return parsedFilenames, nil
+}
+
+func matchQuery(record *visibilityRecord, query *parsedQuery) bool {
+	if record.CloseTimestamp < query.earliestCloseTime || record.CloseTimestamp > query.latestCloseTime {
 		return false
 	}
-	return true
+	return query.query == nil || query.query.Match((*archiver.ArchiveVisibilityRequest)(record))
 }
 
 func convertToExecutionInfo(record *visibilityRecord) *types.WorkflowExecutionInfo {
@@ -34,7 +34,6 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
-	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -221,7 +220,7 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			query: &parsedQuery{
 				earliestCloseTime: int64(1000),
 				latestCloseTime:   int64(12345),
-				workflowID:        common.StringPtr("random workflowID"),
+				query:             s.parseQuery("WorkflowID = 'random workflowID'"),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp: int64(2000),
@@ -232,8 +231,7 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			query: &parsedQuery{
 				earliestCloseTime: int64(1000),
 				latestCloseTime:   int64(12345),
-				workflowID:        common.StringPtr("random workflowID"),
-				runID:             common.StringPtr("random runID"),
+				query:             s.parseQuery("WorkflowID = 'random workflowID' and RunID = 'random runID'"),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp:   int64(12345),
@@ -247,7 +245,7 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			query: &parsedQuery{
 				earliestCloseTime: int64(1000),
 				latestCloseTime:   int64(12345),
-				workflowTypeName:  common.StringPtr("some random type name"),
+				query:             s.parseQuery("WorkflowType = 'some random type name'"),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp: int64(12345),
@@ -258,8 +256,7 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			query: &parsedQuery{
 				earliestCloseTime: int64(1000),
 				latestCloseTime:   int64(12345),
-				workflowTypeName:  common.StringPtr("some random type name"),
-				closeStatus:       types.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
+				query:             s.parseQuery("WorkflowType = 'some random type name' and CloseStatus = 'ContinuedAsNew'"),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp:   int64(12345),
@@ -394,7 +391,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: int64(1),
 		latestCloseTime:   int64(10001),
-		workflowID:        common.StringPtr(testWorkflowID),
+		query:             s.parseQuery("WorkflowID = '" + testWorkflowID + "'"),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -418,7 +415,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: int64(1),
 		latestCloseTime:   int64(10001),
-		closeStatus:       types.WorkflowExecutionCloseStatusFailed.Ptr(),
+		query:             s.parseQuery("CloseStatus = 'Failed'"),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -453,7 +450,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: int64(10),
 		latestCloseTime:   int64(10001),
-		closeStatus:       types.WorkflowExecutionCloseStatusFailed.Ptr(),
+		query:             s.parseQuery("CloseStatus = 'Failed'"),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	URI, err := archiver.NewURI("file://" + dir)
@@ -481,6 +478,65 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestList_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
+	s.NoError(err)
+	response, err := visibilityArchiver.List(context.Background(), URI, &archiver.ListVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 1,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestListAndDelete() {
+	dir := s.T().TempDir()
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record))
+		s.NoError(err)
+	}
+
+	request := &archiver.ListVisibilityRequest{
+		DomainID:        testDomainID,
+		CloseTimeBefore: 1001,
+		PageSize:        1,
+	}
+	var records []*archiver.ArchiveVisibilityRequest
+	for len(records) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.List(context.Background(), URI, request)
+		s.NoError(err)
+		for _, record := range response.Records {
+			// records are deleted while listing, like the archival retention scanner does
+			s.NoError(visibilityArchiver.Delete(context.Background(), URI, &archiver.DeleteVisibilityRequest{
+				DomainID:         record.DomainID,
+				WorkflowID:       record.WorkflowID,
+				RunID:            record.RunID,
+				WorkflowTypeName: record.WorkflowTypeName,
+				StartTimestamp:   record.StartTimestamp,
+				CloseTimestamp:   record.CloseTimestamp,
+			}))
+		}
+		records = append(records, response.Records...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]*archiver.ArchiveVisibilityRequest{
+		(*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[3]),
+		(*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[2]),
+		(*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[1]),
+	}, records)
+
+	request.NextPageToken = nil
+	response, err := visibilityArchiver.List(context.Background(), URI, request)
+	s.NoError(err)
+	s.Empty(response.Records)
+	s.Nil(response.NextPageToken)
+	s.assertFileExists(path.Join(dir, testDomainID, constructVisibilityFilename(s.visibilityRecords[0].CloseTimestamp, s.visibilityRecords[0].RunID)))
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -573,3 +629,9 @@ func (s *visibilityArchiverSuite) assertFileExists(filepath string) {
 	s.NoError(err)
 	s.True(exists)
 }
+
+func (s *visibilityArchiverSuite) parseQuery(query string) *archiver.VisibilityQuery {
+	visibilityQuery, err := archiver.ParseVisibilityQuery(query)
+	s.Require().NoError(err)
+	return visibilityQuery
+}
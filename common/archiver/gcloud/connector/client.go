@@ -26,7 +26,6 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
-	"os"
 
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/iterator"
@@ -53,6 +52,7 @@ type (
 		Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) ([]string, error)
 		QueryWithFilters(ctx context.Context, URI archiver.URI, fileNamePrefix string, pageSize, offset int, filters []Precondition) ([]string, bool, int, error)
 		Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error)
+		Delete(ctx context.Context, URI archiver.URI, fileName string) error
 	}
 
 	storageWrapper struct {
@@ -66,19 +66,8 @@ type (
 // You can find more info about "Google Setting Up Authentication for Server to Server Production Applications" under the following link
 // https://cloud.google.com/docs/authentication/production
 func NewClient(ctx context.Context, config *config.GstorageArchiver) (Client, error) {
-	if credentialsPath := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"); credentialsPath != "" {
-		clientDelegate, err := newClientDelegateWithCredentials(ctx, credentialsPath)
-		return &storageWrapper{client: clientDelegate}, err
-	}
-
-	if config.CredentialsPath != "" {
-		clientDelegate, err := newClientDelegateWithCredentials(ctx, config.CredentialsPath)
-		return &storageWrapper{client: clientDelegate}, err
-	}
-
-	clientDelegate, err := newDefaultClientDelegate(ctx)
+	clientDelegate, err := NewGcloudStorageClient(ctx, config)
 	return &storageWrapper{client: clientDelegate}, err
-
 }
 
 // NewClientWithParams return a gcloudstorage.Client based on input parameters
@@ -131,6 +120,16 @@ func (s *storageWrapper) Get(ctx context.Context, URI archiver.URI, fileName str
 	return nil, err
 }
 
+// Delete removes a file, deleting a file that doesn't exist is not an error
+func (s *storageWrapper) Delete(ctx context.Context, URI archiver.URI, fileName string) error {
+	bucket := s.client.Bucket(URI.Hostname())
+	err := bucket.Object(formatSinkPath(URI.Path()) + "/" + fileName).Delete(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil
+	}
+	return err
+}
+
 // Query, retieves file names by provided storage query
 func (s *storageWrapper) Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) (fileNames []string, err error) {
 	fileNames = make([]string, 0)
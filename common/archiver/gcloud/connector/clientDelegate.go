@@ -23,10 +23,13 @@ package connector
 import (
 	"context"
 	"io/ioutil"
+	"os"
 
 	"cloud.google.com/go/storage"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/option"
+
+	"github.com/uber/cadence/common/config"
 )
 
 type (
@@ -59,6 +62,7 @@ type (
 		NewWriter(ctx context.Context) WriterWrapper
 		NewReader(ctx context.Context) (ReaderWrapper, error)
 		Attrs(ctx context.Context) (*storage.ObjectAttrs, error)
+		Delete(ctx context.Context) error
 	}
 
 	objectDelegate struct {
@@ -98,6 +102,20 @@ type (
 	}
 )
 
+// NewGcloudStorageClient return a GcloudStorageClient based on default google service account creadentials,
+// the credential path in the config and the "GOOGLE_APPLICATION_CREDENTIALS" environment variable are used the same way as NewClient
+func NewGcloudStorageClient(ctx context.Context, config *config.GstorageArchiver) (GcloudStorageClient, error) {
+	if credentialsPath := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"); credentialsPath != "" {
+		return newClientDelegateWithCredentials(ctx, credentialsPath)
+	}
+
+	if config.CredentialsPath != "" {
+		return newClientDelegateWithCredentials(ctx, config.CredentialsPath)
+	}
+
+	return newDefaultClientDelegate(ctx)
+}
+
 func newDefaultClientDelegate(ctx context.Context) (*clientDelegate, error) {
 	nativeClient, err := storage.NewClient(ctx)
 	return &clientDelegate{nativeClient: nativeClient}, err
@@ -186,6 +204,11 @@ func (o *objectDelegate) Attrs(ctx context.Context) (attrs *storage.ObjectAttrs,
 	return o.object.Attrs(ctx)
 }
 
+// Delete deletes the single specified object.
+func (o *objectDelegate) Delete(ctx context.Context) error {
+	return o.object.Delete(ctx)
+}
+
 // Close completes the write operation and flushes any buffered data.
 // If Close doesn't return an error, metadata about the written object
 // can be retrieved by calling Attrs.
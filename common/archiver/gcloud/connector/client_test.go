@@ -218,6 +218,25 @@ func (s *clientSuite) TestGet() {
 	s.Require().NoError(err)
 }
 
+func (s *clientSuite) TestDelete() {
+	ctx := context.Background()
+	mockStorageClient := &mocks.GcloudStorageClient{}
+	mockBucketHandleClient := &mocks.BucketHandleWrapper{}
+	mockObjectHandler := &mocks.ObjectHandleWrapper{}
+
+	storageWrapper, _ := connector.NewClientWithParams(mockStorageClient)
+
+	mockStorageClient.On("Bucket", "my-bucket-cad").Return(mockBucketHandleClient).Times(2)
+	mockBucketHandleClient.On("Object", "cadence_archival/development/myfile.history").Return(mockObjectHandler).Times(2)
+	mockObjectHandler.On("Delete", ctx).Return(nil).Once()
+	mockObjectHandler.On("Delete", ctx).Return(storage.ErrObjectNotExist).Once()
+
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	s.Require().NoError(err)
+	s.NoError(storageWrapper.Delete(ctx, URI, "myfile.history"))
+	s.NoError(storageWrapper.Delete(ctx, URI, "myfile.history"))
+}
+
 func (s *clientSuite) TestWrongGoogleCredentialsPath() {
 	ctx := context.Background()
 	os.Setenv("GOOGLE_APPLICATION_CREDENTIALS", "/Wrong/path")
@@ -36,6 +36,20 @@ type Client struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: ctx, URI, fileName
+func (_m *Client) Delete(ctx context.Context, URI archiver.URI, fileName string) error {
+	ret := _m.Called(ctx, URI, fileName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, archiver.URI, string) error); ok {
+		r0 = rf(ctx, URI, fileName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Exist provides a mock function with given fields: ctx, URI, fileName
 func (_m *Client) Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error) {
 	ret := _m.Called(ctx, URI, fileName)
@@ -288,6 +288,32 @@ outer:
 	return response, nil
 }
 
+// Delete removes all versions of an archived history. Deleting a history that doesn't exist is not an error.
+func (h *historyArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteHistoryRequest) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteHistoryRequest.Error()}
+	}
+
+	filenames, err := h.gcloudStorage.Query(ctx, URI, constructHistoryFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID)+"_")
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+
+	for _, filename := range filenames {
+		if _, _, err := extractCloseFailoverVersion(filepath.Base(filename)); err != nil {
+			continue
+		}
+		if err := h.gcloudStorage.Delete(ctx, URI, filepath.Base(filename)); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (h *historyArchiver) ValidateURI(URI archiver.URI) (err error) {
 
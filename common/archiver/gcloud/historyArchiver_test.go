@@ -602,3 +602,42 @@ func (h *historyArchiverSuite) TestGet_Success_FromToken() {
 
 	h.EqualValues(4, numOfEvents)
 }
+
+func (h *historyArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	h.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
+	historyArchiver := newHistoryArchiver(h.container, nil, storageWrapper)
+	err = historyArchiver.Delete(ctx, URI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	})
+	h.Error(err)
+	h.IsType(&types.BadRequestError{}, err)
+}
+
+func (h *historyArchiverSuite) TestDelete_Success() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	h.NoError(err)
+	prefix := constructHistoryFilenamePrefix(testDomainID, testWorkflowID, testRunID)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
+	storageWrapper.On("Query", ctx, URI, prefix+"_").Return([]string{
+		"cadence_archival/development/" + prefix + "_-24_0.history",
+		"cadence_archival/development/" + prefix + "_-25_0.history",
+		"cadence_archival/development/" + prefix + "_unknown.history",
+	}, nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, prefix+"_-24_0.history").Return(nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, prefix+"_-25_0.history").Return(nil).Times(1)
+	historyArchiver := newHistoryArchiver(h.container, nil, storageWrapper)
+	err = historyArchiver.Delete(ctx, URI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	h.NoError(err)
+	storageWrapper.AssertExpectations(h.T())
+}
@@ -23,24 +23,21 @@
 package gcloud
 
 import (
-	"errors"
-	"fmt"
-	"strconv"
-	"time"
-
-	"github.com/xwb1989/sqlparser"
-
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/definition"
 )
 
 type (
-	// QueryParser parses a limited SQL where clause into a struct
+	// QueryParser parses an archived visibility query into a struct
 	QueryParser interface {
 		Parse(query string) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
 
+	// parsedQuery holds the filename prefix and filters which can be used to narrow down the files to read,
+	// the records read are then filtered by query
 	parsedQuery struct {
 		workflowID      *string
 		workflowType    *string
@@ -49,207 +46,64 @@ type (
 		searchPrecision *string
 		runID           *string
 		emptyResult     bool
+		query           *archiver.VisibilityQuery
 	}
 )
 
-// All allowed fields for filtering
-const (
-	WorkflowID      = "WorkflowID"
-	RunID           = "RunID"
-	WorkflowType    = "WorkflowType"
-	CloseTime       = "CloseTime"
-	StartTime       = "StartTime"
-	CloseStatus     = "CloseStatus"
-	SearchPrecision = "SearchPrecision"
-)
-
 // Precision specific values
 const (
-	PrecisionDay    = "Day"
-	PrecisionHour   = "Hour"
-	PrecisionMinute = "Minute"
-	PrecisionSecond = "Second"
-)
-
-const (
-	queryTemplate = "select * from dummy where %s"
-
-	defaultDateTimeFormat = time.RFC3339
+	PrecisionDay    = archiver.PrecisionDay
+	PrecisionHour   = archiver.PrecisionHour
+	PrecisionMinute = archiver.PrecisionMinute
+	PrecisionSecond = archiver.PrecisionSecond
 )
 
-// NewQueryParser creates a new query parser for filestore
+// NewQueryParser creates a new query parser for gcloud
 func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
 func (p *queryParser) Parse(query string) (*parsedQuery, error) {
-	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+	visibilityQuery, err := archiver.ParseVisibilityQuery(query)
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
-	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
-		return nil, err
+	parsedQuery := &parsedQuery{
+		query: visibilityQuery,
 	}
-
-	if (parsedQuery.closeTime == 0 && parsedQuery.startTime == 0) || (parsedQuery.closeTime != 0 && parsedQuery.startTime != 0) {
-		return nil, errors.New("requires a StartTime or CloseTime")
+	if workflowID, ok := visibilityQuery.StringEqual(definition.WorkflowID); ok {
+		parsedQuery.workflowID = common.StringPtr(workflowID)
 	}
-
-	if parsedQuery.searchPrecision == nil {
-		return nil, errors.New("SearchPrecision is required when searching for a StartTime or CloseTime")
+	if runID, ok := visibilityQuery.StringEqual(definition.RunID); ok {
+		parsedQuery.runID = common.StringPtr(runID)
 	}
-
-	return parsedQuery, nil
-}
-
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
+	if workflowType, ok := visibilityQuery.StringEqual(definition.WorkflowType); ok {
+		parsedQuery.workflowType = common.StringPtr(workflowType)
 	}
 
-	switch expr := expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
+	closeTimeEarliest, closeTimeLatest := visibilityQuery.TimeRange(definition.CloseTime)
+	startTimeEarliest, startTimeLatest := visibilityQuery.TimeRange(definition.StartTime)
+	if closeTimeEarliest > closeTimeLatest || startTimeEarliest > startTimeLatest {
+		parsedQuery.emptyResult = true
+		return parsedQuery, nil
 	}
-}
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
-}
-
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
+	// filenames are only ordered by time under an index, and the search precision
+	// decides how much of the timestamp is part of the filename prefix
+	precision := visibilityQuery.SearchPrecision()
+	if precision == "" {
+		return parsedQuery, nil
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
-}
-
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
-	colName, ok := compExpr.Left.(*sqlparser.ColName)
-	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
+	parsedQuery.searchPrecision = common.StringPtr(precision)
+	if inSearchWindow(closeTimeEarliest, closeTimeLatest, precision) {
+		parsedQuery.closeTime = closeTimeEarliest
+	} else if inSearchWindow(startTimeEarliest, startTimeLatest, precision) {
+		parsedQuery.startTime = startTimeEarliest
 	}
-	colNameStr := sqlparser.String(colName)
-	op := compExpr.Operator
-	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
-	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
-	}
-	valStr := sqlparser.String(valExpr)
-
-	switch colNameStr {
-	case WorkflowID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", WorkflowID)
-		}
-		if parsedQuery.workflowID != nil && *parsedQuery.workflowID != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.workflowID = common.StringPtr(val)
-	case RunID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", RunID)
-		}
-		if parsedQuery.runID != nil && *parsedQuery.runID != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.runID = common.StringPtr(val)
-	case CloseTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", CloseTime)
-		}
-		parsedQuery.closeTime = timestamp
-
-	case StartTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", StartTime)
-		}
-		parsedQuery.startTime = timestamp
-	case WorkflowType:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", WorkflowType)
-		}
-		if parsedQuery.workflowType != nil && *parsedQuery.workflowType != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.workflowType = common.StringPtr(val)
-	case SearchPrecision:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", SearchPrecision)
-		}
-		if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
-			return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
-		}
-		switch val {
-		case PrecisionDay:
-		case PrecisionHour:
-		case PrecisionMinute:
-		case PrecisionSecond:
-		default:
-			return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
-		}
-		parsedQuery.searchPrecision = common.StringPtr(val)
-	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
-	}
-
-	return nil
-}
-
-func convertToTimestamp(timeStr string) (int64, error) {
-	timestamp, err := strconv.ParseInt(timeStr, 10, 64)
-	if err == nil {
-		return timestamp, nil
-	}
-	timestampStr, err := extractStringValue(timeStr)
-	if err != nil {
-		return 0, err
-	}
-	parsedTime, err := time.Parse(defaultDateTimeFormat, timestampStr)
-	if err != nil {
-		return 0, err
-	}
-	return parsedTime.UnixNano(), nil
+	return parsedQuery, nil
 }
 
-func extractStringValue(s string) (string, error) {
-	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
-		return s[1 : len(s)-1], nil
-	}
-	return "", fmt.Errorf("value %s is not a string value", s)
+// inSearchWindow returns true if the time range falls into a single filename prefix of the given precision
+func inSearchWindow(earliest, latest int64, precision string) bool {
+	return constructTimeBasedSearchKey("", "", earliest, precision) == constructTimeBasedSearchKey("", "", latest, precision)
 }
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -243,6 +244,38 @@ func newWorkflowTypeNamePrecondition(workflowTypeName string) connector.Precondi
 	}
 }
 
+// extractVisibilityTimestamp returns the timestamp embedded in a filename constructed by constructVisibilityFilename
+func extractVisibilityTimestamp(filename string) (time.Time, error) {
+	filenameParts := strings.Split(filepath.Base(filename), "_")
+	if len(filenameParts) != 5 {
+		return time.Time{}, errors.New("unknown filename structure")
+	}
+	return time.Parse(time.RFC3339, filenameParts[1])
+}
+
+func newCloseTimeBeforePrecondition(closeTimeBefore int64) connector.Precondition {
+	return func(subject interface{}) bool {
+		fileName, ok := subject.(string)
+		if !ok {
+			return false
+		}
+
+		// the filename only has a precision of seconds, the exact close time is checked after the record is read
+		closeTime, err := extractVisibilityTimestamp(fileName)
+		return err == nil && closeTime.UnixNano() < closeTimeBefore
+	}
+}
+
+func newFilenameAfterPrecondition(lastFilename string) connector.Precondition {
+	return func(subject interface{}) bool {
+		fileName, ok := subject.(string)
+		if !ok {
+			return false
+		}
+		return fileName > lastFilename
+	}
+}
+
 func isRetryableError(err error) (retryable bool) {
 	switch err.Error() {
 	case connector.ErrBucketNotFound.Error(),
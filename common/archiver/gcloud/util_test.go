@@ -165,6 +165,31 @@ func (s *utilSuite) TestWorkflowIdPrecondition() {
 
 }
 
+func (s *utilSuite) TestCloseTimeBeforePrecondition() {
+	closeTimeBefore := time.Date(2020, 2, 27, 9, 42, 29, 0, time.UTC).UnixNano()
+	testCases := []struct {
+		fileName       string
+		expectedResult bool
+	}{
+		{
+			fileName:       "cadence_archival/domain/closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
+			expectedResult: true,
+		},
+		{
+			fileName:       "cadence_archival/domain/closeTimeout_2020-02-27T09:42:29Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
+			expectedResult: false,
+		},
+		{
+			fileName:       "cadence_archival/domain/closeTimeout_2020-02-27T09:42:28Z.visibility",
+			expectedResult: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.Equal(testCase.expectedResult, newCloseTimeBeforePrecondition(closeTimeBefore)(testCase.fileName))
+	}
+}
+
 func (s *utilSuite) TestRunIdPrecondition() {
 	testCases := []struct {
 		workflowID     string
@@ -22,6 +22,7 @@ package gcloud
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -57,6 +58,10 @@ type (
 		Offset int
 	}
 
+	listVisibilityToken struct {
+		LastFilename string
+	}
+
 	visibilityRecord archiver.ArchiveVisibilityRequest
 
 	queryVisibilityRequest struct {
@@ -223,6 +228,10 @@ func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, reques
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
 
+		// pages may contain fewer executions than the page size as records are filtered after they are read
+		if request.parsedQuery.query != nil && !request.parsedQuery.query.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+			continue
+		}
 		response.Executions = append(response.Executions, convertToExecutionInfo(record))
 	}
 
@@ -240,6 +249,86 @@ func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, reques
 	return response, nil
 }
 
+// List returns the archived visibility records closed before the given time, in ascending order of close time.
+// The next page token is the last returned filename, so records deleted between two calls are not skipped.
+func (v *visibilityArchiver) List(ctx context.Context, URI archiver.URI, request *archiver.ListVisibilityRequest) (*archiver.ListVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateListVisibilityRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidListVisibilityRequest.Error()}
+	}
+
+	token := new(listVisibilityToken)
+	if request.NextPageToken != nil {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &types.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+	}
+
+	filters := []connector.Precondition{
+		newCloseTimeBeforePrecondition(request.CloseTimeBefore),
+		newFilenameAfterPrecondition(token.LastFilename),
+	}
+	prefix := constructVisibilityFilenamePrefix(request.DomainID, indexKeyCloseTimeout) + "_"
+	filenames, _, _, err := v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, request.PageSize, 0, filters)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	response := &archiver.ListVisibilityResponse{}
+	for _, file := range filenames {
+		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", request.DomainID, filepath.Base(file)))
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+
+		if record.CloseTimestamp < request.CloseTimeBefore {
+			response.Records = append(response.Records, (*archiver.ArchiveVisibilityRequest)(record))
+		}
+	}
+
+	if len(filenames) == request.PageSize {
+		encodedToken, err := serializeToken(&listVisibilityToken{
+			LastFilename: filenames[len(filenames)-1],
+		})
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		response.NextPageToken = encodedToken
+	}
+
+	return response, nil
+}
+
+// Delete removes an archived visibility record. Deleting a record that doesn't exist is not an error.
+func (v *visibilityArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteVisibilityRequest) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteVisibilityRequest.Error()}
+	}
+
+	filenames := []string{
+		constructVisibilityFilename(request.DomainID, request.WorkflowTypeName, request.WorkflowID, request.RunID, indexKeyCloseTimeout, request.CloseTimestamp),
+		constructVisibilityFilename(request.DomainID, request.WorkflowTypeName, request.WorkflowID, request.RunID, indexKeyStartTimeout, request.StartTimestamp),
+	}
+	for _, filename := range filenames {
+		if err := v.gcloudStorage.Delete(ctx, URI, filename); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeoutInSeconds*time.Second)
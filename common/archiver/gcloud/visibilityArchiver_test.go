@@ -333,3 +333,114 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.Len(response.Executions, 1)
 	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
 }
+
+func (s *visibilityArchiverSuite) TestQuery_Success_FilteredByQuery() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T", 10, 0, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 1, nil)
+	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	testCases := []struct {
+		query   string
+		matched bool
+	}{
+		{
+			query:   "CloseTime = '2020-02-05T11:00:00Z' AND SearchPrecision = 'Day' AND (CloseStatus = 'Completed' OR HistoryLength > 100)",
+			matched: true,
+		},
+		{
+			query:   "CloseTime = '2020-02-05T11:00:00Z' AND SearchPrecision = 'Day' AND (CloseStatus = 'Failed' OR HistoryLength > 100)",
+			matched: false,
+		},
+	}
+	for _, tc := range testCases {
+		response, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
+			DomainID: testDomainID,
+			PageSize: 10,
+			Query:    tc.query,
+		})
+		s.NoError(err)
+		s.NotNil(response)
+		s.Nil(response.NextPageToken)
+		if tc.matched {
+			s.Len(response.Executions, 1)
+			s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
+		} else {
+			s.Empty(response.Executions)
+		}
+	}
+}
+
+func (s *visibilityArchiverSuite) TestList_Fail_InvalidRequest() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(true, nil)
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	response, err := visibilityArchiver.List(ctx, URI, &archiver.ListVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 1,
+	})
+	s.Error(err)
+	s.IsType(&types.BadRequestError{}, err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestList_Success() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	filename := "cadence_archival/visibility/test-domain-id/closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_test-workflow-type_test-run-id.visibility"
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(true, nil)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, "test-domain-id/closeTimeout_", 1, 0, mock.Anything).Return([]string{filename}, true, 1, nil).Times(1)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, "test-domain-id/closeTimeout_", 1, 0, mock.Anything).Return([]string{}, true, 0, nil).Times(1)
+	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_test-workflow-type_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+
+	request := &archiver.ListVisibilityRequest{
+		DomainID:        testDomainID,
+		CloseTimeBefore: time.Unix(0, s.expectedVisibilityRecords[0].CloseTimestamp).Add(time.Hour).UnixNano(),
+		PageSize:        1,
+	}
+	response, err := visibilityArchiver.List(ctx, URI, request)
+	s.NoError(err)
+	s.Len(response.Records, 1)
+	s.Equal(s.expectedVisibilityRecords[0].RunID, response.Records[0].RunID)
+	s.Equal(s.expectedVisibilityRecords[0].CloseTimestamp, response.Records[0].CloseTimestamp)
+	s.NotNil(response.NextPageToken)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.List(ctx, URI, request)
+	s.NoError(err)
+	s.Empty(response.Records)
+	s.Nil(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestDelete_Success() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	record := s.expectedVisibilityRecords[0]
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(true, nil)
+	storageWrapper.On("Delete", mock.Anything, URI, constructVisibilityFilename(record.DomainID, record.WorkflowTypeName, record.WorkflowID, record.RunID, indexKeyCloseTimeout, record.CloseTimestamp)).Return(nil).Times(1)
+	storageWrapper.On("Delete", mock.Anything, URI, constructVisibilityFilename(record.DomainID, record.WorkflowTypeName, record.WorkflowID, record.RunID, indexKeyStartTimeout, record.StartTimestamp)).Return(nil).Times(1)
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+
+	err = visibilityArchiver.Delete(ctx, URI, &archiver.DeleteVisibilityRequest{
+		DomainID:         record.DomainID,
+		WorkflowID:       record.WorkflowID,
+		RunID:            record.RunID,
+		WorkflowTypeName: record.WorkflowTypeName,
+		StartTimestamp:   record.StartTimestamp,
+		CloseTimestamp:   record.CloseTimestamp,
+	})
+	s.NoError(err)
+	storageWrapper.AssertExpectations(s.T())
+}
@@ -0,0 +1,344 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	errCodeNoSuchBucket = "NoSuchBucket"
+	errCodeNoSuchKey    = "NoSuchKey"
+	errCodeNotFound     = "NotFound"
+
+	maxErrorBodySize = 64 * 1024
+
+	signingService       = "s3"
+	defaultSigningRegion = "us-east-1"
+)
+
+type (
+	// client talks to an object store through the path style subset of the S3 REST API
+	// which is also served by MinIO, Ceph RGW and most other S3 compatible stores:
+	//   HEAD /<bucket>                  checks the bucket exists
+	//   HEAD|GET|PUT /<bucket>/<key>    checks, reads or writes an object
+	//   GET /<bucket>?list-type=2&...   lists objects by prefix
+	// Requests are signed with AWS signature version 4 when credentials are configured.
+	client struct {
+		endpoint   *url.URL
+		headers    http.Header
+		httpClient *http.Client
+		signer     *v4.Signer
+		region     string
+	}
+
+	// requestError is returned when the object store responds with a non 2xx status code
+	requestError struct {
+		StatusCode int
+		Code       string
+		Message    string
+	}
+
+	errorResponse struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}
+
+	listObjectsResult struct {
+		XMLName               xml.Name       `xml:"ListBucketResult"`
+		IsTruncated           bool           `xml:"IsTruncated"`
+		NextContinuationToken string         `xml:"NextContinuationToken"`
+		Contents              []objectInfo   `xml:"Contents"`
+		CommonPrefixes        []commonPrefix `xml:"CommonPrefixes"`
+	}
+
+	objectInfo struct {
+		Key string `xml:"Key"`
+	}
+
+	commonPrefix struct {
+		Prefix string `xml:"Prefix"`
+	}
+)
+
+var (
+	errEmptyEndpoint       = errors.New("empty object store endpoint")
+	errConflictingAuth     = errors.New("authToken can't be used together with accessKeyID")
+	errIncompleteSignature = errors.New("accessKeyID and secretAccessKey must be set together")
+)
+
+func newClient(cfg *config.HTTPStoreArchiver) (*client, error) {
+	if len(cfg.Endpoint) == 0 {
+		return nil, errEmptyEndpoint
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("invalid object store endpoint %q, the scheme must be http or https", cfg.Endpoint)
+	}
+	endpoint.Path = strings.TrimRight(endpoint.Path, "/")
+	endpoint.RawPath = ""
+
+	headers := make(http.Header)
+	for k, v := range cfg.Headers {
+		headers.Set(k, v)
+	}
+	if len(cfg.AuthToken) != 0 {
+		if len(cfg.AccessKeyID) != 0 {
+			return nil, errConflictingAuth
+		}
+		headers.Set("Authorization", "Bearer "+cfg.AuthToken)
+	}
+
+	var signer *v4.Signer
+	if len(cfg.AccessKeyID) != 0 || len(cfg.SecretAccessKey) != 0 {
+		if len(cfg.AccessKeyID) == 0 || len(cfg.SecretAccessKey) == 0 {
+			return nil, errIncompleteSignature
+		}
+		signer = v4.NewSigner(credentials.NewStaticCredentials(cfg.AccessKeyID, cfg.SecretAccessKey, ""), func(s *v4.Signer) {
+			// object keys are already escaped in the request path, S3 does not escape them a second time
+			s.DisableURIPathEscaping = true
+		})
+	}
+	region := cfg.Region
+	if len(region) == 0 {
+		region = defaultSigningRegion
+	}
+
+	tlsConfig, err := cfg.TLS.ToTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultBlobstoreTimeout
+	}
+
+	return &client{
+		endpoint: endpoint,
+		headers:  headers,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+		},
+		signer: signer,
+		region: region,
+	}, nil
+}
+
+func (c *client) headBucket(ctx context.Context, bucket string) error {
+	resp, err := c.do(ctx, http.MethodHead, bucket, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c *client) headObject(ctx context.Context, bucket, key string) error {
+	resp, err := c.do(ctx, http.MethodHead, bucket, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c *client) putObject(ctx context.Context, bucket, key string, data []byte) error {
+	resp, err := c.do(ctx, http.MethodPut, bucket, key, nil, data)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c *client) getObject(ctx context.Context, bucket, key string) ([]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, bucket, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+// deleteObject removes the key from the bucket, deleting a key that does not exist is not an error
+func (c *client) deleteObject(ctx context.Context, bucket, key string) error {
+	resp, err := c.do(ctx, http.MethodDelete, bucket, key, nil, nil)
+	if err != nil {
+		if hasErrorCode(err, errCodeNoSuchKey) {
+			return nil
+		}
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// listObjects lists the keys under the given prefix in lexicographical order. When a delimiter is given,
+// keys containing the delimiter after the prefix are rolled up into common prefixes.
+// maxKeys and continuationToken are optional.
+func (c *client) listObjects(
+	ctx context.Context,
+	bucket string,
+	prefix string,
+	delimiter string,
+	maxKeys int,
+	continuationToken string,
+) (*listObjectsResult, error) {
+	query := url.Values{}
+	query.Set("list-type", "2")
+	query.Set("prefix", prefix)
+	if len(delimiter) != 0 {
+		query.Set("delimiter", delimiter)
+	}
+	if maxKeys > 0 {
+		query.Set("max-keys", strconv.Itoa(maxKeys))
+	}
+	if len(continuationToken) != 0 {
+		query.Set("continuation-token", continuationToken)
+	}
+
+	resp, err := c.do(ctx, http.MethodGet, bucket, "", query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	result := &listObjectsResult{}
+	if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *client) do(
+	ctx context.Context,
+	method string,
+	bucket string,
+	key string,
+	query url.Values,
+	body []byte,
+) (*http.Response, error) {
+	u := *c.endpoint
+	u.Path, u.RawPath = c.endpoint.Path+"/"+bucket, c.endpoint.EscapedPath()+"/"+url.PathEscape(bucket)
+	if len(key) != 0 {
+		u.Path += "/" + key
+		u.RawPath += "/" + escapeKey(key)
+	}
+	u.RawQuery = query.Encode()
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range c.headers {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+	if c.signer != nil {
+		var bodySeeker io.ReadSeeker
+		if body != nil {
+			bodySeeker = bytes.NewReader(body)
+		}
+		if _, err := c.signer.Sign(req, bodySeeker, signingService, c.region, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	return nil, newRequestError(resp)
+}
+
+func newRequestError(resp *http.Response) *requestError {
+	rerr := &requestError{
+		StatusCode: resp.StatusCode,
+		Message:    http.StatusText(resp.StatusCode),
+	}
+	errResp := &errorResponse{}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err == nil && xml.Unmarshal(data, errResp) == nil {
+		rerr.Code = errResp.Code
+		if len(errResp.Message) != 0 {
+			rerr.Message = errResp.Message
+		}
+	}
+	// responses to HEAD requests come without a body
+	if len(rerr.Code) == 0 && resp.StatusCode == http.StatusNotFound {
+		rerr.Code = errCodeNotFound
+	}
+	return rerr
+}
+
+func (e *requestError) Error() string {
+	if len(e.Code) == 0 {
+		return fmt.Sprintf("object store request failed with status %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("object store request failed with status %d, code %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// escapeKey escapes every segment of the key but keeps the slashes between them
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func isNotFoundError(err error) bool {
+	rerr, ok := err.(*requestError)
+	return ok && rerr.StatusCode == http.StatusNotFound
+}
+
+func hasErrorCode(err error, code string) bool {
+	rerr, ok := err.(*requestError)
+	return ok && rerr.Code == code
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpstore
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/config"
+)
+
+type clientSuite struct {
+	*require.Assertions
+	suite.Suite
+	server *storeServer
+	client *client
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(clientSuite))
+}
+
+func (s *clientSuite) SetupTest() {
+	var err error
+	s.Assertions = require.New(s.T())
+	s.server = newStoreServer(testBucket)
+	s.client, err = newClient(&config.HTTPStoreArchiver{
+		Endpoint:  s.server.URL + "/",
+		AuthToken: "test-token",
+	})
+	s.NoError(err)
+}
+
+func (s *clientSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *clientSuite) TestNewClient_InvalidEndpoint() {
+	for _, endpoint := range []string{"", "127.0.0.1:9000", "ftp://127.0.0.1:9000"} {
+		_, err := newClient(&config.HTTPStoreArchiver{Endpoint: endpoint})
+		s.Error(err, endpoint)
+	}
+}
+
+func (s *clientSuite) TestNewClient_InvalidAuth() {
+	_, err := newClient(&config.HTTPStoreArchiver{
+		Endpoint:        s.server.URL,
+		AuthToken:       "test-token",
+		AccessKeyID:     "test-access-key",
+		SecretAccessKey: "test-secret-key",
+	})
+	s.Equal(errConflictingAuth, err)
+	_, err = newClient(&config.HTTPStoreArchiver{
+		Endpoint:    s.server.URL,
+		AccessKeyID: "test-access-key",
+	})
+	s.Equal(errIncompleteSignature, err)
+}
+
+func (s *clientSuite) TestNewClient_Timeout() {
+	s.Equal(defaultBlobstoreTimeout, s.client.httpClient.Timeout)
+	client, err := newClient(&config.HTTPStoreArchiver{
+		Endpoint: s.server.URL,
+		Timeout:  time.Second,
+	})
+	s.NoError(err)
+	s.Equal(time.Second, client.httpClient.Timeout)
+}
+
+func (s *clientSuite) TestSignedRequests() {
+	ctx := context.Background()
+	key := "prefix/domain/history/workflow id?#%/run"
+	s.server.requireSignature("test-access-key", "test-secret-key")
+
+	// unsigned requests are rejected
+	err := s.client.putObject(ctx, testBucket, key, []byte("data"))
+	s.True(hasErrorCode(err, "SignatureDoesNotMatch"))
+
+	client, err := newClient(&config.HTTPStoreArchiver{
+		Endpoint:        s.server.URL,
+		AccessKeyID:     "test-access-key",
+		SecretAccessKey: "test-secret-key",
+	})
+	s.NoError(err)
+	s.NoError(client.headBucket(ctx, testBucket))
+	s.NoError(client.putObject(ctx, testBucket, key, []byte("data")))
+	data, err := client.getObject(ctx, testBucket, key)
+	s.NoError(err)
+	s.Equal([]byte("data"), data)
+	result, err := client.listObjects(ctx, testBucket, "prefix/", "/", 10, "")
+	s.NoError(err)
+	s.Len(result.CommonPrefixes, 1)
+	s.NoError(client.deleteObject(ctx, testBucket, key))
+
+	// requests signed with other credentials are rejected
+	client, err = newClient(&config.HTTPStoreArchiver{
+		Endpoint:        s.server.URL,
+		AccessKeyID:     "test-access-key",
+		SecretAccessKey: "wrong-secret-key",
+	})
+	s.NoError(err)
+	_, err = client.getObject(ctx, testBucket, key)
+	s.True(hasErrorCode(err, "SignatureDoesNotMatch"))
+}
+
+func (s *clientSuite) TestPutAndGet() {
+	ctx := context.Background()
+	key := "prefix/domain/history/workflow id?#%/run"
+	s.True(isNotFoundError(s.client.headObject(ctx, testBucket, key)))
+
+	s.NoError(s.client.putObject(ctx, testBucket, key, []byte("data")))
+	s.NoError(s.client.headObject(ctx, testBucket, key))
+	data, err := s.client.getObject(ctx, testBucket, key)
+	s.NoError(err)
+	s.Equal([]byte("data"), data)
+	s.Contains(s.server.objects(testBucket), key)
+
+	_, err = s.client.getObject(ctx, testBucket, "prefix/not-exists")
+	s.True(isNotFoundError(err))
+	s.True(hasErrorCode(err, errCodeNoSuchKey))
+
+	for _, authHeader := range s.server.receivedAuthHeaders() {
+		s.Equal("Bearer test-token", authHeader)
+	}
+}
+
+func (s *clientSuite) TestDeleteObject() {
+	ctx := context.Background()
+	key := "prefix/domain/history/workflow id?#%/run"
+	s.NoError(s.client.putObject(ctx, testBucket, key, []byte("data")))
+	s.NoError(s.client.deleteObject(ctx, testBucket, key))
+	s.NotContains(s.server.objects(testBucket), key)
+	s.NoError(s.client.deleteObject(ctx, testBucket, key))
+
+	err := s.client.deleteObject(ctx, "not-exists", key)
+	s.True(hasErrorCode(err, errCodeNoSuchBucket))
+}
+
+func (s *clientSuite) TestBucketNotExists() {
+	ctx := context.Background()
+	s.True(isNotFoundError(s.client.headBucket(ctx, "not-exists")))
+	err := s.client.putObject(ctx, "not-exists", "key", []byte("data"))
+	s.True(hasErrorCode(err, errCodeNoSuchBucket))
+	_, err = s.client.listObjects(ctx, "not-exists", "", "", 0, "")
+	s.True(hasErrorCode(err, errCodeNoSuchBucket))
+	s.NoError(s.client.headBucket(ctx, testBucket))
+}
+
+func (s *clientSuite) TestListObjects() {
+	ctx := context.Background()
+	for _, key := range []string{"a/1/0", "a/1/1", "a/2/0", "a/3", "b/1"} {
+		s.NoError(s.client.putObject(ctx, testBucket, key, []byte(key)))
+	}
+
+	result, err := s.client.listObjects(ctx, testBucket, "a/", "/", 0, "")
+	s.NoError(err)
+	s.False(result.IsTruncated)
+	s.Equal([]objectInfo{{Key: "a/3"}}, result.Contents)
+	s.Equal([]commonPrefix{{Prefix: "a/1/"}, {Prefix: "a/2/"}}, result.CommonPrefixes)
+
+	var keys []string
+	token := ""
+	for {
+		result, err := s.client.listObjects(ctx, testBucket, "a/", "", 2, token)
+		s.NoError(err)
+		for _, object := range result.Contents {
+			keys = append(keys, object.Key)
+		}
+		if !result.IsTruncated {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	s.Equal([]string{"a/1/0", "a/1/1", "a/2/0", "a/3"}, keys)
+}
+
+func (s *clientSuite) TestIsRetryableError() {
+	ctx := context.Background()
+	s.server.failNextRequests(http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusNotImplemented, http.StatusForbidden)
+	err := s.client.putObject(ctx, testBucket, "key", []byte("data"))
+	s.True(IsRetryableError(err))
+	err = s.client.putObject(ctx, testBucket, "key", []byte("data"))
+	s.True(IsRetryableError(err))
+	err = s.client.putObject(ctx, testBucket, "key", []byte("data"))
+	s.False(IsRetryableError(err))
+	err = s.client.putObject(ctx, testBucket, "key", []byte("data"))
+	s.False(IsRetryableError(err))
+	s.NoError(s.client.putObject(ctx, testBucket, "key", []byte("data")))
+
+	s.server.Close()
+	err = s.client.putObject(ctx, testBucket, "key", []byte("data"))
+	s.True(IsRetryableError(err))
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpstore
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+type (
+	// storeServer is a local stand-in for an S3 compatible object store, it serves
+	// the subset of the API used by the client from memory
+	storeServer struct {
+		*httptest.Server
+
+		sync.Mutex
+		buckets map[string]map[string][]byte
+		// failures are returned, in order, instead of serving the next requests
+		failures []int
+		// authHeaders are the Authorization headers of all received requests
+		authHeaders []string
+		// signer verifies the signature of every request when it's set
+		signer *v4.Signer
+	}
+)
+
+func newStoreServer(buckets ...string) *storeServer {
+	s := &storeServer{
+		buckets: make(map[string]map[string][]byte),
+	}
+	for _, bucket := range buckets {
+		s.buckets[bucket] = make(map[string][]byte)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// requireSignature makes the server reject requests which are not signed with the given credentials
+func (s *storeServer) requireSignature(accessKeyID, secretAccessKey string) {
+	s.Lock()
+	defer s.Unlock()
+	s.signer = v4.NewSigner(credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""), func(signer *v4.Signer) {
+		signer.DisableURIPathEscaping = true
+	})
+}
+
+func (s *storeServer) failNextRequests(statusCodes ...int) {
+	s.Lock()
+	defer s.Unlock()
+	s.failures = append(s.failures, statusCodes...)
+}
+
+func (s *storeServer) objects(bucket string) map[string][]byte {
+	s.Lock()
+	defer s.Unlock()
+	objects := make(map[string][]byte)
+	for k, v := range s.buckets[bucket] {
+		objects[k] = v
+	}
+	return objects
+}
+
+func (s *storeServer) receivedAuthHeaders() []string {
+	s.Lock()
+	defer s.Unlock()
+	return append([]string(nil), s.authHeaders...)
+}
+
+// validSignature signs the received request again with the same time and signed headers
+// and compares the result with the signature sent by the client
+func (s *storeServer) validSignature(r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	idx := strings.Index(auth, "SignedHeaders=")
+	if idx == -1 {
+		return false
+	}
+	signedHeaders := strings.Split(strings.SplitN(auth[idx+len("SignedHeaders="):], ",", 2)[0], ";")
+	signTime, err := time.Parse("20060102T150405Z", r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		return false
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return false
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	req, err := http.NewRequest(r.Method, s.URL+r.URL.RequestURI(), nil)
+	if err != nil {
+		return false
+	}
+	for _, header := range signedHeaders {
+		if header != "host" {
+			req.Header.Set(header, r.Header.Get(header))
+		}
+	}
+	if _, err := s.signer.Sign(req, bytes.NewReader(body), "s3", "us-east-1", signTime); err != nil {
+		return false
+	}
+	return req.Header.Get("Authorization") == auth
+}
+
+func (s *storeServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.authHeaders = append(s.authHeaders, r.Header.Get("Authorization"))
+	if s.signer != nil && !s.validSignature(r) {
+		writeError(w, r, http.StatusForbidden, "SignatureDoesNotMatch")
+		return
+	}
+	if len(s.failures) != 0 {
+		statusCode := s.failures[0]
+		s.failures = s.failures[1:]
+		writeError(w, r, statusCode, "InjectedFailure")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	bucket, key := path, ""
+	if idx := strings.Index(path, "/"); idx != -1 {
+		bucket, key = path[:idx], path[idx+1:]
+	}
+	objects, ok := s.buckets[bucket]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, errCodeNoSuchBucket)
+		return
+	}
+
+	switch {
+	case len(key) == 0 && r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case len(key) == 0 && r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+		s.listObjects(w, r, objects)
+	case len(key) != 0 && r.Method == http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		objects[key] = data
+		w.WriteHeader(http.StatusOK)
+	case len(key) != 0 && r.Method == http.MethodDelete:
+		if _, ok := objects[key]; !ok {
+			writeError(w, r, http.StatusNotFound, errCodeNoSuchKey)
+			return
+		}
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case len(key) != 0 && (r.Method == http.MethodGet || r.Method == http.MethodHead):
+		data, ok := objects[key]
+		if !ok {
+			writeError(w, r, http.StatusNotFound, errCodeNoSuchKey)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	default:
+		writeError(w, r, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (s *storeServer) listObjects(w http.ResponseWriter, r *http.Request, objects map[string][]byte) {
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	startAfter := query.Get("continuation-token")
+	maxKeys := 1000
+	if v := query.Get("max-keys"); len(v) != 0 {
+		maxKeys, _ = strconv.Atoi(v)
+	}
+
+	keys := make([]string, 0, len(objects))
+	for k := range objects {
+		if strings.HasPrefix(k, prefix) && k > startAfter {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	result := &listObjectsResult{}
+	seenPrefixes := make(map[string]bool)
+	for _, k := range keys {
+		if len(result.Contents)+len(result.CommonPrefixes) == maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		if len(delimiter) != 0 {
+			if idx := strings.Index(k[len(prefix):], delimiter); idx != -1 {
+				rolledUp := k[:len(prefix)+idx+len(delimiter)]
+				if !seenPrefixes[rolledUp] {
+					seenPrefixes[rolledUp] = true
+					result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: rolledUp})
+				}
+				result.NextContinuationToken = k
+				continue
+			}
+		}
+		result.Contents = append(result.Contents, objectInfo{Key: k})
+		result.NextContinuationToken = k
+	}
+	if !result.IsTruncated {
+		result.NextContinuationToken = ""
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	xml.NewEncoder(w).Encode(result)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	if r.Method != http.MethodHead {
+		xml.NewEncoder(w).Encode(&errorResponse{Code: code, Message: code})
+	}
+}
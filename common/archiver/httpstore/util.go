@@ -0,0 +1,252 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/types"
+)
+
+// encoding & decoding util
+
+func encode(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func decodeHistoryBlob(data []byte) (*archiver.HistoryBlob, error) {
+	historyBlob := &archiver.HistoryBlob{}
+	err := json.Unmarshal(data, historyBlob)
+	if err != nil {
+		return nil, err
+	}
+	return historyBlob, nil
+}
+
+func decodeVisibilityRecord(data []byte) (*visibilityRecord, error) {
+	record := &visibilityRecord{}
+	err := json.Unmarshal(data, record)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func deserializeQueryVisibilityToken(bytes []byte) string {
+	return string(bytes)
+}
+
+func serializeQueryVisibilityToken(token string) []byte {
+	return []byte(token)
+}
+
+// Only validates the scheme and buckets are passed
+func softValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if len(URI.Hostname()) == 0 {
+		return errNoBucketSpecified
+	}
+	return nil
+}
+
+func bucketExists(ctx context.Context, cli *client, URI archiver.URI) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	err := cli.headBucket(ctx, URI.Hostname())
+	if err == nil {
+		return nil
+	}
+	if isNotFoundError(err) {
+		return errBucketNotExists
+	}
+	return err
+}
+
+func keyExists(ctx context.Context, cli *client, URI archiver.URI, key string) (bool, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	if err := cli.headObject(ctx, URI.Hostname(), key); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Key construction
+func constructHistoryKey(path, domainID, workflowID, runID string, version int64, batchIdx int) string {
+	prefix := constructHistoryKeyPrefixWithVersion(path, domainID, workflowID, runID, version)
+	return fmt.Sprintf("%s%d", prefix, batchIdx)
+}
+
+func constructHistoryKeyPrefixWithVersion(path, domainID, workflowID, runID string, version int64) string {
+	prefix := constructHistoryKeyPrefix(path, domainID, workflowID, runID)
+	return fmt.Sprintf("%s/%v/", prefix, version)
+}
+
+func constructHistoryKeyPrefix(path, domainID, workflowID, runID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "history", workflowID, runID}, "/"), "/")
+}
+
+func constructTimeBasedSearchKey(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, precision string) string {
+	t := time.Unix(0, timestamp).In(time.UTC)
+	var timeFormat = ""
+	switch precision {
+	case archiver.PrecisionSecond:
+		timeFormat = ":05"
+		fallthrough
+	case archiver.PrecisionMinute:
+		timeFormat = ":04" + timeFormat
+		fallthrough
+	case archiver.PrecisionHour:
+		timeFormat = "15" + timeFormat
+		fallthrough
+	case archiver.PrecisionDay:
+		timeFormat = "2006-01-02T" + timeFormat
+	}
+
+	return fmt.Sprintf("%s/%s", constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(timeFormat))
+}
+
+// parseCloseTimeIndex returns the close time and the runID of a visibility key constructed by constructTimestampIndex
+// with the closeTimeout secondary index
+func parseCloseTimeIndex(key string) (time.Time, string, bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 3 || parts[len(parts)-3] != secondaryIndexKeyCloseTimeout {
+		return time.Time{}, "", false
+	}
+	closeTime, err := time.Parse(time.RFC3339, parts[len(parts)-2])
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return closeTime, parts[len(parts)-1], true
+}
+
+func constructTimestampIndex(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, runID string) string {
+	t := time.Unix(0, timestamp).In(time.UTC)
+	return fmt.Sprintf("%s/%s/%s", constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(time.RFC3339), runID)
+}
+
+func constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexType string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "visibility", primaryIndexKey, primaryIndexValue, secondaryIndexType}, "/"), "/")
+}
+
+func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, defaultBlobstoreTimeout)
+}
+
+func upload(ctx context.Context, cli *client, URI archiver.URI, key string, data []byte) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	if err := cli.putObject(ctx, URI.Hostname(), key, data); err != nil {
+		if hasErrorCode(err, errCodeNoSuchBucket) {
+			return &types.BadRequestError{Message: errBucketNotExists.Error()}
+		}
+		return err
+	}
+	return nil
+}
+
+func download(ctx context.Context, cli *client, URI archiver.URI, key string) ([]byte, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	body, err := cli.getObject(ctx, URI.Hostname(), key)
+	if err != nil {
+		if hasErrorCode(err, errCodeNoSuchBucket) {
+			return nil, &types.BadRequestError{Message: errBucketNotExists.Error()}
+		}
+		if isNotFoundError(err) {
+			return nil, &types.EntityNotExistsError{Message: archiver.ErrHistoryNotExist.Error()}
+		}
+		return nil, err
+	}
+	return body, nil
+}
+
+func deleteKey(ctx context.Context, cli *client, URI archiver.URI, key string) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	if err := cli.deleteObject(ctx, URI.Hostname(), key); err != nil {
+		if hasErrorCode(err, errCodeNoSuchBucket) {
+			return &types.BadRequestError{Message: errBucketNotExists.Error()}
+		}
+		return err
+	}
+	return nil
+}
+
+func contextExpired(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func convertToExecutionInfo(record *visibilityRecord) *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: record.WorkflowID,
+			RunID:      record.RunID,
+		},
+		Type: &types.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:     common.Int64Ptr(record.StartTimestamp),
+		ExecutionTime: common.Int64Ptr(record.ExecutionTimestamp),
+		CloseTime:     common.Int64Ptr(record.CloseTimestamp),
+		CloseStatus:   record.CloseStatus.Ptr(),
+		HistoryLength: record.HistoryLength,
+		Memo:          record.Memo,
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: archiver.ConvertSearchAttrToBytes(record.SearchAttributes),
+		},
+	}
+}
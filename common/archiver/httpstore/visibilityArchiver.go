@@ -0,0 +1,341 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpstore
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uber/cadence/common/metrics"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		client      *client
+		queryParser archiver.IndexedVisibilityQueryParser
+	}
+
+	visibilityRecord archiver.ArchiveVisibilityRequest
+
+	queryVisibilityRequest struct {
+		domainID      string
+		pageSize      int
+		nextPageToken []byte
+		parsedQuery   *archiver.IndexedVisibilityQuery
+	}
+
+	indexToArchive struct {
+		primaryIndex            string
+		primaryIndexValue       string
+		secondaryIndex          string
+		secondaryIndexTimestamp int64
+	}
+)
+
+const (
+	errEncodeVisibilityRecord       = "failed to encode visibility record"
+	secondaryIndexKeyStartTimeout   = "startTimeout"
+	secondaryIndexKeyCloseTimeout   = "closeTimeout"
+	primaryIndexKeyWorkflowTypeName = "workflowTypeName"
+	primaryIndexKeyWorkflowID       = "workflowID"
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on a http object store
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.HTTPStoreArchiver,
+) (archiver.VisibilityArchiver, error) {
+	return newVisibilityArchiver(container, config)
+}
+
+func newVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.HTTPStoreArchiver) (*visibilityArchiver, error) {
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityArchiver{
+		container:   container,
+		client:      client,
+		queryParser: archiver.NewIndexedVisibilityQueryParser(),
+	}, nil
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveVisibilityRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	scope := v.container.MetricsClient.Scope(metrics.VisibilityArchiverScope, metrics.DomainTag(request.DomainName))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+	archiveFailReason := ""
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if IsRetryableError(err) {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveTransientErrorCount)
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+			} else {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveNonRetryableErrorCount)
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+				if featureCatalog.NonRetriableError != nil {
+					err = featureCatalog.NonRetriableError()
+				}
+			}
+		}
+	}()
+
+	if err := softValidateURI(URI); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidURI
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidArchiveRequest
+		return err
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		archiveFailReason = errEncodeVisibilityRecord
+		return err
+	}
+	indexes := createIndexesToArchive(request)
+	// Upload archive to all indexes
+	for _, element := range indexes {
+		key := constructTimestampIndex(URI.Path(), request.DomainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.RunID)
+		if err := upload(ctx, v.client, URI, key, encodedVisibilityRecord); err != nil {
+			archiveFailReason = errWriteKey
+			return err
+		}
+	}
+	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
+	return nil
+}
+
+func createIndexesToArchive(request *archiver.ArchiveVisibilityRequest) []indexToArchive {
+	return []indexToArchive{
+		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyCloseTimeout, request.CloseTimestamp},
+		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyStartTimeout, request.StartTimestamp},
+		{primaryIndexKeyWorkflowID, request.WorkflowID, secondaryIndexKeyCloseTimeout, request.CloseTimestamp},
+		{primaryIndexKeyWorkflowID, request.WorkflowID, secondaryIndexKeyStartTimeout, request.StartTimestamp},
+	}
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+
+	return v.query(ctx, URI, &queryVisibilityRequest{
+		domainID:      request.DomainID,
+		pageSize:      request.PageSize,
+		nextPageToken: request.NextPageToken,
+		parsedQuery:   parsedQuery,
+	})
+}
+
+func (v *visibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	var token string
+	if request.nextPageToken != nil {
+		token = deserializeQueryVisibilityToken(request.nextPageToken)
+	}
+	// without a WorkflowID or WorkflowTypeName, every closeTimeout key under the workflowID index is scanned
+	prefix := strings.TrimLeft(strings.Join([]string{URI.Path(), request.domainID, "visibility", primaryIndexKeyWorkflowID}, "/"), "/") + "/"
+	primaryIndex, primaryIndexValue := "", request.parsedQuery.WorkflowTypeName
+	if primaryIndexValue != nil {
+		primaryIndex = primaryIndexKeyWorkflowTypeName
+	}
+	if request.parsedQuery.WorkflowID != nil {
+		primaryIndex = primaryIndexKeyWorkflowID
+		primaryIndexValue = request.parsedQuery.WorkflowID
+	}
+	if primaryIndexValue != nil {
+		prefix = constructVisibilitySearchPrefix(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout) + "/"
+		if request.parsedQuery.CloseTime != nil {
+			prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout, *request.parsedQuery.CloseTime, *request.parsedQuery.SearchPrecision)
+		}
+		if request.parsedQuery.StartTime != nil {
+			prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyStartTimeout, *request.parsedQuery.StartTime, *request.parsedQuery.SearchPrecision)
+		}
+	}
+
+	results, err := v.client.listObjects(ctx, URI.Hostname(), prefix, "", request.pageSize, token)
+	if err != nil {
+		if IsRetryableError(err) {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	if len(results.Contents) == 0 {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	if results.IsTruncated {
+		response.NextPageToken = serializeQueryVisibilityToken(results.NextContinuationToken)
+	}
+	for _, item := range results.Contents {
+		if primaryIndexValue == nil {
+			if _, _, ok := parseCloseTimeIndex(item.Key); !ok {
+				continue
+			}
+		}
+		encodedRecord, err := download(ctx, v.client, URI, item.Key)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		// pages may contain fewer executions than the page size as records are filtered after they are read
+		if request.parsedQuery.Query != nil && !request.parsedQuery.Query.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+			continue
+		}
+		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+	}
+	return response, nil
+}
+
+func (v *visibilityArchiver) List(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ListVisibilityRequest,
+) (*archiver.ListVisibilityResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateListVisibilityRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidListVisibilityRequest.Error()}
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	var token string
+	if request.NextPageToken != nil {
+		token = deserializeQueryVisibilityToken(request.NextPageToken)
+	}
+	// every record has a closeTimeout key under the workflowID index, keys are ordered by workflowID
+	// instead of close time, so all of them are scanned
+	prefix := strings.TrimLeft(strings.Join([]string{URI.Path(), request.DomainID, "visibility", primaryIndexKeyWorkflowID}, "/"), "/") + "/"
+	results, err := v.client.listObjects(ctx, URI.Hostname(), prefix, "", request.PageSize, token)
+	if err != nil {
+		if IsRetryableError(err) {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+
+	response := &archiver.ListVisibilityResponse{}
+	if results.IsTruncated {
+		response.NextPageToken = serializeQueryVisibilityToken(results.NextContinuationToken)
+	}
+	for _, item := range results.Contents {
+		closeTime, _, ok := parseCloseTimeIndex(item.Key)
+		// the key only has a precision of seconds, the exact close time is checked after the record is read
+		if !ok || closeTime.UnixNano() >= request.CloseTimeBefore {
+			continue
+		}
+		encodedRecord, err := download(ctx, v.client, URI, item.Key)
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				continue
+			}
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		if record.CloseTimestamp < request.CloseTimeBefore {
+			response.Records = append(response.Records, (*archiver.ArchiveVisibilityRequest)(record))
+		}
+	}
+	return response, nil
+}
+
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteVisibilityRequest.Error()}
+	}
+
+	indexes := createIndexesToArchive(&archiver.ArchiveVisibilityRequest{
+		WorkflowID:       request.WorkflowID,
+		WorkflowTypeName: request.WorkflowTypeName,
+		StartTimestamp:   request.StartTimestamp,
+		CloseTimestamp:   request.CloseTimestamp,
+	})
+	for _, element := range indexes {
+		key := constructTimestampIndex(URI.Path(), request.DomainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.RunID)
+		if err := deleteKey(ctx, v.client, URI, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	err := softValidateURI(URI)
+	if err != nil {
+		return err
+	}
+	return bucketExists(context.TODO(), v.client, URI)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination indexedVisibilityQuery_mock.go -self_package github.com/uber/cadence/common/archiver
+
+package archiver
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+)
+
+type (
+	// IndexedVisibilityQueryParser parses an archived visibility query into an IndexedVisibilityQuery
+	IndexedVisibilityQueryParser interface {
+		Parse(query string) (*IndexedVisibilityQuery, error)
+	}
+
+	// IndexedVisibilityQuery holds the indexes which archivers storing visibility records under
+	// WorkflowID or WorkflowTypeName prefixed, time ordered keys can use to narrow down the keys to read,
+	// the records read are then filtered by Query
+	IndexedVisibilityQuery struct {
+		WorkflowTypeName *string
+		WorkflowID       *string
+		StartTime        *int64
+		CloseTime        *int64
+		SearchPrecision  *string
+		Query            *VisibilityQuery
+	}
+
+	indexedVisibilityQueryParser struct{}
+)
+
+// NewIndexedVisibilityQueryParser creates a new parser for indexed visibility queries
+func NewIndexedVisibilityQueryParser() IndexedVisibilityQueryParser {
+	return &indexedVisibilityQueryParser{}
+}
+
+func (p *indexedVisibilityQueryParser) Parse(query string) (*IndexedVisibilityQuery, error) {
+	visibilityQuery, err := ParseVisibilityQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	indexedQuery := &IndexedVisibilityQuery{
+		Query: visibilityQuery,
+	}
+	if workflowID, ok := visibilityQuery.StringEqual(definition.WorkflowID); ok {
+		indexedQuery.WorkflowID = common.StringPtr(workflowID)
+	} else if workflowTypeName, ok := visibilityQuery.StringEqual(definition.WorkflowType); ok {
+		indexedQuery.WorkflowTypeName = common.StringPtr(workflowTypeName)
+	}
+
+	// keys are only ordered by time under a primary index, and the search precision
+	// decides how much of the timestamp is part of the key prefix
+	precision := visibilityQuery.SearchPrecision()
+	if precision == "" || (indexedQuery.WorkflowID == nil && indexedQuery.WorkflowTypeName == nil) {
+		return indexedQuery, nil
+	}
+	indexedQuery.SearchPrecision = common.StringPtr(precision)
+	if earliest, latest := visibilityQuery.TimeRange(definition.CloseTime); inSearchWindow(earliest, latest, precision) {
+		indexedQuery.CloseTime = common.Int64Ptr(earliest)
+	} else if earliest, latest := visibilityQuery.TimeRange(definition.StartTime); inSearchWindow(earliest, latest, precision) {
+		indexedQuery.StartTime = common.Int64Ptr(earliest)
+	}
+	return indexedQuery, nil
+}
+
+// inSearchWindow returns true if the time range falls into a single UTC time window of the given precision
+func inSearchWindow(earliest, latest int64, precision string) bool {
+	window := precisionDurations[precision]
+	return earliest <= latest &&
+		time.Unix(0, earliest).UTC().Truncate(window).Equal(time.Unix(0, latest).UTC().Truncate(window))
+}
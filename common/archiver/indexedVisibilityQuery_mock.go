@@ -21,10 +21,10 @@
 // SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: queryParser.go
+// Source: indexedVisibilityQuery.go
 
-// Package s3store is a generated GoMock package.
-package s3store
+// Package archiver is a generated GoMock package.
+package archiver
 
 import (
 	reflect "reflect"
@@ -32,40 +32,40 @@ import (
 	gomock "github.com/golang/mock/gomock"
 )
 
-// MockQueryParser is a mock of QueryParser interface.
-type MockQueryParser struct {
+// MockIndexedVisibilityQueryParser is a mock of IndexedVisibilityQueryParser interface.
+type MockIndexedVisibilityQueryParser struct {
 	ctrl     *gomock.Controller
-	recorder *MockQueryParserMockRecorder
+	recorder *MockIndexedVisibilityQueryParserMockRecorder
 }
 
-// MockQueryParserMockRecorder is the mock recorder for MockQueryParser.
-type MockQueryParserMockRecorder struct {
-	mock *MockQueryParser
+// MockIndexedVisibilityQueryParserMockRecorder is the mock recorder for MockIndexedVisibilityQueryParser.
+type MockIndexedVisibilityQueryParserMockRecorder struct {
+	mock *MockIndexedVisibilityQueryParser
 }
 
-// NewMockQueryParser creates a new mock instance.
-func NewMockQueryParser(ctrl *gomock.Controller) *MockQueryParser {
-	mock := &MockQueryParser{ctrl: ctrl}
-	mock.recorder = &MockQueryParserMockRecorder{mock}
+// NewMockIndexedVisibilityQueryParser creates a new mock instance.
+func NewMockIndexedVisibilityQueryParser(ctrl *gomock.Controller) *MockIndexedVisibilityQueryParser {
+	mock := &MockIndexedVisibilityQueryParser{ctrl: ctrl}
+	mock.recorder = &MockIndexedVisibilityQueryParserMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockQueryParser) EXPECT() *MockQueryParserMockRecorder {
+func (m *MockIndexedVisibilityQueryParser) EXPECT() *MockIndexedVisibilityQueryParserMockRecorder {
 	return m.recorder
 }
 
 // Parse mocks base method.
-func (m *MockQueryParser) Parse(query string) (*parsedQuery, error) {
+func (m *MockIndexedVisibilityQueryParser) Parse(query string) (*IndexedVisibilityQuery, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Parse", query)
-	ret0, _ := ret[0].(*parsedQuery)
+	ret0, _ := ret[0].(*IndexedVisibilityQuery)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Parse indicates an expected call of Parse.
-func (mr *MockQueryParserMockRecorder) Parse(query interface{}) *gomock.Call {
+func (mr *MockIndexedVisibilityQueryParserMockRecorder) Parse(query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockQueryParser)(nil).Parse), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockIndexedVisibilityQueryParser)(nil).Parse), query)
 }
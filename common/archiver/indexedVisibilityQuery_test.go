@@ -18,7 +18,7 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-package s3store
+package archiver
 
 import (
 	"testing"
@@ -29,86 +29,84 @@ import (
 	"github.com/uber/cadence/common"
 )
 
-type queryParserSuite struct {
+type indexedVisibilityQueryParserSuite struct {
 	*require.Assertions
 	suite.Suite
 
-	parser QueryParser
+	parser IndexedVisibilityQueryParser
 }
 
-func TestQueryParserSuite(t *testing.T) {
-	suite.Run(t, new(queryParserSuite))
+func TestIndexedVisibilityQueryParserSuite(t *testing.T) {
+	suite.Run(t, new(indexedVisibilityQueryParserSuite))
 }
 
-func (s *queryParserSuite) SetupTest() {
+func (s *indexedVisibilityQueryParserSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
-	s.parser = NewQueryParser()
+	s.parser = NewIndexedVisibilityQueryParser()
 }
 
-func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
+func (s *indexedVisibilityQueryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 	testCases := []struct {
 		query       string
 		expectErr   bool
-		parsedQuery *parsedQuery
+		parsedQuery *IndexedVisibilityQuery
 	}{
 		{
 			query:     "WorkflowID = \"random workflowID\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
+			parsedQuery: &IndexedVisibilityQuery{
+				WorkflowID: common.StringPtr("random workflowID"),
 			},
 		},
 		{
 			query:     "WorkflowTypeName = \"random workflowTypeName\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowTypeName: common.StringPtr("random workflowTypeName"),
+			parsedQuery: &IndexedVisibilityQuery{
+				WorkflowTypeName: common.StringPtr("random workflowTypeName"),
 			},
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" and WorkflowTypeName = \"random workflowTypeName\"",
-			expectErr: true,
-		},
-		{
-			query:     "WorkflowID = \"random workflowID\" and WorkflowID = \"random workflowID\"",
-			expectErr: true,
-		},
-		{
-			query:     "RunID = \"random runID\"",
-			expectErr: true,
-		},
-		{
-			query:     "WorkflowID = 'random workflowID'",
+			query:     "WorkflowType = \"random workflowTypeName\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
+			parsedQuery: &IndexedVisibilityQuery{
+				WorkflowTypeName: common.StringPtr("random workflowTypeName"),
 			},
 		},
 		{
-			query:     "(WorkflowID = \"random workflowID\")",
+			query:     "WorkflowID = \"random workflowID\" and WorkflowTypeName = \"random workflowTypeName\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
+			parsedQuery: &IndexedVisibilityQuery{
+				WorkflowID: common.StringPtr("random workflowID"),
 			},
 		},
 		{
-			query:     "runID = random workflowID",
-			expectErr: true,
+			query:       "RunID = \"random runID\"",
+			expectErr:   false,
+			parsedQuery: &IndexedVisibilityQuery{},
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
-			expectErr: true,
+			query:     "(WorkflowID = 'random workflowID')",
+			expectErr: false,
+			parsedQuery: &IndexedVisibilityQuery{
+				WorkflowID: common.StringPtr("random workflowID"),
+			},
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" or runID = \"random runID\"",
-			expectErr: true,
+			query:       "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
+			expectErr:   false,
+			parsedQuery: &IndexedVisibilityQuery{},
+		},
+		{
+			query:       "WorkflowID != \"random workflowID\" and CloseStatus = 'failed'",
+			expectErr:   false,
+			parsedQuery: &IndexedVisibilityQuery{},
 		},
 		{
-			query:     "workflowid = \"random workflowID\"",
+			query:     "runID = random workflowID",
 			expectErr: true,
 		},
 		{
-			query:     "runID > \"random workflowID\"",
+			query:     "WorkflowID = 1",
 			expectErr: true,
 		},
 	}
@@ -120,47 +118,52 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 			continue
 		}
 		s.NoError(err)
-		s.Equal(tc.parsedQuery.workflowID, parsedQuery.workflowID)
-		s.Equal(tc.parsedQuery.workflowTypeName, parsedQuery.workflowTypeName)
-
+		s.NotNil(parsedQuery.Query)
+		s.Equal(tc.parsedQuery.WorkflowID, parsedQuery.WorkflowID)
+		s.Equal(tc.parsedQuery.WorkflowTypeName, parsedQuery.WorkflowTypeName)
 	}
 }
 
-func (s *queryParserSuite) TestParsePrecision() {
+func (s *indexedVisibilityQueryParserSuite) TestParsePrecision() {
 	commonQueryPart := "WorkflowID = \"random workflowID\" AND "
 	testCases := []struct {
 		query       string
 		expectErr   bool
-		parsedQuery *parsedQuery
+		parsedQuery *IndexedVisibilityQuery
 	}{
 		{
 			query:     commonQueryPart + "CloseTime = 1000 and SearchPrecision = 'Day'",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				searchPrecision: common.StringPtr(PrecisionDay),
+			parsedQuery: &IndexedVisibilityQuery{
+				SearchPrecision: common.StringPtr(PrecisionDay),
 			},
 		},
 		{
 			query:     commonQueryPart + "CloseTime = 1000 and SearchPrecision = 'Hour'",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				searchPrecision: common.StringPtr(PrecisionHour),
+			parsedQuery: &IndexedVisibilityQuery{
+				SearchPrecision: common.StringPtr(PrecisionHour),
 			},
 		},
 		{
 			query:     commonQueryPart + "CloseTime = 1000 and SearchPrecision = 'Minute'",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				searchPrecision: common.StringPtr(PrecisionMinute),
+			parsedQuery: &IndexedVisibilityQuery{
+				SearchPrecision: common.StringPtr(PrecisionMinute),
 			},
 		},
 		{
 			query:     commonQueryPart + "StartTime = 1000 and SearchPrecision = 'Second'",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				searchPrecision: common.StringPtr(PrecisionSecond),
+			parsedQuery: &IndexedVisibilityQuery{
+				SearchPrecision: common.StringPtr(PrecisionSecond),
 			},
 		},
+		{
+			query:       "CloseTime = 1000 and SearchPrecision = 'Second'",
+			expectErr:   false,
+			parsedQuery: &IndexedVisibilityQuery{},
+		},
 		{
 			query:     commonQueryPart + "SearchPrecision = 'Second'",
 			expectErr: true,
@@ -178,30 +181,37 @@ func (s *queryParserSuite) TestParsePrecision() {
 			continue
 		}
 		s.NoError(err)
-		s.Equal(tc.parsedQuery.searchPrecision, parsedQuery.searchPrecision)
+		s.Equal(tc.parsedQuery.SearchPrecision, parsedQuery.SearchPrecision)
 	}
 }
 
-func (s *queryParserSuite) TestParseCloseTime() {
+func (s *indexedVisibilityQueryParserSuite) TestParseCloseTime() {
 	commonQueryPart := "WorkflowID = \"random workflowID\" AND SearchPrecision = 'Day' AND "
 
 	testCases := []struct {
 		query       string
 		expectErr   bool
-		parsedQuery *parsedQuery
+		parsedQuery *IndexedVisibilityQuery
 	}{
 		{
 			query:     commonQueryPart + "CloseTime = 1000",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				closeTime: common.Int64Ptr(1000),
+			parsedQuery: &IndexedVisibilityQuery{
+				CloseTime: common.Int64Ptr(0),
 			},
 		},
 		{
 			query:     commonQueryPart + "CloseTime = \"2019-01-01T11:11:11Z\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				closeTime: common.Int64Ptr(1546341071000000000),
+			parsedQuery: &IndexedVisibilityQuery{
+				CloseTime: common.Int64Ptr(1546300800000000000),
+			},
+		},
+		{
+			query:     commonQueryPart + "CloseTime = \"2019-01-01T11:11:11Z\" AND StartTime < \"2019-01-01T00:00:00Z\"",
+			expectErr: false,
+			parsedQuery: &IndexedVisibilityQuery{
+				CloseTime: common.Int64Ptr(1546300800000000000),
 			},
 		},
 		{
@@ -221,31 +231,38 @@ func (s *queryParserSuite) TestParseCloseTime() {
 			continue
 		}
 		s.NoError(err)
-		s.Equal(tc.parsedQuery.closeTime, parsedQuery.closeTime)
-
+		s.Equal(tc.parsedQuery.CloseTime, parsedQuery.CloseTime)
+		s.Nil(parsedQuery.StartTime)
 	}
 }
 
-func (s *queryParserSuite) TestParseStartTime() {
+func (s *indexedVisibilityQueryParserSuite) TestParseStartTime() {
 	commonQueryPart := "WorkflowID = \"random workflowID\" AND SearchPrecision = 'Day' AND "
 
 	testCases := []struct {
 		query       string
 		expectErr   bool
-		parsedQuery *parsedQuery
+		parsedQuery *IndexedVisibilityQuery
 	}{
 		{
 			query:     commonQueryPart + "StartTime = 1000",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				startTime: common.Int64Ptr(1000),
+			parsedQuery: &IndexedVisibilityQuery{
+				StartTime: common.Int64Ptr(0),
 			},
 		},
 		{
 			query:     commonQueryPart + "StartTime = \"2019-01-01T11:11:11Z\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				startTime: common.Int64Ptr(1546341071000000000),
+			parsedQuery: &IndexedVisibilityQuery{
+				StartTime: common.Int64Ptr(1546300800000000000),
+			},
+		},
+		{
+			query:     commonQueryPart + "StartTime = \"2019-01-01T11:11:11Z\" AND CloseTime < \"2019-01-02T00:00:00Z\"",
+			expectErr: false,
+			parsedQuery: &IndexedVisibilityQuery{
+				StartTime: common.Int64Ptr(1546300800000000000),
 			},
 		},
 		{
@@ -265,6 +282,7 @@ func (s *queryParserSuite) TestParseStartTime() {
 			continue
 		}
 		s.NoError(err)
-		s.Equal(tc.parsedQuery.closeTime, parsedQuery.closeTime)
+		s.Equal(tc.parsedQuery.StartTime, parsedQuery.StartTime)
+		s.Nil(parsedQuery.CloseTime)
 	}
 }
@@ -60,6 +60,13 @@ type (
 		NextPageToken  []byte
 	}
 
+	// DeleteHistoryRequest is the request to Delete archived history
+	DeleteHistoryRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
 	// HistoryBootstrapContainer contains components needed by all history Archiver implementations
 	HistoryBootstrapContainer struct {
 		HistoryV2Manager persistence.HistoryManager
@@ -73,6 +80,9 @@ type (
 	HistoryArchiver interface {
 		Archive(context.Context, URI, *ArchiveHistoryRequest, ...ArchiveOption) error
 		Get(context.Context, URI, *GetHistoryRequest) (*GetHistoryResponse, error)
+		// Delete deletes all versions of the archived history of a workflow run,
+		// it's not an error if the history doesn't exist
+		Delete(context.Context, URI, *DeleteHistoryRequest) error
 		ValidateURI(URI) error
 	}
 
@@ -115,10 +125,41 @@ type (
 		NextPageToken []byte
 	}
 
+	// ListVisibilityRequest is the request to List archived visibility records
+	ListVisibilityRequest struct {
+		DomainID string
+		// CloseTimeBefore limits the records to the workflows closed before the given timestamp in nanoseconds
+		CloseTimeBefore int64
+		PageSize        int
+		NextPageToken   []byte
+	}
+
+	// ListVisibilityResponse is the response of listing archived visibility records
+	// A page may contain less records than the page size even if there're more pages
+	ListVisibilityResponse struct {
+		Records       []*ArchiveVisibilityRequest
+		NextPageToken []byte
+	}
+
+	// DeleteVisibilityRequest is the request to Delete an archived visibility record,
+	// all of its fields are used by some of the archivers to locate the record
+	DeleteVisibilityRequest struct {
+		DomainID         string
+		WorkflowID       string
+		RunID            string
+		WorkflowTypeName string
+		StartTimestamp   int64
+		CloseTimestamp   int64
+	}
+
 	// VisibilityArchiver is used to archive visibility and read archived visibility
 	VisibilityArchiver interface {
 		Archive(context.Context, URI, *ArchiveVisibilityRequest, ...ArchiveOption) error
 		Query(context.Context, URI, *QueryVisibilityRequest) (*QueryVisibilityResponse, error)
+		// List lists the archived visibility records of a domain, it's used to enforce the archival retention
+		List(context.Context, URI, *ListVisibilityRequest) (*ListVisibilityResponse, error)
+		// Delete deletes an archived visibility record, it's not an error if the record doesn't exist
+		Delete(context.Context, URI, *DeleteVisibilityRequest) error
 		ValidateURI(URI) error
 	}
 )
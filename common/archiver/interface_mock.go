@@ -77,6 +77,20 @@ func (_m *HistoryArchiverMock) Get(ctx context.Context, uri URI, request *GetHis
 	return r0, r1
 }
 
+// Delete provides a mock function with given fields: ctx, uri, request
+func (_m *HistoryArchiverMock) Delete(ctx context.Context, uri URI, request *DeleteHistoryRequest) error {
+	ret := _m.Called(ctx, uri, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *DeleteHistoryRequest) error); ok {
+		r0 = rf(ctx, uri, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ValidateURI provides a mock function with given fields: uri
 func (_m *HistoryArchiverMock) ValidateURI(uri URI) error {
 	ret := _m.Called(uri)
@@ -140,6 +154,43 @@ func (_m *VisibilityArchiverMock) Query(_a0 context.Context, _a1 URI, _a2 *Query
 	return r0, r1
 }
 
+// List provides a mock function with given fields: _a0, _a1, _a2
+func (_m *VisibilityArchiverMock) List(_a0 context.Context, _a1 URI, _a2 *ListVisibilityRequest) (*ListVisibilityResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *ListVisibilityResponse
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *ListVisibilityRequest) *ListVisibilityResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListVisibilityResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, URI, *ListVisibilityRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: _a0, _a1, _a2
+func (_m *VisibilityArchiverMock) Delete(_a0 context.Context, _a1 URI, _a2 *DeleteVisibilityRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *DeleteVisibilityRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ValidateURI provides a mock function with given fields: uri
 func (_m *VisibilityArchiverMock) ValidateURI(uri URI) error {
 	ret := _m.Called(uri)
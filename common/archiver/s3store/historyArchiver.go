@@ -33,7 +33,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 
@@ -99,19 +98,14 @@ func newHistoryArchiver(
 	if len(config.Region) == 0 {
 		return nil, errEmptyAwsRegion
 	}
-	s3Config := &aws.Config{
-		Endpoint:         config.Endpoint,
-		Region:           aws.String(config.Region),
-		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
-	}
-	sess, err := session.NewSession(s3Config)
+	s3cli, err := NewS3Client(config)
 	if err != nil {
 		return nil, err
 	}
 
 	return &historyArchiver{
 		container:       container,
-		s3cli:           s3.New(sess),
+		s3cli:           s3cli,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -127,7 +121,7 @@ func (h *historyArchiver) Archive(
 	defer func() {
 		sw.Stop()
 		if err != nil {
-			if persistence.IsTransientError(err) || isRetryableError(err) {
+			if persistence.IsTransientError(err) || IsRetryableError(err) {
 				scope.IncCounter(metrics.HistoryArchiverArchiveTransientErrorCount)
 			} else {
 				scope.IncCounter(metrics.HistoryArchiverArchiveNonRetryableErrorCount)
@@ -193,7 +187,7 @@ func (h *historyArchiver) Archive(
 		exists, err := keyExists(ctx, h.s3cli, URI, key)
 		if err != nil {
 			logger := logger.WithTags(tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
-			if isRetryableError(err) {
+			if IsRetryableError(err) {
 				logger.Error(archiver.ArchiveTransientErrorMsg)
 			} else {
 				logger.Error(archiver.ArchiveNonRetriableErrorMsg)
@@ -206,7 +200,7 @@ func (h *historyArchiver) Archive(
 		} else {
 			if err := upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
 				logger := logger.WithTags(tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
-				if isRetryableError(err) {
+				if IsRetryableError(err) {
 					logger.Error(archiver.ArchiveTransientErrorMsg)
 				} else {
 					logger.Error(archiver.ArchiveNonRetriableErrorMsg)
@@ -308,7 +302,7 @@ func (h *historyArchiver) Get(
 
 		encodedRecord, err := download(ctx, h.s3cli, URI, key)
 		if err != nil {
-			if isRetryableError(err) {
+			if IsRetryableError(err) {
 				return nil, &types.InternalServiceError{Message: err.Error()}
 			}
 			switch err.(type) {
@@ -346,6 +340,55 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteHistoryRequest.Error()}
+	}
+
+	prefix := constructHistoryKeyPrefix(URI.Path(), request.DomainID, request.WorkflowID, request.RunID) + "/"
+	var token *string
+	for {
+		results, err := h.listKeys(ctx, URI, prefix, token)
+		if err != nil {
+			return err
+		}
+		for _, item := range results.Contents {
+			if err := deleteKey(ctx, h.s3cli, URI, *item.Key); err != nil {
+				return err
+			}
+		}
+		if results.IsTruncated == nil || !*results.IsTruncated {
+			return nil
+		}
+		token = results.NextContinuationToken
+	}
+}
+
+func (h *historyArchiver) listKeys(ctx context.Context, URI archiver.URI, prefix string, token *string) (*s3.ListObjectsV2Output, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	results, err := h.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:            aws.String(URI.Hostname()),
+		Prefix:            aws.String(prefix),
+		ContinuationToken: token,
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil, &types.BadRequestError{Message: errBucketNotExists.Error()}
+		}
+		return nil, err
+	}
+	return results, nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
@@ -412,7 +455,8 @@ func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.UR
 	return highestVersion, nil
 }
 
-func isRetryableError(err error) bool {
+// IsRetryableError returns true if the error returned by the s3 client is transient
+func IsRetryableError(err error) bool {
 	if err == nil {
 		return false
 	}
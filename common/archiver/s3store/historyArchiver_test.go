@@ -209,6 +209,12 @@ func setupFsEmulation(s3cli *mocks.S3API) {
 		return !ok
 	})).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "", nil))
 	s3cli.On("GetObjectWithContext", mock.Anything, mock.Anything).Return(getObjectFn, nil)
+
+	s3cli.On("DeleteObjectWithContext", mock.Anything, mock.Anything).
+		Return(func(_ aws.Context, input *s3.DeleteObjectInput, _ ...request.Option) *s3.DeleteObjectOutput {
+			delete(fs, *input.Bucket+*input.Key)
+			return &s3.DeleteObjectOutput{}
+		}, nil)
 }
 
 func (s *historyArchiverSuite) TestValidateURI() {
@@ -664,6 +670,64 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	err := historyArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	})
+	s.Error(err)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndDelete() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchiveAndDelete")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	deleteRequest := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(historyArchiver.Delete(context.Background(), URI, deleteRequest))
+	// deleting history that does not exist is not an error
+	s.NoError(historyArchiver.Delete(context.Background(), URI, deleteRequest))
+
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		PageSize:             testPageSize,
+		CloseFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.Nil(response)
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	//config := &config.S3Archiver{}
 	//archiver, err := newHistoryArchiver(s.container, config, historyIterator)
@@ -31,6 +31,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"go.uber.org/multierr"
@@ -38,9 +39,25 @@ import (
 	"github.com/uber/cadence/common"
 
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/types"
 )
 
+// NewS3Client creates a s3 client from the given config, it also works with S3 compatible storages
+// when an endpoint is given
+func NewS3Client(config *config.S3Archiver) (s3iface.S3API, error) {
+	s3Config := &aws.Config{
+		Endpoint:         config.Endpoint,
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
+	}
+	sess, err := session.NewSession(s3Config)
+	if err != nil {
+		return nil, err
+	}
+	return s3.New(sess), nil
+}
+
 // encoding & decoding util
 
 func encode(v interface{}) ([]byte, error) {
@@ -151,22 +168,36 @@ func constructTimeBasedSearchKey(path, domainID, primaryIndexKey, primaryIndexVa
 	t := time.Unix(0, timestamp).In(time.UTC)
 	var timeFormat = ""
 	switch precision {
-	case PrecisionSecond:
+	case archiver.PrecisionSecond:
 		timeFormat = ":05"
 		fallthrough
-	case PrecisionMinute:
+	case archiver.PrecisionMinute:
 		timeFormat = ":04" + timeFormat
 		fallthrough
-	case PrecisionHour:
+	case archiver.PrecisionHour:
 		timeFormat = "15" + timeFormat
 		fallthrough
-	case PrecisionDay:
+	case archiver.PrecisionDay:
 		timeFormat = "2006-01-02T" + timeFormat
 	}
 
 	return fmt.Sprintf("%s/%s", constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(timeFormat))
 }
 
+// parseCloseTimeIndex returns the close time and the runID of a visibility key constructed by constructTimestampIndex
+// with the closeTimeout secondary index
+func parseCloseTimeIndex(key string) (time.Time, string, bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 3 || parts[len(parts)-3] != secondaryIndexKeyCloseTimeout {
+		return time.Time{}, "", false
+	}
+	closeTime, err := time.Parse(time.RFC3339, parts[len(parts)-2])
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return closeTime, parts[len(parts)-1], true
+}
+
 func constructTimestampIndex(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, runID string) string {
 	t := time.Unix(0, timestamp).In(time.UTC)
 	return fmt.Sprintf("%s/%s/%s", constructVisibilitySearchPrefix(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(time.RFC3339), runID)
@@ -236,6 +267,25 @@ func download(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key st
 	return body, nil
 }
 
+func deleteKey(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	_, err := s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			if aerr.Code() == s3.ErrCodeNoSuchBucket {
+				return &types.BadRequestError{Message: errBucketNotExists.Error()}
+			}
+		}
+		return err
+	}
+	return nil
+}
+
 func contextExpired(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -22,11 +22,11 @@ package s3store
 
 import (
 	"context"
+	"strings"
 
 	"github.com/uber/cadence/common/metrics"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 
@@ -40,7 +40,7 @@ type (
 	visibilityArchiver struct {
 		container   *archiver.VisibilityBootstrapContainer
 		s3cli       s3iface.S3API
-		queryParser QueryParser
+		queryParser archiver.IndexedVisibilityQueryParser
 	}
 
 	visibilityRecord archiver.ArchiveVisibilityRequest
@@ -49,7 +49,7 @@ type (
 		domainID      string
 		pageSize      int
 		nextPageToken []byte
-		parsedQuery   *parsedQuery
+		parsedQuery   *archiver.IndexedVisibilityQuery
 	}
 
 	indexToArchive struct {
@@ -79,19 +79,14 @@ func NewVisibilityArchiver(
 func newVisibilityArchiver(
 	container *archiver.VisibilityBootstrapContainer,
 	config *config.S3Archiver) (*visibilityArchiver, error) {
-	s3Config := &aws.Config{
-		Endpoint:         config.Endpoint,
-		Region:           aws.String(config.Region),
-		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
-	}
-	sess, err := session.NewSession(s3Config)
+	s3cli, err := NewS3Client(config)
 	if err != nil {
 		return nil, err
 	}
 	return &visibilityArchiver{
 		container:   container,
-		s3cli:       s3.New(sess),
-		queryParser: NewQueryParser(),
+		s3cli:       s3cli,
+		queryParser: archiver.NewIndexedVisibilityQueryParser(),
 	}, nil
 }
 
@@ -109,7 +104,7 @@ func (v *visibilityArchiver) Archive(
 	defer func() {
 		sw.Stop()
 		if err != nil {
-			if isRetryableError(err) {
+			if IsRetryableError(err) {
 				scope.IncCounter(metrics.VisibilityArchiverArchiveTransientErrorCount)
 				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
 			} else {
@@ -195,18 +190,24 @@ func (v *visibilityArchiver) query(
 	if request.nextPageToken != nil {
 		token = deserializeQueryVisibilityToken(request.nextPageToken)
 	}
-	primaryIndex := primaryIndexKeyWorkflowTypeName
-	primaryIndexValue := request.parsedQuery.workflowTypeName
-	if request.parsedQuery.workflowID != nil {
-		primaryIndex = primaryIndexKeyWorkflowID
-		primaryIndexValue = request.parsedQuery.workflowID
+	// without a WorkflowID or WorkflowTypeName, every closeTimeout key under the workflowID index is scanned
+	prefix := strings.TrimLeft(strings.Join([]string{URI.Path(), request.domainID, "visibility", primaryIndexKeyWorkflowID}, "/"), "/") + "/"
+	primaryIndex, primaryIndexValue := "", request.parsedQuery.WorkflowTypeName
+	if primaryIndexValue != nil {
+		primaryIndex = primaryIndexKeyWorkflowTypeName
 	}
-	var prefix = constructVisibilitySearchPrefix(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout) + "/"
-	if request.parsedQuery.closeTime != nil {
-		prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout, *request.parsedQuery.closeTime, *request.parsedQuery.searchPrecision)
+	if request.parsedQuery.WorkflowID != nil {
+		primaryIndex = primaryIndexKeyWorkflowID
+		primaryIndexValue = request.parsedQuery.WorkflowID
 	}
-	if request.parsedQuery.startTime != nil {
-		prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyStartTimeout, *request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
+	if primaryIndexValue != nil {
+		prefix = constructVisibilitySearchPrefix(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout) + "/"
+		if request.parsedQuery.CloseTime != nil {
+			prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout, *request.parsedQuery.CloseTime, *request.parsedQuery.SearchPrecision)
+		}
+		if request.parsedQuery.StartTime != nil {
+			prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyStartTimeout, *request.parsedQuery.StartTime, *request.parsedQuery.SearchPrecision)
+		}
 	}
 
 	results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
@@ -216,7 +217,7 @@ func (v *visibilityArchiver) query(
 		ContinuationToken: token,
 	})
 	if err != nil {
-		if isRetryableError(err) {
+		if IsRetryableError(err) {
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
 		return nil, &types.BadRequestError{Message: err.Error()}
@@ -230,6 +231,11 @@ func (v *visibilityArchiver) query(
 		response.NextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
 	}
 	for _, item := range results.Contents {
+		if primaryIndexValue == nil {
+			if _, _, ok := parseCloseTimeIndex(*item.Key); !ok {
+				continue
+			}
+		}
 		encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
@@ -239,11 +245,106 @@ func (v *visibilityArchiver) query(
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
+		// pages may contain fewer executions than the page size as records are filtered after they are read
+		if request.parsedQuery.Query != nil && !request.parsedQuery.Query.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+			continue
+		}
 		response.Executions = append(response.Executions, convertToExecutionInfo(record))
 	}
 	return response, nil
 }
 
+func (v *visibilityArchiver) List(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ListVisibilityRequest,
+) (*archiver.ListVisibilityResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateListVisibilityRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidListVisibilityRequest.Error()}
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	var token *string
+	if request.NextPageToken != nil {
+		token = deserializeQueryVisibilityToken(request.NextPageToken)
+	}
+	// every record has a closeTimeout key under the workflowID index, keys are ordered by workflowID
+	// instead of close time, so all of them are scanned
+	prefix := strings.TrimLeft(strings.Join([]string{URI.Path(), request.DomainID, "visibility", primaryIndexKeyWorkflowID}, "/"), "/") + "/"
+	results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:            aws.String(URI.Hostname()),
+		Prefix:            aws.String(prefix),
+		MaxKeys:           aws.Int64(int64(request.PageSize)),
+		ContinuationToken: token,
+	})
+	if err != nil {
+		if IsRetryableError(err) {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+
+	response := &archiver.ListVisibilityResponse{}
+	if results.IsTruncated != nil && *results.IsTruncated {
+		response.NextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
+	}
+	for _, item := range results.Contents {
+		closeTime, _, ok := parseCloseTimeIndex(*item.Key)
+		// the key only has a precision of seconds, the exact close time is checked after the record is read
+		if !ok || closeTime.UnixNano() >= request.CloseTimeBefore {
+			continue
+		}
+		encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				continue
+			}
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		if record.CloseTimestamp < request.CloseTimeBefore {
+			response.Records = append(response.Records, (*archiver.ArchiveVisibilityRequest)(record))
+		}
+	}
+	return response, nil
+}
+
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteVisibilityRequest.Error()}
+	}
+
+	indexes := createIndexesToArchive(&archiver.ArchiveVisibilityRequest{
+		WorkflowID:       request.WorkflowID,
+		WorkflowTypeName: request.WorkflowTypeName,
+		StartTimestamp:   request.StartTimestamp,
+		CloseTimestamp:   request.CloseTimestamp,
+	})
+	for _, element := range indexes {
+		key := constructTimestampIndex(URI.Path(), request.DomainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.RunID)
+		if err := deleteKey(ctx, v.s3cli, URI, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
@@ -105,7 +105,7 @@ func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchive
 	archiver := &visibilityArchiver{
 		container:   s.container,
 		s3cli:       s.s3cli,
-		queryParser: NewQueryParser(),
+		queryParser: archiver.NewIndexedVisibilityQueryParser(),
 	}
 	return archiver
 }
@@ -243,7 +243,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
+	mockParser := archiver.NewMockIndexedVisibilityQueryParser(s.controller)
 	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
@@ -256,11 +256,11 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 }
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID:      common.StringPtr(testWorkflowID),
-		closeTime:       common.Int64Ptr(0),
-		searchPrecision: common.StringPtr(PrecisionSecond),
+	mockParser := archiver.NewMockIndexedVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.IndexedVisibilityQuery{
+		WorkflowID:      common.StringPtr(testWorkflowID),
+		CloseTime:       common.Int64Ptr(0),
+		SearchPrecision: common.StringPtr(archiver.PrecisionSecond),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -277,11 +277,11 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       common.Int64Ptr(int64(1 * time.Hour)),
-		searchPrecision: common.StringPtr(PrecisionHour),
-		workflowID:      common.StringPtr(testWorkflowID),
+	mockParser := archiver.NewMockIndexedVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.IndexedVisibilityQuery{
+		CloseTime:       common.Int64Ptr(int64(1 * time.Hour)),
+		SearchPrecision: common.StringPtr(archiver.PrecisionHour),
+		WorkflowID:      common.StringPtr(testWorkflowID),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -301,11 +301,11 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       common.Int64Ptr(0),
-		searchPrecision: common.StringPtr(PrecisionDay),
-		workflowID:      common.StringPtr(testWorkflowID),
+	mockParser := archiver.NewMockIndexedVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.IndexedVisibilityQuery{
+		CloseTime:       common.Int64Ptr(0),
+		SearchPrecision: common.StringPtr(archiver.PrecisionDay),
+		WorkflowID:      common.StringPtr(testWorkflowID),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -347,70 +347,70 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 			hour:      0,
 			minute:    0,
 			second:    0,
-			precision: PrecisionDay,
+			precision: archiver.PrecisionDay,
 		},
 		{
 			day:       1,
 			hour:      1,
 			minute:    0,
 			second:    0,
-			precision: PrecisionDay,
+			precision: archiver.PrecisionDay,
 		},
 		{
 			day:       2,
 			hour:      1,
 			minute:    0,
 			second:    0,
-			precision: PrecisionHour,
+			precision: archiver.PrecisionHour,
 		},
 		{
 			day:       2,
 			hour:      1,
 			minute:    30,
 			second:    0,
-			precision: PrecisionHour,
+			precision: archiver.PrecisionHour,
 		},
 		{
 			day:       3,
 			hour:      2,
 			minute:    1,
 			second:    0,
-			precision: PrecisionMinute,
+			precision: archiver.PrecisionMinute,
 		},
 		{
 			day:       3,
 			hour:      2,
 			minute:    1,
 			second:    30,
-			precision: PrecisionMinute,
+			precision: archiver.PrecisionMinute,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    1,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    1,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    2,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    2,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 	}
 	visibilityArchiver := s.newTestVisibilityArchiver()
@@ -440,11 +440,11 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 	}
 
 	for i, testData := range precisionTests {
-		mockParser := NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:       common.Int64Ptr((testData.day+30)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision: common.StringPtr(testData.precision),
-			workflowID:      common.StringPtr(testWorkflowID),
+		mockParser := archiver.NewMockIndexedVisibilityQueryParser(s.controller)
+		mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.IndexedVisibilityQuery{
+			CloseTime:       common.Int64Ptr((testData.day+30)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
+			SearchPrecision: common.StringPtr(testData.precision),
+			WorkflowID:      common.StringPtr(testWorkflowID),
 		}, nil).AnyTimes()
 		visibilityArchiver.queryParser = mockParser
 
@@ -453,11 +453,11 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.NotNil(response)
 		s.Len(response.Executions, 2, "Iteration ", i)
 
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:       common.Int64Ptr((testData.day)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision: common.StringPtr(testData.precision),
-			workflowID:      common.StringPtr(testWorkflowID),
+		mockParser = archiver.NewMockIndexedVisibilityQueryParser(s.controller)
+		mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.IndexedVisibilityQuery{
+			StartTime:       common.Int64Ptr((testData.day)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
+			SearchPrecision: common.StringPtr(testData.precision),
+			WorkflowID:      common.StringPtr(testWorkflowID),
 		}, nil).AnyTimes()
 		visibilityArchiver.queryParser = mockParser
 
@@ -466,11 +466,11 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.NotNil(response)
 		s.Len(response.Executions, 2, "Iteration ", i)
 
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:        common.Int64Ptr((testData.day+30)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision:  common.StringPtr(testData.precision),
-			workflowTypeName: common.StringPtr(testWorkflowTypeName),
+		mockParser = archiver.NewMockIndexedVisibilityQueryParser(s.controller)
+		mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.IndexedVisibilityQuery{
+			CloseTime:        common.Int64Ptr((testData.day+30)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
+			SearchPrecision:  common.StringPtr(testData.precision),
+			WorkflowTypeName: common.StringPtr(testWorkflowTypeName),
 		}, nil).AnyTimes()
 		visibilityArchiver.queryParser = mockParser
 
@@ -479,11 +479,11 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.NotNil(response)
 		s.Len(response.Executions, 2, "Iteration ", i)
 
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:        common.Int64Ptr((testData.day)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
-			searchPrecision:  common.StringPtr(testData.precision),
-			workflowTypeName: common.StringPtr(testWorkflowTypeName),
+		mockParser = archiver.NewMockIndexedVisibilityQueryParser(s.controller)
+		mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.IndexedVisibilityQuery{
+			StartTime:        common.Int64Ptr((testData.day)*int64(time.Hour)*24 + testData.hour*int64(time.Hour) + testData.minute*int64(time.Minute) + testData.second*int64(time.Second)),
+			SearchPrecision:  common.StringPtr(testData.precision),
+			WorkflowTypeName: common.StringPtr(testWorkflowTypeName),
 		}, nil).AnyTimes()
 		visibilityArchiver.queryParser = mockParser
 
@@ -502,9 +502,9 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 		s.NoError(err)
 	}
 
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID: common.StringPtr(testWorkflowID),
+	mockParser := archiver.NewMockIndexedVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.IndexedVisibilityQuery{
+		WorkflowID: common.StringPtr(testWorkflowID),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -527,9 +527,9 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[2])
 
-	mockParser = NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowTypeName: common.StringPtr(testWorkflowTypeName),
+	mockParser = archiver.NewMockIndexedVisibilityQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&archiver.IndexedVisibilityQuery{
+		WorkflowTypeName: common.StringPtr(testWorkflowTypeName),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	request = &archiver.QueryVisibilityRequest{
@@ -553,6 +553,99 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[2])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_WithoutPrimaryIndex() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI + "/archive-and-query-without-primary-index")
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record))
+		s.NoError(err)
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 2,
+		Query:    fmt.Sprintf("CloseTime > %d or (RunID = '%s' and CloseStatus = 'Failed')", int64(2*time.Hour), testRunID),
+	}
+	executions := []*types.WorkflowExecutionInfo{}
+	var first = true
+	for first || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request)
+		s.NoError(err)
+		s.NotNil(response)
+		s.True(len(response.Executions) <= request.PageSize)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+		first = false
+	}
+	s.Len(executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[1])
+}
+
+func (s *visibilityArchiverSuite) TestList_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	response, err := visibilityArchiver.List(context.Background(), s.testArchivalURI, &archiver.ListVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 1,
+	})
+	s.Error(err)
+	s.IsType(&types.BadRequestError{}, err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestListAndDelete() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI + "/list-and-delete")
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record))
+		s.NoError(err)
+	}
+
+	listExpired := func(closeTimeBefore int64) []*archiver.ArchiveVisibilityRequest {
+		request := &archiver.ListVisibilityRequest{
+			DomainID:        testDomainID,
+			CloseTimeBefore: closeTimeBefore,
+			PageSize:        1,
+		}
+		var records []*archiver.ArchiveVisibilityRequest
+		var first = true
+		for first || request.NextPageToken != nil {
+			response, err := visibilityArchiver.List(context.Background(), URI, request)
+			s.NoError(err)
+			s.NotNil(response)
+			s.True(len(response.Records) <= request.PageSize)
+			records = append(records, response.Records...)
+			request.NextPageToken = response.NextPageToken
+			first = false
+		}
+		return records
+	}
+
+	records := listExpired(int64(2 * time.Hour))
+	s.Len(records, 2)
+	s.Equal((*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[0]), records[0])
+	s.Equal((*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[1]), records[1])
+
+	for _, record := range records {
+		err := visibilityArchiver.Delete(context.Background(), URI, &archiver.DeleteVisibilityRequest{
+			DomainID:         record.DomainID,
+			WorkflowID:       record.WorkflowID,
+			RunID:            record.RunID,
+			WorkflowTypeName: record.WorkflowTypeName,
+			StartTimestamp:   record.StartTimestamp,
+			CloseTimestamp:   record.CloseTimestamp,
+		})
+		s.NoError(err)
+	}
+	s.Empty(listExpired(int64(2 * time.Hour)))
+
+	records = listExpired(int64(4 * time.Hour))
+	s.Len(records, 1)
+	s.Equal((*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[2]), records[0])
+}
+
 func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
 	s.visibilityRecords = []*visibilityRecord{
 		{
@@ -38,6 +38,7 @@ var (
 	errEmptyStartTime        = errors.New("StartTimestamp is empty")
 	errEmptyCloseTime        = errors.New("CloseTimestamp is empty")
 	errEmptyQuery            = errors.New("Query string is empty")
+	errInvalidCloseTime      = errors.New("CloseTimeBefore should be greater than 0")
 )
 
 // TagLoggerWithArchiveHistoryRequestAndURI tags logger with fields in the archive history request and the URI
@@ -143,6 +144,57 @@ func ValidateQueryRequest(request *QueryVisibilityRequest) error {
 	return nil
 }
 
+// ValidateDeleteHistoryRequest validates the delete archived history request
+func ValidateDeleteHistoryRequest(request *DeleteHistoryRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	return nil
+}
+
+// ValidateListVisibilityRequest validates the list visibility request
+func ValidateListVisibilityRequest(request *ListVisibilityRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.PageSize == 0 {
+		return errInvalidPageSize
+	}
+	if request.CloseTimeBefore <= 0 {
+		return errInvalidCloseTime
+	}
+	return nil
+}
+
+// ValidateDeleteVisibilityRequest validates the delete visibility request
+func ValidateDeleteVisibilityRequest(request *DeleteVisibilityRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	if request.WorkflowTypeName == "" {
+		return errEmptyWorkflowTypeName
+	}
+	if request.StartTimestamp == 0 {
+		return errEmptyStartTime
+	}
+	if request.CloseTimestamp == 0 {
+		return errEmptyCloseTime
+	}
+	return nil
+}
+
 // ConvertSearchAttrToBytes converts search attribute value from string back to byte array
 func ConvertSearchAttrToBytes(searchAttrStr map[string]string) map[string][]byte {
 	searchAttr := make(map[string][]byte)
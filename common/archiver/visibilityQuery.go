@@ -0,0 +1,596 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// VisibilityQuery is a parsed archived visibility query.
+	// It supports the where clause grammar of advanced visibility: comparisons, IN, BETWEEN,
+	// AND, OR and parentheses over WorkflowID, RunID, WorkflowType, StartTime, ExecutionTime,
+	// CloseTime, CloseStatus, HistoryLength and custom search attributes.
+	VisibilityQuery struct {
+		expr            queryExpr
+		conditions      []*queryCondition
+		searchPrecision string
+	}
+
+	queryExpr interface {
+		match(record *ArchiveVisibilityRequest) bool
+	}
+
+	queryAndExpr struct {
+		left, right queryExpr
+	}
+
+	queryOrExpr struct {
+		left, right queryExpr
+	}
+
+	queryCondition struct {
+		field    string
+		operator string
+		values   []interface{}
+	}
+)
+
+// Field names supported in archived visibility queries in addition to custom search attributes
+const (
+	WorkflowTypeName = "WorkflowTypeName"
+	SearchPrecision  = "SearchPrecision"
+)
+
+// Precision specific values
+const (
+	PrecisionDay    = "Day"
+	PrecisionHour   = "Hour"
+	PrecisionMinute = "Minute"
+	PrecisionSecond = "Second"
+)
+
+const (
+	visibilityQueryTemplate = "select * from dummy where %s"
+)
+
+var precisionDurations = map[string]time.Duration{
+	PrecisionDay:    24 * time.Hour,
+	PrecisionHour:   time.Hour,
+	PrecisionMinute: time.Minute,
+	PrecisionSecond: time.Second,
+}
+
+// ParseVisibilityQuery parses the where clause of an archived visibility query.
+// SearchPrecision = 'Day' | 'Hour' | 'Minute' | 'Second' can be given along the top level conditions
+// to match StartTime and CloseTime equality conditions against the whole enclosing UTC time window.
+func ParseVisibilityQuery(query string) (*VisibilityQuery, error) {
+	stmt, err := sqlparser.Parse(fmt.Sprintf(visibilityQueryTemplate, query))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil || len(sel.OrderBy) != 0 || sel.Limit != nil || len(sel.GroupBy) != 0 {
+		return nil, errors.New("query must only contain a where clause")
+	}
+
+	q := &VisibilityQuery{}
+	expr, err := q.convertExpr(sel.Where.Expr, true)
+	if err != nil {
+		return nil, err
+	}
+	q.expr = expr
+
+	if q.searchPrecision != "" {
+		if err := q.applySearchPrecision(); err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+// Match returns true if the visibility record satisfies the query
+func (q *VisibilityQuery) Match(record *ArchiveVisibilityRequest) bool {
+	if q.expr == nil {
+		return true
+	}
+	return q.expr.match(record)
+}
+
+// StringEqual returns the value of a top level equality condition on a string field,
+// which can be used by archivers to narrow down the records to read
+func (q *VisibilityQuery) StringEqual(field string) (string, bool) {
+	for _, condition := range q.conditions {
+		if condition.field == field && condition.operator == sqlparser.EqualStr {
+			return condition.values[0].(string), true
+		}
+	}
+	return "", false
+}
+
+// TimeRange returns the inclusive range on a time field implied by the top level conditions
+func (q *VisibilityQuery) TimeRange(field string) (int64, int64) {
+	earliest, latest := int64(0), int64(math.MaxInt64)
+	for _, condition := range q.conditions {
+		if condition.field != field {
+			continue
+		}
+		switch condition.operator {
+		case sqlparser.EqualStr:
+			earliest = common.MaxInt64(earliest, condition.values[0].(int64))
+			latest = common.MinInt64(latest, condition.values[0].(int64))
+		case sqlparser.GreaterThanStr:
+			earliest = common.MaxInt64(earliest, condition.values[0].(int64)+1)
+		case sqlparser.GreaterEqualStr:
+			earliest = common.MaxInt64(earliest, condition.values[0].(int64))
+		case sqlparser.LessThanStr:
+			latest = common.MinInt64(latest, condition.values[0].(int64)-1)
+		case sqlparser.LessEqualStr:
+			latest = common.MinInt64(latest, condition.values[0].(int64))
+		case sqlparser.BetweenStr:
+			earliest = common.MaxInt64(earliest, condition.values[0].(int64))
+			latest = common.MinInt64(latest, condition.values[1].(int64))
+		}
+	}
+	return earliest, latest
+}
+
+// SearchPrecision returns the search precision given in the query, or empty string if there is none
+func (q *VisibilityQuery) SearchPrecision() string {
+	return q.searchPrecision
+}
+
+func (q *VisibilityQuery) convertExpr(expr sqlparser.Expr, topLevel bool) (queryExpr, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, err := q.convertExpr(expr.Left, topLevel)
+		if err != nil {
+			return nil, err
+		}
+		right, err := q.convertExpr(expr.Right, topLevel)
+		if err != nil {
+			return nil, err
+		}
+		return newQueryAndExpr(left, right), nil
+	case *sqlparser.OrExpr:
+		left, err := q.convertExpr(expr.Left, false)
+		if err != nil {
+			return nil, err
+		}
+		right, err := q.convertExpr(expr.Right, false)
+		if err != nil {
+			return nil, err
+		}
+		return &queryOrExpr{left: left, right: right}, nil
+	case *sqlparser.ParenExpr:
+		return q.convertExpr(expr.Expr, topLevel)
+	case *sqlparser.ComparisonExpr:
+		return q.convertComparisonExpr(expr, topLevel)
+	case *sqlparser.RangeCond:
+		return q.convertRangeCond(expr, topLevel)
+	default:
+		return nil, fmt.Errorf("unsupported expression: %s", sqlparser.String(expr))
+	}
+}
+
+func (q *VisibilityQuery) convertComparisonExpr(expr *sqlparser.ComparisonExpr, topLevel bool) (queryExpr, error) {
+	field, err := convertFieldName(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	operator := expr.Operator
+
+	if field == SearchPrecision {
+		return nil, q.convertSearchPrecision(expr, topLevel)
+	}
+
+	var values []interface{}
+	switch operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr,
+		sqlparser.LessThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		value, err := convertFieldValue(field, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		values = []interface{}{value}
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid value for operator %s: %s", operator, sqlparser.String(expr.Right))
+		}
+		for _, valueExpr := range tuple {
+			value, err := convertFieldValue(field, valueExpr)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+	default:
+		return nil, fmt.Errorf("operator %s is not supported", operator)
+	}
+
+	return q.newCondition(field, operator, values, topLevel), nil
+}
+
+func (q *VisibilityQuery) convertRangeCond(expr *sqlparser.RangeCond, topLevel bool) (queryExpr, error) {
+	field, err := convertFieldName(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	from, err := convertFieldValue(field, expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := convertFieldValue(field, expr.To)
+	if err != nil {
+		return nil, err
+	}
+	return q.newCondition(field, expr.Operator, []interface{}{from, to}, topLevel), nil
+}
+
+func (q *VisibilityQuery) convertSearchPrecision(expr *sqlparser.ComparisonExpr, topLevel bool) error {
+	if !topLevel || expr.Operator != sqlparser.EqualStr {
+		return fmt.Errorf("%s can only be used with operator = at top level of the query", SearchPrecision)
+	}
+	value, err := convertStringValue(expr.Right)
+	if err != nil {
+		return err
+	}
+	if _, ok := precisionDurations[value]; !ok {
+		return fmt.Errorf("invalid value for %s: %s", SearchPrecision, value)
+	}
+	if q.searchPrecision != "" && q.searchPrecision != value {
+		return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
+	}
+	q.searchPrecision = value
+	return nil
+}
+
+// applySearchPrecision turns top level StartTime and CloseTime equality conditions
+// into ranges covering the time window given by the search precision
+func (q *VisibilityQuery) applySearchPrecision() error {
+	window := precisionDurations[q.searchPrecision]
+	found := false
+	for _, condition := range q.conditions {
+		if (condition.field != definition.StartTime && condition.field != definition.CloseTime) ||
+			condition.operator != sqlparser.EqualStr {
+			continue
+		}
+		start := time.Unix(0, condition.values[0].(int64)).UTC().Truncate(window)
+		condition.operator = sqlparser.BetweenStr
+		condition.values = []interface{}{start.UnixNano(), start.Add(window).UnixNano() - 1}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("%s requires a StartTime or CloseTime", SearchPrecision)
+	}
+	return nil
+}
+
+func (q *VisibilityQuery) newCondition(field string, operator string, values []interface{}, topLevel bool) *queryCondition {
+	condition := &queryCondition{
+		field:    field,
+		operator: operator,
+		values:   values,
+	}
+	if topLevel {
+		q.conditions = append(q.conditions, condition)
+	}
+	return condition
+}
+
+func newQueryAndExpr(left, right queryExpr) queryExpr {
+	// SearchPrecision is not a condition on the records
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &queryAndExpr{left: left, right: right}
+}
+
+func (e *queryAndExpr) match(record *ArchiveVisibilityRequest) bool {
+	return e.left.match(record) && e.right.match(record)
+}
+
+func (e *queryOrExpr) match(record *ArchiveVisibilityRequest) bool {
+	return e.left.match(record) || e.right.match(record)
+}
+
+func (c *queryCondition) match(record *ArchiveVisibilityRequest) bool {
+	recordValues, ok := getRecordValues(record, c.field)
+	if !ok {
+		// same as advanced visibility, negative conditions match records without the field
+		return c.operator == sqlparser.NotEqualStr || c.operator == sqlparser.NotInStr || c.operator == sqlparser.NotBetweenStr
+	}
+	// a search attribute with multiple values matches if any of the values matches
+	for _, recordValue := range recordValues {
+		if c.matchValue(recordValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *queryCondition) matchValue(recordValue interface{}) bool {
+	switch c.operator {
+	case sqlparser.EqualStr:
+		result, ok := compareValues(recordValue, c.values[0])
+		return ok && result == 0
+	case sqlparser.NotEqualStr:
+		result, ok := compareValues(recordValue, c.values[0])
+		return !ok || result != 0
+	case sqlparser.LessThanStr:
+		result, ok := compareValues(recordValue, c.values[0])
+		return ok && result < 0
+	case sqlparser.LessEqualStr:
+		result, ok := compareValues(recordValue, c.values[0])
+		return ok && result <= 0
+	case sqlparser.GreaterThanStr:
+		result, ok := compareValues(recordValue, c.values[0])
+		return ok && result > 0
+	case sqlparser.GreaterEqualStr:
+		result, ok := compareValues(recordValue, c.values[0])
+		return ok && result >= 0
+	case sqlparser.InStr, sqlparser.NotInStr:
+		in := false
+		for _, value := range c.values {
+			if result, ok := compareValues(recordValue, value); ok && result == 0 {
+				in = true
+				break
+			}
+		}
+		return in == (c.operator == sqlparser.InStr)
+	case sqlparser.BetweenStr, sqlparser.NotBetweenStr:
+		lower, lowerOK := compareValues(recordValue, c.values[0])
+		upper, upperOK := compareValues(recordValue, c.values[1])
+		between := lowerOK && upperOK && lower >= 0 && upper <= 0
+		return between == (c.operator == sqlparser.BetweenStr)
+	default:
+		return false
+	}
+}
+
+func getRecordValues(record *ArchiveVisibilityRequest, field string) ([]interface{}, bool) {
+	switch field {
+	case definition.WorkflowID:
+		return []interface{}{record.WorkflowID}, true
+	case definition.RunID:
+		return []interface{}{record.RunID}, true
+	case definition.WorkflowType:
+		return []interface{}{record.WorkflowTypeName}, true
+	case definition.StartTime:
+		return []interface{}{record.StartTimestamp}, true
+	case definition.ExecutionTime:
+		return []interface{}{record.ExecutionTimestamp}, true
+	case definition.CloseTime:
+		return []interface{}{record.CloseTimestamp}, true
+	case definition.CloseStatus:
+		return []interface{}{int64(record.CloseStatus)}, true
+	case definition.HistoryLength:
+		return []interface{}{record.HistoryLength}, true
+	}
+
+	encodedValue, ok := record.SearchAttributes[field]
+	if !ok {
+		return nil, false
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(encodedValue), &value); err != nil {
+		// value is not json encoded, compare it as a string
+		return []interface{}{encodedValue}, true
+	}
+	if values, ok := value.([]interface{}); ok {
+		return values, true
+	}
+	return []interface{}{value}, true
+}
+
+// compareValues compares a record value with a query value,
+// returning false if the values are of incompatible types
+func compareValues(recordValue interface{}, queryValue interface{}) (int, bool) {
+	switch queryValue := queryValue.(type) {
+	case string:
+		if recordValue, ok := recordValue.(string); ok {
+			return strings.Compare(recordValue, queryValue), true
+		}
+	case int64:
+		switch recordValue := recordValue.(type) {
+		case int64:
+			return compareInt64(recordValue, queryValue), true
+		case float64:
+			return compareFloat64(recordValue, float64(queryValue)), true
+		}
+	case float64:
+		switch recordValue := recordValue.(type) {
+		case int64:
+			return compareFloat64(float64(recordValue), queryValue), true
+		case float64:
+			return compareFloat64(recordValue, queryValue), true
+		}
+	case bool:
+		if recordValue, ok := recordValue.(bool); ok {
+			if recordValue == queryValue {
+				return 0, true
+			}
+			if !recordValue {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	return 0, false
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func convertFieldName(expr sqlparser.Expr) (string, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", fmt.Errorf("invalid filter name: %s", sqlparser.String(expr))
+	}
+	name := colName.Name.String()
+	if name == WorkflowTypeName {
+		return definition.WorkflowType, nil
+	}
+	return name, nil
+}
+
+func convertFieldValue(field string, expr sqlparser.Expr) (interface{}, error) {
+	switch field {
+	case definition.WorkflowID, definition.RunID, definition.WorkflowType:
+		return convertStringValue(expr)
+	case definition.StartTime, definition.ExecutionTime, definition.CloseTime:
+		return convertTimeValue(expr)
+	case definition.CloseStatus:
+		return convertCloseStatusValue(expr)
+	case definition.HistoryLength:
+		value, err := convertValue(expr)
+		if err != nil {
+			return nil, err
+		}
+		if length, ok := value.(int64); ok {
+			return length, nil
+		}
+		return nil, fmt.Errorf("invalid value for %s: %s", field, sqlparser.String(expr))
+	default:
+		return convertValue(expr)
+	}
+}
+
+func convertValue(expr sqlparser.Expr) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			return string(expr.Val), nil
+		case sqlparser.IntVal:
+			return strconv.ParseInt(string(expr.Val), 10, 64)
+		case sqlparser.FloatVal:
+			return strconv.ParseFloat(string(expr.Val), 64)
+		}
+	case sqlparser.BoolVal:
+		return bool(expr), nil
+	}
+	return nil, fmt.Errorf("invalid value: %s", sqlparser.String(expr))
+}
+
+func convertStringValue(expr sqlparser.Expr) (string, error) {
+	if value, ok := expr.(*sqlparser.SQLVal); ok && value.Type == sqlparser.StrVal {
+		return string(value.Val), nil
+	}
+	return "", fmt.Errorf("value %s is not a string value", sqlparser.String(expr))
+}
+
+func convertTimeValue(expr sqlparser.Expr) (int64, error) {
+	value, err := convertValue(expr)
+	if err != nil {
+		return 0, err
+	}
+	switch value := value.(type) {
+	case int64:
+		return value, nil
+	case string:
+		parsedTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return 0, err
+		}
+		return parsedTime.UnixNano(), nil
+	default:
+		return 0, fmt.Errorf("invalid time value: %s", sqlparser.String(expr))
+	}
+}
+
+func convertCloseStatusValue(expr sqlparser.Expr) (int64, error) {
+	value, err := convertValue(expr)
+	if err != nil {
+		return 0, err
+	}
+	var statusStr string
+	switch value := value.(type) {
+	case int64:
+		statusStr = strconv.FormatInt(value, 10)
+	case string:
+		statusStr = value
+	default:
+		return 0, fmt.Errorf("invalid workflow close status: %s", sqlparser.String(expr))
+	}
+	status, err := ConvertCloseStatus(statusStr)
+	if err != nil {
+		return 0, err
+	}
+	return int64(status), nil
+}
+
+// ConvertCloseStatus converts the name or the number of a workflow close status
+func ConvertCloseStatus(statusStr string) (types.WorkflowExecutionCloseStatus, error) {
+	statusStr = strings.ToLower(strings.TrimSpace(statusStr))
+	switch statusStr {
+	case "completed", strconv.Itoa(int(types.WorkflowExecutionCloseStatusCompleted)):
+		return types.WorkflowExecutionCloseStatusCompleted, nil
+	case "failed", strconv.Itoa(int(types.WorkflowExecutionCloseStatusFailed)):
+		return types.WorkflowExecutionCloseStatusFailed, nil
+	case "canceled", strconv.Itoa(int(types.WorkflowExecutionCloseStatusCanceled)):
+		return types.WorkflowExecutionCloseStatusCanceled, nil
+	case "terminated", strconv.Itoa(int(types.WorkflowExecutionCloseStatusTerminated)):
+		return types.WorkflowExecutionCloseStatusTerminated, nil
+	case "continuedasnew", "continued_as_new", strconv.Itoa(int(types.WorkflowExecutionCloseStatusContinuedAsNew)):
+		return types.WorkflowExecutionCloseStatusContinuedAsNew, nil
+	case "timedout", "timed_out", strconv.Itoa(int(types.WorkflowExecutionCloseStatusTimedOut)):
+		return types.WorkflowExecutionCloseStatusTimedOut, nil
+	default:
+		return 0, fmt.Errorf("unknown workflow close status: %s", statusStr)
+	}
+}
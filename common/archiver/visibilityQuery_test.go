@@ -0,0 +1,155 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+type visibilityQuerySuite struct {
+	*require.Assertions
+	suite.Suite
+
+	record *ArchiveVisibilityRequest
+}
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.record = &ArchiveVisibilityRequest{
+		DomainID:         "some random domain ID",
+		WorkflowID:       "some random workflow ID",
+		RunID:            "some random run ID",
+		WorkflowTypeName: "some random workflow type",
+		StartTimestamp:   time.Date(2021, 3, 10, 10, 30, 0, 0, time.UTC).UnixNano(),
+		CloseTimestamp:   time.Date(2021, 3, 11, 8, 15, 0, 0, time.UTC).UnixNano(),
+		CloseStatus:      types.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:    42,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": `["keyword1","keyword2"]`,
+			"CustomStringField":  `"some random string"`,
+			"CustomIntField":     `7`,
+			"CustomDoubleField":  `2.5`,
+			"CustomBoolField":    `true`,
+		},
+	}
+}
+
+func (s *visibilityQuerySuite) TestParse_Invalid() {
+	invalidQueries := []string{
+		"",
+		"WorkflowID",
+		"WorkflowID = 'a' order by StartTime",
+		"WorkflowID like 'a%'",
+		"WorkflowID = 1",
+		"StartTime = 'not a time'",
+		"CloseStatus = 'unknown'",
+		"HistoryLength = 'a'",
+		"SearchPrecision = 'Day'",
+		"SearchPrecision = 'Week' and StartTime = 0",
+		"SearchPrecision = 'Day' and SearchPrecision = 'Hour' and StartTime = 0",
+		"WorkflowID = 'a' or (SearchPrecision = 'Day' and StartTime = 0)",
+		"not WorkflowID = 'a'",
+	}
+	for _, query := range invalidQueries {
+		_, err := ParseVisibilityQuery(query)
+		s.Error(err, query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestMatch() {
+	testCases := []struct {
+		query   string
+		matched bool
+	}{
+		{query: "WorkflowID = 'some random workflow ID'", matched: true},
+		{query: "WorkflowID = \"some random workflow ID\"", matched: true},
+		{query: "WorkflowID != 'some random workflow ID'", matched: false},
+		{query: "WorkflowID = 'other' or RunID = 'some random run ID'", matched: true},
+		{query: "WorkflowID = 'other' or RunID = 'other'", matched: false},
+		{query: "WorkflowID in ('other', 'some random workflow ID')", matched: true},
+		{query: "WorkflowID not in ('other', 'some random workflow ID')", matched: false},
+		{query: "WorkflowType = 'some random workflow type'", matched: true},
+		{query: "WorkflowTypeName = 'some random workflow type'", matched: true},
+		{query: "StartTime >= '2021-03-10T00:00:00Z' and StartTime < '2021-03-11T00:00:00Z'", matched: true},
+		{query: "CloseTime between '2021-03-10T00:00:00Z' and '2021-03-11T00:00:00Z'", matched: false},
+		{query: "CloseTime not between '2021-03-10T00:00:00Z' and '2021-03-11T00:00:00Z'", matched: true},
+		{query: "CloseTime = '2021-03-11T00:00:00Z' and SearchPrecision = 'Day'", matched: true},
+		{query: "CloseTime = '2021-03-11T08:00:00Z' and SearchPrecision = 'Minute'", matched: false},
+		{query: "CloseStatus = 'failed'", matched: true},
+		{query: "CloseStatus = 1", matched: true},
+		{query: "CloseStatus = 'completed' or (HistoryLength > 40 and HistoryLength <= 42)", matched: true},
+		{query: "CustomKeywordField = 'keyword2'", matched: true},
+		{query: "CustomKeywordField = 'keyword3'", matched: false},
+		{query: "CustomStringField = 'some random string'", matched: true},
+		{query: "CustomIntField > 5 and CustomIntField < 8", matched: true},
+		{query: "CustomDoubleField >= 2.5", matched: true},
+		{query: "CustomBoolField = true", matched: true},
+		{query: "CustomIntField = 'a'", matched: false},
+		{query: "CustomDatetimeField = '2021-03-11T00:00:00Z'", matched: false},
+		{query: "CustomDatetimeField != '2021-03-11T00:00:00Z'", matched: true},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query)
+		s.NoError(err, tc.query)
+		s.Equal(tc.matched, query.Match(s.record), tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestStringEqual() {
+	query, err := ParseVisibilityQuery("WorkflowTypeName = 'some type' and (WorkflowID = 'a' or WorkflowID = 'b')")
+	s.NoError(err)
+	workflowType, ok := query.StringEqual(definition.WorkflowType)
+	s.True(ok)
+	s.Equal("some type", workflowType)
+	_, ok = query.StringEqual(definition.WorkflowID)
+	s.False(ok)
+}
+
+func (s *visibilityQuerySuite) TestTimeRange() {
+	query, err := ParseVisibilityQuery("CloseTime > 10 and CloseTime <= 100 and (StartTime = 5 or StartTime = 6)")
+	s.NoError(err)
+	earliest, latest := query.TimeRange(definition.CloseTime)
+	s.Equal(int64(11), earliest)
+	s.Equal(int64(100), latest)
+	earliest, latest = query.TimeRange(definition.StartTime)
+	s.Equal(int64(0), earliest)
+	s.Equal(int64(math.MaxInt64), latest)
+
+	query, err = ParseVisibilityQuery("StartTime = '2021-03-10T10:30:00Z' and SearchPrecision = 'Hour'")
+	s.NoError(err)
+	s.Equal(PrecisionHour, query.SearchPrecision())
+	earliest, latest = query.TimeRange(definition.StartTime)
+	s.Equal(time.Date(2021, 3, 10, 10, 0, 0, 0, time.UTC).UnixNano(), earliest)
+	s.Equal(time.Date(2021, 3, 10, 11, 0, 0, 0, time.UTC).UnixNano()-1, latest)
+}
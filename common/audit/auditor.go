@@ -0,0 +1,271 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// auditor queues the entries and writes them to the sinks in the background so that slow sinks
+	// don't delay the requests, entries are dropped when the queue is full
+	auditor struct {
+		sinks      []Sink
+		logger     log.Logger
+		scope      metrics.Scope
+		timeSource clock.TimeSource
+
+		entryC   chan *Entry
+		stopC    chan struct{}
+		doneC    chan struct{}
+		stopOnce sync.Once
+	}
+
+	nopAuditor struct{}
+
+	workflowExecutionGetter interface {
+		GetWorkflowExecution() *types.WorkflowExecution
+	}
+
+	executionGetter interface {
+		GetExecution() *types.WorkflowExecution
+	}
+
+	workflowIDGetter interface {
+		GetWorkflowID() string
+	}
+)
+
+const defaultAuditQueueSize = 10000
+
+// NewAuditor creates the auditor writing to the sinks of the config,
+// the messaging client is only required by the kafka sink
+func NewAuditor(
+	cfg config.Audit,
+	messagingClient messaging.Client,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) (Auditor, error) {
+	if !cfg.Enable {
+		return NewNopAuditor(), nil
+	}
+
+	var sinks []Sink
+	if cfg.File != nil {
+		sink, err := NewFileSink(*cfg.File)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.Kafka != nil {
+		if messagingClient == nil {
+			return nil, fmt.Errorf("kafka audit sink requires kafka to be configured")
+		}
+		producer, err := messagingClient.NewProducer(cfg.Kafka.Application)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, NewKafkaSink(producer))
+	}
+	queueSize := cfg.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultAuditQueueSize
+	}
+	return newAuditor(sinks, queueSize, logger, metricsClient.Scope(metrics.AuditScope), clock.NewRealTimeSource()), nil
+}
+
+func newAuditor(
+	sinks []Sink,
+	queueSize int,
+	logger log.Logger,
+	scope metrics.Scope,
+	timeSource clock.TimeSource,
+) *auditor {
+	a := &auditor{
+		sinks:      sinks,
+		logger:     logger,
+		scope:      scope,
+		timeSource: timeSource,
+		entryC:     make(chan *Entry, queueSize),
+		stopC:      make(chan struct{}),
+		doneC:      make(chan struct{}),
+	}
+	go a.writeLoop()
+	return a
+}
+
+// NewNopAuditor creates an auditor which records nothing
+func NewNopAuditor() Auditor {
+	return &nopAuditor{}
+}
+
+func (a *auditor) Audit(
+	ctx context.Context,
+	attributes *authorization.Attributes,
+	result authorization.Result,
+	err error,
+) {
+	if attributes.Permission == authorization.PermissionRead {
+		return
+	}
+
+	entry := a.newEntry(ctx, attributes, result, err)
+	select {
+	case a.entryC <- entry:
+	default:
+		a.scope.IncCounter(metrics.AuditEntriesDroppedCounter)
+	}
+}
+
+func (a *auditor) Stop() {
+	a.stopOnce.Do(func() {
+		close(a.stopC)
+		<-a.doneC
+		for _, sink := range a.sinks {
+			if err := sink.Close(); err != nil {
+				a.logger.Error("Failed to close audit sink", tag.Error(err))
+			}
+		}
+	})
+}
+
+func (a *auditor) writeLoop() {
+	defer close(a.doneC)
+	for {
+		select {
+		case entry := <-a.entryC:
+			a.write(entry)
+		case <-a.stopC:
+			// write the entries queued before stopping
+			for {
+				select {
+				case entry := <-a.entryC:
+					a.write(entry)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (a *auditor) write(entry *Entry) {
+	for _, sink := range a.sinks {
+		if err := sink.Write(entry); err != nil {
+			a.scope.IncCounter(metrics.AuditWriteFailuresCounter)
+			a.logger.Error("Failed to write audit entry",
+				tag.Error(err),
+				tag.WorkflowDomainName(entry.DomainName),
+				tag.WorkflowID(entry.WorkflowID),
+				tag.Value(entry.APIName),
+			)
+		}
+	}
+}
+
+func (a *auditor) newEntry(
+	ctx context.Context,
+	attributes *authorization.Attributes,
+	result authorization.Result,
+	err error,
+) *Entry {
+	entry := &Entry{
+		Timestamp:   a.timeSource.Now(),
+		Actor:       result.Principal,
+		ActorSource: ActorSourcePrincipal,
+		APIName:     attributes.APIName,
+		DomainName:  attributes.DomainName,
+		Permission:  permissionString(attributes.Permission),
+	}
+	if entry.Actor == "" {
+		// the authorizer doesn't authenticate the caller, fall back to the unverified caller name
+		entry.Actor = yarpc.CallFromContext(ctx).Caller()
+		entry.ActorSource = ActorSourceCaller
+	}
+
+	switch {
+	case err != nil:
+		entry.Decision = DecisionError
+		entry.Error = err.Error()
+	case result.Decision == authorization.DecisionAllow:
+		entry.Decision = DecisionAllow
+	default:
+		entry.Decision = DecisionDeny
+	}
+
+	if attributes.RequestBody == nil {
+		return entry
+	}
+	switch request := attributes.RequestBody.(type) {
+	case workflowExecutionGetter:
+		entry.WorkflowID = request.GetWorkflowExecution().GetWorkflowID()
+		entry.RunID = request.GetWorkflowExecution().GetRunID()
+	case executionGetter:
+		entry.WorkflowID = request.GetExecution().GetWorkflowID()
+		entry.RunID = request.GetExecution().GetRunID()
+	case workflowIDGetter:
+		entry.WorkflowID = request.GetWorkflowID()
+	}
+	body, serializeErr := attributes.RequestBody.SerializeForLogging()
+	if serializeErr != nil {
+		a.logger.Warn("Failed to serialize request body for audit", tag.Error(serializeErr))
+	} else {
+		entry.RequestBody = body
+	}
+	return entry
+}
+
+func (a *nopAuditor) Audit(
+	ctx context.Context,
+	attributes *authorization.Attributes,
+	result authorization.Result,
+	err error,
+) {
+}
+
+func (a *nopAuditor) Stop() {}
+
+func permissionString(permission authorization.Permission) string {
+	switch permission {
+	case authorization.PermissionRead:
+		return "read"
+	case authorization.PermissionWrite:
+		return "write"
+	case authorization.PermissionAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
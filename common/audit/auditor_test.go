@@ -0,0 +1,202 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	auditorSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+		sink       *MockSink
+		scope      tally.TestScope
+		now        time.Time
+		auditor    *auditor
+	}
+)
+
+func TestAuditorSuite(t *testing.T) {
+	suite.Run(t, new(auditorSuite))
+}
+
+func (s *auditorSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.sink = NewMockSink(s.controller)
+	s.now = time.Unix(1700000000, 0).UTC()
+	s.scope = tally.NewTestScope("", nil)
+	s.auditor = newAuditor([]Sink{s.sink}, 10, loggerimpl.NewNopLogger(),
+		metrics.NewClient(s.scope, metrics.Frontend).Scope(metrics.AuditScope), clock.NewEventTimeSource().Update(s.now))
+}
+
+func (s *auditorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+// stopAuditor waits for the queued entries to be written
+func (s *auditorSuite) stopAuditor() {
+	s.sink.EXPECT().Close().Return(nil)
+	s.auditor.Stop()
+}
+
+func (s *auditorSuite) TestAudit() {
+	request := &types.TerminateWorkflowExecutionRequest{
+		Domain:            "samples-domain",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		Reason:            "cleanup",
+	}
+	body, err := request.SerializeForLogging()
+	s.NoError(err)
+
+	s.sink.EXPECT().Write(&Entry{
+		Timestamp:   s.now,
+		Actor:       "ops-team",
+		ActorSource: ActorSourcePrincipal,
+		APIName:     "TerminateWorkflowExecution",
+		DomainName:  "samples-domain",
+		WorkflowID:  "wid",
+		RunID:       "rid",
+		Permission:  "write",
+		Decision:    DecisionAllow,
+		RequestBody: body,
+	}).Return(nil)
+
+	// the authenticated principal is recorded rather than the caller name sent by the client
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequest(&transport.Request{Caller: "cadence-cli"}))
+	s.auditor.Audit(ctx, &authorization.Attributes{
+		APIName:     "TerminateWorkflowExecution",
+		DomainName:  "samples-domain",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: request,
+	}, authorization.Result{Decision: authorization.DecisionAllow, Principal: "ops-team"}, nil)
+	s.stopAuditor()
+}
+
+func (s *auditorSuite) TestAudit_DecisionAndActor() {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequest(&transport.Request{Caller: "cadence-cli"}))
+	request := &types.StartWorkflowExecutionRequest{Domain: "samples-domain", WorkflowID: "wid"}
+
+	s.sink.EXPECT().Write(gomock.Any()).DoAndReturn(func(entry *Entry) error {
+		s.Equal("cadence-cli", entry.Actor)
+		s.Equal(ActorSourceCaller, entry.ActorSource)
+		s.Equal("wid", entry.WorkflowID)
+		s.Equal(DecisionDeny, entry.Decision)
+		return nil
+	})
+	s.auditor.Audit(ctx, &authorization.Attributes{
+		APIName:     "StartWorkflowExecution",
+		DomainName:  "samples-domain",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: request,
+	}, authorization.Result{Decision: authorization.DecisionDeny}, nil)
+
+	s.sink.EXPECT().Write(gomock.Any()).DoAndReturn(func(entry *Entry) error {
+		s.Equal(DecisionError, entry.Decision)
+		s.Equal("authorizer failure", entry.Error)
+		s.Equal("admin", entry.Permission)
+		return nil
+	})
+	s.auditor.Audit(ctx, &authorization.Attributes{
+		APIName:    "CloseShard",
+		Permission: authorization.PermissionAdmin,
+	}, authorization.Result{Decision: authorization.DecisionDeny}, errors.New("authorizer failure"))
+	s.stopAuditor()
+}
+
+func (s *auditorSuite) TestAudit_ReadIgnored() {
+	s.auditor.Audit(context.Background(), &authorization.Attributes{
+		APIName:    "DescribeWorkflowExecution",
+		DomainName: "samples-domain",
+		Permission: authorization.PermissionRead,
+	}, authorization.Result{Decision: authorization.DecisionAllow}, nil)
+	s.stopAuditor()
+}
+
+func (s *auditorSuite) TestAudit_QueueFull() {
+	// the first entry blocks the sink, the next ones fill the queue and the last one is dropped
+	writeStarted := make(chan struct{})
+	unblockWrite := make(chan struct{})
+	s.sink.EXPECT().Write(gomock.Any()).DoAndReturn(func(entry *Entry) error {
+		close(writeStarted)
+		<-unblockWrite
+		return nil
+	})
+	s.sink.EXPECT().Write(gomock.Any()).Return(nil).Times(10)
+	attributes := &authorization.Attributes{APIName: "CloseShard", Permission: authorization.PermissionAdmin}
+	result := authorization.Result{Decision: authorization.DecisionAllow, Principal: "ops-team"}
+
+	s.auditor.Audit(context.Background(), attributes, result, nil)
+	<-writeStarted
+	for i := 0; i < 11; i++ {
+		s.auditor.Audit(context.Background(), attributes, result, nil)
+	}
+	s.Equal(int64(1), s.scope.Snapshot().Counters()["audit_entries_dropped+operation=Audit"].Value())
+
+	close(unblockWrite)
+	s.stopAuditor()
+}
+
+func (s *auditorSuite) TestAudit_SinkFailure() {
+	other := NewMockSink(s.controller)
+	s.auditor.sinks = append(s.auditor.sinks, other)
+
+	s.sink.EXPECT().Write(gomock.Any()).Return(errors.New("sink failure"))
+	other.EXPECT().Write(gomock.Any()).Return(nil)
+	s.auditor.Audit(context.Background(), &authorization.Attributes{
+		APIName:    "CloseShard",
+		Permission: authorization.PermissionAdmin,
+	}, authorization.Result{Decision: authorization.DecisionAllow}, nil)
+
+	other.EXPECT().Close().Return(nil)
+	s.stopAuditor()
+	s.Equal(int64(1), s.scope.Snapshot().Counters()["audit_write_failures+operation=Audit"].Value())
+}
+
+func (s *auditorSuite) TestNewAuditor() {
+	auditor, err := NewAuditor(config.Audit{}, nil, metrics.NewNoopMetricsClient(), loggerimpl.NewNopLogger())
+	s.NoError(err)
+	s.Equal(NewNopAuditor(), auditor)
+
+	_, err = NewAuditor(config.Audit{
+		Enable: true,
+		Kafka:  &config.AuditKafkaSink{Application: "audit"},
+	}, nil, metrics.NewNoopMetricsClient(), loggerimpl.NewNopLogger())
+	s.Error(err)
+}
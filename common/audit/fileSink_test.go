@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	fileSinkSuite struct {
+		suite.Suite
+		dir string
+	}
+)
+
+func TestFileSinkSuite(t *testing.T) {
+	suite.Run(t, new(fileSinkSuite))
+}
+
+func (s *fileSinkSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "auditFileSinkTest")
+	s.NoError(err)
+	s.dir = dir
+}
+
+func (s *fileSinkSuite) TearDownTest() {
+	s.NoError(os.RemoveAll(s.dir))
+}
+
+func (s *fileSinkSuite) TestWrite() {
+	path := filepath.Join(s.dir, "audit", "audit.log")
+	sink, err := NewFileSink(config.AuditFileSink{Path: path})
+	s.NoError(err)
+
+	s.NoError(sink.Write(&Entry{APIName: "TerminateWorkflowExecution", WorkflowID: "wid-1"}))
+	s.NoError(sink.Write(&Entry{APIName: "ResetWorkflowExecution", WorkflowID: "wid-2"}))
+	s.NoError(sink.Close())
+	s.Error(sink.Write(&Entry{APIName: "SignalWorkflowExecution"}))
+
+	// the file is appended when opened again
+	sink, err = NewFileSink(config.AuditFileSink{Path: path})
+	s.NoError(err)
+	s.NoError(sink.Write(&Entry{APIName: "SignalWorkflowExecution", WorkflowID: "wid-3"}))
+	s.NoError(sink.Close())
+
+	entries := s.readEntries(path)
+	s.Len(entries, 3)
+	s.Equal("TerminateWorkflowExecution", entries[0].APIName)
+	s.Equal("wid-2", entries[1].WorkflowID)
+	s.Equal("SignalWorkflowExecution", entries[2].APIName)
+}
+
+func (s *fileSinkSuite) TestRotate() {
+	path := filepath.Join(s.dir, "audit.log")
+	sink, err := NewFileSink(config.AuditFileSink{Path: path, MaxSizeMB: 1, MaxBackups: 2})
+	s.NoError(err)
+
+	// each entry takes more than a third of the max size
+	requestBody := strings.Repeat("x", bytesPerMB/3)
+	for _, workflowID := range []string{"wid-1", "wid-2", "wid-3", "wid-4", "wid-5", "wid-6", "wid-7"} {
+		s.NoError(sink.Write(&Entry{WorkflowID: workflowID, RequestBody: requestBody}))
+	}
+	s.NoError(sink.Close())
+
+	s.Equal([]string{"wid-7"}, s.readWorkflowIDs(path))
+	s.Equal([]string{"wid-5", "wid-6"}, s.readWorkflowIDs(backupPath(path, 1)))
+	s.Equal([]string{"wid-3", "wid-4"}, s.readWorkflowIDs(backupPath(path, 2)))
+	_, err = os.Stat(backupPath(path, 3))
+	s.True(os.IsNotExist(err))
+}
+
+func (s *fileSinkSuite) readWorkflowIDs(path string) []string {
+	var workflowIDs []string
+	for _, entry := range s.readEntries(path) {
+		workflowIDs = append(workflowIDs, entry.WorkflowID)
+	}
+	return workflowIDs
+}
+
+func (s *fileSinkSuite) readEntries(path string) []*Entry {
+	file, err := os.Open(path)
+	s.NoError(err)
+	defer file.Close()
+
+	var entries []*Entry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 2*bytesPerMB)
+	for scanner.Scan() {
+		entry := &Entry{}
+		s.NoError(json.Unmarshal(scanner.Bytes(), entry))
+		entries = append(entries, entry)
+	}
+	s.NoError(scanner.Err())
+	return entries
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination interface_mock.go -self_package github.com/uber/cadence/common/audit
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/authorization"
+)
+
+type (
+	// Auditor records the authorization decisions of the write and admin APIs
+	Auditor interface {
+		// Audit records the decision made for a request, read requests are ignored
+		Audit(ctx context.Context, attributes *authorization.Attributes, result authorization.Result, err error)
+		// Stop writes the queued entries and closes the sinks
+		Stop()
+	}
+
+	// Sink is the destination of the audit entries
+	Sink interface {
+		Write(entry *Entry) error
+		Close() error
+	}
+
+	// Entry is a record of the audit log
+	Entry struct {
+		Timestamp   time.Time `json:"timestamp"`
+		Actor       string    `json:"actor"`
+		ActorSource string    `json:"actorSource"`
+		APIName     string    `json:"apiName"`
+		DomainName  string    `json:"domainName,omitempty"`
+		WorkflowID  string    `json:"workflowID,omitempty"`
+		RunID       string    `json:"runID,omitempty"`
+		Permission  string    `json:"permission"`
+		Decision    string    `json:"decision"`
+		Error       string    `json:"error,omitempty"`
+		RequestBody string    `json:"requestBody,omitempty"`
+	}
+)
+
+// Decision values of the audit entries
+const (
+	DecisionAllow = "allow"
+	DecisionDeny  = "deny"
+	DecisionError = "error"
+)
+
+// ActorSource values of the audit entries
+const (
+	// ActorSourcePrincipal is set when the actor is the principal authenticated by the authorizer
+	ActorSourcePrincipal = "principal"
+	// ActorSourceCaller is set when the authorizer doesn't authenticate the caller, the actor is then
+	// the caller service name sent by the client which is not verified
+	ActorSourceCaller = "caller"
+)
@@ -64,6 +64,9 @@ type (
 	// Result is result from authority.
 	Result struct {
 		Decision Decision
+		// Principal is the caller identity authenticated by the authority, it's empty
+		// if the authority doesn't authenticate the caller
+		Principal string
 	}
 
 	// Decision is enum type for auth decision
@@ -71,6 +74,8 @@ type (
 
 	// Permission is enum type for auth permission
 	Permission int
+
+	principalContextKey struct{}
 )
 
 func NewPermission(permission string) Permission {
@@ -86,11 +91,54 @@ func NewPermission(permission string) Permission {
 	}
 }
 
+// roleBindingsAllow returns true if any of the principals is bound to a domain role granting the permission.
+// The admin role covers write and read permissions, the write role covers read permission.
+func roleBindingsAllow(bindings []*types.RoleBinding, principals []string, permission Permission) bool {
+	for _, binding := range bindings {
+		if binding.Role == nil || !roleGrants(*binding.Role, permission) {
+			continue
+		}
+		for _, principal := range principals {
+			if principal != "" && principal == binding.GetPrincipal() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func roleGrants(role types.DomainRole, permission Permission) bool {
+	switch role {
+	case types.DomainRoleAdmin:
+		return permission == PermissionRead || permission == PermissionWrite || permission == PermissionAdmin
+	case types.DomainRoleWrite:
+		return permission == PermissionRead || permission == PermissionWrite
+	case types.DomainRoleRead:
+		return permission == PermissionRead
+	default:
+		return false
+	}
+}
+
 // Authorizer is an interface for authorization
 type Authorizer interface {
 	Authorize(ctx context.Context, attributes *Attributes) (Result, error)
 }
 
+// ContextWithPrincipal returns a context carrying the principal authenticated for the request
+func ContextWithPrincipal(ctx context.Context, principal string) context.Context {
+	if principal == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal authenticated for the request, or empty if there is none
+func PrincipalFromContext(ctx context.Context) string {
+	principal, _ := ctx.Value(principalContextKey{}).(string)
+	return principal
+}
+
 func GetAuthProviderClient(privateKey string) (clientworker.AuthorizationProvider, error) {
 	pk, err := ioutil.ReadFile(privateKey)
 	if err != nil {
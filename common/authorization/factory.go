@@ -27,9 +27,20 @@ import (
 )
 
 func NewAuthorizer(authorization config.Authorization, logger log.Logger, domainCache cache.DomainCache) (Authorizer, error) {
+	authorizer, err := newAuthenticatingAuthorizer(authorization, logger, domainCache)
+	if err != nil || !authorization.PolicyAuthorizer.Enable {
+		return authorizer, err
+	}
+	// the policy rules are matched against the caller authenticated by the OAuth or the mTLS authorizer
+	return NewPolicyAuthorizer(authorization.PolicyAuthorizer, authorizer, logger)
+}
+
+func newAuthenticatingAuthorizer(authorization config.Authorization, logger log.Logger, domainCache cache.DomainCache) (Authorizer, error) {
 	switch true {
 	case authorization.OAuthAuthorizer.Enable:
 		return NewOAuthAuthorizer(authorization.OAuthAuthorizer, logger, domainCache)
+	case authorization.MTLSAuthorizer.Enable:
+		return NewMTLSAuthorizer(authorization.MTLSAuthorizer, logger, domainCache)
 	default:
 		return NewNopAuthorizer()
 	}
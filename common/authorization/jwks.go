@@ -0,0 +1,204 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	// jwksKeySet caches the RSA keys of a JSON Web Key Set endpoint by key ID.
+	// Keys are fetched again once the refresh interval is passed, or when a token is signed
+	// by an unknown key so rotated keys are picked up without waiting for the next refresh.
+	// Fetches happen outside of the lock and at most once per min refresh interval, the
+	// cached keys keep being served while the endpoint is unavailable.
+	jwksKeySet struct {
+		url             string
+		refreshInterval time.Duration
+		httpClient      *http.Client
+		timeSource      clock.TimeSource
+		logger          log.Logger
+
+		sync.Mutex
+		keys        map[string]*rsa.PublicKey
+		lastRefresh time.Time     // time of the last successful refresh
+		lastAttempt time.Time     // time of the last refresh attempt, successful or not
+		refreshDone chan struct{} // closed once the refresh in progress completes, nil if there is none
+		refreshErr  error         // error of the last refresh attempt
+	}
+
+	jsonWebKeySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	}
+)
+
+const (
+	defaultJWKSRefreshInterval = time.Hour
+	// jwksMinRefreshInterval limits how often the key set is fetched when it's
+	// outdated, when unknown key IDs are seen or when the endpoint is unavailable
+	jwksMinRefreshInterval = time.Minute
+	jwksRequestTimeout     = 10 * time.Second
+)
+
+func newJWKSKeySet(
+	url string,
+	refreshInterval time.Duration,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *jwksKeySet {
+	if refreshInterval <= 0 {
+		refreshInterval = defaultJWKSRefreshInterval
+	}
+	return &jwksKeySet{
+		url:             url,
+		refreshInterval: refreshInterval,
+		httpClient:      &http.Client{Timeout: jwksRequestTimeout},
+		timeSource:      timeSource,
+		logger:          logger,
+		keys:            make(map[string]*rsa.PublicKey),
+	}
+}
+
+// getKey returns the public key with the given key ID. A known key is returned right away
+// while an outdated key set is refreshed in the background, an unknown key waits for the
+// refresh it triggers.
+func (s *jwksKeySet) getKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	s.Lock()
+	key, ok := s.keys[kid]
+	now := s.timeSource.Now()
+	if s.refreshDone == nil && now.Sub(s.lastAttempt) >= jwksMinRefreshInterval &&
+		(!ok || now.Sub(s.lastRefresh) >= s.refreshInterval) {
+		s.lastAttempt = now
+		s.refreshDone = make(chan struct{})
+		go s.refresh(s.refreshDone)
+	}
+	refreshDone := s.refreshDone
+	s.Unlock()
+
+	if ok {
+		return key, nil
+	}
+	if refreshDone != nil {
+		select {
+		case <-refreshDone:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		s.Lock()
+		key, ok = s.keys[kid]
+		err := s.refreshErr
+		s.Unlock()
+		if !ok && err != nil {
+			return nil, err
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("key %q is not found in JWKS", kid)
+	}
+	return key, nil
+}
+
+func (s *jwksKeySet) refresh(done chan struct{}) {
+	defer close(done)
+	keys, err := s.fetch()
+
+	s.Lock()
+	defer s.Unlock()
+	s.refreshDone = nil
+	s.refreshErr = err
+	if err != nil {
+		// keep using the cached keys if the endpoint is unavailable
+		s.logger.Warn("Failed to refresh JWKS", tag.Error(err))
+		return
+	}
+	s.keys = keys
+	s.lastRefresh = s.timeSource.Now()
+}
+
+func (s *jwksKeySet) fetch() (map[string]*rsa.PublicKey, error) {
+	response, err := s.httpClient.Get(s.url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: unexpected status code %v", response.StatusCode)
+	}
+
+	var keySet jsonWebKeySet
+	if err := json.NewDecoder(response.Body).Decode(&keySet); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %v", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		// only RSA signing keys are supported
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := jwk.rsaPublicKey()
+		if err != nil {
+			// a malformed key only makes the tokens signed by it invalid
+			s.logger.Warn("Skipping invalid JWKS key", tag.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k *jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus of key %q: %v", k.Kid, err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent of key %q: %v", k.Kid, err)
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > int64(^uint32(0)>>1) || exponent.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid exponent of key %q", k.Kid)
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	jwksSuite struct {
+		suite.Suite
+		publicKey  *rsa.PublicKey
+		timeSource *clock.EventTimeSource
+	}
+
+	testJWKSServer struct {
+		*httptest.Server
+		kids     atomic.Value
+		requests int32
+	}
+)
+
+func TestJWKSSuite(t *testing.T) {
+	suite.Run(t, new(jwksSuite))
+}
+
+func (s *jwksSuite) SetupTest() {
+	var err error
+	s.publicKey, err = common.LoadRSAPublicKey("../../config/credentials/keytest.pub")
+	s.NoError(err)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+}
+
+func (s *jwksSuite) TestGetKey() {
+	server := newTestJWKSServer(s.publicKey, "key1")
+	defer server.Close()
+	keySet := newJWKSKeySet(server.URL, time.Hour, s.timeSource, loggerimpl.NewNopLogger())
+
+	key, err := keySet.getKey(context.Background(), "key1")
+	s.NoError(err)
+	s.Equal(s.publicKey, key)
+
+	key, err = keySet.getKey(context.Background(), "key1")
+	s.NoError(err)
+	s.Equal(s.publicKey, key)
+	s.Equal(int32(1), atomic.LoadInt32(&server.requests))
+
+	// the cached key is served while the key set is refreshed in the background
+	s.timeSource.Update(s.timeSource.Now().Add(time.Hour))
+	_, err = keySet.getKey(context.Background(), "key1")
+	s.NoError(err)
+	s.Eventually(func() bool {
+		return atomic.LoadInt32(&server.requests) == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *jwksSuite) TestGetKey_Rotation() {
+	server := newTestJWKSServer(s.publicKey, "key1")
+	defer server.Close()
+	keySet := newJWKSKeySet(server.URL, time.Hour, s.timeSource, loggerimpl.NewNopLogger())
+
+	_, err := keySet.getKey(context.Background(), "key1")
+	s.NoError(err)
+
+	server.kids.Store([]string{"key2"})
+	// unknown keys don't trigger a refresh more than once per min refresh interval
+	_, err = keySet.getKey(context.Background(), "key2")
+	s.Error(err)
+	s.Equal(int32(1), atomic.LoadInt32(&server.requests))
+
+	s.timeSource.Update(s.timeSource.Now().Add(jwksMinRefreshInterval))
+	key, err := keySet.getKey(context.Background(), "key2")
+	s.NoError(err)
+	s.Equal(s.publicKey, key)
+	s.Equal(int32(2), atomic.LoadInt32(&server.requests))
+
+	_, err = keySet.getKey(context.Background(), "key1")
+	s.Error(err)
+}
+
+func (s *jwksSuite) TestGetKey_EndpointUnavailable() {
+	server := newTestJWKSServer(s.publicKey, "key1")
+	keySet := newJWKSKeySet(server.URL, time.Hour, s.timeSource, loggerimpl.NewNopLogger())
+	_, err := keySet.getKey(context.Background(), "key1")
+	s.NoError(err)
+	server.Close()
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Hour))
+	key, err := keySet.getKey(context.Background(), "key1")
+	s.NoError(err)
+	s.Equal(s.publicKey, key)
+
+	_, err = keySet.getKey(context.Background(), "key2")
+	s.Error(err)
+}
+
+func (s *jwksSuite) TestGetKey_FailedRefreshIsNotRetriedRightAway() {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	keySet := newJWKSKeySet(server.URL, time.Hour, s.timeSource, loggerimpl.NewNopLogger())
+
+	_, err := keySet.getKey(context.Background(), "key1")
+	s.Error(err)
+	_, err = keySet.getKey(context.Background(), "key1")
+	s.Error(err)
+	s.Equal(int32(1), atomic.LoadInt32(&requests))
+
+	s.timeSource.Update(s.timeSource.Now().Add(jwksMinRefreshInterval))
+	_, err = keySet.getKey(context.Background(), "key1")
+	s.Error(err)
+	s.Equal(int32(2), atomic.LoadInt32(&requests))
+}
+
+func (s *jwksSuite) TestGetKey_InvalidKeyIsSkipped() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jsonWebKeySet{Keys: []jsonWebKey{
+			{Kty: "RSA", Kid: "invalid", N: "invalid!", E: "AQAB"},
+			{
+				Kty: "RSA",
+				Kid: "key1",
+				N:   base64.RawURLEncoding.EncodeToString(s.publicKey.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.publicKey.E)).Bytes()),
+			},
+		}})
+	}))
+	defer server.Close()
+	keySet := newJWKSKeySet(server.URL, time.Hour, s.timeSource, loggerimpl.NewNopLogger())
+
+	key, err := keySet.getKey(context.Background(), "key1")
+	s.NoError(err)
+	s.Equal(s.publicKey, key)
+	_, err = keySet.getKey(context.Background(), "invalid")
+	s.Error(err)
+}
+
+func (s *jwksSuite) TestRSAPublicKey_Invalid() {
+	_, err := (&jsonWebKey{Kty: "RSA", N: "invalid!", E: "AQAB"}).rsaPublicKey()
+	s.Error(err)
+	_, err = (&jsonWebKey{Kty: "RSA", N: "AQAB", E: ""}).rsaPublicKey()
+	s.Error(err)
+}
+
+func newTestJWKSServer(key *rsa.PublicKey, kids ...string) *testJWKSServer {
+	server := &testJWKSServer{}
+	server.kids.Store(kids)
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&server.requests, 1)
+		keySet := jsonWebKeySet{}
+		for _, kid := range server.kids.Load().([]string) {
+			keySet.Keys = append(keySet.Keys, jsonWebKey{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(keySet)
+	}))
+	return server
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"path"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	mtlsAuthority struct {
+		identities  []mtlsIdentity
+		domainCache cache.DomainCache
+		log         log.Logger
+	}
+
+	mtlsIdentity struct {
+		identity   string
+		domains    []string
+		permission Permission
+	}
+)
+
+// NewMTLSAuthorizer creates an authorizer granting permissions to the identities of the verified client certificates.
+// Identities not granted by the configuration may still be bound to a role in the domain configuration.
+// Only gRPC inbounds terminate TLS, requests received on TChannel carry no certificate and are denied.
+func NewMTLSAuthorizer(
+	authorizationCfg config.MTLSAuthorizer,
+	log log.Logger,
+	domainCache cache.DomainCache,
+) (Authorizer, error) {
+	identities := make([]mtlsIdentity, 0, len(authorizationCfg.Identities))
+	for _, identity := range authorizationCfg.Identities {
+		permission := NewPermission(identity.Permission)
+		if permission < 0 {
+			return nil, fmt.Errorf("invalid permission %q of identity %v", identity.Permission, identity.Identity)
+		}
+		for _, pattern := range append([]string{identity.Identity}, identity.Domains...) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid pattern %q of identity %v", pattern, identity.Identity)
+			}
+		}
+		identities = append(identities, mtlsIdentity{
+			identity:   identity.Identity,
+			domains:    identity.Domains,
+			permission: permission,
+		})
+	}
+	return &mtlsAuthority{
+		identities:  identities,
+		domainCache: domainCache,
+		log:         log,
+	}, nil
+}
+
+// Authorize allows the request if any identity of the peer certificate has the permission on the domain
+func (a *mtlsAuthority) Authorize(
+	ctx context.Context,
+	attributes *Attributes,
+) (Result, error) {
+	cert := getPeerCertificate(ctx)
+	if cert == nil {
+		a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("no verified client certificate")))
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	certIdentities := getCertificateIdentities(cert)
+	for _, identity := range a.identities {
+		if !identity.allows(attributes) {
+			continue
+		}
+		for _, certIdentity := range certIdentities {
+			if matchPatterns([]string{identity.identity}, certIdentity) {
+				return Result{Decision: DecisionAllow, Principal: certIdentity}, nil
+			}
+		}
+	}
+	if attributes.DomainName != "" {
+		domain, err := a.domainCache.GetDomain(attributes.DomainName)
+		if err != nil {
+			return Result{Decision: DecisionDeny}, err
+		}
+		if roleBindingsAllow(domain.GetConfig().RoleBindings, certIdentities, attributes.Permission) {
+			return Result{Decision: DecisionAllow, Principal: certIdentities[0]}, nil
+		}
+	}
+	a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("certificate identities %v don't have %v permission", certIdentities, attributes.Permission)))
+	return Result{Decision: DecisionDeny}, nil
+}
+
+// allows returns true if the permission granted to the identity covers the request
+func (i *mtlsIdentity) allows(attributes *Attributes) bool {
+	switch i.permission {
+	case PermissionAdmin:
+		return true
+	case PermissionWrite:
+		if attributes.Permission != PermissionRead && attributes.Permission != PermissionWrite {
+			return false
+		}
+	case PermissionRead:
+		if attributes.Permission != PermissionRead {
+			return false
+		}
+	}
+	return matchPatterns(i.domains, attributes.DomainName)
+}
+
+// getPeerCertificate returns the leaf certificate the peer of a gRPC call was verified with, if any
+func getPeerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return tlsInfo.State.VerifiedChains[0][0]
+}
+
+// getCertificateIdentities returns the URI SANs (including the SPIFFE ID), the DNS SANs and the common name
+func getCertificateIdentities(cert *x509.Certificate) []string {
+	var identities []string
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+	identities = append(identities, cert.DNSNames...)
+	if cert.Subject.CommonName != "" {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+	return identities
+}
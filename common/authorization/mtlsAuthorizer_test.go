@@ -0,0 +1,195 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	mtlsSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+		authorizer Authorizer
+	}
+)
+
+func TestMTLSSuite(t *testing.T) {
+	suite.Run(t, new(mtlsSuite))
+}
+
+func (s *mtlsSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	domainCache := cache.NewMockDomainCache(s.controller)
+	domainCache.EXPECT().GetDomain(gomock.Any()).DoAndReturn(func(name string) (*cache.DomainCacheEntry, error) {
+		config := &persistence.DomainConfig{Retention: 1}
+		if name == "team-b-payments" {
+			config.RoleBindings = []*types.RoleBinding{
+				{Principal: "spiffe://example.org/team-a/worker", Role: types.DomainRoleRead.Ptr()},
+			}
+		}
+		return cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{Name: name}, config, "active"), nil
+	}).AnyTimes()
+	authorizer, err := NewMTLSAuthorizer(config.MTLSAuthorizer{
+		Enable: true,
+		Identities: []config.MTLSIdentity{
+			{Identity: "spiffe://example.org/team-a/*", Domains: []string{"team-a-*"}, Permission: "write"},
+			{Identity: "reader.example.org", Domains: []string{"samples-domain"}, Permission: "read"},
+			{Identity: "cadence-admin", Permission: "admin"},
+		},
+	}, loggerimpl.NewNopLogger(), domainCache)
+	s.NoError(err)
+	s.authorizer = authorizer
+}
+
+func (s *mtlsSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *mtlsSuite) TestAuthorize() {
+	teamA := &x509.Certificate{URIs: []*url.URL{{Scheme: "spiffe", Host: "example.org", Path: "/team-a/worker"}}}
+	reader := &x509.Certificate{DNSNames: []string{"reader.example.org"}}
+	admin := &x509.Certificate{Subject: pkix.Name{CommonName: "cadence-admin"}}
+	unknown := &x509.Certificate{Subject: pkix.Name{CommonName: "unknown"}}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		att      Attributes
+		expected Decision
+	}{
+		{
+			name:     "spiffe id writes in its domain",
+			ctx:      newPeerContext(teamA, true),
+			att:      Attributes{APIName: "SignalWorkflowExecution", DomainName: "team-a-payments", Permission: PermissionWrite},
+			expected: DecisionAllow,
+		},
+		{
+			name:     "write includes read",
+			ctx:      newPeerContext(teamA, true),
+			att:      Attributes{APIName: "DescribeWorkflowExecution", DomainName: "team-a-payments", Permission: PermissionRead},
+			expected: DecisionAllow,
+		},
+		{
+			name:     "spiffe id in other domain",
+			ctx:      newPeerContext(teamA, true),
+			att:      Attributes{APIName: "SignalWorkflowExecution", DomainName: "team-b-payments", Permission: PermissionWrite},
+			expected: DecisionDeny,
+		},
+		{
+			name:     "spiffe id bound to read role in other domain",
+			ctx:      newPeerContext(teamA, true),
+			att:      Attributes{APIName: "DescribeWorkflowExecution", DomainName: "team-b-payments", Permission: PermissionRead},
+			expected: DecisionAllow,
+		},
+		{
+			name:     "write excludes admin",
+			ctx:      newPeerContext(teamA, true),
+			att:      Attributes{APIName: "UpdateDomain", DomainName: "team-a-payments", Permission: PermissionAdmin},
+			expected: DecisionDeny,
+		},
+		{
+			name:     "dns name reads",
+			ctx:      newPeerContext(reader, true),
+			att:      Attributes{APIName: "DescribeWorkflowExecution", DomainName: "samples-domain", Permission: PermissionRead},
+			expected: DecisionAllow,
+		},
+		{
+			name:     "read excludes write",
+			ctx:      newPeerContext(reader, true),
+			att:      Attributes{APIName: "SignalWorkflowExecution", DomainName: "samples-domain", Permission: PermissionWrite},
+			expected: DecisionDeny,
+		},
+		{
+			name:     "common name admin",
+			ctx:      newPeerContext(admin, true),
+			att:      Attributes{APIName: "UpdateDomain", DomainName: "any-domain", Permission: PermissionAdmin},
+			expected: DecisionAllow,
+		},
+		{
+			name:     "unknown identity",
+			ctx:      newPeerContext(unknown, true),
+			att:      Attributes{APIName: "DescribeWorkflowExecution", DomainName: "samples-domain", Permission: PermissionRead},
+			expected: DecisionDeny,
+		},
+		{
+			name:     "unverified certificate",
+			ctx:      newPeerContext(admin, false),
+			att:      Attributes{APIName: "UpdateDomain", DomainName: "any-domain", Permission: PermissionAdmin},
+			expected: DecisionDeny,
+		},
+		{
+			name:     "no peer",
+			ctx:      context.Background(),
+			att:      Attributes{APIName: "UpdateDomain", DomainName: "any-domain", Permission: PermissionAdmin},
+			expected: DecisionDeny,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			result, err := s.authorizer.Authorize(test.ctx, &test.att)
+			s.NoError(err)
+			s.Equal(test.expected, result.Decision)
+			if test.expected == DecisionAllow {
+				s.NotEmpty(result.Principal)
+			} else {
+				s.Empty(result.Principal)
+			}
+		})
+	}
+}
+
+func (s *mtlsSuite) TestNewMTLSAuthorizer_Invalid() {
+	_, err := NewMTLSAuthorizer(config.MTLSAuthorizer{
+		Identities: []config.MTLSIdentity{{Identity: "[", Permission: "read"}},
+	}, loggerimpl.NewNopLogger(), nil)
+	s.Error(err)
+
+	_, err = NewMTLSAuthorizer(config.MTLSAuthorizer{
+		Identities: []config.MTLSIdentity{{Identity: "worker", Permission: "execute"}},
+	}, loggerimpl.NewNopLogger(), nil)
+	s.Error(err)
+}
+
+func newPeerContext(cert *x509.Certificate, verified bool) context.Context {
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	if verified {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
@@ -33,9 +33,11 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
 )
 
 type oauthAuthority struct {
@@ -43,6 +45,8 @@ type oauthAuthority struct {
 	domainCache      cache.DomainCache
 	log              log.Logger
 	publicKey        *rsa.PublicKey
+	keySet           *jwksKeySet
+	timeSource       clock.TimeSource
 }
 
 type JWTClaims struct {
@@ -54,7 +58,13 @@ type JWTClaims struct {
 	TTL    int64
 }
 
-const groupSeparator = " "
+const (
+	groupSeparator = " "
+
+	defaultGroupsAttributePath = "Groups"
+	defaultAdminAttributePath  = "Admin"
+	attributePathSeparator     = "."
+)
 
 // NewOAuthAuthorizer creates a oauth authority
 func NewOAuthAuthorizer(
@@ -62,6 +72,16 @@ func NewOAuthAuthorizer(
 	log log.Logger,
 	domainCache cache.DomainCache,
 ) (Authorizer, error) {
+	if provider := authorizationCfg.Provider; provider != nil {
+		timeSource := clock.NewRealTimeSource()
+		return &oauthAuthority{
+			authorizationCfg: authorizationCfg,
+			domainCache:      domainCache,
+			log:              log,
+			keySet:           newJWKSKeySet(provider.JWKSURL, provider.JWKSRefreshInterval, timeSource, log),
+			timeSource:       timeSource,
+		}, nil
+	}
 	publicKey, err := common.LoadRSAPublicKey(authorizationCfg.JwtCredentials.PublicKey)
 	if err != nil {
 		return nil, err
@@ -80,39 +100,54 @@ func (a *oauthAuthority) Authorize(
 	attributes *Attributes,
 ) (Result, error) {
 	call := yarpc.CallFromContext(ctx)
-	verifier, err := a.getVerifier()
-	if err != nil {
-		return Result{Decision: DecisionDeny}, err
-	}
-	token := call.Header(common.AuthorizationTokenHeaderName)
-	if token == "" {
-		a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("token is not set in header")))
-		return Result{Decision: DecisionDeny}, nil
-	}
-	claims, err := a.parseToken(token, verifier)
-	if err != nil {
-		a.log.Debug("request is not authorized", tag.Error(err))
-		return Result{Decision: DecisionDeny}, nil
-	}
-	err = a.validateTTL(claims)
-	if err != nil {
-		a.log.Debug("request is not authorized", tag.Error(err))
-		return Result{Decision: DecisionDeny}, nil
+	var claims *JWTClaims
+	if a.keySet != nil {
+		token := call.Header(common.AuthorizationTokenHeaderName)
+		if token == "" {
+			a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("token is not set in header")))
+			return Result{Decision: DecisionDeny}, nil
+		}
+		providerClaims, err := a.parseProviderToken(ctx, token)
+		if err != nil {
+			a.log.Debug("request is not authorized", tag.Error(err))
+			return Result{Decision: DecisionDeny}, nil
+		}
+		claims = providerClaims
+	} else {
+		verifier, err := a.getVerifier()
+		if err != nil {
+			return Result{Decision: DecisionDeny}, err
+		}
+		token := call.Header(common.AuthorizationTokenHeaderName)
+		if token == "" {
+			a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("token is not set in header")))
+			return Result{Decision: DecisionDeny}, nil
+		}
+		claims, err = a.parseToken(token, verifier)
+		if err != nil {
+			a.log.Debug("request is not authorized", tag.Error(err))
+			return Result{Decision: DecisionDeny}, nil
+		}
+		err = a.validateTTL(claims)
+		if err != nil {
+			a.log.Debug("request is not authorized", tag.Error(err))
+			return Result{Decision: DecisionDeny}, nil
+		}
 	}
 	if claims.Admin {
-		return Result{Decision: DecisionAllow}, nil
+		return Result{Decision: DecisionAllow, Principal: claims.Sub}, nil
 	}
 	domain, err := a.domainCache.GetDomain(attributes.DomainName)
 	if err != nil {
 		return Result{Decision: DecisionDeny}, err
 	}
 
-	err = a.validatePermission(claims, attributes, domain.GetInfo().Data)
+	err = a.validatePermission(claims, attributes, domain.GetConfig().RoleBindings, domain.GetInfo().Data)
 	if err != nil {
 		a.log.Debug("request is not authorized", tag.Error(err))
 		return Result{Decision: DecisionDeny}, nil
 	}
-	return Result{Decision: DecisionAllow}, nil
+	return Result{Decision: DecisionAllow, Principal: claims.Sub}, nil
 }
 
 func (a *oauthAuthority) getVerifier() (jwt.Verifier, error) {
@@ -135,6 +170,116 @@ func (a *oauthAuthority) parseToken(tokenStr string, verifier jwt.Verifier) (*JW
 	return &claims, nil
 }
 
+// parseProviderToken verifies the token with the key of the identity provider it is signed by,
+// validates the registered claims and maps the configured claims to groups and admin permission
+func (a *oauthAuthority) parseProviderToken(ctx context.Context, tokenStr string) (*JWTClaims, error) {
+	token, err := jwt.ParseString(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	header := token.Header()
+	key, err := a.keySet.getKey(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := jwt.NewVerifierRS(header.Algorithm, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifier.Verify(token.Payload(), token.Signature()); err != nil {
+		return nil, err
+	}
+
+	var registeredClaims jwt.RegisteredClaims
+	if err := json.Unmarshal(token.RawClaims(), &registeredClaims); err != nil {
+		return nil, err
+	}
+	if err := a.validateRegisteredClaims(&registeredClaims); err != nil {
+		return nil, err
+	}
+	var rawClaims map[string]interface{}
+	if err := json.Unmarshal(token.RawClaims(), &rawClaims); err != nil {
+		return nil, err
+	}
+
+	provider := a.authorizationCfg.Provider
+	groupsAttributePath := provider.GroupsAttributePath
+	if groupsAttributePath == "" {
+		groupsAttributePath = defaultGroupsAttributePath
+	}
+	adminAttributePath := provider.AdminAttributePath
+	if adminAttributePath == "" {
+		adminAttributePath = defaultAdminAttributePath
+	}
+	groups := getGroupsClaim(rawClaims, groupsAttributePath)
+	admin, _ := getClaim(rawClaims, adminAttributePath).(bool)
+	for _, group := range groups {
+		for _, adminGroup := range provider.AdminGroups {
+			if group == adminGroup {
+				admin = true
+			}
+		}
+	}
+	name, _ := rawClaims["name"].(string)
+	return &JWTClaims{
+		Sub:    registeredClaims.Subject,
+		Name:   name,
+		Groups: strings.Join(groups, groupSeparator),
+		Admin:  admin,
+	}, nil
+}
+
+func (a *oauthAuthority) validateRegisteredClaims(claims *jwt.RegisteredClaims) error {
+	provider := a.authorizationCfg.Provider
+	if provider.Issuer != "" && !claims.IsIssuer(provider.Issuer) {
+		return fmt.Errorf("JWT issuer %q is not allowed", claims.Issuer)
+	}
+	if provider.Audience != "" && !claims.IsForAudience(provider.Audience) {
+		return fmt.Errorf("JWT audience %v is not allowed", claims.Audience)
+	}
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("JWT has no expiration time")
+	}
+	if !claims.IsValidAt(a.timeSource.Now()) {
+		return fmt.Errorf("JWT has expired or is not valid yet")
+	}
+	if claims.IssuedAt != nil && claims.ExpiresAt.Unix()-claims.IssuedAt.Unix() > a.authorizationCfg.MaxJwtTTL {
+		return fmt.Errorf("TTL in token is larger than MaxTTL allowed")
+	}
+	return nil
+}
+
+// getClaim returns the claim at the dot separated path, or nil if it doesn't exist
+func getClaim(claims map[string]interface{}, path string) interface{} {
+	var value interface{} = claims
+	for _, key := range strings.Split(path, attributePathSeparator) {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+// getGroupsClaim returns the groups in a claim which is either a space separated string or an array of strings
+func getGroupsClaim(claims map[string]interface{}, path string) []string {
+	switch value := getClaim(claims, path).(type) {
+	case string:
+		return strings.Fields(value)
+	case []interface{}:
+		groups := make([]string, 0, len(value))
+		for _, group := range value {
+			if groupStr, ok := group.(string); ok {
+				groups = append(groups, groupStr)
+			}
+		}
+		return groups
+	default:
+		return nil
+	}
+}
+
 func (a *oauthAuthority) validateTTL(claims *JWTClaims) error {
 	if claims.TTL > a.authorizationCfg.MaxJwtTTL {
 		return fmt.Errorf("TTL in token is larger than MaxTTL allowed")
@@ -145,7 +290,19 @@ func (a *oauthAuthority) validateTTL(claims *JWTClaims) error {
 	return nil
 }
 
-func (a *oauthAuthority) validatePermission(claims *JWTClaims, attributes *Attributes, data map[string]string) error {
+func (a *oauthAuthority) validatePermission(
+	claims *JWTClaims,
+	attributes *Attributes,
+	roleBindings []*types.RoleBinding,
+	data map[string]string,
+) error {
+	// role bindings of the domain configuration are matched against the subject and the groups of the token
+	principals := append(strings.Split(claims.Groups, groupSeparator), claims.Sub)
+	if roleBindingsAllow(roleBindings, principals, attributes.Permission) {
+		return nil
+	}
+
+	// fall back to the groups stored in domain data
 	groups := ""
 	switch attributes.Permission {
 	case PermissionRead:
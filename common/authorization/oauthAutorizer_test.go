@@ -21,8 +21,10 @@
 package authorization
 
 import (
+	"crypto/rsa"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cristalhq/jwt/v3"
 	"github.com/golang/mock/gomock"
@@ -39,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -121,6 +124,63 @@ func (s *oauthSuite) TestCorrectPayload() {
 	result, err := authorizer.Authorize(s.ctx, &s.att)
 	s.NoError(err)
 	s.Equal(result.Decision, DecisionAllow)
+	s.Equal("1234567890", result.Principal)
+}
+
+func (s *oauthSuite) TestRoleBindings() {
+	testCases := []struct {
+		msg          string
+		roleBindings []*types.RoleBinding
+		permission   Permission
+		decision     Decision
+	}{
+		{
+			msg:          "group bound to write role can write",
+			roleBindings: []*types.RoleBinding{{Principal: "b", Role: types.DomainRoleWrite.Ptr()}},
+			permission:   PermissionWrite,
+			decision:     DecisionAllow,
+		},
+		{
+			msg:          "subject bound to admin role has admin permission",
+			roleBindings: []*types.RoleBinding{{Principal: "1234567890", Role: types.DomainRoleAdmin.Ptr()}},
+			permission:   PermissionAdmin,
+			decision:     DecisionAllow,
+		},
+		{
+			msg:          "group bound to read role can't write",
+			roleBindings: []*types.RoleBinding{{Principal: "a", Role: types.DomainRoleRead.Ptr()}},
+			permission:   PermissionWrite,
+			decision:     DecisionDeny,
+		},
+		{
+			msg:          "bindings of other principals don't grant permissions",
+			roleBindings: []*types.RoleBinding{{Principal: "d", Role: types.DomainRoleAdmin.Ptr()}},
+			permission:   PermissionWrite,
+			decision:     DecisionDeny,
+		},
+		{
+			msg:          "groups in domain data are still honored",
+			roleBindings: []*types.RoleBinding{{Principal: "d", Role: types.DomainRoleAdmin.Ptr()}},
+			permission:   PermissionRead,
+			decision:     DecisionAllow,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			s.domainEntry.GetConfig().RoleBindings = tc.roleBindings
+			s.domainCache.EXPECT().GetDomain(s.att.DomainName).Return(s.domainEntry, nil).Times(1)
+			if tc.decision == DecisionDeny {
+				s.logger.On("Debug", "request is not authorized", mock.Anything).Once()
+			}
+			authorizer, err := NewOAuthAuthorizer(s.cfg, s.logger, s.domainCache)
+			s.NoError(err)
+			att := s.att
+			att.Permission = tc.permission
+			result, err := authorizer.Authorize(s.ctx, &att)
+			s.NoError(err)
+			s.Equal(tc.decision, result.Decision)
+		})
+	}
 }
 
 func (s *oauthSuite) TestItIsAdmin() {
@@ -250,3 +310,142 @@ func (s *oauthSuite) TestIncorrectPermission() {
 	s.NoError(err)
 	s.Equal(result.Decision, DecisionDeny)
 }
+
+func (s *oauthSuite) TestProviderCorrectPayload() {
+	server := newTestJWKSServer(s.loadPublicKey(), "key1")
+	defer server.Close()
+	s.cfg.Provider = &config.OAuthProvider{
+		JWKSURL:             server.URL,
+		Issuer:              "https://idp.example.com",
+		Audience:            "cadence",
+		GroupsAttributePath: "realm_access.roles",
+	}
+	s.domainCache.EXPECT().GetDomain(s.att.DomainName).Return(s.domainEntry, nil).Times(1)
+	authorizer, err := NewOAuthAuthorizer(s.cfg, s.logger, s.domainCache)
+	s.NoError(err)
+
+	ctx := s.newProviderContext("key1", s.providerClaims(map[string]interface{}{
+		"realm_access": map[string]interface{}{"roles": []string{"a", "c"}},
+	}))
+	result, err := authorizer.Authorize(ctx, &s.att)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *oauthSuite) TestProviderAdminGroup() {
+	server := newTestJWKSServer(s.loadPublicKey(), "key1")
+	defer server.Close()
+	s.cfg.Provider = &config.OAuthProvider{
+		JWKSURL:     server.URL,
+		AdminGroups: []string{"cadence-admins"},
+	}
+	authorizer, err := NewOAuthAuthorizer(s.cfg, s.logger, s.domainCache)
+	s.NoError(err)
+
+	ctx := s.newProviderContext("key1", s.providerClaims(map[string]interface{}{
+		"Groups": "a cadence-admins",
+	}))
+	result, err := authorizer.Authorize(ctx, &s.att)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *oauthSuite) TestProviderInvalidToken() {
+	server := newTestJWKSServer(s.loadPublicKey(), "key1")
+	defer server.Close()
+	s.cfg.Provider = &config.OAuthProvider{
+		JWKSURL:  server.URL,
+		Issuer:   "https://idp.example.com",
+		Audience: "cadence",
+	}
+	authorizer, err := NewOAuthAuthorizer(s.cfg, s.logger, s.domainCache)
+	s.NoError(err)
+
+	now := time.Now().Unix()
+	testCases := []struct {
+		kid    string
+		claims map[string]interface{}
+		err    string
+	}{
+		{
+			kid:    "key2",
+			claims: s.providerClaims(nil),
+			err:    `key "key2" is not found in JWKS`,
+		},
+		{
+			kid:    "key1",
+			claims: s.providerClaims(map[string]interface{}{"iss": "https://another-idp.example.com"}),
+			err:    `JWT issuer "https://another-idp.example.com" is not allowed`,
+		},
+		{
+			kid:    "key1",
+			claims: s.providerClaims(map[string]interface{}{"aud": []string{"another-service"}}),
+			err:    "JWT audience [another-service] is not allowed",
+		},
+		{
+			kid:    "key1",
+			claims: s.providerClaims(map[string]interface{}{"exp": now - 60}),
+			err:    "JWT has expired or is not valid yet",
+		},
+		{
+			kid:    "key1",
+			claims: s.providerClaims(map[string]interface{}{"exp": nil}),
+			err:    "JWT has no expiration time",
+		},
+		{
+			kid:    "key1",
+			claims: s.providerClaims(map[string]interface{}{"iat": now - 2*s.cfg.MaxJwtTTL}),
+			err:    "TTL in token is larger than MaxTTL allowed",
+		},
+	}
+	for _, tc := range testCases {
+		expectedErr := tc.err
+		s.logger.On("Debug", "request is not authorized", mock.MatchedBy(func(t []tag.Tag) bool {
+			return fmt.Sprintf("%v", t[0].Field().Interface) == expectedErr
+		})).Once()
+		result, err := authorizer.Authorize(s.newProviderContext(tc.kid, tc.claims), &s.att)
+		s.NoError(err)
+		s.Equal(DecisionDeny, result.Decision)
+	}
+}
+
+func (s *oauthSuite) loadPublicKey() *rsa.PublicKey {
+	publicKey, err := common.LoadRSAPublicKey("../../config/credentials/keytest.pub")
+	s.NoError(err)
+	return publicKey
+}
+
+func (s *oauthSuite) providerClaims(overrides map[string]interface{}) map[string]interface{} {
+	now := time.Now().Unix()
+	claims := map[string]interface{}{
+		"iss": "https://idp.example.com",
+		"aud": "cadence",
+		"sub": "1234567890",
+		"iat": now,
+		"exp": now + 60,
+	}
+	for key, value := range overrides {
+		if value == nil {
+			delete(claims, key)
+			continue
+		}
+		claims[key] = value
+	}
+	return claims
+}
+
+func (s *oauthSuite) newProviderContext(kid string, claims map[string]interface{}) context.Context {
+	privateKey, err := common.LoadRSAPrivateKey("../../config/credentials/keytest")
+	s.NoError(err)
+	signer, err := jwt.NewSignerRS(jwt.RS256, privateKey)
+	s.NoError(err)
+	token, err := jwt.NewBuilder(signer, jwt.WithKeyID(kid)).Build(claims)
+	s.NoError(err)
+
+	ctx, call := encoding.NewInboundCall(context.Background())
+	err = call.ReadFromRequest(&transport.Request{
+		Headers: transport.NewHeaders().With(common.AuthorizationTokenHeaderName, token.String()),
+	})
+	s.NoError(err)
+	return ctx
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	policyAuthority struct {
+		// authenticator authenticates the caller and must allow the request before the rules are checked
+		authenticator Authorizer
+		policyFile    string
+		pollInterval  time.Duration
+		log           log.Logger
+		timeSource    clock.TimeSource
+
+		sync.Mutex
+		policy       *policy
+		lastChecked  time.Time
+		lastModified time.Time
+	}
+
+	// policy is the content of the policy file.
+	// A request is allowed if it matches at least one allow rule and no deny rule.
+	policy struct {
+		Rules []*policyRule `yaml:"rules"`
+	}
+
+	// policyRule matches the requests matching all its conditions, an empty condition matches all requests.
+	// Conditions are lists of patterns in the syntax of path.Match, e.g. "team-a-*".
+	policyRule struct {
+		Name          string   `yaml:"name"`
+		Effect        string   `yaml:"effect"`
+		Actors        []string `yaml:"actors"`
+		APIs          []string `yaml:"apis"`
+		Domains       []string `yaml:"domains"`
+		Permissions   []string `yaml:"permissions"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		TaskLists     []string `yaml:"taskLists"`
+	}
+)
+
+const (
+	policyEffectAllow = "allow"
+	policyEffectDeny  = "deny"
+
+	defaultPolicyPollInterval = 10 * time.Second
+)
+
+// NewPolicyAuthorizer creates an authorizer driven by the rules of a local policy file.
+// The file is checked for changes at most once per poll interval, and reloaded if it was modified.
+// It runs behind the given authenticator, i.e. the OAuth or the mTLS authorizer: requests must be
+// allowed by the authenticator first, and the rules are matched against the principal it authenticated.
+func NewPolicyAuthorizer(
+	authorizationCfg config.PolicyAuthorizer,
+	authenticator Authorizer,
+	log log.Logger,
+) (Authorizer, error) {
+	return newPolicyAuthority(authorizationCfg, authenticator, log, clock.NewRealTimeSource())
+}
+
+func newPolicyAuthority(
+	authorizationCfg config.PolicyAuthorizer,
+	authenticator Authorizer,
+	log log.Logger,
+	timeSource clock.TimeSource,
+) (*policyAuthority, error) {
+	pollInterval := authorizationCfg.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPolicyPollInterval
+	}
+	authority := &policyAuthority{
+		authenticator: authenticator,
+		policyFile:    authorizationCfg.PolicyFile,
+		pollInterval:  pollInterval,
+		log:           log,
+		timeSource:    timeSource,
+	}
+	if err := authority.reload(); err != nil {
+		return nil, err
+	}
+	return authority, nil
+}
+
+// Authorize allows the request if the authenticator allows it and it matches at least one allow rule
+// and no deny rule
+func (a *policyAuthority) Authorize(
+	ctx context.Context,
+	attributes *Attributes,
+) (Result, error) {
+	result, err := a.authenticator.Authorize(ctx, attributes)
+	if err != nil || result.Decision != DecisionAllow {
+		return result, err
+	}
+	actor := result.Principal
+	if actor == "" {
+		a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("caller is not authenticated")))
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	policy := a.getPolicy()
+
+	var allowedBy *policyRule
+	for _, rule := range policy.Rules {
+		if !rule.matches(actor, attributes) {
+			continue
+		}
+		if rule.Effect == policyEffectDeny {
+			a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("request of %v is denied by rule %v", actor, rule.Name)))
+			return Result{Decision: DecisionDeny}, nil
+		}
+		if allowedBy == nil {
+			allowedBy = rule
+		}
+	}
+	if allowedBy == nil {
+		a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("request of %v is not allowed by any rule", actor)))
+		return Result{Decision: DecisionDeny}, nil
+	}
+	return Result{Decision: DecisionAllow, Principal: actor}, nil
+}
+
+// getPolicy returns the current policy, reloading the policy file if it's time to check it again
+func (a *policyAuthority) getPolicy() *policy {
+	a.Lock()
+	defer a.Unlock()
+
+	if a.timeSource.Now().Sub(a.lastChecked) >= a.pollInterval {
+		if err := a.reloadLocked(); err != nil {
+			a.log.Error("Failed to reload authorization policy, keep using the previous one", tag.Error(err))
+		}
+	}
+	return a.policy
+}
+
+func (a *policyAuthority) reload() error {
+	a.Lock()
+	defer a.Unlock()
+	return a.reloadLocked()
+}
+
+func (a *policyAuthority) reloadLocked() error {
+	a.lastChecked = a.timeSource.Now()
+
+	info, err := os.Stat(a.policyFile)
+	if err != nil {
+		return fmt.Errorf("failed to get status of policy file: %v", err)
+	}
+	if a.policy != nil && info.ModTime().Equal(a.lastModified) {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(a.policyFile)
+	if err != nil {
+		return fmt.Errorf("failed to read policy file %v: %v", a.policyFile, err)
+	}
+	newPolicy := &policy{}
+	if err := yaml.UnmarshalStrict(content, newPolicy); err != nil {
+		return fmt.Errorf("failed to decode policy file %v: %v", a.policyFile, err)
+	}
+	if err := newPolicy.validate(); err != nil {
+		return fmt.Errorf("invalid policy file %v: %v", a.policyFile, err)
+	}
+
+	a.policy = newPolicy
+	a.lastModified = info.ModTime()
+	a.log.Info("Updated authorization policy", tag.Value(a.policyFile))
+	return nil
+}
+
+func (p *policy) validate() error {
+	for i, rule := range p.Rules {
+		if rule.Effect != policyEffectAllow && rule.Effect != policyEffectDeny {
+			return fmt.Errorf("rule %v %v has invalid effect %q", i, rule.Name, rule.Effect)
+		}
+		for _, permission := range rule.Permissions {
+			if NewPermission(permission) < 0 {
+				return fmt.Errorf("rule %v %v has invalid permission %q", i, rule.Name, permission)
+			}
+		}
+		for _, patterns := range [][]string{rule.Actors, rule.APIs, rule.Domains, rule.WorkflowTypes, rule.TaskLists} {
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return fmt.Errorf("rule %v %v has invalid pattern %q", i, rule.Name, pattern)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// matches returns true if the request matches all conditions of the rule.
+// Conditions on WorkflowType and TaskList never match requests which don't carry them.
+func (r *policyRule) matches(actor string, attributes *Attributes) bool {
+	if !matchPatterns(r.Actors, actor) ||
+		!matchPatterns(r.APIs, attributes.APIName) ||
+		!matchPatterns(r.Domains, attributes.DomainName) {
+		return false
+	}
+	if len(r.Permissions) > 0 {
+		found := false
+		for _, permission := range r.Permissions {
+			if NewPermission(permission) == attributes.Permission {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(r.WorkflowTypes) > 0 && (attributes.WorkflowType == nil || !matchPatterns(r.WorkflowTypes, attributes.WorkflowType.GetName())) {
+		return false
+	}
+	if len(r.TaskLists) > 0 && (attributes.TaskList == nil || !matchPatterns(r.TaskLists, attributes.TaskList.GetName())) {
+		return false
+	}
+	return true
+}
+
+func matchPatterns(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+	return false
+}
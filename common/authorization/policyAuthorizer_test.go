@@ -0,0 +1,270 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	policySuite struct {
+		suite.Suite
+		logger     log.Logger
+		dir        string
+		policyFile string
+		timeSource *clock.EventTimeSource
+	}
+
+	// testAuthenticator allows all requests and authenticates the callers as the principal
+	testAuthenticator struct {
+		principal string
+	}
+)
+
+const testPolicy = `
+rules:
+  - name: admins
+    effect: allow
+    actors: ["admin-*"]
+  - name: readers
+    effect: allow
+    permissions: ["read"]
+    domains: ["samples-domain"]
+  - name: team-a-signals
+    effect: allow
+    actors: ["team-a"]
+    apis: ["SignalWithStartWorkflowExecution", "StartWorkflowExecution"]
+    workflowTypes: ["team-a.*"]
+  - name: team-a-pollers
+    effect: allow
+    actors: ["team-a"]
+    apis: ["Poll*"]
+    taskLists: ["team-a-*"]
+  - name: no-terminate
+    effect: deny
+    apis: ["TerminateWorkflowExecution"]
+    domains: ["samples-domain"]
+`
+
+func TestPolicySuite(t *testing.T) {
+	suite.Run(t, new(policySuite))
+}
+
+func (s *policySuite) SetupTest() {
+	s.logger = loggerimpl.NewNopLogger()
+	dir, err := ioutil.TempDir("", "policyAuthorizerTest")
+	s.NoError(err)
+	s.dir = dir
+	s.policyFile = filepath.Join(dir, "policy.yaml")
+	s.writePolicy(testPolicy, time.Now())
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+}
+
+func (s *policySuite) TearDownTest() {
+	s.NoError(os.RemoveAll(s.dir))
+}
+
+func (s *policySuite) TestAuthorize() {
+	tests := []struct {
+		name      string
+		principal string
+		att       Attributes
+		expected  Decision
+	}{
+		{
+			name:      "admin actor is allowed any API",
+			principal: "admin-bob",
+			att:       Attributes{APIName: "DeprecateDomain", DomainName: "other-domain", Permission: PermissionAdmin},
+			expected:  DecisionAllow,
+		},
+		{
+			name:      "read in allowed domain",
+			principal: "someone",
+			att:       Attributes{APIName: "DescribeWorkflowExecution", DomainName: "samples-domain", Permission: PermissionRead},
+			expected:  DecisionAllow,
+		},
+		{
+			name:      "read in other domain is denied by default",
+			principal: "someone",
+			att:       Attributes{APIName: "DescribeWorkflowExecution", DomainName: "other-domain", Permission: PermissionRead},
+			expected:  DecisionDeny,
+		},
+		{
+			name:      "deny takes precedence over allow",
+			principal: "admin-bob",
+			att:       Attributes{APIName: "TerminateWorkflowExecution", DomainName: "samples-domain", Permission: PermissionWrite},
+			expected:  DecisionDeny,
+		},
+		{
+			name:      "matching workflow type",
+			principal: "team-a",
+			att: Attributes{APIName: "SignalWithStartWorkflowExecution", DomainName: "other-domain", Permission: PermissionWrite,
+				WorkflowType: &types.WorkflowType{Name: "team-a.payments"}},
+			expected: DecisionAllow,
+		},
+		{
+			name:      "other workflow type",
+			principal: "team-a",
+			att: Attributes{APIName: "SignalWithStartWorkflowExecution", DomainName: "other-domain", Permission: PermissionWrite,
+				WorkflowType: &types.WorkflowType{Name: "team-b.payments"}},
+			expected: DecisionDeny,
+		},
+		{
+			name:      "missing workflow type",
+			principal: "team-a",
+			att:       Attributes{APIName: "StartWorkflowExecution", DomainName: "other-domain", Permission: PermissionWrite},
+			expected:  DecisionDeny,
+		},
+		{
+			name:      "matching task list",
+			principal: "team-a",
+			att: Attributes{APIName: "PollForDecisionTask", DomainName: "other-domain", Permission: PermissionWrite,
+				TaskList: &types.TaskList{Name: "team-a-tl"}},
+			expected: DecisionAllow,
+		},
+		{
+			name:      "other task list",
+			principal: "team-a",
+			att: Attributes{APIName: "PollForActivityTask", DomainName: "other-domain", Permission: PermissionWrite,
+				TaskList: &types.TaskList{Name: "team-b-tl"}},
+			expected: DecisionDeny,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			authorizer := s.newAuthorizer(&testAuthenticator{principal: test.principal})
+			result, err := authorizer.Authorize(context.Background(), &test.att)
+			s.NoError(err)
+			s.Equal(test.expected, result.Decision)
+			if test.expected == DecisionAllow {
+				s.Equal(test.principal, result.Principal)
+			}
+		})
+	}
+}
+
+func (s *policySuite) TestAuthorize_ActorIsAuthenticated() {
+	att := &Attributes{APIName: "DeprecateDomain", DomainName: "other-domain", Permission: PermissionAdmin}
+
+	// the caller name in the request headers is not authenticated and never matched against the rules
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequest(&transport.Request{Caller: "admin-cli"}))
+	result, err := s.newAuthorizer(&testAuthenticator{}).Authorize(ctx, att)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	// requests denied by the authenticator are denied whatever the rules
+	authenticator := NewMockAuthorizer(gomock.NewController(s.T()))
+	authenticator.EXPECT().Authorize(ctx, att).Return(Result{Decision: DecisionDeny}, nil)
+	result, err = s.newAuthorizer(authenticator).Authorize(ctx, att)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	result, err = s.newAuthorizer(&testAuthenticator{principal: "admin-cli"}).Authorize(ctx, att)
+	s.NoError(err)
+	s.Equal(Result{Decision: DecisionAllow, Principal: "admin-cli"}, result)
+}
+
+func (s *policySuite) TestReload() {
+	authorizer := s.newAuthorizer(&testAuthenticator{principal: "someone"})
+	att := &Attributes{APIName: "DescribeWorkflowExecution", DomainName: "other-domain", Permission: PermissionRead}
+
+	s.writePolicy(`
+rules:
+  - name: everyone
+    effect: allow
+`, time.Now().Add(time.Minute))
+
+	// the file is not checked again before the poll interval
+	result, err := authorizer.Authorize(context.Background(), att)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Second))
+	result, err = authorizer.Authorize(context.Background(), att)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	// an invalid policy is ignored and the previous one is kept
+	s.writePolicy("rules: [{effect: maybe}]", time.Now().Add(2*time.Minute))
+	s.timeSource.Update(s.timeSource.Now().Add(time.Second))
+	result, err = authorizer.Authorize(context.Background(), att)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policySuite) TestNewPolicyAuthorizer_Invalid() {
+	tests := map[string]string{
+		"invalid effect":     "rules: [{effect: maybe}]",
+		"invalid permission": "rules: [{effect: allow, permissions: [execute]}]",
+		"invalid pattern":    "rules: [{effect: allow, apis: ['[']}]",
+		"unknown field":      "rules: [{effect: allow, workflowIDs: [abc]}]",
+	}
+	for name, content := range tests {
+		s.Run(name, func() {
+			s.writePolicy(content, time.Now())
+			_, err := NewPolicyAuthorizer(config.PolicyAuthorizer{Enable: true, PolicyFile: s.policyFile}, &testAuthenticator{}, s.logger)
+			s.Error(err)
+		})
+	}
+
+	_, err := NewPolicyAuthorizer(config.PolicyAuthorizer{Enable: true, PolicyFile: filepath.Join(s.dir, "missing.yaml")}, &testAuthenticator{}, s.logger)
+	s.Error(err)
+}
+
+func (s *policySuite) newAuthorizer(authenticator Authorizer) Authorizer {
+	authorizer, err := newPolicyAuthority(config.PolicyAuthorizer{
+		Enable:       true,
+		PolicyFile:   s.policyFile,
+		PollInterval: time.Second,
+	}, authenticator, s.logger, s.timeSource)
+	s.NoError(err)
+	return authorizer
+}
+
+func (a *testAuthenticator) Authorize(context.Context, *Attributes) (Result, error) {
+	if a.principal == "" {
+		return Result{Decision: DecisionAllow}, nil
+	}
+	return Result{Decision: DecisionAllow, Principal: a.principal}, nil
+}
+
+func (s *policySuite) writePolicy(content string, modTime time.Time) {
+	s.NoError(ioutil.WriteFile(s.policyFile, []byte(content), 0644))
+	s.NoError(os.Chtimes(s.policyFile, modTime, modTime))
+}
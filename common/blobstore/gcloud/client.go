@@ -0,0 +1,179 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package gcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+
+	"github.com/uber/cadence/common/archiver/gcloud/connector"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	client struct {
+		bucket    connector.BucketHandleWrapper
+		keyPrefix string
+	}
+)
+
+// NewGcloudClient constructs a blobstore backed by google cloud storage
+func NewGcloudClient(cfg *config.GstorageBlobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("gstorage blobstore config is nil")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("bucket not given for gstorage blobstore")
+	}
+	storageClient, err := connector.NewGcloudStorageClient(context.Background(), &cfg.GstorageArchiver)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(storageClient, cfg.Bucket, cfg.KeyPrefix), nil
+}
+
+func newClient(storageClient connector.GcloudStorageClient, bucket string, keyPrefix string) *client {
+	return &client{
+		bucket:    storageClient.Bucket(bucket),
+		keyPrefix: strings.Trim(keyPrefix, "/"),
+	}
+}
+
+// Put stores a blob
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (resp *blobstore.PutResponse, err error) {
+	defer func() {
+		if err != nil {
+			c.bucket.Object(c.bodyKey(request.Key)).Delete(ctx)
+			c.bucket.Object(c.tagsKey(request.Key)).Delete(ctx)
+		}
+	}()
+	if err := c.putObject(ctx, c.bodyKey(request.Key), request.Blob.Body); err != nil {
+		return nil, err
+	}
+	tagsData, err := json.Marshal(request.Blob.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.putObject(ctx, c.tagsKey(request.Key), tagsData); err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	data, err := c.getObject(ctx, c.bodyKey(request.Key))
+	if err != nil {
+		return nil, err
+	}
+	tagsData, err := c.getObject(ctx, c.tagsKey(request.Key))
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string)
+	if err := json.Unmarshal(tagsData, &tags); err != nil {
+		return nil, err
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Body: data,
+			Tags: tags,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	if _, err := c.bucket.Object(c.bodyKey(request.Key)).Attrs(ctx); err != nil {
+		if err == storage.ErrObjectNotExist {
+			return &blobstore.ExistsResponse{Exists: false}, nil
+		}
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{
+		Exists: true,
+	}, nil
+}
+
+// Delete deletes a blob
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	if err := c.bucket.Object(c.bodyKey(request.Key)).Delete(ctx); err != nil {
+		return nil, err
+	}
+	if err := c.bucket.Object(c.tagsKey(request.Key)).Delete(ctx); err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == 429 || (apiErr.Code >= 500 && apiErr.Code != 501)
+	}
+	return false
+}
+
+func (c *client) putObject(ctx context.Context, key string, data []byte) error {
+	writer := c.bucket.Object(key).NewWriter(ctx)
+	if _, err := io.Copy(writer, bytes.NewReader(data)); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func (c *client) getObject(ctx context.Context, key string) ([]byte, error) {
+	reader, err := c.bucket.Object(key).NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// bodyKey and tagsKey follow the layout of filestore, the tags of a blob are kept in a hidden object next to it
+func (c *client) bodyKey(key string) string {
+	return c.withPrefix(key)
+}
+
+func (c *client) tagsKey(key string) string {
+	return c.withPrefix("." + key + ".tags")
+}
+
+func (c *client) withPrefix(key string) string {
+	if c.keyPrefix == "" {
+		return key
+	}
+	return c.keyPrefix + "/" + key
+}
@@ -0,0 +1,182 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package gcloud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/api/googleapi"
+
+	"github.com/uber/cadence/common/archiver/gcloud/connector"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+const testBucket = "test-bucket"
+
+type (
+	ClientSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		storage *fakeStorage
+	}
+
+	// fakeStorage keeps the objects of a bucket in memory
+	fakeStorage struct {
+		objects map[string][]byte
+	}
+
+	fakeBucket struct {
+		connector.BucketHandleWrapper
+		storage *fakeStorage
+		name    string
+	}
+
+	fakeObject struct {
+		storage *fakeStorage
+		name    string
+	}
+
+	fakeWriter struct {
+		bytes.Buffer
+		object *fakeObject
+	}
+
+	fakeReader struct {
+		*bytes.Reader
+	}
+)
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.storage = &fakeStorage{objects: make(map[string][]byte)}
+}
+
+func (s *ClientSuite) TestNewGcloudClient_InvalidConfig() {
+	_, err := NewGcloudClient(nil)
+	s.Error(err)
+	_, err = NewGcloudClient(&config.GstorageBlobstore{})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestCrudOperations() {
+	c := newClient(s.storage, testBucket, "/scanner/")
+	ctx := context.Background()
+
+	existsResp, err := c.Exists(ctx, &blobstore.ExistsRequest{Key: "key"})
+	s.NoError(err)
+	s.False(existsResp.Exists)
+	_, err = c.Get(ctx, &blobstore.GetRequest{Key: "key"})
+	s.Equal(storage.ErrObjectNotExist, err)
+
+	blob := blobstore.Blob{
+		Tags: map[string]string{"key1": "value1", "key2": "value2"},
+		Body: []byte("body"),
+	}
+	_, err = c.Put(ctx, &blobstore.PutRequest{Key: "key", Blob: blob})
+	s.NoError(err)
+	s.Contains(s.storage.objects, testBucket+"/scanner/key")
+	s.Contains(s.storage.objects, testBucket+"/scanner/.key.tags")
+
+	existsResp, err = c.Exists(ctx, &blobstore.ExistsRequest{Key: "key"})
+	s.NoError(err)
+	s.True(existsResp.Exists)
+	getResp, err := c.Get(ctx, &blobstore.GetRequest{Key: "key"})
+	s.NoError(err)
+	s.Equal(blob, getResp.Blob)
+
+	_, err = c.Delete(ctx, &blobstore.DeleteRequest{Key: "key"})
+	s.NoError(err)
+	s.Empty(s.storage.objects)
+	_, err = c.Delete(ctx, &blobstore.DeleteRequest{Key: "key"})
+	s.Equal(storage.ErrObjectNotExist, err)
+}
+
+func (s *ClientSuite) TestIsRetryableError() {
+	c := newClient(s.storage, testBucket, "")
+	s.False(c.IsRetryableError(errors.New("some error")))
+	s.False(c.IsRetryableError(storage.ErrObjectNotExist))
+	s.False(c.IsRetryableError(&googleapi.Error{Code: 404}))
+	s.True(c.IsRetryableError(&googleapi.Error{Code: 429}))
+	s.True(c.IsRetryableError(&googleapi.Error{Code: 503}))
+}
+
+func (f *fakeStorage) Bucket(name string) connector.BucketHandleWrapper {
+	return &fakeBucket{storage: f, name: name}
+}
+
+func (b *fakeBucket) Object(name string) connector.ObjectHandleWrapper {
+	return &fakeObject{storage: b.storage, name: b.name + "/" + name}
+}
+
+func (o *fakeObject) NewWriter(_ context.Context) connector.WriterWrapper {
+	return &fakeWriter{object: o}
+}
+
+func (o *fakeObject) NewReader(_ context.Context) (connector.ReaderWrapper, error) {
+	data, ok := o.storage.objects[o.name]
+	if !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return &fakeReader{Reader: bytes.NewReader(data)}, nil
+}
+
+func (o *fakeObject) Attrs(_ context.Context) (*storage.ObjectAttrs, error) {
+	data, ok := o.storage.objects[o.name]
+	if !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return &storage.ObjectAttrs{Name: o.name, Size: int64(len(data))}, nil
+}
+
+func (o *fakeObject) Delete(_ context.Context) error {
+	if _, ok := o.storage.objects[o.name]; !ok {
+		return storage.ErrObjectNotExist
+	}
+	delete(o.storage.objects, o.name)
+	return nil
+}
+
+func (w *fakeWriter) Close() error {
+	w.object.storage.objects[w.object.name] = w.Bytes()
+	return nil
+}
+
+func (w *fakeWriter) CloseWithError(err error) error {
+	return nil
+}
+
+func (r *fakeReader) Close() error {
+	return nil
+}
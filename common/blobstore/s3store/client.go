@@ -0,0 +1,206 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"go.uber.org/multierr"
+
+	"github.com/uber/cadence/common/archiver/s3store"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	client struct {
+		s3cli     s3iface.S3API
+		bucket    string
+		keyPrefix string
+	}
+)
+
+// NewS3Client constructs a blobstore backed by S3 or a S3 compatible storage
+func NewS3Client(cfg *config.S3Blobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("s3store blobstore config is nil")
+	}
+	if len(cfg.Region) == 0 {
+		return nil, errors.New("region not given for s3store blobstore")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("bucket not given for s3store blobstore")
+	}
+	s3cli, err := s3store.NewS3Client(&cfg.S3Archiver)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(s3cli, cfg.Bucket, cfg.KeyPrefix), nil
+}
+
+func newClient(s3cli s3iface.S3API, bucket string, keyPrefix string) *client {
+	return &client{
+		s3cli:     s3cli,
+		bucket:    bucket,
+		keyPrefix: strings.Trim(keyPrefix, "/"),
+	}
+}
+
+// Put stores a blob
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (resp *blobstore.PutResponse, err error) {
+	defer func() {
+		if err != nil {
+			c.deleteObject(ctx, c.bodyKey(request.Key))
+			c.deleteObject(ctx, c.tagsKey(request.Key))
+		}
+	}()
+	if err := c.putObject(ctx, c.bodyKey(request.Key), request.Blob.Body); err != nil {
+		return nil, err
+	}
+	tagsData, err := json.Marshal(request.Blob.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.putObject(ctx, c.tagsKey(request.Key), tagsData); err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	data, err := c.getObject(ctx, c.bodyKey(request.Key))
+	if err != nil {
+		return nil, err
+	}
+	tagsData, err := c.getObject(ctx, c.tagsKey(request.Key))
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string)
+	if err := json.Unmarshal(tagsData, &tags); err != nil {
+		return nil, err
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Body: data,
+			Tags: tags,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	_, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.bodyKey(request.Key)),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return &blobstore.ExistsResponse{Exists: false}, nil
+		}
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{
+		Exists: true,
+	}, nil
+}
+
+// Delete deletes a blob
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	if err := c.deleteObject(ctx, c.bodyKey(request.Key)); err != nil {
+		return nil, err
+	}
+	if err := c.deleteObject(ctx, c.tagsKey(request.Key)); err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	return s3store.IsRetryableError(err)
+}
+
+func (c *client) putObject(ctx context.Context, key string, data []byte) error {
+	_, err := c.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (c *client) getObject(ctx context.Context, key string) (data []byte, err error) {
+	result, err := c.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ierr := result.Body.Close(); ierr != nil {
+			err = multierr.Append(err, ierr)
+		}
+	}()
+	return ioutil.ReadAll(result.Body)
+}
+
+func (c *client) deleteObject(ctx context.Context, key string) error {
+	_, err := c.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+// bodyKey and tagsKey follow the layout of filestore, the tags of a blob are kept in a hidden object next to it
+func (c *client) bodyKey(key string) string {
+	return c.withPrefix(key)
+}
+
+func (c *client) tagsKey(key string) string {
+	return c.withPrefix("." + key + ".tags")
+}
+
+func (c *client) withPrefix(key string) string {
+	if c.keyPrefix == "" {
+		return key
+	}
+	return c.keyPrefix + "/" + key
+}
+
+func isNotFoundError(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && (aerr.Code() == "NotFound" || aerr.Code() == s3.ErrCodeNoSuchKey)
+}
@@ -0,0 +1,166 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/archiver/s3store/mocks"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+const testBucket = "test-bucket"
+
+type ClientSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	s3cli *mocks.S3API
+	fs    map[string][]byte
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.s3cli = &mocks.S3API{}
+	s.fs = make(map[string][]byte)
+	s.setupFsEmulation()
+}
+
+func (s *ClientSuite) setupFsEmulation() {
+	s.s3cli.On("PutObjectWithContext", mock.Anything, mock.Anything).
+		Return(func(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) *s3.PutObjectOutput {
+			buf := new(bytes.Buffer)
+			buf.ReadFrom(input.Body)
+			s.fs[*input.Bucket+"/"+*input.Key] = buf.Bytes()
+			return &s3.PutObjectOutput{}
+		}, nil)
+	s.s3cli.On("DeleteObjectWithContext", mock.Anything, mock.Anything).
+		Return(func(_ aws.Context, input *s3.DeleteObjectInput, _ ...request.Option) *s3.DeleteObjectOutput {
+			delete(s.fs, *input.Bucket+"/"+*input.Key)
+			return &s3.DeleteObjectOutput{}
+		}, nil)
+	s.s3cli.On("HeadObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadObjectInput) bool {
+		_, ok := s.fs[*input.Bucket+"/"+*input.Key]
+		return !ok
+	})).Return(nil, awserr.New("NotFound", "", nil))
+	s.s3cli.On("HeadObjectWithContext", mock.Anything, mock.Anything).Return(&s3.HeadObjectOutput{}, nil)
+	s.s3cli.On("GetObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.GetObjectInput) bool {
+		_, ok := s.fs[*input.Bucket+"/"+*input.Key]
+		return !ok
+	})).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "", nil))
+	s.s3cli.On("GetObjectWithContext", mock.Anything, mock.Anything).
+		Return(func(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) *s3.GetObjectOutput {
+			return &s3.GetObjectOutput{
+				Body: ioutil.NopCloser(bytes.NewReader(s.fs[*input.Bucket+"/"+*input.Key])),
+			}
+		}, nil)
+}
+
+func (s *ClientSuite) TestNewS3Client_InvalidConfig() {
+	_, err := NewS3Client(nil)
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{Bucket: testBucket})
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{S3Archiver: config.S3Archiver{Region: "us-east-1"}})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestNewS3Client() {
+	c, err := NewS3Client(&config.S3Blobstore{
+		S3Archiver: config.S3Archiver{Region: "us-east-1"},
+		Bucket:     testBucket,
+		KeyPrefix:  "/scanner/",
+	})
+	s.NoError(err)
+	s.Equal(testBucket, c.(*client).bucket)
+	s.Equal("scanner", c.(*client).keyPrefix)
+}
+
+func (s *ClientSuite) TestCrudOperations() {
+	c := newClient(s.s3cli, testBucket, "scanner")
+	ctx := context.Background()
+
+	existsResp, err := c.Exists(ctx, &blobstore.ExistsRequest{Key: "key"})
+	s.NoError(err)
+	s.False(existsResp.Exists)
+	_, err = c.Get(ctx, &blobstore.GetRequest{Key: "key"})
+	s.Error(err)
+
+	blob := blobstore.Blob{
+		Tags: map[string]string{"key1": "value1", "key2": "value2"},
+		Body: []byte("body"),
+	}
+	_, err = c.Put(ctx, &blobstore.PutRequest{Key: "key", Blob: blob})
+	s.NoError(err)
+	s.Contains(s.fs, testBucket+"/scanner/key")
+	s.Contains(s.fs, testBucket+"/scanner/.key.tags")
+
+	existsResp, err = c.Exists(ctx, &blobstore.ExistsRequest{Key: "key"})
+	s.NoError(err)
+	s.True(existsResp.Exists)
+	getResp, err := c.Get(ctx, &blobstore.GetRequest{Key: "key"})
+	s.NoError(err)
+	s.Equal(blob, getResp.Blob)
+
+	_, err = c.Delete(ctx, &blobstore.DeleteRequest{Key: "key"})
+	s.NoError(err)
+	s.Empty(s.fs)
+	existsResp, err = c.Exists(ctx, &blobstore.ExistsRequest{Key: "key"})
+	s.NoError(err)
+	s.False(existsResp.Exists)
+}
+
+func (s *ClientSuite) TestPut_CleanupOnFailure() {
+	s3cli := &mocks.S3API{}
+	s3cli.On("PutObjectWithContext", mock.Anything, mock.Anything).Return(nil, errors.New("put failed"))
+	s3cli.On("DeleteObjectWithContext", mock.Anything, mock.Anything).Return(&s3.DeleteObjectOutput{}, nil)
+	c := newClient(s3cli, testBucket, "")
+
+	_, err := c.Put(context.Background(), &blobstore.PutRequest{Key: "key"})
+	s.Error(err)
+	s3cli.AssertNumberOfCalls(s.T(), "DeleteObjectWithContext", 2)
+}
+
+func (s *ClientSuite) TestIsRetryableError() {
+	c := newClient(s.s3cli, testBucket, "")
+	s.False(c.IsRetryableError(errors.New("some error")))
+	s.False(c.IsRetryableError(awserr.New(s3.ErrCodeNoSuchKey, "", nil)))
+	s.True(c.IsRetryableError(awserr.NewRequestFailure(awserr.New("InternalError", "", nil), 503, "")))
+}
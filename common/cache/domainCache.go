@@ -696,6 +696,18 @@ func copyResetBinary(bins types.BadBinaries) types.BadBinaries {
 	}
 }
 
+func copyRoleBindings(bindings []*types.RoleBinding) []*types.RoleBinding {
+	if bindings == nil {
+		return nil
+	}
+	newBindings := make([]*types.RoleBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		newBinding := *binding
+		newBindings = append(newBindings, &newBinding)
+	}
+	return newBindings
+}
+
 func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 	// this is a deep copy
 	result := &DomainCacheEntry{}
@@ -718,6 +730,7 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 		VisibilityArchivalStatus: entry.config.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    entry.config.VisibilityArchivalURI,
 		BadBinaries:              copyResetBinary(entry.config.BadBinaries),
+		RoleBindings:             copyRoleBindings(entry.config.RoleBindings),
 	}
 	result.replicationConfig = &persistence.DomainReplicationConfig{
 		ActiveClusterName: entry.replicationConfig.ActiveClusterName,
@@ -22,13 +22,21 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/cristalhq/jwt/v3"
 )
 
 // Validate validates the persistence config
 func (a *Authorization) Validate() error {
-	if a.OAuthAuthorizer.Enable && a.NoopAuthorizer.Enable {
+	enabled := 0
+	// the policy authorizer is not counted, it runs behind the OAuth or the mTLS authorizer
+	for _, enable := range []bool{a.OAuthAuthorizer.Enable, a.NoopAuthorizer.Enable, a.MTLSAuthorizer.Enable} {
+		if enable {
+			enabled++
+		}
+	}
+	if enabled > 1 {
 		return fmt.Errorf("[AuthorizationConfig] More than one authorizer is enabled")
 	}
 
@@ -38,6 +46,73 @@ func (a *Authorization) Validate() error {
 		}
 	}
 
+	if a.PolicyAuthorizer.Enable {
+		if policyError := a.validatePolicy(); policyError != nil {
+			return policyError
+		}
+	}
+
+	if a.MTLSAuthorizer.Enable {
+		if mtlsError := a.validateMTLS(); mtlsError != nil {
+			return mtlsError
+		}
+	}
+
+	if a.Audit.Enable {
+		if auditError := a.validateAudit(); auditError != nil {
+			return auditError
+		}
+	}
+
+	return nil
+}
+
+func (a *Authorization) validateAudit() error {
+	auditConfig := a.Audit
+
+	if auditConfig.File == nil && auditConfig.Kafka == nil {
+		return fmt.Errorf("[AuditConfig] At least one sink must be configured")
+	}
+	if auditConfig.File != nil {
+		if auditConfig.File.Path == "" {
+			return fmt.Errorf("[AuditConfig] File Path can't be empty")
+		}
+		if auditConfig.File.MaxSizeMB < 0 || auditConfig.File.MaxBackups < 0 {
+			return fmt.Errorf("[AuditConfig] File MaxSizeMB and MaxBackups can't be negative")
+		}
+	}
+	if auditConfig.Kafka != nil && auditConfig.Kafka.Application == "" {
+		return fmt.Errorf("[AuditConfig] Kafka Application can't be empty")
+	}
+	return nil
+}
+
+func (a *Authorization) validateMTLS() error {
+	for i, identity := range a.MTLSAuthorizer.Identities {
+		if identity.Identity == "" {
+			return fmt.Errorf("[MTLSConfig] Identity %v can't be empty", i)
+		}
+		switch identity.Permission {
+		case "read", "write", "admin":
+		default:
+			return fmt.Errorf("[MTLSConfig] Permission of identity %v is invalid: %q", identity.Identity, identity.Permission)
+		}
+	}
+	return nil
+}
+
+func (a *Authorization) validatePolicy() error {
+	policyConfig := a.PolicyAuthorizer
+
+	if !a.OAuthAuthorizer.Enable && !a.MTLSAuthorizer.Enable {
+		return fmt.Errorf("[PolicyConfig] OAuthAuthorizer or MTLSAuthorizer must be enabled to authenticate the callers")
+	}
+	if policyConfig.PolicyFile == "" {
+		return fmt.Errorf("[PolicyConfig] PolicyFile can't be empty")
+	}
+	if policyConfig.PollInterval < 0 {
+		return fmt.Errorf("[PolicyConfig] PollInterval can't be negative")
+	}
 	return nil
 }
 
@@ -47,6 +122,9 @@ func (a *Authorization) validateOAuth() error {
 	if oauthConfig.MaxJwtTTL <= 0 {
 		return fmt.Errorf("[OAuthConfig] MaxTTL must be greater than 0")
 	}
+	if oauthConfig.Provider != nil {
+		return a.validateOAuthProvider()
+	}
 	if oauthConfig.JwtCredentials.PublicKey == "" {
 		return fmt.Errorf("[OAuthConfig] PublicKey can't be empty")
 	}
@@ -55,3 +133,18 @@ func (a *Authorization) validateOAuth() error {
 	}
 	return nil
 }
+
+func (a *Authorization) validateOAuthProvider() error {
+	provider := a.OAuthAuthorizer.Provider
+
+	if provider.JWKSURL == "" {
+		return fmt.Errorf("[OAuthConfig] JWKSURL can't be empty")
+	}
+	if _, err := url.ParseRequestURI(provider.JWKSURL); err != nil {
+		return fmt.Errorf("[OAuthConfig] JWKSURL is invalid: %v", err)
+	}
+	if provider.JWKSRefreshInterval < 0 {
+		return fmt.Errorf("[OAuthConfig] JWKSRefreshInterval can't be negative")
+	}
+	return nil
+}
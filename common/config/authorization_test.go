@@ -22,6 +22,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -112,3 +113,138 @@ func TestCorrectValidation(t *testing.T) {
 	err := cfg.Validate()
 	assert.NoError(t, err)
 }
+
+func TestProviderJWKSURLIsEmpty(t *testing.T) {
+	cfg := Authorization{
+		OAuthAuthorizer: OAuthAuthorizer{
+			Enable:    true,
+			MaxJwtTTL: 1000000,
+			Provider:  &OAuthProvider{},
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[OAuthConfig] JWKSURL can't be empty")
+}
+
+func TestProviderCorrectValidation(t *testing.T) {
+	cfg := Authorization{
+		OAuthAuthorizer: OAuthAuthorizer{
+			Enable:    true,
+			MaxJwtTTL: 1000000,
+			Provider: &OAuthProvider{
+				JWKSURL:             "https://idp.example.com/.well-known/jwks.json",
+				JWKSRefreshInterval: time.Hour,
+				Issuer:              "https://idp.example.com",
+				Audience:            "cadence",
+				GroupsAttributePath: "realm_access.roles",
+			},
+		},
+	}
+
+	err := cfg.Validate()
+	assert.NoError(t, err)
+}
+
+func TestPolicyBehindMTLS(t *testing.T) {
+	cfg := Authorization{
+		MTLSAuthorizer: MTLSAuthorizer{
+			Enable:     true,
+			Identities: []MTLSIdentity{{Identity: "spiffe://example.org/*", Permission: "read"}},
+		},
+		PolicyAuthorizer: PolicyAuthorizer{
+			Enable:     true,
+			PolicyFile: "policy.yaml",
+		},
+	}
+
+	err := cfg.Validate()
+	assert.NoError(t, err)
+}
+
+func TestPolicyWithoutAuthentication(t *testing.T) {
+	cfg := Authorization{
+		PolicyAuthorizer: PolicyAuthorizer{
+			Enable:     true,
+			PolicyFile: "policy.yaml",
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[PolicyConfig] OAuthAuthorizer or MTLSAuthorizer must be enabled to authenticate the callers")
+}
+
+func TestPolicyFileIsEmpty(t *testing.T) {
+	cfg := Authorization{
+		MTLSAuthorizer: MTLSAuthorizer{
+			Enable:     true,
+			Identities: []MTLSIdentity{{Identity: "spiffe://example.org/*", Permission: "read"}},
+		},
+		PolicyAuthorizer: PolicyAuthorizer{
+			Enable: true,
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[PolicyConfig] PolicyFile can't be empty")
+}
+
+func TestMTLSIdentityIsEmpty(t *testing.T) {
+	cfg := Authorization{
+		MTLSAuthorizer: MTLSAuthorizer{
+			Enable:     true,
+			Identities: []MTLSIdentity{{Permission: "read"}},
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[MTLSConfig] Identity 0 can't be empty")
+}
+
+func TestMTLSPermissionIsInvalid(t *testing.T) {
+	cfg := Authorization{
+		MTLSAuthorizer: MTLSAuthorizer{
+			Enable:     true,
+			Identities: []MTLSIdentity{{Identity: "spiffe://example.org/worker", Permission: "execute"}},
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, `[MTLSConfig] Permission of identity spiffe://example.org/worker is invalid: "execute"`)
+}
+
+func TestAuditWithoutSink(t *testing.T) {
+	cfg := Authorization{
+		Audit: Audit{
+			Enable: true,
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[AuditConfig] At least one sink must be configured")
+}
+
+func TestAuditFilePathIsEmpty(t *testing.T) {
+	cfg := Authorization{
+		Audit: Audit{
+			Enable: true,
+			File:   &AuditFileSink{},
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[AuditConfig] File Path can't be empty")
+}
+
+func TestAuditKafkaApplicationIsEmpty(t *testing.T) {
+	cfg := Authorization{
+		Audit: Audit{
+			Enable: true,
+			File:   &AuditFileSink{Path: "/var/log/cadence/audit.log"},
+			Kafka:  &AuditKafkaSink{},
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[AuditConfig] Kafka Application can't be empty")
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+)
+
+// Validate validates the blobstore config
+func (b *Blobstore) Validate() error {
+	configured := 0
+	if b.Filestore != nil {
+		configured++
+	}
+	if b.S3store != nil {
+		configured++
+		if b.S3store.Region == "" || b.S3store.Bucket == "" {
+			return errors.New("invalid s3store blobstore config, must provide region and bucket")
+		}
+	}
+	if b.Gstorage != nil {
+		configured++
+		if b.Gstorage.Bucket == "" {
+			return errors.New("invalid gstorage blobstore config, must provide bucket")
+		}
+	}
+	if configured > 1 {
+		return errors.New("invalid blobstore config, only one of filestore, s3store and gstorage can be configured")
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestBlobstoreValidate(t *testing.T) {
+	tests := map[string]struct {
+		cfg       Blobstore
+		expectErr bool
+	}{
+		"empty": {
+			cfg: Blobstore{},
+		},
+		"filestore": {
+			cfg: Blobstore{Filestore: &FileBlobstore{OutputDirectory: "/tmp/blobstore"}},
+		},
+		"s3store": {
+			cfg: Blobstore{S3store: &S3Blobstore{S3Archiver: S3Archiver{Region: "us-east-1"}, Bucket: "cadence"}},
+		},
+		"s3store without bucket": {
+			cfg:       Blobstore{S3store: &S3Blobstore{S3Archiver: S3Archiver{Region: "us-east-1"}}},
+			expectErr: true,
+		},
+		"s3store without region": {
+			cfg:       Blobstore{S3store: &S3Blobstore{Bucket: "cadence"}},
+			expectErr: true,
+		},
+		"gstorage": {
+			cfg: Blobstore{Gstorage: &GstorageBlobstore{Bucket: "cadence"}},
+		},
+		"gstorage without bucket": {
+			cfg:       Blobstore{Gstorage: &GstorageBlobstore{}},
+			expectErr: true,
+		},
+		"multiple blobstores": {
+			cfg: Blobstore{
+				Filestore: &FileBlobstore{OutputDirectory: "/tmp/blobstore"},
+				Gstorage:  &GstorageBlobstore{Bucket: "cadence"},
+			},
+			expectErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestS3BlobstoreUnmarshal(t *testing.T) {
+	var cfg Blobstore
+	err := yaml.Unmarshal([]byte(`
+s3store:
+  region: us-east-1
+  endpoint: http://127.0.0.1:9000
+  s3ForcePathStyle: true
+  bucket: cadence
+  keyPrefix: scanner
+`), &cfg)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.S3store)
+	require.Equal(t, "us-east-1", cfg.S3store.Region)
+	require.Equal(t, "http://127.0.0.1:9000", *cfg.S3store.Endpoint)
+	require.True(t, cfg.S3store.S3ForcePathStyle)
+	require.Equal(t, "cadence", cfg.S3store.Bucket)
+	require.Equal(t, "scanner", cfg.S3store.KeyPrefix)
+}
@@ -83,8 +83,40 @@ type (
 	}
 
 	Authorization struct {
-		OAuthAuthorizer OAuthAuthorizer `yaml:"oauthAuthorizer"`
-		NoopAuthorizer  NoopAuthorizer  `yaml:"noopAuthorizer"`
+		OAuthAuthorizer  OAuthAuthorizer  `yaml:"oauthAuthorizer"`
+		NoopAuthorizer   NoopAuthorizer   `yaml:"noopAuthorizer"`
+		PolicyAuthorizer PolicyAuthorizer `yaml:"policyAuthorizer"`
+		MTLSAuthorizer   MTLSAuthorizer   `yaml:"mtlsAuthorizer"`
+		// Audit is the audit log of the write and admin APIs of the frontend
+		Audit Audit `yaml:"audit"`
+	}
+
+	// Audit is the config of the audit log, entries are written to all the configured sinks
+	Audit struct {
+		Enable bool `yaml:"enable"`
+		// File writes the entries as json lines to a local file rotated by size
+		File *AuditFileSink `yaml:"file"`
+		// Kafka publishes the entries as json to the topic of a kafka application
+		Kafka *AuditKafkaSink `yaml:"kafka"`
+		// QueueSize is the number of entries queued for the sinks, which are written in the background.
+		// Entries are dropped when the queue is full, default to 10000
+		QueueSize int `yaml:"queueSize"`
+	}
+
+	// AuditFileSink is the config of the local file audit sink
+	AuditFileSink struct {
+		// Path is the path of the current audit file, rotated files are suffixed by .1, .2 etc.
+		Path string `yaml:"path"`
+		// MaxSizeMB is the size after which the file is rotated, default to 100
+		MaxSizeMB int `yaml:"maxSizeMB"`
+		// MaxBackups is the number of rotated files to keep, default to 10
+		MaxBackups int `yaml:"maxBackups"`
+	}
+
+	// AuditKafkaSink is the config of the kafka audit sink
+	AuditKafkaSink struct {
+		// Application is the kafka application whose topic receives the entries
+		Application string `yaml:"application"`
 	}
 
 	DynamicConfig struct {
@@ -97,12 +129,63 @@ type (
 		Enable bool `yaml:"enable"`
 	}
 
+	// PolicyAuthorizer is the config for the authorizer driven by a local policy file.
+	// It runs behind the OAuthAuthorizer or the MTLSAuthorizer, one of which must be enabled: requests
+	// must be allowed by it first, and the rules are matched against the caller it authenticated.
+	PolicyAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// PolicyFile is the path of the yaml file holding the authorization rules
+		PolicyFile string `yaml:"policyFile"`
+		// PollInterval is how often the policy file is checked for changes, default to 10 seconds
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// MTLSAuthorizer is the config for the authorizer using the identity of the verified client certificate.
+	// It requires TLS with RequireClientAuth on the RPC inbound.
+	MTLSAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// Identities grant permissions to the callers presenting a matching certificate
+		Identities []MTLSIdentity `yaml:"identities"`
+	}
+
+	// MTLSIdentity maps a certificate identity to its permission on domains
+	MTLSIdentity struct {
+		// Identity is matched against the SPIFFE ID, the other URI and DNS SANs and the subject common name
+		// of the certificate, it can be a pattern like "spiffe://example.org/team-a/*"
+		Identity string `yaml:"identity"`
+		// Domains are the patterns of the domains the permission applies to, default to all domains
+		Domains []string `yaml:"domains"`
+		// Permission is read, write (which includes read) or admin (which includes everything)
+		Permission string `yaml:"permission"`
+	}
+
 	OAuthAuthorizer struct {
 		Enable bool `yaml:"enable"`
 		// Credentials to verify/create the JWT
 		JwtCredentials JwtCredentials `yaml:"jwtCredentials"`
 		// Max of TTL in the claim
 		MaxJwtTTL int64 `yaml:"maxJwtTTL"`
+		// Provider is the OIDC identity provider whose JWKS endpoint is used to verify the JWT instead of JwtCredentials
+		Provider *OAuthProvider `yaml:"provider"`
+	}
+
+	// OAuthProvider is the config of an OIDC identity provider
+	OAuthProvider struct {
+		// JWKSURL is the url of the JSON Web Key Set used to verify the JWT, keys are looked up by the kid header
+		JWKSURL string `yaml:"jwksURL"`
+		// JWKSRefreshInterval is how often the key set is fetched again, default to 1 hour
+		JWKSRefreshInterval time.Duration `yaml:"jwksRefreshInterval"`
+		// Issuer is the expected iss claim, not validated if empty
+		Issuer string `yaml:"issuer"`
+		// Audience is the expected aud claim, not validated if empty
+		Audience string `yaml:"audience"`
+		// GroupsAttributePath is the dot separated path to the claim holding the groups of the caller,
+		// as a space separated string or an array of strings, default to Groups
+		GroupsAttributePath string `yaml:"groupsAttributePath"`
+		// AdminAttributePath is the dot separated path to the boolean claim granting admin permission, default to Admin
+		AdminAttributePath string `yaml:"adminAttributePath"`
+		// AdminGroups are the groups granting admin permission
+		AdminGroups []string `yaml:"adminGroups"`
 	}
 
 	JwtCredentials struct {
@@ -161,8 +244,11 @@ type (
 	}
 
 	// Blobstore contains the config for blobstore
+	// Only one of the blobstores should be configured
 	Blobstore struct {
-		Filestore *FileBlobstore `yaml:"filestore"`
+		Filestore *FileBlobstore     `yaml:"filestore"`
+		S3store   *S3Blobstore       `yaml:"s3store"`
+		Gstorage  *GstorageBlobstore `yaml:"gstorage"`
 	}
 
 	// FileBlobstore contains the config for a file backed blobstore
@@ -170,6 +256,24 @@ type (
 		OutputDirectory string `yaml:"outputDirectory"`
 	}
 
+	// S3Blobstore contains the config for a blobstore backed by S3 or a S3 compatible storage
+	S3Blobstore struct {
+		S3Archiver `yaml:",inline"`
+		// Bucket is the name of the bucket to store the blobs in, it must already exist
+		Bucket string `yaml:"bucket"`
+		// KeyPrefix is prepended to the key of every blob, it's optional
+		KeyPrefix string `yaml:"keyPrefix"`
+	}
+
+	// GstorageBlobstore contains the config for a blobstore backed by google cloud storage
+	GstorageBlobstore struct {
+		GstorageArchiver `yaml:",inline"`
+		// Bucket is the name of the bucket to store the blobs in, it must already exist
+		Bucket string `yaml:"bucket"`
+		// KeyPrefix is prepended to the name of every blob, it's optional
+		KeyPrefix string `yaml:"keyPrefix"`
+	}
+
 	// Persistence contains the configuration for data store / persistence layer
 	Persistence struct {
 		// DefaultStore is the name of the default data store to use
@@ -234,7 +338,7 @@ type (
 		AllowedAuthenticators []string `yaml:"allowedAuthenticators"`
 		// Keyspace is the cassandra keyspace
 		Keyspace string `yaml:"keyspace"`
-		// Region is the region filter arg for cassandra
+		// Region is the region filter arg for cassandra, or the AWS region for DynamoDB
 		Region string `yaml:"region"`
 		// Datacenter is the data center filter arg for cassandra
 		Datacenter string `yaml:"datacenter"`
@@ -248,6 +352,7 @@ type (
 		// Use it ONLY when a configure is too specific to a particular NoSQL database that should not be in the common struct
 		// Otherwise please add new fields to the struct for better documentation
 		// If being used in any database, update this comment here to make it clear
+		// MongoDB: appended as the options of the connection string, e.g. replicaSet, authSource
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 	}
 
@@ -488,6 +593,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		HTTPStore *HTTPStoreArchiver `yaml:"httpstore"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -505,12 +611,30 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		HTTPStore *HTTPStoreArchiver `yaml:"httpstore"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// Compression is the compression of archived histories, one of none, gzip and zstd. Default to none.
+		// Histories archived with any compression can always be read.
+		Compression string `yaml:"compression"`
+		// Encryption is the config for encrypting archived histories, they are not encrypted if it's nil
+		Encryption *FilestoreArchiverEncryption `yaml:"encryption"`
+	}
+
+	// FilestoreArchiverEncryption contains the keys for envelope encryption of archived histories.
+	// Each history is encrypted with its own data key, which is then encrypted with the key-encryption key and stored along with it.
+	FilestoreArchiverEncryption struct {
+		// KeyID identifies the key-encryption key used for new histories, it's stored in every encrypted history
+		KeyID string `yaml:"keyID"`
+		// KeyFile is the path to the file of the key-encryption key, which contains a base64 encoded 32 bytes AES-256 key
+		KeyFile string `yaml:"keyFile"`
+		// PreviousKeyFiles are the key files of the previous key-encryption keys by their key ids,
+		// so histories encrypted before a key rotation can still be read
+		PreviousKeyFiles map[string]string `yaml:"previousKeyFiles"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
@@ -525,6 +649,27 @@ type (
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
 	}
 
+	// HTTPStoreArchiver contains the config for the archiver backed by a generic S3 compatible HTTP object store
+	HTTPStoreArchiver struct {
+		// Endpoint is the base url of the object store, e.g. http://127.0.0.1:9000
+		Endpoint string `yaml:"endpoint"`
+		// AuthToken is sent as a bearer token with every request, it's optional
+		// and can't be used together with AccessKeyID
+		AuthToken string `yaml:"authToken"`
+		// AccessKeyID and SecretAccessKey are used to sign every request with AWS signature version 4,
+		// which is required by private buckets of S3 compatible stores, e.g. MinIO or Ceph RGW. They're optional
+		AccessKeyID     string `yaml:"accessKeyID"`
+		SecretAccessKey string `yaml:"secretAccessKey"`
+		// Region is the region requests are signed for, default to us-east-1
+		Region string `yaml:"region"`
+		// Timeout is the timeout of each request to the object store, default to 60s
+		Timeout time.Duration `yaml:"timeout"`
+		// Headers are additional headers sent with every request, it's optional
+		Headers map[string]string `yaml:"headers"`
+		// TLS is the tls config used to connect to an https endpoint, it's optional
+		TLS TLS `yaml:"tls"`
+	}
+
 	// PublicClient is config for connecting to cadence frontend
 	PublicClient struct {
 		// HostPort is the host port to connect on. Host can be DNS name
@@ -603,6 +748,9 @@ func (c *Config) validate() error {
 	if err := c.Archival.Validate(&c.DomainDefaults.Archival); err != nil {
 		return err
 	}
+	if err := c.Blobstore.Validate(); err != nil {
+		return err
+	}
 
 	return c.Authorization.Validate()
 }
@@ -157,8 +157,10 @@ const (
 	// DomainDataKeyForPreferredCluster is the key of DomainData for domain rebalance
 	DomainDataKeyForPreferredCluster = "PreferredCluster"
 	// DomainDataKeyForReadGroups stores which groups have read permission of the domain API
+	// Deprecated: use the role bindings of the domain configuration instead
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
+	// Deprecated: use the role bindings of the domain configuration instead
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
 )
 
@@ -253,6 +255,16 @@ const (
 	LowPrioritySubclass
 )
 
+const (
+	// TaskPriorityHeaderKey is the key of the workflow or activity header which holds the dispatch priority of its
+	// decision or activity tasks. The value is a non-negative decimal integer, tasks with a higher priority are
+	// dispatched to pollers before tasks with the default priority of zero
+	TaskPriorityHeaderKey = "cadence-task-priority"
+	// FairnessKeyHeaderKey is the key of the workflow header which holds the fairness key of the workflow, matching
+	// shares the pollers of a task list fairly between the fairness keys of the tasks in its backlog
+	FairnessKeyHeaderKey = "cadence-fairness-key"
+)
+
 const (
 	// DefaultHistoryMaxAutoResetPoints is the default maximum number for auto reset points
 	DefaultHistoryMaxAutoResetPoints = 20
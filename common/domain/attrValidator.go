@@ -58,6 +58,16 @@ func (d *AttrValidatorImpl) validateDomainConfig(config *persistence.DomainConfi
 	if config.VisibilityArchivalStatus == types.ArchivalStatusEnabled && len(config.VisibilityArchivalURI) == 0 {
 		return errInvalidArchivalConfig
 	}
+	for _, binding := range config.RoleBindings {
+		if binding == nil || binding.GetPrincipal() == "" || binding.Role == nil {
+			return errInvalidRoleBinding
+		}
+		switch binding.GetRole() {
+		case types.DomainRoleRead, types.DomainRoleWrite, types.DomainRoleAdmin:
+		default:
+			return errInvalidRoleBinding
+		}
+	}
 	return nil
 }
 
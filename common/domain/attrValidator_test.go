@@ -84,6 +84,44 @@ func (s *attrValidatorSuite) TestValidateConfigRetentionPeriod() {
 	}
 }
 
+func (s *attrValidatorSuite) TestValidateConfigRoleBindings() {
+	testCases := []struct {
+		msg          string
+		roleBindings []*types.RoleBinding
+		expectedErr  error
+	}{
+		{
+			msg: "valid bindings",
+			roleBindings: []*types.RoleBinding{
+				{Principal: "team-a", Role: types.DomainRoleWrite.Ptr()},
+				{Principal: "cadence-admins", Role: types.DomainRoleAdmin.Ptr()},
+			},
+			expectedErr: nil,
+		},
+		{
+			msg:          "empty principal",
+			roleBindings: []*types.RoleBinding{{Role: types.DomainRoleRead.Ptr()}},
+			expectedErr:  errInvalidRoleBinding,
+		},
+		{
+			msg:          "missing role",
+			roleBindings: []*types.RoleBinding{{Principal: "team-a"}},
+			expectedErr:  errInvalidRoleBinding,
+		},
+		{
+			msg:          "unknown role",
+			roleBindings: []*types.RoleBinding{{Principal: "team-a", Role: types.DomainRole(7).Ptr()}},
+			expectedErr:  errInvalidRoleBinding,
+		},
+	}
+	for _, tc := range testCases {
+		actualErr := s.validator.validateDomainConfig(
+			&persistence.DomainConfig{Retention: 10, RoleBindings: tc.roleBindings},
+		)
+		s.Equal(tc.expectedErr, actualErr, tc.msg)
+	}
+}
+
 func (s *attrValidatorSuite) TestClusterName() {
 	err := s.validator.validateClusterName("some random foo bar")
 	s.IsType(&types.BadRequestError{}, err)
@@ -37,4 +37,5 @@ var (
 
 	errInvalidRetentionPeriod = &types.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &types.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
+	errInvalidRoleBinding     = &types.BadRequestError{Message: "A role binding must have a principal and one of the read, write or admin roles."}
 )
@@ -787,6 +787,7 @@ func (d *handlerImpl) createResponse(
 		VisibilityArchivalURI:                  config.VisibilityArchivalURI,
 		BadBinaries:                            &config.BadBinaries,
 		IsolationGroups:                        &config.IsolationGroups,
+		RoleBindings:                           config.RoleBindings,
 	}
 
 	clusters := []*types.ClusterReplicationConfiguration{}
@@ -997,6 +998,11 @@ func (d *handlerImpl) updateDomainConfiguration(
 			}
 		}
 	}
+	if updateRequest.RoleBindings != nil {
+		isConfigChanged = true
+		// role bindings are replaced as a whole, an empty list removes all of them
+		config.RoleBindings = updateRequest.RoleBindings
+	}
 	return config, isConfigChanged, nil
 }
 
@@ -440,6 +440,53 @@ func (s *domainHandlerCommonSuite) TestUpdateDomain_InvalidRetentionPeriod() {
 	s.Equal(errInvalidRetentionPeriod, err)
 }
 
+func (s *domainHandlerCommonSuite) TestUpdateDomain_RoleBindings() {
+	domain := s.getRandomDomainName()
+	registerRequest := &types.RegisterDomainRequest{
+		Name:                                   domain,
+		Description:                            domain,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+		IsGlobalDomain:                         false,
+	}
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+
+	roleBindings := []*types.RoleBinding{
+		{Principal: "team-a", Role: types.DomainRoleWrite.Ptr()},
+		{Principal: "cadence-admins", Role: types.DomainRoleAdmin.Ptr()},
+	}
+	resp, err := s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:         domain,
+		RoleBindings: roleBindings,
+	})
+	s.NoError(err)
+	s.Equal(roleBindings, resp.Configuration.RoleBindings)
+
+	// updates without role bindings leave them untouched
+	resp, err = s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:        domain,
+		Description: common.StringPtr("new description"),
+	})
+	s.NoError(err)
+	s.Equal(roleBindings, resp.Configuration.RoleBindings)
+
+	// an empty list removes all role bindings
+	_, err = s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:         domain,
+		RoleBindings: []*types.RoleBinding{},
+	})
+	s.NoError(err)
+	describeResp, err := s.handler.DescribeDomain(context.Background(), &types.DescribeDomainRequest{Name: common.StringPtr(domain)})
+	s.NoError(err)
+	s.Empty(describeResp.Configuration.RoleBindings)
+
+	_, err = s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:         domain,
+		RoleBindings: []*types.RoleBinding{{Principal: "team-a"}},
+	})
+	s.Equal(errInvalidRoleBinding, err)
+}
+
 func (s *domainHandlerCommonSuite) TestUpdateDomain_GracefulFailover_Success() {
 	s.mockProducer.On("Publish", mock.Anything, mock.Anything).Return(nil).Twice()
 	domain := uuid.New()
@@ -54,6 +54,22 @@ type Client interface {
 	ListValue(name Key) ([]*types.DynamicConfigEntry, error)
 }
 
+// VersionedClient is a Client which keeps a bounded history of the versions of the config it stores,
+// with the author and time of every change, so that a change can be looked up and rolled back.
+type VersionedClient interface {
+	Client
+	// UpdateValueWithAuthor is UpdateValue recording the author of the change.
+	UpdateValueWithAuthor(name Key, value interface{}, author string) error
+	// RestoreValueWithAuthor is RestoreValue recording the author of the change.
+	RestoreValueWithAuthor(name Key, filters map[Filter]interface{}, author string) error
+	// ListValueHistory returns the stored versions, most recent first. If name is not nil, only the versions
+	// which changed the key are returned, each with the entry of the key only.
+	ListValueHistory(name Key) ([]*types.DynamicConfigVersion, error)
+	// RollbackValue stores the values of a previous version as a new version. If name is not nil, only the
+	// values of the key are rolled back.
+	RollbackValue(version int64, name Key, author string) error
+}
+
 var NotFoundError = &types.EntityNotExistsError{
 	Message: "unable to find key",
 }
@@ -235,3 +235,261 @@ func (mr *MockClientMockRecorder) UpdateValue(name, value interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValue", reflect.TypeOf((*MockClient)(nil).UpdateValue), name, value)
 }
+
+// MockVersionedClient is a mock of VersionedClient interface.
+type MockVersionedClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionedClientMockRecorder
+}
+
+// MockVersionedClientMockRecorder is the mock recorder for MockVersionedClient.
+type MockVersionedClientMockRecorder struct {
+	mock *MockVersionedClient
+}
+
+// NewMockVersionedClient creates a new mock instance.
+func NewMockVersionedClient(ctrl *gomock.Controller) *MockVersionedClient {
+	mock := &MockVersionedClient{ctrl: ctrl}
+	mock.recorder = &MockVersionedClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionedClient) EXPECT() *MockVersionedClientMockRecorder {
+	return m.recorder
+}
+
+// GetBoolValue mocks base method.
+func (m *MockVersionedClient) GetBoolValue(name BoolKey, filters map[Filter]interface{}) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoolValue", name, filters)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoolValue indicates an expected call of GetBoolValue.
+func (mr *MockVersionedClientMockRecorder) GetBoolValue(name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoolValue", reflect.TypeOf((*MockVersionedClient)(nil).GetBoolValue), name, filters)
+}
+
+// GetDurationValue mocks base method.
+func (m *MockVersionedClient) GetDurationValue(name DurationKey, filters map[Filter]interface{}) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDurationValue", name, filters)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDurationValue indicates an expected call of GetDurationValue.
+func (mr *MockVersionedClientMockRecorder) GetDurationValue(name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDurationValue", reflect.TypeOf((*MockVersionedClient)(nil).GetDurationValue), name, filters)
+}
+
+// GetFloatValue mocks base method.
+func (m *MockVersionedClient) GetFloatValue(name FloatKey, filters map[Filter]interface{}) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloatValue", name, filters)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloatValue indicates an expected call of GetFloatValue.
+func (mr *MockVersionedClientMockRecorder) GetFloatValue(name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloatValue", reflect.TypeOf((*MockVersionedClient)(nil).GetFloatValue), name, filters)
+}
+
+// GetIntValue mocks base method.
+func (m *MockVersionedClient) GetIntValue(name IntKey, filters map[Filter]interface{}) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIntValue", name, filters)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIntValue indicates an expected call of GetIntValue.
+func (mr *MockVersionedClientMockRecorder) GetIntValue(name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIntValue", reflect.TypeOf((*MockVersionedClient)(nil).GetIntValue), name, filters)
+}
+
+// GetListValue mocks base method.
+func (m *MockVersionedClient) GetListValue(name ListKey, filters map[Filter]interface{}) ([]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListValue", name, filters)
+	ret0, _ := ret[0].([]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListValue indicates an expected call of GetListValue.
+func (mr *MockVersionedClientMockRecorder) GetListValue(name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListValue", reflect.TypeOf((*MockVersionedClient)(nil).GetListValue), name, filters)
+}
+
+// GetMapValue mocks base method.
+func (m *MockVersionedClient) GetMapValue(name MapKey, filters map[Filter]interface{}) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMapValue", name, filters)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMapValue indicates an expected call of GetMapValue.
+func (mr *MockVersionedClientMockRecorder) GetMapValue(name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMapValue", reflect.TypeOf((*MockVersionedClient)(nil).GetMapValue), name, filters)
+}
+
+// GetStringValue mocks base method.
+func (m *MockVersionedClient) GetStringValue(name StringKey, filters map[Filter]interface{}) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStringValue", name, filters)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStringValue indicates an expected call of GetStringValue.
+func (mr *MockVersionedClientMockRecorder) GetStringValue(name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStringValue", reflect.TypeOf((*MockVersionedClient)(nil).GetStringValue), name, filters)
+}
+
+// GetValue mocks base method.
+func (m *MockVersionedClient) GetValue(name Key) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValue", name)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValue indicates an expected call of GetValue.
+func (mr *MockVersionedClientMockRecorder) GetValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValue", reflect.TypeOf((*MockVersionedClient)(nil).GetValue), name)
+}
+
+// GetValueWithFilters mocks base method.
+func (m *MockVersionedClient) GetValueWithFilters(name Key, filters map[Filter]interface{}) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValueWithFilters", name, filters)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValueWithFilters indicates an expected call of GetValueWithFilters.
+func (mr *MockVersionedClientMockRecorder) GetValueWithFilters(name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValueWithFilters", reflect.TypeOf((*MockVersionedClient)(nil).GetValueWithFilters), name, filters)
+}
+
+// ListValue mocks base method.
+func (m *MockVersionedClient) ListValue(name Key) ([]*types.DynamicConfigEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListValue", name)
+	ret0, _ := ret[0].([]*types.DynamicConfigEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListValue indicates an expected call of ListValue.
+func (mr *MockVersionedClientMockRecorder) ListValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListValue", reflect.TypeOf((*MockVersionedClient)(nil).ListValue), name)
+}
+
+// ListValueHistory mocks base method.
+func (m *MockVersionedClient) ListValueHistory(name Key) ([]*types.DynamicConfigVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListValueHistory", name)
+	ret0, _ := ret[0].([]*types.DynamicConfigVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListValueHistory indicates an expected call of ListValueHistory.
+func (mr *MockVersionedClientMockRecorder) ListValueHistory(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListValueHistory", reflect.TypeOf((*MockVersionedClient)(nil).ListValueHistory), name)
+}
+
+// RestoreValue mocks base method.
+func (m *MockVersionedClient) RestoreValue(name Key, filters map[Filter]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreValue", name, filters)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreValue indicates an expected call of RestoreValue.
+func (mr *MockVersionedClientMockRecorder) RestoreValue(name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreValue", reflect.TypeOf((*MockVersionedClient)(nil).RestoreValue), name, filters)
+}
+
+// RestoreValueWithAuthor mocks base method.
+func (m *MockVersionedClient) RestoreValueWithAuthor(name Key, filters map[Filter]interface{}, author string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreValueWithAuthor", name, filters, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreValueWithAuthor indicates an expected call of RestoreValueWithAuthor.
+func (mr *MockVersionedClientMockRecorder) RestoreValueWithAuthor(name, filters, author interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreValueWithAuthor", reflect.TypeOf((*MockVersionedClient)(nil).RestoreValueWithAuthor), name, filters, author)
+}
+
+// RollbackValue mocks base method.
+func (m *MockVersionedClient) RollbackValue(version int64, name Key, author string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackValue", version, name, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackValue indicates an expected call of RollbackValue.
+func (mr *MockVersionedClientMockRecorder) RollbackValue(version, name, author interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValue", reflect.TypeOf((*MockVersionedClient)(nil).RollbackValue), version, name, author)
+}
+
+// UpdateValue mocks base method.
+func (m *MockVersionedClient) UpdateValue(name Key, value interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateValue", name, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateValue indicates an expected call of UpdateValue.
+func (mr *MockVersionedClientMockRecorder) UpdateValue(name, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValue", reflect.TypeOf((*MockVersionedClient)(nil).UpdateValue), name, value)
+}
+
+// UpdateValueWithAuthor mocks base method.
+func (m *MockVersionedClient) UpdateValueWithAuthor(name Key, value interface{}, author string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateValueWithAuthor", name, value, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateValueWithAuthor indicates an expected call of UpdateValueWithAuthor.
+func (mr *MockVersionedClientMockRecorder) UpdateValueWithAuthor(name, value, author interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValueWithAuthor", reflect.TypeOf((*MockVersionedClient)(nil).UpdateValueWithAuthor), name, value, author)
+}
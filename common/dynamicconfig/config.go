@@ -116,6 +116,12 @@ type IntPropertyFnWithDomainFilter func(domain string) int
 // IntPropertyFnWithTaskListInfoFilters is a wrapper to get int property from dynamic config with three filters: domain, taskList, taskType
 type IntPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) int
 
+// IntPropertyFnWithCallerIdentityFilter is a wrapper to get int property from dynamic config with domain and caller identity as filters
+type IntPropertyFnWithCallerIdentityFilter func(domain string, callerIdentity string) int
+
+// IntPropertyFnWithRPCMethodFilter is a wrapper to get int property from dynamic config with domain and RPC method as filters
+type IntPropertyFnWithRPCMethodFilter func(domain string, rpcMethod string) int
+
 // IntPropertyFnWithShardIDFilter is a wrapper to get int property from dynamic config with shardID as filter
 type IntPropertyFnWithShardIDFilter func(shardID int) int
 
@@ -137,6 +143,9 @@ type DurationPropertyFnWithDomainIDFilter func(domainID string) time.Duration
 // DurationPropertyFnWithTaskListInfoFilters is a wrapper to get duration property from dynamic config  with three filters: domain, taskList, taskType
 type DurationPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) time.Duration
 
+// DurationPropertyFnWithIsolationGroupFilters is a wrapper to get duration property from dynamic config with four filters: domain, taskList, taskType, isolationGroup
+type DurationPropertyFnWithIsolationGroupFilters func(domain string, taskList string, taskType int, isolationGroup string) time.Duration
+
 // DurationPropertyFnWithShardIDFilter is a wrapper to get duration property from dynamic config with shardID as filter
 type DurationPropertyFnWithShardIDFilter func(shardID int) time.Duration
 
@@ -149,6 +158,9 @@ type StringPropertyFn func(opts ...FilterOption) string
 // MapPropertyFn is a wrapper to get map property from dynamic config
 type MapPropertyFn func(opts ...FilterOption) map[string]interface{}
 
+// MapPropertyFnWithDomainFilter is a wrapper to get map property from dynamic config with domain as filter
+type MapPropertyFnWithDomainFilter func(domain string) map[string]interface{}
+
 // StringPropertyFnWithDomainFilter is a wrapper to get string property from dynamic config
 type StringPropertyFnWithDomainFilter func(domain string) string
 
@@ -220,6 +232,46 @@ func (c *Collection) GetIntPropertyFilteredByDomain(key IntKey) IntPropertyFnWit
 	}
 }
 
+// GetIntPropertyFilteredByCallerIdentity gets property with domain and caller identity as filters and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByCallerIdentity(key IntKey) IntPropertyFnWithCallerIdentityFilter {
+	return func(domain string, callerIdentity string) int {
+		filters := c.toFilterMap(
+			DomainFilter(domain),
+			CallerIdentityFilter(callerIdentity),
+		)
+		val, err := c.client.GetIntValue(
+			key,
+			filters,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+			return key.DefaultInt()
+		}
+		c.logValue(key, filters, val, key.DefaultValue(), intCompareEquals)
+		return val
+	}
+}
+
+// GetIntPropertyFilteredByRPCMethod gets property with domain and RPC method as filters and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByRPCMethod(key IntKey) IntPropertyFnWithRPCMethodFilter {
+	return func(domain string, rpcMethod string) int {
+		filters := c.toFilterMap(
+			DomainFilter(domain),
+			RPCMethodFilter(rpcMethod),
+		)
+		val, err := c.client.GetIntValue(
+			key,
+			filters,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+			return key.DefaultInt()
+		}
+		c.logValue(key, filters, val, key.DefaultValue(), intCompareEquals)
+		return val
+	}
+}
+
 // GetIntPropertyFilteredByWorkflowType gets property with workflow type filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByWorkflowType(key IntKey) IntPropertyFnWithWorkflowTypeFilter {
 	return func(domainName string, workflowType string) int {
@@ -404,6 +456,28 @@ func (c *Collection) GetDurationPropertyFilteredByTaskListInfo(key DurationKey)
 	}
 }
 
+// GetDurationPropertyFilteredByIsolationGroup gets property with taskListInfo and isolation group as filters and asserts that it's a duration
+func (c *Collection) GetDurationPropertyFilteredByIsolationGroup(key DurationKey) DurationPropertyFnWithIsolationGroupFilters {
+	return func(domain string, taskList string, taskType int, isolationGroup string) time.Duration {
+		filters := c.toFilterMap(
+			DomainFilter(domain),
+			TaskListFilter(taskList),
+			TaskTypeFilter(taskType),
+			IsolationGroupFilter(isolationGroup),
+		)
+		val, err := c.client.GetDurationValue(
+			key,
+			filters,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+			return key.DefaultDuration()
+		}
+		c.logValue(key, filters, val, key.DefaultValue(), durationCompareEquals)
+		return val
+	}
+}
+
 // GetDurationPropertyFilteredByShardID gets property with shardID id as filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByShardID(key DurationKey) DurationPropertyFnWithShardIDFilter {
 	return func(shardID int) time.Duration {
@@ -472,6 +546,23 @@ func (c *Collection) GetMapProperty(key MapKey) MapPropertyFn {
 	}
 }
 
+// GetMapPropertyFilteredByDomain gets property with domain filter and asserts that it's a map
+func (c *Collection) GetMapPropertyFilteredByDomain(key MapKey) MapPropertyFnWithDomainFilter {
+	return func(domain string) map[string]interface{} {
+		filters := c.toFilterMap(DomainFilter(domain))
+		val, err := c.client.GetMapValue(
+			key,
+			filters,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+			return key.DefaultMap()
+		}
+		c.logValue(key, filters, val, key.DefaultValue(), reflect.DeepEqual)
+		return val
+	}
+}
+
 // GetStringPropertyFilteredByDomain gets property with domain filter and asserts that it's a string
 func (c *Collection) GetStringPropertyFilteredByDomain(key StringKey) StringPropertyFnWithDomainFilter {
 	return func(domain string) string {
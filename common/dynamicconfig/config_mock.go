@@ -44,6 +44,16 @@ func GetIntPropertyFilteredByShardID(value int) func(shardID int) int {
 	return func(shardID int) int { return value }
 }
 
+// GetIntPropertyFilteredByCallerIdentity returns values as IntPropertyFnWithCallerIdentityFilter
+func GetIntPropertyFilteredByCallerIdentity(value int) func(domain string, callerIdentity string) int {
+	return func(domain string, callerIdentity string) int { return value }
+}
+
+// GetIntPropertyFilteredByRPCMethod returns values as IntPropertyFnWithRPCMethodFilter
+func GetIntPropertyFilteredByRPCMethod(value int) func(domain string, rpcMethod string) int {
+	return func(domain string, rpcMethod string) int { return value }
+}
+
 // GetIntPropertyFilteredByWorkflowType returns values as IntPropertyFnWithWorkflowTypeFilters
 func GetIntPropertyFilteredByWorkflowType(value int) func(domainName string, workflowType string) int {
 	return func(domainName string, workflowType string) int { return value }
@@ -89,6 +99,11 @@ func GetDurationPropertyFnFilteredByTaskListInfo(value time.Duration) func(domai
 	return func(domain string, taskList string, taskType int) time.Duration { return value }
 }
 
+// GetDurationPropertyFnFilteredByIsolationGroup returns value as DurationPropertyFnWithIsolationGroupFilters
+func GetDurationPropertyFnFilteredByIsolationGroup(value time.Duration) func(domain string, taskList string, taskType int, isolationGroup string) time.Duration {
+	return func(domain string, taskList string, taskType int, isolationGroup string) time.Duration { return value }
+}
+
 // GetDurationPropertyFnFilteredByShardID returns value as DurationPropertyFnWithShardIDFilter
 func GetDurationPropertyFnFilteredByShardID(value time.Duration) func(shardID int) time.Duration {
 	return func(shardID int) time.Duration { return value }
@@ -99,7 +114,17 @@ func GetStringPropertyFn(value string) func(opts ...FilterOption) string {
 	return func(...FilterOption) string { return value }
 }
 
+// GetStringPropertyFnFilteredByDomain returns value as StringPropertyFnWithDomainFilter
+func GetStringPropertyFnFilteredByDomain(value string) func(domain string) string {
+	return func(domain string) string { return value }
+}
+
 // GetMapPropertyFn returns value as MapPropertyFn
 func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) map[string]interface{} {
 	return func(...FilterOption) map[string]interface{} { return value }
 }
+
+// GetMapPropertyFnFilteredByDomain returns value as MapPropertyFnWithDomainFilter
+func GetMapPropertyFnFilteredByDomain(value map[string]interface{}) func(domain string) map[string]interface{} {
+	return func(domain string) map[string]interface{} { return value }
+}
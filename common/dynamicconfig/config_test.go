@@ -72,6 +72,26 @@ func (s *configSuite) TestGetIntPropertyFilteredByDomain() {
 	s.Equal(50, value(domain))
 }
 
+func (s *configSuite) TestGetIntPropertyFilteredByCallerIdentity() {
+	key := FrontendMaxDomainCallerRPSPerInstance
+	domain := "testDomain"
+	callerIdentity := "testCaller"
+	value := s.cln.GetIntPropertyFilteredByCallerIdentity(key)
+	s.Equal(key.DefaultInt(), value(domain, callerIdentity))
+	s.client.SetValue(key, 50)
+	s.Equal(50, value(domain, callerIdentity))
+}
+
+func (s *configSuite) TestGetIntPropertyFilteredByRPCMethod() {
+	key := FrontendMaxDomainAPIRPSPerInstance
+	domain := "testDomain"
+	rpcMethod := "ListWorkflowExecutions"
+	value := s.cln.GetIntPropertyFilteredByRPCMethod(key)
+	s.Equal(key.DefaultInt(), value(domain, rpcMethod))
+	s.client.SetValue(key, 10)
+	s.Equal(10, value(domain, rpcMethod))
+}
+
 func (s *configSuite) TestGetStringPropertyFnWithDomainFilter() {
 	key := DefaultEventEncoding
 	domain := "testDomain"
@@ -156,6 +176,18 @@ func (s *configSuite) TestGetDurationPropertyFilteredByTaskListInfo() {
 	s.Equal(time.Minute, value(domain, taskList, taskType))
 }
 
+func (s *configSuite) TestGetDurationPropertyFilteredByIsolationGroup() {
+	key := MatchingLongPollExpirationInterval
+	domain := "testDomain"
+	taskList := "testTaskList"
+	taskType := 0
+	isolationGroup := "zone-a"
+	value := s.cln.GetDurationPropertyFilteredByIsolationGroup(key)
+	s.Equal(key.DefaultDuration(), value(domain, taskList, taskType, isolationGroup))
+	s.client.SetValue(key, time.Second)
+	s.Equal(time.Second, value(domain, taskList, taskType, isolationGroup))
+}
+
 func (s *configSuite) TestGetMapProperty() {
 	key := TestGetMapPropertyKey
 	val := map[string]interface{}{
@@ -169,6 +201,18 @@ func (s *configSuite) TestGetMapProperty() {
 	s.Equal("321", value()["testKey"])
 }
 
+func (s *configSuite) TestGetMapPropertyFilteredByDomain() {
+	key := FrontendMaxDomainCallerTypeRPSPerInstance
+	domain := "testDomain"
+	value := s.cln.GetMapPropertyFilteredByDomain(key)
+	s.Equal(key.DefaultMap(), value(domain))
+	val := map[string]interface{}{
+		"cli": 10,
+	}
+	s.client.SetValue(key, val)
+	s.Equal(val, value(domain))
+}
+
 func (s *configSuite) TestUpdateConfig() {
 	key := TestGetBoolPropertyKey
 	value := s.cln.GetBoolProperty(key)
@@ -217,9 +261,75 @@ func TestDynamicConfigFilterTypeIsMapped(t *testing.T) {
 	require.Equal(t, int(LastFilterTypeForTest), len(filters))
 	for i := UnknownFilter; i < LastFilterTypeForTest; i++ {
 		require.NotEmpty(t, filters[i])
+		require.Equal(t, i, ParseFilter(i.String()))
+	}
+}
+
+func TestFilterSpecificity(t *testing.T) {
+	require.Equal(t, 0, Specificity(nil))
+	require.Greater(t, Specificity([]Filter{ClusterName}), Specificity(nil))
+	require.Greater(t, Specificity([]Filter{DomainName, ClusterName}), Specificity([]Filter{WorkflowID}))
+	require.Greater(t, Specificity([]Filter{WorkflowID}), Specificity([]Filter{WorkflowType}))
+	require.Greater(t, Specificity([]Filter{DomainName, TaskListName}), Specificity([]Filter{DomainName, TaskType}))
+	require.Greater(t, Specificity([]Filter{IsolationGroup}), Specificity([]Filter{DomainName}))
+	require.Equal(t, Specificity([]Filter{DomainName, CallerIdentity}), Specificity([]Filter{CallerIdentity, DomainName}))
+	for i := UnknownFilter + 1; i < LastFilterTypeForTest; i++ {
+		require.Greater(t, Specificity([]Filter{i}), Specificity([]Filter{UnknownFilter}), "missing precedence of filter %v", i)
+	}
+}
+
+func TestValidateValue(t *testing.T) {
+	testCases := []struct {
+		key   Key
+		value interface{}
+		valid bool
+	}{
+		{key: TestGetIntPropertyKey, value: 1, valid: true},
+		{key: TestGetIntPropertyKey, value: float64(1), valid: true},
+		{key: TestGetIntPropertyKey, value: 1.5, valid: false},
+		{key: TestGetIntPropertyKey, value: "1", valid: false},
+		{key: MatchingNumTasklistWritePartitions, value: 0, valid: false},
+		{key: MatchingNumTasklistWritePartitions, value: float64(4), valid: true},
+		{key: TestGetFloat64PropertyKey, value: 1, valid: true},
+		{key: TestGetFloat64PropertyKey, value: true, valid: false},
+		{key: PersistenceErrorInjectionRate, value: 0.5, valid: true},
+		{key: PersistenceErrorInjectionRate, value: 2, valid: false},
+		{key: TestGetBoolPropertyKey, value: "true", valid: false},
+		{key: TestGetStringPropertyKey, value: "value", valid: true},
+		{key: TestGetDurationPropertyKey, value: "1m", valid: true},
+		{key: TestGetDurationPropertyKey, value: time.Minute, valid: true},
+		{key: TestGetDurationPropertyKey, value: "wrong duration string", valid: false},
+		{key: TestGetDurationPropertyKey, value: 60, valid: false},
+		{key: TestGetMapPropertyKey, value: map[string]interface{}{"key": 1}, valid: true},
+		{key: TestGetMapPropertyKey, value: []interface{}{1}, valid: false},
+		{key: TestGetListPropertyKey, value: []interface{}{1}, valid: true},
+	}
+	for _, tc := range testCases {
+		err := ValidateValue(tc.key, tc.value)
+		if tc.valid {
+			require.NoError(t, err, "key: %v, value: %v", tc.key, tc.value)
+		} else {
+			require.Error(t, err, "key: %v, value: %v", tc.key, tc.value)
+		}
 	}
 }
 
+func TestValidateFilters(t *testing.T) {
+	// any known filter is allowed when the key declares no filter
+	require.NoError(t, ValidateFilters(TestGetIntPropertyKey, []string{DomainName.String(), ShardID.String()}))
+	require.Error(t, ValidateFilters(TestGetIntPropertyKey, []string{"unknownFilter"}))
+
+	require.NoError(t, ValidateFilters(MatchingNumTasklistWritePartitions, []string{DomainName.String(), TaskListName.String()}))
+	require.NoError(t, ValidateFilters(MatchingNumTasklistWritePartitions, []string{ClusterName.String(), DomainName.String()}))
+	require.Error(t, ValidateFilters(MatchingNumTasklistWritePartitions, []string{ShardID.String()}))
+	// filters which are only passed by the lookups of some keys are not allowed for the other keys
+	require.Error(t, ValidateFilters(MatchingNumTasklistWritePartitions, []string{IsolationGroup.String()}))
+	require.NoError(t, ValidateFilters(MatchingLongPollExpirationInterval, []string{DomainName.String(), IsolationGroup.String()}))
+	require.NoError(t, ValidateFilters(FrontendMaxDomainCallerRPSPerInstance, []string{DomainName.String(), CallerIdentity.String()}))
+	require.NoError(t, ValidateFilters(FrontendMaxDomainAPIRPSPerInstance, []string{DomainName.String(), RPCMethod.String()}))
+	require.Error(t, ValidateFilters(FrontendMaxDomainCallerRPSPerInstance, []string{RPCMethod.String()}))
+}
+
 func BenchmarkLogValue(b *testing.B) {
 	keys := []Key{
 		HistorySizeLimitError,
@@ -32,4 +32,7 @@ type ClientConfig struct {
 	UpdateRetryAttempts int           `yaml:"updateRetryAttempts"`
 	FetchTimeout        time.Duration `yaml:"FetchTimeout"`
 	UpdateTimeout       time.Duration `yaml:"UpdateTimeout"`
+	// HistorySize is the number of the latest versions listed and available for rollback, 10 if not set.
+	// Older versions are deleted, except the one the oldest listed version is compared to
+	HistorySize int `yaml:"historySize"`
 }
@@ -26,6 +26,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"reflect"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -43,16 +45,17 @@ import (
 
 //go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination configstore_mock.go -self_package github.com/uber/cadence/common/dynamicconfig/configstore
 
-var _ dc.Client = (*configStoreClient)(nil)
+var _ dc.VersionedClient = (*configStoreClient)(nil)
 
 // Client is a stateful config store
 type Client interface {
 	common.Daemon
-	dc.Client
+	dc.VersionedClient
 }
 
 const (
 	configStoreMinPollInterval = time.Second * 2
+	defaultHistorySize         = 10
 )
 
 var defaultConfigValues = &csc.ClientConfig{
@@ -283,14 +286,22 @@ func (csc *configStoreClient) GetListValue(name dc.ListKey, filters map[dc.Filte
 }
 
 func (csc *configStoreClient) UpdateValue(name dc.Key, value interface{}) error {
+	return csc.UpdateValueWithAuthor(name, value, "")
+}
+
+func (csc *configStoreClient) UpdateValueWithAuthor(name dc.Key, value interface{}, author string) error {
 	dcValues, ok := value.([]*types.DynamicConfigValue)
 	if !ok && value != nil {
 		return errors.New("invalid value")
 	}
-	return csc.updateValue(name, dcValues, csc.config.UpdateRetryAttempts)
+	return csc.updateValue(name, dcValues, author, csc.config.UpdateRetryAttempts)
 }
 
 func (csc *configStoreClient) RestoreValue(name dc.Key, filters map[dc.Filter]interface{}) error {
+	return csc.RestoreValueWithAuthor(name, filters, "")
+}
+
+func (csc *configStoreClient) RestoreValueWithAuthor(name dc.Key, filters map[dc.Filter]interface{}, author string) error {
 	//if empty filter provided, update fallback value.
 	//if u want to remove entire entry, just do update value with empty
 	loaded := csc.values.Load()
@@ -323,7 +334,7 @@ func (csc *configStoreClient) RestoreValue(name dc.Key, filters map[dc.Filter]in
 		}
 	}
 
-	return csc.updateValue(name, newValues, csc.config.UpdateRetryAttempts)
+	return csc.updateValue(name, newValues, author, csc.config.UpdateRetryAttempts)
 }
 
 func (csc *configStoreClient) ListValue(name dc.Key) ([]*types.DynamicConfigEntry, error) {
@@ -361,6 +372,82 @@ func (csc *configStoreClient) ListValue(name dc.Key) ([]*types.DynamicConfigEntr
 	return resList, nil
 }
 
+func (csc *configStoreClient) ListValueHistory(name dc.Key) ([]*types.DynamicConfigVersion, error) {
+	versions, err := csc.fetchVersions()
+	if err != nil {
+		return nil, err
+	}
+	history := versions
+	if len(history) > csc.historySize() {
+		history = history[:csc.historySize()]
+	}
+	if name == nil {
+		return history, nil
+	}
+
+	// a version changed the key if its entry of the key differs from the one of the previous version
+	keyName := name.String()
+	var resList []*types.DynamicConfigVersion
+	for i, version := range history {
+		entry := findDynamicConfigEntry(version.Entries, keyName)
+		if i == len(versions)-1 {
+			if entry == nil {
+				continue
+			}
+		} else if reflect.DeepEqual(entry, findDynamicConfigEntry(versions[i+1].Entries, keyName)) {
+			continue
+		}
+		resList = append(resList, copyDynamicConfigVersion(version, keyName))
+	}
+	return resList, nil
+}
+
+func (csc *configStoreClient) RollbackValue(version int64, name dc.Key, author string) error {
+	versions, err := csc.fetchVersions()
+	if err != nil {
+		return err
+	}
+	if len(versions) > csc.historySize() {
+		versions = versions[:csc.historySize()]
+	}
+	var target *types.DynamicConfigVersion
+	for _, previous := range versions {
+		if previous.Version == version {
+			target = previous
+			break
+		}
+	}
+	if target == nil {
+		return &types.EntityNotExistsError{Message: fmt.Sprintf("version %v is not found in the dynamic config history", version)}
+	}
+
+	return csc.updateEntries(func(currentCached cacheEntry) ([]*types.DynamicConfigEntry, error) {
+		if version == currentCached.cacheVersion {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("version %v is the current version", version)}
+		}
+
+		if name == nil {
+			newEntries := make([]*types.DynamicConfigEntry, 0, len(target.Entries))
+			for _, entry := range target.Entries {
+				newEntries = append(newEntries, copyDynamicConfigEntry(entry))
+			}
+			return newEntries, nil
+		}
+
+		keyName := name.String()
+		newEntries := make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries)+1)
+		for _, entry := range currentCached.dcEntries {
+			if entry.Name != keyName {
+				newEntries = append(newEntries, copyDynamicConfigEntry(entry))
+			}
+		}
+		if entry := findDynamicConfigEntry(target.Entries, keyName); entry != nil {
+			newEntries = append(newEntries, copyDynamicConfigEntry(entry))
+		}
+		return newEntries, nil
+	}, author, csc.config.UpdateRetryAttempts)
+}
+
 func (csc *configStoreClient) Stop() {
 	if !atomic.CompareAndSwapInt32(&csc.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
 		return
@@ -378,7 +465,7 @@ func (csc *configStoreClient) Start() {
 	}
 }
 
-func (csc *configStoreClient) updateValue(name dc.Key, dcValues []*types.DynamicConfigValue, retryAttempts int) error {
+func (csc *configStoreClient) updateValue(name dc.Key, dcValues []*types.DynamicConfigValue, author string, retryAttempts int) error {
 	//since values are not unique, no way to know if you are trying to update a specific value
 	//or if you want to add another of the same value with different filters.
 	//UpdateValue will replace everything associated with dc key.
@@ -386,57 +473,81 @@ func (csc *configStoreClient) updateValue(name dc.Key, dcValues []*types.Dynamic
 		if err := validateKeyDataBlobPair(name, dcValue.Value); err != nil {
 			return err
 		}
-	}
-	loaded := csc.values.Load()
-	var currentCached cacheEntry
-	if loaded == nil {
-		currentCached = cacheEntry{
-			cacheVersion:  0,
-			schemaVersion: 0,
-			dcEntries:     map[string]*types.DynamicConfigEntry{},
+		filterNames := make([]string, 0, len(dcValue.Filters))
+		for _, filter := range dcValue.Filters {
+			filterNames = append(filterNames, filter.Name)
+		}
+		if err := dc.ValidateFilters(name, filterNames); err != nil {
+			return err
 		}
-	} else {
-		currentCached = loaded.(cacheEntry)
 	}
 
-	keyName := name.String()
-	var newEntries []*types.DynamicConfigEntry
+	return csc.updateEntries(func(currentCached cacheEntry) ([]*types.DynamicConfigEntry, error) {
+		keyName := name.String()
+		var newEntries []*types.DynamicConfigEntry
 
-	existingEntry, entryExists := currentCached.dcEntries[keyName]
+		existingEntry, entryExists := currentCached.dcEntries[keyName]
 
-	if dcValues == nil || len(dcValues) == 0 {
-		newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
+		if dcValues == nil || len(dcValues) == 0 {
+			newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
 
-		for _, entry := range currentCached.dcEntries {
-			if entryExists && entry == existingEntry {
-				continue
-			} else {
-				newEntries = append(newEntries, copyDynamicConfigEntry(entry))
+			for _, entry := range currentCached.dcEntries {
+				if entryExists && entry == existingEntry {
+					continue
+				} else {
+					newEntries = append(newEntries, copyDynamicConfigEntry(entry))
+				}
 			}
-		}
-	} else {
-		if entryExists {
-			newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
 		} else {
-			newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries)+1)
-			newEntries = append(newEntries,
-				&types.DynamicConfigEntry{
-					Name:   keyName,
-					Values: dcValues,
-				})
-		}
-
-		for _, entry := range currentCached.dcEntries {
-			if entryExists && entry.Name == keyName {
+			if entryExists {
+				newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
+			} else {
+				newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries)+1)
 				newEntries = append(newEntries,
 					&types.DynamicConfigEntry{
 						Name:   keyName,
 						Values: dcValues,
 					})
-			} else {
-				newEntries = append(newEntries, copyDynamicConfigEntry(entry))
 			}
+
+			for _, entry := range currentCached.dcEntries {
+				if entryExists && entry.Name == keyName {
+					newEntries = append(newEntries,
+						&types.DynamicConfigEntry{
+							Name:   keyName,
+							Values: dcValues,
+						})
+				} else {
+					newEntries = append(newEntries, copyDynamicConfigEntry(entry))
+				}
+			}
+		}
+		return newEntries, nil
+	}, author, retryAttempts)
+}
+
+// updateEntries stores the entries returned by newEntriesFn for the cached snapshot as a new version.
+// newEntriesFn is called again with the latest snapshot when the version has been updated concurrently.
+func (csc *configStoreClient) updateEntries(
+	newEntriesFn func(currentCached cacheEntry) ([]*types.DynamicConfigEntry, error),
+	author string,
+	retryAttempts int,
+) error {
+	loaded := csc.values.Load()
+	var currentCached cacheEntry
+	if loaded == nil {
+		currentCached = cacheEntry{
+			cacheVersion:  0,
+			schemaVersion: 0,
+			dcEntries:     map[string]*types.DynamicConfigEntry{},
 		}
+	} else {
+		currentCached = loaded.(cacheEntry)
+	}
+
+	newEntries, err := newEntriesFn(currentCached)
+	if err != nil {
+		return err
 	}
 
 	newSnapshot := &persistence.DynamicConfigSnapshot{
@@ -444,13 +555,15 @@ func (csc *configStoreClient) updateValue(name dc.Key, dcValues []*types.Dynamic
 		Values: &types.DynamicConfigBlob{
 			SchemaVersion: currentCached.schemaVersion,
 			Entries:       newEntries,
+			Author:        author,
+			Timestamp:     time.Now().UnixNano(),
 		},
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), csc.config.UpdateTimeout)
 	defer cancel()
 
-	err := csc.configStoreManager.UpdateDynamicConfig(
+	err = csc.configStoreManager.UpdateDynamicConfig(
 		ctx,
 		&persistence.UpdateDynamicConfigRequest{
 			Snapshot: newSnapshot,
@@ -469,7 +582,7 @@ func (csc *configStoreClient) updateValue(name dc.Key, dcValues []*types.Dynamic
 				if err != nil {
 					return err
 				}
-				return csc.updateValue(name, dcValues, retryAttempts-1)
+				return csc.updateEntries(newEntriesFn, author, retryAttempts-1)
 			}
 
 			if retryAttempts == 0 {
@@ -477,10 +590,98 @@ func (csc *configStoreClient) updateValue(name dc.Key, dcValues []*types.Dynamic
 			}
 			return err
 		}
+		csc.deleteVersionsBefore(newSnapshot.Version - int64(csc.historySize()))
 		return nil
 	}
 }
 
+// deleteVersionsBefore deletes the versions older than the history, except the one the oldest version of the
+// history is compared to. The update is already persisted, so a failure is only logged and the versions are
+// deleted by the next update.
+func (csc *configStoreClient) deleteVersionsBefore(version int64) {
+	if version <= 1 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), csc.config.UpdateTimeout)
+	defer cancel()
+
+	err := csc.configStoreManager.DeleteDynamicConfigHistory(
+		ctx,
+		&persistence.DeleteDynamicConfigHistoryRequest{
+			BeforeVersion: version,
+		}, csc.configStoreType,
+	)
+	if err != nil {
+		csc.logger.Warn("Failed to delete old dynamic config versions", tag.Error(err))
+	}
+}
+
+func (csc *configStoreClient) historySize() int {
+	if csc.config.HistorySize == 0 {
+		return defaultHistorySize
+	}
+	return csc.config.HistorySize
+}
+
+// fetchVersions returns the persisted versions of the dynamic config from the latest one down,
+// the latest HistorySize versions are followed by the version the oldest of them is compared to
+func (csc *configStoreClient) fetchVersions() ([]*types.DynamicConfigVersion, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), csc.config.FetchTimeout)
+	defer cancel()
+
+	res, err := csc.configStoreManager.FetchDynamicConfigHistory(
+		ctx,
+		&persistence.FetchDynamicConfigHistoryRequest{
+			PageSize: csc.historySize() + 1,
+		}, csc.configStoreType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dynamic config history %v", err)
+	}
+
+	versions := make([]*types.DynamicConfigVersion, 0, len(res.Snapshots))
+	for _, snapshot := range res.Snapshots {
+		entries := make([]*types.DynamicConfigEntry, 0, len(snapshot.Values.Entries))
+		entries = append(entries, snapshot.Values.Entries...)
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].Name < entries[j].Name
+		})
+		versions = append(versions, &types.DynamicConfigVersion{
+			Version:   snapshot.Version,
+			Author:    snapshot.Values.Author,
+			Timestamp: snapshot.Values.Timestamp,
+			Entries:   entries,
+		})
+	}
+	return versions, nil
+}
+
+func findDynamicConfigEntry(entries []*types.DynamicConfigEntry, keyName string) *types.DynamicConfigEntry {
+	for _, entry := range entries {
+		if entry.Name == keyName {
+			return entry
+		}
+	}
+	return nil
+}
+
+// copyDynamicConfigVersion copies a version, with the entry of keyName only if keyName is not empty
+func copyDynamicConfigVersion(version *types.DynamicConfigVersion, keyName string) *types.DynamicConfigVersion {
+	newEntries := make([]*types.DynamicConfigEntry, 0, len(version.Entries))
+	for _, entry := range version.Entries {
+		if keyName == "" || entry.Name == keyName {
+			newEntries = append(newEntries, copyDynamicConfigEntry(entry))
+		}
+	}
+	return &types.DynamicConfigVersion{
+		Version:   version.Version,
+		Author:    version.Author,
+		Timestamp: version.Timestamp,
+		Entries:   newEntries,
+	}
+}
+
 func copyDynamicConfigEntry(entry *types.DynamicConfigEntry) *types.DynamicConfigEntry {
 	if entry == nil {
 		return nil
@@ -596,29 +797,30 @@ func (csc *configStoreClient) getValueWithFilters(key dc.Key, filters map[dc.Fil
 		return defaultValue, nil
 	}
 	cached := loaded.(cacheEntry)
-	found := false
 
+	var best *types.DynamicConfigValue
+	bestSpecificity := -1
 	if entry, ok := cached.dcEntries[keyName]; ok && entry != nil {
 		for _, dcValue := range entry.Values {
+			if !matchFilters(dcValue, filters) {
+				continue
+			}
 			if len(dcValue.Filters) == 0 {
-				parsedVal, err := convertFromDataBlob(dcValue.Value)
-
-				if err == nil {
-					defaultValue = parsedVal
-					found = true
+				// an undecodable value without filters falls back to the default value of the key
+				if _, err := convertFromDataBlob(dcValue.Value); err != nil {
+					continue
 				}
-				continue
 			}
-
-			if matchFilters(dcValue, filters) {
-				return convertFromDataBlob(dcValue.Value)
+			// the most specific value wins, the first one on a tie
+			if specificity := valueSpecificity(dcValue); specificity > bestSpecificity {
+				best, bestSpecificity = dcValue, specificity
 			}
 		}
 	}
-	if found {
-		return defaultValue, nil
+	if best == nil {
+		return defaultValue, dc.NotFoundError
 	}
-	return defaultValue, dc.NotFoundError
+	return convertFromDataBlob(best.Value)
 }
 
 func matchFilters(dcValue *types.DynamicConfigValue, filters map[dc.Filter]interface{}) bool {
@@ -640,6 +842,14 @@ func matchFilters(dcValue *types.DynamicConfigValue, filters map[dc.Filter]inter
 	return true
 }
 
+func valueSpecificity(dcValue *types.DynamicConfigValue) int {
+	filters := make([]dc.Filter, 0, len(dcValue.Filters))
+	for _, valueFilter := range dcValue.Filters {
+		filters = append(filters, dc.ParseFilter(valueFilter.Name))
+	}
+	return dc.Specificity(filters)
+}
+
 func validateClientConfig(config *csc.ClientConfig) error {
 	if config == nil {
 		return errors.New("no config found for config store based dynamic config client")
@@ -656,6 +866,9 @@ func validateClientConfig(config *csc.ClientConfig) error {
 	if config.UpdateTimeout <= 0 {
 		return errors.New("UpdateTimeout must be positive")
 	}
+	if config.HistorySize < 0 {
+		return errors.New("HistorySize must be non-negative")
+	}
 	return nil
 }
 
@@ -675,50 +888,5 @@ func validateKeyDataBlobPair(key dc.Key, blob *types.DataBlob) error {
 	if err != nil {
 		return err
 	}
-	err = fmt.Errorf("key value pair mismatch, key type: %T, value type: %T", key, value)
-	switch key.(type) {
-	case dc.IntKey:
-		if _, ok := value.(int); !ok {
-			floatVal, ok := value.(float64)
-			if !ok { // int can be decoded as float64
-				return err
-			}
-			if floatVal != math.Trunc(floatVal) {
-				return errors.New("value type is not int")
-			}
-		}
-	case dc.BoolKey:
-		if _, ok := value.(bool); !ok {
-			return err
-		}
-	case dc.FloatKey:
-		if _, ok := value.(float64); !ok {
-			return err
-		}
-	case dc.StringKey:
-		if _, ok := value.(string); !ok {
-			return err
-		}
-	case dc.DurationKey:
-		if _, ok := value.(time.Duration); !ok {
-			durationStr, ok := value.(string)
-			if !ok {
-				return err
-			}
-			if _, err = time.ParseDuration(durationStr); err != nil {
-				return errors.New("value string encoding cannot be parsed into duration")
-			}
-		}
-	case dc.MapKey:
-		if _, ok := value.(map[string]interface{}); !ok {
-			return err
-		}
-	case dc.ListKey:
-		if _, ok := value.([]interface{}); !ok {
-			return err
-		}
-	default:
-		return fmt.Errorf("unknown key type: %T", key)
-	}
-	return nil
+	return dc.ValidateValue(key, value)
 }
@@ -402,6 +402,68 @@ func (s *configStoreClientSuite) TestGetValueWithFilters_UnknownFilter() {
 	s.Equal(false, v)
 }
 
+func (s *configStoreClientSuite) TestGetValueWithFilters_Precedence() {
+	newValue := func(value int, filters map[string]string) *types.DynamicConfigValue {
+		dcValue := &types.DynamicConfigValue{
+			Value: &types.DataBlob{
+				EncodingType: types.EncodingTypeJSON.Ptr(),
+				Data:         jsonMarshalHelper(value),
+			},
+		}
+		for name, filterValue := range filters {
+			dcValue.Filters = append(dcValue.Filters, &types.DynamicConfigFilter{
+				Name: name,
+				Value: &types.DataBlob{
+					EncodingType: types.EncodingTypeJSON.Ptr(),
+					Data:         jsonMarshalHelper(filterValue),
+				},
+			})
+		}
+		return dcValue
+	}
+	snapshot1.Values.Entries = append(snapshot1.Values.Entries, &types.DynamicConfigEntry{
+		Name: dc.TestGetIntPropertyFilteredByTaskListInfoKey.String(),
+		Values: []*types.DynamicConfigValue{
+			newValue(1, map[string]string{"domainName": "samples-domain"}),
+			newValue(2, map[string]string{"domainName": "samples-domain", "taskListName": "sample-tasklist"}),
+			newValue(3, map[string]string{"callerIdentity": "sample-worker"}),
+			newValue(4, map[string]string{"isolationGroup": "zone-a"}),
+		},
+	})
+	defaultTestSetup(s)
+
+	testCases := []struct {
+		filters  map[dc.Filter]interface{}
+		expected int
+	}{
+		{
+			filters:  map[dc.Filter]interface{}{dc.DomainName: "samples-domain", dc.CallerIdentity: "other-worker"},
+			expected: 1,
+		},
+		{
+			filters:  map[dc.Filter]interface{}{dc.DomainName: "samples-domain", dc.TaskListName: "sample-tasklist", dc.CallerIdentity: "sample-worker"},
+			expected: 2,
+		},
+		{
+			filters:  map[dc.Filter]interface{}{dc.DomainName: "samples-domain", dc.CallerIdentity: "sample-worker"},
+			expected: 3,
+		},
+		{
+			filters:  map[dc.Filter]interface{}{dc.CallerIdentity: "sample-worker", dc.IsolationGroup: "zone-a"},
+			expected: 3,
+		},
+		{
+			filters:  map[dc.Filter]interface{}{dc.DomainName: "samples-domain", dc.IsolationGroup: "zone-a"},
+			expected: 4,
+		},
+	}
+	for _, tc := range testCases {
+		v, err := s.client.GetIntValue(dc.TestGetIntPropertyFilteredByTaskListInfoKey, tc.filters)
+		s.NoError(err)
+		s.Equal(tc.expected, v, "filters: %v", tc.filters)
+	}
+}
+
 func (s *configStoreClientSuite) TestGetIntValue() {
 	defaultTestSetup(s)
 	v, err := s.client.GetIntValue(dc.TestGetIntPropertyKey, nil)
@@ -741,6 +803,44 @@ func (s *configStoreClientSuite) TestUpdateValue_NoRetrySuccess() {
 	s.Equal(true, v)
 }
 
+func (s *configStoreClientSuite) TestUpdateValue_InvalidValue() {
+	defaultTestSetup(s)
+
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), gomock.Any(), p.DynamicConfig).
+		Times(0)
+
+	testCases := []*types.DynamicConfigValue{
+		// out of range
+		{
+			Value: &types.DataBlob{
+				EncodingType: types.EncodingTypeJSON.Ptr(),
+				Data:         jsonMarshalHelper(0),
+			},
+		},
+		// filter not declared for the key
+		{
+			Value: &types.DataBlob{
+				EncodingType: types.EncodingTypeJSON.Ptr(),
+				Data:         jsonMarshalHelper(4),
+			},
+			Filters: []*types.DynamicConfigFilter{
+				{
+					Name: dc.ShardID.String(),
+					Value: &types.DataBlob{
+						EncodingType: types.EncodingTypeJSON.Ptr(),
+						Data:         jsonMarshalHelper(1),
+					},
+				},
+			},
+		},
+	}
+	for _, value := range testCases {
+		err := s.client.UpdateValue(dc.MatchingNumTasklistWritePartitions, []*types.DynamicConfigValue{value})
+		s.Error(err)
+	}
+}
+
 func (s *configStoreClientSuite) TestUpdateValue_SuccessNewKey() {
 	values := []*types.DynamicConfigValue{
 		{
@@ -925,6 +1025,236 @@ func (s *configStoreClientSuite) TestListValues_EmptyCache() {
 	s.Nil(val)
 }
 
+func (s *configStoreClientSuite) TestUpdateValue_Author() {
+	defaultTestSetup(s)
+
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(2), p.DynamicConfig).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest, cfgType p.ConfigType) error {
+			s.Equal("tester", request.Snapshot.Values.Author)
+			s.NotZero(request.Snapshot.Values.Timestamp)
+			return nil
+		}).Times(1)
+
+	err := s.client.UpdateValueWithAuthor(dc.TestGetBoolPropertyKey, []*types.DynamicConfigValue{boolValueHelper(true)}, "tester")
+	s.NoError(err)
+}
+
+func (s *configStoreClientSuite) TestListValueHistory() {
+	historyTestSetup(s)
+
+	versions, err := s.client.ListValueHistory(nil)
+	s.NoError(err)
+	s.Equal(3, len(versions))
+	s.Equal(int64(3), versions[0].Version)
+	s.Equal("carol", versions[0].Author)
+	s.Equal(2, len(versions[0].Entries))
+	s.Equal(int64(1), versions[2].Version)
+
+	// the float key was only changed by version 2
+	versions, err = s.client.ListValueHistory(dc.TestGetFloat64PropertyKey)
+	s.NoError(err)
+	s.Equal(1, len(versions))
+	s.Equal(int64(2), versions[0].Version)
+	s.Equal("bob", versions[0].Author)
+	s.Equal(1, len(versions[0].Entries))
+	s.Equal(dc.TestGetFloat64PropertyKey.String(), versions[0].Entries[0].Name)
+
+	versions, err = s.client.ListValueHistory(dc.TestGetBoolPropertyKey)
+	s.NoError(err)
+	s.Equal(2, len(versions))
+	s.Equal(int64(3), versions[0].Version)
+	s.Equal(int64(1), versions[1].Version)
+}
+
+func (s *configStoreClientSuite) TestListValueHistory_HistorySize() {
+	s.client.config.HistorySize = 2
+	defer func() { s.client.config.HistorySize = 0 }()
+
+	s.mockManager.EXPECT().
+		FetchDynamicConfigHistory(gomock.Any(), &p.FetchDynamicConfigHistoryRequest{PageSize: 3}, p.DynamicConfig).
+		Return(&p.FetchDynamicConfigHistoryResponse{
+			Snapshots: historySnapshotsHelper(),
+		}, nil).Times(3)
+
+	versions, err := s.client.ListValueHistory(nil)
+	s.NoError(err)
+	s.Equal(2, len(versions))
+	s.Equal(int64(3), versions[0].Version)
+	s.Equal(int64(2), versions[1].Version)
+
+	// the oldest version is only fetched to find the changes of version 2
+	versions, err = s.client.ListValueHistory(dc.TestGetFloat64PropertyKey)
+	s.NoError(err)
+	s.Equal(1, len(versions))
+	s.Equal(int64(2), versions[0].Version)
+	versions, err = s.client.ListValueHistory(dc.TestGetBoolPropertyKey)
+	s.NoError(err)
+	s.Equal(1, len(versions))
+	s.Equal(int64(3), versions[0].Version)
+}
+
+func (s *configStoreClientSuite) TestListValueHistory_FetchError() {
+	s.mockManager.EXPECT().
+		FetchDynamicConfigHistory(gomock.Any(), gomock.Any(), p.DynamicConfig).
+		Return(nil, errors.New("fetch failed")).Times(1)
+
+	versions, err := s.client.ListValueHistory(nil)
+	s.Error(err)
+	s.Nil(versions)
+}
+
+func (s *configStoreClientSuite) TestRollbackValue() {
+	historyTestSetup(s)
+
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(4), p.DynamicConfig).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest, cfgType p.ConfigType) error {
+			s.Equal("dave", request.Snapshot.Values.Author)
+			s.Equal(1, len(request.Snapshot.Values.Entries))
+			s.Equal(dc.TestGetBoolPropertyKey.String(), request.Snapshot.Values.Entries[0].Name)
+			s.Equal(jsonMarshalHelper(false), request.Snapshot.Values.Entries[0].Values[0].Value.Data)
+			return nil
+		}).Times(1)
+
+	err := s.client.RollbackValue(1, nil, "dave")
+	s.NoError(err)
+}
+
+func (s *configStoreClientSuite) TestRollbackValue_SingleKey() {
+	historyTestSetup(s)
+
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(4), p.DynamicConfig).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest, cfgType p.ConfigType) error {
+			s.Equal(2, len(request.Snapshot.Values.Entries))
+			for _, entry := range request.Snapshot.Values.Entries {
+				if entry.Name == dc.TestGetBoolPropertyKey.String() {
+					s.Equal(jsonMarshalHelper(false), entry.Values[0].Value.Data)
+				} else {
+					s.Equal(jsonMarshalHelper(0.5), entry.Values[0].Value.Data)
+				}
+			}
+			return nil
+		}).Times(1)
+
+	err := s.client.RollbackValue(2, dc.TestGetBoolPropertyKey, "dave")
+	s.NoError(err)
+}
+
+func (s *configStoreClientSuite) TestRollbackValue_DeletesVersionsBeforeHistory() {
+	historyTestSetup(s)
+	s.client.config.HistorySize = 2
+	defer func() { s.client.config.HistorySize = 0 }()
+	s.mockManager.EXPECT().
+		FetchDynamicConfigHistory(gomock.Any(), &p.FetchDynamicConfigHistoryRequest{PageSize: 3}, p.DynamicConfig).
+		Return(&p.FetchDynamicConfigHistoryResponse{
+			Snapshots: historySnapshotsHelper(),
+		}, nil).AnyTimes()
+
+	// version 1 is not in the history
+	err := s.client.RollbackValue(1, nil, "dave")
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(4), p.DynamicConfig).
+		Return(nil).Times(1)
+	// versions 4 and 3 are kept for the history and version 2 to be compared to
+	s.mockManager.EXPECT().
+		DeleteDynamicConfigHistory(gomock.Any(), &p.DeleteDynamicConfigHistoryRequest{BeforeVersion: 2}, p.DynamicConfig).
+		Return(errors.New("delete failed")).Times(1)
+
+	// the update is persisted even though the old versions are not deleted
+	err = s.client.RollbackValue(2, nil, "dave")
+	s.NoError(err)
+}
+
+func (s *configStoreClientSuite) TestRollbackValue_VersionNotFound() {
+	historyTestSetup(s)
+
+	err := s.client.RollbackValue(5, nil, "dave")
+	s.IsType(&types.EntityNotExistsError{}, err)
+	err = s.client.RollbackValue(3, nil, "dave")
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+// historyTestSetup caches the latest of the snapshots returned by historySnapshotsHelper and serves them as the history
+func historyTestSetup(s *configStoreClientSuite) {
+	snapshots := historySnapshotsHelper()
+	s.mockManager.EXPECT().
+		FetchDynamicConfig(gomock.Any(), p.DynamicConfig).
+		Return(&p.FetchDynamicConfigResponse{
+			Snapshot: snapshots[0],
+		}, nil).AnyTimes()
+	s.mockManager.EXPECT().
+		FetchDynamicConfigHistory(gomock.Any(), &p.FetchDynamicConfigHistoryRequest{PageSize: defaultHistorySize + 1}, p.DynamicConfig).
+		Return(&p.FetchDynamicConfigHistoryResponse{
+			Snapshots: snapshots,
+		}, nil).AnyTimes()
+	s.client.update()
+}
+
+// historySnapshotsHelper returns versions 3 to 1 of a config where version 2 set the float key and version 3 the bool key
+func historySnapshotsHelper() []*p.DynamicConfigSnapshot {
+	boolEntryV1 := &types.DynamicConfigEntry{
+		Name:   dc.TestGetBoolPropertyKey.String(),
+		Values: []*types.DynamicConfigValue{boolValueHelper(false)},
+	}
+	boolEntryV3 := &types.DynamicConfigEntry{
+		Name:   dc.TestGetBoolPropertyKey.String(),
+		Values: []*types.DynamicConfigValue{boolValueHelper(true)},
+	}
+	floatEntry := &types.DynamicConfigEntry{
+		Name: dc.TestGetFloat64PropertyKey.String(),
+		Values: []*types.DynamicConfigValue{
+			{
+				Value: &types.DataBlob{
+					EncodingType: types.EncodingTypeJSON.Ptr(),
+					Data:         jsonMarshalHelper(0.5),
+				},
+			},
+		},
+	}
+	return []*p.DynamicConfigSnapshot{
+		{
+			Version: 3,
+			Values: &types.DynamicConfigBlob{
+				SchemaVersion: 1,
+				Entries:       []*types.DynamicConfigEntry{floatEntry, boolEntryV3},
+				Author:        "carol",
+				Timestamp:     3,
+			},
+		},
+		{
+			Version: 2,
+			Values: &types.DynamicConfigBlob{
+				SchemaVersion: 1,
+				Entries:       []*types.DynamicConfigEntry{boolEntryV1, floatEntry},
+				Author:        "bob",
+				Timestamp:     2,
+			},
+		},
+		{
+			Version: 1,
+			Values: &types.DynamicConfigBlob{
+				SchemaVersion: 1,
+				Entries:       []*types.DynamicConfigEntry{boolEntryV1},
+				Author:        "alice",
+				Timestamp:     1,
+			},
+		},
+	}
+}
+
+func boolValueHelper(v bool) *types.DynamicConfigValue {
+	return &types.DynamicConfigValue{
+		Value: &types.DataBlob{
+			EncodingType: types.EncodingTypeJSON.Ptr(),
+			Data:         jsonMarshalHelper(v),
+		},
+	}
+}
+
 func jsonMarshalHelper(v interface{}) []byte {
 	data, _ := json.Marshal(v)
 	return data
@@ -209,6 +209,21 @@ func (mr *MockClientMockRecorder) ListValue(name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListValue", reflect.TypeOf((*MockClient)(nil).ListValue), name)
 }
 
+// ListValueHistory mocks base method.
+func (m *MockClient) ListValueHistory(name dynamicconfig.Key) ([]*types.DynamicConfigVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListValueHistory", name)
+	ret0, _ := ret[0].([]*types.DynamicConfigVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListValueHistory indicates an expected call of ListValueHistory.
+func (mr *MockClientMockRecorder) ListValueHistory(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListValueHistory", reflect.TypeOf((*MockClient)(nil).ListValueHistory), name)
+}
+
 // RestoreValue mocks base method.
 func (m *MockClient) RestoreValue(name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}) error {
 	m.ctrl.T.Helper()
@@ -223,6 +238,34 @@ func (mr *MockClientMockRecorder) RestoreValue(name, filters interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreValue", reflect.TypeOf((*MockClient)(nil).RestoreValue), name, filters)
 }
 
+// RestoreValueWithAuthor mocks base method.
+func (m *MockClient) RestoreValueWithAuthor(name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, author string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreValueWithAuthor", name, filters, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreValueWithAuthor indicates an expected call of RestoreValueWithAuthor.
+func (mr *MockClientMockRecorder) RestoreValueWithAuthor(name, filters, author interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreValueWithAuthor", reflect.TypeOf((*MockClient)(nil).RestoreValueWithAuthor), name, filters, author)
+}
+
+// RollbackValue mocks base method.
+func (m *MockClient) RollbackValue(version int64, name dynamicconfig.Key, author string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackValue", version, name, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackValue indicates an expected call of RollbackValue.
+func (mr *MockClientMockRecorder) RollbackValue(version, name, author interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValue", reflect.TypeOf((*MockClient)(nil).RollbackValue), version, name, author)
+}
+
 // Start mocks base method.
 func (m *MockClient) Start() {
 	m.ctrl.T.Helper()
@@ -260,3 +303,17 @@ func (mr *MockClientMockRecorder) UpdateValue(name, value interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValue", reflect.TypeOf((*MockClient)(nil).UpdateValue), name, value)
 }
+
+// UpdateValueWithAuthor mocks base method.
+func (m *MockClient) UpdateValueWithAuthor(name dynamicconfig.Key, value interface{}, author string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateValueWithAuthor", name, value, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateValueWithAuthor indicates an expected call of UpdateValueWithAuthor.
+func (mr *MockClientMockRecorder) UpdateValueWithAuthor(name, value, author interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValueWithAuthor", reflect.TypeOf((*MockClient)(nil).UpdateValueWithAuthor), name, value, author)
+}
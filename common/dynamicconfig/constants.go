@@ -35,6 +35,8 @@ type (
 		KeyName      string
 		Description  string
 		DefaultValue int
+		// AllowedRange is the inclusive range of the values accepted for the key, any int value when not set
+		AllowedRange *IntRange
 		Filters      []Filter
 	}
 
@@ -49,6 +51,8 @@ type (
 		KeyName      string
 		Description  string
 		DefaultValue float64
+		// AllowedRange is the inclusive range of the values accepted for the key, any float value when not set
+		AllowedRange *FloatRange
 		Filters      []Filter
 	}
 
@@ -80,6 +84,18 @@ type (
 		Filters      []Filter
 	}
 
+	// IntRange is an inclusive range of int values
+	IntRange struct {
+		Min int
+		Max int
+	}
+
+	// FloatRange is an inclusive range of float values
+	FloatRange struct {
+		Min float64
+		Max float64
+	}
+
 	IntKey      int
 	BoolKey     int
 	FloatKey    int
@@ -98,6 +114,28 @@ type (
 	}
 )
 
+// ValueTypeName returns the name of the value type declared for the key
+func ValueTypeName(key Key) string {
+	switch key.(type) {
+	case IntKey:
+		return "int"
+	case BoolKey:
+		return "bool"
+	case FloatKey:
+		return "float"
+	case StringKey:
+		return "string"
+	case DurationKey:
+		return "duration"
+	case MapKey:
+		return "map"
+	case ListKey:
+		return "list"
+	default:
+		return "unknown"
+	}
+}
+
 // ListAllProductionKeys returns all key used in production
 func ListAllProductionKeys() []Key {
 	result := make([]Key, 0, len(IntKeys)+len(BoolKeys)+len(FloatKeys)+len(StringKeys)+len(DurationKeys)+len(MapKeys))
@@ -176,9 +214,106 @@ func ValidateKeyValuePair(key Key, value interface{}) error {
 	default:
 		return fmt.Errorf("unknown key type: %T", key)
 	}
+	return ValidateValue(key, value)
+}
+
+// ValidateValue validates a value against the schema of the key declared in the registry: the value type and,
+// for int and float keys, the allowed range. Values decoded from JSON or YAML are accepted, i.e. an int may be
+// an integral float64, a float may be an int and a duration may be a string parsed by time.ParseDuration.
+func ValidateValue(key Key, value interface{}) error {
+	typeErr := fmt.Errorf("value of %v is not %v but is: %T", key, ValueTypeName(key), value)
+	switch k := key.(type) {
+	case IntKey:
+		var intVal int
+		switch v := value.(type) {
+		case int:
+			intVal = v
+		case float64:
+			if v != math.Trunc(v) || v < math.MinInt64 || v > math.MaxInt64 {
+				return typeErr
+			}
+			intVal = int(v)
+		default:
+			return typeErr
+		}
+		if r := k.AllowedRange(); r != nil && (intVal < r.Min || intVal > r.Max) {
+			return fmt.Errorf("value %v of %v is out of the allowed range [%v, %v]", intVal, key, r.Min, r.Max)
+		}
+	case BoolKey:
+		if _, ok := value.(bool); !ok {
+			return typeErr
+		}
+	case FloatKey:
+		var floatVal float64
+		switch v := value.(type) {
+		case float64:
+			floatVal = v
+		case int:
+			floatVal = float64(v)
+		default:
+			return typeErr
+		}
+		if r := k.AllowedRange(); r != nil && (floatVal < r.Min || floatVal > r.Max) {
+			return fmt.Errorf("value %v of %v is out of the allowed range [%v, %v]", floatVal, key, r.Min, r.Max)
+		}
+	case StringKey:
+		if _, ok := value.(string); !ok {
+			return typeErr
+		}
+	case DurationKey:
+		switch v := value.(type) {
+		case time.Duration:
+		case string:
+			if _, err := time.ParseDuration(v); err != nil {
+				return fmt.Errorf("value %q of %v cannot be parsed into duration: %v", v, key, err)
+			}
+		default:
+			return typeErr
+		}
+	case MapKey:
+		if _, ok := value.(map[string]interface{}); !ok {
+			return typeErr
+		}
+	case ListKey:
+		if _, ok := value.([]interface{}); !ok {
+			return typeErr
+		}
+	default:
+		return fmt.Errorf("unknown key type: %T", key)
+	}
+	return nil
+}
+
+// ValidateFilters validates the names of the filters constraining a value of the key. When the registry declares
+// the filters of the key, only those and the cluster name, which every lookup of a service is filtered by, are allowed.
+func ValidateFilters(key Key, filterNames []string) error {
+	allowed := key.Filters()
+	for _, name := range filterNames {
+		filter := ParseFilter(name)
+		if filter == UnknownFilter {
+			return fmt.Errorf("unknown filter %q for %v", name, key)
+		}
+		if len(allowed) == 0 || filterInSlice(filter, contextFilters) || filterInSlice(filter, allowed) {
+			continue
+		}
+		return fmt.Errorf("filter %q is not allowed for %v, allowed filters: %v", name, key, allowed)
+	}
 	return nil
 }
 
+// contextFilters are the filters which may constrain the value of any key,
+// the collections of the services add them to all their lookups
+var contextFilters = []Filter{ClusterName}
+
+func filterInSlice(filter Filter, filters []Filter) bool {
+	for _, f := range filters {
+		if f == filter {
+			return true
+		}
+	}
+	return false
+}
+
 func (k IntKey) String() string {
 	return IntKeys[k].KeyName
 }
@@ -199,6 +334,11 @@ func (k IntKey) Filters() []Filter {
 	return IntKeys[k].Filters
 }
 
+// AllowedRange returns the range of the values accepted for the key, nil if any int value is accepted
+func (k IntKey) AllowedRange() *IntRange {
+	return IntKeys[k].AllowedRange
+}
+
 func (k BoolKey) String() string {
 	return BoolKeys[k].KeyName
 }
@@ -239,6 +379,11 @@ func (k FloatKey) Filters() []Filter {
 	return FloatKeys[k].Filters
 }
 
+// AllowedRange returns the range of the values accepted for the key, nil if any float value is accepted
+func (k FloatKey) AllowedRange() *FloatRange {
+	return FloatKeys[k].AllowedRange
+}
+
 func (k StringKey) String() string {
 	return StringKeys[k].KeyName
 }
@@ -544,6 +689,18 @@ const (
 	// Default value: UnlimitedRPS
 	// Allowed filters: DomainName
 	FrontendMaxDomainVisibilityRPSPerInstance
+	// FrontendMaxDomainCallerRPSPerInstance is the per-instance request rate limit per second of each caller identity within a domain. The identity is reported by the caller and not authenticated
+	// KeyName: frontend.domainCallerrps
+	// Value type: Int
+	// Default value: UnlimitedRPS
+	// Allowed filters: DomainName,CallerIdentity
+	FrontendMaxDomainCallerRPSPerInstance
+	// FrontendMaxDomainAPIRPSPerInstance is the per-instance request rate limit per second of each API within a domain, the API is the RPCMethod filter
+	// KeyName: frontend.domainAPIrps
+	// Value type: Int
+	// Default value: UnlimitedRPS, APIs without a value are only limited by the user, worker and visibility rate limits
+	// Allowed filters: DomainName,RPCMethod
+	FrontendMaxDomainAPIRPSPerInstance
 	// FrontendGlobalDomainUserRPS is workflow domain rate limit per second for the whole Cadence cluster
 	// KeyName: frontend.globalDomainrps
 	// Value type: Int
@@ -721,6 +878,36 @@ const (
 	// Default value: 20
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingPartitionUpscaleRPS is the add rate per write partition above which the adaptive scaler adds partitions to a task list
+	// KeyName: matching.partitionUpscaleRPS
+	// Value type: Int
+	// Default value: 200
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionUpscaleRPS
+	// MatchingAdaptiveScalerMaxPartitions is the max number of partitions the adaptive scaler can scale a task list to
+	// KeyName: matching.adaptiveScalerMaxPartitions
+	// Value type: Int
+	// Default value: 20
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingAdaptiveScalerMaxPartitions
+	// MatchingPriorityStarvationThreshold is the number of consecutive high priority tasks dispatched from a task list before a waiting default priority task is dispatched first, 0 means strict priority
+	// KeyName: matching.priorityStarvationThreshold
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPriorityStarvationThreshold
+	// MatchingFairnessKeyMaxBufferedTasks is the max number of backlog tasks of a single fairness key buffered by a task list in fairness mode, the tasks of the key past it are skipped and read again once the key has room so that the backlog of the other keys is read
+	// KeyName: matching.fairnessKeyMaxBufferedTasks
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingFairnessKeyMaxBufferedTasks
+	// MatchingFairnessMaxSkippedTasks is the max number of backlog tasks a task list skips in fairness mode while their fairness key has no room in the buffer, past it the task list waits for room instead of reading ahead
+	// KeyName: matching.fairnessMaxSkippedTasks
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingFairnessMaxSkippedTasks
 
 	// key for history
 
@@ -1384,6 +1571,16 @@ const (
 	// Value type: Int
 	// Default value: 100
 	SampleLoggingRate
+	// PersistenceMaxConcurrency is the max number of concurrent calls to a persistence datastore when adaptive concurrency is enabled
+	// KeyName: system.persistenceMaxConcurrency
+	// Value type: Int
+	// Default value: 1000
+	PersistenceMaxConcurrency
+	// PersistenceMinConcurrency is the min number of concurrent calls to a persistence datastore when adaptive concurrency is enabled
+	// KeyName: system.persistenceMinConcurrency
+	// Value type: Int
+	// Default value: 10
+	PersistenceMinConcurrency
 	// LargeShardHistorySizeMetricThreshold defines the threshold for what consititutes a large history storage size to alert on
 	// KeyName: system.largeShardHistorySizeMetricThreshold
 	// Value type: Int
@@ -1509,6 +1706,13 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	EnableClientVersionCheck
+	// FrontendEnableGlobalRatelimiter is whether frontends rebalance the global domain rate limits by the usage
+	// exchanged through the aggregators, instead of dividing them evenly by the number of frontend hosts
+	// KeyName: frontend.enableGlobalRatelimiter
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	FrontendEnableGlobalRatelimiter
 	// SendRawWorkflowHistory is whether to enable raw history retrieving
 	// KeyName: frontend.sendRawWorkflowHistory
 	// Value type: Bool
@@ -1542,6 +1746,18 @@ const (
 	// Default value: false
 	// Allowed filters: DomainID
 	MatchingEnableTaskInfoLogByDomainID
+	// MatchingEnableAdaptiveScaler is to enable automatic scaling of task list partitions based on the observed load
+	// KeyName: matching.enableAdaptiveScaler
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableAdaptiveScaler
+	// MatchingEnableTaskListFairness is to dispatch the backlog of a task list in weighted round robin order across the fairness keys of its tasks
+	// KeyName: matching.enableTaskListFairness
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableTaskListFairness
 
 	// key for history
 
@@ -1641,6 +1857,18 @@ const (
 	// Default value: true
 	// Allowed filters: DomainName
 	EnableActivityLocalDispatchByDomain
+	// EnableTaskPriority is to set the priority from workflow and activity headers on the decision and activity tasks pushed to matching
+	// KeyName: history.enableTaskPriority
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableTaskPriority
+	// SendActivityTypeToMatching is to send the activity type on the activity tasks pushed to matching, which is required by per activity type dispatch limits
+	// KeyName: history.sendActivityTypeToMatching
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	SendActivityTypeToMatching
 	// HistoryEnableTaskInfoLogByDomainID is enables info level logs for decision/activity task based on the request domainID
 	// KeyName: history.enableTaskInfoLogByDomainID
 	// Value type: Bool
@@ -1691,6 +1919,18 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	HistoryScannerEnabled
+	// ArchivalRetentionScannerEnabled indicates if archival retention scanner should be started as part of worker.Scanner
+	// KeyName: worker.archivalRetentionScannerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ArchivalRetentionScannerEnabled
+	// ArchivalRetentionScannerDryRun makes the archival retention scanner only report the archived workflows it would delete
+	// KeyName: worker.archivalRetentionScannerDryRun
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	ArchivalRetentionScannerDryRun
 	// ConcreteExecutionsScannerEnabled is indicates if executions scanner should be started as part of worker.Scanner
 	// KeyName: worker.executionsScannerEnabled
 	// Value type: Bool
@@ -1865,6 +2105,12 @@ const (
 	// Value type: Bool
 	// Default value: true
 	EnableShardIDMetrics
+	// EnablePersistenceAdaptiveConcurrency is whether persistence calls are limited by a concurrency adapting to the
+	// latency and errors of the datastore, shedding low priority calls (e.g. scanners, replication, visibility) first
+	// KeyName: system.enablePersistenceAdaptiveConcurrency
+	// Value type: Bool
+	// Default value: false
+	EnablePersistenceAdaptiveConcurrency
 	// LastBoolKey must be the last one in this const group
 	LastBoolKey
 )
@@ -1913,6 +2159,12 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	MatchingErrorInjectionRate
+	// MatchingPartitionDownscaleFactor is the fraction of the upscale threshold below which the adaptive scaler removes task list partitions
+	// KeyName: matching.partitionDownscaleFactor
+	// Value type: Float64
+	// Default value: 0.75
+	// Allowed filters: N/A
+	MatchingPartitionDownscaleFactor
 
 	// key for history
 
@@ -2058,6 +2310,12 @@ const (
 	// Default value: N/A
 	// TODO: https://github.com/uber/cadence/issues/3861
 	WorkerBlobIntegrityCheckProbability
+	// PersistenceLowPriorityConcurrencyRatio is the share of the persistence concurrency limit that low priority calls can use
+	// KeyName: system.persistenceLowPriorityConcurrencyRatio
+	// Value type: Float64
+	// Default value: 0.5
+	// Allowed filters: N/A
+	PersistenceLowPriorityConcurrencyRatio
 
 	// LastFloatKey must be the last one in this const group
 	LastFloatKey
@@ -2126,6 +2384,12 @@ const (
 	// Value type: string ["test-domain","test-domain2"]
 	// Default value: ""
 	ESAnalyzerWorkflowTypeMetricDomains
+	// FrontendFairnessKeySearchAttribute is the search attribute holding the fairness key of a workflow when the workflow header has none
+	// KeyName: frontend.fairnessKeySearchAttribute
+	// Value type: String
+	// Default value: "" => fairness key is only taken from the workflow header
+	// Allowed filters: DomainName
+	FrontendFairnessKeySearchAttribute
 
 	// LastStringKey must be the last one in this const group
 	LastStringKey
@@ -2139,6 +2403,18 @@ const (
 	TestGetDurationPropertyFilteredByDomainKey
 	TestGetDurationPropertyFilteredByTaskListInfoKey
 
+	// key for common & admin
+
+	// GlobalRatelimiterUpdateInterval is how often frontends report the usage of global rate limits to their aggregators.
+	// Aggregators drop the usage of hosts which haven't reported for 3 intervals
+	// KeyName: system.globalRatelimiterUpdateInterval
+	// Value type: Duration
+	// Default value: 3s (3*time.Second)
+	// Allowed filters: N/A
+	GlobalRatelimiterUpdateInterval
+
+	// key for frontend
+
 	// FrontendShutdownDrainDuration is the duration of traffic drain during shutdown
 	// KeyName: frontend.shutdownDrainDuration
 	// Value type: Duration
@@ -2162,7 +2438,7 @@ const (
 	// KeyName: matching.longPollExpirationInterval
 	// Value type: Duration
 	// Default value: time.Minute
-	// Allowed filters: DomainName,TasklistName,TasklistType
+	// Allowed filters: DomainName,TasklistName,TasklistType,IsolationGroup
 	MatchingLongPollExpirationInterval
 	// MatchingUpdateAckInterval is the interval for update ack
 	// KeyName: matching.updateAckInterval
@@ -2194,6 +2470,36 @@ const (
 	// Default value: 100ms
 	// Allowed filters: DomainName
 	MatchingActivityTaskSyncMatchWaitTime
+	// MatchingAdaptiveScalerUpdateInterval is the interval at which the adaptive scaler re-evaluates the number of partitions of a task list
+	// KeyName: matching.adaptiveScalerUpdateInterval
+	// Value type: Duration
+	// Default value: 15s (15*time.Second)
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingAdaptiveScalerUpdateInterval
+	// MatchingPartitionUpscaleSustainedDuration is the duration the add rate must stay above the upscale threshold before partitions are added
+	// KeyName: matching.partitionUpscaleSustainedDuration
+	// Value type: Duration
+	// Default value: 1m (1*time.Minute)
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionUpscaleSustainedDuration
+	// MatchingPartitionDownscaleSustainedDuration is the duration the add rate must stay below the downscale threshold before partitions are removed
+	// KeyName: matching.partitionDownscaleSustainedDuration
+	// Value type: Duration
+	// Default value: 2m (2*time.Minute)
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionDownscaleSustainedDuration
+	// MatchingPartitionConfigRefreshInterval is the interval at which matching clients refresh the cached partition config of a task list
+	// KeyName: matching.partitionConfigRefreshInterval
+	// Value type: Duration
+	// Default value: 10s (10*time.Second)
+	// Allowed filters: N/A
+	MatchingPartitionConfigRefreshInterval
+	// MatchingPriorityTaskSyncMatchWaitTime is the amount of time a high priority task waits to be sync matched before it is persisted
+	// KeyName: matching.priorityTaskSyncMatchWaitTime
+	// Value type: Duration
+	// Default value: 500ms (500*time.Millisecond)
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPriorityTaskSyncMatchWaitTime
 
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	// KeyName: history.longPollExpirationInterval
@@ -2541,6 +2847,12 @@ const (
 	// Default value: 10m (time.Minute*10)
 	// Allowed filters: N/A
 	WorkerReplicationTaskMaxRetryDuration
+	// ArchivalRetentionPeriod is how long archived histories and visibility records of a domain are kept, measured from workflow close time
+	// KeyName: worker.archivalRetentionPeriod
+	// Value type: Duration
+	// Default value: 0 (archived data is kept forever)
+	// Allowed filters: DomainName
+	ArchivalRetentionPeriod
 	// ESAnalyzerTimeWindow defines the time window ElasticSearch Analyzer will consider while taking workflow averages
 	// KeyName: worker.ESAnalyzerTimeWindow
 	// Value type: Duration
@@ -2601,6 +2913,13 @@ const (
 	// Default value: the default attributes of this release version, see definition.GetDefaultIndexedKeys()
 	// Allowed filters: N/A
 	ValidSearchAttributes
+	// FrontendMaxDomainCallerTypeRPSPerInstance is the per-instance request rate limit per second of each caller type within a domain,
+	// keyed by the client implementation header value such as cli, uber-go or uber-java
+	// KeyName: frontend.domainCallerTyperps
+	// Value type: Map
+	// Default value: nil, caller types not in the map are only limited by the user, worker and visibility rate limits
+	// Allowed filters: DomainName
+	FrontendMaxDomainCallerTypeRPSPerInstance
 
 	// key for history
 
@@ -2622,6 +2941,24 @@ const (
 	// Default value: see common.ConvertIntMapToDynamicConfigMapProperty(DefaultStuckTaskSplitThreshold) in code base
 	// Allowed filters: N/A
 	QueueProcessorStuckTaskSplitThreshold
+	// MatchingFairnessKeyWeights is the weight of each fairness key when dispatching the backlog of a task list in fairness mode, keyed by fairness key
+	// KeyName: matching.fairnessKeyWeights
+	// Value type: Map
+	// Default value: nil => every fairness key has a weight of 1
+	// Allowed filters: DomainName
+	MatchingFairnessKeyWeights
+	// MatchingWorkflowTypeDispatchRPS is the max rate at which decision tasks of a workflow type are dispatched, shared by all partitions of a task list, keyed by workflow type name
+	// KeyName: matching.workflowTypeDispatchRPS
+	// Value type: Map
+	// Default value: nil => no limit for any workflow type
+	// Allowed filters: DomainName
+	MatchingWorkflowTypeDispatchRPS
+	// MatchingActivityTypeDispatchRPS is the max rate at which activity tasks of an activity type are dispatched, shared by all partitions of a task list, keyed by activity type name
+	// KeyName: matching.activityTypeDispatchRPS
+	// Value type: Map
+	// Default value: nil => no limit for any activity type
+	// Allowed filters: DomainName
+	MatchingActivityTypeDispatchRPS
 
 	// LastMapKey must be the last one in this const group
 	LastMapKey
@@ -2691,11 +3028,13 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "system.maxDecisionStartToCloseSeconds",
 		Description:  "MaxDecisionStartToCloseSeconds is the maximum allowed value for decision start to close timeout in seconds",
 		DefaultValue: 240,
+		Filters:      []Filter{DomainName},
 	},
 	BlobSizeLimitError: DynamicInt{
 		KeyName:      "limit.blobSize.error",
 		Description:  "BlobSizeLimitError is the per event blob size limit",
 		DefaultValue: 2 * 1024 * 1024,
+		Filters:      []Filter{DomainName},
 	},
 	BlobSizeLimitWarn: DynamicInt{
 		KeyName:      "limit.blobSize.warn",
@@ -2829,12 +3168,14 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description: "deprecated: never used for ratelimiting, only sampling-based failure injection, and only on database-based visibility.\n" +
 			"FrontendVisibilityListMaxQPS is max qps frontend can list open/close workflows",
 		DefaultValue: 10,
+		Filters:      []Filter{DomainName},
 	},
 	FrontendESVisibilityListMaxQPS: DynamicInt{
 		KeyName: "frontend.esVisibilityListMaxQPS",
 		Description: "deprecated: never read from, all ES reads and writes erroneously use PersistenceMaxQPS.\n" +
 			"FrontendESVisibilityListMaxQPS is max qps frontend can list open/close workflows from ElasticSearch",
 		DefaultValue: 30,
+		Filters:      []Filter{DomainName},
 	},
 	FrontendESIndexMaxResultWindow: DynamicInt{
 		KeyName:      "frontend.esIndexMaxResultWindow",
@@ -2880,6 +3221,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "FrontendMaxDomainVisibilityRPSPerInstance is the per-instance List*WorkflowExecutions request rate limit per second",
 		DefaultValue: UnlimitedRPS,
 	},
+	FrontendMaxDomainCallerRPSPerInstance: DynamicInt{
+		KeyName:      "frontend.domainCallerrps",
+		Filters:      []Filter{DomainName, CallerIdentity},
+		Description:  "FrontendMaxDomainCallerRPSPerInstance is the per-instance request rate limit per second of each caller identity within a domain. The identity is reported by the caller and not authenticated",
+		DefaultValue: UnlimitedRPS,
+	},
+	FrontendMaxDomainAPIRPSPerInstance: DynamicInt{
+		KeyName:      "frontend.domainAPIrps",
+		Filters:      []Filter{DomainName, RPCMethod},
+		Description:  "FrontendMaxDomainAPIRPSPerInstance is the per-instance request rate limit per second of each API within a domain, the API is the RPCMethod filter",
+		DefaultValue: UnlimitedRPS,
+	},
 	FrontendGlobalDomainUserRPS: DynamicInt{
 		KeyName:      "frontend.globalDomainrps",
 		Filters:      []Filter{DomainName},
@@ -2957,11 +3310,13 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "matching.domainrps",
 		Description:  "MatchingDomainUserRPS is request rate per domain per second for each matching host",
 		DefaultValue: 0,
+		Filters:      []Filter{DomainName},
 	},
 	MatchingDomainWorkerRPS: DynamicInt{
 		KeyName:      "matching.domainworkerrps",
 		Description:  "MatchingDomainWorkerRPS is background-processing request rate per domain per second for each matching host",
 		DefaultValue: UnlimitedRPS,
+		Filters:      []Filter{DomainName},
 	},
 	MatchingPersistenceMaxQPS: DynamicInt{
 		KeyName:      "matching.persistenceMaxQPS",
@@ -3013,12 +3368,14 @@ var IntKeys = map[IntKey]DynamicInt{
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
 		Description:  "MatchingNumTasklistWritePartitions is the number of write partitions for a task list",
 		DefaultValue: 1,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
 	},
 	MatchingNumTasklistReadPartitions: DynamicInt{
 		KeyName:      "matching.numTasklistReadPartitions",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
 		Description:  "MatchingNumTasklistReadPartitions is the number of read partitions for a task list",
 		DefaultValue: 1,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
 	},
 	MatchingForwarderMaxOutstandingPolls: DynamicInt{
 		KeyName:      "matching.forwarderMaxOutstandingPolls",
@@ -3044,6 +3401,41 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task list partition tree",
 		DefaultValue: 20,
 	},
+	MatchingPartitionUpscaleRPS: DynamicInt{
+		KeyName:      "matching.partitionUpscaleRPS",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionUpscaleRPS is the add rate per write partition above which the adaptive scaler adds partitions to a task list",
+		DefaultValue: 200,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
+	},
+	MatchingAdaptiveScalerMaxPartitions: DynamicInt{
+		KeyName:      "matching.adaptiveScalerMaxPartitions",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingAdaptiveScalerMaxPartitions is the max number of partitions the adaptive scaler can scale a task list to",
+		DefaultValue: 20,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
+	},
+	MatchingPriorityStarvationThreshold: DynamicInt{
+		KeyName:      "matching.priorityStarvationThreshold",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPriorityStarvationThreshold is the number of consecutive high priority tasks dispatched from a task list before a waiting default priority task is dispatched first, 0 means strict priority",
+		DefaultValue: 10,
+		AllowedRange: &IntRange{Min: 0, Max: math.MaxInt32},
+	},
+	MatchingFairnessKeyMaxBufferedTasks: DynamicInt{
+		KeyName:      "matching.fairnessKeyMaxBufferedTasks",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingFairnessKeyMaxBufferedTasks is the max number of backlog tasks of a single fairness key buffered by a task list in fairness mode, the tasks of the key past it are skipped and read again once the key has room so that the backlog of the other keys is read",
+		DefaultValue: 100,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
+	},
+	MatchingFairnessMaxSkippedTasks: DynamicInt{
+		KeyName:      "matching.fairnessMaxSkippedTasks",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingFairnessMaxSkippedTasks is the max number of backlog tasks a task list skips in fairness mode while their fairness key has no room in the buffer, past it the task list waits for room instead of reading ahead",
+		DefaultValue: 10000,
+		AllowedRange: &IntRange{Min: 0, Max: math.MaxInt32},
+	},
 	HistoryRPS: DynamicInt{
 		KeyName:      "history.rps",
 		Description:  "HistoryRPS is request rate per second for each history host",
@@ -3110,6 +3502,7 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "history.acquireShardConcurrency",
 		Description:  "AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.",
 		DefaultValue: 1,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
 	},
 	TaskProcessRPS: DynamicInt{
 		KeyName:      "history.taskProcessRPS",
@@ -3282,6 +3675,7 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "history.replicatorTaskBatchSize",
 		Description:  "ReplicatorTaskBatchSize is batch size for ReplicatorProcessor",
 		DefaultValue: 25,
+		Filters:      []Filter{ShardID},
 	},
 	ReplicatorTaskDeleteBatchSize: DynamicInt{
 		KeyName:      "history.replicatorTaskDeleteBatchSize",
@@ -3409,6 +3803,7 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "history.maxActivityCountDispatchByDomain",
 		Description:  "MaxActivityCountDispatchByDomain max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.",
 		DefaultValue: 0,
+		Filters:      []Filter{DomainName},
 	},
 	ReplicationTaskFetcherParallelism: DynamicInt{
 		KeyName:      "history.ReplicationTaskFetcherParallelism",
@@ -3435,6 +3830,7 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "worker.indexerConcurrency",
 		Description:  "WorkerIndexerConcurrency is the max concurrent messages to be processed at any given time",
 		DefaultValue: 1000,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
 	},
 	WorkerESProcessorNumOfWorkers: DynamicInt{
 		KeyName:      "worker.ESProcessorNumOfWorkers",
@@ -3455,6 +3851,7 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "worker.ArchiverConcurrency",
 		Description:  "WorkerArchiverConcurrency is controls the number of coroutines handling archival work per archival workflow",
 		DefaultValue: 50,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
 	},
 	WorkerArchivalsPerIteration: DynamicInt{
 		KeyName:      "worker.ArchivalsPerIteration",
@@ -3490,6 +3887,7 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "worker.executionsScannerConcurrency",
 		Description:  "ConcreteExecutionsScannerConcurrency is indicates the concurrency of concrete execution scanner",
 		DefaultValue: 25,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
 	},
 	ConcreteExecutionsScannerBlobstoreFlushThreshold: DynamicInt{
 		KeyName:      "worker.executionsScannerBlobstoreFlushThreshold",
@@ -3510,6 +3908,7 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "worker.currentExecutionsConcurrency",
 		Description:  "CurrentExecutionsScannerConcurrency is indicates the concurrency of current executions scanner",
 		DefaultValue: 25,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
 	},
 	CurrentExecutionsScannerBlobstoreFlushThreshold: DynamicInt{
 		KeyName:      "worker.currentExecutionsBlobstoreFlushThreshold",
@@ -3530,6 +3929,7 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "worker.timersScannerConcurrency",
 		Description:  "TimersScannerConcurrency is the concurrency of timers scanner",
 		DefaultValue: 5,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
 	},
 	TimersScannerPersistencePageSize: DynamicInt{
 		KeyName:      "worker.timersScannerPersistencePageSize",
@@ -3570,11 +3970,13 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "worker.ESAnalyzerNumWorkflowsToRefresh",
 		Description:  "ESAnalyzerNumWorkflowsToRefresh controls how many workflows per workflow type should be refreshed per workflow type",
 		DefaultValue: 100,
+		Filters:      []Filter{DomainName, WorkflowType},
 	},
 	ESAnalyzerMinNumWorkflowsForAvg: DynamicInt{
 		KeyName:      "worker.ESAnalyzerMinNumWorkflowsForAvg",
 		Description:  "ESAnalyzerMinNumWorkflowsForAvg controls how many workflows to have at least to rely on workflow run time avg per type",
 		DefaultValue: 100,
+		Filters:      []Filter{DomainName, WorkflowType},
 	},
 	VisibilityArchivalQueryMaxRangeInDays: DynamicInt{
 		KeyName:      "frontend.visibilityArchivalQueryMaxRangeInDays",
@@ -3590,12 +3992,25 @@ var IntKeys = map[IntKey]DynamicInt{
 		KeyName:      "system.workflowDeletionJitterRange",
 		Description:  "WorkflowDeletionJitterRange defines the duration in minutes for workflow close tasks jittering",
 		DefaultValue: 60,
+		Filters:      []Filter{DomainName},
 	},
 	SampleLoggingRate: DynamicInt{
 		KeyName:      "system.sampleLoggingRate",
 		Description:  "The rate for which sampled logs are logged at. 100 means 1/100 is logged",
 		DefaultValue: 100,
 	},
+	PersistenceMaxConcurrency: DynamicInt{
+		KeyName:      "system.persistenceMaxConcurrency",
+		Description:  "PersistenceMaxConcurrency is the max number of concurrent calls to a persistence datastore when adaptive concurrency is enabled",
+		DefaultValue: 1000,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
+	},
+	PersistenceMinConcurrency: DynamicInt{
+		KeyName:      "system.persistenceMinConcurrency",
+		Description:  "PersistenceMinConcurrency is the min number of concurrent calls to a persistence datastore when adaptive concurrency is enabled",
+		DefaultValue: 10,
+		AllowedRange: &IntRange{Min: 1, Max: math.MaxInt32},
+	},
 	LargeShardHistorySizeMetricThreshold: DynamicInt{
 		KeyName:      "system.largeShardHistorySizeMetricThreshold",
 		Description:  "defines the threshold for what consititutes a large history size to alert on, default is 10mb",
@@ -3660,6 +4075,7 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		KeyName:      "history.enableRecordWorkflowExecutionUninitialized",
 		Description:  "EnableRecordWorkflowExecutionUninitialized enables record workflow execution uninitialized state in ElasticSearch",
 		DefaultValue: false,
+		Filters:      []Filter{DomainName},
 	},
 	DisableListVisibilityByFilter: DynamicBool{
 		KeyName:      "frontend.disableListVisibilityByFilter",
@@ -3714,6 +4130,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableClientVersionCheck is enables client version check for frontend",
 		DefaultValue: false,
 	},
+	FrontendEnableGlobalRatelimiter: DynamicBool{
+		KeyName:      "frontend.enableGlobalRatelimiter",
+		Description:  "FrontendEnableGlobalRatelimiter is whether frontends rebalance the global domain rate limits by the usage exchanged through the aggregators",
+		DefaultValue: false,
+	},
 	SendRawWorkflowHistory: DynamicBool{
 		KeyName:      "frontend.sendRawWorkflowHistory",
 		Filters:      []Filter{DomainName},
@@ -3743,6 +4164,18 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnableTaskInfoLogByDomainID is enables info level logs for decision/activity task based on the request domainID",
 		DefaultValue: false,
 	},
+	MatchingEnableAdaptiveScaler: DynamicBool{
+		KeyName:      "matching.enableAdaptiveScaler",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableAdaptiveScaler is to enable automatic scaling of task list partitions based on the observed load",
+		DefaultValue: false,
+	},
+	MatchingEnableTaskListFairness: DynamicBool{
+		KeyName:      "matching.enableTaskListFairness",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableTaskListFairness is to dispatch the backlog of a task list in weighted round robin order across the fairness keys of its tasks",
+		DefaultValue: false,
+	},
 	EventsCacheGlobalEnable: DynamicBool{
 		KeyName:      "history.eventsCacheGlobalEnable",
 		Description:  "EventsCacheGlobalEnable is enables global cache over all history shards",
@@ -3832,6 +4265,18 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableActivityLocalDispatchByDomain is allows worker to dispatch activity tasks through local tunnel after decisions are made. This is an performance optimization to skip activity scheduling efforts",
 		DefaultValue: true,
 	},
+	EnableTaskPriority: DynamicBool{
+		KeyName:      "history.enableTaskPriority",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableTaskPriority is to set the priority from workflow and activity headers on the decision and activity tasks pushed to matching",
+		DefaultValue: false,
+	},
+	SendActivityTypeToMatching: DynamicBool{
+		KeyName:      "history.sendActivityTypeToMatching",
+		Filters:      []Filter{DomainName},
+		Description:  "SendActivityTypeToMatching is to send the activity type on the activity tasks pushed to matching, which is required by per activity type dispatch limits",
+		DefaultValue: false,
+	},
 	HistoryEnableTaskInfoLogByDomainID: DynamicBool{
 		KeyName:      "history.enableTaskInfoLogByDomainID",
 		Filters:      []Filter{DomainID},
@@ -3848,6 +4293,7 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		KeyName:      "history.useNewInitialFailoverVersion",
 		Description:  "use the minInitialFailover version",
 		DefaultValue: false,
+		Filters:      []Filter{DomainName},
 	},
 	AllowArchivingIncompleteHistory: DynamicBool{
 		KeyName:      "worker.AllowArchivingIncompleteHistory",
@@ -3869,6 +4315,17 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "HistoryScannerEnabled is indicates if history scanner should be started as part of worker.Scanner",
 		DefaultValue: false,
 	},
+	ArchivalRetentionScannerEnabled: DynamicBool{
+		KeyName:      "worker.archivalRetentionScannerEnabled",
+		Description:  "ArchivalRetentionScannerEnabled indicates if archival retention scanner should be started as part of worker.Scanner",
+		DefaultValue: false,
+	},
+	ArchivalRetentionScannerDryRun: DynamicBool{
+		KeyName:      "worker.archivalRetentionScannerDryRun",
+		Filters:      []Filter{DomainName},
+		Description:  "ArchivalRetentionScannerDryRun makes the archival retention scanner only report the archived workflows it would delete",
+		DefaultValue: false,
+	},
 	ConcreteExecutionsScannerEnabled: DynamicBool{
 		KeyName:      "worker.executionsScannerEnabled",
 		Description:  "ConcreteExecutionsScannerEnabled is indicates if executions scanner should be started as part of worker.Scanner",
@@ -3977,6 +4434,7 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		KeyName:      "system.enableTasklistIsolation",
 		Description:  "EnableTasklistIsolation is a feature to enable isolation-groups for a domain. Should not be enabled without a deep understanding of this feature",
 		DefaultValue: false,
+		Filters:      []Filter{DomainName},
 	},
 	EnableServiceAuthorization: DynamicBool{
 		KeyName:      "system.enableServiceAuthorization",
@@ -4007,6 +4465,7 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		KeyName:      "system.Lockdown",
 		Description:  "Lockdown defines if we want to allow failovers of domains to this cluster",
 		DefaultValue: false,
+		Filters:      []Filter{DomainName},
 	},
 	EnablePendingActivityValidation: DynamicBool{
 		KeyName:      "limit.pendingActivityCount.enabled",
@@ -4023,6 +4482,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "Enable shardId metrics in persistence client",
 		DefaultValue: true,
 	},
+	EnablePersistenceAdaptiveConcurrency: DynamicBool{
+		KeyName:      "system.enablePersistenceAdaptiveConcurrency",
+		Description:  "EnablePersistenceAdaptiveConcurrency is whether persistence calls are limited by a concurrency adapting to the latency and errors of the datastore",
+		DefaultValue: false,
+	},
 }
 
 var FloatKeys = map[FloatKey]DynamicFloat{
@@ -4035,118 +4499,147 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		KeyName:      "system.persistenceErrorInjectionRate",
 		Description:  "PersistenceErrorInjectionRate is rate for injecting random error in persistence",
 		DefaultValue: 0,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	AdminErrorInjectionRate: DynamicFloat{
 		KeyName:      "admin.errorInjectionRate",
 		Description:  "dminErrorInjectionRate is the rate for injecting random error in admin client",
 		DefaultValue: 0,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	DomainFailoverRefreshTimerJitterCoefficient: DynamicFloat{
 		KeyName:      "frontend.domainFailoverRefreshTimerJitterCoefficient",
 		Description:  "DomainFailoverRefreshTimerJitterCoefficient is the jitter for domain failover refresh timer jitter",
 		DefaultValue: 0.1,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	FrontendErrorInjectionRate: DynamicFloat{
 		KeyName:      "frontend.errorInjectionRate",
 		Description:  "FrontendErrorInjectionRate is rate for injecting random error in frontend client",
 		DefaultValue: 0,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	MatchingErrorInjectionRate: DynamicFloat{
 		KeyName:      "matching.errorInjectionRate",
 		Description:  "MatchingErrorInjectionRate is rate for injecting random error in matching client",
 		DefaultValue: 0,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
+	},
+	MatchingPartitionDownscaleFactor: DynamicFloat{
+		KeyName:      "matching.partitionDownscaleFactor",
+		Description:  "MatchingPartitionDownscaleFactor is the fraction of the upscale threshold below which the adaptive scaler removes task list partitions",
+		DefaultValue: 0.75,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	TaskRedispatchIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.taskRedispatchIntervalJitterCoefficient",
 		Description:  "TaskRedispatchIntervalJitterCoefficient is the task redispatch interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	QueueProcessorRandomSplitProbability: DynamicFloat{
 		KeyName:      "history.queueProcessorRandomSplitProbability",
 		Description:  "QueueProcessorRandomSplitProbability is the probability for a domain to be split to a new processing queue",
 		DefaultValue: 0.01,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	QueueProcessorPollBackoffIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.queueProcessorPollBackoffIntervalJitterCoefficient",
 		Description:  "QueueProcessorPollBackoffIntervalJitterCoefficient is backoff interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	TimerProcessorUpdateAckIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.timerProcessorUpdateAckIntervalJitterCoefficient",
 		Description:  "TimerProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	TimerProcessorMaxPollIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.timerProcessorMaxPollIntervalJitterCoefficient",
 		Description:  "TimerProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	TimerProcessorSplitQueueIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.timerProcessorSplitQueueIntervalJitterCoefficient",
 		Description:  "TimerProcessorSplitQueueIntervalJitterCoefficient is the split processing queue interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	TransferProcessorMaxPollIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.transferProcessorMaxPollIntervalJitterCoefficient",
 		Description:  "TransferProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	TransferProcessorSplitQueueIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.transferProcessorSplitQueueIntervalJitterCoefficient",
 		Description:  "TransferProcessorSplitQueueIntervalJitterCoefficient is the split processing queue interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	TransferProcessorUpdateAckIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.transferProcessorUpdateAckIntervalJitterCoefficient",
 		Description:  "TransferProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	CrossClusterSourceProcessorMaxPollIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.crossClusterSourceProcessorMaxPollIntervalJitterCoefficient",
 		Description:  "CrossClusterSourceProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	CrossClusterSourceProcessorUpdateAckIntervalJitterCoefficient: DynamicFloat{
 		KeyName:      "history.crossClusterSourceProcessorUpdateAckIntervalJitterCoefficient",
 		Description:  "CrossClusterSourceProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	CrossClusterTargetProcessorJitterCoefficient: DynamicFloat{
 		KeyName:      "history.crossClusterTargetProcessorJitterCoefficient",
 		Description:  "CrossClusterTargetProcessorJitterCoefficient is the jitter coefficient used in cross cluster task processor",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	CrossClusterFetcherJitterCoefficient: DynamicFloat{
 		KeyName:      "history.crossClusterFetcherJitterCoefficient",
 		Description:  "CrossClusterFetcherJitterCoefficient is the jitter coefficient used in cross cluster task fetcher",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	ReplicationTaskProcessorCleanupJitterCoefficient: DynamicFloat{
 		KeyName:      "history.ReplicationTaskProcessorCleanupJitterCoefficient",
 		Filters:      []Filter{ShardID},
 		Description:  "ReplicationTaskProcessorCleanupJitterCoefficient is the jitter for cleanup timer",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	ReplicationTaskProcessorStartWaitJitterCoefficient: DynamicFloat{
 		KeyName:      "history.ReplicationTaskProcessorStartWaitJitterCoefficient",
 		Filters:      []Filter{ShardID},
 		Description:  "ReplicationTaskProcessorStartWaitJitterCoefficient is the jitter for batch start wait timer",
 		DefaultValue: 0.9,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	ReplicationTaskProcessorHostQPS: DynamicFloat{
 		KeyName:      "history.ReplicationTaskProcessorHostQPS",
 		Description:  "ReplicationTaskProcessorHostQPS is the qps of task processing rate limiter on host level",
 		DefaultValue: 1500,
+		AllowedRange: &FloatRange{Min: 0, Max: math.MaxFloat64},
 	},
 	ReplicationTaskProcessorShardQPS: DynamicFloat{
 		KeyName:      "history.ReplicationTaskProcessorShardQPS",
 		Description:  "ReplicationTaskProcessorShardQPS is the qps of task processing rate limiter on shard level",
 		DefaultValue: 5,
+		AllowedRange: &FloatRange{Min: 0, Max: math.MaxFloat64},
 	},
 	ReplicationTaskGenerationQPS: DynamicFloat{
 		KeyName:      "history.ReplicationTaskGenerationQPS",
 		Description:  "ReplicationTaskGenerationQPS is the wait time between each replication task generation qps",
 		DefaultValue: 100,
+		AllowedRange: &FloatRange{Min: 0, Max: math.MaxFloat64},
 	},
 	MutableStateChecksumInvalidateBefore: DynamicFloat{
 		KeyName:      "history.mutableStateChecksumInvalidateBefore",
@@ -4157,26 +4650,37 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		KeyName:      "history.NotifyFailoverMarkerTimerJitterCoefficient",
 		Description:  "NotifyFailoverMarkerTimerJitterCoefficient is the jitter for failover marker notifier timer",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	HistoryErrorInjectionRate: DynamicFloat{
 		KeyName:      "history.errorInjectionRate",
 		Description:  "HistoryErrorInjectionRate is rate for injecting random error in history client",
 		DefaultValue: 0,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	ReplicationTaskFetcherTimerJitterCoefficient: DynamicFloat{
 		KeyName:      "history.ReplicationTaskFetcherTimerJitterCoefficient",
 		Description:  "ReplicationTaskFetcherTimerJitterCoefficient is the jitter for fetcher timer",
 		DefaultValue: 0.15,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	WorkerDeterministicConstructionCheckProbability: DynamicFloat{
 		KeyName:      "worker.DeterministicConstructionCheckProbability",
 		Description:  "WorkerDeterministicConstructionCheckProbability controls the probability of running a deterministic construction check for any given archival",
 		DefaultValue: 0.002,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 	WorkerBlobIntegrityCheckProbability: DynamicFloat{
 		KeyName:      "worker.BlobIntegrityCheckProbability",
 		Description:  "WorkerBlobIntegrityCheckProbability controls the probability of running an integrity check for any given archival",
 		DefaultValue: 0.002,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
+	},
+	PersistenceLowPriorityConcurrencyRatio: DynamicFloat{
+		KeyName:      "system.persistenceLowPriorityConcurrencyRatio",
+		Description:  "PersistenceLowPriorityConcurrencyRatio is the share of the persistence concurrency limit that low priority calls can use",
+		DefaultValue: 0.5,
+		AllowedRange: &FloatRange{Min: 0, Max: 1},
 	},
 }
 
@@ -4237,6 +4741,12 @@ var StringKeys = map[StringKey]DynamicString{
 		Description:  "ESAnalyzerWorkflowDurationWarnThresholds defines the domains we want to emit wf version metrics on",
 		DefaultValue: "",
 	},
+	FrontendFairnessKeySearchAttribute: DynamicString{
+		KeyName:      "frontend.fairnessKeySearchAttribute",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendFairnessKeySearchAttribute is the search attribute holding the fairness key of a workflow when the workflow header has none",
+		DefaultValue: "",
+	},
 }
 
 var DurationKeys = map[DurationKey]DynamicDuration{
@@ -4255,6 +4765,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "",
 		DefaultValue: 0,
 	},
+	GlobalRatelimiterUpdateInterval: DynamicDuration{
+		KeyName:      "system.globalRatelimiterUpdateInterval",
+		Description:  "GlobalRatelimiterUpdateInterval is how often frontends report the usage of global rate limits to their aggregators",
+		DefaultValue: 3 * time.Second,
+	},
 	FrontendShutdownDrainDuration: DynamicDuration{
 		KeyName:      "frontend.shutdownDrainDuration",
 		Description:  "FrontendShutdownDrainDuration is the duration of traffic drain during shutdown",
@@ -4273,7 +4788,7 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 	},
 	MatchingLongPollExpirationInterval: DynamicDuration{
 		KeyName:      "matching.longPollExpirationInterval",
-		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Filters:      []Filter{DomainName, TaskListName, TaskType, IsolationGroup},
 		Description:  "MatchingLongPollExpirationInterval is the long poll expiration interval in the matching service",
 		DefaultValue: time.Minute,
 	},
@@ -4306,6 +4821,35 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "MatchingActivityTaskSyncMatchWaitTime is the amount of time activity task will wait to be sync matched",
 		DefaultValue: time.Millisecond * 50,
 	},
+	MatchingAdaptiveScalerUpdateInterval: DynamicDuration{
+		KeyName:      "matching.adaptiveScalerUpdateInterval",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingAdaptiveScalerUpdateInterval is the interval at which the adaptive scaler re-evaluates the number of partitions of a task list",
+		DefaultValue: time.Second * 15,
+	},
+	MatchingPartitionUpscaleSustainedDuration: DynamicDuration{
+		KeyName:      "matching.partitionUpscaleSustainedDuration",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionUpscaleSustainedDuration is the duration the add rate must stay above the upscale threshold before partitions are added",
+		DefaultValue: time.Minute,
+	},
+	MatchingPartitionDownscaleSustainedDuration: DynamicDuration{
+		KeyName:      "matching.partitionDownscaleSustainedDuration",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionDownscaleSustainedDuration is the duration the add rate must stay below the downscale threshold before partitions are removed",
+		DefaultValue: time.Minute * 2,
+	},
+	MatchingPartitionConfigRefreshInterval: DynamicDuration{
+		KeyName:      "matching.partitionConfigRefreshInterval",
+		Description:  "MatchingPartitionConfigRefreshInterval is the interval at which matching clients refresh the cached partition config of a task list",
+		DefaultValue: time.Second * 10,
+	},
+	MatchingPriorityTaskSyncMatchWaitTime: DynamicDuration{
+		KeyName:      "matching.priorityTaskSyncMatchWaitTime",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPriorityTaskSyncMatchWaitTime is the amount of time a high priority task waits to be sync matched before it is persisted",
+		DefaultValue: time.Millisecond * 500,
+	},
 	HistoryLongPollExpirationInterval: DynamicDuration{
 		KeyName:      "history.longPollExpirationInterval",
 		Filters:      []Filter{DomainName},
@@ -4601,6 +5145,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "WorkerReplicationTaskMaxRetryDuration is the max retry duration for any task",
 		DefaultValue: time.Minute * 10,
 	},
+	ArchivalRetentionPeriod: DynamicDuration{
+		KeyName:      "worker.archivalRetentionPeriod",
+		Filters:      []Filter{DomainName},
+		Description:  "ArchivalRetentionPeriod is how long archived histories and visibility records of a domain are kept, measured from workflow close time. Zero keeps them forever",
+		DefaultValue: 0,
+	},
 	ESAnalyzerTimeWindow: DynamicDuration{
 		KeyName:      "worker.ESAnalyzerTimeWindow",
 		Description:  "ESAnalyzerTimeWindow defines the time window ElasticSearch Analyzer will consider while taking workflow averages",
@@ -4625,6 +5175,7 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		KeyName:      "worker.ESAnalyzerBufferWaitTime",
 		Description:  "ESAnalyzerBufferWaitTime controls min time required to consider a worklow stuck",
 		DefaultValue: time.Minute * 30,
+		Filters:      []Filter{DomainName, WorkflowType},
 	},
 	AsyncTaskDispatchTimeout: DynamicDuration{
 		KeyName:      "matching.asyncTaskDispatchTimeout",
@@ -4650,6 +5201,12 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "ValidSearchAttributes is legal indexed keys that can be used in list APIs. When overriding, ensure to include the existing default attributes of the current release",
 		DefaultValue: definition.GetDefaultIndexedKeys(),
 	},
+	FrontendMaxDomainCallerTypeRPSPerInstance: DynamicMap{
+		KeyName:      "frontend.domainCallerTyperps",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendMaxDomainCallerTypeRPSPerInstance is the per-instance request rate limit per second of each caller type within a domain, keyed by the client implementation header value",
+		DefaultValue: nil,
+	},
 	TaskSchedulerRoundRobinWeights: DynamicMap{
 		KeyName:     "history.taskSchedulerRoundRobinWeight",
 		Description: "TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler",
@@ -4669,6 +5226,24 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "QueueProcessorStuckTaskSplitThreshold is the threshold for the number of attempts of a task",
 		DefaultValue: common.ConvertIntMapToDynamicConfigMapProperty(map[int]int{0: 100, 1: 10000}),
 	},
+	MatchingFairnessKeyWeights: DynamicMap{
+		KeyName:      "matching.fairnessKeyWeights",
+		Filters:      []Filter{DomainName},
+		Description:  "MatchingFairnessKeyWeights is the weight of each fairness key when dispatching the backlog of a task list in fairness mode, keyed by fairness key",
+		DefaultValue: nil,
+	},
+	MatchingWorkflowTypeDispatchRPS: DynamicMap{
+		KeyName:      "matching.workflowTypeDispatchRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "MatchingWorkflowTypeDispatchRPS is the max rate at which decision tasks of a workflow type are dispatched, shared by all partitions of a task list, keyed by workflow type name",
+		DefaultValue: nil,
+	},
+	MatchingActivityTypeDispatchRPS: DynamicMap{
+		KeyName:      "matching.activityTypeDispatchRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "MatchingActivityTypeDispatchRPS is the max rate at which activity tasks of an activity type are dispatched, shared by all partitions of a task list, keyed by activity type name",
+		DefaultValue: nil,
+	},
 }
 
 var ListKeys = map[ListKey]DynamicList{
@@ -21,10 +21,15 @@
 package dynamicconfig
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -32,6 +37,7 @@ import (
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -40,39 +46,83 @@ var _ Client = (*fileBasedClient)(nil)
 const (
 	minPollInterval = time.Second * 5
 	fileMode        = 0644 // used for update config file
+
+	// prefixes of the sources of values, telling which layer produced a value
+	baseLayerPrefix    = "base:"
+	overlayLayerPrefix = "overlay:"
+	envLayerPrefix     = "env:"
 )
 
 type constrainedValue struct {
 	Value       interface{}
 	Constraints map[string]interface{}
+	// Source is the layer the value comes from
+	Source string `yaml:"-"`
 }
 
 // FileBasedClientConfig is the config for the file based dynamic config client.
 // It specifies where the config file is stored and how often the config should be
 // updated by checking the config file again.
+//
+// The config is made of layers merged in order, a value of a later layer overrides
+// the value with the same constraints of an earlier layer:
+//  1. the base file at Filepath;
+//  2. the YAML files in OverlayDir (e.g. one file per cluster) in the lexical order of their names;
+//  3. the environment variables named EnvPrefix followed by the key name in upper case with dots
+//     replaced by underscores, e.g. CADENCE_DC_FRONTEND_RPS for frontend.rps, whose YAML value
+//     overrides the value without constraints of the key.
+//
+// A value not following the declaration of its key is dropped with an error log, unless Strict
+// is set, in which case the whole config is rejected and the previous values are kept.
 type FileBasedClientConfig struct {
 	Filepath     string        `yaml:"filepath"`
+	OverlayDir   string        `yaml:"overlayDir"`
+	EnvPrefix    string        `yaml:"envPrefix"`
 	PollInterval time.Duration `yaml:"pollInterval"`
+	Strict       bool          `yaml:"strict"`
 }
 
 type fileBasedClient struct {
 	values          atomic.Value
+	updateLock      sync.Mutex
 	lastUpdatedTime time.Time
+	lastFiles       []string
+	lastEnv         map[string]string
+	envKeys         map[string]Key
 	config          *FileBasedClientConfig
 	doneCh          chan struct{}
 	logger          log.Logger
+	metricsClient   metrics.Client
+}
+
+// configLayer holds the values of a layer of the config
+type configLayer struct {
+	source string
+	values map[string][]*constrainedValue
 }
 
 // NewFileBasedClient creates a file based client.
-func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh chan struct{}) (Client, error) {
+func NewFileBasedClient(
+	config *FileBasedClientConfig,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	doneCh chan struct{},
+) (Client, error) {
 	if err := validateConfig(config); err != nil {
 		return nil, err
 	}
 
 	client := &fileBasedClient{
-		config: config,
-		doneCh: doneCh,
-		logger: logger,
+		config:        config,
+		envKeys:       make(map[string]Key),
+		doneCh:        doneCh,
+		logger:        logger,
+		metricsClient: metricsClient,
+	}
+	if config.EnvPrefix != "" {
+		for keyName, key := range GetAllKeys() {
+			client.envKeys[envVariableName(config.EnvPrefix, keyName)] = key
+		}
 	}
 	if err := client.update(); err != nil {
 		return nil, err
@@ -207,6 +257,10 @@ func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 	keyName := name.String()
 	currentValues := make(map[string][]*constrainedValue)
 
+	// the file is read under the lock so that concurrent updates don't overwrite each other
+	fc.updateLock.Lock()
+	defer fc.updateLock.Unlock()
+
 	confContent, err := ioutil.ReadFile(fc.config.Filepath)
 	if err != nil {
 		return fmt.Errorf("failed to read dynamic config file %v: %v", fc.config.Filepath, err)
@@ -216,6 +270,10 @@ func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 		return fmt.Errorf("failed to decode dynamic config %v", err)
 	}
 
+	if d, ok := value.(time.Duration); ok {
+		// durations are read from their string representation
+		value = d.String()
+	}
 	cVal := &constrainedValue{
 		Value: value,
 	}
@@ -227,57 +285,301 @@ func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 		return fmt.Errorf("failed to write config file, err: %v", err)
 	}
 
-	return fc.storeValues(currentValues)
+	// reload all the layers as the base file may be overridden by other layers
+	fc.lastUpdatedTime = time.Time{}
+	if err := fc.updateLocked(); err != nil {
+		// the rejected config is not left on disk, where it would fail the next start in strict mode
+		if restoreErr := ioutil.WriteFile(fc.config.Filepath, confContent, fileMode); restoreErr != nil {
+			fc.logger.Error("Failed to restore dynamic config file", tag.Error(restoreErr))
+		}
+		return err
+	}
+	return nil
 }
 
 func (fc *fileBasedClient) RestoreValue(name Key, filters map[Filter]interface{}) error {
 	return errors.New("not supported for file based client")
 }
 
+// ListValue lists the values of a key, or of all keys when name is nil, with the layer each value comes from
 func (fc *fileBasedClient) ListValue(name Key) ([]*types.DynamicConfigEntry, error) {
-	return nil, errors.New("not supported for file based client")
+	values := fc.values.Load().(map[string][]*constrainedValue)
+	var keyNames []string
+	if name == nil {
+		for keyName := range values {
+			keyNames = append(keyNames, keyName)
+		}
+		sort.Strings(keyNames)
+	} else {
+		keyNames = []string{name.String()}
+	}
+
+	entries := make([]*types.DynamicConfigEntry, 0, len(keyNames))
+	for _, keyName := range keyNames {
+		constrainedValues, ok := values[keyName]
+		if !ok {
+			continue
+		}
+		entry := &types.DynamicConfigEntry{
+			Name:   keyName,
+			Values: make([]*types.DynamicConfigValue, 0, len(constrainedValues)),
+		}
+		for _, cv := range constrainedValues {
+			value, err := cv.toDynamicConfigValue()
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert value of %v: %v", keyName, err)
+			}
+			entry.Values = append(entry.Values, value)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
 }
 
 func (fc *fileBasedClient) update() error {
+	fc.updateLock.Lock()
+	defer fc.updateLock.Unlock()
+
+	return fc.updateLocked()
+}
+
+func (fc *fileBasedClient) updateLocked() error {
 	defer func() {
 		fc.lastUpdatedTime = time.Now()
 	}()
 
-	newValues := make(map[string][]*constrainedValue)
+	files, err := fc.configFiles()
+	if err != nil {
+		return err
+	}
+	changed := !stringSlicesEqual(files, fc.lastFiles)
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("failed to get status of dynamic config file: %v", err)
+		}
+		if info.ModTime().After(fc.lastUpdatedTime) {
+			changed = true
+		}
+	}
+	env := fc.envOverrides()
+	if !changed && stringMapsEqual(env, fc.lastEnv) {
+		return nil
+	}
+
+	layers := make([]*configLayer, 0, len(files)+1)
+	for i, file := range files {
+		values, err := readConfigFile(file)
+		if err != nil {
+			return err
+		}
+		source := overlayLayerPrefix + file
+		if i == 0 {
+			source = baseLayerPrefix + file
+		}
+		layers = append(layers, &configLayer{source: source, values: values})
+	}
+	for name, value := range env {
+		var v interface{}
+		if err := yaml.Unmarshal([]byte(value), &v); err != nil {
+			return fmt.Errorf("failed to decode dynamic config environment variable %v: %v", name, err)
+		}
+		layers = append(layers, &configLayer{
+			source: envLayerPrefix + name,
+			values: map[string][]*constrainedValue{
+				fc.envKeys[name].String(): {{Value: v}},
+			},
+		})
+	}
 
-	info, err := os.Stat(fc.config.Filepath)
+	if err := fc.storeValues(mergeLayers(layers)); err != nil {
+		return err
+	}
+	fc.lastFiles = files
+	fc.lastEnv = env
+	return nil
+}
+
+// configFiles returns the base file followed by the overlay files
+func (fc *fileBasedClient) configFiles() ([]string, error) {
+	files := []string{fc.config.Filepath}
+	if fc.config.OverlayDir == "" {
+		return files, nil
+	}
+
+	dirEntries, err := ioutil.ReadDir(fc.config.OverlayDir)
 	if err != nil {
-		return fmt.Errorf("failed to get status of dynamic config file: %v", err)
+		return nil, fmt.Errorf("failed to read dynamic config overlay directory %v: %v", fc.config.OverlayDir, err)
 	}
-	if !info.ModTime().After(fc.lastUpdatedTime) {
+	var overlays []string
+	for _, entry := range dirEntries {
+		ext := filepath.Ext(entry.Name())
+		if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			overlays = append(overlays, filepath.Join(fc.config.OverlayDir, entry.Name()))
+		}
+	}
+	sort.Strings(overlays)
+	return append(files, overlays...), nil
+}
+
+// envOverrides returns the environment variables overriding dynamic config keys
+func (fc *fileBasedClient) envOverrides() map[string]string {
+	if fc.config.EnvPrefix == "" {
 		return nil
 	}
 
-	confContent, err := ioutil.ReadFile(fc.config.Filepath)
+	env := make(map[string]string)
+	for _, kv := range os.Environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[0], fc.config.EnvPrefix) {
+			continue
+		}
+		if _, ok := fc.envKeys[parts[0]]; !ok {
+			if fc.lastFiles == nil {
+				// only warn on the first load, the environment of a process does not change afterwards
+				fc.logger.Warn("Ignoring environment variable not matching any dynamic config key", tag.Key(parts[0]))
+			}
+			continue
+		}
+		env[parts[0]] = parts[1]
+	}
+	return env
+}
+
+func readConfigFile(file string) (map[string][]*constrainedValue, error) {
+	values := make(map[string][]*constrainedValue)
+	confContent, err := ioutil.ReadFile(file)
 	if err != nil {
-		return fmt.Errorf("failed to read dynamic config file %v: %v", fc.config.Filepath, err)
+		return nil, fmt.Errorf("failed to read dynamic config file %v: %v", file, err)
+	}
+	if err = yaml.Unmarshal(confContent, values); err != nil {
+		return nil, fmt.Errorf("failed to decode dynamic config %v: %v", file, err)
 	}
+	return values, nil
+}
 
-	if err = yaml.Unmarshal(confContent, newValues); err != nil {
-		return fmt.Errorf("failed to decode dynamic config %v", err)
+// mergeLayers merges the values of the layers in order, a value overrides the value
+// with the same constraints of an earlier layer
+func mergeLayers(layers []*configLayer) map[string][]*constrainedValue {
+	merged := make(map[string][]*constrainedValue)
+	for _, layer := range layers {
+		for keyName, values := range layer.values {
+		ValueLoop:
+			for _, v := range values {
+				v.Source = layer.source
+				for i, existing := range merged[keyName] {
+					if existing.Source != layer.source && sameConstraints(existing.Constraints, v.Constraints) {
+						merged[keyName][i] = v
+						continue ValueLoop
+					}
+				}
+				merged[keyName] = append(merged[keyName], v)
+			}
+		}
+	}
+	return merged
+}
+
+func sameConstraints(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if other, ok := b[name]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *constrainedValue) toDynamicConfigValue() (*types.DynamicConfigValue, error) {
+	data, err := json.Marshal(v.Value)
+	if err != nil {
+		return nil, err
+	}
+	constraintNames := make([]string, 0, len(v.Constraints))
+	for name := range v.Constraints {
+		constraintNames = append(constraintNames, name)
 	}
+	sort.Strings(constraintNames)
 
-	return fc.storeValues(newValues)
+	filters := make([]*types.DynamicConfigFilter, 0, len(constraintNames))
+	for _, name := range constraintNames {
+		filterData, err := json.Marshal(v.Constraints[name])
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, &types.DynamicConfigFilter{
+			Name: name,
+			Value: &types.DataBlob{
+				EncodingType: types.EncodingTypeJSON.Ptr(),
+				Data:         filterData,
+			},
+		})
+	}
+	return &types.DynamicConfigValue{
+		Value: &types.DataBlob{
+			EncodingType: types.EncodingTypeJSON.Ptr(),
+			Data:         data,
+		},
+		Filters: filters,
+		Source:  v.Source,
+	}, nil
+}
+
+func envVariableName(prefix string, keyName string) string {
+	return prefix + strings.ToUpper(strings.ReplaceAll(keyName, ".", "_"))
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func stringMapsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if other, ok := b[k]; !ok || other != v {
+			return false
+		}
+	}
+	return true
 }
 
 func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue) error {
-	// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
-	// manually convert key type to string for all values here
-	// We don't need to convert constraints as their type can't be map. If user does use a map as filter
-	// value, it won't match anyway.
-	for _, s := range newValues {
+	for keyName, s := range newValues {
+		key, err := GetKeyFromKeyName(keyName)
+		if err != nil {
+			fc.logger.Warn("Unknown dynamic config key", tag.Key(keyName))
+		}
+		validValues := make([]*constrainedValue, 0, len(s))
 		for _, cv := range s {
-			var err error
-			cv.Value, err = convertKeyTypeToString(cv.Value)
-			if err != nil {
-				return err
+			if err := fc.prepareValue(cv, key); err != nil {
+				// the previous values are kept in strict mode, otherwise only the invalid value is dropped
+				// instead of falling back to the default value when the value is read
+				if fc.config.Strict {
+					return fmt.Errorf("invalid dynamic config value from %v: %v", cv.Source, err)
+				}
+				fc.logger.Error("Dropped invalid dynamic config value",
+					tag.Key(keyName),
+					tag.Dynamic("source", cv.Source),
+					tag.Error(err),
+				)
+				fc.metricsClient.IncCounter(metrics.DynamicConfigScope, metrics.DynamicConfigInvalidValueCounter)
+				continue
 			}
+			validValues = append(validValues, cv)
 		}
+		newValues[keyName] = validValues
 	}
 
 	fc.values.Store(newValues)
@@ -285,25 +587,42 @@ func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue)
 	return nil
 }
 
+// prepareValue converts the value to the types values are read as and validates it against the declaration of key,
+// the value of a key not declared in the registry is only converted
+func (fc *fileBasedClient) prepareValue(cv *constrainedValue, key Key) error {
+	// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
+	// manually convert key type to string for all values here
+	// We don't need to convert constraints as their type can't be map. If user does use a map as filter
+	// value, it won't match anyway.
+	value, err := convertKeyTypeToString(cv.Value)
+	if err != nil {
+		return err
+	}
+	cv.Value = value
+	if key == nil {
+		return nil
+	}
+	return cv.validate(key)
+}
+
 func (fc *fileBasedClient) getValueWithFilters(key Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
 	keyName := key.String()
 	values := fc.values.Load().(map[string][]*constrainedValue)
-	found := false
+	var best *constrainedValue
+	bestSpecificity := -1
 	for _, constrainedValue := range values[keyName] {
-		if len(constrainedValue.Constraints) == 0 {
-			// special handling for default value (value without any constraints)
-			defaultValue = constrainedValue.Value
-			found = true
+		if !match(constrainedValue, filters) {
 			continue
 		}
-		if match(constrainedValue, filters) {
-			return constrainedValue.Value, nil
+		// the most specific value wins, the first one in the file on a tie
+		if specificity := constrainedValue.specificity(); specificity > bestSpecificity {
+			best, bestSpecificity = constrainedValue, specificity
 		}
 	}
-	if !found {
+	if best == nil {
 		return defaultValue, NotFoundError
 	}
-	return defaultValue, nil
+	return best.Value, nil
 }
 
 // match will return true if the constraints matches the filters or any subsets
@@ -321,6 +640,26 @@ func match(v *constrainedValue, filters map[Filter]interface{}) bool {
 	return true
 }
 
+func (v *constrainedValue) validate(key Key) error {
+	if err := ValidateValue(key, v.Value); err != nil {
+		return err
+	}
+	constraintNames := make([]string, 0, len(v.Constraints))
+	for name := range v.Constraints {
+		constraintNames = append(constraintNames, name)
+	}
+	sort.Strings(constraintNames)
+	return ValidateFilters(key, constraintNames)
+}
+
+func (v *constrainedValue) specificity() int {
+	constraints := make([]Filter, 0, len(v.Constraints))
+	for constrain := range v.Constraints {
+		constraints = append(constraints, ParseFilter(constrain))
+	}
+	return Specificity(constraints)
+}
+
 func convertKeyTypeToString(v interface{}) (interface{}, error) {
 	switch v := v.(type) {
 	case map[interface{}]interface{}:
@@ -367,6 +706,11 @@ func validateConfig(config *FileBasedClientConfig) error {
 	if _, err := os.Stat(config.Filepath); err != nil {
 		return fmt.Errorf("error checking dynamic config file at path %s, error: %v", config.Filepath, err)
 	}
+	if config.OverlayDir != "" {
+		if _, err := os.Stat(config.OverlayDir); err != nil {
+			return fmt.Errorf("error checking dynamic config overlay directory at path %s, error: %v", config.OverlayDir, err)
+		}
+	}
 
 	// check if poll interval needs to be adjusted
 	if config.PollInterval < minPollInterval {
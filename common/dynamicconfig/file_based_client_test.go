@@ -22,6 +22,9 @@ package dynamicconfig
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,6 +32,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
 )
 
 type fileBasedClientSuite struct {
@@ -49,7 +54,7 @@ func (s *fileBasedClientSuite) SetupSuite() {
 	s.client, err = NewFileBasedClient(&FileBasedClientConfig{
 		Filepath:     "config/testConfig.yaml",
 		PollInterval: time.Second * 5,
-	}, log.NewNoop(), s.doneCh)
+	}, log.NewNoop(), metrics.NewNoopMetricsClient(), s.doneCh)
 	s.Require().NoError(err)
 }
 
@@ -107,6 +112,63 @@ func (s *fileBasedClientSuite) TestGetValueWithFilters_UnknownFilter() {
 	s.Equal(false, v)
 }
 
+func (s *fileBasedClientSuite) TestGetValueWithFilters_Precedence() {
+	testCases := []struct {
+		filters  map[Filter]interface{}
+		expected int
+	}{
+		{
+			filters:  map[Filter]interface{}{DomainName: "samples-domain", TaskListName: "other-tasklist"},
+			expected: 1,
+		},
+		{
+			// more constraints win regardless of their order
+			filters:  map[Filter]interface{}{DomainName: "samples-domain", TaskListName: "sample-tasklist"},
+			expected: 2,
+		},
+		{
+			// task list name is more specific than task type
+			filters:  map[Filter]interface{}{DomainName: "samples-domain", TaskListName: "sample-tasklist", TaskType: 0},
+			expected: 2,
+		},
+		{
+			filters:  map[Filter]interface{}{DomainName: "samples-domain", TaskListName: "other-tasklist", TaskType: 0},
+			expected: 3,
+		},
+		{
+			// caller identity is more specific than domain name
+			filters:  map[Filter]interface{}{DomainName: "samples-domain", CallerIdentity: "sample-worker"},
+			expected: 4,
+		},
+		{
+			// isolation group is more specific than domain name
+			filters:  map[Filter]interface{}{DomainName: "samples-domain", IsolationGroup: "zone-a"},
+			expected: 5,
+		},
+		{
+			// caller identity is more specific than isolation group
+			filters:  map[Filter]interface{}{IsolationGroup: "zone-a", CallerIdentity: "sample-worker"},
+			expected: 4,
+		},
+		{
+			// RPC method is more specific than isolation group
+			filters:  map[Filter]interface{}{IsolationGroup: "zone-a", RPCMethod: "PollForDecisionTask"},
+			expected: 6,
+		},
+		{
+			// caller identity is more specific than RPC method
+			filters:  map[Filter]interface{}{CallerIdentity: "sample-worker", RPCMethod: "PollForDecisionTask"},
+			expected: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		v, err := s.client.GetIntValue(TestGetIntPropertyFilteredByTaskListInfoKey, tc.filters)
+		s.NoError(err)
+		s.Equal(tc.expected, v, "filters: %v", tc.filters)
+	}
+}
+
 func (s *fileBasedClientSuite) TestGetIntValue() {
 	v, err := s.client.GetIntValue(TestGetIntPropertyKey, nil)
 	s.NoError(err)
@@ -123,10 +185,8 @@ func (s *fileBasedClientSuite) TestGetIntValue_FilterNotMatch() {
 }
 
 func (s *fileBasedClientSuite) TestGetIntValue_WrongType() {
-	filters := map[Filter]interface{}{
-		DomainName: "global-samples-domain",
-	}
-	v, err := s.client.GetIntValue(TestGetIntPropertyKey, filters)
+	client := newFileBasedClientWithValues(TestGetIntPropertyKey, 1000.1)
+	v, err := client.GetIntValue(TestGetIntPropertyKey, nil)
 	s.Error(err)
 	s.Equal(TestGetIntPropertyKey.DefaultInt(), v)
 }
@@ -138,10 +198,8 @@ func (s *fileBasedClientSuite) TestGetFloatValue() {
 }
 
 func (s *fileBasedClientSuite) TestGetFloatValue_WrongType() {
-	filters := map[Filter]interface{}{
-		DomainName: "samples-domain",
-	}
-	v, err := s.client.GetFloatValue(TestGetFloat64PropertyKey, filters)
+	client := newFileBasedClientWithValues(TestGetFloat64PropertyKey, "wrong type")
+	v, err := client.GetFloatValue(TestGetFloat64PropertyKey, nil)
 	s.Error(err)
 	s.Equal(TestGetFloat64PropertyKey.DefaultFloat(), v)
 }
@@ -179,10 +237,8 @@ func (s *fileBasedClientSuite) TestGetMapValue() {
 }
 
 func (s *fileBasedClientSuite) TestGetMapValue_WrongType() {
-	filters := map[Filter]interface{}{
-		TaskListName: "random tasklist",
-	}
-	v, err := s.client.GetMapValue(TestGetMapPropertyKey, filters)
+	client := newFileBasedClientWithValues(TestGetMapPropertyKey, "1")
+	v, err := client.GetMapValue(TestGetMapPropertyKey, nil)
 	s.Error(err)
 	s.Equal(TestGetMapPropertyKey.DefaultMap(), v)
 }
@@ -194,26 +250,21 @@ func (s *fileBasedClientSuite) TestGetDurationValue() {
 }
 
 func (s *fileBasedClientSuite) TestGetDurationValue_NotStringRepresentation() {
-	filters := map[Filter]interface{}{
-		DomainName: "samples-domain",
-	}
-	v, err := s.client.GetDurationValue(TestGetDurationPropertyKey, filters)
+	client := newFileBasedClientWithValues(TestGetDurationPropertyKey, 2)
+	v, err := client.GetDurationValue(TestGetDurationPropertyKey, nil)
 	s.Error(err)
 	s.Equal(TestGetDurationPropertyKey.DefaultDuration(), v)
 }
 
 func (s *fileBasedClientSuite) TestGetDurationValue_ParseFailed() {
-	filters := map[Filter]interface{}{
-		DomainName:   "samples-domain",
-		TaskListName: "longIdleTimeTasklist",
-	}
-	v, err := s.client.GetDurationValue(TestGetDurationPropertyKey, filters)
+	client := newFileBasedClientWithValues(TestGetDurationPropertyKey, "wrong duration string")
+	v, err := client.GetDurationValue(TestGetDurationPropertyKey, nil)
 	s.Error(err)
 	s.Equal(TestGetDurationPropertyKey.DefaultDuration(), v)
 }
 
 func (s *fileBasedClientSuite) TestValidateConfig_ConfigNotExist() {
-	_, err := NewFileBasedClient(nil, nil, nil)
+	_, err := NewFileBasedClient(nil, nil, nil, nil)
 	s.Error(err)
 }
 
@@ -221,7 +272,7 @@ func (s *fileBasedClientSuite) TestValidateConfig_FileNotExist() {
 	_, err := NewFileBasedClient(&FileBasedClientConfig{
 		Filepath:     "file/not/exist.yaml",
 		PollInterval: time.Second * 10,
-	}, nil, nil)
+	}, nil, nil, nil)
 	s.Error(err)
 }
 
@@ -230,7 +281,7 @@ func (s *fileBasedClientSuite) TestValidateConfig_ShortPollInterval() {
 		Filepath:     "config/testConfig.yaml",
 		PollInterval: time.Second,
 	}
-	_, err := NewFileBasedClient(cfg, log.NewNoop(), nil)
+	_, err := NewFileBasedClient(cfg, log.NewNoop(), metrics.NewNoopMetricsClient(), nil)
 	s.NoError(err)
 	s.Equal(minPollInterval, cfg.PollInterval, "fallback to default poll interval")
 
@@ -350,3 +401,252 @@ func (s *fileBasedClientSuite) TestUpdateConfig() {
 	err = client.UpdateValue(key, v)
 	s.NoError(err)
 }
+
+func TestFileBasedClientLayers(t *testing.T) {
+	dir := t.TempDir()
+	overlayDir := filepath.Join(dir, "overlays")
+	require.NoError(t, os.Mkdir(overlayDir, 0755))
+	writeFile := func(path string, content string) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	writeFile(filepath.Join(dir, "base.yaml"), `
+testGetIntPropertyKey:
+- value: 1
+- value: 10
+  constraints:
+    domainName: samples-domain
+testGetBoolPropertyKey:
+- value: false
+`)
+	writeFile(filepath.Join(overlayDir, "a.yaml"), `
+testGetIntPropertyKey:
+- value: 2
+`)
+	writeFile(filepath.Join(overlayDir, "b.yaml"), `
+testGetIntPropertyKey:
+- value: 3
+testGetBoolPropertyKey:
+- value: true
+`)
+	writeFile(filepath.Join(overlayDir, "README.md"), "not a config file")
+	t.Setenv("CADENCE_TEST_DC_TESTGETINTPROPERTYKEY", "4")
+	t.Setenv("CADENCE_TEST_DC_UNKNOWNKEY", "5")
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	dcClient, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     filepath.Join(dir, "base.yaml"),
+		OverlayDir:   overlayDir,
+		EnvPrefix:    "CADENCE_TEST_DC_",
+		PollInterval: time.Minute,
+	}, log.NewNoop(), metrics.NewNoopMetricsClient(), doneCh)
+	require.NoError(t, err)
+	client := dcClient.(*fileBasedClient)
+
+	requireInt := func(expected int, filters map[Filter]interface{}) {
+		v, err := client.GetIntValue(TestGetIntPropertyKey, filters)
+		require.NoError(t, err)
+		require.Equal(t, expected, v)
+	}
+	requireInt(4, nil)
+	requireInt(10, map[Filter]interface{}{DomainName: "samples-domain"})
+	b, err := client.GetBoolValue(TestGetBoolPropertyKey, nil)
+	require.NoError(t, err)
+	require.True(t, b)
+
+	entries, err := client.ListValue(TestGetIntPropertyKey)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	sources := make(map[string]*types.DynamicConfigValue)
+	for _, v := range entries[0].Values {
+		sources[v.Source] = v
+	}
+	require.Len(t, sources, 2)
+	require.Equal(t, []byte("4"), sources[envLayerPrefix+"CADENCE_TEST_DC_TESTGETINTPROPERTYKEY"].Value.Data)
+	require.Equal(t, []byte("10"), sources[baseLayerPrefix+filepath.Join(dir, "base.yaml")].Value.Data)
+
+	entries, err = client.ListValue(nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, TestGetBoolPropertyKey.String(), entries[0].Name)
+	require.Equal(t, overlayLayerPrefix+filepath.Join(overlayDir, "b.yaml"), entries[0].Values[0].Source)
+
+	// removing a layer is picked up on the next update
+	require.NoError(t, os.Unsetenv("CADENCE_TEST_DC_TESTGETINTPROPERTYKEY"))
+	require.NoError(t, client.update())
+	requireInt(3, nil)
+
+	require.NoError(t, os.Remove(filepath.Join(overlayDir, "b.yaml")))
+	require.NoError(t, client.update())
+	requireInt(2, nil)
+	b, err = client.GetBoolValue(TestGetBoolPropertyKey, nil)
+	require.NoError(t, err)
+	require.False(t, b)
+}
+
+func TestFileBasedClientValidation(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile := func(content string) {
+		require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+		// make sure the change is picked up by the next update
+		require.NoError(t, os.Chtimes(file, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+	}
+	filters := map[Filter]interface{}{DomainName: "samples-domain", ClusterName: "cluster0"}
+
+	// only the invalid value is dropped, the other values of the file are loaded
+	writeFile(`
+matching.numTasklistWritePartitions:
+- value: 0
+- value: 4
+  constraints:
+    domainName: samples-domain
+testGetBoolPropertyKey:
+- value: true
+`)
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	dcClient, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     file,
+		PollInterval: time.Minute,
+	}, log.NewNoop(), metrics.NewNoopMetricsClient(), doneCh)
+	require.NoError(t, err)
+	client := dcClient.(*fileBasedClient)
+
+	v, err := client.GetIntValue(MatchingNumTasklistWritePartitions, filters)
+	require.NoError(t, err)
+	require.Equal(t, 4, v)
+	v, err = client.GetIntValue(MatchingNumTasklistWritePartitions, nil)
+	require.Error(t, err)
+	require.Equal(t, MatchingNumTasklistWritePartitions.DefaultInt(), v)
+	b, err := client.GetBoolValue(TestGetBoolPropertyKey, nil)
+	require.NoError(t, err)
+	require.True(t, b)
+}
+
+func TestFileBasedClientValidation_Strict(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile := func(content string) {
+		require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+		// make sure the change is picked up by the next update
+		require.NoError(t, os.Chtimes(file, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+	}
+
+	writeFile(`
+matching.numTasklistWritePartitions:
+- value: 0
+`)
+	_, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     file,
+		PollInterval: time.Minute,
+		Strict:       true,
+	}, log.NewNoop(), metrics.NewNoopMetricsClient(), nil)
+	require.Error(t, err)
+
+	writeFile(`
+matching.numTasklistWritePartitions:
+- value: 4
+  constraints:
+    domainName: samples-domain
+    clusterName: cluster0
+unknown.key:
+- value: 1
+`)
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	dcClient, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     file,
+		PollInterval: time.Minute,
+		Strict:       true,
+	}, log.NewNoop(), metrics.NewNoopMetricsClient(), doneCh)
+	require.NoError(t, err)
+	client := dcClient.(*fileBasedClient)
+
+	testCases := []string{
+		// out of range
+		`
+matching.numTasklistWritePartitions:
+- value: 0
+`,
+		// wrong type
+		`
+matching.numTasklistWritePartitions:
+- value: four
+`,
+		// filter not declared for the key
+		`
+matching.numTasklistWritePartitions:
+- value: 4
+  constraints:
+    shardID: 1
+`,
+		// unknown filter
+		`
+matching.numTasklistWritePartitions:
+- value: 4
+  constraints:
+    unknownFilter: 1
+`,
+	}
+	for _, content := range testCases {
+		writeFile(content)
+		require.Error(t, client.update(), content)
+
+		// the previous config is kept
+		v, err := client.GetIntValue(MatchingNumTasklistWritePartitions, map[Filter]interface{}{DomainName: "samples-domain", ClusterName: "cluster0"})
+		require.NoError(t, err)
+		require.Equal(t, 4, v)
+	}
+
+	// an update rejected by the validation of the whole config is not left in the file
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Error(t, client.UpdateValue(TestGetBoolPropertyKey, true))
+	restored, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Equal(t, string(content), string(restored))
+	_, err = client.GetBoolValue(TestGetBoolPropertyKey, nil)
+	require.Error(t, err)
+}
+
+func TestFileBasedClientUpdateValue_Concurrent(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("testGetBoolPropertyKey:\n- value: false\n"), 0644))
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	dcClient, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     file,
+		PollInterval: time.Minute,
+	}, log.NewNoop(), metrics.NewNoopMetricsClient(), doneCh)
+	require.NoError(t, err)
+	client := dcClient.(*fileBasedClient)
+
+	keys := []IntKey{TestGetIntPropertyKey, MatchingNumTasklistWritePartitions, MatchingNumTasklistReadPartitions}
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(key IntKey, value int) {
+			defer wg.Done()
+			require.NoError(t, client.UpdateValue(key, value))
+		}(key, i+1)
+	}
+	wg.Wait()
+
+	// none of the updates is lost
+	for i, key := range keys {
+		v, err := client.GetIntValue(key, nil)
+		require.NoError(t, err)
+		require.Equal(t, i+1, v)
+	}
+	b, err := client.GetBoolValue(TestGetBoolPropertyKey, nil)
+	require.NoError(t, err)
+	require.False(t, b)
+}
+
+func newFileBasedClientWithValues(key Key, value interface{}) *fileBasedClient {
+	// values not following the schema of their key can't be loaded but are still read safely
+	client := &fileBasedClient{logger: log.NewNoop()}
+	client.values.Store(map[string][]*constrainedValue{
+		key.String(): {{Value: value}},
+	})
+	return client
+}
@@ -31,7 +31,7 @@ import (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= UnknownFilter || f > WorkflowID {
+	if f <= UnknownFilter || f >= LastFilterTypeForTest {
 		return filters[UnknownFilter]
 	}
 	return filters[f]
@@ -55,6 +55,12 @@ func ParseFilter(filterName string) Filter {
 		return WorkflowID
 	case "workflowType":
 		return WorkflowType
+	case "isolationGroup":
+		return IsolationGroup
+	case "callerIdentity":
+		return CallerIdentity
+	case "rpcMethod":
+		return RPCMethod
 	default:
 		return UnknownFilter
 	}
@@ -70,6 +76,9 @@ var filters = []string{
 	"clusterName",
 	"workflowID",
 	"workflowType",
+	"isolationGroup",
+	"callerIdentity",
+	"rpcMethod",
 }
 
 const (
@@ -90,11 +99,50 @@ const (
 	WorkflowID
 	// WorkflowType is the workflow type name
 	WorkflowType
+	// IsolationGroup is the isolation group, e.g. the zone a host or a poller belongs to
+	IsolationGroup
+	// CallerIdentity is the identity reported by the caller of an API, it's not authenticated
+	CallerIdentity
+	// RPCMethod is the name of the called RPC method, e.g. ListWorkflowExecutions
+	RPCMethod
 
 	// LastFilterTypeForTest must be the last one in this const group for testing purpose
 	LastFilterTypeForTest
 )
 
+// filterPrecedence lists the filters from the most to the least specific one.
+// It breaks ties between values constrained by the same number of filters.
+var filterPrecedence = []Filter{
+	WorkflowID,
+	WorkflowType,
+	TaskListName,
+	TaskType,
+	ShardID,
+	CallerIdentity,
+	RPCMethod,
+	IsolationGroup,
+	DomainID,
+	DomainName,
+	ClusterName,
+}
+
+// Specificity returns how specific a value constrained by given filters is, the most specific matching
+// value of a key wins. A value constrained by more filters is more specific, and among values constrained
+// by the same number of filters, the one constrained by the more specific filters following filterPrecedence wins.
+// A value without any constraints is the least specific.
+func Specificity(filters []Filter) int {
+	mask := 0
+	for _, f := range filters {
+		for i, p := range filterPrecedence {
+			if f == p {
+				mask |= 1 << (len(filterPrecedence) - i)
+				break
+			}
+		}
+	}
+	return len(filters)<<(len(filterPrecedence)+1) | mask
+}
+
 // FilterOption is used to provide filters for dynamic config keys
 type FilterOption func(filterMap map[Filter]interface{})
 
@@ -154,6 +202,27 @@ func WorkflowTypeFilter(name string) FilterOption {
 	}
 }
 
+// IsolationGroupFilter filters by isolation group
+func IsolationGroupFilter(isolationGroup string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[IsolationGroup] = isolationGroup
+	}
+}
+
+// CallerIdentityFilter filters by the identity of the caller
+func CallerIdentityFilter(identity string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[CallerIdentity] = identity
+	}
+}
+
+// RPCMethodFilter filters by the name of the called RPC method
+func RPCMethodFilter(method string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[RPCMethod] = method
+	}
+}
+
 // ToGetDynamicConfigFilterRequest generates a GetDynamicConfigRequest object
 // by converting filters to DynamicConfigFilter objects and setting values
 func ToGetDynamicConfigFilterRequest(configName string, filters []FilterOption) *types.GetDynamicConfigRequest {
@@ -278,6 +278,16 @@ func WorkflowTaskListType(taskListType int) Tag {
 	return newInt("wf-task-list-type", taskListType)
 }
 
+// WorkflowTaskListReadPartitions returns tag for the number of read partitions of a task list
+func WorkflowTaskListReadPartitions(n int) Tag {
+	return newInt("wf-task-list-read-partitions", n)
+}
+
+// WorkflowTaskListWritePartitions returns tag for the number of write partitions of a task list
+func WorkflowTaskListWritePartitions(n int) Tag {
+	return newInt("wf-task-list-write-partitions", n)
+}
+
 // WorkflowTaskListKind returns tag for WorkflowTaskListKind
 func WorkflowTaskListKind(taskListKind int32) Tag {
 	return newInt32("wf-task-list-kind", taskListKind)
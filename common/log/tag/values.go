@@ -269,8 +269,10 @@ var (
 	StoreOperationGetDLQSize                 = storeOperation("get-dlq-size")
 	StoreOperationDeleteMessageFromDLQ       = storeOperation("delete-message-from-dlq")
 
-	StoreOperationFetchDynamicConfig  = storeOperation("fetch-dynamic-config")
-	StoreOperationUpdateDynamicConfig = storeOperation("update-dynamic-config")
+	StoreOperationFetchDynamicConfig         = storeOperation("fetch-dynamic-config")
+	StoreOperationFetchDynamicConfigHistory  = storeOperation("fetch-dynamic-config-history")
+	StoreOperationUpdateDynamicConfig        = storeOperation("update-dynamic-config")
+	StoreOperationDeleteDynamicConfigHistory = storeOperation("delete-dynamic-config-history")
 )
 
 // Pre-defined values for TagSysClientOperation
@@ -294,6 +296,7 @@ var (
 	AdminClientOperationPurgeDLQMessages                  = clientOperation("admin-purge-dlq-messsages")
 	AdminClientOperationMergeDLQMessages                  = clientOperation("admin-merge-dlq-messsages")
 	AdminClientOperationRefreshWorkflowTasks              = clientOperation("admin-refresh-wf-tasks")
+	AdminClientOperationRestoreArchivedWorkflow           = clientOperation("admin-restore-archived-wf")
 	AdminClientOperationResendReplicationTasks            = clientOperation("admin-resend-replication-tasks")
 	AdminClientOperationGetCrossClusterTasks              = clientOperation("admin-get-cross-cluster-tasks")
 	AdminClientOperationRespondCrossClusterTasksCompleted = clientOperation("admin-respond-cross-cluster-tasks-completed")
@@ -301,6 +304,8 @@ var (
 	AdminClientOperationUpdateDynamicConfig               = clientOperation("admin-update-dynamic-config")
 	AdminClientOperationRestoreDynamicConfig              = clientOperation("admin-restore-dynamic-config")
 	AdminClientOperationListDynamicConfig                 = clientOperation("admin-list-dynamic-config")
+	AdminClientOperationListDynamicConfigHistory          = clientOperation("admin-list-dynamic-config-history")
+	AdminClientOperationRollbackDynamicConfig             = clientOperation("admin-rollback-dynamic-config")
 	AdminClientOperationUpdateGlobalIsolationGroups       = clientOperation("admin-update-global-isolation-groups")
 	AdminClientOperationGetGlobalIsolationGroups          = clientOperation("admin-get-global-isolation-groups")
 	AdminClientOperationUpdateDomainIsolationGroups       = clientOperation("admin-update-domain-isolation-groups")
@@ -389,21 +394,24 @@ var (
 	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
 	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
 	HistoryClientOperationRefreshWorkflowTasks              = clientOperation("history-refresh-wf-tasks")
+	HistoryClientOperationRestoreArchivedWorkflow           = clientOperation("history-restore-archived-wf")
 	HistoryClientOperationNotifyFailoverMarkers             = clientOperation("history-notify-failover-markers")
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
 	HistoryClientOperationGetFailoverInfo                   = clientOperation("history-get-failover-info")
+	HistoryClientOperationRatelimitUpdate                   = clientOperation("history-ratelimit-update")
 
-	MatchingClientOperationAddActivityTask        = clientOperation("matching-add-activity-task")
-	MatchingClientOperationAddDecisionTask        = clientOperation("matching-add-decision-task")
-	MatchingClientOperationPollForActivityTask    = clientOperation("matching-poll-for-activity-task")
-	MatchingClientOperationPollForDecisionTask    = clientOperation("matching-poll-for-decision-task")
-	MatchingClientOperationQueryWorkflow          = clientOperation("matching-query-wf")
-	MatchingClientOperationQueryTaskCompleted     = clientOperation("matching-query-task-completed")
-	MatchingClientOperationCancelOutstandingPoll  = clientOperation("matching-cancel-outstanding-poll")
-	MatchingClientOperationDescribeTaskList       = clientOperation("matching-describe-task-list")
-	MatchingClientOperationListTaskListPartitions = clientOperation("matching-list-task-list-partitions")
-	MatchingClientOperationGetTaskListsByDomain   = clientOperation("get-task-list-for-domain")
+	MatchingClientOperationAddActivityTask            = clientOperation("matching-add-activity-task")
+	MatchingClientOperationAddDecisionTask            = clientOperation("matching-add-decision-task")
+	MatchingClientOperationPollForActivityTask        = clientOperation("matching-poll-for-activity-task")
+	MatchingClientOperationPollForDecisionTask        = clientOperation("matching-poll-for-decision-task")
+	MatchingClientOperationQueryWorkflow              = clientOperation("matching-query-wf")
+	MatchingClientOperationQueryTaskCompleted         = clientOperation("matching-query-task-completed")
+	MatchingClientOperationCancelOutstandingPoll      = clientOperation("matching-cancel-outstanding-poll")
+	MatchingClientOperationDescribeTaskList           = clientOperation("matching-describe-task-list")
+	MatchingClientOperationListTaskListPartitions     = clientOperation("matching-list-task-list-partitions")
+	MatchingClientOperationGetTaskListPartitionConfig = clientOperation("matching-get-task-list-partition-config")
+	MatchingClientOperationGetTaskListsByDomain       = clientOperation("get-task-list-for-domain")
 )
 
 // Pre-defined values for TagIDType
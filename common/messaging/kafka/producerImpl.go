@@ -104,6 +104,12 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
+	case []byte:
+		msg := &sarama.ProducerMessage{
+			Topic: p.topic,
+			Value: sarama.ByteEncoder(message),
+		}
+		return msg, nil
 	case *sarama.ConsumerMessage:
 		msg := &sarama.ProducerMessage{
 			Topic: p.topic,
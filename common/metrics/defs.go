@@ -299,8 +299,12 @@ const (
 	PersistenceGetDLQSizeScope
 	// PersistenceFetchDynamicConfigScope tracks FetchDynamicConfig calls made by service to persistence layer
 	PersistenceFetchDynamicConfigScope
+	// PersistenceFetchDynamicConfigHistoryScope tracks FetchDynamicConfigHistory calls made by service to persistence layer
+	PersistenceFetchDynamicConfigHistoryScope
 	// PersistenceUpdateDynamicConfigScope tracks UpdateDynamicConfig calls made by service to persistence layer
 	PersistenceUpdateDynamicConfigScope
+	// PersistenceDeleteDynamicConfigHistoryScope tracks DeleteDynamicConfigHistory calls made by service to persistence layer
+	PersistenceDeleteDynamicConfigHistoryScope
 	// PersistenceShardRequestCountScope tracks number of persistence calls made to each shard
 	PersistenceShardRequestCountScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
@@ -381,6 +385,8 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientRestoreArchivedWorkflowScope tracks RPC calls to history service
+	HistoryClientRestoreArchivedWorkflowScope
 	// HistoryClientNotifyFailoverMarkersScope tracks RPC calls to history service
 	HistoryClientNotifyFailoverMarkersScope
 	// HistoryClientGetCrossClusterTasksScope tracks RPC calls to history service
@@ -389,6 +395,8 @@ const (
 	HistoryClientRespondCrossClusterTasksCompletedScope
 	// HistoryClientGetFailoverInfoScope tracks RPC calls to history service
 	HistoryClientGetFailoverInfoScope
+	// HistoryClientRatelimitUpdateScope tracks RPC calls to history service
+	HistoryClientRatelimitUpdateScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -409,6 +417,8 @@ const (
 	MatchingClientListTaskListPartitionsScope
 	// MatchingClientGetTaskListsByDomainScope tracks RPC calls to matching service
 	MatchingClientGetTaskListsByDomainScope
+	// MatchingClientGetTaskListPartitionConfigScope tracks RPC calls to matching service
+	MatchingClientGetTaskListPartitionConfigScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -531,6 +541,8 @@ const (
 	AdminClientMergeDLQMessagesScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
+	// AdminClientRestoreArchivedWorkflowScope tracks RPC calls to admin service
+	AdminClientRestoreArchivedWorkflowScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetCrossClusterTasksScope tracks RPC calls to Admin service
@@ -545,6 +557,10 @@ const (
 	AdminClientRestoreDynamicConfigScope
 	// AdminClientListDynamicConfigScope tracks RPC calls to admin service
 	AdminClientListDynamicConfigScope
+	// AdminClientListDynamicConfigHistoryScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigHistoryScope
+	// AdminClientRollbackDynamicConfigScope tracks RPC calls to admin service
+	AdminClientRollbackDynamicConfigScope
 	// AdminClientGetGlobalIsolationGroupsScope is a request to get all the global isolation-groups
 	AdminClientGetGlobalIsolationGroupsScope
 	// AdminClientUpdateGlobalIsolationGroupsScope is a request to update the global isolation-groups
@@ -745,6 +761,10 @@ const (
 	ClusterMetadataScope
 	// GetAvailableIsolationGroupsScope is the metric for the default partitioner's getIsolationGroups operation
 	GetAvailableIsolationGroupsScope
+	// DynamicConfigScope is used by the dynamic config clients
+	DynamicConfigScope
+	// AuditScope is used by the audit log of the frontend
+	AuditScope
 
 	NumCommonScopes
 )
@@ -805,10 +825,16 @@ const (
 	AdminRestoreDynamicConfigScope
 	// AdminListDynamicConfigScope is the metric scope for admin.ListDynamicConfig
 	AdminListDynamicConfigScope
+	// AdminListDynamicConfigHistoryScope is the metric scope for admin.ListDynamicConfigHistory
+	AdminListDynamicConfigHistoryScope
+	// AdminRollbackDynamicConfigScope is the metric scope for admin.RollbackDynamicConfig
+	AdminRollbackDynamicConfigScope
 	// AdminDeleteWorkflowScope is the metric scope for admin.DeleteWorkflow
 	AdminDeleteWorkflowScope
 	// MaintainCorruptWorkflowScope is the metric scope for admin.MaintainCorruptWorkflow
 	MaintainCorruptWorkflowScope
+	// AdminRestoreArchivedWorkflowScope is the metric scope for admin.RestoreArchivedWorkflow
+	AdminRestoreArchivedWorkflowScope
 	// GetGlobalIsolationGroups is the scope for getting global isolation groups
 	GetGlobalIsolationGroups
 	// UpdateGlobalIsolationGroups is the scope for getting global isolation groups
@@ -989,6 +1015,8 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope tracks RefreshWorkflowTasks API calls received by service
 	HistoryRefreshWorkflowTasksScope
+	// HistoryRestoreArchivedWorkflowScope tracks RestoreArchivedWorkflow API calls received by service
+	HistoryRestoreArchivedWorkflowScope
 	// HistoryNotifyFailoverMarkersScope is the scope used by notify failover marker API
 	HistoryNotifyFailoverMarkersScope
 	// HistoryGetCrossClusterTasksScope tracks GetCrossClusterTasks API calls received by service
@@ -997,6 +1025,8 @@ const (
 	HistoryRespondCrossClusterTasksCompletedScope
 	// HistoryGetFailoverInfoScope tracks HistoryGetFailoverInfo API calls received by service
 	HistoryGetFailoverInfoScope
+	// HistoryRatelimitUpdateScope tracks RatelimitUpdate API calls received by service
+	HistoryRatelimitUpdateScope
 	// TaskPriorityAssignerScope is the scope used by all metric emitted by task priority assigner
 	TaskPriorityAssignerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -1211,6 +1241,8 @@ const (
 	MatchingListTaskListPartitionsScope
 	// MatchingGetTaskListsByDomainScope tracks GetTaskListsByDomain API calls received by service
 	MatchingGetTaskListsByDomainScope
+	// MatchingGetTaskListPartitionConfigScope tracks GetTaskListPartitionConfig API calls received by service
+	MatchingGetTaskListPartitionConfigScope
 
 	NumMatchingScopes
 )
@@ -1255,6 +1287,8 @@ const (
 	CheckDataCorruptionWorkflowScope
 	// ESAnalyzerScope is scope used by ElasticSearch Analyzer (esanalyzer) workflow
 	ESAnalyzerScope
+	// ArchivalRetentionScavengerScope is scope used by all metrics emitted by worker.archival.Scavenger module
+	ArchivalRetentionScavengerScope
 
 	NumWorkerScopes
 )
@@ -1351,7 +1385,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetDLQAckLevelScope:                                 {operation: "GetDLQAckLevel"},
 		PersistenceGetDLQSizeScope:                                     {operation: "GetDLQSize"},
 		PersistenceFetchDynamicConfigScope:                             {operation: "FetchDynamicConfig"},
+		PersistenceFetchDynamicConfigHistoryScope:                      {operation: "FetchDynamicConfigHistory"},
 		PersistenceUpdateDynamicConfigScope:                            {operation: "UpdateDynamicConfig"},
+		PersistenceDeleteDynamicConfigHistoryScope:                     {operation: "DeleteDynamicConfigHistory"},
 		PersistenceShardRequestCountScope:                              {operation: "ShardIdPersistenceRequest"},
 
 		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
@@ -1395,10 +1431,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRestoreArchivedWorkflowScope:             {operation: "HistoryClientRestoreArchivedWorkflowScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientNotifyFailoverMarkersScope:               {operation: "HistoryClientNotifyFailoverMarkersScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetCrossClusterTasksScope:                {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondCrossClusterTasksCompletedScope:   {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetFailoverInfoScope:                     {operation: "HistoryClientGetFailoverInfo", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRatelimitUpdateScope:                     {operation: "HistoryClientRatelimitUpdate", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:                {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:                {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
@@ -1409,6 +1447,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientListTaskListPartitionsScope:             {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientGetTaskListsByDomainScope:               {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientGetTaskListPartitionConfigScope:         {operation: "MatchingClientGetTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                    {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeDomainScope:                     {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                   {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1462,6 +1501,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRestoreArchivedWorkflowScope:               {operation: "AdminClientRestoreArchivedWorkflow", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRemoveTaskScope:                            {operation: "AdminClientRemoveTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1477,6 +1517,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientUpdateDynamicConfigScope:                   {operation: "AdminClientUpdateDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRestoreDynamicConfigScope:                  {operation: "AdminClientRestoreDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListDynamicConfigHistoryScope:              {operation: "AdminClientListDynamicConfigHistoryScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRollbackDynamicConfigScope:                 {operation: "AdminClientRollbackDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetGlobalIsolationGroupsScope:              {operation: "AdminClientGetGlobalIsolationGroups", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateGlobalIsolationGroupsScope:           {operation: "AdminClientUpdateGlobalIsolationGroups", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetDomainIsolationGroupsScope:              {operation: "AdminClientGetDomainIsolationGroups", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1570,6 +1612,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DomainFailoverScope:         {operation: "DomainFailover"},
 		DomainReplicationQueueScope: {operation: "DomainReplicationQueue"},
 		ClusterMetadataScope:        {operation: "ClusterMetadata"},
+		DynamicConfigScope:          {operation: "DynamicConfig"},
+		AuditScope:                  {operation: "Audit"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -1601,8 +1645,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminUpdateDynamicConfigScope:               {operation: "AdminUpdateDynamicConfig"},
 		AdminRestoreDynamicConfigScope:              {operation: "AdminRestoreDynamicConfig"},
 		AdminListDynamicConfigScope:                 {operation: "AdminListDynamicConfig"},
+		AdminListDynamicConfigHistoryScope:          {operation: "AdminListDynamicConfigHistory"},
+		AdminRollbackDynamicConfigScope:             {operation: "AdminRollbackDynamicConfig"},
 		AdminDeleteWorkflowScope:                    {operation: "AdminDeleteWorkflow"},
 		MaintainCorruptWorkflowScope:                {operation: "MaintainCorruptWorkflow"},
+		AdminRestoreArchivedWorkflowScope:           {operation: "AdminRestoreArchivedWorkflow"},
 		GetGlobalIsolationGroups:                    {operation: "GetGlobalIsolationGroups"},
 		UpdateGlobalIsolationGroups:                 {operation: "UpdateGlobalIsolationGroups"},
 		GetDomainIsolationGroups:                    {operation: "GetDomainIsolationGroups"},
@@ -1694,10 +1741,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                                     {operation: "ShardController"},
 		HistoryReapplyEventsScope:                                       {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                                {operation: "RefreshWorkflowTasks"},
+		HistoryRestoreArchivedWorkflowScope:                             {operation: "RestoreArchivedWorkflow"},
 		HistoryNotifyFailoverMarkersScope:                               {operation: "NotifyFailoverMarkers"},
 		HistoryGetCrossClusterTasksScope:                                {operation: "GetCrossClusterTasks"},
 		HistoryRespondCrossClusterTasksCompletedScope:                   {operation: "RespondCrossClusterTasksCompleted"},
 		HistoryGetFailoverInfoScope:                                     {operation: "GetFailoverInfo"},
+		HistoryRatelimitUpdateScope:                                     {operation: "RatelimitUpdate"},
 		TaskPriorityAssignerScope:                                       {operation: "TaskPriorityAssigner"},
 		TransferQueueProcessorScope:                                     {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                               {operation: "TransferActiveQueueProcessor"},
@@ -1790,18 +1839,19 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollForDecisionTaskScope:       {operation: "PollForDecisionTask"},
-		MatchingPollForActivityTaskScope:       {operation: "PollForActivityTask"},
-		MatchingAddActivityTaskScope:           {operation: "AddActivityTask"},
-		MatchingAddDecisionTaskScope:           {operation: "AddDecisionTask"},
-		MatchingAddTaskScope:                   {operation: "AddTask"},
-		MatchingTaskListMgrScope:               {operation: "TaskListMgr"},
-		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
-		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
-		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
-		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
-		MatchingListTaskListPartitionsScope:    {operation: "ListTaskListPartitions"},
-		MatchingGetTaskListsByDomainScope:      {operation: "GetTaskListsByDomain"},
+		MatchingPollForDecisionTaskScope:        {operation: "PollForDecisionTask"},
+		MatchingPollForActivityTaskScope:        {operation: "PollForActivityTask"},
+		MatchingAddActivityTaskScope:            {operation: "AddActivityTask"},
+		MatchingAddDecisionTaskScope:            {operation: "AddDecisionTask"},
+		MatchingAddTaskScope:                    {operation: "AddTask"},
+		MatchingTaskListMgrScope:                {operation: "TaskListMgr"},
+		MatchingQueryWorkflowScope:              {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope:  {operation: "RespondQueryTaskCompleted"},
+		MatchingCancelOutstandingPollScope:      {operation: "CancelOutstandingPoll"},
+		MatchingDescribeTaskListScope:           {operation: "DescribeTaskList"},
+		MatchingListTaskListPartitionsScope:     {operation: "ListTaskListPartitions"},
+		MatchingGetTaskListsByDomainScope:       {operation: "GetTaskListsByDomain"},
+		MatchingGetTaskListPartitionConfigScope: {operation: "GetTaskListPartitionConfig"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1824,6 +1874,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 		ESAnalyzerScope:                        {operation: "ESAnalyzer"},
+		ArchivalRetentionScavengerScope:        {operation: "archivalretentionscavenger"},
 	},
 }
 
@@ -2020,6 +2071,11 @@ const (
 	IsolationGroupStateDrained
 	IsolationGroupStateHealthy
 
+	DynamicConfigInvalidValueCounter
+
+	AuditEntriesDroppedCounter
+	AuditWriteFailuresCounter
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -2313,6 +2369,8 @@ const (
 	AsyncMatchDispatchLatencyPerTaskList
 	AsyncMatchDispatchTimeoutCounterPerTaskList
 	ExpiredTasksPerTaskListCounter
+	FairnessSkippedTasksPerTaskListCounter
+	TypeThrottledTasksPerTaskListCounter
 	ForwardedPerTaskListCounter
 	ForwardTaskCallsPerTaskList
 	ForwardTaskErrorsPerTaskList
@@ -2333,6 +2391,12 @@ const (
 	TaskListManagersGauge
 	TaskLagPerTaskListGauge
 	TaskBacklogPerTaskListGauge
+	EstimatedAddTaskQPSPerTaskListGauge
+	TaskListPartitionUpscaleCounter
+	TaskListPartitionDownscaleCounter
+	TaskListPartitionUpdateFailedCounter
+	TaskListReadPartitionsGauge
+	TaskListWritePartitionsGauge
 
 	NumMatchingMetrics
 )
@@ -2415,6 +2479,10 @@ const (
 	ESAnalyzerNumStuckWorkflowsRefreshed
 	ESAnalyzerNumStuckWorkflowsFailedToRefresh
 	ESAnalyzerNumLongRunningWorkflows
+	ArchivalRetentionExpiredCount
+	ArchivalRetentionDeletedCount
+	ArchivalRetentionDryRunCount
+	ArchivalRetentionErrorCount
 
 	NumWorkerMetrics
 )
@@ -2634,6 +2702,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		IsolationGroupStatePollerUnavailable: {metricName: "isolation_group_poller_unavailable", metricType: Counter},
 		IsolationGroupStateDrained:           {metricName: "isolation_group_drained", metricType: Counter},
 		IsolationGroupStateHealthy:           {metricName: "isolation_group_healthy", metricType: Counter},
+		DynamicConfigInvalidValueCounter:     {metricName: "dynamic_config_invalid_value", metricType: Counter},
+		AuditEntriesDroppedCounter:           {metricName: "audit_entries_dropped", metricType: Counter},
+		AuditWriteFailuresCounter:            {metricName: "audit_write_failures", metricType: Counter},
 	},
 	History: {
 		TaskRequests:             {metricName: "task_requests", metricType: Counter},
@@ -2911,6 +2982,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		SyncThrottlePerTaskListCounter:              {metricName: "sync_throttle_count_per_tl", metricRollupName: "sync_throttle_count"},
 		BufferThrottlePerTaskListCounter:            {metricName: "buffer_throttle_count_per_tl", metricRollupName: "buffer_throttle_count"},
 		ExpiredTasksPerTaskListCounter:              {metricName: "tasks_expired_per_tl", metricRollupName: "tasks_expired"},
+		FairnessSkippedTasksPerTaskListCounter:      {metricName: "fairness_skipped_tasks_per_tl", metricRollupName: "fairness_skipped_tasks"},
+		TypeThrottledTasksPerTaskListCounter:        {metricName: "type_throttled_tasks_per_tl", metricRollupName: "type_throttled_tasks"},
 		ForwardedPerTaskListCounter:                 {metricName: "forwarded_per_tl", metricRollupName: "forwarded"},
 		ForwardTaskCallsPerTaskList:                 {metricName: "forward_task_calls_per_tl", metricRollupName: "forward_task_calls"},
 		ForwardTaskErrorsPerTaskList:                {metricName: "forward_task_errors_per_tl", metricRollupName: "forward_task_errors"},
@@ -2935,6 +3008,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskListManagersGauge:                       {metricName: "tasklist_managers", metricType: Gauge},
 		TaskLagPerTaskListGauge:                     {metricName: "task_lag_per_tl", metricType: Gauge},
 		TaskBacklogPerTaskListGauge:                 {metricName: "task_backlog_per_tl", metricType: Gauge},
+		EstimatedAddTaskQPSPerTaskListGauge:         {metricName: "estimated_add_task_qps_per_tl", metricType: Gauge},
+		TaskListPartitionUpscaleCounter:             {metricName: "tasklist_partition_upscale", metricType: Counter},
+		TaskListPartitionDownscaleCounter:           {metricName: "tasklist_partition_downscale", metricType: Counter},
+		TaskListPartitionUpdateFailedCounter:        {metricName: "tasklist_partition_update_failed", metricType: Counter},
+		TaskListReadPartitionsGauge:                 {metricName: "tasklist_read_partitions", metricType: Gauge},
+		TaskListWritePartitionsGauge:                {metricName: "tasklist_write_partitions", metricType: Gauge},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -3013,6 +3092,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ESAnalyzerNumStuckWorkflowsRefreshed:          {metricName: "es_analyzer_num_stuck_workflows_refreshed", metricType: Counter},
 		ESAnalyzerNumStuckWorkflowsFailedToRefresh:    {metricName: "es_analyzer_num_stuck_workflows_failed_to_refresh", metricType: Counter},
 		ESAnalyzerNumLongRunningWorkflows:             {metricName: "es_analyzer_num_long_running_workflows", metricType: Counter},
+		ArchivalRetentionExpiredCount:                 {metricName: "archival_retention_expired", metricType: Counter},
+		ArchivalRetentionDeletedCount:                 {metricName: "archival_retention_deleted", metricType: Counter},
+		ArchivalRetentionDryRunCount:                  {metricName: "archival_retention_dry_run", metricType: Counter},
+		ArchivalRetentionErrorCount:                   {metricName: "archival_retention_errors", metricType: Counter},
 	},
 }
 
@@ -40,6 +40,9 @@ import (
 const (
 	IsolationGroupKey = "isolation-group"
 	WorkflowIDKey     = "wf-id"
+	// FairnessKey is the partition config key holding the fairness key of a workflow, which matching
+	// uses to share task list pollers fairly between workflows with different keys
+	FairnessKey = "fairness-key"
 )
 
 // ErrNoIsolationGroupsAvailable is returned when there are no available isolation-groups
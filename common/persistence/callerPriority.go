@@ -0,0 +1,53 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+)
+
+type (
+	// CallerPriority is the priority of a persistence call, used to shed background calls before
+	// user facing calls when the database is degraded
+	CallerPriority int
+
+	callerPriorityContextKey struct{}
+)
+
+const (
+	// CallerPriorityHigh is the priority of user facing calls, it is the default
+	CallerPriorityHigh CallerPriority = iota
+	// CallerPriorityLow is the priority of background calls, e.g. scanners and replication
+	CallerPriorityLow
+)
+
+// ContextWithCallerPriority returns a copy of ctx carrying the priority of persistence calls made with it
+func ContextWithCallerPriority(ctx context.Context, priority CallerPriority) context.Context {
+	return context.WithValue(ctx, callerPriorityContextKey{}, priority)
+}
+
+// CallerPriorityFromContext returns the priority of persistence calls made with ctx
+func CallerPriorityFromContext(ctx context.Context) CallerPriority {
+	if priority, ok := ctx.Value(callerPriorityContextKey{}).(CallerPriority); ok {
+		return priority
+	}
+	return CallerPriorityHigh
+}
@@ -89,8 +89,9 @@ type (
 
 	// Datastore represents a datastore
 	Datastore struct {
-		factory   DataStoreFactory
-		ratelimit quotas.Limiter
+		factory         DataStoreFactory
+		ratelimit       quotas.Limiter
+		adaptiveLimiter *quotas.AdaptiveConcurrencyLimiter
 	}
 	factoryImpl struct {
 		sync.RWMutex
@@ -150,7 +151,8 @@ func NewFactory(
 		dc:            dc,
 	}
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
-	factory.init(clusterName, limiters)
+	adaptiveLimiters := buildAdaptiveLimiters(cfg, dc)
+	factory.init(clusterName, limiters, adaptiveLimiters)
 	return factory
 }
 
@@ -168,6 +170,9 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if ds.adaptiveLimiter != nil {
+		result = p.NewTaskPersistenceAdaptiveClient(result, ds.adaptiveLimiter, f.dc.EnableAdaptiveConcurrency, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.logger, f.config)
 	}
@@ -188,6 +193,9 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if ds.adaptiveLimiter != nil {
+		result = p.NewShardPersistenceAdaptiveClient(result, ds.adaptiveLimiter, f.dc.EnableAdaptiveConcurrency, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewShardPersistenceMetricsClient(result, f.metricsClient, f.logger, f.config)
 	}
@@ -208,6 +216,9 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if ds.adaptiveLimiter != nil {
+		result = p.NewHistoryPersistenceAdaptiveClient(result, ds.adaptiveLimiter, f.dc.EnableAdaptiveConcurrency, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewHistoryPersistenceMetricsClient(result, f.metricsClient, f.logger, f.config)
 	}
@@ -230,6 +241,9 @@ func (f *factoryImpl) NewDomainManager() (p.DomainManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewDomainPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if ds.adaptiveLimiter != nil {
+		result = p.NewDomainPersistenceAdaptiveClient(result, ds.adaptiveLimiter, f.dc.EnableAdaptiveConcurrency, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewDomainPersistenceMetricsClient(result, f.metricsClient, f.logger, f.config)
 	}
@@ -250,6 +264,9 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if ds.adaptiveLimiter != nil {
+		result = p.NewWorkflowExecutionPersistenceAdaptiveClient(result, ds.adaptiveLimiter, f.dc.EnableAdaptiveConcurrency, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger, f.config, f.dc.PersistenceSampleLoggingRate, f.dc.EnableShardIDMetrics)
 	}
@@ -342,6 +359,9 @@ func (f *factoryImpl) newDBVisibilityManager(
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if ds.adaptiveLimiter != nil {
+		result = p.NewVisibilityPersistenceAdaptiveClient(result, ds.adaptiveLimiter, f.dc.EnableAdaptiveConcurrency, f.logger)
+	}
 	if visibilityConfig.EnableDBVisibilitySampling != nil && visibilityConfig.EnableDBVisibilitySampling() {
 		result = p.NewVisibilitySamplingClient(result, &p.SamplingConfig{
 			VisibilityClosedMaxQPS: visibilityConfig.WriteDBVisibilityClosedMaxQPS,
@@ -369,6 +389,9 @@ func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error)
 	if ds.ratelimit != nil {
 		result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if ds.adaptiveLimiter != nil {
+		result = p.NewQueuePersistenceAdaptiveClient(result, ds.adaptiveLimiter, f.dc.EnableAdaptiveConcurrency, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger, f.config)
 	}
@@ -389,6 +412,9 @@ func (f *factoryImpl) NewConfigStoreManager() (p.ConfigStoreManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewConfigStorePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if ds.adaptiveLimiter != nil {
+		result = p.NewConfigStorePersistenceAdaptiveClient(result, ds.adaptiveLimiter, f.dc.EnableAdaptiveConcurrency, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewConfigStorePersistenceMetricsClient(result, f.metricsClient, f.logger, f.config)
 	}
@@ -402,13 +428,20 @@ func (f *factoryImpl) Close() {
 	ds.factory.Close()
 }
 
-func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limiter) {
+func (f *factoryImpl) init(
+	clusterName string,
+	limiters map[string]quotas.Limiter,
+	adaptiveLimiters map[string]*quotas.AdaptiveConcurrencyLimiter,
+) {
 	f.datastores = make(map[storeType]Datastore, len(storeTypes))
 	defaultCfg := f.config.DataStores[f.config.DefaultStore]
 	if defaultCfg.Cassandra != nil {
 		f.logger.Warn("Cassandra config is deprecated, please use NoSQL with pluginName of cassandra.")
 	}
-	defaultDataStore := Datastore{ratelimit: limiters[f.config.DefaultStore]}
+	defaultDataStore := Datastore{
+		ratelimit:       limiters[f.config.DefaultStore],
+		adaptiveLimiter: adaptiveLimiters[f.config.DefaultStore],
+	}
 	switch {
 	case defaultCfg.NoSQL != nil:
 		shardedNoSQLConfig := defaultCfg.NoSQL.ConvertToShardedNoSQLConfig()
@@ -454,7 +487,10 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	if visibilityCfg.Cassandra != nil {
 		f.logger.Warn("Cassandra config is deprecated, please use NoSQL with pluginName of cassandra.")
 	}
-	visibilityDataStore := Datastore{ratelimit: limiters[f.config.VisibilityStore]}
+	visibilityDataStore := Datastore{
+		ratelimit:       limiters[f.config.VisibilityStore],
+		adaptiveLimiter: adaptiveLimiters[f.config.VisibilityStore],
+	}
 	switch {
 	case visibilityCfg.NoSQL != nil:
 		shardedNoSQLConfig := visibilityCfg.NoSQL.ConvertToShardedNoSQLConfig()
@@ -494,3 +530,18 @@ func buildRatelimiters(cfg *config.Persistence, maxQPS quotas.RPSFunc) map[strin
 	}
 	return result
 }
+
+func buildAdaptiveLimiters(cfg *config.Persistence, dc *p.DynamicConfiguration) map[string]*quotas.AdaptiveConcurrencyLimiter {
+	result := make(map[string]*quotas.AdaptiveConcurrencyLimiter, len(cfg.DataStores))
+	if dc == nil || dc.EnableAdaptiveConcurrency == nil {
+		return result
+	}
+	for dsName := range cfg.DataStores {
+		result[dsName] = quotas.NewAdaptiveConcurrencyLimiter(
+			dc.MinConcurrency.AsFloat64(),
+			dc.MaxConcurrency.AsFloat64(),
+			dc.LowPriorityConcurrencyRatio.AsFloat64(),
+		)
+	}
+	return result
+}
@@ -31,6 +31,10 @@ type (
 		EnableCassandraAllConsistencyLevelDelete dynamicconfig.BoolPropertyFn
 		PersistenceSampleLoggingRate             dynamicconfig.IntPropertyFn
 		EnableShardIDMetrics                     dynamicconfig.BoolPropertyFn
+		EnableAdaptiveConcurrency                dynamicconfig.BoolPropertyFn
+		MaxConcurrency                           dynamicconfig.IntPropertyFn
+		MinConcurrency                           dynamicconfig.IntPropertyFn
+		LowPriorityConcurrencyRatio              dynamicconfig.FloatPropertyFn
 	}
 )
 
@@ -41,5 +45,9 @@ func NewDynamicConfiguration(dc *dynamicconfig.Collection) *DynamicConfiguration
 		EnableCassandraAllConsistencyLevelDelete: dc.GetBoolProperty(dynamicconfig.EnableCassandraAllConsistencyLevelDelete),
 		PersistenceSampleLoggingRate:             dc.GetIntProperty(dynamicconfig.SampleLoggingRate),
 		EnableShardIDMetrics:                     dc.GetBoolProperty(dynamicconfig.EnableShardIDMetrics),
+		EnableAdaptiveConcurrency:                dc.GetBoolProperty(dynamicconfig.EnablePersistenceAdaptiveConcurrency),
+		MaxConcurrency:                           dc.GetIntProperty(dynamicconfig.PersistenceMaxConcurrency),
+		MinConcurrency:                           dc.GetIntProperty(dynamicconfig.PersistenceMinConcurrency),
+		LowPriorityConcurrencyRatio:              dc.GetFloat64Property(dynamicconfig.PersistenceLowPriorityConcurrencyRatio),
 	}
 }
@@ -70,6 +70,26 @@ func (m *configStoreManagerImpl) FetchDynamicConfig(ctx context.Context, cfgType
 	}}, nil
 }
 
+func (m *configStoreManagerImpl) FetchDynamicConfigHistory(ctx context.Context, request *FetchDynamicConfigHistoryRequest, cfgType ConfigType) (*FetchDynamicConfigHistoryResponse, error) {
+	entries, err := m.persistence.FetchConfigHistory(ctx, cfgType, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*DynamicConfigSnapshot, 0, len(entries))
+	for _, entry := range entries {
+		config, err := m.serializer.DeserializeDynamicConfigBlob(entry.Values)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, &DynamicConfigSnapshot{
+			Version: entry.Version,
+			Values:  config,
+		})
+	}
+	return &FetchDynamicConfigHistoryResponse{Snapshots: snapshots}, nil
+}
+
 func (m *configStoreManagerImpl) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, cfgType ConfigType) error {
 	blob, err := m.serializer.SerializeDynamicConfigBlob(request.Snapshot.Values, common.EncodingTypeThriftRW)
 	if err != nil {
@@ -85,3 +105,7 @@ func (m *configStoreManagerImpl) UpdateDynamicConfig(ctx context.Context, reques
 
 	return m.persistence.UpdateConfig(ctx, entry)
 }
+
+func (m *configStoreManagerImpl) DeleteDynamicConfigHistory(ctx context.Context, request *DeleteDynamicConfigHistoryRequest, cfgType ConfigType) error {
+	return m.persistence.DeleteConfigHistory(ctx, cfgType, request.BeforeVersion)
+}
@@ -451,6 +451,16 @@ type (
 		Kind        int
 		Expiry      time.Time
 		LastUpdated time.Time
+		// AdaptivePartitionConfig is the partition config of the task list set by the adaptive
+		// scaler of its root partition, nil when the partitions come from dynamic config
+		AdaptivePartitionConfig *TaskListPartitionConfig
+	}
+
+	// TaskListPartitionConfig describes the number of partitions of a task list
+	TaskListPartitionConfig struct {
+		Version            int64
+		NumReadPartitions  int
+		NumWritePartitions int
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -464,6 +474,11 @@ type (
 		Expiry                 time.Time
 		CreatedTime            time.Time
 		PartitionConfig        map[string]string
+		// Priority of the task, tasks with higher priority are dispatched to pollers first
+		Priority int32
+		// TypeName is the workflow type of a decision task or the activity type of an activity task,
+		// used by matching to enforce per type dispatch limits
+		TypeName string
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -1372,6 +1387,7 @@ type (
 		VisibilityArchivalURI    string
 		BadBinaries              types.BadBinaries
 		IsolationGroups          types.IsolationGroupConfiguration
+		RoleBindings             []*types.RoleBinding
 	}
 
 	// DomainReplicationConfig describes the cross DC domain replication configuration
@@ -1709,6 +1725,24 @@ type (
 		Snapshot *DynamicConfigSnapshot
 	}
 
+	// FetchDynamicConfigHistoryRequest is a request to FetchDynamicConfigHistory
+	FetchDynamicConfigHistoryRequest struct {
+		// maximum number of snapshots returned
+		PageSize int
+	}
+
+	// FetchDynamicConfigHistoryResponse is a response to FetchDynamicConfigHistory
+	FetchDynamicConfigHistoryResponse struct {
+		// snapshots from the latest version down
+		Snapshots []*DynamicConfigSnapshot
+	}
+
+	// DeleteDynamicConfigHistoryRequest is a request to DeleteDynamicConfigHistory
+	DeleteDynamicConfigHistoryRequest struct {
+		// snapshots with a lower version are deleted
+		BeforeVersion int64
+	}
+
 	// UpdateDynamicConfigRequest is a request to update dynamic config with snapshot
 	UpdateDynamicConfigRequest struct {
 		Snapshot *DynamicConfigSnapshot
@@ -1869,7 +1903,9 @@ type (
 	ConfigStoreManager interface {
 		Closeable
 		FetchDynamicConfig(ctx context.Context, cfgType ConfigType) (*FetchDynamicConfigResponse, error)
+		FetchDynamicConfigHistory(ctx context.Context, request *FetchDynamicConfigHistoryRequest, cfgType ConfigType) (*FetchDynamicConfigHistoryResponse, error)
 		UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, cfgType ConfigType) error
+		DeleteDynamicConfigHistory(ctx context.Context, request *DeleteDynamicConfigHistoryRequest, cfgType ConfigType) error
 		//can add functions for config types other than dynamic config
 	}
 )
@@ -1545,6 +1545,20 @@ func (mr *MockConfigStoreManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockConfigStoreManager)(nil).Close))
 }
 
+// DeleteDynamicConfigHistory mocks base method.
+func (m *MockConfigStoreManager) DeleteDynamicConfigHistory(ctx context.Context, request *DeleteDynamicConfigHistoryRequest, cfgType ConfigType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDynamicConfigHistory", ctx, request, cfgType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDynamicConfigHistory indicates an expected call of DeleteDynamicConfigHistory.
+func (mr *MockConfigStoreManagerMockRecorder) DeleteDynamicConfigHistory(ctx, request, cfgType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfigHistory", reflect.TypeOf((*MockConfigStoreManager)(nil).DeleteDynamicConfigHistory), ctx, request, cfgType)
+}
+
 // FetchDynamicConfig mocks base method.
 func (m *MockConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfgType ConfigType) (*FetchDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -1560,6 +1574,21 @@ func (mr *MockConfigStoreManagerMockRecorder) FetchDynamicConfig(ctx, cfgType in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDynamicConfig", reflect.TypeOf((*MockConfigStoreManager)(nil).FetchDynamicConfig), ctx, cfgType)
 }
 
+// FetchDynamicConfigHistory mocks base method.
+func (m *MockConfigStoreManager) FetchDynamicConfigHistory(ctx context.Context, request *FetchDynamicConfigHistoryRequest, cfgType ConfigType) (*FetchDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchDynamicConfigHistory", ctx, request, cfgType)
+	ret0, _ := ret[0].(*FetchDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchDynamicConfigHistory indicates an expected call of FetchDynamicConfigHistory.
+func (mr *MockConfigStoreManagerMockRecorder) FetchDynamicConfigHistory(ctx, request, cfgType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDynamicConfigHistory", reflect.TypeOf((*MockConfigStoreManager)(nil).FetchDynamicConfigHistory), ctx, request, cfgType)
+}
+
 // UpdateDynamicConfig mocks base method.
 func (m *MockConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, cfgType ConfigType) error {
 	m.ctrl.T.Helper()
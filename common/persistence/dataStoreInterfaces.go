@@ -184,7 +184,9 @@ type (
 	ConfigStore interface {
 		Closeable
 		FetchConfig(ctx context.Context, configType ConfigType) (*InternalConfigStoreEntry, error)
+		FetchConfigHistory(ctx context.Context, configType ConfigType, pageSize int) ([]*InternalConfigStoreEntry, error)
 		UpdateConfig(ctx context.Context, value *InternalConfigStoreEntry) error
+		DeleteConfigHistory(ctx context.Context, configType ConfigType, beforeVersion int64) error
 	}
 
 	InternalConfigStoreEntry struct {
@@ -787,6 +789,7 @@ type (
 		VisibilityArchivalURI    string
 		BadBinaries              *DataBlob
 		IsolationGroups          *DataBlob
+		RoleBindings             *DataBlob
 	}
 
 	// InternalCreateDomainRequest is used to create the domain
@@ -888,6 +891,8 @@ type (
 		Expiry                 time.Time
 		CreatedTime            time.Time
 		PartitionConfig        map[string]string
+		Priority               int32
+		TypeName               string
 	}
 
 	// InternalCreateTasksInfo describes a task to be created in InternalCreateTasksRequest
@@ -195,6 +195,10 @@ func (m *domainManagerImpl) toInternalDomainConfig(c *DomainConfig) (InternalDom
 	if err != nil {
 		return InternalDomainConfig{}, err
 	}
+	roleBindings, err := m.serializer.SerializeRoleBindings(c.RoleBindings, common.EncodingTypeThriftRW)
+	if err != nil {
+		return InternalDomainConfig{}, err
+	}
 	return InternalDomainConfig{
 		Retention:                common.DaysToDuration(c.Retention),
 		EmitMetric:               c.EmitMetric,
@@ -204,6 +208,7 @@ func (m *domainManagerImpl) toInternalDomainConfig(c *DomainConfig) (InternalDom
 		VisibilityArchivalURI:    c.VisibilityArchivalURI,
 		BadBinaries:              badBinaries,
 		IsolationGroups:          isolationGroups,
+		RoleBindings:             roleBindings,
 	}, nil
 }
 
@@ -223,6 +228,10 @@ func (m *domainManagerImpl) fromInternalDomainConfig(ic *InternalDomainConfig) (
 	if igRes != nil {
 		isolationGroups = *igRes
 	}
+	roleBindings, err := m.serializer.DeserializeRoleBindings(ic.RoleBindings)
+	if err != nil {
+		return DomainConfig{}, err
+	}
 
 	if badBinaries.Binaries == nil {
 		badBinaries.Binaries = map[string]*types.BadBinaryInfo{}
@@ -236,6 +245,7 @@ func (m *domainManagerImpl) fromInternalDomainConfig(ic *InternalDomainConfig) (
 		VisibilityArchivalURI:    ic.VisibilityArchivalURI,
 		BadBinaries:              *badBinaries,
 		IsolationGroups:          isolationGroups,
+		RoleBindings:             roleBindings,
 	}, nil
 }
 
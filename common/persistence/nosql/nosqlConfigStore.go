@@ -61,6 +61,14 @@ func (m *nosqlConfigStore) FetchConfig(ctx context.Context, configType persisten
 	return entry, nil
 }
 
+func (m *nosqlConfigStore) FetchConfigHistory(ctx context.Context, configType persistence.ConfigType, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	entries, err := m.db.SelectLatestConfigs(ctx, int(configType), pageSize)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "FetchConfigHistory", err)
+	}
+	return entries, nil
+}
+
 func (m *nosqlConfigStore) UpdateConfig(ctx context.Context, value *persistence.InternalConfigStoreEntry) error {
 	err := m.db.InsertConfig(ctx, value)
 	if err != nil {
@@ -71,3 +79,11 @@ func (m *nosqlConfigStore) UpdateConfig(ctx context.Context, value *persistence.
 	}
 	return nil
 }
+
+func (m *nosqlConfigStore) DeleteConfigHistory(ctx context.Context, configType persistence.ConfigType, beforeVersion int64) error {
+	err := m.db.DeleteConfigsBefore(ctx, int(configType), beforeVersion)
+	if err != nil {
+		return convertCommonErrors(m.db, "DeleteConfigHistory", err)
+	}
+	return nil
+}
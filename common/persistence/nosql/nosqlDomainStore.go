@@ -283,6 +283,7 @@ func (m *nosqlDomainStore) toNoSQLInternalDomainConfig(
 		VisibilityArchivalURI:    domainConfig.VisibilityArchivalURI,
 		BadBinaries:              domainConfig.BadBinaries,
 		IsolationGroups:          domainConfig.IsolationGroups,
+		RoleBindings:             domainConfig.RoleBindings,
 	}, nil
 }
 
@@ -300,5 +301,6 @@ func (m *nosqlDomainStore) fromNoSQLInternalDomainConfig(
 		VisibilityArchivalURI:    domainConfig.VisibilityArchivalURI,
 		BadBinaries:              domainConfig.BadBinaries,
 		IsolationGroups:          domainConfig.IsolationGroups,
+		RoleBindings:             domainConfig.RoleBindings,
 	}, nil
 }
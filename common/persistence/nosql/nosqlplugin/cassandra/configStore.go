@@ -27,13 +27,18 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	// version is the clustering key(DESC order) so this query will always return the record with largest version
 	templateSelectLatestConfig = `SELECT row_type, version, timestamp, values, encoding FROM cluster_config WHERE row_type = ? LIMIT 1;`
 
+	templateSelectLatestConfigs = `SELECT row_type, version, timestamp, values, encoding FROM cluster_config WHERE row_type = ? LIMIT ?;`
+
 	templateInsertConfig = `INSERT INTO cluster_config (row_type, version, timestamp, values, encoding) VALUES (?, ?, ?, ?, ?) IF NOT EXISTS;`
+
+	templateDeleteConfigsBefore = `DELETE FROM cluster_config WHERE row_type = ? AND version < ?;`
 )
 
 func (db *cdb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
@@ -70,3 +75,40 @@ func (db *cdb) SelectLatestConfig(ctx context.Context, rowType int) (*persistenc
 		},
 	}, err
 }
+
+func (db *cdb) SelectLatestConfigs(ctx context.Context, rowType int, limit int) ([]*persistence.InternalConfigStoreEntry, error) {
+	query := db.session.Query(templateSelectLatestConfigs, rowType, limit).WithContext(ctx)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &types.InternalServiceError{
+			Message: "SelectLatestConfigs operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	var version int64
+	var timestamp time.Time
+	var data []byte
+	var encoding common.EncodingType
+	var rows []*persistence.InternalConfigStoreEntry
+	for iter.Scan(&rowType, &version, &timestamp, &data, &encoding) {
+		rows = append(rows, &persistence.InternalConfigStoreEntry{
+			RowType:   rowType,
+			Version:   version,
+			Timestamp: timestamp,
+			Values: &persistence.DataBlob{
+				Data:     data,
+				Encoding: encoding,
+			},
+		})
+		data = nil
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (db *cdb) DeleteConfigsBefore(ctx context.Context, rowType int, version int64) error {
+	query := db.session.Query(templateDeleteConfigsBefore, rowType, version).WithContext(ctx)
+	return query.Exec()
+}
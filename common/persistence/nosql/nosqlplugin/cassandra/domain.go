@@ -61,7 +61,9 @@ const (
 		`bad_binaries: ?,` +
 		`bad_binaries_encoding: ?,` +
 		`isolation_groups: ?,` +
-		`isolation_groups_encoding: ?` +
+		`isolation_groups_encoding: ?,` +
+		`role_bindings: ?,` +
+		`role_bindings_encoding: ?` +
 		`}`
 
 	templateDomainReplicationConfigType = `{` +
@@ -93,6 +95,8 @@ const (
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`config.isolation_groups,` +
 		`config.isolation_groups_encoding,` +
+		`config.role_bindings,` +
+		`config.role_bindings_encoding,` +
 		`is_global_domain, ` +
 		`config_version, ` +
 		`failover_version, ` +
@@ -141,6 +145,7 @@ const (
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
 		`config.bad_binaries, config.bad_binaries_encoding, ` +
 		`config.isolation_groups, config.isolation_groups_encoding, ` +
+		`config.role_bindings, config.role_bindings_encoding, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -181,6 +186,7 @@ func (db *cdb) InsertDomain(
 		failoverEndTime = row.FailoverEndTime.UnixNano()
 	}
 	isolationGroupData, isolationGroupEncoding := getIsolationGroupFields(row)
+	roleBindingsData, roleBindingsEncoding := getRoleBindingsFields(row)
 
 	batch.Query(templateCreateDomainByNameQueryWithinBatchV2,
 		constDomainPartition,
@@ -203,6 +209,8 @@ func (db *cdb) InsertDomain(
 		string(row.Config.BadBinaries.Encoding),
 		isolationGroupData,
 		isolationGroupEncoding,
+		roleBindingsData,
+		roleBindingsEncoding,
 		row.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
 		row.IsGlobalDomain,
@@ -286,6 +294,7 @@ func (db *cdb) UpdateDomain(
 	}
 
 	isolationGroupData, isolationGroupEncoding := getIsolationGroupFields(row)
+	roleBindingsData, roleBindingsEncoding := getRoleBindingsFields(row)
 
 	batch.Query(templateUpdateDomainByNameQueryWithinBatchV2,
 		row.Info.ID,
@@ -306,6 +315,8 @@ func (db *cdb) UpdateDomain(
 		string(row.Config.BadBinaries.Encoding),
 		isolationGroupData,
 		isolationGroupEncoding,
+		roleBindingsData,
+		roleBindingsEncoding,
 		row.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
 		row.ConfigVersion,
@@ -379,6 +390,8 @@ func (db *cdb) SelectDomain(
 	var retentionDays int32
 	var isolationGroupData []byte
 	var isolationGroupEncoding string
+	var roleBindingsData []byte
+	var roleBindingsEncoding string
 
 	query = db.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, domainName).WithContext(ctx)
 	err = query.Scan(
@@ -402,6 +415,8 @@ func (db *cdb) SelectDomain(
 		&replicationClusters,
 		&isolationGroupData,
 		&isolationGroupEncoding,
+		&roleBindingsData,
+		&roleBindingsEncoding,
 		&isGlobalDomain,
 		&configVersion,
 		&failoverVersion,
@@ -417,6 +432,7 @@ func (db *cdb) SelectDomain(
 	}
 
 	config.IsolationGroups = p.NewDataBlob(isolationGroupData, common.EncodingType(isolationGroupEncoding))
+	config.RoleBindings = p.NewDataBlob(roleBindingsData, common.EncodingType(roleBindingsEncoding))
 	config.BadBinaries = p.NewDataBlob(badBinariesData, common.EncodingType(badBinariesDataEncoding))
 	config.Retention = common.DaysToDuration(retentionDays)
 	replicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
@@ -465,6 +481,8 @@ func (db *cdb) SelectAllDomains(
 	var badBinariesDataEncoding string
 	var isolationGroups []byte
 	var isolationGroupsEncoding string
+	var roleBindings []byte
+	var roleBindingsEncoding string
 	var retentionDays int32
 	var failoverEndTime int64
 	var lastUpdateTime int64
@@ -489,6 +507,8 @@ func (db *cdb) SelectAllDomains(
 		&badBinariesDataEncoding,
 		&isolationGroups,
 		&isolationGroupsEncoding,
+		&roleBindings,
+		&roleBindingsEncoding,
 		&domain.ReplicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&domain.IsGlobalDomain,
@@ -506,6 +526,7 @@ func (db *cdb) SelectAllDomains(
 			domain.ReplicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
 			domain.Config.Retention = common.DaysToDuration(retentionDays)
 			domain.Config.IsolationGroups = p.NewDataBlob(isolationGroups, common.EncodingType(isolationGroupsEncoding))
+			domain.Config.RoleBindings = p.NewDataBlob(roleBindings, common.EncodingType(roleBindingsEncoding))
 			domain.LastUpdatedTime = time.Unix(0, lastUpdateTime)
 			if failoverEndTime > emptyFailoverEndTime {
 				domain.FailoverEndTime = common.TimePtr(time.Unix(0, failoverEndTime))
@@ -515,6 +536,8 @@ func (db *cdb) SelectAllDomains(
 		replicationClusters = []map[string]interface{}{}
 		badBinariesData = []byte("")
 		badBinariesDataEncoding = ""
+		roleBindings = nil
+		roleBindingsEncoding = ""
 		failoverEndTime = 0
 		lastUpdateTime = 0
 		retentionDays = 0
@@ -556,7 +579,7 @@ func (db *cdb) DeleteDomain(
 	} else {
 		var ID string
 		query := db.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, *domainName).WithContext(ctx)
-		err := query.Scan(&ID, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		err := query.Scan(&ID, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		if err != nil {
 			if db.client.IsNotFoundError(err) {
 				return nil
@@ -609,3 +632,13 @@ func getIsolationGroupFields(row *nosqlplugin.DomainRow) ([]byte, string) {
 	}
 	return d, e
 }
+
+func getRoleBindingsFields(row *nosqlplugin.DomainRow) ([]byte, string) {
+	var d []byte
+	var e string
+	if row != nil && row.Config != nil && row.Config.RoleBindings != nil {
+		d = row.Config.RoleBindings.GetData()
+		e = row.Config.RoleBindings.GetEncodingString()
+	}
+	return d, e
+}
@@ -52,7 +52,8 @@ const (
 		`type: ?, ` +
 		`ack_level: ?, ` +
 		`kind: ?, ` +
-		`last_updated: ? ` +
+		`last_updated: ?, ` +
+		`adaptive_partition_config: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -61,7 +62,9 @@ const (
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
 		`created_time: ?, ` +
-		`partition_config: ? ` +
+		`partition_config: ?, ` +
+		`priority: ?, ` +
+		`type_name: ? ` +
 		`}`
 
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
@@ -174,16 +177,18 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 	ackLevel := tlDB["ack_level"].(int64)
 	taskListKind := tlDB["kind"].(int)
 	lastUpdatedTime := tlDB["last_updated"].(time.Time)
+	adaptivePartitionConfig, _ := tlDB["adaptive_partition_config"].(map[string]interface{})
 
 	return &nosqlplugin.TaskListRow{
 		DomainID:     filter.DomainID,
 		TaskListName: filter.TaskListName,
 		TaskListType: filter.TaskListType,
 
-		TaskListKind:    taskListKind,
-		LastUpdatedTime: lastUpdatedTime,
-		AckLevel:        ackLevel,
-		RangeID:         rangeID,
+		TaskListKind:            taskListKind,
+		LastUpdatedTime:         lastUpdatedTime,
+		AckLevel:                ackLevel,
+		RangeID:                 rangeID,
+		AdaptivePartitionConfig: toTaskListPartitionConfig(adaptivePartitionConfig),
 	}, nil
 }
 
@@ -203,6 +208,7 @@ func (db *cdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow)
 		0,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		fromTaskListPartitionConfig(row.AdaptivePartitionConfig),
 	).WithContext(ctx)
 
 	previous := make(map[string]interface{})
@@ -229,6 +235,7 @@ func (db *cdb) UpdateTaskList(
 		row.AckLevel,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		fromTaskListPartitionConfig(row.AdaptivePartitionConfig),
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
@@ -246,6 +253,31 @@ func (db *cdb) UpdateTaskList(
 	return handleTaskListAppliedError(applied, previous)
 }
 
+func fromTaskListPartitionConfig(config *p.TaskListPartitionConfig) interface{} {
+	if config == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"version":              config.Version,
+		"num_read_partitions":  config.NumReadPartitions,
+		"num_write_partitions": config.NumWritePartitions,
+	}
+}
+
+func toTaskListPartitionConfig(config map[string]interface{}) *p.TaskListPartitionConfig {
+	version, _ := config["version"].(int64)
+	if version == 0 {
+		return nil
+	}
+	numReadPartitions, _ := config["num_read_partitions"].(int)
+	numWritePartitions, _ := config["num_write_partitions"].(int)
+	return &p.TaskListPartitionConfig{
+		Version:            version,
+		NumReadPartitions:  numReadPartitions,
+		NumWritePartitions: numWritePartitions,
+	}
+}
+
 func handleTaskListAppliedError(applied bool, previous map[string]interface{}) error {
 	if !applied {
 		// NOTE: Cassandra only returns the conflicted columns in this results
@@ -292,6 +324,7 @@ func (db *cdb) UpdateTaskListWithTTL(
 		row.AckLevel,
 		row.TaskListKind,
 		time.Now(),
+		fromTaskListPartitionConfig(row.AdaptivePartitionConfig),
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
@@ -368,7 +401,9 @@ func (db *cdb) InsertTasks(
 				task.RunID,
 				scheduleID,
 				task.CreatedTime,
-				task.PartitionConfig)
+				task.PartitionConfig,
+				task.Priority,
+				task.TypeName)
 		} else {
 			if ttl > maxCassandraTTL {
 				ttl = maxCassandraTTL
@@ -385,6 +420,8 @@ func (db *cdb) InsertTasks(
 				scheduleID,
 				task.CreatedTime,
 				task.PartitionConfig,
+				task.Priority,
+				task.TypeName,
 				ttl)
 		}
 	}
@@ -468,6 +505,10 @@ func createTaskInfo(
 			info.CreatedTime = v.(time.Time)
 		case "partition_config":
 			info.PartitionConfig = v.(map[string]string)
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "type_name":
+			info.TypeName = v.(string)
 		}
 	}
 
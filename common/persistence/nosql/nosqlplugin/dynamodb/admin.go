@@ -22,15 +22,104 @@
 package dynamodb
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 var _ nosqlplugin.AdminDB = (*ddb)(nil)
 
+const (
+	testSchemaDir = "schema/dynamodb/"
+)
+
 func (db *ddb) SetupTestDatabase(schemaBaseDir string) error {
-	panic("TODO")
+	if schemaBaseDir == "" {
+		var err error
+		schemaBaseDir, err = nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	schemaFile := schemaBaseDir + "cadence/schema.json"
+	byteValues, err := ioutil.ReadFile(schemaFile)
+	if err != nil {
+		return err
+	}
+	var commands []map[string]json.RawMessage
+	if err := json.Unmarshal(byteValues, &commands); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	for _, cmd := range commands {
+		for name, input := range cmd {
+			if err := db.runSchemaCommand(ctx, name, input); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runSchemaCommand runs a command of the schema file, the table name of the command is prefixed with the keyspace
+func (db *ddb) runSchemaCommand(ctx context.Context, name string, input json.RawMessage) error {
+	switch name {
+	case "CreateTable":
+		var request dynamodb.CreateTableInput
+		if err := json.Unmarshal(input, &request); err != nil {
+			return err
+		}
+		request.TableName = db.tableName(aws.StringValue(request.TableName))
+		if _, err := db.client.CreateTableWithContext(ctx, &request); err != nil {
+			return err
+		}
+		return db.client.WaitUntilTableExistsWithContext(ctx, &dynamodb.DescribeTableInput{
+			TableName: request.TableName,
+		})
+	case "UpdateTimeToLive":
+		var request dynamodb.UpdateTimeToLiveInput
+		if err := json.Unmarshal(input, &request); err != nil {
+			return err
+		}
+		request.TableName = db.tableName(aws.StringValue(request.TableName))
+		_, err := db.client.UpdateTimeToLiveWithContext(ctx, &request)
+		return err
+	default:
+		return fmt.Errorf("unsupported schema command: %v", name)
+	}
 }
 
 func (db *ddb) TeardownTestDatabase() error {
-	panic("TODO")
+	if db.cfg.Keyspace == "" {
+		return fmt.Errorf("keyspace is required to find the tables to delete")
+	}
+	ctx := context.Background()
+	prefix := db.cfg.Keyspace + "_"
+	var tableNames []*string
+	err := db.client.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{},
+		func(output *dynamodb.ListTablesOutput, lastPage bool) bool {
+			for _, name := range output.TableNames {
+				if strings.HasPrefix(aws.StringValue(name), prefix) {
+					tableNames = append(tableNames, name)
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+	for _, name := range tableNames {
+		if _, err := db.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{TableName: name}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -22,15 +22,95 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
+var clusterConfigKeyNames = []string{"rowtype", "version"}
+
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	item := cadence.ClusterConfigItem{
+		RowType:              row.RowType,
+		Version:              row.Version,
+		UnixTimestampSeconds: row.Timestamp.Unix(),
+		Data:                 row.Values.Data,
+		DataEncoding:         row.Values.GetEncodingString(),
+	}
+	condition := expression.AttributeNotExists(expression.Name("version"))
+	err := db.putItem(ctx, cadence.ClusterConfigTableName, &item, &condition)
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	keyCondition := expression.Key("rowtype").Equal(expression.Value(rowType))
+	input, err := db.newQuery(cadence.ClusterConfigTableName, keyCondition, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	input.ScanIndexForward = aws.Bool(false)
+	input.Limit = aws.Int64(1)
+	output, err := db.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Items) == 0 {
+		return nil, errItemNotFound
+	}
+	var result cadence.ClusterConfigItem
+	if err := dynamodbattribute.UnmarshalMap(output.Items[0], &result); err != nil {
+		return nil, err
+	}
+	return &persistence.InternalConfigStoreEntry{
+		RowType:   rowType,
+		Version:   result.Version,
+		Timestamp: time.Unix(result.UnixTimestampSeconds, 0),
+		Values:    persistence.NewDataBlob(result.Data, common.EncodingType(result.DataEncoding)),
+	}, nil
+}
+
+func (db *ddb) SelectLatestConfigs(ctx context.Context, rowType int, limit int) ([]*persistence.InternalConfigStoreEntry, error) {
+	keyCondition := expression.Key("rowtype").Equal(expression.Value(rowType))
+	input, err := db.newQuery(cadence.ClusterConfigTableName, keyCondition, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	input.ScanIndexForward = aws.Bool(false)
+	input.Limit = aws.Int64(int64(limit))
+	output, err := db.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*persistence.InternalConfigStoreEntry, 0, len(output.Items))
+	for _, item := range output.Items {
+		var result cadence.ClusterConfigItem
+		if err := dynamodbattribute.UnmarshalMap(item, &result); err != nil {
+			return nil, err
+		}
+		rows = append(rows, &persistence.InternalConfigStoreEntry{
+			RowType:   rowType,
+			Version:   result.Version,
+			Timestamp: time.Unix(result.UnixTimestampSeconds, 0),
+			Values:    persistence.NewDataBlob(result.Data, common.EncodingType(result.DataEncoding)),
+		})
+	}
+	return rows, nil
+}
+
+func (db *ddb) DeleteConfigsBefore(ctx context.Context, rowType int, version int64) error {
+	keyCondition := expression.Key("rowtype").Equal(expression.Value(rowType)).And(
+		expression.Key("version").LessThan(expression.Value(version)),
+	)
+	_, err := db.rangeDeleteItems(ctx, cadence.ClusterConfigTableName, keyCondition, clusterConfigKeyNames, 0)
+	return err
 }
@@ -21,58 +21,566 @@
 package dynamodb
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 const (
-	// PluginName is the name of the plugin
-	PluginName = "dynamodb"
+	// maxTransactionItems is the max number of items that can be written by a single TransactWriteItems request
+	maxTransactionItems = 100
+	// maxBatchWriteItems is the max number of items that can be written by a single BatchWriteItem request
+	maxBatchWriteItems = 25
+
+	conditionalCheckFailedReason = "ConditionalCheckFailed"
 )
 
 var (
 	errConditionFailed = errors.New("internal condition fail error")
+	errItemNotFound    = errors.New("item not found")
+
+	// itemEncoder keeps empty maps and lists, so that the entries of them can be updated later.
+	// The default encoder of the SDK converts them to NULL.
+	itemEncoder = dynamodbattribute.NewEncoder(func(e *dynamodbattribute.Encoder) {
+		e.EnableEmptyCollections = true
+	})
 )
 
 // ddb represents a logical connection to DynamoDB database
 type ddb struct {
+	client *dynamodb.DynamoDB
+	cfg    *config.NoSQL
+	logger log.Logger
 }
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
-// NewDynamoDB return a new DB
-func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
-}
-
 func (db *ddb) Close() {
-	panic("TODO")
+	// the HTTP client of the AWS session doesn't need to be closed
 }
 
 func (db *ddb) PluginName() string {
 	return PluginName
 }
 
-func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
+// tableName returns the name of a table prefixed with the keyspace, so that multiple clusters can share the same region
+func (db *ddb) tableName(name string) *string {
+	if db.cfg.Keyspace == "" {
+		return aws.String(name)
+	}
+	return aws.String(db.cfg.Keyspace + "_" + name)
+}
+
+// getItem reads an item with strong consistency and decodes it into out, it returns errItemNotFound if the item doesn't exist
+func (db *ddb) getItem(ctx context.Context, table string, key map[string]*dynamodb.AttributeValue, out interface{}) error {
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      db.tableName(table),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return err
+	}
+	if output.Item == nil {
+		return errItemNotFound
+	}
+	return dynamodbattribute.UnmarshalMap(output.Item, out)
+}
+
+// putItem writes an item if the condition(optional) is met, it returns errConditionFailed if the condition is not met
+func (db *ddb) putItem(ctx context.Context, table string, item interface{}, condition *expression.ConditionBuilder) error {
+	input, err := db.newPut(table, item, condition)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 input.TableName,
+		Item:                      input.Item,
+		ConditionExpression:       input.ConditionExpression,
+		ExpressionAttributeNames:  input.ExpressionAttributeNames,
+		ExpressionAttributeValues: input.ExpressionAttributeValues,
+	})
+	if isConditionalCheckFailed(err) {
+		return errConditionFailed
+	}
+	return err
+}
+
+// updateItem updates an item if the condition(optional) is met, it returns errConditionFailed if the condition is not met
+// NOTE: the item is created if it doesn't exist and there is no condition
+func (db *ddb) updateItem(
+	ctx context.Context,
+	table string,
+	key map[string]*dynamodb.AttributeValue,
+	update expression.UpdateBuilder,
+	condition *expression.ConditionBuilder,
+) error {
+	input, err := db.newUpdate(table, key, update, condition)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 input.TableName,
+		Key:                       input.Key,
+		UpdateExpression:          input.UpdateExpression,
+		ConditionExpression:       input.ConditionExpression,
+		ExpressionAttributeNames:  input.ExpressionAttributeNames,
+		ExpressionAttributeValues: input.ExpressionAttributeValues,
+	})
+	if isConditionalCheckFailed(err) {
+		return errConditionFailed
+	}
+	return err
+}
+
+// deleteItem deletes an item if the condition(optional) is met, it returns errConditionFailed if the condition is not met
+func (db *ddb) deleteItem(
+	ctx context.Context,
+	table string,
+	key map[string]*dynamodb.AttributeValue,
+	condition *expression.ConditionBuilder,
+) error {
+	input, err := db.newDelete(table, key, condition)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 input.TableName,
+		Key:                       input.Key,
+		ConditionExpression:       input.ConditionExpression,
+		ExpressionAttributeNames:  input.ExpressionAttributeNames,
+		ExpressionAttributeValues: input.ExpressionAttributeValues,
+	})
+	if isConditionalCheckFailed(err) {
+		return errConditionFailed
+	}
+	return err
+}
+
+// newPut builds the put operation of an item, which is also used in transactions
+func (db *ddb) newPut(table string, item interface{}, condition *expression.ConditionBuilder) (*dynamodb.Put, error) {
+	av, err := encodeValue(item)
+	if err != nil {
+		return nil, err
+	}
+	put := &dynamodb.Put{
+		TableName: db.tableName(table),
+		Item:      av.M,
+	}
+	if condition != nil {
+		expr, err := expression.NewBuilder().WithCondition(*condition).Build()
+		if err != nil {
+			return nil, err
+		}
+		put.ConditionExpression = expr.Condition()
+		put.ExpressionAttributeNames = expr.Names()
+		put.ExpressionAttributeValues = expr.Values()
+		put.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+	}
+	return put, nil
+}
+
+// newUpdate builds the update operation of an item, which is also used in transactions
+func (db *ddb) newUpdate(
+	table string,
+	key map[string]*dynamodb.AttributeValue,
+	update expression.UpdateBuilder,
+	condition *expression.ConditionBuilder,
+) (*dynamodb.Update, error) {
+	builder := expression.NewBuilder().WithUpdate(update)
+	if condition != nil {
+		builder = builder.WithCondition(*condition)
+	}
+	expr, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	result := &dynamodb.Update{
+		TableName:                 db.tableName(table),
+		Key:                       key,
+		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+	if condition != nil {
+		result.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+	}
+	return result, nil
+}
+
+// newDelete builds the delete operation of an item, which is also used in transactions
+func (db *ddb) newDelete(
+	table string,
+	key map[string]*dynamodb.AttributeValue,
+	condition *expression.ConditionBuilder,
+) (*dynamodb.Delete, error) {
+	result := &dynamodb.Delete{
+		TableName: db.tableName(table),
+		Key:       key,
+	}
+	if condition != nil {
+		expr, err := expression.NewBuilder().WithCondition(*condition).Build()
+		if err != nil {
+			return nil, err
+		}
+		result.ConditionExpression = expr.Condition()
+		result.ExpressionAttributeNames = expr.Names()
+		result.ExpressionAttributeValues = expr.Values()
+		result.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+	}
+	return result, nil
+}
+
+// newConditionCheck builds a condition check of an item in a transaction
+func (db *ddb) newConditionCheck(
+	table string,
+	key map[string]*dynamodb.AttributeValue,
+	condition expression.ConditionBuilder,
+) (*dynamodb.ConditionCheck, error) {
+	expr, err := expression.NewBuilder().WithCondition(condition).Build()
+	if err != nil {
+		return nil, err
+	}
+	return &dynamodb.ConditionCheck{
+		TableName:                           db.tableName(table),
+		Key:                                 key,
+		ConditionExpression:                 expr.Condition(),
+		ExpressionAttributeNames:            expr.Names(),
+		ExpressionAttributeValues:           expr.Values(),
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	}, nil
+}
+
+// conditionFailureHandler converts the failed condition of an item in a transaction to an error,
+// old is the item before the transaction, or nil if the item didn't exist
+type conditionFailureHandler func(old map[string]*dynamodb.AttributeValue) error
+
+// transaction is a list of items to be written by TransactWriteItems, the LWT equivalent of a Cassandra batch.
+// Each item has an optional handler for its condition failure. If multiple conditions fail,
+// the error is returned by the handler of the first failed item, so the items should be added by the priority of their conditions.
+type transaction struct {
+	items    []*dynamodb.TransactWriteItem
+	handlers []conditionFailureHandler
+}
+
+func (t *transaction) put(put *dynamodb.Put, handler conditionFailureHandler) {
+	t.items = append(t.items, &dynamodb.TransactWriteItem{Put: put})
+	t.handlers = append(t.handlers, handler)
+}
+
+func (t *transaction) update(update *dynamodb.Update, handler conditionFailureHandler) {
+	t.items = append(t.items, &dynamodb.TransactWriteItem{Update: update})
+	t.handlers = append(t.handlers, handler)
+}
+
+func (t *transaction) delete(del *dynamodb.Delete, handler conditionFailureHandler) {
+	t.items = append(t.items, &dynamodb.TransactWriteItem{Delete: del})
+	t.handlers = append(t.handlers, handler)
+}
+
+func (t *transaction) conditionCheck(check *dynamodb.ConditionCheck, handler conditionFailureHandler) {
+	t.items = append(t.items, &dynamodb.TransactWriteItem{ConditionCheck: check})
+	t.handlers = append(t.handlers, handler)
+}
+
+// executeTransaction writes all the items of the transaction atomically
+// NOTE: DynamoDB rejects a transaction with more than maxTransactionItems items, or with multiple operations on the same item.
+func (db *ddb) executeTransaction(ctx context.Context, t *transaction) error {
+	if len(t.items) == 0 {
+		return nil
+	}
+	if len(t.items) > maxTransactionItems {
+		return fmt.Errorf("transaction has %v items, which exceeds the limit of %v items", len(t.items), maxTransactionItems)
+	}
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: t.items,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var canceled *dynamodb.TransactionCanceledException
+	if !errors.As(err, &canceled) {
+		return err
+	}
+	for i, reason := range canceled.CancellationReasons {
+		if i >= len(t.handlers) || reason == nil || aws.StringValue(reason.Code) != conditionalCheckFailedReason {
+			continue
+		}
+		if t.handlers[i] != nil {
+			return t.handlers[i](reason.Item)
+		}
+		return errConditionFailed
+	}
+	return err
+}
+
+// queryPage runs the query until pageSize items are read or there are no more items, so that a page is not cut short
+// by the filter expression. It returns all the items if pageSize is not positive.
+// It returns the items and the LastEvaluatedKey of the page, which is empty if this is the last page.
+func (db *ddb) queryPage(
+	ctx context.Context,
+	input *dynamodb.QueryInput,
+	pageSize int,
+) ([]map[string]*dynamodb.AttributeValue, map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		output, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, output.Items...)
+		if len(output.LastEvaluatedKey) == 0 || (pageSize > 0 && len(items) >= pageSize) {
+			return items, output.LastEvaluatedKey, nil
+		}
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+// newQuery builds a strongly consistent query on a table
+func (db *ddb) newQuery(
+	table string,
+	keyCondition expression.KeyConditionBuilder,
+	filter *expression.ConditionBuilder,
+	projection *expression.ProjectionBuilder,
+	pageToken []byte,
+) (*dynamodb.QueryInput, error) {
+	builder := expression.NewBuilder().WithKeyCondition(keyCondition)
+	if filter != nil {
+		builder = builder.WithFilter(*filter)
+	}
+	if projection != nil {
+		builder = builder.WithProjection(*projection)
+	}
+	expr, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	startKey, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &dynamodb.QueryInput{
+		TableName:                 db.tableName(table),
+		KeyConditionExpression:    expr.KeyCondition(),
+		FilterExpression:          expr.Filter(),
+		ProjectionExpression:      expr.Projection(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ExclusiveStartKey:         startKey,
+		ConsistentRead:            aws.Bool(true),
+	}, nil
+}
+
+// queryPageInto runs the query by queryPage, decodes the items into out(a pointer to a slice), and returns the next page token
+func (db *ddb) queryPageInto(ctx context.Context, input *dynamodb.QueryInput, pageSize int, out interface{}) ([]byte, error) {
+	items, lastEvaluatedKey, err := db.queryPage(ctx, input, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := dynamodbattribute.UnmarshalListOfMaps(items, out); err != nil {
+		return nil, err
+	}
+	return encodePageToken(lastEvaluatedKey)
+}
+
+// scanPageInto reads a page of all the items of a table, decodes the items into out(a pointer to a slice), and returns the next page token
+func (db *ddb) scanPageInto(ctx context.Context, table string, pageSize int, pageToken []byte, out interface{}) ([]byte, error) {
+	startKey, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	input := &dynamodb.ScanInput{
+		TableName:         db.tableName(table),
+		ExclusiveStartKey: startKey,
+		ConsistentRead:    aws.Bool(true),
+	}
+	if pageSize > 0 {
+		input.Limit = aws.Int64(int64(pageSize))
+	}
+	output, err := db.client.ScanWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := dynamodbattribute.UnmarshalListOfMaps(output.Items, out); err != nil {
+		return nil, err
+	}
+	return encodePageToken(output.LastEvaluatedKey)
+}
+
+// countItems returns the number of items matching the key condition
+func (db *ddb) countItems(ctx context.Context, table string, keyCondition expression.KeyConditionBuilder) (int64, error) {
+	input, err := db.newQuery(table, keyCondition, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	input.Select = aws.String(dynamodb.SelectCount)
+	var count int64
+	for {
+		output, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return 0, err
+		}
+		count += aws.Int64Value(output.Count)
+		if len(output.LastEvaluatedKey) == 0 {
+			return count, nil
+		}
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+// rangeDeleteItems deletes the items matching the key condition, as DynamoDB doesn't support range deletion.
+// keyNames are the names of the key attributes of the table. It returns the number of items deleted.
+// If batchSize is positive, at most batchSize items are deleted.
+// NOTE: this is not atomic, the deletion must be idempotent for the callers to retry
+func (db *ddb) rangeDeleteItems(
+	ctx context.Context,
+	table string,
+	keyCondition expression.KeyConditionBuilder,
+	keyNames []string,
+	batchSize int,
+) (int, error) {
+	projection := expression.NamesList(expression.Name(keyNames[0]))
+	for _, name := range keyNames[1:] {
+		projection = projection.AddNames(expression.Name(name))
+	}
+	input, err := db.newQuery(table, keyCondition, nil, &projection, nil)
+	if err != nil {
+		return 0, err
+	}
+	keys, _, err := db.queryPage(ctx, input, batchSize)
+	if err != nil {
+		return 0, err
+	}
+	if err := db.batchDeleteItems(ctx, table, keys); err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+// batchDeleteItems deletes the items by BatchWriteItem, and retries the unprocessed items
+func (db *ddb) batchDeleteItems(ctx context.Context, table string, keys []map[string]*dynamodb.AttributeValue) error {
+	tableName := aws.StringValue(db.tableName(table))
+	for start := 0; start < len(keys); start += maxBatchWriteItems {
+		end := start + maxBatchWriteItems
+		if end > len(keys) {
+			end = len(keys)
+		}
+		requests := make([]*dynamodb.WriteRequest, 0, end-start)
+		for _, key := range keys[start:end] {
+			requests = append(requests, &dynamodb.WriteRequest{
+				DeleteRequest: &dynamodb.DeleteRequest{Key: key},
+			})
+		}
+		unprocessed := map[string][]*dynamodb.WriteRequest{tableName: requests}
+		for attempt := 0; len(unprocessed) > 0; attempt++ {
+			if attempt > 0 {
+				// back off a bit as the unprocessed items are usually caused by throttling
+				time.Sleep(time.Duration(attempt) * 10 * time.Millisecond)
+			}
+			output, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: unprocessed,
+			})
+			if err != nil {
+				return err
+			}
+			unprocessed = output.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+func isConditionalCheckFailed(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+}
+
+// encodeData encodes the non-significant fields of an item into a data blob
+func encodeData(value interface{}) ([]byte, string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, string(common.EncodingTypeJSON), nil
+}
+
+// decodeData decodes a data blob written by encodeData
+func decodeData(data []byte, encoding string, value interface{}) error {
+	if common.EncodingType(encoding) != common.EncodingTypeJSON {
+		return fmt.Errorf("unsupported data encoding: %v", encoding)
+	}
+	return json.Unmarshal(data, value)
+}
+
+// encodePageToken encodes the LastEvaluatedKey of a query into a page token, it returns nil if this is the last page
+func encodePageToken(lastEvaluatedKey map[string]*dynamodb.AttributeValue) ([]byte, error) {
+	if len(lastEvaluatedKey) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(lastEvaluatedKey)
+}
+
+// decodePageToken decodes a token written by encodePageToken into the ExclusiveStartKey of a query
+func decodePageToken(pageToken []byte) (map[string]*dynamodb.AttributeValue, error) {
+	if len(pageToken) == 0 {
+		return nil, nil
+	}
+	var key map[string]*dynamodb.AttributeValue
+	if err := json.Unmarshal(pageToken, &key); err != nil {
+		return nil, fmt.Errorf("invalid page token: %v", err)
+	}
+	return key, nil
+}
+
+// encodeValue encodes a value by itemEncoder, which is used for the items and the values of the update expressions
+func encodeValue(in interface{}) (*dynamodb.AttributeValue, error) {
+	av, err := itemEncoder.Encode(in)
+	if err != nil {
+		return nil, err
+	}
+	return av, nil
+}
+
+func numberValue(value int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(value, 10))}
 }
 
-func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
+func stringValue(value string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{S: aws.String(value)}
 }
 
-func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
+// encodeSortKey encodes an int64 into a fixed length string, whose lexicographical order is the same as the numeric order
+func encodeSortKey(value int64) string {
+	return fmt.Sprintf("%020d", uint64(value)^(1<<63))
 }
 
-func (db *ddb) IsDBUnavailableError(err error) bool {
-	panic("TODO")
+// encodeDescendingSortKey is the same as encodeSortKey, but with the reversed order
+func encodeDescendingSortKey(value int64) string {
+	return fmt.Sprintf("%020d", ^(uint64(value) ^ (1 << 63)))
 }
 
-func (db *ddb) IsConditionFailedError(err error) bool {
-	return err == errConditionFailed
+// getExpireAt returns the TTL attribute value in unix seconds, or 0 if there is no TTL
+func getExpireAt(ttlSeconds int64) int64 {
+	if ttlSeconds <= 0 {
+		return 0
+	}
+	return time.Now().Unix() + ttlSeconds
 }
@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSortKey(t *testing.T) {
+	values := []int64{math.MinInt64, -1000, -1, 0, 1, 1000, math.MaxInt64}
+	ascending := make([]string, 0, len(values))
+	descending := make([]string, 0, len(values))
+	for _, value := range values {
+		ascending = append(ascending, encodeSortKey(value))
+		descending = append(descending, encodeDescendingSortKey(value))
+		assert.Len(t, ascending[len(ascending)-1], 20)
+		assert.Len(t, descending[len(descending)-1], 20)
+	}
+	assert.True(t, sort.StringsAreSorted(ascending))
+	sort.Sort(sort.Reverse(sort.StringSlice(descending)))
+	for i, value := range values {
+		assert.Equal(t, encodeDescendingSortKey(value), descending[i])
+	}
+}
+
+func TestEncodeMapKey(t *testing.T) {
+	for _, key := range []string{"", "timer.1", "a[0]", "#key#"} {
+		encoded := encodeMapKey(key)
+		assert.NotContains(t, encoded, ".")
+		assert.NotContains(t, encoded, "[")
+		decoded, err := decodeMapKey(encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, key, decoded)
+	}
+
+	decoded, err := decodeInt64MapKey(encodeInt64MapKey(-123))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-123), decoded)
+}
+
+func TestPageToken(t *testing.T) {
+	token, err := encodePageToken(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, token)
+
+	key := shardTaskKey(1, 2)
+	token, err = encodePageToken(key)
+	assert.NoError(t, err)
+	decoded, err := decodePageToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, key, decoded)
+
+	_, err = decodePageToken([]byte("invalid"))
+	assert.Error(t, err)
+}
@@ -22,17 +22,68 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
+// domainMetadataItemID is the id of the single item in domain_metadata table
+const domainMetadataItemID = 1
+
+var errDomainConditionFailed = nosqlplugin.NewConditionFailure("domain")
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	item, err := newDomainItem(row, metadataNotificationVersion)
+	if err != nil {
+		return err
+	}
+
+	txn := &transaction{}
+	nameCondition := expression.AttributeNotExists(expression.Name("name"))
+	put, err := db.newPut(cadence.DomainTableName, item, &nameCondition)
+	if err != nil {
+		return err
+	}
+	txn.put(put, func(old map[string]*dynamodb.AttributeValue) error {
+		existing := &cadence.DomainItem{}
+		if err := dynamodbattribute.UnmarshalMap(old, existing); err != nil {
+			return err
+		}
+		return &types.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain %v already exists", existing.DomainID),
+		}
+	})
+
+	idCondition := expression.AttributeNotExists(expression.Name("domainid"))
+	put, err = db.newPut(cadence.DomainByIDTableName, &cadence.DomainByIDItem{
+		DomainID: item.DomainID,
+		Name:     item.Name,
+	}, &idCondition)
+	if err != nil {
+		return err
+	}
+	txn.put(put, domainConditionFailureHandler)
+
+	if err := db.updateDomainMetadata(txn, metadataNotificationVersion); err != nil {
+		return err
+	}
+	return db.executeTransaction(ctx, txn)
 }
 
 // Update domain
@@ -40,7 +91,22 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	item, err := newDomainItem(row, row.NotificationVersion)
+	if err != nil {
+		return err
+	}
+
+	txn := &transaction{}
+	condition := expression.AttributeExists(expression.Name("name"))
+	put, err := db.newPut(cadence.DomainTableName, item, &condition)
+	if err != nil {
+		return err
+	}
+	txn.put(put, domainConditionFailureHandler)
+	if err := db.updateDomainMetadata(txn, row.NotificationVersion); err != nil {
+		return err
+	}
+	return db.executeTransaction(ctx, txn)
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +115,30 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	name := domainName
+	if domainID != nil {
+		var byID cadence.DomainByIDItem
+		if err := db.getItem(ctx, cadence.DomainByIDTableName, domainIDKey(*domainID), &byID); err != nil {
+			return nil, err
+		}
+		name = &byID.Name
+	}
+
+	var item cadence.DomainItem
+	if err := db.getItem(ctx, cadence.DomainTableName, domainNameKey(*name), &item); err != nil {
+		return nil, err
+	}
+	if domainID != nil && item.DomainID != *domainID {
+		// the name has been taken by another domain after the domain was deleted
+		return nil, errItemNotFound
+	}
+	return convertToDomainRow(&item)
 }
 
 // Get all domain data
@@ -58,7 +147,21 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	var items []*cadence.DomainItem
+	nextPageToken, err := db.scanPageInto(ctx, cadence.DomainTableName, pageSize, pageToken, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*nosqlplugin.DomainRow
+	for _, item := range items {
+		row, err := convertToDomainRow(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +170,131 @@ func (db *ddb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	if domainName == nil {
+		var byID cadence.DomainByIDItem
+		err := db.getItem(ctx, cadence.DomainByIDTableName, domainIDKey(*domainID), &byID)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		domainName = &byID.Name
+	}
+	if domainID == nil {
+		var item cadence.DomainItem
+		err := db.getItem(ctx, cadence.DomainTableName, domainNameKey(*domainName), &item)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		domainID = &item.DomainID
+	}
+
+	txn := &transaction{}
+	del, err := db.newDelete(cadence.DomainByIDTableName, domainIDKey(*domainID), nil)
+	if err != nil {
+		return err
+	}
+	txn.delete(del, nil)
+	del, err = db.newDelete(cadence.DomainTableName, domainNameKey(*domainName), nil)
+	if err != nil {
+		return err
+	}
+	txn.delete(del, nil)
+	return db.executeTransaction(ctx, txn)
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	var item cadence.DomainMetadataItem
+	err := db.getItem(ctx, cadence.DomainMetadataTableName, domainMetadataKey(), &item)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata item is created along with the first domain
+			return 0, nil
+		}
+		return -1, err
+	}
+	return item.NotificationVersion, nil
+}
+
+// updateDomainMetadata adds the update to the transaction, which bumps the notification version of domain metadata
+// if it still equals to the given notification version
+func (db *ddb) updateDomainMetadata(
+	txn *transaction,
+	notificationVersion int64,
+) error {
+	condition := expression.Name("notificationversion").Equal(expression.Value(notificationVersion))
+	if notificationVersion == 0 {
+		// the metadata item doesn't exist before the first domain is created
+		condition = expression.AttributeNotExists(expression.Name("notificationversion")).Or(condition)
+	}
+	update := expression.Set(expression.Name("notificationversion"), expression.Value(notificationVersion+1))
+	result, err := db.newUpdate(cadence.DomainMetadataTableName, domainMetadataKey(), update, &condition)
+	if err != nil {
+		return err
+	}
+	txn.update(result, domainConditionFailureHandler)
+	return nil
+}
+
+func domainConditionFailureHandler(map[string]*dynamodb.AttributeValue) error {
+	return errDomainConditionFailed
+}
+
+func domainNameKey(name string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"name": stringValue(name),
+	}
+}
+
+func domainIDKey(domainID string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"domainid": stringValue(domainID),
+	}
+}
+
+func domainMetadataKey() map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"id": numberValue(domainMetadataItemID),
+	}
+}
+
+func newDomainItem(
+	row *nosqlplugin.DomainRow,
+	notificationVersion int64,
+) (*cadence.DomainItem, error) {
+	if row.Info == nil {
+		return nil, errors.New("domain info must be provided")
+	}
+	data, encoding, err := encodeData(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.DomainItem{
+		Name:                row.Info.Name,
+		DomainID:            row.Info.ID,
+		NotificationVersion: notificationVersion,
+		Data:                data,
+		DataEncoding:        encoding,
+	}, nil
+}
+
+func convertToDomainRow(
+	item *cadence.DomainItem,
+) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := decodeData(item.Data, item.DataEncoding, row); err != nil {
+		return nil, err
+	}
+	row.NotificationVersion = item.NotificationVersion
+	return row, nil
 }
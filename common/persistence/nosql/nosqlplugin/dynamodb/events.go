@@ -22,31 +22,205 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
+var historyNodeKeyNames = []string{"branchkey", "nodekey"}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *ddb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	if treeRow == nil {
+		return db.putItem(ctx, cadence.HistoryNodeTableName, newHistoryNodeItem(nodeRow), nil)
+	}
+	if nodeRow == nil {
+		return db.putItem(ctx, cadence.HistoryTreeTableName, newHistoryTreeItem(treeRow), nil)
+	}
+
+	txn := &transaction{}
+	put, err := db.newPut(cadence.HistoryTreeTableName, newHistoryTreeItem(treeRow), nil)
+	if err != nil {
+		return err
+	}
+	txn.put(put, nil)
+	put, err = db.newPut(cadence.HistoryNodeTableName, newHistoryNodeItem(nodeRow), nil)
+	if err != nil {
+		return err
+	}
+	txn.put(put, nil)
+	return db.executeTransaction(ctx, txn)
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *ddb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	if filter.MinNodeID >= filter.MaxNodeID {
+		return nil, nil, nil
+	}
+	// nodes are ordered by nodeid ascending and then txnid descending
+	keyCondition := historyBranchKeyCondition(filter.TreeID, filter.BranchID).And(
+		expression.Key("nodekey").Between(
+			expression.Value(encodeSortKey(filter.MinNodeID)),
+			// "~" is greater than the separator of the node key, so it covers all txnIDs of the node
+			expression.Value(encodeSortKey(filter.MaxNodeID-1)+"~"),
+		),
+	)
+	input, err := db.newQuery(cadence.HistoryNodeTableName, keyCondition, nil, nil, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var items []*cadence.HistoryNodeItem
+	nextPageToken, err := db.queryPageInto(ctx, input, filter.PageSize, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryNodeRow
+	for _, item := range items {
+		txnID := item.TxnID
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			ShardID:      item.ShardID,
+			TreeID:       item.TreeID,
+			BranchID:     item.BranchID,
+			NodeID:       item.NodeID,
+			TxnID:        &txnID,
+			Data:         item.Data,
+			DataEncoding: item.DataEncoding,
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
+// NOTE: the nodes are deleted before the branch record, so that a failed deletion can be retried
 func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	if treeFilter.BranchID == nil {
+		return fmt.Errorf("require a branchID to delete a history branch")
+	}
+	for _, nodeFilter := range nodeFilters {
+		keyCondition := historyBranchKeyCondition(nodeFilter.TreeID, nodeFilter.BranchID).And(
+			expression.Key("nodekey").GreaterThanEqual(expression.Value(encodeSortKey(nodeFilter.MinNodeID))),
+		)
+		if _, err := db.rangeDeleteItems(ctx, cadence.HistoryNodeTableName, keyCondition, historyNodeKeyNames, 0); err != nil {
+			return err
+		}
+	}
+	return db.deleteItem(ctx, cadence.HistoryTreeTableName, map[string]*dynamodb.AttributeValue{
+		"treeid":   stringValue(treeFilter.TreeID),
+		"branchid": stringValue(*treeFilter.BranchID),
+	}, nil)
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	var items []*cadence.HistoryTreeItem
+	pagingToken, err := db.scanPageInto(ctx, cadence.HistoryTreeTableName, pageSize, nextPageToken, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryTreeRow
+	for _, item := range items {
+		rows = append(rows, convertToHistoryTreeRow(item))
+	}
+	return rows, pagingToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *ddb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	keyCondition := expression.Key("treeid").Equal(expression.Value(filter.TreeID))
+	if filter.BranchID != nil {
+		keyCondition = keyCondition.And(expression.Key("branchid").Equal(expression.Value(*filter.BranchID)))
+	}
+	input, err := db.newQuery(cadence.HistoryTreeTableName, keyCondition, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var items []*cadence.HistoryTreeItem
+	if _, err := db.queryPageInto(ctx, input, 0, &items); err != nil {
+		return nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryTreeRow
+	for _, item := range items {
+		rows = append(rows, convertToHistoryTreeRow(item))
+	}
+	return rows, nil
+}
+
+func historyBranchKeyCondition(treeID, branchID string) expression.KeyConditionBuilder {
+	return expression.Key("branchkey").Equal(expression.Value(treeID + "#" + branchID))
+}
+
+func newHistoryTreeItem(row *nosqlplugin.HistoryTreeRow) *cadence.HistoryTreeItem {
+	ancestors := make([]cadence.HistoryBranchRangeItem, 0, len(row.Ancestors))
+	for _, an := range row.Ancestors {
+		ancestors = append(ancestors, cadence.HistoryBranchRangeItem{
+			BranchID:  an.BranchID,
+			EndNodeID: an.EndNodeID,
+		})
+	}
+	return &cadence.HistoryTreeItem{
+		TreeID:          row.TreeID,
+		BranchID:        row.BranchID,
+		ShardID:         row.ShardID,
+		Ancestors:       ancestors,
+		CreateTimestamp: row.CreateTimestamp.UnixNano(),
+		Info:            row.Info,
+	}
+}
+
+func newHistoryNodeItem(row *nosqlplugin.HistoryNodeRow) *cadence.HistoryNodeItem {
+	var txnID int64
+	if row.TxnID != nil {
+		txnID = *row.TxnID
+	}
+	return &cadence.HistoryNodeItem{
+		BranchKey:    row.TreeID + "#" + row.BranchID,
+		NodeKey:      encodeSortKey(row.NodeID) + "#" + encodeDescendingSortKey(txnID),
+		ShardID:      row.ShardID,
+		TreeID:       row.TreeID,
+		BranchID:     row.BranchID,
+		NodeID:       row.NodeID,
+		TxnID:        txnID,
+		Data:         row.Data,
+		DataEncoding: row.DataEncoding,
+	}
+}
+
+func convertToHistoryTreeRow(item *cadence.HistoryTreeItem) *nosqlplugin.HistoryTreeRow {
+	ancestors := make([]*types.HistoryBranchRange, 0, len(item.Ancestors))
+	for _, an := range item.Ancestors {
+		ancestors = append(ancestors, &types.HistoryBranchRange{
+			BranchID:  an.BranchID,
+			EndNodeID: an.EndNodeID,
+		})
+	}
+	if len(ancestors) > 0 {
+		// sort ancestors based on EndNodeID so that we can set BeginNodeID
+		sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+		ancestors[0].BeginNodeID = int64(1)
+		for i := 1; i < len(ancestors); i++ {
+			ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+		}
+	}
+	return &nosqlplugin.HistoryTreeRow{
+		ShardID:         item.ShardID,
+		TreeID:          item.TreeID,
+		BranchID:        item.BranchID,
+		Ancestors:       ancestors,
+		CreateTimestamp: time.Unix(0, item.CreateTimestamp),
+		Info:            item.Info,
+	}
 }
@@ -23,17 +23,34 @@ package dynamodb
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
+var queueMessageKeyNames = []string{"queuetype", "messageid"}
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	condition := expression.AttributeNotExists(expression.Name("messageid"))
+	err := db.putItem(ctx, cadence.QueueMessageTableName, &cadence.QueueMessageItem{
+		QueueType: int(row.QueueType),
+		MessageID: row.ID,
+		Payload:   row.Payload,
+	}, &condition)
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +58,24 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	input, err := db.newQuery(cadence.QueueMessageTableName, queueTypeKeyCondition(queueType), nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	input.ScanIndexForward = aws.Bool(false)
+	input.Limit = aws.Int64(1)
+	output, err := db.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	if len(output.Items) == 0 {
+		return 0, errItemNotFound
+	}
+	var item cadence.QueueMessageItem
+	if err := dynamodbattribute.UnmarshalMap(output.Items[0], &item); err != nil {
+		return 0, err
+	}
+	return item.MessageID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +85,26 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	keyCondition := queueTypeKeyCondition(queueType).And(
+		expression.Key("messageid").GreaterThan(expression.Value(exclusiveBeginMessageID)),
+	)
+	input, err := db.newQuery(cadence.QueueMessageTableName, keyCondition, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var items []*cadence.QueueMessageItem
+	if _, err := db.queryPageInto(ctx, input, maxRows, &items); err != nil {
+		return nil, err
+	}
+	var result []*nosqlplugin.QueueMessageRow
+	for _, item := range items {
+		result = append(result, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        item.MessageID,
+			Payload:   item.Payload,
+		})
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +112,33 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	response := &nosqlplugin.SelectMessagesBetweenResponse{}
+	if request.ExclusiveBeginMessageID >= request.InclusiveEndMessageID {
+		return response, nil
+	}
+	keyCondition := queueTypeKeyCondition(request.QueueType).And(
+		expression.Key("messageid").Between(
+			expression.Value(request.ExclusiveBeginMessageID+1),
+			expression.Value(request.InclusiveEndMessageID),
+		),
+	)
+	input, err := db.newQuery(cadence.QueueMessageTableName, keyCondition, nil, nil, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	var items []*cadence.QueueMessageItem
+	response.NextPageToken, err = db.queryPageInto(ctx, input, request.PageSize, &items)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		response.Rows = append(response.Rows, nosqlplugin.QueueMessageRow{
+			QueueType: request.QueueType,
+			ID:        item.MessageID,
+			Payload:   item.Payload,
+		})
+	}
+	return response, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +147,11 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	keyCondition := queueTypeKeyCondition(queueType).And(
+		expression.Key("messageid").LessThan(expression.Value(exclusiveBeginMessageID)),
+	)
+	_, err := db.rangeDeleteItems(ctx, cadence.QueueMessageTableName, keyCondition, queueMessageKeyNames, 0)
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +161,17 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID >= inclusiveEndMessageID {
+		return nil
+	}
+	keyCondition := queueTypeKeyCondition(queueType).And(
+		expression.Key("messageid").Between(
+			expression.Value(exclusiveBeginMessageID+1),
+			expression.Value(inclusiveEndMessageID),
+		),
+	)
+	_, err := db.rangeDeleteItems(ctx, cadence.QueueMessageTableName, keyCondition, queueMessageKeyNames, 0)
+	return err
 }
 
 // Delete one message
@@ -87,7 +180,10 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteItem(ctx, cadence.QueueMessageTableName, map[string]*dynamodb.AttributeValue{
+		"queuetype": numberValue(int64(queueType)),
+		"messageid": numberValue(messageID),
+	}, nil)
 }
 
 // Insert an empty metadata row, starting from a version
@@ -96,7 +192,17 @@ func (db *ddb) InsertQueueMetadata(
 	queueType persistence.QueueType,
 	version int64,
 ) error {
-	panic("TODO")
+	condition := expression.AttributeNotExists(expression.Name("queuetype"))
+	err := db.putItem(ctx, cadence.QueueMetadataTableName, &cadence.QueueMetadataItem{
+		QueueType:        int(queueType),
+		ClusterAckLevels: map[string]int64{},
+		Version:          version,
+	}, &condition)
+	if db.IsConditionFailedError(err) {
+		// it's ok if the record exists already.
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -106,7 +212,19 @@ func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	ackLevels, err := encodeValue(row.ClusterAckLevels)
+	if err != nil {
+		return err
+	}
+	update := expression.
+		Set(expression.Name("clusteracklevels"), expression.Value(ackLevels)).
+		Set(expression.Name("version"), expression.Value(row.Version))
+	condition := expression.Name("version").Equal(expression.Value(row.Version - 1))
+	err = db.updateItem(ctx, cadence.QueueMetadataTableName, queueMetadataKey(row.QueueType), update, &condition)
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -114,12 +232,34 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	var item cadence.QueueMetadataItem
+	if err := db.getItem(ctx, cadence.QueueMetadataTableName, queueMetadataKey(queueType), &item); err != nil {
+		return nil, err
+	}
+	// if record exist but ackLevels is empty, we initialize the map
+	if item.ClusterAckLevels == nil {
+		item.ClusterAckLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: item.ClusterAckLevels,
+		Version:          item.Version,
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.countItems(ctx, cadence.QueueMessageTableName, queueTypeKeyCondition(queueType))
+}
+
+func queueTypeKeyCondition(queueType persistence.QueueType) expression.KeyConditionBuilder {
+	return expression.Key("queuetype").Equal(expression.Value(int(queueType)))
+}
+
+func queueMetadataKey(queueType persistence.QueueType) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"queuetype": numberValue(int64(queueType)),
+	}
 }
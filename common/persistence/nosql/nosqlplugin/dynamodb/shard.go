@@ -22,29 +22,122 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	item, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	condition := expression.AttributeNotExists(expression.Name("shardid"))
+	err = db.putItem(ctx, cadence.ShardTableName, item, &condition)
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedShardError(ctx, row.ShardID, "InsertShard operation failed because shard already exists")
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	var item cadence.ShardItem
+	if err := db.getItem(ctx, cadence.ShardTableName, shardKey(shardID), &item); err != nil {
+		return 0, nil, err
+	}
+
+	info := &nosqlplugin.ShardRow{}
+	if err := decodeData(item.Data, item.DataEncoding, info); err != nil {
+		return 0, nil, err
+	}
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return item.RangeID, info, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	update := expression.Set(expression.Name("rangeid"), expression.Value(rangeID))
+	condition := expression.Name("rangeid").Equal(expression.Value(previousRangeID))
+	err := db.updateItem(ctx, cadence.ShardTableName, shardKey(shardID), update, &condition)
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedShardError(ctx, shardID, fmt.Sprintf("UpdateRangeID operation failed, previous rangeID: %v", previousRangeID))
+	}
+	return err
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	item, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	condition := expression.Name("rangeid").Equal(expression.Value(previousRangeID))
+	err = db.putItem(ctx, cadence.ShardTableName, item, &condition)
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedShardError(ctx, row.ShardID, fmt.Sprintf("UpdateShard operation failed, previous rangeID: %v", previousRangeID))
+	}
+	return err
+}
+
+func shardKey(shardID int) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"shardid": numberValue(int64(shardID)),
+	}
+}
+
+func newShardItem(row *nosqlplugin.ShardRow) (*cadence.ShardItem, error) {
+	info := *row
+	info.UpdatedAt = time.Now()
+	data, encoding, err := encodeData(&info)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.ShardItem{
+		ShardID:      row.ShardID,
+		RangeID:      row.RangeID,
+		Data:         data,
+		DataEncoding: encoding,
+	}, nil
+}
+
+// newConflictedShardError reads the current rangeID of the shard to build the condition failure,
+// because a failed conditional write of a single item doesn't return the existing item
+func (db *ddb) newConflictedShardError(ctx context.Context, shardID int, details string) error {
+	rangeID := int64(-1)
+	var item cadence.ShardItem
+	err := db.getItem(ctx, cadence.ShardTableName, shardKey(shardID), &item)
+	if err == nil {
+		rangeID = item.RangeID
+	} else if !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: details,
+	}
 }
@@ -22,20 +22,42 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
+var taskKeyNames = []string{"tasklistkey", "taskid"}
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *ddb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	var item cadence.TaskListItem
+	if err := db.getItem(ctx, cadence.TaskListTableName, taskListKey(filter), &item); err != nil {
+		return nil, err
+	}
+	return convertToTaskListRow(&item)
 }
 
 // InsertTaskList insert a single tasklist row
-// Return IsConditionFailedError if the row already exists, and also the existing row
+// Return TaskOperationConditionFailure if the row already exists
 func (db *ddb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	item, err := newTaskListItem(row, 0)
+	if err != nil {
+		return err
+	}
+	condition := expression.AttributeNotExists(expression.Name("tasklistkey"))
+	err = db.putItem(ctx, cadence.TaskListTableName, item, &condition)
+	if db.IsConditionFailedError(err) {
+		return db.newTaskListConditionFailure(ctx, filterOfTaskListRow(row), "InsertTaskList operation failed because tasklist already exists")
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,53 +67,249 @@ func (db *ddb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, 0, row, previousRangeID)
 }
 
-// UpdateTaskList updates a single tasklist row, and set an TTL on the record
+// UpdateTaskListWithTTL updates a single tasklist row, and set an TTL on the record
 // Return TaskOperationConditionFailure if the condition doesn't meet
-// Ignore TTL if it's not supported, which becomes exactly the same as UpdateTaskList, but ListTaskList must be
-// implemented for TaskListScavenger
 func (db *ddb) UpdateTaskListWithTTL(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, ttlSeconds, row, previousRangeID)
+}
+
+func (db *ddb) updateTaskList(
+	ctx context.Context,
+	ttlSeconds int64,
+	row *nosqlplugin.TaskListRow,
+	previousRangeID int64,
+) error {
+	item, err := newTaskListItem(row, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	condition := expression.Name("rangeid").Equal(expression.Value(previousRangeID))
+	err = db.putItem(ctx, cadence.TaskListTableName, item, &condition)
+	if db.IsConditionFailedError(err) {
+		return db.newTaskListConditionFailure(ctx, filterOfTaskListRow(row), fmt.Sprintf("UpdateTaskList operation failed, previous rangeID: %v", previousRangeID))
+	}
+	return err
 }
 
 // ListTaskList returns all tasklists.
-// Noop if TTL is already implemented in other methods
 func (db *ddb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	var items []*cadence.TaskListItem
+	token, err := db.scanPageInto(ctx, cadence.TaskListTableName, pageSize, nextPageToken, &items)
+	if err != nil {
+		return nil, err
+	}
+	result := &nosqlplugin.ListTaskListResult{
+		NextPageToken: token,
+	}
+	for _, item := range items {
+		row, err := convertToTaskListRow(item)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *ddb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	condition := expression.Name("rangeid").Equal(expression.Value(previousRangeID))
+	err := db.deleteItem(ctx, cadence.TaskListTableName, taskListKey(filter), &condition)
+	if db.IsConditionFailedError(err) {
+		return db.newTaskListConditionFailure(ctx, filter, fmt.Sprintf("DeleteTaskList operation failed, previous rangeID: %v", previousRangeID))
+	}
+	return err
 }
 
 // InsertTasks inserts a batch of tasks
 // Return TaskOperationConditionFailure if the condition doesn't meet
+// NOTE: each transaction checks the rangeID of the tasklist, but a batch larger than the limit of a transaction
+// is split into multiple transactions, so it's not atomic anymore.
 func (db *ddb) InsertTasks(
 	ctx context.Context,
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	taskListFilter := filterOfTaskListRow(tasklistCondition)
+	// The following condition check is used to ensure that range_id didn't change
+	check, err := db.newConditionCheck(cadence.TaskListTableName, taskListKey(taskListFilter),
+		expression.Name("rangeid").Equal(expression.Value(tasklistCondition.RangeID)))
+	if err != nil {
+		return err
+	}
+	onConditionFailure := func(old map[string]*dynamodb.AttributeValue) error {
+		rangeID := int64(-1)
+		if old != nil {
+			var item cadence.TaskListItem
+			if err := dynamodbattribute.UnmarshalMap(old, &item); err != nil {
+				return err
+			}
+			rangeID = item.RangeID
+		}
+		return &nosqlplugin.TaskOperationConditionFailure{
+			RangeID: rangeID,
+			Details: fmt.Sprintf("InsertTasks operation failed, rangeID: %v, actual rangeID: %v", tasklistCondition.RangeID, rangeID),
+		}
+	}
+
+	txn := &transaction{}
+	txn.conditionCheck(check, onConditionFailure)
+	for _, task := range tasksToInsert {
+		item, err := newTaskItem(tasklistCondition, task)
+		if err != nil {
+			return err
+		}
+		put, err := db.newPut(cadence.TaskTableName, item, nil)
+		if err != nil {
+			return err
+		}
+		txn.put(put, nil)
+		if len(txn.items) == maxTransactionItems {
+			if err := db.executeTransaction(ctx, txn); err != nil {
+				return err
+			}
+			txn = &transaction{}
+			txn.conditionCheck(check, onConditionFailure)
+		}
+	}
+	if len(txn.items) == 1 && len(tasksToInsert) > 0 {
+		// all tasks have been inserted by the previous transactions
+		return nil
+	}
+	return db.executeTransaction(ctx, txn)
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return nil, nil
+	}
+	keyCondition := taskKeyCondition(filter)
+	// DynamoDB deletes the expired items in background, which may take a while
+	notExpired := expression.AttributeNotExists(expression.Name("expireat")).Or(
+		expression.Name("expireat").GreaterThan(expression.Value(time.Now().Unix())),
+	)
+	input, err := db.newQuery(cadence.TaskTableName, keyCondition, &notExpired, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var items []*cadence.TaskItem
+	if _, err := db.queryPageInto(ctx, input, filter.BatchSize, &items); err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.TaskRow, 0, len(items))
+	for _, item := range items {
+		row := &nosqlplugin.TaskRow{}
+		if err := decodeData(item.Data, item.DataEncoding, row); err != nil {
+			return nil, err
+		}
+		row.DomainID = item.DomainID
+		row.TaskListName = item.TaskListName
+		row.TaskListType = item.TaskListType
+		row.TaskID = item.TaskID
+		rows = append(rows, row)
+	}
+	return rows, nil
 }
 
-// DeleteTask delete a batch tasks that taskIDs less than the row
-// If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
+// RangeDeleteTasks delete a batch of tasks, and returns the number of rows deleted
 func (db *ddb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return 0, nil
+	}
+	return db.rangeDeleteItems(ctx, cadence.TaskTableName, taskKeyCondition(filter), taskKeyNames, filter.BatchSize)
+}
+
+func (db *ddb) newTaskListConditionFailure(ctx context.Context, filter *nosqlplugin.TaskListFilter, details string) error {
+	var item cadence.TaskListItem
+	err := db.getItem(ctx, cadence.TaskListTableName, taskListKey(filter), &item)
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			return err
+		}
+		item.RangeID = -1
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: item.RangeID,
+		Details: fmt.Sprintf("%v, actual rangeID: %v", details, item.RangeID),
+	}
+}
+
+// encodeTaskListKey returns the key of tasklist, which is also the hash key of the tasks of the tasklist
+func encodeTaskListKey(filter *nosqlplugin.TaskListFilter) string {
+	return fmt.Sprintf("%v#%v#%v", filter.DomainID, filter.TaskListName, filter.TaskListType)
+}
+
+func taskListKey(filter *nosqlplugin.TaskListFilter) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"tasklistkey": stringValue(encodeTaskListKey(filter)),
+	}
+}
+
+// taskKeyCondition returns the key condition of the tasks within (MinTaskID, MaxTaskID]
+func taskKeyCondition(filter *nosqlplugin.TasksFilter) expression.KeyConditionBuilder {
+	return expression.Key("tasklistkey").Equal(expression.Value(encodeTaskListKey(&filter.TaskListFilter))).And(
+		expression.Key("taskid").Between(expression.Value(filter.MinTaskID+1), expression.Value(filter.MaxTaskID)),
+	)
+}
+
+func filterOfTaskListRow(row *nosqlplugin.TaskListRow) *nosqlplugin.TaskListFilter {
+	return &nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}
+}
+
+func newTaskListItem(row *nosqlplugin.TaskListRow, ttlSeconds int64) (*cadence.TaskListItem, error) {
+	data, encoding, err := encodeData(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.TaskListItem{
+		TaskListKey:  encodeTaskListKey(filterOfTaskListRow(row)),
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+		RangeID:      row.RangeID,
+		Data:         data,
+		DataEncoding: encoding,
+		ExpireAt:     getExpireAt(ttlSeconds),
+	}, nil
+}
+
+func convertToTaskListRow(item *cadence.TaskListItem) (*nosqlplugin.TaskListRow, error) {
+	row := &nosqlplugin.TaskListRow{}
+	if err := decodeData(item.Data, item.DataEncoding, row); err != nil {
+		return nil, err
+	}
+	row.RangeID = item.RangeID
+	return row, nil
+}
+
+func newTaskItem(taskList *nosqlplugin.TaskListRow, task *nosqlplugin.TaskRowForInsert) (*cadence.TaskItem, error) {
+	data, encoding, err := encodeData(&task.TaskRow)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.TaskItem{
+		TaskListKey:  encodeTaskListKey(filterOfTaskListRow(taskList)),
+		TaskID:       task.TaskID,
+		DomainID:     taskList.DomainID,
+		TaskListName: taskList.TaskListName,
+		TaskListType: taskList.TaskListType,
+		Data:         data,
+		DataEncoding: encoding,
+		ExpireAt:     getExpireAt(int64(task.TTLSeconds)),
+	}, nil
 }
@@ -23,40 +23,105 @@ package tests
 import (
 	"testing"
 
+	"github.com/stretchr/testify/suite"
+
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/dynamodb"
+	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/environment"
+	"github.com/uber/cadence/testflags"
 )
 
-// This is to make sure adding new noop method when adding new nosql interfaces
-// Remove it when any other tests are implemented.
-func TestDynamoDBNoopStruct(t *testing.T) {
-	_, _ = dynamodb.NewDynamoDB(config.NoSQL{}, nil)
+func TestDynamoDBConfigStorePersistence(t *testing.T) {
+	testflags.RequireDynamoDB(t)
+	s := new(persistencetests.ConfigStorePersistenceSuite)
+	s.TestBase = NewTestBaseWithDynamoDB()
+	s.TestBase.Setup()
+	suite.Run(t, s)
 }
 
 func TestDynamoDBHistoryPersistence(t *testing.T) {
-	//s := new(persistencetests.HistoryV2PersistenceSuite)
-	//s.TestBase = public.NewTestBaseWithDynamoDB(&persistencetests.TestBaseOptions{})
-	//s.TestBase.Setup()
-	//suite.Run(t, s)
+	testflags.RequireDynamoDB(t)
+	s := new(persistencetests.HistoryV2PersistenceSuite)
+	s.TestBase = NewTestBaseWithDynamoDB()
+	s.TestBase.Setup()
+	suite.Run(t, s)
 }
 
 func TestDynamoDBMatchingPersistence(t *testing.T) {
-	//s := new(persistencetests.MatchingPersistenceSuite)
-	//s.TestBase = public.NewTestBaseWithDynamoDB(&persistencetests.TestBaseOptions{})
-	//s.TestBase.Setup()
-	//suite.Run(t, s)
+	testflags.RequireDynamoDB(t)
+	s := new(persistencetests.MatchingPersistenceSuite)
+	s.TestBase = NewTestBaseWithDynamoDB()
+	s.TestBase.Setup()
+	suite.Run(t, s)
 }
 
 func TestDynamoDBDomainPersistence(t *testing.T) {
-	//s := new(persistencetests.MetadataPersistenceSuiteV2)
-	//s.TestBase = public.NewTestBaseWithDynamoDB(&persistencetests.TestBaseOptions{})
-	//s.TestBase.Setup()
-	//suite.Run(t, s)
+	testflags.RequireDynamoDB(t)
+	s := new(persistencetests.MetadataPersistenceSuiteV2)
+	s.TestBase = NewTestBaseWithDynamoDB()
+	s.TestBase.Setup()
+	suite.Run(t, s)
 }
 
 func TestDynamoDBQueuePersistence(t *testing.T) {
-	//s := new(persistencetests.QueuePersistenceSuite)
-	//s.TestBase = public.NewTestBaseWithDynamoDB(&persistencetests.TestBaseOptions{})
-	//s.TestBase.Setup()
-	//suite.Run(t, s)
+	testflags.RequireDynamoDB(t)
+	s := new(persistencetests.QueuePersistenceSuite)
+	s.TestBase = NewTestBaseWithDynamoDB()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestDynamoDBShardPersistence(t *testing.T) {
+	testflags.RequireDynamoDB(t)
+	s := new(persistencetests.ShardPersistenceSuite)
+	s.TestBase = NewTestBaseWithDynamoDB()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestDynamoDBVisibilityPersistence(t *testing.T) {
+	testflags.RequireDynamoDB(t)
+	s := new(persistencetests.DBVisibilityPersistenceSuite)
+	s.TestBase = NewTestBaseWithDynamoDB()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestDynamoDBExecutionManager(t *testing.T) {
+	testflags.RequireDynamoDB(t)
+	s := new(persistencetests.ExecutionManagerSuite)
+	s.TestBase = NewTestBaseWithDynamoDB()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestDynamoDBExecutionManagerWithEventsV2(t *testing.T) {
+	testflags.RequireDynamoDB(t)
+	s := new(persistencetests.ExecutionManagerSuiteForEventsV2)
+	s.TestBase = NewTestBaseWithDynamoDB()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func NewTestBaseWithDynamoDB() persistencetests.TestBase {
+	options := &persistencetests.TestBaseOptions{
+		DBPluginName: dynamodb.PluginName,
+		DBHost:       getTestConfig().Hosts,
+		DBUsername:   getTestConfig().User,
+		DBPassword:   getTestConfig().Password,
+		DBPort:       getTestConfig().Port,
+	}
+	return persistencetests.NewTestBaseWithNoSQL(options)
+}
+
+func getTestConfig() *config.NoSQL {
+	return &config.NoSQL{
+		PluginName: dynamodb.PluginName,
+		// DynamoDB local accepts any credentials
+		User:     "local",
+		Password: "local",
+		Hosts:    environment.GetDynamoDBAddress(),
+		Port:     environment.GetDynamoDBPort(),
+	}
 }
@@ -22,8 +22,14 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 func (db *ddb) InsertVisibility(
@@ -31,7 +37,11 @@ func (db *ddb) InsertVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	item, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.putItem(ctx, cadence.VisibilityTableName, item, nil)
 }
 
 func (db *ddb) UpdateVisibility(
@@ -39,26 +49,158 @@ func (db *ddb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	// a single item is kept for each run, so UpdateOpenToClose and UpdateCloseToOpen are only about the isclosed attribute
+	item, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, row.Status != nil, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.putItem(ctx, cadence.VisibilityTableName, item, nil)
 }
 
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	indexName := cadence.VisibilityStartTimeIndexName
+	sortKey := "starttime"
+	if filter.SortType == nosqlplugin.SortByClosedTime {
+		indexName = cadence.VisibilityCloseTimeIndexName
+		sortKey = "closetime"
+	}
+
+	request := filter.ListRequest
+	keyCondition := expression.Key("domainid").Equal(expression.Value(request.DomainUUID)).And(
+		expression.Key(sortKey).Between(
+			expression.Value(request.EarliestTime.UnixNano()),
+			expression.Value(request.LatestTime.UnixNano()),
+		),
+	)
+
+	var condition expression.ConditionBuilder
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen:
+		condition = isClosedCondition(false)
+	case nosqlplugin.AllClosed:
+		condition = isClosedCondition(true)
+	case nosqlplugin.OpenByWorkflowType:
+		condition = isClosedCondition(false).And(expression.Name("workflowtype").Equal(expression.Value(filter.WorkflowType)))
+	case nosqlplugin.ClosedByWorkflowType:
+		condition = isClosedCondition(true).And(expression.Name("workflowtype").Equal(expression.Value(filter.WorkflowType)))
+	case nosqlplugin.OpenByWorkflowID:
+		condition = isClosedCondition(false).And(expression.Name("workflowid").Equal(expression.Value(filter.WorkflowID)))
+	case nosqlplugin.ClosedByWorkflowID:
+		condition = isClosedCondition(true).And(expression.Name("workflowid").Equal(expression.Value(filter.WorkflowID)))
+	case nosqlplugin.ClosedByClosedStatus:
+		condition = isClosedCondition(true).And(expression.Name("closestatus").Equal(expression.Value(filter.CloseStatus)))
+	default:
+		return nil, fmt.Errorf("unknown visibility filter type %v", filter.FilterType)
+	}
+
+	input, err := db.newQuery(cadence.VisibilityTableName, keyCondition, &condition, nil, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	// global secondary indexes don't support strongly consistent reads
+	input.IndexName = aws.String(indexName)
+	input.ConsistentRead = nil
+	// items are ordered by the sort time descending
+	input.ScanIndexForward = aws.Bool(false)
+
+	var items []*cadence.VisibilityItem
+	nextPageToken, err := db.queryPageInto(ctx, input, request.PageSize, &items)
+	if err != nil {
+		return nil, err
+	}
+	response := &nosqlplugin.SelectVisibilityResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, item := range items {
+		row, err := convertToVisibilityRow(item)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	return response, nil
 }
 
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	condition := expression.Name("workflowid").Equal(expression.Value(workflowID))
+	err := db.deleteItem(ctx, cadence.VisibilityTableName, visibilityKey(domainID, runID), &condition)
+	if db.IsConditionFailedError(err) {
+		// the run doesn't exist, or belongs to another workflow
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	var item cadence.VisibilityItem
+	err := db.getItem(ctx, cadence.VisibilityTableName, visibilityKey(domainID, runID), &item)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if item.WorkflowID != workflowID || !item.IsClosed {
+		return nil, nil
+	}
+	return convertToVisibilityRow(&item)
+}
+
+func isClosedCondition(isClosed bool) expression.ConditionBuilder {
+	return expression.Name("isclosed").Equal(expression.Value(isClosed))
+}
+
+func visibilityKey(domainID, runID string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"domainid": stringValue(domainID),
+		"runid":    stringValue(runID),
+	}
+}
+
+func newVisibilityItem(
+	domainID string,
+	row *nosqlplugin.VisibilityRow,
+	isClosed bool,
+	ttlSeconds int64,
+) (*cadence.VisibilityItem, error) {
+	data, encoding, err := encodeData(row)
+	if err != nil {
+		return nil, err
+	}
+	item := &cadence.VisibilityItem{
+		DomainID:     domainID,
+		RunID:        row.RunID,
+		WorkflowID:   row.WorkflowID,
+		WorkflowType: row.TypeName,
+		StartTime:    row.StartTime.UnixNano(),
+		IsClosed:     isClosed,
+		Data:         data,
+		DataEncoding: encoding,
+		ExpireAt:     getExpireAt(ttlSeconds),
+	}
+	if isClosed {
+		item.CloseTime = row.CloseTime.UnixNano()
+		item.CloseStatus = int32(*row.Status)
+	}
+	return item, nil
+}
+
+func convertToVisibilityRow(
+	item *cadence.VisibilityItem,
+) (*nosqlplugin.VisibilityRow, error) {
+	row := &nosqlplugin.VisibilityRow{}
+	if err := decodeData(item.Data, item.DataEncoding, row); err != nil {
+		return nil, err
+	}
+	row.DomainID = item.DomainID
+	return row, nil
 }
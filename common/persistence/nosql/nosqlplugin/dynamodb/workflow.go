@@ -23,10 +23,16 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*ddb)(nil)
@@ -41,7 +47,31 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	txn := &transaction{}
+	err := db.assertShardRangeID(txn, shardCondition)
+	if err != nil {
+		return err
+	}
+
+	err = db.createOrUpdateCurrentWorkflow(txn, shardID, domainID, workflowID, currentWorkflowRequest)
+	if err != nil {
+		return err
+	}
+
+	err = db.createWorkflowExecutionWithMergeMaps(txn, shardID, domainID, workflowID, execution, shardCondition)
+	if err != nil {
+		return err
+	}
+
+	err = db.createTasks(txn, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	if err != nil {
+		return err
+	}
+	return db.executeTransaction(ctx, txn)
 }
 
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
@@ -56,105 +86,357 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	txn := &transaction{}
+	err := db.assertShardRangeID(txn, shardCondition)
+	if err != nil {
+		return err
+	}
+
+	err = db.createOrUpdateCurrentWorkflow(txn, shardID, domainID, workflowID, currentWorkflowRequest)
+	if err != nil {
+		return err
+	}
+
+	if mutatedExecution != nil {
+		err = db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(txn, shardID, domainID, workflowID, mutatedExecution)
+		if err != nil {
+			return err
+		}
+	}
+
+	if insertedExecution != nil {
+		err = db.createWorkflowExecutionWithMergeMaps(txn, shardID, domainID, workflowID, insertedExecution, shardCondition)
+		if err != nil {
+			return err
+		}
+	}
+
+	if resetExecution != nil {
+		err = db.resetWorkflowExecutionAndMapsAndEventBuffer(txn, shardID, domainID, workflowID, resetExecution)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = db.createTasks(txn, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	if err != nil {
+		return err
+	}
+	return db.executeTransaction(ctx, txn)
 }
 
 func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	var item cadence.CurrentWorkflowItem
+	if err := db.getItem(ctx, cadence.CurrentWorkflowTableName, currentWorkflowKey(shardID, domainID, workflowID), &item); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            item.RunID,
+		State:            item.State,
+		CloseStatus:      item.CloseStatus,
+		CreateRequestID:  item.CreateRequestID,
+		LastWriteVersion: item.LastWriteVersion,
+	}, nil
 }
 
 func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	var item cadence.WorkflowExecutionItem
+	if err := db.getItem(ctx, cadence.WorkflowExecutionTableName, workflowExecutionKey(shardID, domainID, workflowID, runID), &item); err != nil {
+		return nil, err
+	}
+	return convertToWorkflowExecution(&item)
 }
 
 func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	condition := expression.Name("runid").Equal(expression.Value(currentRunIDCondition))
+	err := db.deleteItem(ctx, cadence.CurrentWorkflowTableName, currentWorkflowKey(shardID, domainID, workflowID), &condition)
+	if db.IsConditionFailedError(err) {
+		// the same as Cassandra, it's not an error if the current run has changed
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	return db.deleteItem(ctx, cadence.WorkflowExecutionTableName, workflowExecutionKey(shardID, domainID, workflowID, runID), nil)
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	keyCondition := expression.Key("shardid").Equal(expression.Value(shardID))
+	input, err := db.newQuery(cadence.CurrentWorkflowTableName, keyCondition, nil, nil, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var items []*cadence.CurrentWorkflowItem
+	nextPageToken, err := db.queryPageInto(ctx, input, pageSize, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var executions []*persistence.CurrentWorkflowExecution
+	for _, item := range items {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     item.DomainID,
+			WorkflowID:   item.WorkflowID,
+			RunID:        item.RunID,
+			State:        item.State,
+			CurrentRunID: item.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	keyCondition := expression.Key("shardid").Equal(expression.Value(shardID))
+	projection := expression.NamesList(expression.Name("data"), expression.Name("dataencoding"))
+	input, err := db.newQuery(cadence.WorkflowExecutionTableName, keyCondition, nil, &projection, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var items []*cadence.WorkflowExecutionItem
+	nextPageToken, err := db.queryPageInto(ctx, input, pageSize, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var executions []*persistence.InternalListConcreteExecutionsEntity
+	for _, item := range items {
+		data := &workflowExecutionData{}
+		if err := decodeData(item.Data, item.DataEncoding, data); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:            db.tableName(cadence.WorkflowExecutionTableName),
+		Key:                  workflowExecutionKey(shardID, domainID, workflowID, runID),
+		ProjectionExpression: aws.String("shardid"),
+		ConsistentRead:       aws.Bool(true),
+	})
+	if err != nil {
+		return false, err
+	}
+	return output.Item != nil, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.TransferTask, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.selectShardTasksOrderByTaskID(ctx, cadence.TransferTaskTableName,
+		expression.Key("shardid").Equal(expression.Value(shardID)),
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []*nosqlplugin.TransferTask
+	for _, item := range items {
+		task := &nosqlplugin.TransferTask{}
+		if err := decodeData(item.Data, item.DataEncoding, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, cadence.TransferTaskTableName, shardTaskKey(shardID, taskID), nil)
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	if exclusiveBeginTaskID >= inclusiveEndTaskID {
+		return nil
+	}
+	keyCondition := shardTaskKeyCondition(expression.Key("shardid").Equal(expression.Value(shardID)), exclusiveBeginTaskID, inclusiveEndTaskID)
+	_, err := db.rangeDeleteItems(ctx, cadence.TransferTaskTableName, keyCondition, shardTaskKeyNames, 0)
+	return err
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.TimerTask, []byte, error) {
-	panic("TODO")
+	if !inclusiveMinTime.Before(exclusiveMaxTime) {
+		return nil, nil, nil
+	}
+	input, err := db.newQuery(cadence.TimerTaskTableName, timerTaskKeyCondition(shardID, inclusiveMinTime, exclusiveMaxTime), nil, nil, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var items []*cadence.TimerTaskItem
+	nextPageToken, err := db.queryPageInto(ctx, input, pageSize, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var timers []*nosqlplugin.TimerTask
+	for _, item := range items {
+		timer := &nosqlplugin.TimerTask{}
+		if err := decodeData(item.Data, item.DataEncoding, timer); err != nil {
+			return nil, nil, err
+		}
+		timers = append(timers, timer)
+	}
+	return timers, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	return db.deleteItem(ctx, cadence.TimerTaskTableName, map[string]*dynamodb.AttributeValue{
+		"shardid":  numberValue(int64(shardID)),
+		"timerkey": stringValue(encodeTimerKey(visibilityTimestamp, taskID)),
+	}, nil)
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	if !inclusiveMinTime.Before(exclusiveMaxTime) {
+		return nil
+	}
+	keyCondition := timerTaskKeyCondition(shardID, inclusiveMinTime, exclusiveMaxTime)
+	_, err := db.rangeDeleteItems(ctx, cadence.TimerTaskTableName, keyCondition, timerTaskKeyNames, 0)
+	return err
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.selectShardTasksOrderByTaskID(ctx, cadence.ReplicationTaskTableName,
+		expression.Key("shardid").Equal(expression.Value(shardID)),
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := convertToReplicationTasks(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, cadence.ReplicationTaskTableName, shardTaskKey(shardID, taskID), nil)
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	keyCondition := expression.Key("shardid").Equal(expression.Value(shardID)).
+		And(expression.Key("taskid").LessThanEqual(expression.Value(inclusiveEndTaskID)))
+	_, err := db.rangeDeleteItems(ctx, cadence.ReplicationTaskTableName, keyCondition, shardTaskKeyNames, 0)
+	return err
 }
 
-func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, shardCondition nosqlplugin.ShardCondition) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	txn := &transaction{}
+	check, err := db.newConditionCheck(cadence.ShardTableName, shardKey(shardCondition.ShardID),
+		expression.Name("rangeid").Equal(expression.Value(shardCondition.RangeID)))
+	if err != nil {
+		return err
+	}
+	txn.conditionCheck(check, func(old map[string]*dynamodb.AttributeValue) error {
+		rangeID, err := decodeShardRangeID(old)
+		if err != nil {
+			return err
+		}
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: rangeID,
+		}
+	})
+	if err := db.createReplicationTasks(txn, shardCondition.ShardID, tasks); err != nil {
+		return err
+	}
+	return db.executeTransaction(ctx, txn)
 }
 
 func (db *ddb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.CrossClusterTask, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.selectShardTasksOrderByTaskID(ctx, cadence.CrossClusterTaskTableName,
+		expression.Key("shardkey").Equal(expression.Value(encodeShardKey(shardID, targetCluster))),
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []*nosqlplugin.CrossClusterTask
+	for _, item := range items {
+		task := &nosqlplugin.CrossClusterTask{}
+		if err := decodeData(item.Data, item.DataEncoding, &task.TransferTask); err != nil {
+			return nil, nil, err
+		}
+		task.TargetCluster = targetCluster
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, cadence.CrossClusterTaskTableName, clusterShardTaskKey(shardID, targetCluster, taskID), nil)
 }
 
 func (db *ddb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, targetCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	if exclusiveBeginTaskID >= inclusiveEndTaskID {
+		return nil
+	}
+	keyCondition := shardTaskKeyCondition(expression.Key("shardkey").Equal(expression.Value(encodeShardKey(shardID, targetCluster))),
+		exclusiveBeginTaskID, inclusiveEndTaskID)
+	_, err := db.rangeDeleteItems(ctx, cadence.CrossClusterTaskTableName, keyCondition, clusterShardTaskKeyNames, 0)
+	return err
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
-	panic("TODO")
+	item, err := newShardTaskItem(shardID, sourceCluster, task.TaskID, &task)
+	if err != nil {
+		return err
+	}
+	// the same as Cassandra, inserting an existing task overrides it
+	return db.putItem(ctx, cadence.ReplicationDLQTaskTableName, item, nil)
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.selectShardTasksOrderByTaskID(ctx, cadence.ReplicationDLQTaskTableName,
+		expression.Key("shardkey").Equal(expression.Value(encodeShardKey(shardID, sourceCluster))),
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := convertToReplicationTasks(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	return db.countItems(ctx, cadence.ReplicationDLQTaskTableName,
+		expression.Key("shardkey").Equal(expression.Value(encodeShardKey(shardID, sourceCluster))))
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, cadence.ReplicationDLQTaskTableName, clusterShardTaskKey(shardID, sourceCluster, taskID), nil)
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	if exclusiveBeginTaskID >= inclusiveEndTaskID {
+		return nil
+	}
+	keyCondition := shardTaskKeyCondition(expression.Key("shardkey").Equal(expression.Value(encodeShardKey(shardID, sourceCluster))),
+		exclusiveBeginTaskID, inclusiveEndTaskID)
+	_, err := db.rangeDeleteItems(ctx, cadence.ReplicationDLQTaskTableName, keyCondition, clusterShardTaskKeyNames, 0)
+	return err
 }
@@ -0,0 +1,847 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/dynamodb/cadence"
+)
+
+var (
+	shardTaskKeyNames        = []string{"shardid", "taskid"}
+	clusterShardTaskKeyNames = []string{"shardkey", "taskid"}
+	timerTaskKeyNames        = []string{"shardid", "timerkey"}
+)
+
+// workflowExecutionData is the data blob of a workflow_execution item
+type workflowExecutionData struct {
+	ExecutionInfo    *persistence.InternalWorkflowExecutionInfo
+	VersionHistories *persistence.DataBlob
+	Checksum         *checksum.Checksum
+	LastWriteVersion int64
+}
+
+// assertShardRangeID adds a condition check of the shard rangeID to the transaction.
+// It must be added before any other item, so that the shard condition failure takes the priority.
+func (db *ddb) assertShardRangeID(txn *transaction, shardCondition *nosqlplugin.ShardCondition) error {
+	check, err := db.newConditionCheck(cadence.ShardTableName, shardKey(shardCondition.ShardID),
+		expression.Name("rangeid").Equal(expression.Value(shardCondition.RangeID)))
+	if err != nil {
+		return err
+	}
+	txn.conditionCheck(check, func(old map[string]*dynamodb.AttributeValue) error {
+		rangeID, err := decodeShardRangeID(old)
+		if err != nil {
+			return err
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(rangeID),
+		}
+	})
+	return nil
+}
+
+// decodeShardRangeID returns the rangeID of the shard item returned by a failed condition, or -1 if the shard doesn't exist
+func decodeShardRangeID(old map[string]*dynamodb.AttributeValue) (int64, error) {
+	if old == nil {
+		return -1, nil
+	}
+	var item cadence.ShardItem
+	if err := dynamodbattribute.UnmarshalMap(old, &item); err != nil {
+		return 0, err
+	}
+	return item.RangeID, nil
+}
+
+func encodeWorkflowKey(domainID, workflowID string) string {
+	// domainID is a UUID, so the key is unique even if the workflowID contains "#"
+	return domainID + "#" + workflowID
+}
+
+func encodeExecutionKey(domainID, workflowID, runID string) string {
+	return domainID + "#" + workflowID + "#" + runID
+}
+
+func currentWorkflowKey(shardID int, domainID, workflowID string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"shardid":     numberValue(int64(shardID)),
+		"workflowkey": stringValue(encodeWorkflowKey(domainID, workflowID)),
+	}
+}
+
+func workflowExecutionKey(shardID int, domainID, workflowID, runID string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"shardid":      numberValue(int64(shardID)),
+		"executionkey": stringValue(encodeExecutionKey(domainID, workflowID, runID)),
+	}
+}
+
+func (db *ddb) createOrUpdateCurrentWorkflow(
+	txn *transaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		condition := expression.AttributeNotExists(expression.Name("workflowkey"))
+		put, err := db.newPut(cadence.CurrentWorkflowTableName, &cadence.CurrentWorkflowItem{
+			ShardID:          shardID,
+			WorkflowKey:      encodeWorkflowKey(domainID, workflowID),
+			DomainID:         domainID,
+			WorkflowID:       workflowID,
+			RunID:            request.Row.RunID,
+			State:            request.Row.State,
+			CloseStatus:      request.Row.CloseStatus,
+			CreateRequestID:  request.Row.CreateRequestID,
+			LastWriteVersion: request.Row.LastWriteVersion,
+		}, &condition)
+		if err != nil {
+			return err
+		}
+		txn.put(put, func(old map[string]*dynamodb.AttributeValue) error {
+			var existing cadence.CurrentWorkflowItem
+			if err := dynamodbattribute.UnmarshalMap(old, &existing); err != nil {
+				return err
+			}
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v",
+				workflowID, existing.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  existing.CreateRequestID,
+					RunID:            existing.RunID,
+					State:            existing.State,
+					CloseStatus:      existing.CloseStatus,
+					LastWriteVersion: existing.LastWriteVersion,
+				},
+			}
+		})
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		condition := expression.Name("runid").Equal(expression.Value(*request.Condition.CurrentRunID))
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			condition = condition.And(
+				expression.Name("lastwriteversion").Equal(expression.Value(*request.Condition.LastWriteVersion)),
+				expression.Name("state").Equal(expression.Value(*request.Condition.State)),
+			)
+		}
+		update := expression.
+			Set(expression.Name("runid"), expression.Value(request.Row.RunID)).
+			Set(expression.Name("state"), expression.Value(request.Row.State)).
+			Set(expression.Name("closestatus"), expression.Value(request.Row.CloseStatus)).
+			Set(expression.Name("createrequestid"), expression.Value(request.Row.CreateRequestID)).
+			Set(expression.Name("lastwriteversion"), expression.Value(request.Row.LastWriteVersion))
+		item, err := db.newUpdate(cadence.CurrentWorkflowTableName, currentWorkflowKey(shardID, domainID, workflowID), update, &condition)
+		if err != nil {
+			return err
+		}
+		txn.update(item, func(old map[string]*dynamodb.AttributeValue) error {
+			actualCurrRunID := ""
+			if old != nil {
+				var existing cadence.CurrentWorkflowItem
+				if err := dynamodbattribute.UnmarshalMap(old, &existing); err != nil {
+					return err
+				}
+				actualCurrRunID = existing.RunID
+			}
+			msg := fmt.Sprintf("Failed to update current workflow. WorkflowId: %v, Request Current RunID: %v, Actual Value: %v",
+				workflowID, request.Condition.GetCurrentRunID(), actualCurrRunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		})
+		return nil
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+}
+
+func (db *ddb) createWorkflowExecutionWithMergeMaps(
+	txn *transaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	item, err := newWorkflowExecutionItem(shardID, domainID, workflowID, execution)
+	if err != nil {
+		return err
+	}
+	condition := expression.AttributeNotExists(expression.Name("executionkey"))
+	put, err := db.newPut(cadence.WorkflowExecutionTableName, item, &condition)
+	if err != nil {
+		return err
+	}
+	txn.put(put, func(old map[string]*dynamodb.AttributeValue) error {
+		var existing cadence.WorkflowExecutionItem
+		if err := dynamodbattribute.UnmarshalMap(old, &existing); err != nil {
+			return err
+		}
+		data := &workflowExecutionData{}
+		if err := decodeData(existing.Data, existing.DataEncoding, data); err != nil {
+			return err
+		}
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+			execution.WorkflowID, execution.RunID, shardCondition.RangeID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: data.LastWriteVersion,
+			},
+		}
+	})
+	return nil
+}
+
+func (db *ddb) updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(
+	txn *transaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	update, err := newWorkflowExecutionDataUpdate(execution)
+	if err != nil {
+		return err
+	}
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		emptyEvents, err := encodeValue([]cadence.BufferedEventsItem{})
+		if err != nil {
+			return err
+		}
+		update = update.Set(expression.Name("bufferedevents"), expression.Value(emptyEvents))
+	case nosqlplugin.EventBufferWriteModeAppend:
+		newEvents, err := encodeValue([]cadence.BufferedEventsItem{{
+			Data:         execution.NewBufferedEventBatch.Data,
+			DataEncoding: string(execution.NewBufferedEventBatch.Encoding),
+		}})
+		if err != nil {
+			return err
+		}
+		update = update.Set(expression.Name("bufferedevents"),
+			expression.ListAppend(expression.Name("bufferedevents"), expression.Value(newEvents)))
+	}
+
+	// an attribute path can't be both set and removed by the same update expression,
+	// the deletion wins as it does in the Cassandra batch of the same timestamp
+	deleted := make(map[string]struct{})
+	for _, key := range execution.ActivityInfoKeysToDelete {
+		deleted["activitymap."+encodeInt64MapKey(key)] = struct{}{}
+	}
+	for _, key := range execution.TimerInfoKeysToDelete {
+		deleted["timermap."+encodeMapKey(key)] = struct{}{}
+	}
+	for _, key := range execution.ChildWorkflowInfoKeysToDelete {
+		deleted["childexecutionmap."+encodeInt64MapKey(key)] = struct{}{}
+	}
+	for _, key := range execution.RequestCancelInfoKeysToDelete {
+		deleted["requestcancelmap."+encodeInt64MapKey(key)] = struct{}{}
+	}
+	for _, key := range execution.SignalInfoKeysToDelete {
+		deleted["signalmap."+encodeInt64MapKey(key)] = struct{}{}
+	}
+	for _, key := range execution.SignalRequestedIDsKeysToDelete {
+		deleted["signalrequested."+encodeMapKey(key)] = struct{}{}
+	}
+
+	maps, err := encodeWorkflowExecutionMaps(execution)
+	if err != nil {
+		return err
+	}
+	for path, value := range maps.entries() {
+		if _, ok := deleted[path]; !ok {
+			update = update.Set(expression.Name(path), expression.Value(value))
+		}
+	}
+	for _, signalRequestedID := range execution.SignalRequestedIDs {
+		path := "signalrequested." + encodeMapKey(signalRequestedID)
+		if _, ok := deleted[path]; !ok {
+			update = update.Set(expression.Name(path), expression.Value(true))
+		}
+	}
+	for path := range deleted {
+		update = update.Remove(expression.Name(path))
+	}
+	return db.updateWorkflowExecution(txn, shardID, domainID, workflowID, execution, update)
+}
+
+func (db *ddb) resetWorkflowExecutionAndMapsAndEventBuffer(
+	txn *transaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+
+	update, err := newWorkflowExecutionDataUpdate(execution)
+	if err != nil {
+		return err
+	}
+	maps, err := encodeWorkflowExecutionMaps(execution)
+	if err != nil {
+		return err
+	}
+	for field, value := range map[string]interface{}{
+		"activitymap":       maps.activityMap,
+		"timermap":          maps.timerMap,
+		"childexecutionmap": maps.childExecutionMap,
+		"requestcancelmap":  maps.requestCancelMap,
+		"signalmap":         maps.signalMap,
+		"signalrequested":   encodeSignalRequested(execution.SignalRequestedIDs),
+		"bufferedevents":    []cadence.BufferedEventsItem{},
+	} {
+		// the values are encoded by itemEncoder, as the maps and lists may be empty
+		av, err := encodeValue(value)
+		if err != nil {
+			return err
+		}
+		update = update.Set(expression.Name(field), expression.Value(av))
+	}
+	return db.updateWorkflowExecution(txn, shardID, domainID, workflowID, execution, update)
+}
+
+// updateWorkflowExecution applies the update if the nextEventID of the execution matches PreviousNextEventIDCondition
+func (db *ddb) updateWorkflowExecution(
+	txn *transaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	update expression.UpdateBuilder,
+) error {
+	if execution.PreviousNextEventIDCondition == nil {
+		return fmt.Errorf("PreviousNextEventIDCondition is required for updating workflow execution")
+	}
+	condition := expression.Name("nexteventid").Equal(expression.Value(*execution.PreviousNextEventIDCondition))
+	item, err := db.newUpdate(cadence.WorkflowExecutionTableName,
+		workflowExecutionKey(shardID, domainID, workflowID, execution.RunID), update, &condition)
+	if err != nil {
+		return err
+	}
+	txn.update(item, func(old map[string]*dynamodb.AttributeValue) error {
+		actualNextEventID := "<not found>"
+		if old != nil {
+			var existing cadence.WorkflowExecutionItem
+			if err := dynamodbattribute.UnmarshalMap(old, &existing); err != nil {
+				return err
+			}
+			actualNextEventID = strconv.FormatInt(existing.NextEventID, 10)
+		}
+		msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RunID: %v, Request Condition: %v, Actual Value: %v",
+			shardID, execution.RunID, *execution.PreviousNextEventIDCondition, actualNextEventID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	})
+	return nil
+}
+
+func (db *ddb) createTasks(
+	txn *transaction,
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+) error {
+	for _, task := range transferTasks {
+		item, err := newShardTaskItem(shardID, "", task.TaskID, task)
+		if err != nil {
+			return err
+		}
+		if err := db.putTask(txn, cadence.TransferTaskTableName, item); err != nil {
+			return err
+		}
+	}
+
+	for _, task := range crossClusterTasks {
+		item, err := newShardTaskItem(shardID, task.TargetCluster, task.TaskID, &task.TransferTask)
+		if err != nil {
+			return err
+		}
+		if err := db.putTask(txn, cadence.CrossClusterTaskTableName, item); err != nil {
+			return err
+		}
+	}
+
+	if err := db.createReplicationTasks(txn, shardID, replicationTasks); err != nil {
+		return err
+	}
+
+	for _, task := range timerTasks {
+		data, encoding, err := encodeData(task)
+		if err != nil {
+			return err
+		}
+		item := &cadence.TimerTaskItem{
+			ShardID:             shardID,
+			TimerKey:            encodeTimerKey(task.VisibilityTimestamp, task.TaskID),
+			VisibilityTimestamp: task.VisibilityTimestamp.UnixNano(),
+			TaskID:              task.TaskID,
+			Data:                data,
+			DataEncoding:        encoding,
+		}
+		if err := db.putTask(txn, cadence.TimerTaskTableName, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *ddb) createReplicationTasks(
+	txn *transaction,
+	shardID int,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+) error {
+	for _, task := range replicationTasks {
+		item, err := newShardTaskItem(shardID, "", task.TaskID, task)
+		if err != nil {
+			return err
+		}
+		if err := db.putTask(txn, cadence.ReplicationTaskTableName, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *ddb) putTask(txn *transaction, table string, item interface{}) error {
+	put, err := db.newPut(table, item, nil)
+	if err != nil {
+		return err
+	}
+	txn.put(put, nil)
+	return nil
+}
+
+// encodeShardKey returns the hash key of the tables whose tasks are partitioned by both shard and cluster
+func encodeShardKey(shardID int, cluster string) string {
+	return strconv.Itoa(shardID) + "#" + cluster
+}
+
+// encodeTimerKey returns the range key of timer_task table, which is ordered by the visibility timestamp and then the taskID
+func encodeTimerKey(visibilityTimestamp time.Time, taskID int64) string {
+	return encodeSortKey(visibilityTimestamp.UnixNano()) + "#" + encodeSortKey(taskID)
+}
+
+func shardTaskKey(shardID int, taskID int64) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"shardid": numberValue(int64(shardID)),
+		"taskid":  numberValue(taskID),
+	}
+}
+
+func clusterShardTaskKey(shardID int, cluster string, taskID int64) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"shardkey": stringValue(encodeShardKey(shardID, cluster)),
+		"taskid":   numberValue(taskID),
+	}
+}
+
+// shardTaskKeyCondition returns the key condition of the tasks within (exclusiveMinTaskID, inclusiveMaxTaskID]
+func shardTaskKeyCondition(hashKey expression.KeyConditionBuilder, exclusiveMinTaskID, inclusiveMaxTaskID int64) expression.KeyConditionBuilder {
+	return hashKey.And(expression.Key("taskid").Between(
+		expression.Value(exclusiveMinTaskID+1),
+		expression.Value(inclusiveMaxTaskID),
+	))
+}
+
+// timerTaskKeyCondition returns the key condition of the timers within [inclusiveMinTime, exclusiveMaxTime)
+func timerTaskKeyCondition(shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) expression.KeyConditionBuilder {
+	// "~" is greater than all the characters of the encoded taskID
+	return expression.Key("shardid").Equal(expression.Value(shardID)).And(expression.Key("timerkey").Between(
+		expression.Value(encodeSortKey(inclusiveMinTime.UnixNano())),
+		expression.Value(encodeSortKey(exclusiveMaxTime.UnixNano()-1)+"~"),
+	))
+}
+
+// selectShardTasksOrderByTaskID reads tasks from one of the tables with ShardTaskItem schema
+func (db *ddb) selectShardTasksOrderByTaskID(
+	ctx context.Context,
+	table string,
+	hashKey expression.KeyConditionBuilder,
+	pageSize int,
+	pageToken []byte,
+	exclusiveMinTaskID int64,
+	inclusiveMaxTaskID int64,
+) ([]*cadence.ShardTaskItem, []byte, error) {
+	if exclusiveMinTaskID >= inclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	input, err := db.newQuery(table, shardTaskKeyCondition(hashKey, exclusiveMinTaskID, inclusiveMaxTaskID), nil, nil, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var items []*cadence.ShardTaskItem
+	nextPageToken, err := db.queryPageInto(ctx, input, pageSize, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, nextPageToken, nil
+}
+
+func newShardTaskItem(
+	shardID int,
+	cluster string,
+	taskID int64,
+	task interface{},
+) (*cadence.ShardTaskItem, error) {
+	data, encoding, err := encodeData(task)
+	if err != nil {
+		return nil, err
+	}
+	item := &cadence.ShardTaskItem{
+		ShardID:      shardID,
+		Cluster:      cluster,
+		TaskID:       taskID,
+		Data:         data,
+		DataEncoding: encoding,
+	}
+	if cluster != "" {
+		item.ShardKey = encodeShardKey(shardID, cluster)
+	}
+	return item, nil
+}
+
+func convertToReplicationTasks(
+	items []*cadence.ShardTaskItem,
+) ([]*nosqlplugin.ReplicationTask, error) {
+	var tasks []*nosqlplugin.ReplicationTask
+	for _, item := range items {
+		task := &nosqlplugin.ReplicationTask{}
+		if err := decodeData(item.Data, item.DataEncoding, task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func encodeWorkflowExecutionData(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) ([]byte, string, error) {
+	executionInfo := execution.InternalWorkflowExecutionInfo
+	return encodeData(&workflowExecutionData{
+		ExecutionInfo:    &executionInfo,
+		VersionHistories: execution.VersionHistories,
+		Checksum:         execution.Checksums,
+		LastWriteVersion: execution.LastWriteVersion,
+	})
+}
+
+// newWorkflowExecutionDataUpdate returns the update of a workflow_execution item other than the maps and event buffer
+func newWorkflowExecutionDataUpdate(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (expression.UpdateBuilder, error) {
+	data, encoding, err := encodeWorkflowExecutionData(execution)
+	if err != nil {
+		return expression.UpdateBuilder{}, err
+	}
+	return expression.
+		Set(expression.Name("nexteventid"), expression.Value(execution.NextEventID)).
+		Set(expression.Name("data"), expression.Value(data)).
+		Set(expression.Name("dataencoding"), expression.Value(encoding)).
+		Set(expression.Name("mapsdataencoding"), expression.Value(string(common.EncodingTypeJSON))).
+		Set(expression.Name("lastupdatetimestamp"), expression.Value(time.Now().UnixNano())), nil
+}
+
+func newWorkflowExecutionItem(
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*cadence.WorkflowExecutionItem, error) {
+	data, encoding, err := encodeWorkflowExecutionData(execution)
+	if err != nil {
+		return nil, err
+	}
+	maps, err := encodeWorkflowExecutionMaps(execution)
+	if err != nil {
+		return nil, err
+	}
+	// maps and lists must not be NULL, otherwise later updates to their entries would fail
+	return &cadence.WorkflowExecutionItem{
+		ShardID:             shardID,
+		ExecutionKey:        encodeExecutionKey(domainID, workflowID, execution.RunID),
+		DomainID:            domainID,
+		WorkflowID:          workflowID,
+		RunID:               execution.RunID,
+		NextEventID:         execution.NextEventID,
+		Data:                data,
+		DataEncoding:        encoding,
+		ActivityMap:         maps.activityMap,
+		TimerMap:            maps.timerMap,
+		ChildExecutionMap:   maps.childExecutionMap,
+		RequestCancelMap:    maps.requestCancelMap,
+		SignalMap:           maps.signalMap,
+		SignalRequested:     encodeSignalRequested(execution.SignalRequestedIDs),
+		BufferedEvents:      []cadence.BufferedEventsItem{},
+		MapsDataEncoding:    string(common.EncodingTypeJSON),
+		LastUpdateTimestamp: time.Now().UnixNano(),
+	}, nil
+}
+
+func convertToWorkflowExecution(
+	item *cadence.WorkflowExecutionItem,
+) (*nosqlplugin.WorkflowExecution, error) {
+	data := &workflowExecutionData{}
+	if err := decodeData(item.Data, item.DataEncoding, data); err != nil {
+		return nil, err
+	}
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:    data.ExecutionInfo,
+		VersionHistories: data.VersionHistories,
+	}
+	if state.ExecutionInfo == nil {
+		return nil, fmt.Errorf("workflow execution item has no execution info")
+	}
+	state.ExecutionInfo.NextEventID = item.NextEventID
+	if data.Checksum != nil {
+		state.Checksum = *data.Checksum
+	}
+
+	encoding := item.MapsDataEncoding
+	state.ActivityInfos = make(map[int64]*persistence.InternalActivityInfo, len(item.ActivityMap))
+	for key, value := range item.ActivityMap {
+		scheduleID, err := decodeInt64MapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.InternalActivityInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.ActivityInfos[scheduleID] = info
+	}
+	state.TimerInfos = make(map[string]*persistence.TimerInfo, len(item.TimerMap))
+	for key, value := range item.TimerMap {
+		timerID, err := decodeMapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.TimerInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.TimerInfos[timerID] = info
+	}
+	state.ChildExecutionInfos = make(map[int64]*persistence.InternalChildExecutionInfo, len(item.ChildExecutionMap))
+	for key, value := range item.ChildExecutionMap {
+		initiatedID, err := decodeInt64MapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.InternalChildExecutionInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.ChildExecutionInfos[initiatedID] = info
+	}
+	state.RequestCancelInfos = make(map[int64]*persistence.RequestCancelInfo, len(item.RequestCancelMap))
+	for key, value := range item.RequestCancelMap {
+		initiatedID, err := decodeInt64MapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.RequestCancelInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.RequestCancelInfos[initiatedID] = info
+	}
+	state.SignalInfos = make(map[int64]*persistence.SignalInfo, len(item.SignalMap))
+	for key, value := range item.SignalMap {
+		initiatedID, err := decodeInt64MapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.SignalInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.SignalInfos[initiatedID] = info
+	}
+
+	state.SignalRequestedIDs = make(map[string]struct{}, len(item.SignalRequested))
+	for key := range item.SignalRequested {
+		signalRequestedID, err := decodeMapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		state.SignalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+
+	state.BufferedEvents = make([]*persistence.DataBlob, 0, len(item.BufferedEvents))
+	for _, events := range item.BufferedEvents {
+		state.BufferedEvents = append(state.BufferedEvents, persistence.NewDataBlob(events.Data, common.EncodingType(events.DataEncoding)))
+	}
+	return state, nil
+}
+
+// workflowExecutionMaps holds the encoded entries of the maps of a workflow execution
+type workflowExecutionMaps struct {
+	activityMap       map[string][]byte
+	timerMap          map[string][]byte
+	childExecutionMap map[string][]byte
+	requestCancelMap  map[string][]byte
+	signalMap         map[string][]byte
+}
+
+func encodeWorkflowExecutionMaps(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*workflowExecutionMaps, error) {
+	maps := &workflowExecutionMaps{
+		activityMap:       make(map[string][]byte, len(execution.ActivityInfos)),
+		timerMap:          make(map[string][]byte, len(execution.TimerInfos)),
+		childExecutionMap: make(map[string][]byte, len(execution.ChildWorkflowInfos)),
+		requestCancelMap:  make(map[string][]byte, len(execution.RequestCancelInfos)),
+		signalMap:         make(map[string][]byte, len(execution.SignalInfos)),
+	}
+	for key, info := range execution.ActivityInfos {
+		if err := encodeMapEntry(maps.activityMap, encodeInt64MapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	for key, info := range execution.TimerInfos {
+		if err := encodeMapEntry(maps.timerMap, encodeMapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	for key, info := range execution.ChildWorkflowInfos {
+		if err := encodeMapEntry(maps.childExecutionMap, encodeInt64MapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	for key, info := range execution.RequestCancelInfos {
+		if err := encodeMapEntry(maps.requestCancelMap, encodeInt64MapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	for key, info := range execution.SignalInfos {
+		if err := encodeMapEntry(maps.signalMap, encodeInt64MapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	return maps, nil
+}
+
+// entries returns every entry keyed by its attribute path, to be set by an update expression
+func (m *workflowExecutionMaps) entries() map[string][]byte {
+	entries := make(map[string][]byte)
+	for attribute, values := range map[string]map[string][]byte{
+		"activitymap":       m.activityMap,
+		"timermap":          m.timerMap,
+		"childexecutionmap": m.childExecutionMap,
+		"requestcancelmap":  m.requestCancelMap,
+		"signalmap":         m.signalMap,
+	} {
+		for key, value := range values {
+			entries[attribute+"."+key] = value
+		}
+	}
+	return entries
+}
+
+func encodeMapEntry(entries map[string][]byte, key string, value interface{}) error {
+	data, _, err := encodeData(value)
+	if err != nil {
+		return err
+	}
+	entries[key] = data
+	return nil
+}
+
+func encodeSignalRequested(signalRequestedIDs []string) map[string]bool {
+	signalRequested := make(map[string]bool, len(signalRequestedIDs))
+	for _, signalRequestedID := range signalRequestedIDs {
+		signalRequested[encodeMapKey(signalRequestedID)] = true
+	}
+	return signalRequested
+}
+
+// encodeMapKey encodes a map key to be used in an attribute path, as user provided keys(e.g. timerID) may contain dots or brackets
+func encodeMapKey(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodeMapKey(key string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(key)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+func encodeInt64MapKey(key int64) string {
+	return encodeMapKey(strconv.FormatInt(key, 10))
+}
+
+func decodeInt64MapKey(key string) (int64, error) {
+	decoded, err := decodeMapKey(key)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(decoded, 10, 64)
+}
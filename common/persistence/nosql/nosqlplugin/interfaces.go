@@ -527,5 +527,9 @@ type (
 		InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error
 		// SelectLatestConfig returns the config entry of the row_type with the largest(latest) version value
 		SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error)
+		// SelectLatestConfigs returns up to limit config entries of the row_type, from the largest(latest) version value down
+		SelectLatestConfigs(ctx context.Context, rowType int, limit int) ([]*persistence.InternalConfigStoreEntry, error)
+		// DeleteConfigsBefore deletes the config entries of the row_type with a version value lower than the given one
+		DeleteConfigsBefore(ctx context.Context, rowType int, version int64) error
 	}
 )
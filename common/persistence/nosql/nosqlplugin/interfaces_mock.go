@@ -177,6 +177,20 @@ func (mr *MockDBMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDB)(nil).Close))
 }
 
+// DeleteConfigsBefore mocks base method.
+func (m *MockDB) DeleteConfigsBefore(ctx context.Context, rowType int, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigsBefore", ctx, rowType, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConfigsBefore indicates an expected call of DeleteConfigsBefore.
+func (mr *MockDBMockRecorder) DeleteConfigsBefore(ctx, rowType, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigsBefore", reflect.TypeOf((*MockDB)(nil).DeleteConfigsBefore), ctx, rowType, version)
+}
+
 // DeleteCrossClusterTask mocks base method.
 func (m *MockDB) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
 	m.ctrl.T.Helper()
@@ -927,6 +941,21 @@ func (mr *MockDBMockRecorder) SelectLatestConfig(ctx, rowType interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLatestConfig", reflect.TypeOf((*MockDB)(nil).SelectLatestConfig), ctx, rowType)
 }
 
+// SelectLatestConfigs mocks base method.
+func (m *MockDB) SelectLatestConfigs(ctx context.Context, rowType, limit int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLatestConfigs", ctx, rowType, limit)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLatestConfigs indicates an expected call of SelectLatestConfigs.
+func (mr *MockDBMockRecorder) SelectLatestConfigs(ctx, rowType, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLatestConfigs", reflect.TypeOf((*MockDB)(nil).SelectLatestConfigs), ctx, rowType, limit)
+}
+
 // SelectMessagesBetween mocks base method.
 func (m *MockDB) SelectMessagesBetween(ctx context.Context, request SelectMessagesBetweenRequest) (*SelectMessagesBetweenResponse, error) {
 	m.ctrl.T.Helper()
@@ -1277,6 +1306,20 @@ func (m *MocktableCRUD) EXPECT() *MocktableCRUDMockRecorder {
 	return m.recorder
 }
 
+// DeleteConfigsBefore mocks base method.
+func (m *MocktableCRUD) DeleteConfigsBefore(ctx context.Context, rowType int, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigsBefore", ctx, rowType, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConfigsBefore indicates an expected call of DeleteConfigsBefore.
+func (mr *MocktableCRUDMockRecorder) DeleteConfigsBefore(ctx, rowType, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigsBefore", reflect.TypeOf((*MocktableCRUD)(nil).DeleteConfigsBefore), ctx, rowType, version)
+}
+
 // DeleteCrossClusterTask mocks base method.
 func (m *MocktableCRUD) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
 	m.ctrl.T.Helper()
@@ -1957,6 +2000,21 @@ func (mr *MocktableCRUDMockRecorder) SelectLatestConfig(ctx, rowType interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLatestConfig", reflect.TypeOf((*MocktableCRUD)(nil).SelectLatestConfig), ctx, rowType)
 }
 
+// SelectLatestConfigs mocks base method.
+func (m *MocktableCRUD) SelectLatestConfigs(ctx context.Context, rowType, limit int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLatestConfigs", ctx, rowType, limit)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLatestConfigs indicates an expected call of SelectLatestConfigs.
+func (mr *MocktableCRUDMockRecorder) SelectLatestConfigs(ctx, rowType, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLatestConfigs", reflect.TypeOf((*MocktableCRUD)(nil).SelectLatestConfigs), ctx, rowType, limit)
+}
+
 // SelectMessagesBetween mocks base method.
 func (m *MocktableCRUD) SelectMessagesBetween(ctx context.Context, request SelectMessagesBetweenRequest) (*SelectMessagesBetweenResponse, error) {
 	m.ctrl.T.Helper()
@@ -3525,6 +3583,20 @@ func (m *MockConfigStoreCRUD) EXPECT() *MockConfigStoreCRUDMockRecorder {
 	return m.recorder
 }
 
+// DeleteConfigsBefore mocks base method.
+func (m *MockConfigStoreCRUD) DeleteConfigsBefore(ctx context.Context, rowType int, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigsBefore", ctx, rowType, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConfigsBefore indicates an expected call of DeleteConfigsBefore.
+func (mr *MockConfigStoreCRUDMockRecorder) DeleteConfigsBefore(ctx, rowType, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigsBefore", reflect.TypeOf((*MockConfigStoreCRUD)(nil).DeleteConfigsBefore), ctx, rowType, version)
+}
+
 // InsertConfig mocks base method.
 func (m *MockConfigStoreCRUD) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
 	m.ctrl.T.Helper()
@@ -3553,3 +3625,18 @@ func (mr *MockConfigStoreCRUDMockRecorder) SelectLatestConfig(ctx, rowType inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLatestConfig", reflect.TypeOf((*MockConfigStoreCRUD)(nil).SelectLatestConfig), ctx, rowType)
 }
+
+// SelectLatestConfigs mocks base method.
+func (m *MockConfigStoreCRUD) SelectLatestConfigs(ctx context.Context, rowType, limit int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLatestConfigs", ctx, rowType, limit)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLatestConfigs indicates an expected call of SelectLatestConfigs.
+func (mr *MockConfigStoreCRUDMockRecorder) SelectLatestConfigs(ctx, rowType, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLatestConfigs", reflect.TypeOf((*MockConfigStoreCRUD)(nil).SelectLatestConfigs), ctx, rowType, limit)
+}
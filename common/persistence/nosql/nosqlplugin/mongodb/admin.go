@@ -57,7 +57,7 @@ func (db *mdb) SetupTestDatabase(schemaBaseDir string) error {
 	}
 	for _, cmd := range commands {
 		result := db.dbConn.RunCommand(context.Background(), cmd)
-		if result.Err() != nil {
+		if err := result.Err(); err != nil {
 			return err
 		}
 	}
@@ -65,7 +65,7 @@ func (db *mdb) SetupTestDatabase(schemaBaseDir string) error {
 }
 
 func (db *mdb) TeardownTestDatabase() error {
-	result := db.dbConn.RunCommand(context.Background(), bson.D{{"dropDatabase", 1}})
+	result := db.dbConn.RunCommand(context.Background(), bson.D{{Key: "dropDatabase", Value: 1}})
 	err := result.Err()
 	return err
 }
@@ -51,9 +51,9 @@ func (db *mdb) InsertConfig(ctx context.Context, row *persistence.InternalConfig
 }
 
 func (db *mdb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	filter := bson.D{{"rowtype", rowType}}
+	filter := bson.D{{Key: "rowtype", Value: rowType}}
 	queryOptions := options.FindOneOptions{}
-	queryOptions.SetSort(bson.D{{"version", -1}})
+	queryOptions.SetSort(bson.D{{Key: "version", Value: -1}})
 
 	collection := db.dbConn.Collection(cadence.ClusterConfigCollectionName)
 	var result cadence.ClusterConfigCollectionEntry
@@ -68,3 +68,34 @@ func (db *mdb) SelectLatestConfig(ctx context.Context, rowType int) (*persistenc
 		Values:    persistence.NewDataBlob(result.Data, common.EncodingType(result.DataEncoding)),
 	}, nil
 }
+
+func (db *mdb) SelectLatestConfigs(ctx context.Context, rowType int, limit int) ([]*persistence.InternalConfigStoreEntry, error) {
+	filter := bson.D{{Key: "rowtype", Value: rowType}}
+	queryOptions := options.Find()
+	queryOptions.SetSort(bson.D{{Key: "version", Value: -1}})
+	queryOptions.SetLimit(int64(limit))
+
+	collection := db.dbConn.Collection(cadence.ClusterConfigCollectionName)
+	var results []cadence.ClusterConfigCollectionEntry
+	if err := findAll(ctx, collection, filter, &results, queryOptions); err != nil {
+		return nil, err
+	}
+	rows := make([]*persistence.InternalConfigStoreEntry, 0, len(results))
+	for _, result := range results {
+		rows = append(rows, &persistence.InternalConfigStoreEntry{
+			RowType:   rowType,
+			Version:   result.Version,
+			Timestamp: time.Unix(result.UnixTimestampSeconds, 0),
+			Values:    persistence.NewDataBlob(result.Data, common.EncodingType(result.DataEncoding)),
+		})
+	}
+	return rows, nil
+}
+
+func (db *mdb) DeleteConfigsBefore(ctx context.Context, rowType int, version int64) error {
+	_, err := db.dbConn.Collection(cadence.ClusterConfigCollectionName).DeleteMany(ctx, bson.M{
+		"rowtype": rowType,
+		"version": bson.M{"$lt": version},
+	})
+	return err
+}
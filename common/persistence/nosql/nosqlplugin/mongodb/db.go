@@ -22,9 +22,17 @@ package mongodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -47,3 +55,96 @@ func (db *mdb) Close() {
 func (db *mdb) PluginName() string {
 	return PluginName
 }
+
+func (db *mdb) collection(name string) *mongo.Collection {
+	return db.dbConn.Collection(name)
+}
+
+// executeTransaction runs fn within a multi-document transaction. The transaction is retried by the driver on
+// transient errors (e.g. write conflicts), so fn must not have side effects other than the database operations.
+// NOTE: MongoDB only supports transactions on replica sets or sharded clusters.
+func (db *mdb) executeTransaction(ctx context.Context, fn func(sessCtx mongo.SessionContext) error) error {
+	session, err := db.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	txnOptions := options.Transaction().
+		SetReadConcern(readconcern.Snapshot()).
+		SetWriteConcern(writeconcern.New(writeconcern.WMajority()))
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	}, txnOptions)
+	return err
+}
+
+// encodeData encodes the non-significant fields of a document into a data blob
+func encodeData(value interface{}) ([]byte, string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, string(common.EncodingTypeJSON), nil
+}
+
+// decodeData decodes a data blob written by encodeData
+func decodeData(data []byte, encoding string, value interface{}) error {
+	if common.EncodingType(encoding) != common.EncodingTypeJSON {
+		return fmt.Errorf("unsupported data encoding: %v", encoding)
+	}
+	return json.Unmarshal(data, value)
+}
+
+// encodePageToken encodes the paging state of a query, which is usually the sort key of the last document returned
+func encodePageToken(token interface{}) ([]byte, error) {
+	return json.Marshal(token)
+}
+
+// decodePageToken decodes a token written by encodePageToken, it returns false if the token is empty
+func decodePageToken(data []byte, token interface{}) (bool, error) {
+	if len(data) == 0 {
+		return false, nil
+	}
+	if err := json.Unmarshal(data, token); err != nil {
+		return false, fmt.Errorf("invalid page token: %v", err)
+	}
+	return true, nil
+}
+
+// idPageToken is the paging state for queries that scan a collection by the document _id
+type idPageToken struct {
+	LastID primitive.ObjectID `json:"lastID"`
+}
+
+// applyIDPageToken adds the condition of the page token to the filter of a query sorted by _id
+func applyIDPageToken(filter bson.M, pageToken []byte) error {
+	var token idPageToken
+	ok, err := decodePageToken(pageToken, &token)
+	if err != nil || !ok {
+		return err
+	}
+	filter["_id"] = bson.M{"$gt": token.LastID}
+	return nil
+}
+
+// newIDPageToken returns the next page token of a query sorted by _id, or nil if this is the last page
+func newIDPageToken(lastID interface{}, count, pageSize int) ([]byte, error) {
+	if count == 0 || count < pageSize {
+		return nil, nil
+	}
+	id, ok := lastID.(primitive.ObjectID)
+	if !ok {
+		return nil, fmt.Errorf("unexpected document _id type %T", lastID)
+	}
+	return encodePageToken(&idPageToken{LastID: id})
+}
+
+// findAll runs the query and decodes all the documents into results, which must be a pointer to a slice
+func findAll(ctx context.Context, collection *mongo.Collection, filter interface{}, results interface{}, opts ...*options.FindOptions) error {
+	cursor, err := collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return err
+	}
+	return cursor.All(ctx, results)
+}
@@ -22,17 +22,58 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+// domainMetadataDocumentID is the _id of the single document in domain_metadata collection
+const domainMetadataDocumentID = 1
+
+var errDomainConditionFailed = nosqlplugin.NewConditionFailure("domain")
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		var existing cadence.DomainCollectionEntry
+		err := db.collection(cadence.DomainCollectionName).FindOne(sessCtx, bson.M{"name": row.Info.Name}).Decode(&existing)
+		if err == nil {
+			return &types.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain %v already exists", existing.DomainID),
+			}
+		}
+		if err != mongo.ErrNoDocuments {
+			return err
+		}
+
+		metadataNotificationVersion, err := db.SelectDomainMetadata(sessCtx)
+		if err != nil {
+			return err
+		}
+
+		doc, err := newDomainCollectionEntry(row, metadataNotificationVersion)
+		if err != nil {
+			return err
+		}
+		if _, err := db.collection(cadence.DomainCollectionName).InsertOne(sessCtx, doc); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return errDomainConditionFailed
+			}
+			return err
+		}
+		return db.updateDomainMetadata(sessCtx, metadataNotificationVersion)
+	})
 }
 
 // Update domain
@@ -40,7 +81,20 @@ func (db *mdb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		doc, err := newDomainCollectionEntry(row, row.NotificationVersion)
+		if err != nil {
+			return err
+		}
+		result, err := db.collection(cadence.DomainCollectionName).ReplaceOne(sessCtx, bson.M{"name": row.Info.Name}, doc)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return errDomainConditionFailed
+		}
+		return db.updateDomainMetadata(sessCtx, row.NotificationVersion)
+	})
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +103,24 @@ func (db *mdb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	filter := bson.M{}
+	if domainID != nil {
+		filter["domainid"] = *domainID
+	} else {
+		filter["name"] = *domainName
+	}
+
+	var doc cadence.DomainCollectionEntry
+	if err := db.collection(cadence.DomainCollectionName).FindOne(ctx, filter).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return convertToDomainRow(&doc)
 }
 
 // Get all domain data
@@ -58,7 +129,34 @@ func (db *mdb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	filter := bson.M{}
+	if err := applyIDPageToken(filter, pageToken); err != nil {
+		return nil, nil, err
+	}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(pageSize))
+	var docs []*cadence.DomainCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.DomainCollectionName), filter, &docs, queryOptions); err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*nosqlplugin.DomainRow
+	for _, doc := range docs {
+		row, err := convertToDomainRow(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	var nextPageToken []byte
+	if len(docs) > 0 {
+		var err error
+		nextPageToken, err = newIDPageToken(docs[len(docs)-1].ID, len(docs), pageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +165,90 @@ func (db *mdb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	filter := bson.M{}
+	if domainID != nil {
+		filter["domainid"] = *domainID
+	}
+	if domainName != nil {
+		filter["name"] = *domainName
+	}
+	_, err := db.collection(cadence.DomainCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 func (db *mdb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	var doc cadence.DomainMetadataCollectionEntry
+	err := db.collection(cadence.DomainMetadataCollectionName).FindOne(ctx, bson.M{"_id": domainMetadataDocumentID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// the metadata document is created along with the first domain
+			return 0, nil
+		}
+		return -1, err
+	}
+	return doc.NotificationVersion, nil
+}
+
+// updateDomainMetadata bumps the notification version of domain metadata
+// if it still equals to the given notification version
+func (db *mdb) updateDomainMetadata(
+	sessCtx mongo.SessionContext,
+	notificationVersion int64,
+) error {
+	filter := bson.M{
+		"_id":                 domainMetadataDocumentID,
+		"notificationversion": notificationVersion,
+	}
+	update := bson.M{"$set": bson.M{"notificationversion": notificationVersion + 1}}
+	// the metadata document doesn't exist before the first domain is created
+	updateOptions := options.Update().SetUpsert(notificationVersion == 0)
+
+	result, err := db.collection(cadence.DomainMetadataCollectionName).UpdateOne(sessCtx, filter, update, updateOptions)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errDomainConditionFailed
+		}
+		return err
+	}
+	if result.MatchedCount == 0 && result.UpsertedCount == 0 {
+		return errDomainConditionFailed
+	}
+	return nil
+}
+
+func newDomainCollectionEntry(
+	row *nosqlplugin.DomainRow,
+	notificationVersion int64,
+) (*cadence.DomainCollectionEntry, error) {
+	if row.Info == nil {
+		return nil, errors.New("domain info must be provided")
+	}
+	data, encoding, err := encodeData(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.DomainCollectionEntry{
+		DomainID:            row.Info.ID,
+		Name:                row.Info.Name,
+		NotificationVersion: notificationVersion,
+		Data:                data,
+		DataEncoding:        encoding,
+	}, nil
+}
+
+func convertToDomainRow(
+	doc *cadence.DomainCollectionEntry,
+) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := decodeData(doc.Data, doc.DataEncoding, row); err != nil {
+		return nil, err
+	}
+	row.NotificationVersion = doc.NotificationVersion
+	return row, nil
 }
@@ -22,31 +22,242 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+type historyNodePageToken struct {
+	LastNodeID int64 `json:"lastNodeID"`
+	LastTxnID  int64 `json:"lastTxnID"`
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *mdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	if treeRow != nil && nodeRow != nil {
+		return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+			if err := db.upsertHistoryTree(sessCtx, treeRow); err != nil {
+				return err
+			}
+			return db.upsertHistoryNode(sessCtx, nodeRow)
+		})
+	}
+	if treeRow != nil {
+		return db.upsertHistoryTree(ctx, treeRow)
+	}
+	return db.upsertHistoryNode(ctx, nodeRow)
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *mdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	query := bson.M{
+		"treeid":   filter.TreeID,
+		"branchid": filter.BranchID,
+		"nodeid":   bson.M{"$gte": filter.MinNodeID, "$lt": filter.MaxNodeID},
+	}
+	var token historyNodePageToken
+	ok, err := decodePageToken(filter.NextPageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ok {
+		// nodes are ordered by nodeid ascending and then txnid descending
+		query["$or"] = bson.A{
+			bson.M{"nodeid": bson.M{"$gt": token.LastNodeID}},
+			bson.M{"nodeid": token.LastNodeID, "txnid": bson.M{"$lt": token.LastTxnID}},
+		}
+	}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "nodeid", Value: 1}, {Key: "txnid", Value: -1}})
+	if filter.PageSize > 0 {
+		queryOptions.SetLimit(int64(filter.PageSize))
+	}
+
+	var docs []*cadence.HistoryNodeCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.HistoryNodeCollectionName), query, &docs, queryOptions); err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryNodeRow
+	for _, doc := range docs {
+		txnID := doc.TxnID
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			ShardID:      doc.ShardID,
+			TreeID:       doc.TreeID,
+			BranchID:     doc.BranchID,
+			NodeID:       doc.NodeID,
+			TxnID:        &txnID,
+			Data:         doc.Data,
+			DataEncoding: doc.DataEncoding,
+		})
+	}
+
+	var nextPageToken []byte
+	if filter.PageSize > 0 && len(docs) == filter.PageSize {
+		lastDoc := docs[len(docs)-1]
+		nextPageToken, err = encodePageToken(&historyNodePageToken{
+			LastNodeID: lastDoc.NodeID,
+			LastTxnID:  lastDoc.TxnID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *mdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	if treeFilter.BranchID == nil {
+		return fmt.Errorf("require a branchID to delete a history branch")
+	}
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		_, err := db.collection(cadence.HistoryTreeCollectionName).DeleteOne(sessCtx, bson.M{
+			"treeid":   treeFilter.TreeID,
+			"branchid": *treeFilter.BranchID,
+		})
+		if err != nil {
+			return err
+		}
+		for _, nodeFilter := range nodeFilters {
+			_, err := db.collection(cadence.HistoryNodeCollectionName).DeleteMany(sessCtx, bson.M{
+				"treeid":   nodeFilter.TreeID,
+				"branchid": nodeFilter.BranchID,
+				"nodeid":   bson.M{"$gte": nodeFilter.MinNodeID},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *mdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	filter := bson.M{}
+	if err := applyIDPageToken(filter, nextPageToken); err != nil {
+		return nil, nil, err
+	}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(pageSize))
+	var docs []*cadence.HistoryTreeCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.HistoryTreeCollectionName), filter, &docs, queryOptions); err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryTreeRow
+	for _, doc := range docs {
+		rows = append(rows, convertToHistoryTreeRow(doc))
+	}
+
+	var pagingToken []byte
+	if len(docs) > 0 {
+		var err error
+		pagingToken, err = newIDPageToken(docs[len(docs)-1].ID, len(docs), pageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, pagingToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *mdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	query := bson.M{"treeid": filter.TreeID}
+	if filter.BranchID != nil {
+		query["branchid"] = *filter.BranchID
+	}
+	var docs []*cadence.HistoryTreeCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.HistoryTreeCollectionName), query, &docs); err != nil {
+		return nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryTreeRow
+	for _, doc := range docs {
+		rows = append(rows, convertToHistoryTreeRow(doc))
+	}
+	return rows, nil
+}
+
+func (db *mdb) upsertHistoryTree(ctx context.Context, row *nosqlplugin.HistoryTreeRow) error {
+	ancestors := make([]cadence.HistoryBranchRangeEntry, 0, len(row.Ancestors))
+	for _, an := range row.Ancestors {
+		ancestors = append(ancestors, cadence.HistoryBranchRangeEntry{
+			BranchID:  an.BranchID,
+			EndNodeID: an.EndNodeID,
+		})
+	}
+	doc := &cadence.HistoryTreeCollectionEntry{
+		ShardID:         row.ShardID,
+		TreeID:          row.TreeID,
+		BranchID:        row.BranchID,
+		Ancestors:       ancestors,
+		CreateTimestamp: row.CreateTimestamp.UnixNano(),
+		Info:            row.Info,
+	}
+	_, err := db.collection(cadence.HistoryTreeCollectionName).ReplaceOne(ctx,
+		bson.M{"treeid": row.TreeID, "branchid": row.BranchID},
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+func (db *mdb) upsertHistoryNode(ctx context.Context, row *nosqlplugin.HistoryNodeRow) error {
+	var txnID int64
+	if row.TxnID != nil {
+		txnID = *row.TxnID
+	}
+	doc := &cadence.HistoryNodeCollectionEntry{
+		ShardID:      row.ShardID,
+		TreeID:       row.TreeID,
+		BranchID:     row.BranchID,
+		NodeID:       row.NodeID,
+		TxnID:        txnID,
+		Data:         row.Data,
+		DataEncoding: row.DataEncoding,
+	}
+	_, err := db.collection(cadence.HistoryNodeCollectionName).ReplaceOne(ctx,
+		bson.M{"treeid": row.TreeID, "branchid": row.BranchID, "nodeid": row.NodeID, "txnid": txnID},
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+func convertToHistoryTreeRow(doc *cadence.HistoryTreeCollectionEntry) *nosqlplugin.HistoryTreeRow {
+	ancestors := make([]*types.HistoryBranchRange, 0, len(doc.Ancestors))
+	for _, an := range doc.Ancestors {
+		ancestors = append(ancestors, &types.HistoryBranchRange{
+			BranchID:  an.BranchID,
+			EndNodeID: an.EndNodeID,
+		})
+	}
+	if len(ancestors) > 0 {
+		// sort ancestors based on EndNodeID so that we can set BeginNodeID
+		sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+		ancestors[0].BeginNodeID = int64(1)
+		for i := 1; i < len(ancestors); i++ {
+			ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+		}
+	}
+	return &nosqlplugin.HistoryTreeRow{
+		ShardID:         doc.ShardID,
+		TreeID:          doc.TreeID,
+		BranchID:        doc.BranchID,
+		Ancestors:       ancestors,
+		CreateTimestamp: time.Unix(0, doc.CreateTimestamp),
+		Info:            doc.Info,
+	}
 }
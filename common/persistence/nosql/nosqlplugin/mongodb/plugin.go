@@ -23,6 +23,7 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -59,6 +60,14 @@ func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger, dc *persist
 
 func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*mdb, error) {
 	uri := fmt.Sprintf("mongodb://%v:%v@%v:%v/", cfg.User, cfg.Password, cfg.Hosts, cfg.Port)
+	if len(cfg.ConnectAttributes) > 0 {
+		// e.g. replicaSet, authSource or tls
+		params := url.Values{}
+		for key, value := range cfg.ConnectAttributes {
+			params.Set(key, value)
+		}
+		uri += "?" + params.Encode()
+	}
 	// TODO CreateDB/CreateAdminDB don't pass in context.Context so we are using background for now
 	// It's okay because this is being called during server startup or CLI.
 	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
@@ -22,19 +22,35 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+type queueMessagePageToken struct {
+	LastMessageID int64 `json:"lastMessageID"`
+}
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).InsertOne(ctx, &cadence.QueueMessageCollectionEntry{
+		QueueType: int(row.QueueType),
+		MessageID: row.ID,
+		Payload:   row.Payload,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -42,7 +58,13 @@ func (db *mdb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	queryOptions := options.FindOne().SetSort(bson.D{{Key: "messageid", Value: -1}})
+	var doc cadence.QueueMessageCollectionEntry
+	err := db.collection(cadence.QueueMessageCollectionName).FindOne(ctx, bson.M{"queuetype": queueType}, queryOptions).Decode(&doc)
+	if err != nil {
+		return 0, err
+	}
+	return doc.MessageID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -52,7 +74,24 @@ func (db *mdb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	filter := bson.M{
+		"queuetype": queueType,
+		"messageid": bson.M{"$gt": exclusiveBeginMessageID},
+	}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "messageid", Value: 1}}).SetLimit(int64(maxRows))
+	var docs []*cadence.QueueMessageCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.QueueMessageCollectionName), filter, &docs, queryOptions); err != nil {
+		return nil, err
+	}
+	var result []*nosqlplugin.QueueMessageRow
+	for _, doc := range docs {
+		result = append(result, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        doc.MessageID,
+			Payload:   doc.Payload,
+		})
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -60,7 +99,46 @@ func (db *mdb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	beginMessageID := request.ExclusiveBeginMessageID
+	var token queueMessagePageToken
+	ok, err := decodePageToken(request.NextPageToken, &token)
+	if err != nil {
+		return nil, err
+	}
+	if ok && token.LastMessageID > beginMessageID {
+		beginMessageID = token.LastMessageID
+	}
+
+	filter := bson.M{
+		"queuetype": request.QueueType,
+		"messageid": bson.M{"$gt": beginMessageID, "$lte": request.InclusiveEndMessageID},
+	}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "messageid", Value: 1}})
+	if request.PageSize > 0 {
+		queryOptions.SetLimit(int64(request.PageSize))
+	}
+	var docs []*cadence.QueueMessageCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.QueueMessageCollectionName), filter, &docs, queryOptions); err != nil {
+		return nil, err
+	}
+
+	response := &nosqlplugin.SelectMessagesBetweenResponse{}
+	for _, doc := range docs {
+		response.Rows = append(response.Rows, nosqlplugin.QueueMessageRow{
+			QueueType: request.QueueType,
+			ID:        doc.MessageID,
+			Payload:   doc.Payload,
+		})
+	}
+	if request.PageSize > 0 && len(docs) == request.PageSize {
+		response.NextPageToken, err = encodePageToken(&queueMessagePageToken{
+			LastMessageID: docs[len(docs)-1].MessageID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -69,7 +147,11 @@ func (db *mdb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).DeleteMany(ctx, bson.M{
+		"queuetype": queueType,
+		"messageid": bson.M{"$lt": exclusiveBeginMessageID},
+	})
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -79,7 +161,11 @@ func (db *mdb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).DeleteMany(ctx, bson.M{
+		"queuetype": queueType,
+		"messageid": bson.M{"$gt": exclusiveBeginMessageID, "$lte": inclusiveEndMessageID},
+	})
+	return err
 }
 
 // Delete one message
@@ -88,7 +174,11 @@ func (db *mdb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).DeleteOne(ctx, bson.M{
+		"queuetype": queueType,
+		"messageid": messageID,
+	})
+	return err
 }
 
 // Insert an empty metadata row, starting from a version
@@ -97,8 +187,16 @@ func (db *mdb) InsertQueueMetadata(
 	queueType persistence.QueueType,
 	version int64,
 ) error {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil
+	_, err := db.collection(cadence.QueueMetadataCollectionName).InsertOne(ctx, &cadence.QueueMetadataCollectionEntry{
+		QueueType:        int(queueType),
+		ClusterAckLevels: map[string]int64{},
+		Version:          version,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// it's ok if the record exists already.
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -108,7 +206,17 @@ func (db *mdb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	result, err := db.collection(cadence.QueueMetadataCollectionName).UpdateOne(ctx,
+		bson.M{"queuetype": row.QueueType, "version": row.Version - 1},
+		bson.M{"$set": bson.M{"clusteracklevels": row.ClusterAckLevels, "version": row.Version}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return nil
 }
 
 // Read a QueueMetadata
@@ -116,13 +224,25 @@ func (db *mdb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil, nil
+	var doc cadence.QueueMetadataCollectionEntry
+	err := db.collection(cadence.QueueMetadataCollectionName).FindOne(ctx, bson.M{"queuetype": queueType}).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	// if record exist but ackLevels is empty, we initialize the map
+	if doc.ClusterAckLevels == nil {
+		doc.ClusterAckLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: doc.ClusterAckLevels,
+		Version:          doc.Version,
+	}, nil
 }
 
 func (db *mdb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.collection(cadence.QueueMessageCollectionName).CountDocuments(ctx, bson.M{"queuetype": queueType})
 }
@@ -22,32 +22,134 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
-	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	log.Warn("not implemented...ignore the error for testing...")
-	return nil
+	doc, err := newShardCollectionEntry(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.ShardCollectionName).InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.newConflictedShardError(ctx, row.ShardID, "InsertShard operation failed because shard already exists")
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *mdb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	var doc cadence.ShardCollectionEntry
+	err := db.collection(cadence.ShardCollectionName).FindOne(ctx, bson.M{"shardid": shardID}).Decode(&doc)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	info := &nosqlplugin.ShardRow{}
+	if err := decodeData(doc.Data, doc.DataEncoding, info); err != nil {
+		return 0, nil, err
+	}
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return doc.RangeID, info, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(ctx,
+		bson.M{"shardid": shardID, "rangeid": previousRangeID},
+		bson.M{"$set": bson.M{"rangeid": rangeID}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.newConflictedShardError(ctx, shardID, fmt.Sprintf("UpdateRangeID operation failed, previous rangeID: %v", previousRangeID))
+	}
+	return nil
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	doc, err := newShardCollectionEntry(row)
+	if err != nil {
+		return err
+	}
+	result, err := db.collection(cadence.ShardCollectionName).ReplaceOne(ctx,
+		bson.M{"shardid": row.ShardID, "rangeid": previousRangeID},
+		doc,
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.newConflictedShardError(ctx, row.ShardID, fmt.Sprintf("UpdateShard operation failed, previous rangeID: %v", previousRangeID))
+	}
+	return nil
+}
+
+func newShardCollectionEntry(row *nosqlplugin.ShardRow) (*cadence.ShardCollectionEntry, error) {
+	info := *row
+	info.UpdatedAt = time.Now()
+	data, encoding, err := encodeData(&info)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.ShardCollectionEntry{
+		ShardID:      row.ShardID,
+		RangeID:      row.RangeID,
+		Data:         data,
+		DataEncoding: encoding,
+	}, nil
+}
+
+// newConflictedShardError reads the current rangeID of the shard to build the condition failure,
+// because MongoDB doesn't return the existing document when a conditional write is not applied
+func (db *mdb) newConflictedShardError(ctx context.Context, shardID int, details string) error {
+	rangeID, err := db.selectShardRangeID(ctx, shardID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			rangeID = -1
+		} else {
+			return err
+		}
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: details,
+	}
+}
+
+func (db *mdb) selectShardRangeID(ctx context.Context, shardID int) (int64, error) {
+	var doc cadence.ShardCollectionEntry
+	err := db.collection(cadence.ShardCollectionName).FindOne(ctx, bson.M{"shardid": shardID}).Decode(&doc)
+	if err != nil {
+		return 0, err
+	}
+	return doc.RangeID, nil
 }
@@ -22,20 +22,46 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	// fencingCounterField is increased on a tasklist or shard document within a transaction that uses its rangeID as condition,
+	// so that any concurrent change to the rangeID will conflict with the transaction
+	fencingCounterField = "fencingcounter"
 )
 
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	var doc cadence.TaskListCollectionEntry
+	err := db.collection(cadence.TaskListCollectionName).FindOne(ctx, taskListKeyFilter(filter)).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return convertToTaskListRow(&doc)
 }
 
 // InsertTaskList insert a single tasklist row
-// Return IsConditionFailedError if the row already exists, and also the existing row
+// Return TaskOperationConditionFailure if the row already exists
 func (db *mdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	doc, err := newTaskListCollectionEntry(row, 0)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.TaskListCollectionName).InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.newTaskListConditionFailure(ctx, filterOfTaskListRow(row), "InsertTaskList operation failed because tasklist already exists")
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,32 +71,85 @@ func (db *mdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, 0, row, previousRangeID)
 }
 
-// UpdateTaskList updates a single tasklist row, and set an TTL on the record
+// UpdateTaskListWithTTL updates a single tasklist row, and set an TTL on the record
 // Return TaskOperationConditionFailure if the condition doesn't meet
-// Ignore TTL if it's not supported, which becomes exactly the same as UpdateTaskList, but ListTaskList must be
-// implemented for TaskListScavenger
 func (db *mdb) UpdateTaskListWithTTL(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, ttlSeconds, row, previousRangeID)
+}
+
+func (db *mdb) updateTaskList(
+	ctx context.Context,
+	ttlSeconds int64,
+	row *nosqlplugin.TaskListRow,
+	previousRangeID int64,
+) error {
+	doc, err := newTaskListCollectionEntry(row, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	filter := taskListKeyFilter(filterOfTaskListRow(row))
+	filter["rangeid"] = previousRangeID
+	result, err := db.collection(cadence.TaskListCollectionName).ReplaceOne(ctx, filter, doc)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.newTaskListConditionFailure(ctx, filterOfTaskListRow(row), fmt.Sprintf("UpdateTaskList operation failed, previous rangeID: %v", previousRangeID))
+	}
+	return nil
 }
 
 // ListTaskList returns all tasklists.
-// Noop if TTL is already implemented in other methods
 func (db *mdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	filter := bson.M{}
+	if err := applyIDPageToken(filter, nextPageToken); err != nil {
+		return nil, err
+	}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(pageSize))
+
+	var docs []*cadence.TaskListCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.TaskListCollectionName), filter, &docs, queryOptions); err != nil {
+		return nil, err
+	}
+	result := &nosqlplugin.ListTaskListResult{}
+	for _, doc := range docs {
+		row, err := convertToTaskListRow(doc)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	if len(docs) > 0 {
+		token, err := newIDPageToken(docs[len(docs)-1].ID, len(docs), pageSize)
+		if err != nil {
+			return nil, err
+		}
+		result.NextPageToken = token
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *mdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	queryFilter := taskListKeyFilter(filter)
+	queryFilter["rangeid"] = previousRangeID
+	result, err := db.collection(cadence.TaskListCollectionName).DeleteOne(ctx, queryFilter)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return db.newTaskListConditionFailure(ctx, filter, fmt.Sprintf("DeleteTaskList operation failed, previous rangeID: %v", previousRangeID))
+	}
+	return nil
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,18 +159,164 @@ func (db *mdb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	docs := make([]interface{}, 0, len(tasksToInsert))
+	for _, task := range tasksToInsert {
+		doc, err := newTaskCollectionEntry(tasklistCondition, task)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+
+	taskListFilter := filterOfTaskListRow(tasklistCondition)
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		// The following update is used to ensure that range_id didn't change
+		filter := taskListKeyFilter(taskListFilter)
+		filter["rangeid"] = tasklistCondition.RangeID
+		result, err := db.collection(cadence.TaskListCollectionName).UpdateOne(sessCtx, filter, bson.M{"$inc": bson.M{fencingCounterField: 1}})
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return db.newTaskListConditionFailure(sessCtx, taskListFilter, fmt.Sprintf("InsertTasks operation failed, rangeID: %v", tasklistCondition.RangeID))
+		}
+		if len(docs) == 0 {
+			return nil
+		}
+		_, err = db.collection(cadence.TaskCollectionName).InsertMany(sessCtx, docs)
+		return err
+	})
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *mdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	queryFilter := taskListKeyFilter(&filter.TaskListFilter)
+	queryFilter["taskid"] = bson.M{"$gt": filter.MinTaskID, "$lte": filter.MaxTaskID}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "taskid", Value: 1}})
+	if filter.BatchSize > 0 {
+		queryOptions.SetLimit(int64(filter.BatchSize))
+	}
+
+	var docs []*cadence.TaskCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.TaskCollectionName), queryFilter, &docs, queryOptions); err != nil {
+		return nil, err
+	}
+	rows := make([]*nosqlplugin.TaskRow, 0, len(docs))
+	for _, doc := range docs {
+		row := &nosqlplugin.TaskRow{}
+		if err := decodeData(doc.Data, doc.DataEncoding, row); err != nil {
+			return nil, err
+		}
+		row.DomainID = doc.DomainID
+		row.TaskListName = doc.TaskListName
+		row.TaskListType = doc.TaskListType
+		row.TaskID = doc.TaskID
+		rows = append(rows, row)
+	}
+	return rows, nil
 }
 
-// DeleteTask delete a batch tasks that taskIDs less than the row
-// If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
+// RangeDeleteTasks delete a batch of tasks, and returns the number of rows deleted
 func (db *mdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	maxTaskID := filter.MaxTaskID
+	if filter.BatchSize > 0 {
+		// MongoDB doesn't support limit on delete, so find out the largest taskID within the batch first
+		tasks, err := db.SelectTasks(ctx, filter)
+		if err != nil {
+			return 0, err
+		}
+		if len(tasks) == 0 {
+			return 0, nil
+		}
+		maxTaskID = tasks[len(tasks)-1].TaskID
+	}
+
+	queryFilter := taskListKeyFilter(&filter.TaskListFilter)
+	queryFilter["taskid"] = bson.M{"$gt": filter.MinTaskID, "$lte": maxTaskID}
+	result, err := db.collection(cadence.TaskCollectionName).DeleteMany(ctx, queryFilter)
+	if err != nil {
+		return 0, err
+	}
+	return int(result.DeletedCount), nil
+}
+
+func (db *mdb) newTaskListConditionFailure(ctx context.Context, filter *nosqlplugin.TaskListFilter, details string) error {
+	var doc cadence.TaskListCollectionEntry
+	err := db.collection(cadence.TaskListCollectionName).FindOne(ctx, taskListKeyFilter(filter)).Decode(&doc)
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			return err
+		}
+		doc.RangeID = -1
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: doc.RangeID,
+		Details: fmt.Sprintf("%v, actual rangeID: %v", details, doc.RangeID),
+	}
+}
+
+func taskListKeyFilter(filter *nosqlplugin.TaskListFilter) bson.M {
+	return bson.M{
+		"domainid":     filter.DomainID,
+		"tasklistname": filter.TaskListName,
+		"tasklisttype": filter.TaskListType,
+	}
+}
+
+func filterOfTaskListRow(row *nosqlplugin.TaskListRow) *nosqlplugin.TaskListFilter {
+	return &nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}
+}
+
+func newTaskListCollectionEntry(row *nosqlplugin.TaskListRow, ttlSeconds int64) (*cadence.TaskListCollectionEntry, error) {
+	data, encoding, err := encodeData(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.TaskListCollectionEntry{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+		RangeID:      row.RangeID,
+		Data:         data,
+		DataEncoding: encoding,
+		ExpireAt:     getExpireAt(ttlSeconds),
+	}, nil
+}
+
+func convertToTaskListRow(doc *cadence.TaskListCollectionEntry) (*nosqlplugin.TaskListRow, error) {
+	row := &nosqlplugin.TaskListRow{}
+	if err := decodeData(doc.Data, doc.DataEncoding, row); err != nil {
+		return nil, err
+	}
+	row.RangeID = doc.RangeID
+	return row, nil
+}
+
+func newTaskCollectionEntry(taskList *nosqlplugin.TaskListRow, task *nosqlplugin.TaskRowForInsert) (*cadence.TaskCollectionEntry, error) {
+	data, encoding, err := encodeData(&task.TaskRow)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.TaskCollectionEntry{
+		DomainID:     taskList.DomainID,
+		TaskListName: taskList.TaskListName,
+		TaskListType: taskList.TaskListType,
+		TaskID:       task.TaskID,
+		Data:         data,
+		DataEncoding: encoding,
+		ExpireAt:     getExpireAt(int64(task.TTLSeconds)),
+	}, nil
+}
+
+// getExpireAt returns the expiry time for the TTL index, or nil if ttlSeconds is not positive
+func getExpireAt(ttlSeconds int64) *time.Time {
+	if ttlSeconds <= 0 {
+		return nil
+	}
+	expireAt := time.Now().Add(time.Duration(ttlSeconds) * time.Second)
+	return &expireAt
 }
@@ -22,16 +22,31 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+type visibilityPageToken struct {
+	LastSortTime int64  `json:"lastSortTime"`
+	LastRunID    string `json:"lastRunID"`
+}
+
 func (db *mdb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	doc, err := newVisibilityCollectionEntry(row.DomainID, &row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.upsertVisibility(ctx, doc)
 }
 
 func (db *mdb) UpdateVisibility(
@@ -39,26 +54,181 @@ func (db *mdb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	// a single document is kept for each run, so UpdateOpenToClose and UpdateCloseToOpen are only about the isclosed field
+	doc, err := newVisibilityCollectionEntry(row.DomainID, &row.VisibilityRow, row.Status != nil, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.upsertVisibility(ctx, doc)
 }
 
 func (db *mdb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	sortField := "starttime"
+	if filter.SortType == nosqlplugin.SortByClosedTime {
+		sortField = "closetime"
+	}
+
+	request := filter.ListRequest
+	query := bson.M{
+		"domainid": request.DomainUUID,
+		sortField: bson.M{
+			"$gte": request.EarliestTime.UnixNano(),
+			"$lte": request.LatestTime.UnixNano(),
+		},
+	}
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen:
+		query["isclosed"] = false
+	case nosqlplugin.AllClosed:
+		query["isclosed"] = true
+	case nosqlplugin.OpenByWorkflowType:
+		query["isclosed"] = false
+		query["workflowtype"] = filter.WorkflowType
+	case nosqlplugin.ClosedByWorkflowType:
+		query["isclosed"] = true
+		query["workflowtype"] = filter.WorkflowType
+	case nosqlplugin.OpenByWorkflowID:
+		query["isclosed"] = false
+		query["workflowid"] = filter.WorkflowID
+	case nosqlplugin.ClosedByWorkflowID:
+		query["isclosed"] = true
+		query["workflowid"] = filter.WorkflowID
+	case nosqlplugin.ClosedByClosedStatus:
+		query["isclosed"] = true
+		query["closestatus"] = filter.CloseStatus
+	default:
+		return nil, fmt.Errorf("unknown visibility filter type %v", filter.FilterType)
+	}
+
+	var token visibilityPageToken
+	ok, err := decodePageToken(request.NextPageToken, &token)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		// documents are ordered by the sort time descending and then runid ascending
+		query["$or"] = bson.A{
+			bson.M{sortField: bson.M{"$lt": token.LastSortTime}},
+			bson.M{sortField: token.LastSortTime, "runid": bson.M{"$gt": token.LastRunID}},
+		}
+	}
+
+	queryOptions := options.Find().SetSort(bson.D{{Key: sortField, Value: -1}, {Key: "runid", Value: 1}})
+	if request.PageSize > 0 {
+		queryOptions.SetLimit(int64(request.PageSize))
+	}
+	var docs []*cadence.VisibilityCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.VisibilityCollectionName), query, &docs, queryOptions); err != nil {
+		return nil, err
+	}
+
+	response := &nosqlplugin.SelectVisibilityResponse{}
+	for _, doc := range docs {
+		row, err := convertToVisibilityRow(doc)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	if request.PageSize > 0 && len(docs) == request.PageSize {
+		lastDoc := docs[len(docs)-1]
+		lastSortTime := lastDoc.StartTime
+		if filter.SortType == nosqlplugin.SortByClosedTime {
+			lastSortTime = lastDoc.CloseTime
+		}
+		response.NextPageToken, err = encodePageToken(&visibilityPageToken{
+			LastSortTime: lastSortTime,
+			LastRunID:    lastDoc.RunID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
 }
 
 func (db *mdb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.VisibilityCollectionName).DeleteOne(ctx, bson.M{
+		"domainid":   domainID,
+		"workflowid": workflowID,
+		"runid":      runID,
+	})
+	return err
 }
 
 func (db *mdb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	var doc cadence.VisibilityCollectionEntry
+	err := db.collection(cadence.VisibilityCollectionName).FindOne(ctx, bson.M{
+		"domainid":   domainID,
+		"workflowid": workflowID,
+		"runid":      runID,
+		"isclosed":   true,
+	}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return convertToVisibilityRow(&doc)
+}
+
+func (db *mdb) upsertVisibility(
+	ctx context.Context,
+	doc *cadence.VisibilityCollectionEntry,
+) error {
+	_, err := db.collection(cadence.VisibilityCollectionName).ReplaceOne(ctx,
+		bson.M{"domainid": doc.DomainID, "workflowid": doc.WorkflowID, "runid": doc.RunID},
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+func newVisibilityCollectionEntry(
+	domainID string,
+	row *nosqlplugin.VisibilityRow,
+	isClosed bool,
+	ttlSeconds int64,
+) (*cadence.VisibilityCollectionEntry, error) {
+	data, encoding, err := encodeData(row)
+	if err != nil {
+		return nil, err
+	}
+	doc := &cadence.VisibilityCollectionEntry{
+		DomainID:     domainID,
+		WorkflowID:   row.WorkflowID,
+		RunID:        row.RunID,
+		WorkflowType: row.TypeName,
+		StartTime:    row.StartTime.UnixNano(),
+		IsClosed:     isClosed,
+		Data:         data,
+		DataEncoding: encoding,
+		ExpireAt:     getExpireAt(ttlSeconds),
+	}
+	if isClosed {
+		doc.CloseTime = row.CloseTime.UnixNano()
+		doc.CloseStatus = int32(*row.Status)
+	}
+	return doc, nil
+}
+
+func convertToVisibilityRow(
+	doc *cadence.VisibilityCollectionEntry,
+) (*nosqlplugin.VisibilityRow, error) {
+	row := &nosqlplugin.VisibilityRow{}
+	if err := decodeData(doc.Data, doc.DataEncoding, row); err != nil {
+		return nil, err
+	}
+	row.DomainID = doc.DomainID
+	return row, nil
 }
@@ -18,15 +18,20 @@
 // LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
-
 package mongodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*mdb)(nil)
@@ -41,7 +46,28 @@ func (db *mdb) InsertWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		err := db.assertShardRangeID(sessCtx, shardCondition)
+		if err != nil {
+			return err
+		}
+
+		err = db.createOrUpdateCurrentWorkflow(sessCtx, shardID, domainID, workflowID, currentWorkflowRequest)
+		if err != nil {
+			return err
+		}
+
+		err = db.createWorkflowExecutionWithMergeMaps(sessCtx, shardID, domainID, workflowID, execution, shardCondition)
+		if err != nil {
+			return err
+		}
+
+		return db.createTasks(sessCtx, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	})
 }
 
 func (db *mdb) UpdateWorkflowExecutionWithTasks(
@@ -56,105 +82,404 @@ func (db *mdb) UpdateWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		err := db.assertShardRangeID(sessCtx, shardCondition)
+		if err != nil {
+			return err
+		}
+
+		err = db.createOrUpdateCurrentWorkflow(sessCtx, shardID, domainID, workflowID, currentWorkflowRequest)
+		if err != nil {
+			return err
+		}
+
+		if mutatedExecution != nil {
+			err = db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(sessCtx, shardID, domainID, workflowID, mutatedExecution)
+			if err != nil {
+				return err
+			}
+		}
+
+		if insertedExecution != nil {
+			err = db.createWorkflowExecutionWithMergeMaps(sessCtx, shardID, domainID, workflowID, insertedExecution, shardCondition)
+			if err != nil {
+				return err
+			}
+		}
+
+		if resetExecution != nil {
+			err = db.resetWorkflowExecutionAndMapsAndEventBuffer(sessCtx, shardID, domainID, workflowID, resetExecution)
+			if err != nil {
+				return err
+			}
+		}
+
+		return db.createTasks(sessCtx, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	})
 }
 
 func (db *mdb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	doc, err := db.selectCurrentWorkflow(ctx, shardID, domainID, workflowID)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            doc.RunID,
+		State:            doc.State,
+		CloseStatus:      doc.CloseStatus,
+		CreateRequestID:  doc.CreateRequestID,
+		LastWriteVersion: doc.LastWriteVersion,
+	}, nil
 }
 
 func (db *mdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	var doc cadence.WorkflowExecutionCollectionEntry
+	err := db.collection(cadence.WorkflowExecutionCollectionName).FindOne(ctx,
+		workflowExecutionFilter(shardID, domainID, workflowID, runID),
+	).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return convertToWorkflowExecution(&doc)
 }
 
 func (db *mdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	filter := currentWorkflowFilter(shardID, domainID, workflowID)
+	filter["runid"] = currentRunIDCondition
+	_, err := db.collection(cadence.CurrentWorkflowCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 func (db *mdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	_, err := db.collection(cadence.WorkflowExecutionCollectionName).DeleteOne(ctx,
+		workflowExecutionFilter(shardID, domainID, workflowID, runID),
+	)
+	return err
 }
 
 func (db *mdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	filter := bson.M{"shardid": shardID}
+	if err := applyIDPageToken(filter, pageToken); err != nil {
+		return nil, nil, err
+	}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(pageSize))
+	var docs []*cadence.CurrentWorkflowCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.CurrentWorkflowCollectionName), filter, &docs, queryOptions); err != nil {
+		return nil, nil, err
+	}
+
+	var executions []*persistence.CurrentWorkflowExecution
+	for _, doc := range docs {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     doc.DomainID,
+			WorkflowID:   doc.WorkflowID,
+			RunID:        doc.RunID,
+			State:        doc.State,
+			CurrentRunID: doc.RunID,
+		})
+	}
+
+	var nextPageToken []byte
+	if len(docs) > 0 {
+		var err error
+		nextPageToken, err = newIDPageToken(docs[len(docs)-1].ID, len(docs), pageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	filter := bson.M{"shardid": shardID}
+	if err := applyIDPageToken(filter, pageToken); err != nil {
+		return nil, nil, err
+	}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(pageSize)).
+		SetProjection(bson.M{"_id": 1, "data": 1, "dataencoding": 1})
+	var docs []*cadence.WorkflowExecutionCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.WorkflowExecutionCollectionName), filter, &docs, queryOptions); err != nil {
+		return nil, nil, err
+	}
+
+	var executions []*persistence.InternalListConcreteExecutionsEntity
+	for _, doc := range docs {
+		data := &workflowExecutionData{}
+		if err := decodeData(doc.Data, doc.DataEncoding, data); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+
+	var nextPageToken []byte
+	if len(docs) > 0 {
+		var err error
+		nextPageToken, err = newIDPageToken(docs[len(docs)-1].ID, len(docs), pageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	count, err := db.collection(cadence.WorkflowExecutionCollectionName).CountDocuments(ctx,
+		workflowExecutionFilter(shardID, domainID, workflowID, runID),
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
 
 func (db *mdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.TransferTask, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.selectShardTasksOrderByTaskID(ctx, cadence.TransferTaskCollectionName, bson.M{"shardid": shardID},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []*nosqlplugin.TransferTask
+	for _, doc := range docs {
+		task := &nosqlplugin.TransferTask{}
+		if err := decodeData(doc.Data, doc.DataEncoding, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TransferTaskCollectionName).DeleteOne(ctx, bson.M{
+		"shardid": shardID,
+		"taskid":  taskID,
+	})
+	return err
 }
 
 func (db *mdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TransferTaskCollectionName).DeleteMany(ctx, bson.M{
+		"shardid": shardID,
+		"taskid":  bson.M{"$gt": exclusiveBeginTaskID, "$lte": inclusiveEndTaskID},
+	})
+	return err
 }
 
 func (db *mdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.TimerTask, []byte, error) {
-	panic("TODO")
+	filter := bson.M{
+		"shardid":             shardID,
+		"visibilitytimestamp": bson.M{"$gte": inclusiveMinTime.UnixNano(), "$lt": exclusiveMaxTime.UnixNano()},
+	}
+	var token timerTaskPageToken
+	ok, err := decodePageToken(pageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ok {
+		filter["$or"] = bson.A{
+			bson.M{"visibilitytimestamp": bson.M{"$gt": token.LastVisibilityTimestamp}},
+			bson.M{"visibilitytimestamp": token.LastVisibilityTimestamp, "taskid": bson.M{"$gt": token.LastTaskID}},
+		}
+	}
+	queryOptions := options.Find().SetSort(bson.D{{Key: "visibilitytimestamp", Value: 1}, {Key: "taskid", Value: 1}}).SetLimit(int64(pageSize))
+	var docs []*cadence.TimerTaskCollectionEntry
+	if err := findAll(ctx, db.collection(cadence.TimerTaskCollectionName), filter, &docs, queryOptions); err != nil {
+		return nil, nil, err
+	}
+
+	var timers []*nosqlplugin.TimerTask
+	for _, doc := range docs {
+		timer := &nosqlplugin.TimerTask{}
+		if err := decodeData(doc.Data, doc.DataEncoding, timer); err != nil {
+			return nil, nil, err
+		}
+		timers = append(timers, timer)
+	}
+
+	var nextPageToken []byte
+	if len(docs) > 0 && len(docs) == pageSize {
+		lastDoc := docs[len(docs)-1]
+		nextPageToken, err = encodePageToken(&timerTaskPageToken{
+			LastVisibilityTimestamp: lastDoc.VisibilityTimestamp,
+			LastTaskID:              lastDoc.TaskID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return timers, nextPageToken, nil
 }
 
 func (db *mdb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TimerTaskCollectionName).DeleteOne(ctx, bson.M{
+		"shardid":             shardID,
+		"visibilitytimestamp": visibilityTimestamp.UnixNano(),
+		"taskid":              taskID,
+	})
+	return err
 }
 
 func (db *mdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TimerTaskCollectionName).DeleteMany(ctx, bson.M{
+		"shardid":             shardID,
+		"visibilitytimestamp": bson.M{"$gte": inclusiveMinTime.UnixNano(), "$lt": exclusiveMaxTime.UnixNano()},
+	})
+	return err
 }
 
 func (db *mdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.selectShardTasksOrderByTaskID(ctx, cadence.ReplicationTaskCollectionName, bson.M{"shardid": shardID},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := convertToReplicationTasks(docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationTaskCollectionName).DeleteOne(ctx, bson.M{
+		"shardid": shardID,
+		"taskid":  taskID,
+	})
+	return err
 }
 
 func (db *mdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationTaskCollectionName).DeleteMany(ctx, bson.M{
+		"shardid": shardID,
+		"taskid":  bson.M{"$lte": inclusiveEndTaskID},
+	})
+	return err
 }
 
-func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, shardCondition nosqlplugin.ShardCondition) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	return db.executeTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		rangeID, err := db.fenceShardRangeID(sessCtx, &shardCondition)
+		if err != nil {
+			return err
+		}
+		if rangeID != shardCondition.RangeID {
+			return &nosqlplugin.ShardOperationConditionFailure{
+				RangeID: rangeID,
+			}
+		}
+		return db.createReplicationTasks(sessCtx, shardCondition.ShardID, tasks)
+	})
 }
 
 func (db *mdb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.CrossClusterTask, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.selectShardTasksOrderByTaskID(ctx, cadence.CrossClusterTaskCollectionName, bson.M{"shardid": shardID, "cluster": targetCluster},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []*nosqlplugin.CrossClusterTask
+	for _, doc := range docs {
+		task := &nosqlplugin.CrossClusterTask{}
+		if err := decodeData(doc.Data, doc.DataEncoding, &task.TransferTask); err != nil {
+			return nil, nil, err
+		}
+		task.TargetCluster = targetCluster
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.CrossClusterTaskCollectionName).DeleteOne(ctx, bson.M{
+		"shardid": shardID,
+		"cluster": targetCluster,
+		"taskid":  taskID,
+	})
+	return err
 }
 
 func (db *mdb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, targetCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.CrossClusterTaskCollectionName).DeleteMany(ctx, bson.M{
+		"shardid": shardID,
+		"cluster": targetCluster,
+		"taskid":  bson.M{"$gt": exclusiveBeginTaskID, "$lte": inclusiveEndTaskID},
+	})
+	return err
 }
 
 func (db *mdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
-	panic("TODO")
+	doc, err := newShardTaskCollectionEntry(shardID, sourceCluster, task.TaskID, &task)
+	if err != nil {
+		return err
+	}
+	// the same as Cassandra, inserting an existing task overrides it
+	_, err = db.collection(cadence.ReplicationDLQTaskCollectionName).ReplaceOne(ctx,
+		bson.M{"shardid": shardID, "cluster": sourceCluster, "taskid": task.TaskID},
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	return err
 }
 
 func (db *mdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.selectShardTasksOrderByTaskID(ctx, cadence.ReplicationDLQTaskCollectionName, bson.M{"shardid": shardID, "cluster": sourceCluster},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := convertToReplicationTasks(docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	return db.collection(cadence.ReplicationDLQTaskCollectionName).CountDocuments(ctx, bson.M{
+		"shardid": shardID,
+		"cluster": sourceCluster,
+	})
 }
 
 func (db *mdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationDLQTaskCollectionName).DeleteOne(ctx, bson.M{
+		"shardid": shardID,
+		"cluster": sourceCluster,
+		"taskid":  taskID,
+	})
+	return err
 }
 
 func (db *mdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationDLQTaskCollectionName).DeleteMany(ctx, bson.M{
+		"shardid": shardID,
+		"cluster": sourceCluster,
+		"taskid":  bson.M{"$gt": exclusiveBeginTaskID, "$lte": inclusiveEndTaskID},
+	})
+	return err
 }
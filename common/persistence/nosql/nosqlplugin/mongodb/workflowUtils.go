@@ -0,0 +1,814 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+// workflowExecutionData is the data blob of a workflow_execution document
+type workflowExecutionData struct {
+	ExecutionInfo    *persistence.InternalWorkflowExecutionInfo
+	VersionHistories *persistence.DataBlob
+	Checksum         *checksum.Checksum
+	LastWriteVersion int64
+}
+
+type taskIDPageToken struct {
+	LastTaskID int64 `json:"lastTaskID"`
+}
+
+type timerTaskPageToken struct {
+	LastVisibilityTimestamp int64 `json:"lastVisibilityTimestamp"`
+	LastTaskID              int64 `json:"lastTaskID"`
+}
+
+// fenceShardRangeID bumps the fencing counter of the shard document if its rangeID matches the condition,
+// so that any concurrent change of the shard rangeID conflicts with the current transaction.
+// It returns the actual rangeID of the shard, or -1 if the shard doesn't exist.
+func (db *mdb) fenceShardRangeID(sessCtx mongo.SessionContext, shardCondition *nosqlplugin.ShardCondition) (int64, error) {
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(sessCtx,
+		bson.M{"shardid": shardCondition.ShardID, "rangeid": shardCondition.RangeID},
+		bson.M{"$inc": bson.M{fencingCounterField: 1}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if result.MatchedCount > 0 {
+		return shardCondition.RangeID, nil
+	}
+
+	rangeID, err := db.selectShardRangeID(sessCtx, shardCondition.ShardID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return -1, nil
+		}
+		return 0, err
+	}
+	return rangeID, nil
+}
+
+func (db *mdb) assertShardRangeID(sessCtx mongo.SessionContext, shardCondition *nosqlplugin.ShardCondition) error {
+	rangeID, err := db.fenceShardRangeID(sessCtx, shardCondition)
+	if err != nil {
+		return err
+	}
+	if rangeID != shardCondition.RangeID {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(rangeID),
+		}
+	}
+	return nil
+}
+
+func currentWorkflowFilter(shardID int, domainID, workflowID string) bson.M {
+	return bson.M{
+		"shardid":    shardID,
+		"domainid":   domainID,
+		"workflowid": workflowID,
+	}
+}
+
+func workflowExecutionFilter(shardID int, domainID, workflowID, runID string) bson.M {
+	return bson.M{
+		"shardid":    shardID,
+		"domainid":   domainID,
+		"workflowid": workflowID,
+		"runid":      runID,
+	}
+}
+
+func (db *mdb) selectCurrentWorkflow(
+	ctx context.Context,
+	shardID int,
+	domainID string,
+	workflowID string,
+) (*cadence.CurrentWorkflowCollectionEntry, error) {
+	var doc cadence.CurrentWorkflowCollectionEntry
+	err := db.collection(cadence.CurrentWorkflowCollectionName).FindOne(ctx, currentWorkflowFilter(shardID, domainID, workflowID)).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+func (db *mdb) createOrUpdateCurrentWorkflow(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		existing, err := db.selectCurrentWorkflow(sessCtx, shardID, domainID, workflowID)
+		if err == nil {
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v",
+				workflowID, existing.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  existing.CreateRequestID,
+					RunID:            existing.RunID,
+					State:            existing.State,
+					CloseStatus:      existing.CloseStatus,
+					LastWriteVersion: existing.LastWriteVersion,
+				},
+			}
+		}
+		if !db.IsNotFoundError(err) {
+			return err
+		}
+		_, err = db.collection(cadence.CurrentWorkflowCollectionName).InsertOne(sessCtx, &cadence.CurrentWorkflowCollectionEntry{
+			ShardID:          shardID,
+			DomainID:         domainID,
+			WorkflowID:       workflowID,
+			RunID:            request.Row.RunID,
+			State:            request.Row.State,
+			CloseStatus:      request.Row.CloseStatus,
+			CreateRequestID:  request.Row.CreateRequestID,
+			LastWriteVersion: request.Row.LastWriteVersion,
+		})
+		return err
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		filter := currentWorkflowFilter(shardID, domainID, workflowID)
+		filter["runid"] = *request.Condition.CurrentRunID
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			filter["lastwriteversion"] = *request.Condition.LastWriteVersion
+			filter["state"] = *request.Condition.State
+		}
+		result, err := db.collection(cadence.CurrentWorkflowCollectionName).UpdateOne(sessCtx, filter, bson.M{
+			"$set": bson.M{
+				"runid":            request.Row.RunID,
+				"state":            request.Row.State,
+				"closestatus":      request.Row.CloseStatus,
+				"createrequestid":  request.Row.CreateRequestID,
+				"lastwriteversion": request.Row.LastWriteVersion,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			actualCurrRunID := ""
+			existing, err := db.selectCurrentWorkflow(sessCtx, shardID, domainID, workflowID)
+			if err == nil {
+				actualCurrRunID = existing.RunID
+			} else if !db.IsNotFoundError(err) {
+				return err
+			}
+			msg := fmt.Sprintf("Failed to update current workflow. WorkflowId: %v, Request Current RunID: %v, Actual Value: %v",
+				workflowID, request.Condition.GetCurrentRunID(), actualCurrRunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+}
+
+func (db *mdb) createWorkflowExecutionWithMergeMaps(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	var existing cadence.WorkflowExecutionCollectionEntry
+	err := db.collection(cadence.WorkflowExecutionCollectionName).FindOne(sessCtx,
+		workflowExecutionFilter(shardID, domainID, workflowID, execution.RunID),
+	).Decode(&existing)
+	if err == nil {
+		data := &workflowExecutionData{}
+		if err := decodeData(existing.Data, existing.DataEncoding, data); err != nil {
+			return err
+		}
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+			execution.WorkflowID, execution.RunID, shardCondition.RangeID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: data.LastWriteVersion,
+			},
+		}
+	}
+	if !db.IsNotFoundError(err) {
+		return err
+	}
+
+	doc, err := newWorkflowExecutionCollectionEntry(shardID, domainID, workflowID, execution)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.WorkflowExecutionCollectionName).InsertOne(sessCtx, doc)
+	return err
+}
+
+func (db *mdb) updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	set, err := newWorkflowExecutionDataFields(execution)
+	if err != nil {
+		return err
+	}
+	unset := bson.M{}
+	update := bson.M{}
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		set["bufferedevents"] = []cadence.BufferedEventsEntry{}
+	case nosqlplugin.EventBufferWriteModeAppend:
+		update["$push"] = bson.M{"bufferedevents": cadence.BufferedEventsEntry{
+			Data:         execution.NewBufferedEventBatch.Data,
+			DataEncoding: string(execution.NewBufferedEventBatch.Encoding),
+		}}
+	}
+
+	maps, err := encodeWorkflowExecutionMaps(execution)
+	if err != nil {
+		return err
+	}
+	maps.mergeInto(set)
+	for _, key := range execution.ActivityInfoKeysToDelete {
+		unset["activitymap."+encodeInt64MapKey(key)] = ""
+	}
+	for _, key := range execution.TimerInfoKeysToDelete {
+		unset["timermap."+encodeMapKey(key)] = ""
+	}
+	for _, key := range execution.ChildWorkflowInfoKeysToDelete {
+		unset["childexecutionmap."+encodeInt64MapKey(key)] = ""
+	}
+	for _, key := range execution.RequestCancelInfoKeysToDelete {
+		unset["requestcancelmap."+encodeInt64MapKey(key)] = ""
+	}
+	for _, key := range execution.SignalInfoKeysToDelete {
+		unset["signalmap."+encodeInt64MapKey(key)] = ""
+	}
+	if len(execution.SignalRequestedIDs) > 0 {
+		update["$addToSet"] = bson.M{"signalrequested": bson.M{"$each": execution.SignalRequestedIDs}}
+	}
+
+	update["$set"] = set
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	err = db.updateWorkflowExecution(sessCtx, shardID, domainID, workflowID, execution, update)
+	if err != nil {
+		return err
+	}
+
+	if len(execution.SignalRequestedIDsKeysToDelete) > 0 {
+		// adding to and pulling from the same array can't be done in a single update
+		_, err = db.collection(cadence.WorkflowExecutionCollectionName).UpdateOne(sessCtx,
+			workflowExecutionFilter(shardID, domainID, workflowID, execution.RunID),
+			bson.M{"$pull": bson.M{"signalrequested": bson.M{"$in": execution.SignalRequestedIDsKeysToDelete}}},
+		)
+	}
+	return err
+}
+
+func (db *mdb) resetWorkflowExecutionAndMapsAndEventBuffer(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+
+	set, err := newWorkflowExecutionDataFields(execution)
+	if err != nil {
+		return err
+	}
+	maps, err := encodeWorkflowExecutionMaps(execution)
+	if err != nil {
+		return err
+	}
+	set["activitymap"] = maps.activityMap
+	set["timermap"] = maps.timerMap
+	set["childexecutionmap"] = maps.childExecutionMap
+	set["requestcancelmap"] = maps.requestCancelMap
+	set["signalmap"] = maps.signalMap
+	set["signalrequested"] = nonNilStrings(execution.SignalRequestedIDs)
+	set["bufferedevents"] = []cadence.BufferedEventsEntry{}
+
+	return db.updateWorkflowExecution(sessCtx, shardID, domainID, workflowID, execution, bson.M{"$set": set})
+}
+
+// updateWorkflowExecution applies the update if the nextEventID of the execution matches PreviousNextEventIDCondition
+func (db *mdb) updateWorkflowExecution(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	update bson.M,
+) error {
+	if execution.PreviousNextEventIDCondition == nil {
+		return fmt.Errorf("PreviousNextEventIDCondition is required for updating workflow execution")
+	}
+	filter := workflowExecutionFilter(shardID, domainID, workflowID, execution.RunID)
+	filter["nexteventid"] = *execution.PreviousNextEventIDCondition
+
+	result, err := db.collection(cadence.WorkflowExecutionCollectionName).UpdateOne(sessCtx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	actualNextEventID := "<not found>"
+	var existing cadence.WorkflowExecutionCollectionEntry
+	err = db.collection(cadence.WorkflowExecutionCollectionName).FindOne(sessCtx,
+		workflowExecutionFilter(shardID, domainID, workflowID, execution.RunID),
+		options.FindOne().SetProjection(bson.M{"nexteventid": 1}),
+	).Decode(&existing)
+	if err == nil {
+		actualNextEventID = strconv.FormatInt(existing.NextEventID, 10)
+	} else if !db.IsNotFoundError(err) {
+		return err
+	}
+	msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RunID: %v, Request Condition: %v, Actual Value: %v",
+		shardID, execution.RunID, *execution.PreviousNextEventIDCondition, actualNextEventID)
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func (db *mdb) createTasks(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+) error {
+	if len(transferTasks) > 0 {
+		docs := make([]interface{}, 0, len(transferTasks))
+		for _, task := range transferTasks {
+			doc, err := newShardTaskCollectionEntry(shardID, "", task.TaskID, task)
+			if err != nil {
+				return err
+			}
+			docs = append(docs, doc)
+		}
+		if _, err := db.collection(cadence.TransferTaskCollectionName).InsertMany(sessCtx, docs); err != nil {
+			return err
+		}
+	}
+
+	if len(crossClusterTasks) > 0 {
+		docs := make([]interface{}, 0, len(crossClusterTasks))
+		for _, task := range crossClusterTasks {
+			doc, err := newShardTaskCollectionEntry(shardID, task.TargetCluster, task.TaskID, &task.TransferTask)
+			if err != nil {
+				return err
+			}
+			docs = append(docs, doc)
+		}
+		if _, err := db.collection(cadence.CrossClusterTaskCollectionName).InsertMany(sessCtx, docs); err != nil {
+			return err
+		}
+	}
+
+	if err := db.createReplicationTasks(sessCtx, shardID, replicationTasks); err != nil {
+		return err
+	}
+
+	if len(timerTasks) > 0 {
+		docs := make([]interface{}, 0, len(timerTasks))
+		for _, task := range timerTasks {
+			data, encoding, err := encodeData(task)
+			if err != nil {
+				return err
+			}
+			docs = append(docs, &cadence.TimerTaskCollectionEntry{
+				ShardID:             shardID,
+				VisibilityTimestamp: task.VisibilityTimestamp.UnixNano(),
+				TaskID:              task.TaskID,
+				Data:                data,
+				DataEncoding:        encoding,
+			})
+		}
+		if _, err := db.collection(cadence.TimerTaskCollectionName).InsertMany(sessCtx, docs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *mdb) createReplicationTasks(
+	sessCtx mongo.SessionContext,
+	shardID int,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+) error {
+	if len(replicationTasks) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, 0, len(replicationTasks))
+	for _, task := range replicationTasks {
+		doc, err := newShardTaskCollectionEntry(shardID, "", task.TaskID, task)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+	_, err := db.collection(cadence.ReplicationTaskCollectionName).InsertMany(sessCtx, docs)
+	return err
+}
+
+// selectShardTasksOrderByTaskID reads tasks from one of the collections with ShardTaskCollectionEntry schema
+func (db *mdb) selectShardTasksOrderByTaskID(
+	ctx context.Context,
+	collectionName string,
+	filter bson.M,
+	pageSize int,
+	pageToken []byte,
+	exclusiveMinTaskID int64,
+	inclusiveMaxTaskID int64,
+) ([]*cadence.ShardTaskCollectionEntry, []byte, error) {
+	var token taskIDPageToken
+	ok, err := decodePageToken(pageToken, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ok && token.LastTaskID > exclusiveMinTaskID {
+		exclusiveMinTaskID = token.LastTaskID
+	}
+	filter["taskid"] = bson.M{"$gt": exclusiveMinTaskID, "$lte": inclusiveMaxTaskID}
+
+	queryOptions := options.Find().SetSort(bson.D{{Key: "taskid", Value: 1}}).SetLimit(int64(pageSize))
+	var docs []*cadence.ShardTaskCollectionEntry
+	if err := findAll(ctx, db.collection(collectionName), filter, &docs, queryOptions); err != nil {
+		return nil, nil, err
+	}
+
+	var nextPageToken []byte
+	if len(docs) > 0 && len(docs) == pageSize {
+		nextPageToken, err = encodePageToken(&taskIDPageToken{
+			LastTaskID: docs[len(docs)-1].TaskID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return docs, nextPageToken, nil
+}
+
+func newShardTaskCollectionEntry(
+	shardID int,
+	cluster string,
+	taskID int64,
+	task interface{},
+) (*cadence.ShardTaskCollectionEntry, error) {
+	data, encoding, err := encodeData(task)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.ShardTaskCollectionEntry{
+		ShardID:      shardID,
+		Cluster:      cluster,
+		TaskID:       taskID,
+		Data:         data,
+		DataEncoding: encoding,
+	}, nil
+}
+
+func convertToReplicationTasks(
+	docs []*cadence.ShardTaskCollectionEntry,
+) ([]*nosqlplugin.ReplicationTask, error) {
+	var tasks []*nosqlplugin.ReplicationTask
+	for _, doc := range docs {
+		task := &nosqlplugin.ReplicationTask{}
+		if err := decodeData(doc.Data, doc.DataEncoding, task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func encodeWorkflowExecutionData(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) ([]byte, string, error) {
+	executionInfo := execution.InternalWorkflowExecutionInfo
+	return encodeData(&workflowExecutionData{
+		ExecutionInfo:    &executionInfo,
+		VersionHistories: execution.VersionHistories,
+		Checksum:         execution.Checksums,
+		LastWriteVersion: execution.LastWriteVersion,
+	})
+}
+
+// newWorkflowExecutionDataFields returns the fields of a workflow_execution document other than the maps and event buffer
+func newWorkflowExecutionDataFields(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (bson.M, error) {
+	data, encoding, err := encodeWorkflowExecutionData(execution)
+	if err != nil {
+		return nil, err
+	}
+	return bson.M{
+		"nexteventid":         execution.NextEventID,
+		"data":                data,
+		"dataencoding":        encoding,
+		"mapsdataencoding":    string(common.EncodingTypeJSON),
+		"lastupdatetimestamp": time.Now().UnixNano(),
+	}, nil
+}
+
+func newWorkflowExecutionCollectionEntry(
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*cadence.WorkflowExecutionCollectionEntry, error) {
+	data, encoding, err := encodeWorkflowExecutionData(execution)
+	if err != nil {
+		return nil, err
+	}
+	maps, err := encodeWorkflowExecutionMaps(execution)
+	if err != nil {
+		return nil, err
+	}
+	// maps and arrays must not be null, otherwise later updates to their entries would fail
+	return &cadence.WorkflowExecutionCollectionEntry{
+		ShardID:             shardID,
+		DomainID:            domainID,
+		WorkflowID:          workflowID,
+		RunID:               execution.RunID,
+		NextEventID:         execution.NextEventID,
+		Data:                data,
+		DataEncoding:        encoding,
+		ActivityMap:         maps.activityMap,
+		TimerMap:            maps.timerMap,
+		ChildExecutionMap:   maps.childExecutionMap,
+		RequestCancelMap:    maps.requestCancelMap,
+		SignalMap:           maps.signalMap,
+		SignalRequested:     nonNilStrings(execution.SignalRequestedIDs),
+		BufferedEvents:      []cadence.BufferedEventsEntry{},
+		MapsDataEncoding:    string(common.EncodingTypeJSON),
+		LastUpdateTimestamp: time.Now().UnixNano(),
+	}, nil
+}
+
+func convertToWorkflowExecution(
+	doc *cadence.WorkflowExecutionCollectionEntry,
+) (*nosqlplugin.WorkflowExecution, error) {
+	data := &workflowExecutionData{}
+	if err := decodeData(doc.Data, doc.DataEncoding, data); err != nil {
+		return nil, err
+	}
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:    data.ExecutionInfo,
+		VersionHistories: data.VersionHistories,
+	}
+	if state.ExecutionInfo == nil {
+		return nil, fmt.Errorf("workflow execution document has no execution info")
+	}
+	state.ExecutionInfo.NextEventID = doc.NextEventID
+	if data.Checksum != nil {
+		state.Checksum = *data.Checksum
+	}
+
+	encoding := doc.MapsDataEncoding
+	state.ActivityInfos = make(map[int64]*persistence.InternalActivityInfo, len(doc.ActivityMap))
+	for key, value := range doc.ActivityMap {
+		scheduleID, err := decodeInt64MapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.InternalActivityInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.ActivityInfos[scheduleID] = info
+	}
+	state.TimerInfos = make(map[string]*persistence.TimerInfo, len(doc.TimerMap))
+	for key, value := range doc.TimerMap {
+		timerID, err := decodeMapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.TimerInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.TimerInfos[timerID] = info
+	}
+	state.ChildExecutionInfos = make(map[int64]*persistence.InternalChildExecutionInfo, len(doc.ChildExecutionMap))
+	for key, value := range doc.ChildExecutionMap {
+		initiatedID, err := decodeInt64MapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.InternalChildExecutionInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.ChildExecutionInfos[initiatedID] = info
+	}
+	state.RequestCancelInfos = make(map[int64]*persistence.RequestCancelInfo, len(doc.RequestCancelMap))
+	for key, value := range doc.RequestCancelMap {
+		initiatedID, err := decodeInt64MapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.RequestCancelInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.RequestCancelInfos[initiatedID] = info
+	}
+	state.SignalInfos = make(map[int64]*persistence.SignalInfo, len(doc.SignalMap))
+	for key, value := range doc.SignalMap {
+		initiatedID, err := decodeInt64MapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		info := &persistence.SignalInfo{}
+		if err := decodeData(value, encoding, info); err != nil {
+			return nil, err
+		}
+		state.SignalInfos[initiatedID] = info
+	}
+
+	state.SignalRequestedIDs = make(map[string]struct{}, len(doc.SignalRequested))
+	for _, signalRequestedID := range doc.SignalRequested {
+		state.SignalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+
+	state.BufferedEvents = make([]*persistence.DataBlob, 0, len(doc.BufferedEvents))
+	for _, events := range doc.BufferedEvents {
+		state.BufferedEvents = append(state.BufferedEvents, persistence.NewDataBlob(events.Data, common.EncodingType(events.DataEncoding)))
+	}
+	return state, nil
+}
+
+// workflowExecutionMaps holds the encoded entries of the maps of a workflow execution
+type workflowExecutionMaps struct {
+	activityMap       map[string][]byte
+	timerMap          map[string][]byte
+	childExecutionMap map[string][]byte
+	requestCancelMap  map[string][]byte
+	signalMap         map[string][]byte
+}
+
+func encodeWorkflowExecutionMaps(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*workflowExecutionMaps, error) {
+	maps := &workflowExecutionMaps{
+		activityMap:       make(map[string][]byte, len(execution.ActivityInfos)),
+		timerMap:          make(map[string][]byte, len(execution.TimerInfos)),
+		childExecutionMap: make(map[string][]byte, len(execution.ChildWorkflowInfos)),
+		requestCancelMap:  make(map[string][]byte, len(execution.RequestCancelInfos)),
+		signalMap:         make(map[string][]byte, len(execution.SignalInfos)),
+	}
+	for key, info := range execution.ActivityInfos {
+		if err := encodeMapEntry(maps.activityMap, encodeInt64MapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	for key, info := range execution.TimerInfos {
+		if err := encodeMapEntry(maps.timerMap, encodeMapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	for key, info := range execution.ChildWorkflowInfos {
+		if err := encodeMapEntry(maps.childExecutionMap, encodeInt64MapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	for key, info := range execution.RequestCancelInfos {
+		if err := encodeMapEntry(maps.requestCancelMap, encodeInt64MapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	for key, info := range execution.SignalInfos {
+		if err := encodeMapEntry(maps.signalMap, encodeInt64MapKey(key), info); err != nil {
+			return nil, err
+		}
+	}
+	return maps, nil
+}
+
+// mergeInto adds every entry to the $set document of an update
+func (m *workflowExecutionMaps) mergeInto(set bson.M) {
+	for field, entries := range map[string]map[string][]byte{
+		"activitymap":       m.activityMap,
+		"timermap":          m.timerMap,
+		"childexecutionmap": m.childExecutionMap,
+		"requestcancelmap":  m.requestCancelMap,
+		"signalmap":         m.signalMap,
+	} {
+		for key, value := range entries {
+			set[field+"."+key] = value
+		}
+	}
+}
+
+func encodeMapEntry(entries map[string][]byte, key string, value interface{}) error {
+	data, _, err := encodeData(value)
+	if err != nil {
+		return err
+	}
+	entries[key] = data
+	return nil
+}
+
+// encodeMapKey encodes a map key to be used in a field name, as user provided keys(e.g. timerID) may contain dots
+func encodeMapKey(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodeMapKey(key string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(key)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+func encodeInt64MapKey(key int64) string {
+	return encodeMapKey(strconv.FormatInt(key, 10))
+}
+
+func decodeInt64MapKey(key string) (int64, error) {
+	decoded, err := decodeMapKey(key)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(decoded, 10, 64)
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -152,6 +152,8 @@ type (
 		ScheduledID     int64
 		CreatedTime     time.Time
 		PartitionConfig map[string]string
+		Priority        int32
+		TypeName        string
 	}
 
 	// TaskListFilter is for filtering tasklist
@@ -167,10 +169,11 @@ type (
 		TaskListName string
 		TaskListType int
 
-		RangeID         int64
-		TaskListKind    int
-		AckLevel        int64
-		LastUpdatedTime time.Time
+		RangeID                 int64
+		TaskListKind            int
+		AckLevel                int64
+		LastUpdatedTime         time.Time
+		AdaptivePartitionConfig *persistence.TaskListPartitionConfig
 	}
 
 	// ListTaskListResult is the result of list tasklists
@@ -219,6 +222,7 @@ type (
 		VisibilityArchivalURI    string
 		BadBinaries              *persistence.DataBlob
 		IsolationGroups          *persistence.DataBlob
+		RoleBindings             *persistence.DataBlob
 	}
 
 	// SelectMessagesBetweenRequest is a request struct for SelectMessagesBetween
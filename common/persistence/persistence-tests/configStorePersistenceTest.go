@@ -33,12 +33,14 @@ import (
 	"github.com/uber/cadence/common/config"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/dynamodb"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/mongodb"
 	"github.com/uber/cadence/common/types"
 )
 
 var supportedPlugins = map[string]bool{
 	cassandra.PluginName: true,
+	dynamodb.PluginName:  true,
 	mongodb.PluginName:   true,
 }
 
@@ -175,6 +177,53 @@ func (s *ConfigStorePersistenceSuite) TestFetchLatestVersionSuccess() {
 	s.Equal(int64(3), snapshot.Version)
 }
 
+func (s *ConfigStorePersistenceSuite) TestFetchHistorySuccess() {
+	if !validDatabaseCheck(s.Config()) {
+		s.T().Skip()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	s.DefaultTestCluster.TearDownTestDatabase()
+	s.DefaultTestCluster.SetupTestDatabase()
+
+	for version := int64(1); version <= 3; version++ {
+		err := s.UpdateDynamicConfig(ctx, generateRandomSnapshot(version))
+		s.Nil(err)
+	}
+
+	response, err := s.ConfigStoreManager.FetchDynamicConfigHistory(ctx, &p.FetchDynamicConfigHistoryRequest{PageSize: 2}, p.DynamicConfig)
+	s.Nil(err)
+	s.Equal(2, len(response.Snapshots))
+	s.Equal(int64(3), response.Snapshots[0].Version)
+	s.Equal(int64(2), response.Snapshots[1].Version)
+}
+
+func (s *ConfigStorePersistenceSuite) TestDeleteHistorySuccess() {
+	if !validDatabaseCheck(s.Config()) {
+		s.T().Skip()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	s.DefaultTestCluster.TearDownTestDatabase()
+	s.DefaultTestCluster.SetupTestDatabase()
+
+	for version := int64(1); version <= 3; version++ {
+		err := s.UpdateDynamicConfig(ctx, generateRandomSnapshot(version))
+		s.Nil(err)
+	}
+
+	err := s.ConfigStoreManager.DeleteDynamicConfigHistory(ctx, &p.DeleteDynamicConfigHistoryRequest{BeforeVersion: 3}, p.DynamicConfig)
+	s.Nil(err)
+	response, err := s.ConfigStoreManager.FetchDynamicConfigHistory(ctx, &p.FetchDynamicConfigHistoryRequest{PageSize: 3}, p.DynamicConfig)
+	s.Nil(err)
+	s.Equal(1, len(response.Snapshots))
+	s.Equal(int64(3), response.Snapshots[0].Version)
+}
+
 func generateRandomSnapshot(version int64) *p.DynamicConfigSnapshot {
 	data, _ := json.Marshal("test_value")
 
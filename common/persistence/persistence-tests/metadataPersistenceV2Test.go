@@ -698,6 +698,11 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 
 	isolationGroups1 := types.IsolationGroupConfiguration{}
 
+	roleBindings := []*types.RoleBinding{
+		{Principal: "team-a", Role: types.DomainRoleWrite.Ptr()},
+		{Principal: "cadence-admins", Role: types.DomainRoleAdmin.Ptr()},
+	}
+
 	isolationGroups2 := types.IsolationGroupConfiguration{
 		"zone-1": types.IsolationGroupPartition{
 			Name:  "zone-1",
@@ -832,6 +837,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			VisibilityArchivalURI:    updatedVisibilityArchivalURI,
 			BadBinaries:              testBinaries,
 			IsolationGroups:          isolationGroups2,
+			RoleBindings:             roleBindings,
 		},
 		&p.DomainReplicationConfig{
 			ActiveClusterName: updateClusterActive,
@@ -877,6 +883,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(&failoverEndTime, resp4.FailoverEndTime)
 	m.Equal(lastUpdateTime, resp4.LastUpdatedTime)
 	m.Equal(isolationGroups2, resp4.Config.IsolationGroups)
+	m.Equal(roleBindings, resp4.Config.RoleBindings)
 
 	resp5, err5 := m.GetDomain(ctx, id, "")
 	m.NoError(err5)
@@ -0,0 +1,1635 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	shardAdaptivePersistenceClient struct {
+		limiter     adaptiveLimiter
+		persistence ShardManager
+		logger      log.Logger
+	}
+
+	workflowExecutionAdaptivePersistenceClient struct {
+		limiter     adaptiveLimiter
+		persistence ExecutionManager
+		logger      log.Logger
+	}
+
+	taskAdaptivePersistenceClient struct {
+		limiter     adaptiveLimiter
+		persistence TaskManager
+		logger      log.Logger
+	}
+
+	historyAdaptivePersistenceClient struct {
+		limiter     adaptiveLimiter
+		persistence HistoryManager
+		logger      log.Logger
+	}
+
+	metadataAdaptivePersistenceClient struct {
+		limiter     adaptiveLimiter
+		persistence DomainManager
+		logger      log.Logger
+	}
+
+	visibilityAdaptivePersistenceClient struct {
+		limiter     adaptiveLimiter
+		persistence VisibilityManager
+		logger      log.Logger
+	}
+
+	queueAdaptivePersistenceClient struct {
+		limiter     adaptiveLimiter
+		persistence QueueManager
+		logger      log.Logger
+	}
+
+	configStoreAdaptivePersistenceClient struct {
+		limiter     adaptiveLimiter
+		persistence ConfigStoreManager
+		logger      log.Logger
+	}
+
+	adaptiveLimiter struct {
+		limiter     *quotas.AdaptiveConcurrencyLimiter
+		enabled     dynamicconfig.BoolPropertyFn
+		lowPriority bool
+	}
+)
+
+var _ ShardManager = (*shardAdaptivePersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionAdaptivePersistenceClient)(nil)
+var _ TaskManager = (*taskAdaptivePersistenceClient)(nil)
+var _ HistoryManager = (*historyAdaptivePersistenceClient)(nil)
+var _ DomainManager = (*metadataAdaptivePersistenceClient)(nil)
+var _ VisibilityManager = (*visibilityAdaptivePersistenceClient)(nil)
+var _ QueueManager = (*queueAdaptivePersistenceClient)(nil)
+var _ ConfigStoreManager = (*configStoreAdaptivePersistenceClient)(nil)
+
+// NewShardPersistenceAdaptiveClient creates a client to manage shards
+func NewShardPersistenceAdaptiveClient(
+	persistence ShardManager,
+	limiter *quotas.AdaptiveConcurrencyLimiter,
+	enabled dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) ShardManager {
+	return &shardAdaptivePersistenceClient{
+		persistence: persistence,
+		limiter: adaptiveLimiter{
+			limiter: limiter,
+			enabled: enabled,
+		},
+		logger: logger,
+	}
+}
+
+// NewWorkflowExecutionPersistenceAdaptiveClient creates a client to manage executions
+func NewWorkflowExecutionPersistenceAdaptiveClient(
+	persistence ExecutionManager,
+	limiter *quotas.AdaptiveConcurrencyLimiter,
+	enabled dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) ExecutionManager {
+	return &workflowExecutionAdaptivePersistenceClient{
+		persistence: persistence,
+		limiter: adaptiveLimiter{
+			limiter: limiter,
+			enabled: enabled,
+		},
+		logger: logger,
+	}
+}
+
+// NewTaskPersistenceAdaptiveClient creates a client to manage tasks
+func NewTaskPersistenceAdaptiveClient(
+	persistence TaskManager,
+	limiter *quotas.AdaptiveConcurrencyLimiter,
+	enabled dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) TaskManager {
+	return &taskAdaptivePersistenceClient{
+		persistence: persistence,
+		limiter: adaptiveLimiter{
+			limiter: limiter,
+			enabled: enabled,
+		},
+		logger: logger,
+	}
+}
+
+// NewHistoryPersistenceAdaptiveClient creates a client to manage workflow execution history
+func NewHistoryPersistenceAdaptiveClient(
+	persistence HistoryManager,
+	limiter *quotas.AdaptiveConcurrencyLimiter,
+	enabled dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) HistoryManager {
+	return &historyAdaptivePersistenceClient{
+		persistence: persistence,
+		limiter: adaptiveLimiter{
+			limiter: limiter,
+			enabled: enabled,
+		},
+		logger: logger,
+	}
+}
+
+// NewDomainPersistenceAdaptiveClient creates a client to manage metadata
+func NewDomainPersistenceAdaptiveClient(
+	persistence DomainManager,
+	limiter *quotas.AdaptiveConcurrencyLimiter,
+	enabled dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) DomainManager {
+	return &metadataAdaptivePersistenceClient{
+		persistence: persistence,
+		limiter: adaptiveLimiter{
+			limiter: limiter,
+			enabled: enabled,
+		},
+		logger: logger,
+	}
+}
+
+// NewVisibilityPersistenceAdaptiveClient creates a client to manage visibility, visibility calls are always of low priority
+func NewVisibilityPersistenceAdaptiveClient(
+	persistence VisibilityManager,
+	limiter *quotas.AdaptiveConcurrencyLimiter,
+	enabled dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) VisibilityManager {
+	return &visibilityAdaptivePersistenceClient{
+		persistence: persistence,
+		limiter: adaptiveLimiter{
+			limiter:     limiter,
+			enabled:     enabled,
+			lowPriority: true,
+		},
+		logger: logger,
+	}
+}
+
+// NewQueuePersistenceAdaptiveClient creates a client to manage queue
+func NewQueuePersistenceAdaptiveClient(
+	persistence QueueManager,
+	limiter *quotas.AdaptiveConcurrencyLimiter,
+	enabled dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) QueueManager {
+	return &queueAdaptivePersistenceClient{
+		persistence: persistence,
+		limiter: adaptiveLimiter{
+			limiter: limiter,
+			enabled: enabled,
+		},
+		logger: logger,
+	}
+}
+
+// NewConfigStorePersistenceAdaptiveClient creates a client to manage config store
+func NewConfigStorePersistenceAdaptiveClient(
+	persistence ConfigStoreManager,
+	limiter *quotas.AdaptiveConcurrencyLimiter,
+	enabled dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) ConfigStoreManager {
+	return &configStoreAdaptivePersistenceClient{
+		persistence: persistence,
+		limiter: adaptiveLimiter{
+			limiter: limiter,
+			enabled: enabled,
+		},
+		logger: logger,
+	}
+}
+
+func (p *shardAdaptivePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardAdaptivePersistenceClient) CreateShard(
+	ctx context.Context,
+	request *CreateShardRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "CreateShard")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.CreateShard(ctx, request)
+	return err
+}
+
+func (p *shardAdaptivePersistenceClient) GetShard(
+	ctx context.Context,
+	request *GetShardRequest,
+) (*GetShardResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetShard")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetShard(ctx, request)
+	return response, err
+}
+
+func (p *shardAdaptivePersistenceClient) UpdateShard(
+	ctx context.Context,
+	request *UpdateShardRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "UpdateShard")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.UpdateShard(ctx, request)
+	return err
+}
+
+func (p *shardAdaptivePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetShardID() int {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) CreateWorkflowExecution(
+	ctx context.Context,
+	request *CreateWorkflowExecutionRequest,
+) (*CreateWorkflowExecutionResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "CreateWorkflowExecution")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.CreateWorkflowExecution(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (*GetWorkflowExecutionResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetWorkflowExecution")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetWorkflowExecution(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (*UpdateWorkflowExecutionResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "UpdateWorkflowExecution")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.UpdateWorkflowExecution(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *ConflictResolveWorkflowExecutionRequest,
+) (*ConflictResolveWorkflowExecutionResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "ConflictResolveWorkflowExecution")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ConflictResolveWorkflowExecution(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *DeleteWorkflowExecutionRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteWorkflowExecution")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteWorkflowExecution(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *DeleteCurrentWorkflowExecutionRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteCurrentWorkflowExecution")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetCurrentExecution(
+	ctx context.Context,
+	request *GetCurrentExecutionRequest,
+) (*GetCurrentExecutionResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetCurrentExecution")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetCurrentExecution(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) ListCurrentExecutions(
+	ctx context.Context,
+	request *ListCurrentExecutionsRequest,
+) (*ListCurrentExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "ListCurrentExecutions")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListCurrentExecutions(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) IsWorkflowExecutionExists(
+	ctx context.Context,
+	request *IsWorkflowExecutionExistsRequest,
+) (*IsWorkflowExecutionExistsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "IsWorkflowExecutionExists")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.IsWorkflowExecutionExists(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) ListConcreteExecutions(
+	ctx context.Context,
+	request *ListConcreteExecutionsRequest,
+) (*ListConcreteExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "ListConcreteExecutions")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListConcreteExecutions(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetTransferTasks(
+	ctx context.Context,
+	request *GetTransferTasksRequest,
+) (*GetTransferTasksResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetTransferTasks")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetTransferTasks(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetCrossClusterTasks(
+	ctx context.Context,
+	request *GetCrossClusterTasksRequest,
+) (*GetCrossClusterTasksResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetCrossClusterTasks")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetCrossClusterTasks(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetReplicationTasks(
+	ctx context.Context,
+	request *GetReplicationTasksRequest,
+) (*GetReplicationTasksResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetReplicationTasks")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetReplicationTasks(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) CompleteTransferTask(
+	ctx context.Context,
+	request *CompleteTransferTaskRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "CompleteTransferTask")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.CompleteTransferTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) RangeCompleteTransferTask(
+	ctx context.Context,
+	request *RangeCompleteTransferTaskRequest,
+) (*RangeCompleteTransferTaskResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "RangeCompleteTransferTask")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.RangeCompleteTransferTask(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) CompleteCrossClusterTask(
+	ctx context.Context,
+	request *CompleteCrossClusterTaskRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "CompleteCrossClusterTask")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.CompleteCrossClusterTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) RangeCompleteCrossClusterTask(
+	ctx context.Context,
+	request *RangeCompleteCrossClusterTaskRequest,
+) (*RangeCompleteCrossClusterTaskResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "RangeCompleteCrossClusterTask")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.RangeCompleteCrossClusterTask(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) CompleteReplicationTask(
+	ctx context.Context,
+	request *CompleteReplicationTaskRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "CompleteReplicationTask")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.CompleteReplicationTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) RangeCompleteReplicationTask(
+	ctx context.Context,
+	request *RangeCompleteReplicationTaskRequest,
+) (*RangeCompleteReplicationTaskResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "RangeCompleteReplicationTask")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.RangeCompleteReplicationTask(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *PutReplicationTaskToDLQRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "PutReplicationTaskToDLQ")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.PutReplicationTaskToDLQ(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *GetReplicationTasksFromDLQRequest,
+) (*GetReplicationTasksFromDLQResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetReplicationTasksFromDLQ")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetReplicationDLQSize(
+	ctx context.Context,
+	request *GetReplicationDLQSizeRequest,
+) (*GetReplicationDLQSizeResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetReplicationDLQSize")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetReplicationDLQSize(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteReplicationTaskFromDLQ")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) (*RangeDeleteReplicationTaskFromDLQResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "RangeDeleteReplicationTaskFromDLQ")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) CreateFailoverMarkerTasks(
+	ctx context.Context,
+	request *CreateFailoverMarkersRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "CreateFailoverMarkerTasks")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.CreateFailoverMarkerTasks(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) GetTimerIndexTasks(
+	ctx context.Context,
+	request *GetTimerIndexTasksRequest,
+) (*GetTimerIndexTasksResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetTimerIndexTasks")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetTimerIndexTasks(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) CompleteTimerTask(
+	ctx context.Context,
+	request *CompleteTimerTaskRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "CompleteTimerTask")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.CompleteTimerTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) RangeCompleteTimerTask(
+	ctx context.Context,
+	request *RangeCompleteTimerTaskRequest,
+) (*RangeCompleteTimerTaskResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "RangeCompleteTimerTask")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.RangeCompleteTimerTask(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionAdaptivePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskAdaptivePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskAdaptivePersistenceClient) CreateTasks(
+	ctx context.Context,
+	request *CreateTasksRequest,
+) (*CreateTasksResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "CreateTasks")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.CreateTasks(ctx, request)
+	return response, err
+}
+
+func (p *taskAdaptivePersistenceClient) GetTasks(
+	ctx context.Context,
+	request *GetTasksRequest,
+) (*GetTasksResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetTasks")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetTasks(ctx, request)
+	return response, err
+}
+
+func (p *taskAdaptivePersistenceClient) CompleteTask(
+	ctx context.Context,
+	request *CompleteTaskRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "CompleteTask")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.CompleteTask(ctx, request)
+	return err
+}
+
+func (p *taskAdaptivePersistenceClient) CompleteTasksLessThan(
+	ctx context.Context,
+	request *CompleteTasksLessThanRequest,
+) (*CompleteTasksLessThanResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "CompleteTasksLessThan")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.CompleteTasksLessThan(ctx, request)
+	return response, err
+}
+
+func (p *taskAdaptivePersistenceClient) GetOrphanTasks(ctx context.Context, request *GetOrphanTasksRequest) (*GetOrphanTasksResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetOrphanTasks")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetOrphanTasks(ctx, request)
+	return response, err
+}
+
+func (p *taskAdaptivePersistenceClient) LeaseTaskList(
+	ctx context.Context,
+	request *LeaseTaskListRequest,
+) (*LeaseTaskListResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "LeaseTaskList")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.LeaseTaskList(ctx, request)
+	return response, err
+}
+
+func (p *taskAdaptivePersistenceClient) UpdateTaskList(
+	ctx context.Context,
+	request *UpdateTaskListRequest,
+) (*UpdateTaskListResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "UpdateTaskList")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.UpdateTaskList(ctx, request)
+	return response, err
+}
+
+func (p *taskAdaptivePersistenceClient) ListTaskList(
+	ctx context.Context,
+	request *ListTaskListRequest,
+) (*ListTaskListResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "ListTaskList")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListTaskList(ctx, request)
+	return response, err
+}
+
+func (p *taskAdaptivePersistenceClient) DeleteTaskList(
+	ctx context.Context,
+	request *DeleteTaskListRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteTaskList")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteTaskList(ctx, request)
+	return err
+}
+
+func (p *taskAdaptivePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataAdaptivePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataAdaptivePersistenceClient) CreateDomain(
+	ctx context.Context,
+	request *CreateDomainRequest,
+) (*CreateDomainResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "CreateDomain")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.CreateDomain(ctx, request)
+	return response, err
+}
+
+func (p *metadataAdaptivePersistenceClient) GetDomain(
+	ctx context.Context,
+	request *GetDomainRequest,
+) (*GetDomainResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetDomain")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetDomain(ctx, request)
+	return response, err
+}
+
+func (p *metadataAdaptivePersistenceClient) UpdateDomain(
+	ctx context.Context,
+	request *UpdateDomainRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "UpdateDomain")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.UpdateDomain(ctx, request)
+	return err
+}
+
+func (p *metadataAdaptivePersistenceClient) DeleteDomain(
+	ctx context.Context,
+	request *DeleteDomainRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteDomain")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteDomain(ctx, request)
+	return err
+}
+
+func (p *metadataAdaptivePersistenceClient) DeleteDomainByName(
+	ctx context.Context,
+	request *DeleteDomainByNameRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteDomainByName")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteDomainByName(ctx, request)
+	return err
+}
+
+func (p *metadataAdaptivePersistenceClient) ListDomains(
+	ctx context.Context,
+	request *ListDomainsRequest,
+) (*ListDomainsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "ListDomains")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListDomains(ctx, request)
+	return response, err
+}
+
+func (p *metadataAdaptivePersistenceClient) GetMetadata(
+	ctx context.Context,
+) (*GetMetadataResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetMetadata")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetMetadata(ctx)
+	return response, err
+}
+
+func (p *metadataAdaptivePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *visibilityAdaptivePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityAdaptivePersistenceClient) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *RecordWorkflowExecutionStartedRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "VisibilityRecordWorkflowExecutionStarted")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.RecordWorkflowExecutionStarted(ctx, request)
+	return err
+}
+
+func (p *visibilityAdaptivePersistenceClient) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *RecordWorkflowExecutionClosedRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "VisibilityRecordWorkflowExecutionClosed")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.RecordWorkflowExecutionClosed(ctx, request)
+	return err
+}
+
+func (p *visibilityAdaptivePersistenceClient) RecordWorkflowExecutionUninitialized(
+	ctx context.Context,
+	request *RecordWorkflowExecutionUninitializedRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "VisibilityRecordWorkflowExecutionUninitialized")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.RecordWorkflowExecutionUninitialized(ctx, request)
+	return err
+}
+
+func (p *visibilityAdaptivePersistenceClient) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *UpsertWorkflowExecutionRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "VisibilityUpsertWorkflowExecution")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.UpsertWorkflowExecution(ctx, request)
+	return err
+}
+
+func (p *visibilityAdaptivePersistenceClient) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityListOpenWorkflowExecutions")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListOpenWorkflowExecutions(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityListClosedWorkflowExecutions")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListClosedWorkflowExecutions(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByTypeRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityListOpenWorkflowExecutionsByType")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByTypeRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityListClosedWorkflowExecutionsByType")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByWorkflowIDRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityListOpenWorkflowExecutionsByWorkflowID")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByWorkflowIDRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityListClosedWorkflowExecutionsByWorkflowID")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
+	request *ListClosedWorkflowExecutionsByStatusRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityListClosedWorkflowExecutionsByStatus")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) GetClosedWorkflowExecution(
+	ctx context.Context,
+	request *GetClosedWorkflowExecutionRequest,
+) (*GetClosedWorkflowExecutionResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityGetClosedWorkflowExecution")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetClosedWorkflowExecution(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "VisibilityDeleteWorkflowExecution")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteWorkflowExecution(ctx, request)
+	return err
+}
+
+func (p *visibilityAdaptivePersistenceClient) DeleteUninitializedWorkflowExecution(
+	ctx context.Context,
+	request *VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "VisibilityDeleteUninitializedWorkflowExecution")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteUninitializedWorkflowExecution(ctx, request)
+	return err
+}
+
+func (p *visibilityAdaptivePersistenceClient) ListWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByQueryRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityListWorkflowExecutions")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ListWorkflowExecutions(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByQueryRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityScanWorkflowExecutions")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ScanWorkflowExecutions(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *CountWorkflowExecutionsRequest,
+) (*CountWorkflowExecutionsResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "VisibilityCountWorkflowExecutions")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.CountWorkflowExecutions(ctx, request)
+	return response, err
+}
+
+func (p *visibilityAdaptivePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyAdaptivePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyAdaptivePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (p *historyAdaptivePersistenceClient) AppendHistoryNodes(
+	ctx context.Context,
+	request *AppendHistoryNodesRequest,
+) (*AppendHistoryNodesResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "AppendHistoryNodes")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.AppendHistoryNodes(ctx, request)
+	return response, err
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (p *historyAdaptivePersistenceClient) ReadHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadHistoryBranchResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "ReadHistoryBranch")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ReadHistoryBranch(ctx, request)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyAdaptivePersistenceClient) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadHistoryBranchByBatchResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "ReadHistoryBranchByBatch")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ReadHistoryBranchByBatch(ctx, request)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyAdaptivePersistenceClient) ReadRawHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadRawHistoryBranchResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "ReadRawHistoryBranch")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ReadRawHistoryBranch(ctx, request)
+	return response, err
+}
+
+// ForkHistoryBranch forks a new branch from a old branch
+func (p *historyAdaptivePersistenceClient) ForkHistoryBranch(
+	ctx context.Context,
+	request *ForkHistoryBranchRequest,
+) (*ForkHistoryBranchResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "ForkHistoryBranch")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ForkHistoryBranch(ctx, request)
+	return response, err
+}
+
+// DeleteHistoryBranch removes a branch
+func (p *historyAdaptivePersistenceClient) DeleteHistoryBranch(
+	ctx context.Context,
+	request *DeleteHistoryBranchRequest,
+) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteHistoryBranch")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteHistoryBranch(ctx, request)
+	return err
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (p *historyAdaptivePersistenceClient) GetHistoryTree(
+	ctx context.Context,
+	request *GetHistoryTreeRequest,
+) (*GetHistoryTreeResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetHistoryTree")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetHistoryTree(ctx, request)
+	return response, err
+}
+
+func (p *historyAdaptivePersistenceClient) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *GetAllHistoryTreeBranchesRequest,
+) (*GetAllHistoryTreeBranchesResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "GetAllHistoryTreeBranches")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetAllHistoryTreeBranches(ctx, request)
+	return response, err
+}
+
+func (p *queueAdaptivePersistenceClient) EnqueueMessage(
+	ctx context.Context,
+	message []byte,
+) error {
+	release, ok := p.limiter.acquire(ctx, "EnqueueMessage")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.EnqueueMessage(ctx, message)
+	return err
+}
+
+func (p *queueAdaptivePersistenceClient) ReadMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*QueueMessage, error) {
+	release, ok := p.limiter.acquire(ctx, "ReadMessages")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.ReadMessages(ctx, lastMessageID, maxCount)
+	return response, err
+}
+
+func (p *queueAdaptivePersistenceClient) UpdateAckLevel(
+	ctx context.Context,
+	messageID int64,
+	clusterName string,
+) error {
+	release, ok := p.limiter.acquire(ctx, "UpdateAckLevel")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.UpdateAckLevel(ctx, messageID, clusterName)
+	return err
+}
+
+func (p *queueAdaptivePersistenceClient) GetAckLevels(
+	ctx context.Context,
+) (map[string]int64, error) {
+	release, ok := p.limiter.acquire(ctx, "GetAckLevels")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetAckLevels(ctx)
+	return response, err
+}
+
+func (p *queueAdaptivePersistenceClient) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteMessagesBefore")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteMessagesBefore(ctx, messageID)
+	return err
+}
+
+func (p *queueAdaptivePersistenceClient) EnqueueMessageToDLQ(
+	ctx context.Context,
+	message []byte,
+) error {
+	release, ok := p.limiter.acquire(ctx, "EnqueueMessageToDLQ")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.EnqueueMessageToDLQ(ctx, message)
+	return err
+}
+
+func (p *queueAdaptivePersistenceClient) ReadMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*QueueMessage, []byte, error) {
+	release, ok := p.limiter.acquire(ctx, "ReadMessagesFromDLQ")
+	if !ok {
+		return nil, nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	messages, nextPageToken, err := p.persistence.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
+	return messages, nextPageToken, err
+}
+
+func (p *queueAdaptivePersistenceClient) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	release, ok := p.limiter.acquire(ctx, "RangeDeleteMessagesFromDLQ")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+	return err
+}
+
+func (p *queueAdaptivePersistenceClient) UpdateDLQAckLevel(
+	ctx context.Context,
+	messageID int64,
+	clusterName string,
+) error {
+	release, ok := p.limiter.acquire(ctx, "UpdateDLQAckLevel")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.UpdateDLQAckLevel(ctx, messageID, clusterName)
+	return err
+}
+
+func (p *queueAdaptivePersistenceClient) GetDLQAckLevels(
+	ctx context.Context,
+) (map[string]int64, error) {
+	release, ok := p.limiter.acquire(ctx, "GetDLQAckLevels")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetDLQAckLevels(ctx)
+	return response, err
+}
+
+func (p *queueAdaptivePersistenceClient) GetDLQSize(
+	ctx context.Context,
+) (int64, error) {
+	release, ok := p.limiter.acquire(ctx, "GetDLQSize")
+	if !ok {
+		return 0, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.GetDLQSize(ctx)
+	return response, err
+}
+
+func (p *queueAdaptivePersistenceClient) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteMessageFromDLQ")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteMessageFromDLQ(ctx, messageID)
+	return err
+}
+
+func (p *queueAdaptivePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *configStoreAdaptivePersistenceClient) FetchDynamicConfig(ctx context.Context, configType ConfigType) (*FetchDynamicConfigResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "FetchDynamicConfig")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.FetchDynamicConfig(ctx, configType)
+	return response, err
+}
+
+func (p *configStoreAdaptivePersistenceClient) FetchDynamicConfigHistory(ctx context.Context, request *FetchDynamicConfigHistoryRequest, configType ConfigType) (*FetchDynamicConfigHistoryResponse, error) {
+	release, ok := p.limiter.acquire(ctx, "FetchDynamicConfigHistory")
+	if !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	response, err := p.persistence.FetchDynamicConfigHistory(ctx, request, configType)
+	return response, err
+}
+
+func (p *configStoreAdaptivePersistenceClient) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, configType ConfigType) error {
+	release, ok := p.limiter.acquire(ctx, "UpdateDynamicConfig")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.UpdateDynamicConfig(ctx, request, configType)
+	return err
+}
+
+func (p *configStoreAdaptivePersistenceClient) DeleteDynamicConfigHistory(ctx context.Context, request *DeleteDynamicConfigHistoryRequest, configType ConfigType) error {
+	release, ok := p.limiter.acquire(ctx, "DeleteDynamicConfigHistory")
+	if !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	var err error
+	defer func() { release(err) }()
+
+	err = p.persistence.DeleteDynamicConfigHistory(ctx, request, configType)
+	return err
+}
+
+func (p *configStoreAdaptivePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// acquire takes a slot for the operation, the returned function must be called with the result of the operation
+func (l adaptiveLimiter) acquire(ctx context.Context, operation string) (func(error), bool) {
+	if !l.enabled() {
+		return func(error) {}, true
+	}
+
+	lowPriority := l.lowPriority || CallerPriorityFromContext(ctx) == CallerPriorityLow
+	release, ok := l.limiter.Acquire(ctx, operation, lowPriority)
+	if !ok {
+		return nil, false
+	}
+	return func(err error) {
+		release(isOverloadedError(err))
+	}, true
+}
+
+// isOverloadedError checks if the error indicates that the database is overloaded
+func isOverloadedError(err error) bool {
+	if err == nil || err == ErrPersistenceLimitExceeded {
+		return false
+	}
+	switch err.(type) {
+	case *types.ServiceBusyError, *TimeoutError:
+		return true
+	}
+	return err == context.DeadlineExceeded
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestAdaptivePersistenceClientShedsLowPriorityCalls(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	shardManager := NewMockShardManager(ctrl)
+
+	limiter := quotas.NewAdaptiveConcurrencyLimiter(
+		func() float64 { return 1 },
+		func() float64 { return 2 },
+		func() float64 { return 0.5 },
+	)
+	enabled := dynamicconfig.GetBoolPropertyFn(true)
+	shardClient := NewShardPersistenceAdaptiveClient(shardManager, limiter, enabled, loggerimpl.NewNopLogger())
+	visibilityLimiter := adaptiveLimiter{limiter: limiter, enabled: enabled, lowPriority: true}
+	lowPriorityCtx := ContextWithCallerPriority(context.Background(), CallerPriorityLow)
+
+	// hold one slot while the calls below are made
+	shardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *UpdateShardRequest) error {
+			_, err := shardClient.GetShard(lowPriorityCtx, &GetShardRequest{})
+			assert.Equal(t, ErrPersistenceLimitExceeded, err, "low priority call should be shed")
+			_, ok := visibilityLimiter.acquire(context.Background(), "VisibilityDeleteWorkflowExecution")
+			assert.False(t, ok, "visibility call should be shed")
+			_, err = shardClient.GetShard(context.Background(), &GetShardRequest{})
+			assert.NoError(t, err, "high priority call should go through")
+			return nil
+		})
+	shardManager.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&GetShardResponse{}, nil).Times(1)
+	assert.NoError(t, shardClient.UpdateShard(context.Background(), &UpdateShardRequest{}))
+
+	// the slots are released after the calls
+	shardManager.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&GetShardResponse{}, nil).Times(1)
+	_, err := shardClient.GetShard(lowPriorityCtx, &GetShardRequest{})
+	assert.NoError(t, err)
+}
+
+func TestAdaptivePersistenceClientDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	shardManager := NewMockShardManager(ctrl)
+	limiter := quotas.NewAdaptiveConcurrencyLimiter(
+		func() float64 { return 0 },
+		func() float64 { return 0 },
+		func() float64 { return 0 },
+	)
+	shardClient := NewShardPersistenceAdaptiveClient(shardManager, limiter, dynamicconfig.GetBoolPropertyFn(false), loggerimpl.NewNopLogger())
+
+	shardManager.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&GetShardResponse{}, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := shardClient.GetShard(ContextWithCallerPriority(context.Background(), CallerPriorityLow), &GetShardRequest{})
+		assert.NoError(t, err)
+	}
+}
+
+func TestAdaptivePersistenceClientReleasesOnPanic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	shardManager := NewMockShardManager(ctrl)
+	limiter := quotas.NewAdaptiveConcurrencyLimiter(
+		func() float64 { return 1 },
+		func() float64 { return 1 },
+		func() float64 { return 1 },
+	)
+	shardClient := NewShardPersistenceAdaptiveClient(shardManager, limiter, dynamicconfig.GetBoolPropertyFn(true), loggerimpl.NewNopLogger())
+
+	shardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *UpdateShardRequest) error {
+			panic("persistence failure")
+		})
+	assert.Panics(t, func() {
+		shardClient.UpdateShard(context.Background(), &UpdateShardRequest{})
+	})
+
+	// the only slot is released by the panicking call
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	shardManager.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&GetShardResponse{}, nil).Times(1)
+	_, err := shardClient.GetShard(ctx, &GetShardRequest{})
+	assert.NoError(t, err)
+}
+
+func TestIsOverloadedError(t *testing.T) {
+	assert.False(t, isOverloadedError(nil))
+	assert.False(t, isOverloadedError(ErrPersistenceLimitExceeded))
+	assert.False(t, isOverloadedError(&types.EntityNotExistsError{}))
+	assert.False(t, isOverloadedError(errors.New("some error")))
+	assert.True(t, isOverloadedError(&types.ServiceBusyError{}))
+	assert.True(t, isOverloadedError(&TimeoutError{}))
+	assert.True(t, isOverloadedError(context.DeadlineExceeded))
+}
+
+func TestCallerPriorityFromContext(t *testing.T) {
+	assert.Equal(t, CallerPriorityHigh, CallerPriorityFromContext(context.Background()))
+	assert.Equal(t, CallerPriorityLow, CallerPriorityFromContext(ContextWithCallerPriority(context.Background(), CallerPriorityLow)))
+}
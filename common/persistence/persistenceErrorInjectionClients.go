@@ -2354,6 +2354,28 @@ func (p *configStoreErrorInjectionPersistenceClient) FetchDynamicConfig(ctx cont
 	return response, persistenceErr
 }
 
+func (p *configStoreErrorInjectionPersistenceClient) FetchDynamicConfigHistory(ctx context.Context, request *FetchDynamicConfigHistoryRequest, cfgType ConfigType) (*FetchDynamicConfigHistoryResponse, error) {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var response *FetchDynamicConfigHistoryResponse
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		response, persistenceErr = p.persistence.FetchDynamicConfigHistory(ctx, request, cfgType)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationFetchDynamicConfigHistory,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return nil, fakeErr
+	}
+	return response, persistenceErr
+}
+
 func (p *configStoreErrorInjectionPersistenceClient) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, cfgType ConfigType) error {
 	fakeErr := generateFakeError(p.errorRate)
 
@@ -2375,6 +2397,27 @@ func (p *configStoreErrorInjectionPersistenceClient) UpdateDynamicConfig(ctx con
 	return persistenceErr
 }
 
+func (p *configStoreErrorInjectionPersistenceClient) DeleteDynamicConfigHistory(ctx context.Context, request *DeleteDynamicConfigHistoryRequest, cfgType ConfigType) error {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		persistenceErr = p.persistence.DeleteDynamicConfigHistory(ctx, request, cfgType)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationDeleteDynamicConfigHistory,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return fakeErr
+	}
+	return persistenceErr
+}
+
 func (p *configStoreErrorInjectionPersistenceClient) Close() {
 	p.persistence.Close()
 }
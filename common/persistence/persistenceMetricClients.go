@@ -1745,6 +1745,20 @@ func (p *configStorePersistenceClient) FetchDynamicConfig(ctx context.Context, c
 	return resp, nil
 }
 
+func (p *configStorePersistenceClient) FetchDynamicConfigHistory(ctx context.Context, request *FetchDynamicConfigHistoryRequest, configType ConfigType) (*FetchDynamicConfigHistoryResponse, error) {
+	var resp *FetchDynamicConfigHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = p.persistence.FetchDynamicConfigHistory(ctx, request, configType)
+		return err
+	}
+	err := p.call(metrics.PersistenceFetchDynamicConfigHistoryScope, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (p *configStorePersistenceClient) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, configType ConfigType) error {
 	op := func() error {
 		return p.persistence.UpdateDynamicConfig(ctx, request, configType)
@@ -1752,6 +1766,13 @@ func (p *configStorePersistenceClient) UpdateDynamicConfig(ctx context.Context,
 	return p.call(metrics.PersistenceUpdateDynamicConfigScope, op)
 }
 
+func (p *configStorePersistenceClient) DeleteDynamicConfigHistory(ctx context.Context, request *DeleteDynamicConfigHistoryRequest, configType ConfigType) error {
+	op := func() error {
+		return p.persistence.DeleteDynamicConfigHistory(ctx, request, configType)
+	}
+	return p.call(metrics.PersistenceDeleteDynamicConfigHistoryScope, op)
+}
+
 func (p *configStorePersistenceClient) Close() {
 	p.persistence.Close()
 }
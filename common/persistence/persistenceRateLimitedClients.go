@@ -1223,6 +1223,13 @@ func (p *configStoreRateLimitedPersistenceClient) FetchDynamicConfig(ctx context
 	return p.persistence.FetchDynamicConfig(ctx, configType)
 }
 
+func (p *configStoreRateLimitedPersistenceClient) FetchDynamicConfigHistory(ctx context.Context, request *FetchDynamicConfigHistoryRequest, configType ConfigType) (*FetchDynamicConfigHistoryResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.FetchDynamicConfigHistory(ctx, request, configType)
+}
+
 func (p *configStoreRateLimitedPersistenceClient) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, configType ConfigType) error {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
@@ -1230,6 +1237,13 @@ func (p *configStoreRateLimitedPersistenceClient) UpdateDynamicConfig(ctx contex
 	return p.persistence.UpdateDynamicConfig(ctx, request, configType)
 }
 
+func (p *configStoreRateLimitedPersistenceClient) DeleteDynamicConfigHistory(ctx context.Context, request *DeleteDynamicConfigHistoryRequest, configType ConfigType) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.DeleteDynamicConfigHistory(ctx, request, configType)
+}
+
 func (p *configStoreRateLimitedPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -25,6 +25,7 @@ package serialization
 import (
 	"time"
 
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -1396,6 +1397,22 @@ func (t *TaskInfo) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority internal sql blob getter
+func (t *TaskInfo) GetPriority() (o int32) {
+	if t != nil {
+		return t.Priority
+	}
+	return
+}
+
+// GetTypeName internal sql blob getter
+func (t *TaskInfo) GetTypeName() (o string) {
+	if t != nil {
+		return t.TypeName
+	}
+	return
+}
+
 // GetKind internal sql blob getter
 func (t *TaskListInfo) GetKind() (o int16) {
 	if t != nil {
@@ -1428,6 +1445,14 @@ func (t *TaskListInfo) GetLastUpdated() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetAdaptivePartitionConfig internal sql blob getter
+func (t *TaskListInfo) GetAdaptivePartitionConfig() (o *persistence.TaskListPartitionConfig) {
+	if t != nil {
+		return t.AdaptivePartitionConfig
+	}
+	return
+}
+
 // GetDomainID internal sql blob getter
 func (t *TransferTaskInfo) GetDomainID() (o []byte) {
 	if t != nil {
@@ -86,6 +86,8 @@ type (
 		LastUpdatedTimestamp        time.Time
 		IsolationGroups             []byte
 		IsolationGroupsEncoding     string
+		RoleBindings                []byte
+		RoleBindingsEncoding        string
 	}
 
 	// HistoryBranchRange blob in a serialization agnostic format
@@ -253,14 +255,17 @@ type (
 		ExpiryTimestamp  time.Time
 		CreatedTimestamp time.Time
 		PartitionConfig  map[string]string
+		Priority         int32
+		TypeName         string
 	}
 
 	// TaskListInfo blob in a serialization agnostic format
 	TaskListInfo struct {
-		Kind            int16
-		AckLevel        int64
-		ExpiryTimestamp time.Time
-		LastUpdated     time.Time
+		Kind                    int16
+		AckLevel                int64
+		ExpiryTimestamp         time.Time
+		LastUpdated             time.Time
+		AdaptivePartitionConfig *persistence.TaskListPartitionConfig
 	}
 
 	// TransferTaskInfo blob in a serialization agnostic format
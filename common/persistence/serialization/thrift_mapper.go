@@ -27,6 +27,7 @@ import (
 
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
@@ -128,6 +129,8 @@ func domainInfoToThrift(info *DomainInfo) *sqlblobs.DomainInfo {
 		LastUpdatedTime:                      timeToUnixNanoPtr(info.LastUpdatedTimestamp),
 		IsolationGroupsConfiguration:         info.IsolationGroups,
 		IsolationGroupsConfigurationEncoding: &info.IsolationGroupsEncoding,
+		RoleBindings:                         info.RoleBindings,
+		RoleBindingsEncoding:                 &info.RoleBindingsEncoding,
 	}
 }
 
@@ -162,6 +165,8 @@ func domainInfoFromThrift(info *sqlblobs.DomainInfo) *DomainInfo {
 		LastUpdatedTimestamp:        timeFromUnixNano(info.GetLastUpdatedTime()),
 		IsolationGroups:             info.GetIsolationGroupsConfiguration(),
 		IsolationGroupsEncoding:     info.GetIsolationGroupsConfigurationEncoding(),
+		RoleBindings:                info.RoleBindings,
+		RoleBindingsEncoding:        info.GetRoleBindingsEncoding(),
 	}
 }
 
@@ -530,6 +535,8 @@ func taskInfoToThrift(info *TaskInfo) *sqlblobs.TaskInfo {
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		CreatedTimeNanos: timeToUnixNanoPtr(info.CreatedTimestamp),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         &info.Priority,
+		TypeName:         &info.TypeName,
 	}
 }
 
@@ -544,6 +551,8 @@ func taskInfoFromThrift(info *sqlblobs.TaskInfo) *TaskInfo {
 		ExpiryTimestamp:  timeFromUnixNano(info.GetExpiryTimeNanos()),
 		CreatedTimestamp: timeFromUnixNano(info.GetCreatedTimeNanos()),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         info.GetPriority(),
+		TypeName:         info.GetTypeName(),
 	}
 }
 
@@ -552,10 +561,22 @@ func taskListInfoToThrift(info *TaskListInfo) *sqlblobs.TaskListInfo {
 		return nil
 	}
 	return &sqlblobs.TaskListInfo{
-		Kind:             &info.Kind,
-		AckLevel:         &info.AckLevel,
-		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
-		LastUpdatedNanos: timeToUnixNanoPtr(info.LastUpdated),
+		Kind:                    &info.Kind,
+		AckLevel:                &info.AckLevel,
+		ExpiryTimeNanos:         timeToUnixNanoPtr(info.ExpiryTimestamp),
+		LastUpdatedNanos:        timeToUnixNanoPtr(info.LastUpdated),
+		AdaptivePartitionConfig: taskListPartitionConfigToThrift(info.AdaptivePartitionConfig),
+	}
+}
+
+func taskListPartitionConfigToThrift(config *persistence.TaskListPartitionConfig) *sqlblobs.TaskListPartitionConfig {
+	if config == nil {
+		return nil
+	}
+	return &sqlblobs.TaskListPartitionConfig{
+		Version:            common.Int64Ptr(config.Version),
+		NumReadPartitions:  common.Int32Ptr(int32(config.NumReadPartitions)),
+		NumWritePartitions: common.Int32Ptr(int32(config.NumWritePartitions)),
 	}
 }
 
@@ -564,10 +585,22 @@ func taskListInfoFromThrift(info *sqlblobs.TaskListInfo) *TaskListInfo {
 		return nil
 	}
 	return &TaskListInfo{
-		Kind:            info.GetKind(),
-		AckLevel:        info.GetAckLevel(),
-		ExpiryTimestamp: timeFromUnixNano(info.GetExpiryTimeNanos()),
-		LastUpdated:     timeFromUnixNano(info.GetLastUpdatedNanos()),
+		Kind:                    info.GetKind(),
+		AckLevel:                info.GetAckLevel(),
+		ExpiryTimestamp:         timeFromUnixNano(info.GetExpiryTimeNanos()),
+		LastUpdated:             timeFromUnixNano(info.GetLastUpdatedNanos()),
+		AdaptivePartitionConfig: taskListPartitionConfigFromThrift(info.AdaptivePartitionConfig),
+	}
+}
+
+func taskListPartitionConfigFromThrift(config *sqlblobs.TaskListPartitionConfig) *persistence.TaskListPartitionConfig {
+	if config == nil {
+		return nil
+	}
+	return &persistence.TaskListPartitionConfig{
+		Version:            config.GetVersion(),
+		NumReadPartitions:  int(config.GetNumReadPartitions()),
+		NumWritePartitions: int(config.GetNumWritePartitions()),
 	}
 }
 
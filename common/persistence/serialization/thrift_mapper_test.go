@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -104,6 +105,8 @@ func TestDomainInfo(t *testing.T) {
 		FailoverEndTimestamp:        common.TimePtr(time.Now()),
 		PreviousFailoverVersion:     int64(rand.Intn(1000)),
 		LastUpdatedTimestamp:        time.Now(),
+		RoleBindings:                []byte("RoleBindings"),
+		RoleBindingsEncoding:        "RoleBindingsEncoding",
 	}
 	actual := domainInfoFromThrift(domainInfoToThrift(expected))
 	assert.Equal(t, expected.Name, actual.Name)
@@ -128,6 +131,8 @@ func TestDomainInfo(t *testing.T) {
 	assert.Equal(t, expected.VisibilityArchivalURI, actual.VisibilityArchivalURI)
 	assert.Equal(t, expected.FailoverEndTimestamp.Sub(*actual.FailoverEndTimestamp), time.Duration(0))
 	assert.Equal(t, expected.PreviousFailoverVersion, actual.PreviousFailoverVersion)
+	assert.Equal(t, expected.RoleBindings, actual.RoleBindings)
+	assert.Equal(t, expected.RoleBindingsEncoding, actual.RoleBindingsEncoding)
 	assert.Equal(t, expected.LastUpdatedTimestamp.Sub(actual.LastUpdatedTimestamp), time.Duration(0))
 }
 
@@ -429,6 +434,8 @@ func TestTaskInfo(t *testing.T) {
 		ExpiryTimestamp:  time.Now(),
 		CreatedTimestamp: time.Now(),
 		PartitionConfig:  map[string]string{"zone": "dca1"},
+		Priority:         int32(rand.Intn(10)),
+		TypeName:         "typeName",
 	}
 	actual := taskInfoFromThrift(taskInfoToThrift(expected))
 	assert.Equal(t, expected.WorkflowID, actual.WorkflowID)
@@ -437,6 +444,8 @@ func TestTaskInfo(t *testing.T) {
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 	assert.Equal(t, expected.CreatedTimestamp.Sub(actual.CreatedTimestamp), time.Duration(0))
 	assert.Equal(t, expected.PartitionConfig, actual.PartitionConfig)
+	assert.Equal(t, expected.Priority, actual.Priority)
+	assert.Equal(t, expected.TypeName, actual.TypeName)
 }
 
 func TestTaskListInfo(t *testing.T) {
@@ -445,12 +454,22 @@ func TestTaskListInfo(t *testing.T) {
 		AckLevel:        int64(rand.Intn(1000)),
 		ExpiryTimestamp: time.Now(),
 		LastUpdated:     time.Now(),
+		AdaptivePartitionConfig: &persistence.TaskListPartitionConfig{
+			Version:            int64(rand.Intn(1000)),
+			NumReadPartitions:  rand.Intn(10) + 1,
+			NumWritePartitions: rand.Intn(10) + 1,
+		},
 	}
 	actual := taskListInfoFromThrift(taskListInfoToThrift(expected))
 	assert.Equal(t, expected.Kind, actual.Kind)
 	assert.Equal(t, expected.AckLevel, actual.AckLevel)
 	assert.Equal(t, expected.LastUpdated.Sub(actual.LastUpdated), time.Duration(0))
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
+	assert.Equal(t, expected.AdaptivePartitionConfig, actual.AdaptivePartitionConfig)
+
+	expected.AdaptivePartitionConfig = nil
+	actual = taskListInfoFromThrift(taskListInfoToThrift(expected))
+	assert.Nil(t, actual.AdaptivePartitionConfig)
 }
 
 func TestTransferTaskInfo(t *testing.T) {
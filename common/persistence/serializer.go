@@ -76,6 +76,10 @@ type (
 
 		SerializeIsolationGroups(event *types.IsolationGroupConfiguration, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeIsolationGroups(data *DataBlob) (*types.IsolationGroupConfiguration, error)
+
+		// serialize/deserialize domain role bindings
+		SerializeRoleBindings(bindings []*types.RoleBinding, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeRoleBindings(data *DataBlob) ([]*types.RoleBinding, error)
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -284,6 +288,32 @@ func (t *serializerImpl) DeserializeIsolationGroups(data *DataBlob) (*types.Isol
 	return &cfg, err
 }
 
+func (t *serializerImpl) SerializeRoleBindings(
+	bindings []*types.RoleBinding,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+
+	if bindings == nil {
+		return nil, nil
+	}
+	return t.serialize(bindings, encodingType)
+}
+
+func (t *serializerImpl) DeserializeRoleBindings(
+	data *DataBlob,
+) ([]*types.RoleBinding, error) {
+
+	if data == nil {
+		return nil, nil
+	}
+	bindings := []*types.RoleBinding{}
+	if len(data.Data) == 0 {
+		return bindings, nil
+	}
+	err := t.deserialize(data, &bindings)
+	return bindings, err
+}
+
 func (t *serializerImpl) serialize(input interface{}, encodingType common.EncodingType) (*DataBlob, error) {
 	if input == nil {
 		return nil, nil
@@ -331,6 +361,8 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(thrift.FromDynamicConfigBlob(input))
 	case *types.IsolationGroupConfiguration:
 		return t.thriftrwEncoder.Encode(thrift.FromIsolationGroupConfig(input))
+	case []*types.RoleBinding:
+		return t.thriftrwEncoder.Encode(thrift.FromRoleBindings(input))
 	default:
 		return nil, nil
 	}
@@ -432,6 +464,13 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = *thrift.ToIsolationGroupConfig(&thriftTarget)
 		return nil
+	case *[]*types.RoleBinding:
+		thriftTarget := workflow.DomainRoleBindings{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = thrift.ToRoleBindings(&thriftTarget)
+		return nil
 	default:
 		return nil
 	}
@@ -200,6 +200,10 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	}
 
 	isolationGroupCfg1 := &types.IsolationGroupConfiguration{}
+	roleBindings := []*types.RoleBinding{
+		{Principal: "team-a", Role: types.DomainRoleWrite.Ptr()},
+		{Principal: "cadence-admins", Role: types.DomainRoleAdmin.Ptr()},
+	}
 
 	for i := 0; i < concurrency; i++ {
 
@@ -584,6 +588,23 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			isolationGroups2FromJSON, err := serializer.DeserializeIsolationGroups(nil)
 			s.Nil(err)
 			s.Nil(isolationGroups2FromJSON)
+
+			// role bindings
+			for _, encodingType := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON} {
+				nilRoleBindings, err := serializer.SerializeRoleBindings(nil, encodingType)
+				s.Nil(err)
+				s.Nil(nilRoleBindings)
+
+				for _, bindings := range [][]*types.RoleBinding{{}, roleBindings} {
+					roleBindingsBlob, err := serializer.SerializeRoleBindings(bindings, encodingType)
+					s.Nil(err)
+					s.NotNil(roleBindingsBlob)
+
+					roleBindingsFromBlob, err := serializer.DeserializeRoleBindings(roleBindingsBlob)
+					s.Nil(err)
+					s.Equal(bindings, roleBindingsFromBlob)
+				}
+			}
 		}()
 	}
 
@@ -57,6 +57,14 @@ func (m *sqlConfigStore) FetchConfig(ctx context.Context, configType persistence
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (m *sqlConfigStore) FetchConfigHistory(ctx context.Context, configType persistence.ConfigType, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (m *sqlConfigStore) UpdateConfig(ctx context.Context, value *persistence.InternalConfigStoreEntry) error {
 	return fmt.Errorf("not implemented")
 }
+
+func (m *sqlConfigStore) DeleteConfigHistory(ctx context.Context, configType persistence.ConfigType, beforeVersion int64) error {
+	return fmt.Errorf("not implemented")
+}
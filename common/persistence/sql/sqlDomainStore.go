@@ -112,6 +112,13 @@ func (m *sqlDomainStore) CreateDomain(
 		isolationGroupsEncoding = request.Config.IsolationGroups.GetEncodingString()
 	}
 
+	var roleBindings []byte
+	var roleBindingsEncoding string
+	if request.Config != nil && request.Config.RoleBindings != nil {
+		roleBindings = request.Config.RoleBindings.GetData()
+		roleBindingsEncoding = request.Config.RoleBindings.GetEncodingString()
+	}
+
 	domainInfo := &serialization.DomainInfo{
 		Name:                        request.Info.Name,
 		Status:                      int32(request.Info.Status),
@@ -138,6 +145,8 @@ func (m *sqlDomainStore) CreateDomain(
 		BadBinariesEncoding:         badBinariesEncoding,
 		IsolationGroups:             isolationGroups,
 		IsolationGroupsEncoding:     isolationGroupsEncoding,
+		RoleBindings:                roleBindings,
+		RoleBindingsEncoding:        roleBindingsEncoding,
 	}
 
 	blob, err := m.parser.DomainInfoToBlob(domainInfo)
@@ -240,6 +249,11 @@ func (m *sqlDomainStore) domainRowToGetDomainResponse(row *sqlplugin.DomainRow)
 		isolationGroups = persistence.NewDataBlob(domainInfo.IsolationGroups, common.EncodingType(domainInfo.IsolationGroupsEncoding))
 	}
 
+	var roleBindings *persistence.DataBlob
+	if domainInfo.RoleBindings != nil {
+		roleBindings = persistence.NewDataBlob(domainInfo.RoleBindings, common.EncodingType(domainInfo.RoleBindingsEncoding))
+	}
+
 	return &persistence.InternalGetDomainResponse{
 		Info: &persistence.DomainInfo{
 			ID:          row.ID.String(),
@@ -260,6 +274,7 @@ func (m *sqlDomainStore) domainRowToGetDomainResponse(row *sqlplugin.DomainRow)
 			VisibilityArchivalURI:    domainInfo.GetVisibilityArchivalURI(),
 			BadBinaries:              badBinaries,
 			IsolationGroups:          isolationGroups,
+			RoleBindings:             roleBindings,
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: cluster.GetOrUseDefaultActiveCluster(m.activeClusterName, domainInfo.GetActiveClusterName()),
@@ -300,6 +315,13 @@ func (m *sqlDomainStore) UpdateDomain(
 		isolationGroupsEncoding = request.Config.IsolationGroups.GetEncodingString()
 	}
 
+	var roleBindings []byte
+	roleBindingsEncoding := string(common.EncodingTypeEmpty)
+	if request.Config.RoleBindings != nil {
+		roleBindings = request.Config.RoleBindings.Data
+		roleBindingsEncoding = request.Config.RoleBindings.GetEncodingString()
+	}
+
 	domainInfo := &serialization.DomainInfo{
 		Status:                      int32(request.Info.Status),
 		Description:                 request.Info.Description,
@@ -326,6 +348,8 @@ func (m *sqlDomainStore) UpdateDomain(
 		BadBinariesEncoding:         badBinariesEncoding,
 		IsolationGroups:             isolationGroups,
 		IsolationGroupsEncoding:     isolationGroupsEncoding,
+		RoleBindings:                roleBindings,
+		RoleBindingsEncoding:        roleBindingsEncoding,
 	}
 
 	blob, err := m.parser.DomainInfoToBlob(domainInfo)
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -176,10 +177,27 @@ func getCadencePackageDir() (string, error) {
 		panic(err)
 	}
 	cadenceIndex := strings.LastIndex(cadencePackageDir, "/cadence/")
+	if cadenceIndex < 0 {
+		// the repo is not checked out into a cadence directory, look for the go.mod of the module instead
+		return getModuleRootDir(cadencePackageDir)
+	}
 	cadencePackageDir = cadencePackageDir[:cadenceIndex+len("/cadence/")]
 	return cadencePackageDir, err
 }
 
+func getModuleRootDir(dir string) (string, error) {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir + "/", nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("unable to find the cadence module root")
+		}
+		dir = parent
+	}
+}
+
 // loadDatabaseSchema loads the schema from the given .sql files on this database
 func (s *testCluster) loadDatabaseSchema(dir string, fileNames []string, override bool) (err error) {
 	db, err := NewSQLAdminDB(&s.cfg)
@@ -174,13 +174,14 @@ func (m *sqlTaskStore) LeaseTaskList(
 			return fmt.Errorf("%v rows affected instead of 1", rowsAffected)
 		}
 		resp = &persistence.LeaseTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
-			DomainID:    request.DomainID,
-			Name:        request.TaskList,
-			TaskType:    request.TaskType,
-			RangeID:     rangeID + 1,
-			AckLevel:    ackLevel,
-			Kind:        request.TaskListKind,
-			LastUpdated: now,
+			DomainID:                request.DomainID,
+			Name:                    request.TaskList,
+			TaskType:                request.TaskType,
+			RangeID:                 rangeID + 1,
+			AckLevel:                ackLevel,
+			Kind:                    request.TaskListKind,
+			LastUpdated:             now,
+			AdaptivePartitionConfig: tlInfo.GetAdaptivePartitionConfig(),
 		}}
 		return nil
 	})
@@ -194,10 +195,11 @@ func (m *sqlTaskStore) UpdateTaskList(
 	dbShardID := sqlplugin.GetDBShardIDFromDomainIDAndTasklist(request.TaskListInfo.DomainID, request.TaskListInfo.Name, m.db.GetTotalNumDBShards())
 	domainID := serialization.MustParseUUID(request.TaskListInfo.DomainID)
 	tlInfo := &serialization.TaskListInfo{
-		AckLevel:        request.TaskListInfo.AckLevel,
-		Kind:            int16(request.TaskListInfo.Kind),
-		ExpiryTimestamp: time.Unix(0, 0),
-		LastUpdated:     time.Now(),
+		AckLevel:                request.TaskListInfo.AckLevel,
+		Kind:                    int16(request.TaskListInfo.Kind),
+		ExpiryTimestamp:         time.Unix(0, 0),
+		LastUpdated:             time.Now(),
+		AdaptivePartitionConfig: request.TaskListInfo.AdaptivePartitionConfig,
 	}
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		tlInfo.ExpiryTimestamp = stickyTaskListExpiry()
@@ -325,6 +327,7 @@ func (m *sqlTaskStore) ListTaskList(
 		resp.Items[i].AckLevel = info.GetAckLevel()
 		resp.Items[i].Expiry = info.GetExpiryTimestamp()
 		resp.Items[i].LastUpdated = info.GetLastUpdated()
+		resp.Items[i].AdaptivePartitionConfig = info.GetAdaptivePartitionConfig()
 	}
 
 	return resp, nil
@@ -393,6 +396,8 @@ func (m *sqlTaskStore) CreateTasks(
 			ExpiryTimestamp:  expiryTime,
 			CreatedTimestamp: time.Now(),
 			PartitionConfig:  v.Data.PartitionConfig,
+			Priority:         v.Data.Priority,
+			TypeName:         v.Data.TypeName,
 		})
 		if err != nil {
 			return nil, err
@@ -480,6 +485,8 @@ func (m *sqlTaskStore) GetTasks(
 			Expiry:          info.GetExpiryTimestamp(),
 			CreatedTime:     info.GetCreatedTimestamp(),
 			PartitionConfig: info.GetPartitionConfig(),
+			Priority:        info.GetPriority(),
+			TypeName:        info.GetTypeName(),
 		}
 	}
 
@@ -0,0 +1,274 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // needed to register the sqlite3 driver
+
+	"github.com/uber/cadence/common/config"
+	pt "github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	sqliteschema "github.com/uber/cadence/schema/sqlite"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "sqlite"
+	// driverName is the name of the database/sql driver registered by go-sqlite3
+	driverName = "sqlite3"
+	// memoryModeAttrName is the connect attribute to keep a database in memory, i.e. mode=memory
+	memoryModeAttrName  = "mode"
+	memoryModeAttrValue = "memory"
+	// adminDatabaseName is used when connecting without a database name, e.g. to create or drop a database
+	adminDatabaseName = ":memory:"
+)
+
+var dsnAttrDefaults = map[string]string{
+	"_busy_timeout": "10000",
+}
+
+var dsnAttrOverrides = map[string]string{
+	// sqlite has no row level locks, so every transaction takes the database write lock when it begins
+	"_txlock": "immediate",
+	"_loc":    "UTC",
+}
+
+type (
+	plugin struct{}
+
+	// sharedConn is a connection to a database shared by every store of this process.
+	// SQLite only allows a single writer, so all the access to a database goes through a single connection
+	sharedConn struct {
+		dbName   string
+		inMemory bool
+		refCount int
+		xdb      *sqlx.DB
+	}
+)
+
+var (
+	sharedConnsLock sync.Mutex
+	// sharedConns are keyed by dsn
+	sharedConns = map[string]*sharedConn{}
+)
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginName, &plugin{})
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
+	conns, err := sqldriver.CreateDBConnections(cfg, p.createSingleDBConn)
+	if err != nil {
+		return nil, err
+	}
+	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards)
+}
+
+// CreateAdminDB initialize the adminDb object
+func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
+	conns, err := sqldriver.CreateDBConnections(cfg, p.createSingleDBConn)
+	if err != nil {
+		return nil, err
+	}
+	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards)
+}
+
+// createSingleDBConn returns the connection shared by the given database.
+// An in-memory database doesn't outlive the process, so its schema is set up the first time it's used
+func (p *plugin) createSingleDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	if cfg.DatabaseName == "" {
+		// nothing is shared for the admin connection, it's closed along with the db object
+		return openConn(buildDSN(cfg))
+	}
+
+	sharedConnsLock.Lock()
+	defer sharedConnsLock.Unlock()
+
+	dsn := buildDSN(cfg)
+	conn, ok := sharedConns[dsn]
+	if !ok {
+		xdb, err := openConn(dsn)
+		if err != nil {
+			return nil, err
+		}
+		conn = &sharedConn{
+			dbName:   cfg.DatabaseName,
+			inMemory: isInMemory(cfg),
+			xdb:      xdb,
+		}
+		sharedConns[dsn] = conn
+	}
+	if conn.inMemory {
+		if err := setupSchema(conn.xdb, conn.dbName); err != nil {
+			return nil, fmt.Errorf("failed to setup schema of in-memory database %v: %v", conn.dbName, err)
+		}
+	}
+	conn.refCount++
+	return conn.xdb, nil
+}
+
+func openConn(dsn string) (*sqlx.DB, error) {
+	db, err := sqlx.Connect(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	// sqlite serializes all the writes, a single connection avoids busy errors between connections
+	// and also keeps an in-memory database alive, so the pool settings in the config are not used
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}
+
+// releaseConns closes the connections which are no longer used by any db object.
+// In-memory databases are kept until they are dropped, otherwise their data would be lost
+func releaseConns(xdbs []*sqlx.DB) error {
+	sharedConnsLock.Lock()
+	defer sharedConnsLock.Unlock()
+
+	var firstErr error
+	for _, xdb := range xdbs {
+		dsn, conn := findSharedConn(xdb)
+		if conn == nil {
+			if err := xdb.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		conn.refCount--
+		if conn.refCount <= 0 && !conn.inMemory {
+			delete(sharedConns, dsn)
+			if err := xdb.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// dropMemoryConn discards the in-memory database of the given name, it returns false
+// if there is no such in-memory database
+func dropMemoryConn(dbName string) bool {
+	sharedConnsLock.Lock()
+	defer sharedConnsLock.Unlock()
+
+	dropped := false
+	for dsn, conn := range sharedConns {
+		if conn.inMemory && conn.dbName == dbName {
+			delete(sharedConns, dsn)
+			_ = conn.xdb.Close()
+			dropped = true
+		}
+	}
+	return dropped
+}
+
+func findSharedConn(xdb *sqlx.DB) (string, *sharedConn) {
+	for dsn, conn := range sharedConns {
+		if conn.xdb == xdb {
+			return dsn, conn
+		}
+	}
+	return "", nil
+}
+
+// setupSchema loads the embedded cadence and visibility schema into a database which has no schema yet
+func setupSchema(xdb *sqlx.DB, dbName string) error {
+	var count int
+	if err := xdb.Get(&count, `SELECT count(1) FROM sqlite_master WHERE type = 'table' AND name = 'schema_version'`); err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	stmts := []string{createSchemaVersionTableQuery, createSchemaUpdateHistoryTableQuery}
+	for _, file := range []string{"cadence/schema.sql", "visibility/schema.sql"} {
+		content, err := sqliteschema.SchemaFS.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		stmts = append(stmts, string(content))
+	}
+	for _, stmt := range stmts {
+		if _, err := xdb.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	_, err := xdb.Exec(writeSchemaVersionQuery, dbName, time.Now().UTC(), sqliteschema.Version, sqliteschema.Version)
+	return err
+}
+
+func buildDSN(cfg *config.SQL) string {
+	attrs := url.Values{}
+	for k, v := range dsnAttrDefaults {
+		attrs.Set(k, v)
+	}
+	for k, v := range cfg.ConnectAttributes {
+		attrs.Set(k, v)
+	}
+	// these attrs are always overriden
+	for k, v := range dsnAttrOverrides {
+		attrs.Set(k, v)
+	}
+
+	dbName := cfg.DatabaseName
+	if dbName == "" {
+		dbName = adminDatabaseName
+		attrs.Del(memoryModeAttrName)
+	}
+	return "file:" + dbName + "?" + attrs.Encode()
+}
+
+func isInMemory(cfg *config.SQL) bool {
+	return cfg.ConnectAttributes[memoryModeAttrName] == memoryModeAttrValue
+}
+
+const (
+	testSchemaDir = "schema/sqlite"
+)
+
+// GetTestClusterOption return test options
+// The test database is a file in the temp dir, so no external service is needed
+func GetTestClusterOption() *pt.TestBaseOptions {
+	return &pt.TestBaseOptions{
+		DBPluginName: PluginName,
+		DBName:       filepath.Join(os.TempDir(), "cadence_test_"+pt.GenerateRandomDBName(10)+".db"),
+		SchemaDir:    testSchemaDir,
+		StoreType:    config.StoreTypeSQL,
+	}
+}
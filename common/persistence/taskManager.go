@@ -134,6 +134,8 @@ func (t *taskManager) toInternalTaskInfo(taskInfo *TaskInfo) *InternalTaskInfo {
 		Expiry:                 taskInfo.Expiry,
 		CreatedTime:            taskInfo.CreatedTime,
 		PartitionConfig:        taskInfo.PartitionConfig,
+		Priority:               taskInfo.Priority,
+		TypeName:               taskInfo.TypeName,
 	}
 }
 func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *TaskInfo {
@@ -150,5 +152,7 @@ func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *
 		Expiry:                 internalTaskInfo.Expiry,
 		CreatedTime:            internalTaskInfo.CreatedTime,
 		PartitionConfig:        internalTaskInfo.PartitionConfig,
+		Priority:               internalTaskInfo.Priority,
+		TypeName:               internalTaskInfo.TypeName,
 	}
 }
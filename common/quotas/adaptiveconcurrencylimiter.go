@@ -0,0 +1,165 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// weight of a new latency sample in the recent and long term latency of an operation
+	recentLatencyWeight   = 0.1
+	longTermLatencyWeight = 0.01
+	// weight of a new limit in the smoothed limit
+	limitSmoothing = 0.2
+	// the limit never shrinks by more than half on a single sample
+	minGradient = 0.5
+	// multiplicative decrease of the limit on calls failing because the backend is overloaded
+	overloadBackoffRatio = 0.9
+)
+
+type (
+	// AdaptiveConcurrencyLimiter limits the number of concurrent calls to a backend and adapts the limit to its health.
+	// The latency of every operation is tracked separately. When the recent latency of an operation grows above its
+	// long term latency the limit shrinks by their ratio, when a call fails because the backend is overloaded the
+	// limit shrinks multiplicatively, and otherwise it grows back by the square root of the limit.
+	// Low priority calls can only use a share of the limit, so they are shed first when the limit shrinks,
+	// while high priority calls wait for a free slot until their context is done.
+	AdaptiveConcurrencyLimiter struct {
+		minLimit         RPSFunc
+		maxLimit         RPSFunc
+		lowPriorityRatio RPSFunc
+
+		mu       sync.Mutex
+		limit    float64
+		inflight int
+		released chan struct{}
+		latency  map[string]*operationLatency
+	}
+
+	operationLatency struct {
+		recent   float64
+		longTerm float64
+	}
+)
+
+// NewAdaptiveConcurrencyLimiter creates a new limiter adapting the concurrency between minLimit and maxLimit,
+// starting from maxLimit. Low priority calls can use up to lowPriorityRatio of the limit.
+func NewAdaptiveConcurrencyLimiter(minLimit, maxLimit, lowPriorityRatio RPSFunc) *AdaptiveConcurrencyLimiter {
+	return &AdaptiveConcurrencyLimiter{
+		minLimit:         minLimit,
+		maxLimit:         maxLimit,
+		lowPriorityRatio: lowPriorityRatio,
+		limit:            maxLimit(),
+		released:         make(chan struct{}),
+		latency:          make(map[string]*operationLatency),
+	}
+}
+
+// Acquire takes a slot for a call of given operation. Low priority calls are rejected right away when their share
+// of the limit is used, while high priority calls wait for a slot until ctx is done. When a slot is taken,
+// the returned function must be called with whether the call failed because the backend is overloaded.
+func (l *AdaptiveConcurrencyLimiter) Acquire(
+	ctx context.Context,
+	operation string,
+	lowPriority bool,
+) (func(overloaded bool), bool) {
+	for {
+		l.mu.Lock()
+		limit := l.limitLocked()
+		if lowPriority {
+			limit *= l.lowPriorityRatio()
+		}
+		if float64(l.inflight) < math.Max(limit, 1) {
+			l.inflight++
+			l.mu.Unlock()
+			return l.releaseFunc(operation, time.Now()), true
+		}
+		released := l.released
+		l.mu.Unlock()
+
+		if lowPriority {
+			return nil, false
+		}
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+}
+
+// Limit returns the current concurrency limit
+func (l *AdaptiveConcurrencyLimiter) Limit() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limitLocked()
+}
+
+func (l *AdaptiveConcurrencyLimiter) releaseFunc(operation string, start time.Time) func(overloaded bool) {
+	var once sync.Once
+	return func(overloaded bool) {
+		once.Do(func() {
+			l.release(operation, time.Since(start), overloaded)
+		})
+	}
+}
+
+func (l *AdaptiveConcurrencyLimiter) release(operation string, latency time.Duration, overloaded bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inflight--
+	// wake up the waiting calls
+	close(l.released)
+	l.released = make(chan struct{})
+
+	if overloaded {
+		l.setLimitLocked(l.limit * overloadBackoffRatio)
+		return
+	}
+
+	sample := float64(latency)
+	ol, ok := l.latency[operation]
+	if !ok {
+		l.latency[operation] = &operationLatency{recent: sample, longTerm: sample}
+		return
+	}
+	ol.recent += (sample - ol.recent) * recentLatencyWeight
+	ol.longTerm += (sample - ol.longTerm) * longTermLatencyWeight
+
+	gradient := math.Max(minGradient, math.Min(1, ol.longTerm/ol.recent))
+	newLimit := l.limit*gradient + math.Sqrt(l.limit)
+	l.setLimitLocked(l.limit*(1-limitSmoothing) + newLimit*limitSmoothing)
+}
+
+// limitLocked returns the limit within the current bounds, which may have been changed since the limit was set
+func (l *AdaptiveConcurrencyLimiter) limitLocked() float64 {
+	return math.Max(l.minLimit(), math.Min(l.maxLimit(), l.limit))
+}
+
+func (l *AdaptiveConcurrencyLimiter) setLimitLocked(limit float64) {
+	l.limit = limit
+	l.limit = l.limitLocked()
+}
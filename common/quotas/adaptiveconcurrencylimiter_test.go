@@ -0,0 +1,124 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestAdaptiveConcurrencyLimiter(min, max, lowPriorityRatio float64) *AdaptiveConcurrencyLimiter {
+	return NewAdaptiveConcurrencyLimiter(
+		func() float64 { return min },
+		func() float64 { return max },
+		func() float64 { return lowPriorityRatio },
+	)
+}
+
+func TestAdaptiveConcurrencyLimiterShedsLowPriority(t *testing.T) {
+	t.Parallel()
+	limiter := newTestAdaptiveConcurrencyLimiter(1, 4, 0.5)
+
+	release1, ok := limiter.Acquire(context.Background(), "op", true)
+	assert.True(t, ok)
+	_, ok = limiter.Acquire(context.Background(), "op", true)
+	assert.True(t, ok)
+	_, ok = limiter.Acquire(context.Background(), "op", true)
+	assert.False(t, ok, "low priority share should be used")
+
+	// high priority calls can use the rest of the limit
+	_, ok = limiter.Acquire(context.Background(), "op", false)
+	assert.True(t, ok)
+	_, ok = limiter.Acquire(context.Background(), "op", false)
+	assert.True(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, ok = limiter.Acquire(ctx, "op", false)
+	assert.False(t, ok, "high priority call should wait until its context is done")
+
+	release1(false)
+	release1(false) // releasing twice is a no-op
+	_, ok = limiter.Acquire(context.Background(), "op", true)
+	assert.False(t, ok, "low priority share should still be used")
+	_, ok = limiter.Acquire(context.Background(), "op", false)
+	assert.True(t, ok)
+}
+
+func TestAdaptiveConcurrencyLimiterWaitsForRelease(t *testing.T) {
+	t.Parallel()
+	limiter := newTestAdaptiveConcurrencyLimiter(1, 1, 1)
+
+	release, ok := limiter.Acquire(context.Background(), "op", false)
+	assert.True(t, ok)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		release(false)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, ok = limiter.Acquire(ctx, "op", false)
+	assert.True(t, ok)
+}
+
+func TestAdaptiveConcurrencyLimiterAdaptsLimit(t *testing.T) {
+	t.Parallel()
+	limiter := newTestAdaptiveConcurrencyLimiter(10, 100, 0.5)
+	assert.Equal(t, 100.0, limiter.Limit())
+
+	acquire := func() {
+		limiter.mu.Lock()
+		limiter.inflight++
+		limiter.mu.Unlock()
+	}
+	// a steady latency keeps the limit at its maximum
+	for i := 0; i < 10; i++ {
+		acquire()
+		limiter.release("op", time.Millisecond, false)
+	}
+	assert.Equal(t, 100.0, limiter.Limit())
+
+	// a degrading latency shrinks the limit
+	for i := 0; i < 10; i++ {
+		acquire()
+		limiter.release("op", 10*time.Millisecond, false)
+	}
+	degraded := limiter.Limit()
+	assert.Less(t, degraded, 100.0)
+
+	// overload errors shrink the limit down to its minimum
+	for i := 0; i < 100; i++ {
+		acquire()
+		limiter.release("op", time.Millisecond, true)
+	}
+	assert.Equal(t, 10.0, limiter.Limit())
+
+	// a healthy operation grows the limit back
+	for i := 0; i < 100; i++ {
+		acquire()
+		limiter.release("other-op", time.Millisecond, false)
+	}
+	assert.Equal(t, 100.0, limiter.Limit())
+	assert.Equal(t, 0, limiter.inflight)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package quotas
+
+import (
+	"golang.org/x/time/rate"
+)
+
+// LimiterCache stores the limiters of a dimension by key and must be safe for concurrent use.
+// The caches of common/cache satisfy it, which keeps the number of limiters bounded even when
+// the keys come from values set by the callers.
+type LimiterCache interface {
+	Get(key interface{}) interface{}
+	PutIfNotExist(key interface{}, value interface{}) (interface{}, error)
+}
+
+// Dimension limits requests by a key derived from their info, such as the domain and the API name.
+// Requests the key function returns an empty key for are not limited by the dimension.
+type Dimension struct {
+	key      func(info Info) string
+	factory  func(info Info) Limiter
+	limiters LimiterCache
+}
+
+// NewDimension creates a new dimension storing its limiters in given cache.
+// Given factory is called with the info of the first request of each key to create its limiter,
+// and again if the cache evicted the limiter of the key in the meantime.
+func NewDimension(key func(info Info) string, factory func(info Info) Limiter, limiters LimiterCache) *Dimension {
+	return &Dimension{
+		key:      key,
+		factory:  factory,
+		limiters: limiters,
+	}
+}
+
+func (d *Dimension) limiterFor(info Info) (Limiter, bool) {
+	key := d.key(info)
+	if key == "" {
+		return nil, false
+	}
+
+	if limiter, ok := d.limiters.Get(key).(Limiter); ok {
+		return limiter, true
+	}
+
+	newLimiter := d.factory(info)
+	limiter, err := d.limiters.PutIfNotExist(key, newLimiter)
+	if err != nil {
+		// the cache refused the limiter, e.g. it's full of pinned entries, so this request gets a limiter of its own
+		return newLimiter, true
+	}
+	return limiter.(Limiter), true
+}
+
+// DimensionalRateLimiter applies the limits of several dimensions before the underlying policy.
+// Every key of a dimension has its own budget, so an API or a caller exhausting its quota
+// doesn't consume the budget left to the other APIs and callers of the same domain.
+type DimensionalRateLimiter struct {
+	policy     Policy
+	dimensions []*Dimension
+}
+
+// NewDimensionalRateLimiter returns a new rate limiter checking the dimensions in order and then the policy
+func NewDimensionalRateLimiter(policy Policy, dimensions ...*Dimension) *DimensionalRateLimiter {
+	return &DimensionalRateLimiter{
+		policy:     policy,
+		dimensions: dimensions,
+	}
+}
+
+// Allow attempts to allow a request to go through. The method returns
+// immediately with a true or false indicating if the request can make
+// progress
+func (d *DimensionalRateLimiter) Allow(info Info) bool {
+	reservations := make([]*rate.Reservation, 0, len(d.dimensions))
+	cancel := func() {
+		for _, rsv := range reservations {
+			rsv.Cancel()
+		}
+	}
+
+	for _, dimension := range d.dimensions {
+		limiter, ok := dimension.limiterFor(info)
+		if !ok {
+			continue
+		}
+		// take a reservation first so that it can be cancelled if a later stage drops the request
+		rsv := limiter.Reserve()
+		if !rsv.OK() {
+			cancel()
+			return false
+		}
+		if rsv.Delay() != 0 {
+			rsv.Cancel()
+			cancel()
+			return false
+		}
+		reservations = append(reservations, rsv)
+	}
+
+	if !d.policy.Allow(info) {
+		cancel()
+		return false
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package quotas
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDimensionalRateLimiter(t *testing.T) {
+	t.Parallel()
+	policy := newFixedRpsDimensionalRateLimiter(100, 1)
+	check := func(suffix string) {
+		listInfo := Info{Domain: defaultDomain, API: "ListWorkflowExecutions"}
+		assert.True(t, policy.Allow(listInfo), "first should work"+suffix)
+		assert.False(t, policy.Allow(listInfo), "second should be limited"+suffix)
+		// other APIs of the same domain have their own budget
+		assert.True(t, policy.Allow(Info{Domain: defaultDomain, API: "StartWorkflowExecution"}), "other API should work"+suffix)
+		// requests without an API are only limited by the policy
+		assert.True(t, policy.Allow(Info{Domain: defaultDomain}), "no API should work"+suffix)
+	}
+
+	check("")
+	// allow bucket to refill
+	time.Sleep(time.Second)
+	check(" after refill")
+}
+
+func TestDimensionalRateLimiterBlockedByPolicy(t *testing.T) {
+	t.Parallel()
+	policy := newFixedRpsDimensionalRateLimiter(1, 10)
+	assert.True(t, policy.Allow(Info{Domain: defaultDomain, API: "ListWorkflowExecutions"}))
+	assert.False(t, policy.Allow(Info{Domain: defaultDomain, API: "StartWorkflowExecution"}), "domain should be limited")
+}
+
+func TestDimensionalRateLimiterMultipleDimensions(t *testing.T) {
+	t.Parallel()
+	policy := NewDimensionalRateLimiter(
+		newFixedRpsMultiStageRateLimiter(100, 100),
+		NewDimension(
+			func(info Info) string { return info.Domain + "/" + info.API },
+			func(info Info) Limiter { return NewSimpleRateLimiter(100) },
+			newMapLimiterCache(),
+		),
+		NewDimension(
+			func(info Info) string { return info.Caller },
+			func(info Info) Limiter { return NewSimpleRateLimiter(1) },
+			newMapLimiterCache(),
+		),
+	)
+	assert.True(t, policy.Allow(Info{Domain: defaultDomain, API: "ListWorkflowExecutions", Caller: "script"}))
+	assert.False(t, policy.Allow(Info{Domain: defaultDomain, API: "ListWorkflowExecutions", Caller: "script"}), "caller should be limited")
+	// other callers of the same API have their own budget
+	assert.True(t, policy.Allow(Info{Domain: defaultDomain, API: "ListWorkflowExecutions", Caller: "worker"}))
+}
+
+func newFixedRpsDimensionalRateLimiter(domainRps, apiRps float64) Policy {
+	return NewDimensionalRateLimiter(
+		newFixedRpsMultiStageRateLimiter(domainRps, domainRps),
+		NewDimension(
+			func(info Info) string {
+				if info.API == "" {
+					return ""
+				}
+				return info.Domain + "/" + info.API
+			},
+			func(info Info) Limiter {
+				return NewDynamicRateLimiter(func() float64 { return apiRps })
+			},
+			newMapLimiterCache(),
+		),
+	)
+}
+
+func TestDimensionRecreatesEvictedLimiters(t *testing.T) {
+	t.Parallel()
+	limiters := newMapLimiterCache()
+	created := 0
+	dimension := NewDimension(
+		func(info Info) string { return info.Caller },
+		func(info Info) Limiter {
+			created++
+			return NewSimpleRateLimiter(1)
+		},
+		limiters,
+	)
+	policy := NewDimensionalRateLimiter(newFixedRpsMultiStageRateLimiter(100, 100), dimension)
+	info := Info{Domain: defaultDomain, Caller: "script"}
+	assert.True(t, policy.Allow(info))
+	assert.False(t, policy.Allow(info), "caller should be limited")
+	assert.Equal(t, 1, created)
+
+	limiters.evict("script")
+	assert.True(t, policy.Allow(info), "evicted caller should get a new limiter")
+	assert.Equal(t, 2, created)
+}
+
+// mapLimiterCache is an unbounded LimiterCache for tests
+type mapLimiterCache struct {
+	sync.Mutex
+	limiters map[interface{}]interface{}
+}
+
+func newMapLimiterCache() *mapLimiterCache {
+	return &mapLimiterCache{limiters: make(map[interface{}]interface{})}
+}
+
+func (c *mapLimiterCache) Get(key interface{}) interface{} {
+	c.Lock()
+	defer c.Unlock()
+	return c.limiters[key]
+}
+
+func (c *mapLimiterCache) PutIfNotExist(key interface{}, value interface{}) (interface{}, error) {
+	c.Lock()
+	defer c.Unlock()
+	if existing, ok := c.limiters[key]; ok {
+		return existing, nil
+	}
+	c.limiters[key] = value
+	return value, nil
+}
+
+func (c *mapLimiterCache) evict(key interface{}) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.limiters, key)
+}
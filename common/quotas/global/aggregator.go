@@ -0,0 +1,150 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package global
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+)
+
+// usageExpiryIntervals is the number of update intervals after which the usage of a host that stopped
+// reporting is dropped, so that the share of departed hosts is redistributed to the remaining ones
+const usageExpiryIntervals = 3
+
+type (
+	// Aggregator collects the usage of global rate limits reported by all hosts and computes the
+	// share of each limit every host is allowed to use. Each key is aggregated by a single host,
+	// elected through the membership hash ring, so an aggregator only sees the keys it owns.
+	Aggregator struct {
+		timeSource     clock.TimeSource
+		updateInterval dynamicconfig.DurationPropertyFn
+
+		mu     sync.Mutex
+		keys   map[string]*keyUsage
+		lastGC time.Time
+	}
+
+	keyUsage struct {
+		// firstSeen is when the aggregator started to receive updates for the key,
+		// which is reset when the key moves to another aggregator and back
+		firstSeen time.Time
+		hosts     map[string]hostUsage
+	}
+
+	hostUsage struct {
+		rps        float64
+		lastUpdate time.Time
+	}
+)
+
+// NewAggregator creates a new aggregator
+func NewAggregator(timeSource clock.TimeSource, updateInterval dynamicconfig.DurationPropertyFn) *Aggregator {
+	return &Aggregator{
+		timeSource:     timeSource,
+		updateInterval: updateInterval,
+		keys:           make(map[string]*keyUsage),
+		lastGC:         timeSource.Now(),
+	}
+}
+
+// Update records the rps of each key reported by caller and returns the weight of the caller for each key,
+// which is the share of the caller in the total usage of the key by all hosts.
+// Keys the aggregator only started to receive within the last update interval are left out of the result,
+// since the usage of the other hosts is not known yet, e.g. after the key moved from another aggregator.
+func (a *Aggregator) Update(caller string, usage map[string]float64) map[string]float64 {
+	now := a.timeSource.Now()
+	interval := a.updateInterval()
+	expiry := now.Add(-usageExpiryIntervals * interval)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.lastGC.Before(expiry) {
+		a.gc(expiry)
+		a.lastGC = now
+	}
+
+	weights := make(map[string]float64, len(usage))
+	for key, rps := range usage {
+		ku, ok := a.keys[key]
+		if !ok || ku.expired(expiry) {
+			ku = &keyUsage{
+				firstSeen: now,
+				hosts:     make(map[string]hostUsage),
+			}
+			a.keys[key] = ku
+		}
+		ku.hosts[caller] = hostUsage{
+			rps:        rps,
+			lastUpdate: now,
+		}
+
+		if now.Sub(ku.firstSeen) < interval {
+			continue
+		}
+		weights[key] = ku.weight(caller, expiry)
+	}
+	return weights
+}
+
+// gc drops the usage of hosts that stopped reporting and the keys no host reports anymore
+func (a *Aggregator) gc(expiry time.Time) {
+	for key, ku := range a.keys {
+		for host, hu := range ku.hosts {
+			if hu.lastUpdate.Before(expiry) {
+				delete(ku.hosts, host)
+			}
+		}
+		if len(ku.hosts) == 0 {
+			delete(a.keys, key)
+		}
+	}
+}
+
+func (ku *keyUsage) expired(expiry time.Time) bool {
+	for _, hu := range ku.hosts {
+		if !hu.lastUpdate.Before(expiry) {
+			return false
+		}
+	}
+	return true
+}
+
+func (ku *keyUsage) weight(caller string, expiry time.Time) float64 {
+	total := 0.0
+	hosts := 0
+	for host, hu := range ku.hosts {
+		if hu.lastUpdate.Before(expiry) {
+			delete(ku.hosts, host)
+			continue
+		}
+		total += hu.rps
+		hosts++
+	}
+	if total <= 0 {
+		// no host is using the key, split it evenly until there is usage to balance by
+		return 1 / float64(hosts)
+	}
+	return ku.hosts[caller].rps / total
+}
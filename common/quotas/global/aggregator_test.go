@@ -0,0 +1,75 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package global
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+)
+
+func TestAggregator(t *testing.T) {
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	a := NewAggregator(timeSource, dynamicconfig.GetDurationPropertyFn(time.Second))
+
+	// the usage of the other hosts is not known until the key was aggregated for an interval
+	assert.Empty(t, a.Update("host-a", map[string]float64{"key": 30}))
+	assert.Empty(t, a.Update("host-b", map[string]float64{"key": 10}))
+
+	timeSource.Update(now.Add(time.Second))
+	assert.Equal(t, map[string]float64{"key": 0.75}, a.Update("host-a", map[string]float64{"key": 30}))
+	assert.Equal(t, map[string]float64{"key": 0.25}, a.Update("host-b", map[string]float64{"key": 10}))
+
+	// an idle key is split evenly, once the last usage of host-b was replaced
+	timeSource.Update(now.Add(2 * time.Second))
+	assert.Equal(t, map[string]float64{"key": 0}, a.Update("host-a", map[string]float64{"key": 0}))
+	assert.Equal(t, map[string]float64{"key": 0.5}, a.Update("host-b", map[string]float64{"key": 0}))
+
+	// host-b stopped reporting, its usage expires after 3 intervals
+	timeSource.Update(now.Add(4 * time.Second))
+	assert.Equal(t, map[string]float64{"key": 0.5}, a.Update("host-a", map[string]float64{"key": 0, "other": 5}))
+	timeSource.Update(now.Add(6 * time.Second))
+	assert.Equal(t, map[string]float64{"key": 1, "other": 1}, a.Update("host-a", map[string]float64{"key": 5, "other": 5}))
+}
+
+func TestAggregator_ExpiredKey(t *testing.T) {
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	a := NewAggregator(timeSource, dynamicconfig.GetDurationPropertyFn(time.Second))
+
+	a.Update("host-a", map[string]float64{"key": 10})
+	timeSource.Update(now.Add(time.Second))
+	assert.Equal(t, map[string]float64{"key": 1}, a.Update("host-a", map[string]float64{"key": 10}))
+
+	// the key was not reported for 3 intervals, e.g. it was aggregated by another host in the meantime
+	timeSource.Update(now.Add(5 * time.Second))
+	assert.Empty(t, a.Update("host-a", map[string]float64{"key": 10}))
+
+	// keys no host reports anymore are dropped
+	timeSource.Update(now.Add(10 * time.Second))
+	a.Update("host-a", map[string]float64{"other": 10})
+	assert.NotContains(t, a.keys, "key")
+}
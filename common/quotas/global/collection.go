@@ -0,0 +1,245 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package global
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Collection keeps the limiters of global rate limit keys on a host. It periodically reports their usage
+	// to the aggregators and sets the rps of every limiter to the share of the global rps its host is using,
+	// so that a key busier on some hosts than on the others is still limited to its global rps in total.
+	Collection struct {
+		status         int32
+		service        string
+		caller         string
+		resolver       membership.Resolver
+		client         history.Client
+		enabled        dynamicconfig.BoolPropertyFn
+		updateInterval dynamicconfig.DurationPropertyFn
+		timeSource     clock.TimeSource
+		logger         log.Logger
+		stopCh         chan struct{}
+
+		mu       sync.RWMutex
+		limiters map[string]*limiter
+	}
+
+	limiter struct {
+		quotas.Limiter
+		requests int64
+
+		mu          sync.RWMutex
+		weight      float64
+		lastUpdated time.Time
+	}
+)
+
+// idleHostsShare is the share of the global rps split evenly across all hosts, the rest is split by the weights
+// of the hosts. It lets hosts with no recent usage serve some requests, while the limits of all hosts still add
+// up to the global rps as their weights add up to 1.
+const idleHostsShare = 0.1
+
+// NewCollection creates a new collection of global limiters for the hosts of given service.
+// Until a limiter receives its weight from the aggregator, or when the collection is disabled,
+// the global rps is divided evenly by the number of hosts of the service, like quotas.PerMember does.
+func NewCollection(
+	service string,
+	caller string,
+	resolver membership.Resolver,
+	client history.Client,
+	enabled dynamicconfig.BoolPropertyFn,
+	updateInterval dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *Collection {
+	return &Collection{
+		status:         common.DaemonStatusInitialized,
+		service:        service,
+		caller:         caller,
+		resolver:       resolver,
+		client:         client,
+		enabled:        enabled,
+		updateInterval: updateInterval,
+		timeSource:     timeSource,
+		logger:         logger,
+		stopCh:         make(chan struct{}),
+		limiters:       make(map[string]*limiter),
+	}
+}
+
+// Start starts reporting the usage of the limiters
+func (c *Collection) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go c.updateLoop()
+}
+
+// Stop stops reporting the usage of the limiters
+func (c *Collection) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(c.stopCh)
+}
+
+// For retrieves the limiter of a given key, limiting it to globalRPS across all hosts and instanceRPS on this host.
+// If limiter for such key does not exists, it creates new one.
+func (c *Collection) For(key string, globalRPS, instanceRPS quotas.RPSFunc) quotas.Limiter {
+	c.mu.RLock()
+	l, ok := c.limiters[key]
+	c.mu.RUnlock()
+	if ok {
+		return l
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if l, ok = c.limiters[key]; !ok {
+		l = &limiter{}
+		l.Limiter = quotas.NewDynamicRateLimiter(func() float64 {
+			return c.rps(l, globalRPS(), instanceRPS())
+		})
+		c.limiters[key] = l
+	}
+	return l
+}
+
+func (c *Collection) rps(l *limiter, globalRPS, instanceRPS float64) float64 {
+	if globalRPS <= 0 || !c.enabled() {
+		return quotas.PerMember(c.service, globalRPS, instanceRPS, c.resolver)
+	}
+
+	weight, ok := l.getWeight(c.timeSource.Now().Add(-usageExpiryIntervals * c.updateInterval()))
+	if !ok {
+		return quotas.PerMember(c.service, globalRPS, instanceRPS, c.resolver)
+	}
+	memberCount, err := c.resolver.MemberCount(c.service)
+	if err != nil || memberCount < 1 {
+		return quotas.PerMember(c.service, globalRPS, instanceRPS, c.resolver)
+	}
+	evenShare := idleHostsShare * globalRPS / float64(memberCount)
+	return math.Min(evenShare+(1-idleHostsShare)*globalRPS*weight, instanceRPS)
+}
+
+func (c *Collection) updateLoop() {
+	lastUpdate := c.timeSource.Now()
+	timer := time.NewTimer(c.updateInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case <-timer.C:
+			now := c.timeSource.Now()
+			c.update(now.Sub(lastUpdate))
+			lastUpdate = now
+			timer.Reset(c.updateInterval())
+		}
+	}
+}
+
+// update reports the rps of every limiter since the last update and applies the weights returned by the aggregators
+func (c *Collection) update(elapsed time.Duration) {
+	c.mu.RLock()
+	usage := make(map[string]float64, len(c.limiters))
+	for key, l := range c.limiters {
+		// requests are reset even when the collection is disabled, so that
+		// the first update after enabling it only reports the recent usage
+		usage[key] = float64(atomic.SwapInt64(&l.requests, 0)) / elapsed.Seconds()
+	}
+	c.mu.RUnlock()
+
+	if !c.enabled() || len(usage) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.updateInterval())
+	defer cancel()
+	resp, err := c.client.RatelimitUpdate(ctx, &types.RatelimitUpdateRequest{
+		Caller: c.caller,
+		Usage:  usage,
+	})
+	if err != nil {
+		c.logger.Warn("Failed to update global ratelimits", tag.Error(err))
+		return
+	}
+
+	now := c.timeSource.Now()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for key, weight := range resp.GetWeights() {
+		if l, ok := c.limiters[key]; ok {
+			l.setWeight(weight, now)
+		}
+	}
+}
+
+func (l *limiter) Allow() bool {
+	atomic.AddInt64(&l.requests, 1)
+	return l.Limiter.Allow()
+}
+
+func (l *limiter) Wait(ctx context.Context) error {
+	atomic.AddInt64(&l.requests, 1)
+	return l.Limiter.Wait(ctx)
+}
+
+func (l *limiter) Reserve() *rate.Reservation {
+	atomic.AddInt64(&l.requests, 1)
+	return l.Limiter.Reserve()
+}
+
+func (l *limiter) setWeight(weight float64, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.weight = weight
+	l.lastUpdated = now
+}
+
+// getWeight returns the last weight received for the limiter, unless it was received before expiry
+func (l *limiter) getWeight(expiry time.Time) (float64, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.lastUpdated.IsZero() || l.lastUpdated.Before(expiry) {
+		return 0, false
+	}
+	return l.weight, true
+}
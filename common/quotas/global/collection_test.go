@@ -0,0 +1,129 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package global
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestCollection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	resolver := membership.NewMockResolver(ctrl)
+	resolver.EXPECT().MemberCount("frontend").Return(4, nil).AnyTimes()
+	client := history.NewMockClient(ctrl)
+
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	enabled := false
+	c := NewCollection(
+		"frontend",
+		"host-a",
+		resolver,
+		client,
+		func(...dynamicconfig.FilterOption) bool { return enabled },
+		dynamicconfig.GetDurationPropertyFn(time.Second),
+		timeSource,
+		loggerimpl.NewNopLogger(),
+	)
+	globalRPS := func() float64 { return 20 }
+	instanceRPS := func() float64 { return 12 }
+	l := c.For("key", globalRPS, instanceRPS).(*limiter)
+	assert.Equal(t, l, c.For("key", globalRPS, instanceRPS))
+
+	// disabled, the global rps is divided by the number of hosts
+	for i := 0; i < 10; i++ {
+		l.Allow()
+	}
+	c.update(time.Second)
+	assert.Equal(t, 5.0, c.rps(l, globalRPS(), instanceRPS()))
+
+	// enabled, the usage is reported and the weight applied
+	enabled = true
+	for i := 0; i < 4; i++ {
+		l.Allow()
+	}
+	client.EXPECT().RatelimitUpdate(gomock.Any(), &types.RatelimitUpdateRequest{
+		Caller: "host-a",
+		Usage:  map[string]float64{"key": 2},
+	}).Return(&types.RatelimitUpdateResponse{Weights: map[string]float64{"key": 0.25, "unknown": 1}}, nil)
+	c.update(2 * time.Second)
+	assert.Equal(t, 5.0, c.rps(l, globalRPS(), instanceRPS()))
+
+	// the rps of a busy host is still capped by the instance rps
+	client.EXPECT().RatelimitUpdate(gomock.Any(), gomock.Any()).Return(&types.RatelimitUpdateResponse{Weights: map[string]float64{"key": 0.9}}, nil)
+	c.update(time.Second)
+	assert.Equal(t, 12.0, c.rps(l, globalRPS(), instanceRPS()))
+
+	// an idle host is allowed its part of the share of the global rps split evenly
+	client.EXPECT().RatelimitUpdate(gomock.Any(), gomock.Any()).Return(&types.RatelimitUpdateResponse{Weights: map[string]float64{"key": 0}}, nil)
+	c.update(time.Second)
+	assert.Equal(t, 0.5, c.rps(l, globalRPS(), instanceRPS()))
+
+	// failed updates keep the last weight until it expires
+	client.EXPECT().RatelimitUpdate(gomock.Any(), gomock.Any()).Return(nil, &types.InternalServiceError{}).AnyTimes()
+	c.update(time.Second)
+	assert.Equal(t, 0.5, c.rps(l, globalRPS(), instanceRPS()))
+	timeSource.Update(now.Add(4 * time.Second))
+	assert.Equal(t, 5.0, c.rps(l, globalRPS(), instanceRPS()))
+}
+
+func TestCollection_LimitsAddUpToGlobalRPS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	resolver := membership.NewMockResolver(ctrl)
+	resolver.EXPECT().MemberCount("frontend").Return(100, nil).AnyTimes()
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	c := NewCollection(
+		"frontend",
+		"host-a",
+		resolver,
+		history.NewMockClient(ctrl),
+		dynamicconfig.GetBoolPropertyFn(true),
+		dynamicconfig.GetDurationPropertyFn(time.Second),
+		timeSource,
+		loggerimpl.NewNopLogger(),
+	)
+
+	// a single busy host and 99 idle ones
+	for _, globalRPS := range []float64{10, 1000} {
+		total := 0.0
+		for host := 0; host < 100; host++ {
+			weight := 0.0
+			if host == 0 {
+				weight = 1
+			}
+			l := &limiter{}
+			l.setWeight(weight, timeSource.Now())
+			total += c.rps(l, globalRPS, globalRPS)
+		}
+		assert.InDelta(t, globalRPS, total, 1e-9)
+	}
+}
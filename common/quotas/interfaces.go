@@ -35,6 +35,14 @@ type RPSKeyFunc func(key string) float64
 // Info corresponds to information required to determine rate limits
 type Info struct {
 	Domain string
+	// API is the name of the API called by the request
+	API string
+	// Caller is the identity of the caller, e.g. the identity reported by a worker.
+	// It's set by the caller and not authenticated, so it must not be trusted to separate tenants.
+	Caller string
+	// CallerType is the client implementation of the caller, e.g. cli, uber-go or uber-java.
+	// Like Caller, it's reported by the caller.
+	CallerType string
 }
 
 // Limiter corresponds to basic rate limiting functionality.
@@ -286,6 +286,7 @@ type GetDynamicConfigResponse struct {
 type UpdateDynamicConfigRequest struct {
 	ConfigName   string                `json:"configName,omitempty"`
 	ConfigValues []*DynamicConfigValue `json:"configValues,omitempty"`
+	Identity     string                `json:"identity,omitempty"`
 }
 
 func (v *UpdateDynamicConfigRequest) SerializeForLogging() (string, error) {
@@ -298,6 +299,7 @@ func (v *UpdateDynamicConfigRequest) SerializeForLogging() (string, error) {
 type RestoreDynamicConfigRequest struct {
 	ConfigName string                 `json:"configName,omitempty"`
 	Filters    []*DynamicConfigFilter `json:"filters,omitempty"`
+	Identity   string                 `json:"identity,omitempty"`
 }
 
 func (v *RestoreDynamicConfigRequest) SerializeForLogging() (string, error) {
@@ -352,6 +354,35 @@ type AdminDeleteWorkflowResponse struct {
 type AdminMaintainWorkflowRequest = AdminDeleteWorkflowRequest
 type AdminMaintainWorkflowResponse = AdminDeleteWorkflowResponse
 
+// RestoreArchivedWorkflowRequest is an internal type (TBD...)
+type RestoreArchivedWorkflowRequest struct {
+	Domain    string             `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+}
+
+func (v *RestoreArchivedWorkflowRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RestoreArchivedWorkflowRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *RestoreArchivedWorkflowRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
 type ListDynamicConfigRequest struct {
 	ConfigName string `json:"configName,omitempty"`
 }
@@ -367,6 +398,34 @@ type ListDynamicConfigResponse struct {
 	Entries []*DynamicConfigEntry `json:"entries,omitempty"`
 }
 
+type ListDynamicConfigHistoryRequest struct {
+	ConfigName string `json:"configName,omitempty"`
+}
+
+func (v *ListDynamicConfigHistoryRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+type ListDynamicConfigHistoryResponse struct {
+	Versions []*DynamicConfigVersion `json:"versions,omitempty"`
+}
+
+type RollbackDynamicConfigRequest struct {
+	Version    int64  `json:"version,omitempty"`
+	ConfigName string `json:"configName,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+}
+
+func (v *RollbackDynamicConfigRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
 type IsolationGroupState int
 
 const (
@@ -23,6 +23,15 @@ package types
 type DynamicConfigBlob struct {
 	SchemaVersion int64                 `json:"schemaVersion,omitempty"`
 	Entries       []*DynamicConfigEntry `json:"entries,omitempty"`
+	Author        string                `json:"author,omitempty"`
+	Timestamp     int64                 `json:"timestamp,omitempty"`
+}
+
+type DynamicConfigVersion struct {
+	Version   int64                 `json:"version,omitempty"`
+	Author    string                `json:"author,omitempty"`
+	Timestamp int64                 `json:"timestamp,omitempty"`
+	Entries   []*DynamicConfigEntry `json:"entries,omitempty"`
 }
 
 type DynamicConfigEntry struct {
@@ -33,6 +42,7 @@ type DynamicConfigEntry struct {
 type DynamicConfigValue struct {
 	Value   *DataBlob              `json:"value,omitempty"`
 	Filters []*DynamicConfigFilter `json:"filters,omitempty"`
+	Source  string                 `json:"source,omitempty"`
 }
 
 type DynamicConfigFilter struct {
@@ -625,6 +625,28 @@ func (v *HistoryRefreshWorkflowTasksRequest) GetRequest() (o *RefreshWorkflowTas
 	return
 }
 
+// HistoryRestoreArchivedWorkflowRequest is an internal type (TBD...)
+type HistoryRestoreArchivedWorkflowRequest struct {
+	DomainUUID string             `json:"domainUUID,omitempty"`
+	Execution  *WorkflowExecution `json:"execution,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryRestoreArchivedWorkflowRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *HistoryRestoreArchivedWorkflowRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
 // RemoveSignalMutableStateRequest is an internal type (TBD...)
 type RemoveSignalMutableStateRequest struct {
 	DomainUUID        string             `json:"domainUUID,omitempty"`
@@ -1197,3 +1219,38 @@ func (v *GetFailoverInfoResponse) GetPendingShards() (o []int32) {
 	}
 	return
 }
+
+// RatelimitUpdateRequest is an internal type (TBD...)
+type RatelimitUpdateRequest struct {
+	Caller string             `json:"caller,omitempty"`
+	Usage  map[string]float64 `json:"usage,omitempty"`
+}
+
+// GetCaller is an internal getter (TBD...)
+func (v *RatelimitUpdateRequest) GetCaller() (o string) {
+	if v != nil {
+		return v.Caller
+	}
+	return
+}
+
+// GetUsage is an internal getter (TBD...)
+func (v *RatelimitUpdateRequest) GetUsage() (o map[string]float64) {
+	if v != nil && v.Usage != nil {
+		return v.Usage
+	}
+	return
+}
+
+// RatelimitUpdateResponse is an internal type (TBD...)
+type RatelimitUpdateResponse struct {
+	Weights map[string]float64 `json:"weights,omitempty"`
+}
+
+// GetWeights is an internal getter (TBD...)
+func (v *RatelimitUpdateResponse) GetWeights() (o map[string]float64) {
+	if v != nil && v.Weights != nil {
+		return v.Weights
+	}
+	return
+}
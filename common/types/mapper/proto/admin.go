@@ -25,6 +25,7 @@ import (
 
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -860,6 +861,7 @@ func FromUpdateDynamicConfigRequest(t *types.UpdateDynamicConfigRequest) *adminv
 	return &adminv1.UpdateDynamicConfigRequest{
 		ConfigName:   t.ConfigName,
 		ConfigValues: FromDynamicConfigValueArray(t.ConfigValues),
+		Identity:     t.Identity,
 	}
 }
 
@@ -871,6 +873,7 @@ func ToUpdateDynamicConfigRequest(t *adminv1.UpdateDynamicConfigRequest) *types.
 	return &types.UpdateDynamicConfigRequest{
 		ConfigName:   t.ConfigName,
 		ConfigValues: ToDynamicConfigValueArray(t.ConfigValues),
+		Identity:     t.Identity,
 	}
 }
 
@@ -882,6 +885,7 @@ func FromRestoreDynamicConfigRequest(t *types.RestoreDynamicConfigRequest) *admi
 	return &adminv1.RestoreDynamicConfigRequest{
 		ConfigName: t.ConfigName,
 		Filters:    FromDynamicConfigFilterArray(t.Filters),
+		Identity:   t.Identity,
 	}
 }
 
@@ -893,6 +897,7 @@ func ToRestoreDynamicConfigRequest(t *adminv1.RestoreDynamicConfigRequest) *type
 	return &types.RestoreDynamicConfigRequest{
 		ConfigName: t.ConfigName,
 		Filters:    ToDynamicConfigFilterArray(t.Filters),
+		Identity:   t.Identity,
 	}
 }
 
@@ -988,6 +993,28 @@ func ToAdminMaintainWorkflowResponse(t *adminv1.MaintainCorruptWorkflowResponse)
 	}
 }
 
+// FromAdminRestoreArchivedWorkflowRequest converts internal RestoreArchivedWorkflowRequest type to proto
+func FromAdminRestoreArchivedWorkflowRequest(t *types.RestoreArchivedWorkflowRequest) *adminv1.RestoreArchivedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RestoreArchivedWorkflowRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToAdminRestoreArchivedWorkflowRequest converts proto RestoreArchivedWorkflowRequest type to internal
+func ToAdminRestoreArchivedWorkflowRequest(t *adminv1.RestoreArchivedWorkflowRequest) *types.RestoreArchivedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RestoreArchivedWorkflowRequest{
+		Domain:    t.Domain,
+		Execution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
 // FromListDynamicConfigRequest converts internal ListDynamicConfigRequest type to proto
 func FromListDynamicConfigRequest(t *types.ListDynamicConfigRequest) *adminv1.ListDynamicConfigRequest {
 	if t == nil {
@@ -1028,6 +1055,120 @@ func ToListDynamicConfigResponse(t *adminv1.ListDynamicConfigResponse) *types.Li
 	}
 }
 
+// FromListDynamicConfigHistoryRequest converts internal ListDynamicConfigHistoryRequest type to proto
+func FromListDynamicConfigHistoryRequest(t *types.ListDynamicConfigHistoryRequest) *adminv1.ListDynamicConfigHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListDynamicConfigHistoryRequest{
+		ConfigName: t.ConfigName,
+	}
+}
+
+// ToListDynamicConfigHistoryRequest converts proto ListDynamicConfigHistoryRequest type to internal
+func ToListDynamicConfigHistoryRequest(t *adminv1.ListDynamicConfigHistoryRequest) *types.ListDynamicConfigHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigHistoryRequest{
+		ConfigName: t.ConfigName,
+	}
+}
+
+// FromListDynamicConfigHistoryResponse converts internal ListDynamicConfigHistoryResponse type to proto
+func FromListDynamicConfigHistoryResponse(t *types.ListDynamicConfigHistoryResponse) *adminv1.ListDynamicConfigHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListDynamicConfigHistoryResponse{
+		Versions: FromDynamicConfigVersionArray(t.Versions),
+	}
+}
+
+// ToListDynamicConfigHistoryResponse converts proto ListDynamicConfigHistoryResponse type to internal
+func ToListDynamicConfigHistoryResponse(t *adminv1.ListDynamicConfigHistoryResponse) *types.ListDynamicConfigHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigHistoryResponse{
+		Versions: ToDynamicConfigVersionArray(t.Versions),
+	}
+}
+
+// FromRollbackDynamicConfigRequest converts internal RollbackDynamicConfigRequest type to proto
+func FromRollbackDynamicConfigRequest(t *types.RollbackDynamicConfigRequest) *adminv1.RollbackDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RollbackDynamicConfigRequest{
+		Version:    t.Version,
+		ConfigName: t.ConfigName,
+		Identity:   t.Identity,
+	}
+}
+
+// ToRollbackDynamicConfigRequest converts proto RollbackDynamicConfigRequest type to internal
+func ToRollbackDynamicConfigRequest(t *adminv1.RollbackDynamicConfigRequest) *types.RollbackDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RollbackDynamicConfigRequest{
+		Version:    t.Version,
+		ConfigName: t.ConfigName,
+		Identity:   t.Identity,
+	}
+}
+
+// FromDynamicConfigVersionArray converts internal DynamicConfigVersion array type to proto
+func FromDynamicConfigVersionArray(t []*types.DynamicConfigVersion) []*adminv1.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.DynamicConfigVersion, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigVersion(t[i])
+	}
+	return v
+}
+
+// ToDynamicConfigVersionArray converts proto DynamicConfigVersion array type to internal
+func ToDynamicConfigVersionArray(t []*adminv1.DynamicConfigVersion) []*types.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigVersion, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigVersion(t[i])
+	}
+	return v
+}
+
+// FromDynamicConfigVersion converts internal DynamicConfigVersion type to proto
+func FromDynamicConfigVersion(t *types.DynamicConfigVersion) *adminv1.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DynamicConfigVersion{
+		Version:   t.Version,
+		Author:    t.Author,
+		Timestamp: unixNanoToTime(&t.Timestamp),
+		Entries:   FromDynamicConfigEntryArray(t.Entries),
+	}
+}
+
+// ToDynamicConfigVersion converts proto DynamicConfigVersion type to internal
+func ToDynamicConfigVersion(t *adminv1.DynamicConfigVersion) *types.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigVersion{
+		Version:   t.Version,
+		Author:    t.Author,
+		Timestamp: common.Int64Default(timeToUnixNano(t.Timestamp)),
+		Entries:   ToDynamicConfigEntryArray(t.Entries),
+	}
+}
+
 // FromDynamicConfigEntryArray converts internal DynamicConfigEntry array type to proto
 func FromDynamicConfigEntryArray(t []*types.DynamicConfigEntry) []*adminv1.DynamicConfigEntry {
 	if t == nil {
@@ -1106,6 +1247,7 @@ func FromDynamicConfigValue(t *types.DynamicConfigValue) *adminv1.DynamicConfigV
 	return &adminv1.DynamicConfigValue{
 		Value:   FromDataBlob(t.Value),
 		Filters: FromDynamicConfigFilterArray(t.Filters),
+		Source:  t.Source,
 	}
 }
 
@@ -1117,6 +1259,7 @@ func ToDynamicConfigValue(t *adminv1.DynamicConfigValue) *types.DynamicConfigVal
 	return &types.DynamicConfigValue{
 		Value:   ToDataBlob(t.Value),
 		Filters: ToDynamicConfigFilterArray(t.Filters),
+		Source:  t.Source,
 	}
 }
 
@@ -210,6 +210,38 @@ func TestAdminRespondCrossClusterTasksCompletedResponse(t *testing.T) {
 	}
 }
 
+func TestDynamicConfigValue(t *testing.T) {
+	for _, item := range []*types.DynamicConfigValue{nil, {}, {Value: &testdata.DataBlob, Source: "overlay.yaml"}} {
+		assert.Equal(t, item, ToDynamicConfigValue(FromDynamicConfigValue(item)))
+	}
+}
+
+func TestListDynamicConfigHistoryResponse(t *testing.T) {
+	version := &types.DynamicConfigVersion{
+		Version:   3,
+		Author:    "tester",
+		Timestamp: testdata.Timestamp1,
+		Entries: []*types.DynamicConfigEntry{
+			{Name: "testKey", Values: []*types.DynamicConfigValue{{Value: &testdata.DataBlob}}},
+		},
+	}
+	for _, item := range []*types.ListDynamicConfigHistoryResponse{nil, {}, {Versions: []*types.DynamicConfigVersion{version, {}}}} {
+		assert.Equal(t, item, ToListDynamicConfigHistoryResponse(FromListDynamicConfigHistoryResponse(item)))
+	}
+}
+
+func TestRollbackDynamicConfigRequest(t *testing.T) {
+	for _, item := range []*types.RollbackDynamicConfigRequest{nil, {}, {Version: 3, ConfigName: "testKey", Identity: "tester"}} {
+		assert.Equal(t, item, ToRollbackDynamicConfigRequest(FromRollbackDynamicConfigRequest(item)))
+	}
+}
+
+func TestAdminRestoreArchivedWorkflowRequest(t *testing.T) {
+	for _, item := range []*types.RestoreArchivedWorkflowRequest{nil, {}, &testdata.AdminRestoreArchivedWorkflowRequest} {
+		assert.Equal(t, item, ToAdminRestoreArchivedWorkflowRequest(FromAdminRestoreArchivedWorkflowRequest(item)))
+	}
+}
+
 func TestFromGetGlobalIsolationGroupsResponse(t *testing.T) {
 	tests := map[string]struct {
 		in       *types.GetGlobalIsolationGroupsResponse
@@ -302,6 +302,77 @@ func ToBadBinaries(t *apiv1.BadBinaries) *types.BadBinaries {
 	}
 }
 
+func FromDomainRole(t *types.DomainRole) apiv1.DomainRole {
+	if t == nil {
+		return apiv1.DomainRole_DOMAIN_ROLE_INVALID
+	}
+	switch *t {
+	case types.DomainRoleRead:
+		return apiv1.DomainRole_DOMAIN_ROLE_READ
+	case types.DomainRoleWrite:
+		return apiv1.DomainRole_DOMAIN_ROLE_WRITE
+	case types.DomainRoleAdmin:
+		return apiv1.DomainRole_DOMAIN_ROLE_ADMIN
+	}
+	panic("unexpected enum value")
+}
+
+func ToDomainRole(t apiv1.DomainRole) *types.DomainRole {
+	switch t {
+	case apiv1.DomainRole_DOMAIN_ROLE_INVALID:
+		return nil
+	case apiv1.DomainRole_DOMAIN_ROLE_READ:
+		return types.DomainRoleRead.Ptr()
+	case apiv1.DomainRole_DOMAIN_ROLE_WRITE:
+		return types.DomainRoleWrite.Ptr()
+	case apiv1.DomainRole_DOMAIN_ROLE_ADMIN:
+		return types.DomainRoleAdmin.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromRoleBinding(t *types.RoleBinding) *apiv1.RoleBinding {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RoleBinding{
+		Principal: t.Principal,
+		Role:      FromDomainRole(t.Role),
+	}
+}
+
+func ToRoleBinding(t *apiv1.RoleBinding) *types.RoleBinding {
+	if t == nil {
+		return nil
+	}
+	return &types.RoleBinding{
+		Principal: t.Principal,
+		Role:      ToDomainRole(t.Role),
+	}
+}
+
+func FromRoleBindingArray(t []*types.RoleBinding) []*apiv1.RoleBinding {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.RoleBinding, len(t))
+	for i := range t {
+		v[i] = FromRoleBinding(t[i])
+	}
+	return v
+}
+
+func ToRoleBindingArray(t []*apiv1.RoleBinding) []*types.RoleBinding {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.RoleBinding, len(t))
+	for i := range t {
+		v[i] = ToRoleBinding(t[i])
+	}
+	return v
+}
+
 func FromBadBinaryInfo(t *types.BadBinaryInfo) *apiv1.BadBinaryInfo {
 	if t == nil {
 		return nil
@@ -1077,6 +1148,7 @@ func FromDescribeDomainResponseDomain(t *types.DescribeDomainResponse) *apiv1.Do
 		domain.HistoryArchivalUri = config.HistoryArchivalURI
 		domain.VisibilityArchivalStatus = FromArchivalStatus(config.VisibilityArchivalStatus)
 		domain.VisibilityArchivalUri = config.VisibilityArchivalURI
+		domain.RoleBindings = FromRoleBindingArray(config.RoleBindings)
 	}
 	if repl := t.ReplicationConfiguration; repl != nil {
 		domain.ActiveClusterName = repl.ActiveClusterName
@@ -1119,6 +1191,7 @@ func ToDescribeDomainResponseDomain(t *apiv1.Domain) *types.DescribeDomainRespon
 			VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.VisibilityArchivalUri,
 			IsolationGroups:                        ToIsolationGroupConfig(t.IsolationGroups),
+			RoleBindings:                           ToRoleBindingArray(t.RoleBindings),
 		},
 		ReplicationConfiguration: &types.DomainReplicationConfiguration{
 			ActiveClusterName: t.ActiveClusterName,
@@ -4084,6 +4157,7 @@ const (
 	DomainUpdateClustersField                 = "clusters"
 	DomainUpdateDeleteBadBinaryField          = "delete_bad_binary"
 	DomainUpdateFailoverTimeoutField          = "failover_timeout"
+	DomainUpdateRoleBindingsField             = "role_bindings"
 )
 
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
@@ -4149,6 +4223,10 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRe
 		request.FailoverTimeout = secondsToDuration(t.FailoverTimeoutInSeconds)
 		fields = append(fields, DomainUpdateFailoverTimeoutField)
 	}
+	if t.RoleBindings != nil {
+		request.RoleBindings = FromRoleBindingArray(t.RoleBindings)
+		fields = append(fields, DomainUpdateRoleBindingsField)
+	}
 
 	request.UpdateMask = newFieldMask(fields)
 
@@ -4204,6 +4282,13 @@ func ToUpdateDomainRequest(t *apiv1.UpdateDomainRequest) *types.UpdateDomainRequ
 	if fs.isSet(DomainUpdateFailoverTimeoutField) {
 		request.FailoverTimeoutInSeconds = durationToSeconds(t.FailoverTimeout)
 	}
+	if fs.isSet(DomainUpdateRoleBindingsField) {
+		// an empty list in the mask removes all the bindings, so it must not map to nil
+		request.RoleBindings = ToRoleBindingArray(t.RoleBindings)
+		if request.RoleBindings == nil {
+			request.RoleBindings = []*types.RoleBinding{}
+		}
+	}
 
 	return &request
 }
@@ -4232,6 +4317,7 @@ func FromUpdateDomainResponse(t *types.UpdateDomainResponse) *apiv1.UpdateDomain
 		domain.HistoryArchivalUri = config.HistoryArchivalURI
 		domain.VisibilityArchivalStatus = FromArchivalStatus(config.VisibilityArchivalStatus)
 		domain.VisibilityArchivalUri = config.VisibilityArchivalURI
+		domain.RoleBindings = FromRoleBindingArray(config.RoleBindings)
 	}
 	if repl := t.ReplicationConfiguration; repl != nil {
 		domain.ActiveClusterName = repl.ActiveClusterName
@@ -4264,6 +4350,7 @@ func ToUpdateDomainResponse(t *apiv1.UpdateDomainResponse) *types.UpdateDomainRe
 			VisibilityArchivalStatus:               ToArchivalStatus(t.Domain.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.Domain.VisibilityArchivalUri,
 			IsolationGroups:                        ToIsolationGroupConfig(t.GetDomain().GetIsolationGroups()),
+			RoleBindings:                           ToRoleBindingArray(t.Domain.RoleBindings),
 		},
 		ReplicationConfiguration: &types.DomainReplicationConfiguration{
 			ActiveClusterName: t.Domain.ActiveClusterName,
@@ -1053,3 +1053,18 @@ func TestDescribeTaskListResponseMap(t *testing.T) {
 		assert.Equal(t, item, ToDescribeTaskListResponseMap(FromDescribeTaskListResponseMap(item)))
 	}
 }
+
+func TestDescribeDomainResponse_RoleBindings(t *testing.T) {
+	withRoleBindings := testdata.DomainConfiguration
+	withRoleBindings.RoleBindings = testdata.RoleBindings
+	item := testdata.DescribeDomainResponse
+	item.Configuration = &withRoleBindings
+	assert.Equal(t, &item, ToDescribeDomainResponse(FromDescribeDomainResponse(&item)))
+}
+
+func TestUpdateDomainRequest_RoleBindings(t *testing.T) {
+	for _, roleBindings := range [][]*types.RoleBinding{nil, {}, testdata.RoleBindings} {
+		request := &types.UpdateDomainRequest{Name: testdata.DomainName, RoleBindings: roleBindings}
+		assert.Equal(t, request, ToUpdateDomainRequest(FromUpdateDomainRequest(request)))
+	}
+}
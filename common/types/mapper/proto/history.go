@@ -287,6 +287,44 @@ func ToHistoryGetFailoverInfoResponse(t *historyv1.GetFailoverInfoResponse) *typ
 	}
 }
 
+func FromHistoryRatelimitUpdateRequest(t *types.RatelimitUpdateRequest) *historyv1.RatelimitUpdateRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.RatelimitUpdateRequest{
+		Caller: t.Caller,
+		Usage:  t.Usage,
+	}
+}
+
+func ToHistoryRatelimitUpdateRequest(t *historyv1.RatelimitUpdateRequest) *types.RatelimitUpdateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RatelimitUpdateRequest{
+		Caller: t.Caller,
+		Usage:  t.Usage,
+	}
+}
+
+func FromHistoryRatelimitUpdateResponse(t *types.RatelimitUpdateResponse) *historyv1.RatelimitUpdateResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.RatelimitUpdateResponse{
+		Weights: t.Weights,
+	}
+}
+
+func ToHistoryRatelimitUpdateResponse(t *historyv1.RatelimitUpdateResponse) *types.RatelimitUpdateResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RatelimitUpdateResponse{
+		Weights: t.Weights,
+	}
+}
+
 func FromHistoryGetMutableStateRequest(t *types.GetMutableStateRequest) *historyv1.GetMutableStateRequest {
 	if t == nil {
 		return nil
@@ -942,6 +980,26 @@ func ToHistoryRefreshWorkflowTasksRequest(t *historyv1.RefreshWorkflowTasksReque
 	}
 }
 
+func FromHistoryRestoreArchivedWorkflowRequest(t *types.HistoryRestoreArchivedWorkflowRequest) *historyv1.RestoreArchivedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.RestoreArchivedWorkflowRequest{
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+func ToHistoryRestoreArchivedWorkflowRequest(t *historyv1.RestoreArchivedWorkflowRequest) *types.HistoryRestoreArchivedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRestoreArchivedWorkflowRequest{
+		DomainUUID: t.DomainId,
+		Execution:  ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
 func FromHistoryRemoveSignalMutableStateRequest(t *types.RemoveSignalMutableStateRequest) *historyv1.RemoveSignalMutableStateRequest {
 	if t == nil {
 		return nil
@@ -212,6 +212,12 @@ func TestHistoryRefreshWorkflowTasksRequest(t *testing.T) {
 	}
 	assert.Nil(t, FromHistoryRefreshWorkflowTasksRequest(&types.HistoryRefreshWorkflowTasksRequest{}))
 }
+
+func TestHistoryRestoreArchivedWorkflowRequest(t *testing.T) {
+	for _, item := range []*types.HistoryRestoreArchivedWorkflowRequest{nil, {}, &testdata.HistoryRestoreArchivedWorkflowRequest} {
+		assert.Equal(t, item, ToHistoryRestoreArchivedWorkflowRequest(FromHistoryRestoreArchivedWorkflowRequest(item)))
+	}
+}
 func TestHistoryRemoveSignalMutableStateRequest(t *testing.T) {
 	for _, item := range []*types.RemoveSignalMutableStateRequest{nil, {}, &testdata.HistoryRemoveSignalMutableStateRequest} {
 		assert.Equal(t, item, ToHistoryRemoveSignalMutableStateRequest(FromHistoryRemoveSignalMutableStateRequest(item)))
@@ -363,3 +369,15 @@ func TestHistoryGetTaskInfoResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryGetFailoverInfoResponse(FromHistoryGetFailoverInfoResponse(item)))
 	}
 }
+
+func TestHistoryRatelimitUpdateRequest(t *testing.T) {
+	for _, item := range []*types.RatelimitUpdateRequest{nil, {}, &testdata.RatelimitUpdateRequest} {
+		assert.Equal(t, item, ToHistoryRatelimitUpdateRequest(FromHistoryRatelimitUpdateRequest(item)))
+	}
+}
+
+func TestHistoryRatelimitUpdateResponse(t *testing.T) {
+	for _, item := range []*types.RatelimitUpdateResponse{nil, {}, &testdata.RatelimitUpdateResponse} {
+		assert.Equal(t, item, ToHistoryRatelimitUpdateResponse(FromHistoryRatelimitUpdateResponse(item)))
+	}
+}
@@ -41,6 +41,8 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:            t.ForwardedFrom,
 		ActivityTaskDispatchInfo: FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:          t.PartitionConfig,
+		Priority:                 t.Priority,
+		ActivityType:             FromActivityType(t.ActivityType),
 	}
 }
 
@@ -59,6 +61,8 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		ForwardedFrom:                 t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
+		ActivityType:                  ToActivityType(t.ActivityType),
 	}
 }
 
@@ -75,6 +79,8 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
 		PartitionConfig:        t.PartitionConfig,
+		Priority:               t.Priority,
+		WorkflowType:           FromWorkflowType(t.WorkflowType),
 	}
 }
 
@@ -91,6 +97,8 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
+		WorkflowType:                  ToWorkflowType(t.WorkflowType),
 	}
 }
 
@@ -236,6 +244,68 @@ func ToMatchingListTaskListPartitionsResponse(t *matchingv1.ListTaskListPartitio
 	}
 }
 
+func FromTaskListPartitionConfig(t *types.TaskListPartitionConfig) *matchingv1.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.TaskListPartitionConfig{
+		Version:            t.Version,
+		NumReadPartitions:  t.NumReadPartitions,
+		NumWritePartitions: t.NumWritePartitions,
+	}
+}
+
+func ToTaskListPartitionConfig(t *matchingv1.TaskListPartitionConfig) *types.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListPartitionConfig{
+		Version:            t.Version,
+		NumReadPartitions:  t.NumReadPartitions,
+		NumWritePartitions: t.NumWritePartitions,
+	}
+}
+
+func FromMatchingGetTaskListPartitionConfigRequest(t *types.MatchingGetTaskListPartitionConfigRequest) *matchingv1.GetTaskListPartitionConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetTaskListPartitionConfigRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+func ToMatchingGetTaskListPartitionConfigRequest(t *matchingv1.GetTaskListPartitionConfigRequest) *types.MatchingGetTaskListPartitionConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetTaskListPartitionConfigRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+func FromMatchingGetTaskListPartitionConfigResponse(t *types.MatchingGetTaskListPartitionConfigResponse) *matchingv1.GetTaskListPartitionConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetTaskListPartitionConfigResponse{
+		PartitionConfig: FromTaskListPartitionConfig(t.PartitionConfig),
+	}
+}
+
+func ToMatchingGetTaskListPartitionConfigResponse(t *matchingv1.GetTaskListPartitionConfigResponse) *types.MatchingGetTaskListPartitionConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetTaskListPartitionConfigResponse{
+		PartitionConfig: ToTaskListPartitionConfig(t.PartitionConfig),
+	}
+}
+
 func FromMatchingGetTaskListsByDomainRequest(t *types.GetTaskListsByDomainRequest) *matchingv1.GetTaskListsByDomainRequest {
 	if t == nil {
 		return nil
@@ -77,6 +77,18 @@ func TestMatchingListTaskListPartitionsResponse(t *testing.T) {
 	}
 }
 
+func TestMatchingGetTaskListPartitionConfigRequest(t *testing.T) {
+	for _, item := range []*types.MatchingGetTaskListPartitionConfigRequest{nil, {}, &testdata.MatchingGetTaskListPartitionConfigRequest} {
+		assert.Equal(t, item, ToMatchingGetTaskListPartitionConfigRequest(FromMatchingGetTaskListPartitionConfigRequest(item)))
+	}
+}
+
+func TestMatchingGetTaskListPartitionConfigResponse(t *testing.T) {
+	for _, item := range []*types.MatchingGetTaskListPartitionConfigResponse{nil, {}, &testdata.MatchingGetTaskListPartitionConfigResponse} {
+		assert.Equal(t, item, ToMatchingGetTaskListPartitionConfigResponse(FromMatchingGetTaskListPartitionConfigResponse(item)))
+	}
+}
+
 func TestMatchingPollForActivityTaskRequest(t *testing.T) {
 	for _, item := range []*types.MatchingPollForActivityTaskRequest{nil, {}, &testdata.MatchingPollForActivityTaskRequest} {
 		assert.Equal(t, item, ToMatchingPollForActivityTaskRequest(FromMatchingPollForActivityTaskRequest(item)))
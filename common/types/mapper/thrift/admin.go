@@ -522,6 +522,7 @@ func FromUpdateDynamicConfigRequest(t *types.UpdateDynamicConfigRequest) *admin.
 	return &admin.UpdateDynamicConfigRequest{
 		ConfigName:   &t.ConfigName,
 		ConfigValues: FromDynamicConfigValueArray(t.ConfigValues),
+		Identity:     &t.Identity,
 	}
 }
 
@@ -533,6 +534,7 @@ func ToUpdateDynamicConfigRequest(t *admin.UpdateDynamicConfigRequest) *types.Up
 	return &types.UpdateDynamicConfigRequest{
 		ConfigName:   t.GetConfigName(),
 		ConfigValues: ToDynamicConfigValueArray(t.ConfigValues),
+		Identity:     t.GetIdentity(),
 	}
 }
 
@@ -544,6 +546,7 @@ func FromRestoreDynamicConfigRequest(t *types.RestoreDynamicConfigRequest) *admi
 	return &admin.RestoreDynamicConfigRequest{
 		ConfigName: &t.ConfigName,
 		Filters:    FromDynamicConfigFilterArray(t.Filters),
+		Identity:   &t.Identity,
 	}
 }
 
@@ -555,6 +558,7 @@ func ToRestoreDynamicConfigRequest(t *admin.RestoreDynamicConfigRequest) *types.
 	return &types.RestoreDynamicConfigRequest{
 		ConfigName: t.GetConfigName(),
 		Filters:    ToDynamicConfigFilterArray(t.Filters),
+		Identity:   t.GetIdentity(),
 	}
 }
 
@@ -604,6 +608,28 @@ func ToAdminDeleteWorkflowResponse(t *admin.AdminDeleteWorkflowResponse) *types.
 	}
 }
 
+// FromRestoreArchivedWorkflowRequest converts internal RestoreArchivedWorkflowRequest type to thrift
+func FromRestoreArchivedWorkflowRequest(t *types.RestoreArchivedWorkflowRequest) *admin.RestoreArchivedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RestoreArchivedWorkflowRequest{
+		Domain:    &t.Domain,
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToRestoreArchivedWorkflowRequest converts thrift RestoreArchivedWorkflowRequest type to internal
+func ToRestoreArchivedWorkflowRequest(t *admin.RestoreArchivedWorkflowRequest) *types.RestoreArchivedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RestoreArchivedWorkflowRequest{
+		Domain:    t.GetDomain(),
+		Execution: ToWorkflowExecution(t.Execution),
+	}
+}
+
 // FromAdminMaintainWorkflowRequest converts internal AdminMaintainWorkflowRequest type to thrift
 func FromAdminMaintainWorkflowRequest(t *types.AdminMaintainWorkflowRequest) *admin.AdminMaintainWorkflowRequest {
 	if t == nil {
@@ -690,6 +716,70 @@ func ToListDynamicConfigResponse(t *admin.ListDynamicConfigResponse) *types.List
 	}
 }
 
+// FromListDynamicConfigHistoryRequest converts internal ListDynamicConfigHistoryRequest type to thrift
+func FromListDynamicConfigHistoryRequest(t *types.ListDynamicConfigHistoryRequest) *admin.ListDynamicConfigHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListDynamicConfigHistoryRequest{
+		ConfigName: &t.ConfigName,
+	}
+}
+
+// ToListDynamicConfigHistoryRequest converts thrift ListDynamicConfigHistoryRequest type to internal
+func ToListDynamicConfigHistoryRequest(t *admin.ListDynamicConfigHistoryRequest) *types.ListDynamicConfigHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigHistoryRequest{
+		ConfigName: t.GetConfigName(),
+	}
+}
+
+// FromListDynamicConfigHistoryResponse converts internal ListDynamicConfigHistoryResponse type to thrift
+func FromListDynamicConfigHistoryResponse(t *types.ListDynamicConfigHistoryResponse) *admin.ListDynamicConfigHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListDynamicConfigHistoryResponse{
+		Versions: FromDynamicConfigVersionArray(t.Versions),
+	}
+}
+
+// ToListDynamicConfigHistoryResponse converts thrift ListDynamicConfigHistoryResponse type to internal
+func ToListDynamicConfigHistoryResponse(t *admin.ListDynamicConfigHistoryResponse) *types.ListDynamicConfigHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigHistoryResponse{
+		Versions: ToDynamicConfigVersionArray(t.Versions),
+	}
+}
+
+// FromRollbackDynamicConfigRequest converts internal RollbackDynamicConfigRequest type to thrift
+func FromRollbackDynamicConfigRequest(t *types.RollbackDynamicConfigRequest) *admin.RollbackDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RollbackDynamicConfigRequest{
+		Version:    &t.Version,
+		ConfigName: &t.ConfigName,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToRollbackDynamicConfigRequest converts thrift RollbackDynamicConfigRequest type to internal
+func ToRollbackDynamicConfigRequest(t *admin.RollbackDynamicConfigRequest) *types.RollbackDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RollbackDynamicConfigRequest{
+		Version:    t.GetVersion(),
+		ConfigName: t.GetConfigName(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
 func FromGetGlobalIsolationGroupsRequest(t *types.GetGlobalIsolationGroupsRequest) *admin.GetGlobalIsolationGroupsRequest {
 	if t == nil {
 		return nil
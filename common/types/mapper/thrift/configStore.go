@@ -34,6 +34,8 @@ func FromDynamicConfigBlob(t *types.DynamicConfigBlob) *config.DynamicConfigBlob
 	return &config.DynamicConfigBlob{
 		SchemaVersion: &t.SchemaVersion,
 		Entries:       FromDynamicConfigEntryArray(t.Entries),
+		Author:        &t.Author,
+		Timestamp:     &t.Timestamp,
 	}
 }
 
@@ -45,6 +47,58 @@ func ToDynamicConfigBlob(t *config.DynamicConfigBlob) *types.DynamicConfigBlob {
 	return &types.DynamicConfigBlob{
 		SchemaVersion: t.GetSchemaVersion(),
 		Entries:       ToDynamicConfigEntryArray(t.Entries),
+		Author:        t.GetAuthor(),
+		Timestamp:     t.GetTimestamp(),
+	}
+}
+
+// FromDynamicConfigVersionArray converts internal DynamicConfigVersion array type to thrift
+func FromDynamicConfigVersionArray(t []*types.DynamicConfigVersion) []*config.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	v := make([]*config.DynamicConfigVersion, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigVersion(t[i])
+	}
+	return v
+}
+
+// ToDynamicConfigVersionArray converts thrift DynamicConfigVersion array type to internal
+func ToDynamicConfigVersionArray(t []*config.DynamicConfigVersion) []*types.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigVersion, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigVersion(t[i])
+	}
+	return v
+}
+
+// FromDynamicConfigVersion converts internal DynamicConfigVersion type to thrift
+func FromDynamicConfigVersion(t *types.DynamicConfigVersion) *config.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	return &config.DynamicConfigVersion{
+		Version:   &t.Version,
+		Author:    &t.Author,
+		Timestamp: &t.Timestamp,
+		Entries:   FromDynamicConfigEntryArray(t.Entries),
+	}
+}
+
+// ToDynamicConfigVersion converts thrift DynamicConfigVersion type to internal
+func ToDynamicConfigVersion(t *config.DynamicConfigVersion) *types.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigVersion{
+		Version:   t.GetVersion(),
+		Author:    t.GetAuthor(),
+		Timestamp: t.GetTimestamp(),
+		Entries:   ToDynamicConfigEntryArray(t.Entries),
 	}
 }
 
@@ -126,6 +180,7 @@ func FromDynamicConfigValue(t *types.DynamicConfigValue) *config.DynamicConfigVa
 	return &config.DynamicConfigValue{
 		Value:   FromDataBlob(t.Value),
 		Filters: FromDynamicConfigFilterArray(t.Filters),
+		Source:  &t.Source,
 	}
 }
 
@@ -137,6 +192,7 @@ func ToDynamicConfigValue(t *config.DynamicConfigValue) *types.DynamicConfigValu
 	return &types.DynamicConfigValue{
 		Value:   ToDataBlob(t.Value),
 		Filters: ToDynamicConfigFilterArray(t.Filters),
+		Source:  t.GetSource(),
 	}
 }
 
@@ -676,6 +676,28 @@ func ToHistoryRefreshWorkflowTasksRequest(t *history.RefreshWorkflowTasksRequest
 	}
 }
 
+// FromHistoryRestoreArchivedWorkflowRequest converts internal HistoryRestoreArchivedWorkflowRequest type to thrift
+func FromHistoryRestoreArchivedWorkflowRequest(t *types.HistoryRestoreArchivedWorkflowRequest) *history.RestoreArchivedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.RestoreArchivedWorkflowRequest{
+		DomainUUID: &t.DomainUUID,
+		Execution:  FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToHistoryRestoreArchivedWorkflowRequest converts thrift RestoreArchivedWorkflowRequest type to internal
+func ToHistoryRestoreArchivedWorkflowRequest(t *history.RestoreArchivedWorkflowRequest) *types.HistoryRestoreArchivedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRestoreArchivedWorkflowRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Execution:  ToWorkflowExecution(t.Execution),
+	}
+}
+
 // FromRemoveSignalMutableStateRequest converts internal RemoveSignalMutableStateRequest type to thrift
 func FromRemoveSignalMutableStateRequest(t *types.RemoveSignalMutableStateRequest) *history.RemoveSignalMutableStateRequest {
 	if t == nil {
@@ -1295,3 +1317,45 @@ func ToGetFailoverInfoResponse(t *history.GetFailoverInfoResponse) *types.GetFai
 		PendingShards:       t.GetPendingShards(),
 	}
 }
+
+// FromRatelimitUpdateRequest converts internal RatelimitUpdateRequest type to thrift
+func FromRatelimitUpdateRequest(t *types.RatelimitUpdateRequest) *history.RatelimitUpdateRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.RatelimitUpdateRequest{
+		Caller: &t.Caller,
+		Usage:  t.Usage,
+	}
+}
+
+// ToRatelimitUpdateRequest converts thrift RatelimitUpdateRequest type to internal
+func ToRatelimitUpdateRequest(t *history.RatelimitUpdateRequest) *types.RatelimitUpdateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RatelimitUpdateRequest{
+		Caller: t.GetCaller(),
+		Usage:  t.Usage,
+	}
+}
+
+// FromRatelimitUpdateResponse converts internal RatelimitUpdateResponse type to thrift
+func FromRatelimitUpdateResponse(t *types.RatelimitUpdateResponse) *history.RatelimitUpdateResponse {
+	if t == nil {
+		return nil
+	}
+	return &history.RatelimitUpdateResponse{
+		Weights: t.Weights,
+	}
+}
+
+// ToRatelimitUpdateResponse converts thrift RatelimitUpdateResponse type to internal
+func ToRatelimitUpdateResponse(t *history.RatelimitUpdateResponse) *types.RatelimitUpdateResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RatelimitUpdateResponse{
+		Weights: t.Weights,
+	}
+}
@@ -43,6 +43,8 @@ func FromAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matching.AddAc
 		ForwardedFrom:                 &t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      &t.Priority,
+		ActivityType:                  FromActivityType(t.ActivityType),
 	}
 }
 
@@ -62,6 +64,8 @@ func ToAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types.AddActi
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.GetPriority(),
+		ActivityType:                  ToActivityType(t.ActivityType),
 	}
 }
 
@@ -110,6 +114,8 @@ func FromAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matching.AddDe
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      &t.Priority,
+		WorkflowType:                  FromWorkflowType(t.WorkflowType),
 	}
 }
 
@@ -127,6 +133,8 @@ func ToAddDecisionTaskRequest(t *matching.AddDecisionTaskRequest) *types.AddDeci
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.GetPriority(),
+		WorkflowType:                  ToWorkflowType(t.WorkflowType),
 	}
 }
 
@@ -200,6 +208,74 @@ func ToMatchingListTaskListPartitionsRequest(t *matching.ListTaskListPartitionsR
 	}
 }
 
+// FromTaskListPartitionConfig converts internal TaskListPartitionConfig type to thrift
+func FromTaskListPartitionConfig(t *types.TaskListPartitionConfig) *matching.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &matching.TaskListPartitionConfig{
+		Version:            &t.Version,
+		NumReadPartitions:  &t.NumReadPartitions,
+		NumWritePartitions: &t.NumWritePartitions,
+	}
+}
+
+// ToTaskListPartitionConfig converts thrift TaskListPartitionConfig type to internal
+func ToTaskListPartitionConfig(t *matching.TaskListPartitionConfig) *types.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListPartitionConfig{
+		Version:            t.GetVersion(),
+		NumReadPartitions:  t.GetNumReadPartitions(),
+		NumWritePartitions: t.GetNumWritePartitions(),
+	}
+}
+
+// FromMatchingGetTaskListPartitionConfigRequest converts internal GetTaskListPartitionConfigRequest type to thrift
+func FromMatchingGetTaskListPartitionConfigRequest(t *types.MatchingGetTaskListPartitionConfigRequest) *matching.GetTaskListPartitionConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.GetTaskListPartitionConfigRequest{
+		DomainUUID:   &t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToMatchingGetTaskListPartitionConfigRequest converts thrift GetTaskListPartitionConfigRequest type to internal
+func ToMatchingGetTaskListPartitionConfigRequest(t *matching.GetTaskListPartitionConfigRequest) *types.MatchingGetTaskListPartitionConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetTaskListPartitionConfigRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromMatchingGetTaskListPartitionConfigResponse converts internal GetTaskListPartitionConfigResponse type to thrift
+func FromMatchingGetTaskListPartitionConfigResponse(t *types.MatchingGetTaskListPartitionConfigResponse) *matching.GetTaskListPartitionConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &matching.GetTaskListPartitionConfigResponse{
+		PartitionConfig: FromTaskListPartitionConfig(t.PartitionConfig),
+	}
+}
+
+// ToMatchingGetTaskListPartitionConfigResponse converts thrift GetTaskListPartitionConfigResponse type to internal
+func ToMatchingGetTaskListPartitionConfigResponse(t *matching.GetTaskListPartitionConfigResponse) *types.MatchingGetTaskListPartitionConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetTaskListPartitionConfigResponse{
+		PartitionConfig: ToTaskListPartitionConfig(t.PartitionConfig),
+	}
+}
+
 // FromMatchingPollForActivityTaskRequest converts internal PollForActivityTaskRequest type to thrift
 func FromMatchingPollForActivityTaskRequest(t *types.MatchingPollForActivityTaskRequest) *matching.PollForActivityTaskRequest {
 	if t == nil {
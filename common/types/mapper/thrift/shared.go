@@ -1857,6 +1857,7 @@ func FromDomainConfiguration(t *types.DomainConfiguration) *shared.DomainConfigu
 		VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  &t.VisibilityArchivalURI,
 		Isolationgroups:                        FromIsolationGroupConfig(t.IsolationGroups),
+		RoleBindings:                           FromRoleBindings(t.RoleBindings),
 	}
 }
 
@@ -1874,9 +1875,116 @@ func ToDomainConfiguration(t *shared.DomainConfiguration) *types.DomainConfigura
 		VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  t.GetVisibilityArchivalURI(),
 		IsolationGroups:                        ToIsolationGroupConfig(t.Isolationgroups),
+		RoleBindings:                           ToRoleBindings(t.RoleBindings),
 	}
 }
 
+// FromDomainRole converts internal DomainRole type to thrift
+func FromDomainRole(t *types.DomainRole) *shared.DomainRole {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.DomainRoleRead:
+		v := shared.DomainRoleRead
+		return &v
+	case types.DomainRoleWrite:
+		v := shared.DomainRoleWrite
+		return &v
+	case types.DomainRoleAdmin:
+		v := shared.DomainRoleAdmin
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToDomainRole converts thrift DomainRole type to internal
+func ToDomainRole(t *shared.DomainRole) *types.DomainRole {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.DomainRoleRead:
+		v := types.DomainRoleRead
+		return &v
+	case shared.DomainRoleWrite:
+		v := types.DomainRoleWrite
+		return &v
+	case shared.DomainRoleAdmin:
+		v := types.DomainRoleAdmin
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// FromRoleBinding converts internal RoleBinding type to thrift
+func FromRoleBinding(t *types.RoleBinding) *shared.RoleBinding {
+	if t == nil {
+		return nil
+	}
+	return &shared.RoleBinding{
+		Principal: &t.Principal,
+		Role:      FromDomainRole(t.Role),
+	}
+}
+
+// ToRoleBinding converts thrift RoleBinding type to internal
+func ToRoleBinding(t *shared.RoleBinding) *types.RoleBinding {
+	if t == nil {
+		return nil
+	}
+	return &types.RoleBinding{
+		Principal: t.GetPrincipal(),
+		Role:      ToDomainRole(t.Role),
+	}
+}
+
+// FromRoleBindingArray converts internal RoleBinding type array to thrift
+func FromRoleBindingArray(t []*types.RoleBinding) []*shared.RoleBinding {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.RoleBinding, len(t))
+	for i := range t {
+		v[i] = FromRoleBinding(t[i])
+	}
+	return v
+}
+
+// ToRoleBindingArray converts thrift RoleBinding type array to internal
+func ToRoleBindingArray(t []*shared.RoleBinding) []*types.RoleBinding {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.RoleBinding, len(t))
+	for i := range t {
+		v[i] = ToRoleBinding(t[i])
+	}
+	return v
+}
+
+// FromRoleBindings converts internal RoleBinding array to thrift DomainRoleBindings,
+// an empty array is kept to tell the bindings are cleared from not set
+func FromRoleBindings(t []*types.RoleBinding) *shared.DomainRoleBindings {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainRoleBindings{
+		RoleBindings: FromRoleBindingArray(t),
+	}
+}
+
+// ToRoleBindings converts thrift DomainRoleBindings type to internal RoleBinding array
+func ToRoleBindings(t *shared.DomainRoleBindings) []*types.RoleBinding {
+	if t == nil {
+		return nil
+	}
+	if t.RoleBindings == nil {
+		return []*types.RoleBinding{}
+	}
+	return ToRoleBindingArray(t.RoleBindings)
+}
+
 // FromDomainInfo converts internal DomainInfo type to thrift
 func FromDomainInfo(t *types.DomainInfo) *shared.DomainInfo {
 	if t == nil {
@@ -5753,7 +5861,8 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 		t.HistoryArchivalStatus != nil ||
 		t.HistoryArchivalURI != nil ||
 		t.VisibilityArchivalStatus != nil ||
-		t.VisibilityArchivalURI != nil {
+		t.VisibilityArchivalURI != nil ||
+		t.RoleBindings != nil {
 		request.Configuration = &shared.DomainConfiguration{
 			WorkflowExecutionRetentionPeriodInDays: t.WorkflowExecutionRetentionPeriodInDays,
 			EmitMetric:                             t.EmitMetric,
@@ -5762,6 +5871,7 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 			HistoryArchivalURI:                     t.HistoryArchivalURI,
 			VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.VisibilityArchivalURI,
+			RoleBindings:                           FromRoleBindings(t.RoleBindings),
 		}
 	}
 	if t.ActiveClusterName != nil || t.Clusters != nil {
@@ -5797,6 +5907,7 @@ func ToUpdateDomainRequest(t *shared.UpdateDomainRequest) *types.UpdateDomainReq
 		request.HistoryArchivalURI = t.Configuration.HistoryArchivalURI
 		request.VisibilityArchivalStatus = ToArchivalStatus(t.Configuration.VisibilityArchivalStatus)
 		request.VisibilityArchivalURI = t.Configuration.VisibilityArchivalURI
+		request.RoleBindings = ToRoleBindings(t.Configuration.RoleBindings)
 	}
 	if t.ReplicationConfiguration != nil {
 		request.ActiveClusterName = t.ReplicationConfiguration.ActiveClusterName
@@ -37,3 +37,18 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		assert.Equal(t, item, thrift.ToWorkflowExecutionInfo(thrift.FromWorkflowExecutionInfo(item)))
 	}
 }
+
+func TestDomainConfiguration(t *testing.T) {
+	withRoleBindings := testdata.DomainConfiguration
+	withRoleBindings.RoleBindings = testdata.RoleBindings
+	for _, item := range []*types.DomainConfiguration{nil, &testdata.DomainConfiguration, &withRoleBindings} {
+		assert.Equal(t, item, thrift.ToDomainConfiguration(thrift.FromDomainConfiguration(item)))
+	}
+}
+
+func TestUpdateDomainRequest_RoleBindings(t *testing.T) {
+	for _, roleBindings := range [][]*types.RoleBinding{nil, {}, testdata.RoleBindings} {
+		request := &types.UpdateDomainRequest{Name: testdata.DomainName, RoleBindings: roleBindings}
+		assert.Equal(t, request, thrift.ToUpdateDomainRequest(thrift.FromUpdateDomainRequest(request)))
+	}
+}
@@ -38,6 +38,8 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom                 string                    `json:"forwardedFrom,omitempty"`
 	ActivityTaskDispatchInfo      *ActivityTaskDispatchInfo `json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig               map[string]string
+	Priority                      int32         `json:"priority,omitempty"`
+	ActivityType                  *ActivityType `json:"activityType,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -112,6 +114,22 @@ func (v *AddActivityTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetActivityType is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetActivityType() (o *ActivityType) {
+	if v != nil && v.ActivityType != nil {
+		return v.ActivityType
+	}
+	return
+}
+
 // ActivityTaskDispatchInfo is an internal type (TBD...)
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent                  *HistoryEvent `json:"scheduledEvent,omitempty"`
@@ -133,6 +151,8 @@ type AddDecisionTaskRequest struct {
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
 	PartitionConfig               map[string]string
+	Priority                      int32         `json:"priority,omitempty"`
+	WorkflowType                  *WorkflowType `json:"workflowType,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -199,6 +219,22 @@ func (v *AddDecisionTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+	return
+}
+
 // CancelOutstandingPollRequest is an internal type (TBD...)
 type CancelOutstandingPollRequest struct {
 	DomainUUID   string    `json:"domainUUID,omitempty"`
@@ -283,6 +319,81 @@ func (v *MatchingListTaskListPartitionsRequest) GetTaskList() (o *TaskList) {
 	return
 }
 
+// TaskListPartitionConfig is an internal type (TBD...)
+type TaskListPartitionConfig struct {
+	Version            int64 `json:"version,omitempty"`
+	NumReadPartitions  int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions int32 `json:"numWritePartitions,omitempty"`
+}
+
+// GetVersion is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
+	}
+	return
+}
+
+// GetNumReadPartitions is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetNumReadPartitions() (o int32) {
+	if v != nil {
+		return v.NumReadPartitions
+	}
+	return
+}
+
+// GetNumWritePartitions is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetNumWritePartitions() (o int32) {
+	if v != nil {
+		return v.NumWritePartitions
+	}
+	return
+}
+
+// MatchingGetTaskListPartitionConfigRequest is an internal type (TBD...)
+type MatchingGetTaskListPartitionConfigRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingGetTaskListPartitionConfigRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingGetTaskListPartitionConfigRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingGetTaskListPartitionConfigRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// MatchingGetTaskListPartitionConfigResponse is an internal type (TBD...)
+type MatchingGetTaskListPartitionConfigResponse struct {
+	PartitionConfig *TaskListPartitionConfig `json:"partitionConfig,omitempty"`
+}
+
+// GetPartitionConfig is an internal getter (TBD...)
+func (v *MatchingGetTaskListPartitionConfigResponse) GetPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.PartitionConfig != nil {
+		return v.PartitionConfig
+	}
+	return
+}
+
 // MatchingGetTaskListsByDomainRequest is an internal type (TBD...)
 type MatchingGetTaskListsByDomainRequest struct {
 	Domain string `json:"domain,omitempty"`
@@ -1793,6 +1793,7 @@ type DomainConfiguration struct {
 	VisibilityArchivalStatus               *ArchivalStatus              `json:"visibilityArchivalStatus,omitempty"`
 	VisibilityArchivalURI                  string                       `json:"visibilityArchivalURI,omitempty"`
 	IsolationGroups                        *IsolationGroupConfiguration `json:"isolationGroupConfiguration,omitempty"`
+	RoleBindings                           []*RoleBinding               `json:"roleBindings,omitempty"`
 }
 
 // GetWorkflowExecutionRetentionPeriodInDays is an internal getter (TBD...)
@@ -1859,6 +1860,94 @@ func (v *DomainConfiguration) GetIsolationGroupsConfiguration() IsolationGroupCo
 	return nil
 }
 
+// GetRoleBindings is an internal getter (TBD...)
+func (v *DomainConfiguration) GetRoleBindings() (o []*RoleBinding) {
+	if v != nil {
+		return v.RoleBindings
+	}
+	return
+}
+
+// DomainRole is the role granted to a principal on a domain
+type DomainRole int32
+
+// Ptr is a helper function for getting pointer value
+func (e DomainRole) Ptr() *DomainRole {
+	return &e
+}
+
+// String returns a readable string representation of DomainRole.
+func (e DomainRole) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "READ"
+	case 1:
+		return "WRITE"
+	case 2:
+		return "ADMIN"
+	}
+	return fmt.Sprintf("DomainRole(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *DomainRole) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "READ":
+		*e = DomainRoleRead
+		return nil
+	case "WRITE":
+		*e = DomainRoleWrite
+		return nil
+	case "ADMIN":
+		*e = DomainRoleAdmin
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DomainRole", err)
+		}
+		*e = DomainRole(val)
+		return nil
+	}
+}
+
+// MarshalText encodes DomainRole to text.
+func (e DomainRole) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// DomainRoleRead grants the read APIs of the domain
+	DomainRoleRead DomainRole = iota
+	// DomainRoleWrite grants the read and write APIs of the domain
+	DomainRoleWrite
+	// DomainRoleAdmin grants all the APIs of the domain
+	DomainRoleAdmin
+)
+
+// RoleBinding grants a role on a domain to a principal, a group or an identity of the caller
+type RoleBinding struct {
+	Principal string      `json:"principal,omitempty"`
+	Role      *DomainRole `json:"role,omitempty"`
+}
+
+// GetPrincipal is an internal getter (TBD...)
+func (v *RoleBinding) GetPrincipal() (o string) {
+	if v != nil {
+		return v.Principal
+	}
+	return
+}
+
+// GetRole is an internal getter (TBD...)
+func (v *RoleBinding) GetRole() (o DomainRole) {
+	if v != nil && v.Role != nil {
+		return *v.Role
+	}
+	return
+}
+
 // DomainInfo is an internal type (TBD...)
 type DomainInfo struct {
 	Name        string            `json:"name,omitempty"`
@@ -6767,6 +6856,7 @@ type UpdateDomainRequest struct {
 	SecurityToken                          string                             `json:"securityToken,omitempty"`
 	DeleteBadBinary                        *string                            `json:"deleteBadBinary,omitempty"`
 	FailoverTimeoutInSeconds               *int32                             `json:"failoverTimeoutInSeconds,omitempty"`
+	RoleBindings                           []*RoleBinding                     `json:"roleBindings,omitempty"`
 }
 
 func (v *UpdateDomainRequest) SerializeForLogging() (string, error) {
@@ -6792,6 +6882,14 @@ func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
 	return
 }
 
+// GetRoleBindings is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetRoleBindings() (o []*RoleBinding) {
+	if v != nil {
+		return v.RoleBindings
+	}
+	return
+}
+
 // GetHistoryArchivalURI is an internal getter (TBD...)
 func (v *UpdateDomainRequest) GetHistoryArchivalURI() (o string) {
 	if v != nil && v.HistoryArchivalURI != nil {
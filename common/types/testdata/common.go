@@ -69,6 +69,7 @@ const (
 	MessageID1        = 50001
 	MessageID2        = 50002
 	EventStoreVersion = 333
+	TaskPriority      = 3
 
 	EventID1 = int64(1)
 	EventID2 = int64(2)
@@ -89,6 +89,11 @@ var (
 			},
 		},
 	}
+	RoleBindings = []*types.RoleBinding{
+		{Principal: "team-a", Role: types.DomainRoleWrite.Ptr()},
+		{Principal: "spiffe://example.org/auditor", Role: types.DomainRoleRead.Ptr()},
+		{Principal: "cadence-admins", Role: types.DomainRoleAdmin.Ptr()},
+	}
 	DomainReplicationConfiguration = types.DomainReplicationConfiguration{
 		ActiveClusterName: ClusterName1,
 		Clusters:          ClusterReplicationConfigurationArray,
@@ -577,6 +577,13 @@ var (
 		CompletedShardCount: 0,
 		PendingShards:       []int32{1, 2, 3},
 	}
+	RatelimitUpdateRequest = types.RatelimitUpdateRequest{
+		Caller: HostName,
+		Usage:  map[string]float64{DomainName: 10},
+	}
+	RatelimitUpdateResponse = types.RatelimitUpdateResponse{
+		Weights: map[string]float64{DomainName: 0.5},
+	}
 )
 
 func generateEvent(modifier func(e *types.HistoryEvent)) types.HistoryEvent {
@@ -156,6 +156,10 @@ var (
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
 	}
+	AdminRestoreArchivedWorkflowRequest = types.RestoreArchivedWorkflowRequest{
+		Domain:    DomainName,
+		Execution: &WorkflowExecution,
+	}
 	AdminRemoveTaskRequest = types.RemoveTaskRequest{
 		ShardID:             ShardID,
 		Type:                common.Int32Ptr(QueueType),
@@ -176,6 +176,10 @@ var (
 		DomainUIID: DomainID,
 		Request:    &AdminRefreshWorkflowTasksRequest,
 	}
+	HistoryRestoreArchivedWorkflowRequest = types.HistoryRestoreArchivedWorkflowRequest{
+		DomainUUID: DomainID,
+		Execution:  &WorkflowExecution,
+	}
 	HistoryRemoveSignalMutableStateRequest = types.RemoveSignalMutableStateRequest{
 		DomainUUID:        DomainID,
 		WorkflowExecution: &WorkflowExecution,
@@ -41,6 +41,8 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      TaskPriority,
+		ActivityType:                  &ActivityType,
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -51,6 +53,8 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      TaskPriority,
+		WorkflowType:                  &WorkflowType,
 	}
 	MatchingCancelOutstandingPollRequest = types.CancelOutstandingPollRequest{
 		DomainUUID:   DomainID,
@@ -74,6 +78,18 @@ var (
 		ActivityTaskListPartitions: TaskListPartitionMetadataArray,
 		DecisionTaskListPartitions: TaskListPartitionMetadataArray,
 	}
+	MatchingGetTaskListPartitionConfigRequest = types.MatchingGetTaskListPartitionConfigRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+	}
+	MatchingGetTaskListPartitionConfigResponse = types.MatchingGetTaskListPartitionConfigResponse{
+		PartitionConfig: &types.TaskListPartitionConfig{
+			Version:            Version1,
+			NumReadPartitions:  3,
+			NumWritePartitions: 2,
+		},
+	}
 	MatchingPollForActivityTaskRequest = types.MatchingPollForActivityTaskRequest{
 		DomainUUID:     DomainID,
 		PollerID:       PollerID,
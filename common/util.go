@@ -649,6 +649,46 @@ func ValidateDomainUUID(
 	return nil
 }
 
+// GetTaskPriorityFromHeader returns the task dispatch priority set in the given workflow or activity header,
+// the second return value is false if the header has no valid priority
+func GetTaskPriorityFromHeader(header *types.Header) (int32, bool) {
+	if header == nil {
+		return 0, false
+	}
+	value, ok := header.Fields[TaskPriorityHeaderKey]
+	if !ok {
+		return 0, false
+	}
+	priority, err := strconv.ParseInt(strings.TrimSpace(string(value)), 10, 32)
+	if err != nil || priority < 0 {
+		return 0, false
+	}
+	return int32(priority), true
+}
+
+// GetFairnessKey returns the fairness key of a workflow, taken from its header or else from the value of the
+// given search attribute, returns an empty string if the workflow has no fairness key
+func GetFairnessKey(header *types.Header, searchAttributes *types.SearchAttributes, searchAttributeKey string) string {
+	if header != nil {
+		if value := strings.TrimSpace(string(header.Fields[FairnessKeyHeaderKey])); value != "" {
+			return value
+		}
+	}
+	if searchAttributes == nil || searchAttributeKey == "" {
+		return ""
+	}
+	value, ok := searchAttributes.IndexedFields[searchAttributeKey]
+	if !ok {
+		return ""
+	}
+	var key string
+	if err := json.Unmarshal(value, &key); err != nil {
+		// not a string search attribute, use its encoded value as the key
+		return strings.TrimSpace(string(value))
+	}
+	return strings.TrimSpace(key)
+}
+
 // GetSizeOfMapStringToByteArray get size of map[string][]byte
 func GetSizeOfMapStringToByteArray(input map[string][]byte) int {
 	if input == nil {
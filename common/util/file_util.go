@@ -101,6 +101,14 @@ func ReadFile(filepath string) ([]byte, error) {
 	return ioutil.ReadFile(filepath)
 }
 
+// DeleteFile deletes the file specified by filepath, it's not an error if the file doesn't exist
+func DeleteFile(filepath string) error {
+	if err := os.Remove(filepath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 // ListFiles lists all files in a directory.
 func ListFiles(dirPath string) ([]string, error) {
 	if info, err := os.Stat(dirPath); err != nil {
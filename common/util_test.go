@@ -323,6 +323,100 @@ func TestValidateDomainUUID(t *testing.T) {
 	}
 }
 
+func TestGetTaskPriorityFromHeader(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		header   *types.Header
+		priority int32
+		valid    bool
+	}{
+		{
+			msg:    "nil header",
+			header: nil,
+		},
+		{
+			msg:    "no priority",
+			header: &types.Header{Fields: map[string][]byte{"key": []byte("value")}},
+		},
+		{
+			msg:    "invalid priority",
+			header: &types.Header{Fields: map[string][]byte{TaskPriorityHeaderKey: []byte("high")}},
+		},
+		{
+			msg:    "negative priority",
+			header: &types.Header{Fields: map[string][]byte{TaskPriorityHeaderKey: []byte("-1")}},
+		},
+		{
+			msg:      "valid priority",
+			header:   &types.Header{Fields: map[string][]byte{TaskPriorityHeaderKey: []byte(" 5 ")}},
+			priority: 5,
+			valid:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			priority, ok := GetTaskPriorityFromHeader(tc.header)
+			require.Equal(t, tc.valid, ok)
+			require.Equal(t, tc.priority, priority)
+		})
+	}
+}
+
+func TestGetFairnessKey(t *testing.T) {
+	testCases := []struct {
+		msg                string
+		header             *types.Header
+		searchAttributes   *types.SearchAttributes
+		searchAttributeKey string
+		fairnessKey        string
+	}{
+		{
+			msg: "no header or search attributes",
+		},
+		{
+			msg:         "header",
+			header:      &types.Header{Fields: map[string][]byte{FairnessKeyHeaderKey: []byte(" tenant-a ")}},
+			fairnessKey: "tenant-a",
+		},
+		{
+			msg:                "header takes precedence",
+			header:             &types.Header{Fields: map[string][]byte{FairnessKeyHeaderKey: []byte("tenant-a")}},
+			searchAttributes:   &types.SearchAttributes{IndexedFields: map[string][]byte{"Tenant": []byte(`"tenant-b"`)}},
+			searchAttributeKey: "Tenant",
+			fairnessKey:        "tenant-a",
+		},
+		{
+			msg:              "search attribute not configured",
+			searchAttributes: &types.SearchAttributes{IndexedFields: map[string][]byte{"Tenant": []byte(`"tenant-b"`)}},
+		},
+		{
+			msg:                "string search attribute",
+			header:             &types.Header{Fields: map[string][]byte{"key": []byte("value")}},
+			searchAttributes:   &types.SearchAttributes{IndexedFields: map[string][]byte{"Tenant": []byte(`"tenant-b"`)}},
+			searchAttributeKey: "Tenant",
+			fairnessKey:        "tenant-b",
+		},
+		{
+			msg:                "int search attribute",
+			searchAttributes:   &types.SearchAttributes{IndexedFields: map[string][]byte{"TenantID": []byte("42")}},
+			searchAttributeKey: "TenantID",
+			fairnessKey:        "42",
+		},
+		{
+			msg:                "missing search attribute",
+			searchAttributes:   &types.SearchAttributes{IndexedFields: map[string][]byte{"Other": []byte(`"tenant-b"`)}},
+			searchAttributeKey: "Tenant",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			require.Equal(t, tc.fairnessKey, GetFairnessKey(tc.header, tc.searchAttributes, tc.searchAttributeKey))
+		})
+	}
+}
+
 func TestConvertErrToGetTaskFailedCause(t *testing.T) {
 	testCases := []struct {
 		err                 error
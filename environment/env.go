@@ -75,6 +75,13 @@ const (
 	// MongoDefaultPort is Mongo default port
 	MongoDefaultPort = "27017"
 
+	// DynamoDBSeeds env
+	DynamoDBSeeds = "DYNAMODB_SEEDS"
+	// DynamoDBPort env
+	DynamoDBPort = "DYNAMODB_PORT"
+	// DynamoDBDefaultPort is the default port of DynamoDB local
+	DynamoDBDefaultPort = "8000"
+
 	// KafkaSeeds env
 	KafkaSeeds = "KAFKA_SEEDS"
 	// KafkaPort env
@@ -350,3 +357,25 @@ func GetMongoPort() int {
 	}
 	return p
 }
+
+// GetDynamoDBAddress return the DynamoDB address
+func GetDynamoDBAddress() string {
+	addr := os.Getenv(DynamoDBSeeds)
+	if addr == "" {
+		addr = Localhost
+	}
+	return addr
+}
+
+// GetDynamoDBPort return the DynamoDB port
+func GetDynamoDBPort() int {
+	port := os.Getenv(DynamoDBPort)
+	if port == "" {
+		port = DynamoDBDefaultPort
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		panic(fmt.Sprintf("error getting env %v", DynamoDBPort))
+	}
+	return p
+}
@@ -0,0 +1,195 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/domain.proto
+
+package apiv1
+
+var yarpcFileDescriptorClosure824795d6ae7d8e2f = [][]byte{
+	// uber/cadence/api/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x73, 0xda, 0x46,
+		0x10, 0xae, 0xc0, 0x76, 0xed, 0x15, 0x10, 0x72, 0x8e, 0x83, 0x42, 0x33, 0x35, 0x21, 0x93, 0x19,
+		0x9a, 0x99, 0x8a, 0x98, 0x74, 0xda, 0xa4, 0x9d, 0x3e, 0x00, 0x52, 0x52, 0x3a, 0x8e, 0xe3, 0x11,
+		0xc4, 0x9d, 0x69, 0x1e, 0xd4, 0x43, 0x3a, 0xe0, 0x26, 0x42, 0xa7, 0x39, 0x09, 0x1c, 0xbf, 0x75,
+		0xfa, 0xb3, 0xf2, 0xd8, 0x5f, 0xd6, 0xd1, 0xe9, 0x04, 0x02, 0x34, 0x76, 0xdf, 0x74, 0xbb, 0xdf,
+		0x7e, 0xfb, 0xdd, 0xde, 0xee, 0x9d, 0xa0, 0xb1, 0x18, 0x13, 0xde, 0x76, 0xb0, 0x4b, 0x7c, 0x87,
+		0xb4, 0x71, 0x40, 0xdb, 0xcb, 0xb3, 0xb6, 0xcb, 0xe6, 0x98, 0xfa, 0x7a, 0xc0, 0x59, 0xc4, 0xd0,
+		0x71, 0x8c, 0xd0, 0x25, 0x42, 0xc7, 0x01, 0xd5, 0x97, 0x67, 0xf5, 0x6f, 0xa7, 0x8c, 0x4d, 0x3d,
+		0xd2, 0x16, 0x90, 0xf1, 0x62, 0xd2, 0x76, 0x17, 0x1c, 0x47, 0x94, 0xc9, 0xa0, 0xfa, 0xe9, 0xb6,
+		0x3f, 0xa2, 0x73, 0x12, 0x46, 0x78, 0x1e, 0x48, 0x40, 0x6e, 0x5e, 0x87, 0xcd, 0xe7, 0x29, 0x45,
+		0xf3, 0xcb, 0x11, 0x1c, 0x18, 0x42, 0x08, 0xaa, 0x40, 0x81, 0xba, 0x9a, 0xd2, 0x50, 0x5a, 0x47,
+		0x56, 0x81, 0xba, 0x08, 0xc1, 0x9e, 0x8f, 0xe7, 0x44, 0x2b, 0x08, 0x8b, 0xf8, 0x46, 0xaf, 0xe1,
+		0x20, 0x8c, 0x70, 0xb4, 0x08, 0xb5, 0x62, 0x43, 0x69, 0x55, 0x3a, 0x4f, 0xf4, 0x1c, 0xdd, 0x7a,
+		0x42, 0x38, 0x14, 0x40, 0x4b, 0x06, 0xa0, 0x06, 0xa8, 0x2e, 0x09, 0x1d, 0x4e, 0x83, 0x78, 0x07,
+		0xda, 0x9e, 0x60, 0xcd, 0x9a, 0xd0, 0x29, 0xa8, 0xec, 0xda, 0x27, 0xdc, 0x26, 0x73, 0x4c, 0x3d,
+		0x6d, 0x5f, 0x20, 0x40, 0x98, 0xcc, 0xd8, 0x82, 0x5e, 0xc3, 0x9e, 0x8b, 0x23, 0xac, 0x1d, 0x34,
+		0x8a, 0x2d, 0xb5, 0xf3, 0xec, 0x96, 0xdc, 0xba, 0x81, 0x23, 0x6c, 0xfa, 0x11, 0xbf, 0xb1, 0x44,
+		0x08, 0x9a, 0xc1, 0xd3, 0x6b, 0xc6, 0x3f, 0x4d, 0x3c, 0x76, 0x6d, 0x93, 0xcf, 0xc4, 0x59, 0xc4,
+		0x19, 0x6d, 0x4e, 0x22, 0xe2, 0x8b, 0xaf, 0x80, 0x70, 0xca, 0x5c, 0xed, 0xeb, 0x86, 0xd2, 0x52,
+		0x3b, 0x8f, 0xf4, 0xa4, 0xb0, 0x7a, 0x5a, 0x58, 0xdd, 0x90, 0x85, 0xb7, 0x1a, 0x29, 0x8b, 0x99,
+		0x92, 0x58, 0x29, 0xc7, 0xa5, 0xa0, 0x40, 0x7d, 0x28, 0x8d, 0xb1, 0x6b, 0x8f, 0xa9, 0x8f, 0x39,
+		0x25, 0xa1, 0x76, 0x28, 0x28, 0x1b, 0xb9, 0x62, 0x7b, 0xd8, 0xed, 0x49, 0x9c, 0xa5, 0x8e, 0xd7,
+		0x0b, 0xf4, 0x11, 0x6a, 0x33, 0x1a, 0x46, 0x8c, 0xdf, 0xd8, 0x98, 0x3b, 0x33, 0xba, 0xc4, 0x9e,
+		0x2d, 0x0b, 0x7f, 0x24, 0x0a, 0xff, 0x34, 0x97, 0xaf, 0x2b, 0xb1, 0xb2, 0xf4, 0x27, 0x92, 0x63,
+		0xd3, 0x8c, 0x5e, 0xc0, 0x83, 0x1d, 0xf2, 0x05, 0xa7, 0x1a, 0x88, 0x82, 0xa3, 0xad, 0xa0, 0x0f,
+		0x9c, 0x22, 0x0c, 0xf5, 0x25, 0x0d, 0xe9, 0x98, 0x7a, 0x34, 0xda, 0x55, 0xa4, 0xfe, 0x7f, 0x45,
+		0xda, 0x9a, 0x66, 0x4b, 0xd4, 0x8f, 0x50, 0xcb, 0x4b, 0x11, 0xeb, 0x2a, 0x09, 0x5d, 0x27, 0xbb,
+		0xa1, 0xb1, 0x34, 0x1d, 0x8e, 0xb1, 0x13, 0xd1, 0x25, 0xb1, 0x1d, 0x6f, 0x11, 0x46, 0x84, 0xdb,
+		0xa2, 0x69, 0xcb, 0x22, 0xe6, 0x7e, 0xe2, 0xea, 0x27, 0x9e, 0x8b, 0xb8, 0x83, 0x2f, 0xe1, 0x50,
+		0x02, 0x43, 0xad, 0x22, 0xfa, 0xe8, 0x87, 0x5c, 0xe1, 0x32, 0xc6, 0x22, 0x81, 0x47, 0x1d, 0x71,
+		0xf6, 0x7d, 0xe6, 0x4f, 0xe8, 0x34, 0x6d, 0x84, 0x15, 0x0b, 0xfa, 0x0e, 0xaa, 0x13, 0x4c, 0x3d,
+		0xb6, 0x24, 0xdc, 0x5e, 0x12, 0x1e, 0xc6, 0xdd, 0x7d, 0xaf, 0xa1, 0xb4, 0x8a, 0xd6, 0xbd, 0xd4,
+		0x7e, 0x95, 0x98, 0x51, 0x0b, 0xaa, 0x34, 0xb4, 0xa7, 0x1e, 0x1b, 0x63, 0xcf, 0x4e, 0xe6, 0x5f,
+		0xab, 0x36, 0x94, 0xd6, 0xa1, 0x55, 0xa1, 0xe1, 0x5b, 0x61, 0x96, 0xc3, 0xf8, 0x06, 0xca, 0x2b,
+		0x52, 0xea, 0x4f, 0x98, 0x76, 0x5f, 0xb4, 0x51, 0xfe, 0xbc, 0xbd, 0x91, 0xc8, 0x81, 0x3f, 0x61,
+		0x56, 0x69, 0x92, 0x59, 0xa1, 0x8f, 0x71, 0x46, 0xe6, 0x09, 0xcd, 0xf6, 0x94, 0xb3, 0x45, 0x10,
+		0x6a, 0x48, 0x50, 0xbd, 0xc8, 0xa5, 0x1a, 0xa4, 0xe0, 0xb7, 0x31, 0x76, 0x73, 0xcb, 0xf7, 0xe8,
+		0x86, 0x33, 0x44, 0x26, 0x94, 0x39, 0xf3, 0x48, 0xdc, 0xeb, 0x2e, 0xf5, 0xa7, 0xa1, 0x76, 0x2c,
+		0x0a, 0x9a, 0xdf, 0xeb, 0x16, 0xf3, 0x48, 0x2f, 0x01, 0x5a, 0x25, 0xbe, 0x5e, 0x84, 0xf5, 0x9f,
+		0xe0, 0x68, 0x35, 0xae, 0xa8, 0x0a, 0xc5, 0x4f, 0xe4, 0x46, 0x5e, 0x43, 0xf1, 0x27, 0x7a, 0x00,
+		0xfb, 0x4b, 0xec, 0x2d, 0xd2, 0x8b, 0x28, 0x59, 0xfc, 0x5c, 0x78, 0xa5, 0x34, 0x0d, 0x38, 0xbd,
+		0xe3, 0x98, 0xd0, 0x13, 0x28, 0x6d, 0xf4, 0x45, 0xc2, 0xab, 0x3a, 0xeb, 0x8e, 0x68, 0x7e, 0x51,
+		0x40, 0xcd, 0x0c, 0x22, 0xfa, 0x1d, 0x0e, 0x57, 0xc3, 0xab, 0x88, 0x0d, 0xe9, 0x77, 0x0d, 0xaf,
+		0x9e, 0x7e, 0x24, 0x57, 0xce, 0x2a, 0xbe, 0x6e, 0x43, 0x79, 0xc3, 0x95, 0xb3, 0xbd, 0x57, 0xd9,
+		0xed, 0xa9, 0x9d, 0xe6, 0xad, 0xb9, 0x6e, 0xc4, 0x11, 0x67, 0x4a, 0xf0, 0x8f, 0x02, 0xe5, 0x0d,
+		0x27, 0x7a, 0x08, 0x07, 0x9c, 0xe0, 0x90, 0xf9, 0x32, 0x89, 0x5c, 0xa1, 0x3a, 0x1c, 0xb2, 0x80,
+		0x70, 0x1c, 0x31, 0x2e, 0x2b, 0xb9, 0x5a, 0xa3, 0x5f, 0xa1, 0xe4, 0x70, 0x82, 0x23, 0xe2, 0xda,
+		0xf1, 0x13, 0x22, 0x2e, 0x77, 0xb5, 0x53, 0xdf, 0xb9, 0x06, 0x47, 0xe9, 0xfb, 0x62, 0xa9, 0x12,
+		0x1f, 0x5b, 0x9a, 0xff, 0x16, 0xa0, 0x94, 0xed, 0xc1, 0xdc, 0x91, 0x50, 0xf2, 0x47, 0x62, 0x04,
+		0xda, 0x0a, 0x1a, 0x46, 0x98, 0x47, 0xf6, 0xea, 0x11, 0x93, 0x15, 0xb9, 0x4d, 0xc6, 0xc3, 0x34,
+		0x76, 0x18, 0x87, 0xae, 0xec, 0xe8, 0x0a, 0x1e, 0xad, 0x58, 0xc9, 0xe7, 0x80, 0x72, 0x92, 0xa1,
+		0xbd, 0x7b, 0x77, 0xb5, 0x34, 0xd8, 0x14, 0xb1, 0x6b, 0xde, 0x0e, 0x9c, 0x38, 0x6c, 0x1e, 0x78,
+		0x24, 0x2e, 0x55, 0x38, 0xc3, 0xdc, 0xb5, 0x1d, 0xb6, 0xf0, 0x23, 0xf1, 0x9c, 0xed, 0x5b, 0xc7,
+		0x2b, 0xe7, 0x30, 0xf6, 0xf5, 0x63, 0x17, 0x7a, 0x06, 0x95, 0x80, 0x88, 0x56, 0x4f, 0x22, 0x42,
+		0x6d, 0xbf, 0x51, 0x6c, 0xed, 0x5b, 0x65, 0x69, 0x15, 0xd0, 0xb0, 0xf9, 0x17, 0xa8, 0x99, 0x11,
+		0x41, 0x8f, 0xe1, 0x28, 0xe0, 0xd4, 0x77, 0x68, 0x80, 0x3d, 0x79, 0x92, 0x6b, 0x03, 0x7a, 0x09,
+		0x7b, 0xf1, 0x08, 0x89, 0x0a, 0x55, 0x3a, 0xa7, 0xb7, 0xbc, 0x84, 0x31, 0xa7, 0x25, 0xc0, 0xcf,
+		0xff, 0x56, 0xa0, 0x94, 0x7d, 0x9a, 0xd1, 0x23, 0x38, 0x31, 0xde, 0xbf, 0xeb, 0x0e, 0x2e, 0xec,
+		0xe1, 0xa8, 0x3b, 0xfa, 0x30, 0xb4, 0x07, 0x17, 0x57, 0xdd, 0xf3, 0x81, 0x51, 0xfd, 0x0a, 0x3d,
+		0x06, 0x6d, 0xd3, 0x65, 0x99, 0x6f, 0x07, 0xc3, 0x91, 0x69, 0x99, 0x46, 0x55, 0xd9, 0xf5, 0x1a,
+		0xe6, 0xa5, 0x65, 0xf6, 0xbb, 0x23, 0xd3, 0xa8, 0x16, 0x76, 0x69, 0x0d, 0xf3, 0xdc, 0x8c, 0x5d,
+		0xc5, 0xe7, 0x33, 0xa8, 0x6c, 0xdd, 0xfb, 0xdf, 0x40, 0xad, 0x6b, 0xf5, 0x7f, 0x1b, 0x5c, 0x75,
+		0xcf, 0x73, 0x55, 0x6c, 0x3b, 0x8d, 0xc1, 0xb0, 0xdb, 0x3b, 0x17, 0x2a, 0x72, 0x42, 0xcd, 0x8b,
+		0xc4, 0x59, 0x78, 0x4e, 0x01, 0xd6, 0x05, 0x40, 0x35, 0x38, 0x96, 0x92, 0xac, 0xf7, 0xe7, 0x66,
+		0x26, 0xc3, 0x03, 0xa8, 0x66, 0x1d, 0x96, 0xd9, 0x8d, 0x99, 0x4f, 0xe0, 0x7e, 0xd6, 0xfa, 0x87,
+		0x35, 0x18, 0x99, 0xd5, 0xc2, 0xb6, 0xb9, 0x6b, 0xbc, 0x1b, 0x5c, 0x54, 0x8b, 0xbd, 0x8f, 0x50,
+		0x73, 0xd8, 0x3c, 0xef, 0x0c, 0x7a, 0x6a, 0xa2, 0xe1, 0x32, 0x6e, 0xb1, 0x4b, 0xe5, 0xcf, 0xb3,
+		0x29, 0x8d, 0x66, 0x8b, 0xb1, 0xee, 0xb0, 0x79, 0x3b, 0xfb, 0x6b, 0xf6, 0x3d, 0x75, 0xbd, 0xf6,
+		0x94, 0x25, 0x3f, 0x72, 0xf2, 0x3f, 0xed, 0x17, 0x1c, 0xd0, 0xe5, 0xd9, 0xf8, 0x40, 0xd8, 0x5e,
+		0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x97, 0xb6, 0xa5, 0x4e, 0x43, 0x0a, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x51, 0x6f, 0xdb, 0x36,
+		0x17, 0xfd, 0x14, 0xc7, 0x4e, 0x7b, 0x9d, 0x26, 0xfa, 0x98, 0x35, 0x71, 0xd2, 0x75, 0x4b, 0x05,
+		0x0c, 0xf5, 0x8a, 0x4d, 0x46, 0xdc, 0x97, 0x62, 0x45, 0x37, 0x38, 0xb6, 0x93, 0xa8, 0xcd, 0x6c,
+		0x43, 0xf6, 0x1a, 0x74, 0x03, 0x26, 0xd0, 0x12, 0xe5, 0x72, 0x96, 0x48, 0x81, 0xa2, 0x9c, 0xf8,
+		0x65, 0xd8, 0x2f, 0xd9, 0xc3, 0xfe, 0xd2, 0xfe, 0xd0, 0x20, 0x89, 0x8a, 0xed, 0xce, 0x41, 0xf7,
+		0x30, 0xec, 0x8d, 0xbc, 0xe7, 0xdc, 0xc3, 0x43, 0xe2, 0xde, 0x2b, 0xc1, 0x71, 0x32, 0x26, 0xa2,
+		0xe1, 0x62, 0x8f, 0x30, 0x97, 0x34, 0x70, 0x44, 0x1b, 0xb3, 0x93, 0x86, 0xcb, 0xc3, 0x90, 0x33,
+		0x33, 0x12, 0x5c, 0x72, 0xb4, 0x97, 0x32, 0x4c, 0xc5, 0x30, 0x71, 0x44, 0xcd, 0xd9, 0xc9, 0xd1,
+		0x67, 0x13, 0xce, 0x27, 0x01, 0x69, 0x64, 0x94, 0x71, 0xe2, 0x37, 0xbc, 0x44, 0x60, 0x49, 0x8b,
+		0x24, 0xe3, 0x0d, 0xfc, 0xff, 0x8a, 0x8b, 0xa9, 0x1f, 0xf0, 0xeb, 0xee, 0x0d, 0x71, 0x93, 0x14,
+		0x42, 0x9f, 0x43, 0xf5, 0x5a, 0x05, 0x1d, 0xea, 0xd5, 0xb4, 0x63, 0xad, 0x7e, 0xdf, 0x86, 0x22,
+		0x64, 0x79, 0xe8, 0x21, 0x54, 0x44, 0xc2, 0x52, 0x6c, 0x23, 0xc3, 0xca, 0x22, 0x61, 0x96, 0x67,
+		0x18, 0xb0, 0x5d, 0x88, 0x8d, 0xe6, 0x11, 0x41, 0x08, 0x36, 0x19, 0x0e, 0x89, 0x12, 0xc8, 0xd6,
+		0x29, 0xa7, 0xe5, 0x4a, 0x3a, 0xa3, 0x72, 0x7e, 0x27, 0xe7, 0x31, 0x6c, 0x0d, 0xf0, 0x3c, 0xe0,
+		0xd8, 0x4b, 0x61, 0x0f, 0x4b, 0x9c, 0xc1, 0xdb, 0x76, 0xb6, 0x36, 0x5e, 0xc2, 0xd6, 0x19, 0xa6,
+		0x41, 0x22, 0x08, 0xda, 0x87, 0x8a, 0x20, 0x38, 0xe6, 0x4c, 0xe5, 0xab, 0x1d, 0xaa, 0xc1, 0x96,
+		0x47, 0x24, 0xa6, 0x41, 0x9c, 0x39, 0xdc, 0xb6, 0x8b, 0xad, 0xf1, 0xbb, 0x06, 0x9b, 0xdf, 0x93,
+		0x90, 0xa3, 0x57, 0x50, 0xf1, 0x29, 0x09, 0xbc, 0xb8, 0xa6, 0x1d, 0x97, 0xea, 0xd5, 0xe6, 0x17,
+		0xe6, 0x9a, 0xf7, 0x33, 0x53, 0xaa, 0x79, 0x96, 0xf1, 0xba, 0x4c, 0x8a, 0xb9, 0xad, 0x92, 0x8e,
+		0xae, 0xa0, 0xba, 0x14, 0x46, 0x3a, 0x94, 0xa6, 0x64, 0xae, 0x5c, 0xa4, 0x4b, 0xd4, 0x84, 0xf2,
+		0x0c, 0x07, 0x09, 0xc9, 0x0c, 0x54, 0x9b, 0x9f, 0xae, 0x95, 0x57, 0xd7, 0xb4, 0x73, 0xea, 0x37,
+		0x1b, 0x2f, 0x34, 0xe3, 0x0f, 0x0d, 0x2a, 0x17, 0x04, 0x7b, 0x44, 0xa0, 0xef, 0x3e, 0xb0, 0xf8,
+		0x74, 0xad, 0x46, 0x4e, 0xfe, 0x6f, 0x4d, 0xfe, 0xa9, 0x81, 0x3e, 0x24, 0x58, 0xb8, 0xef, 0x5b,
+		0x52, 0x0a, 0x3a, 0x4e, 0x24, 0x89, 0x91, 0x03, 0x3b, 0x94, 0x79, 0xe4, 0x86, 0x78, 0xce, 0x8a,
+		0xed, 0x17, 0x6b, 0x55, 0x3f, 0x4c, 0x37, 0xad, 0x3c, 0x77, 0xf9, 0x1e, 0x0f, 0xe8, 0x72, 0xec,
+		0xe8, 0x67, 0x40, 0x7f, 0x27, 0xfd, 0x8b, 0xb7, 0xf2, 0xe1, 0x5e, 0x07, 0x4b, 0x7c, 0x1a, 0xf0,
+		0x31, 0x3a, 0x83, 0x07, 0x84, 0xb9, 0xdc, 0xa3, 0x6c, 0xe2, 0xc8, 0x79, 0x94, 0x17, 0xe8, 0x4e,
+		0xf3, 0xc9, 0x5a, 0xad, 0xae, 0x62, 0xa6, 0x15, 0x6d, 0x6f, 0x93, 0xa5, 0xdd, 0x6d, 0x01, 0x6f,
+		0x2c, 0x15, 0xf0, 0x20, 0x6f, 0x3a, 0x22, 0xde, 0x12, 0x11, 0x53, 0xce, 0x2c, 0xe6, 0xf3, 0x94,
+		0x48, 0xc3, 0x28, 0x28, 0x1a, 0x21, 0x5d, 0xa3, 0xa7, 0xb0, 0xeb, 0x13, 0x2c, 0x13, 0x41, 0x9c,
+		0x59, 0x4e, 0x55, 0x0d, 0xb7, 0xa3, 0xc2, 0x4a, 0xc0, 0x78, 0x03, 0x07, 0xc3, 0x24, 0x8a, 0xb8,
+		0x90, 0xc4, 0x6b, 0x07, 0x94, 0x30, 0xa9, 0x90, 0x38, 0xed, 0xd5, 0x09, 0x77, 0x62, 0x6f, 0xaa,
+		0x94, 0xcb, 0x13, 0x3e, 0xf4, 0xa6, 0xe8, 0x10, 0xee, 0xfd, 0x82, 0x67, 0x38, 0x03, 0x72, 0xcd,
+		0xad, 0x74, 0x3f, 0xf4, 0xa6, 0xc6, 0x6f, 0x25, 0xa8, 0xda, 0x44, 0x8a, 0xf9, 0x80, 0x07, 0xd4,
+		0x9d, 0xa3, 0x0e, 0xe8, 0x94, 0x51, 0x49, 0x71, 0xe0, 0x50, 0x26, 0x89, 0x98, 0xe1, 0xdc, 0x65,
+		0xb5, 0x79, 0x68, 0xe6, 0xe3, 0xc5, 0x2c, 0xc6, 0x8b, 0xd9, 0x51, 0xe3, 0xc5, 0xde, 0x55, 0x29,
+		0x96, 0xca, 0x40, 0x0d, 0xd8, 0x1b, 0x63, 0x77, 0xca, 0x7d, 0xdf, 0x71, 0x39, 0xf1, 0x7d, 0xea,
+		0xa6, 0x36, 0xb3, 0xb3, 0x35, 0x1b, 0x29, 0xa8, 0xbd, 0x40, 0xd2, 0x63, 0x43, 0x7c, 0x43, 0xc3,
+		0x24, 0x5c, 0x1c, 0x5b, 0xfa, 0xe8, 0xb1, 0x2a, 0xe5, 0xf6, 0xd8, 0x2f, 0x17, 0x2a, 0x58, 0x4a,
+		0x12, 0x46, 0x32, 0xae, 0x6d, 0x1e, 0x6b, 0xf5, 0xf2, 0x2d, 0xb5, 0xa5, 0xc2, 0xe8, 0x15, 0x3c,
+		0x62, 0x9c, 0x39, 0x22, 0xbd, 0x3a, 0x1e, 0x07, 0xc4, 0x21, 0x42, 0x70, 0xe1, 0xe4, 0x23, 0x25,
+		0xae, 0x95, 0x8f, 0x4b, 0xf5, 0xfb, 0x76, 0x8d, 0x71, 0x66, 0x17, 0x8c, 0x6e, 0x4a, 0xb0, 0x73,
+		0x1c, 0xbd, 0x86, 0x3d, 0x72, 0x13, 0xd1, 0xdc, 0xc8, 0xc2, 0x72, 0xe5, 0x63, 0x96, 0xd1, 0x22,
+		0xab, 0x70, 0x6d, 0x84, 0x70, 0x60, 0xc5, 0x3c, 0xc8, 0x82, 0xe7, 0x82, 0x27, 0xd1, 0x00, 0x0b,
+		0x49, 0xb3, 0xe1, 0xbc, 0x66, 0x60, 0xa2, 0x6f, 0xa1, 0x1c, 0x4b, 0x2c, 0xf3, 0x82, 0xdf, 0x69,
+		0xd6, 0xd7, 0x16, 0xe9, 0xaa, 0xe0, 0x30, 0xe5, 0xdb, 0x79, 0x9a, 0x31, 0x83, 0x47, 0xab, 0x68,
+		0x9b, 0x33, 0x9f, 0x4e, 0x94, 0x43, 0x74, 0x05, 0x3a, 0x2d, 0x60, 0x67, 0x92, 0xe2, 0x45, 0x6b,
+		0x7f, 0xf5, 0x0f, 0x4e, 0xba, 0xb5, 0x6e, 0xef, 0xd2, 0x15, 0x20, 0x7e, 0x76, 0x0d, 0xdb, 0xcb,
+		0xad, 0x83, 0x0e, 0xe1, 0x61, 0xb7, 0xd7, 0xee, 0x77, 0xac, 0xde, 0xb9, 0x33, 0x7a, 0x37, 0xe8,
+		0x3a, 0x56, 0xef, 0x6d, 0xeb, 0xd2, 0xea, 0xe8, 0xff, 0x43, 0x47, 0xb0, 0xbf, 0x0a, 0x8d, 0x2e,
+		0x6c, 0xeb, 0x6c, 0x64, 0x5f, 0xe9, 0x1a, 0xda, 0x07, 0xb4, 0x8a, 0xbd, 0x1e, 0xf6, 0x7b, 0xfa,
+		0x06, 0xaa, 0xc1, 0x27, 0xab, 0xf1, 0x81, 0xdd, 0x1f, 0xf5, 0x9f, 0xeb, 0xa5, 0x67, 0xbf, 0xc2,
+		0xde, 0x9a, 0xe7, 0x40, 0x4f, 0xe0, 0xb1, 0x35, 0xec, 0x5f, 0xb6, 0x46, 0x56, 0xbf, 0xe7, 0x9c,
+		0xdb, 0xfd, 0x1f, 0x06, 0xce, 0x70, 0xd4, 0x1a, 0x2d, 0xfb, 0xb8, 0x93, 0x72, 0xd1, 0x6d, 0x5d,
+		0x8e, 0x2e, 0xde, 0xe9, 0xda, 0xdd, 0x94, 0x8e, 0xdd, 0xb2, 0x7a, 0xdd, 0x8e, 0xbe, 0x71, 0xfa,
+		0x13, 0x1c, 0xb8, 0x3c, 0x5c, 0xf7, 0x78, 0xa7, 0xd5, 0x76, 0xf6, 0x51, 0x1f, 0xa4, 0x75, 0x32,
+		0xd0, 0x7e, 0x3c, 0x99, 0x50, 0xf9, 0x3e, 0x19, 0x9b, 0x2e, 0x0f, 0x1b, 0xcb, 0xbf, 0x00, 0x5f,
+		0x53, 0x2f, 0x68, 0x4c, 0x78, 0xfe, 0x61, 0x57, 0xff, 0x03, 0x2f, 0x71, 0x44, 0x67, 0x27, 0xe3,
+		0x4a, 0x16, 0x7b, 0xfe, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0x0a, 0xaa, 0xd2, 0x33, 0x08,
+		0x00, 0x00,
+	},
+}
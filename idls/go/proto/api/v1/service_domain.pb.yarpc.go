@@ -0,0 +1,708 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/service_domain.proto
+
+package apiv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// DomainAPIYARPCClient is the YARPC client-side interface for the DomainAPI service.
+type DomainAPIYARPCClient interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest, ...yarpc.CallOption) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest, ...yarpc.CallOption) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest, ...yarpc.CallOption) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest, ...yarpc.CallOption) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest, ...yarpc.CallOption) (*DeprecateDomainResponse, error)
+}
+
+func newDomainAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) DomainAPIYARPCClient {
+	return &_DomainAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.api.v1.DomainAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewDomainAPIYARPCClient builds a new YARPC client for the DomainAPI service.
+func NewDomainAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) DomainAPIYARPCClient {
+	return newDomainAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// DomainAPIYARPCServer is the YARPC server-side interface for the DomainAPI service.
+type DomainAPIYARPCServer interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest) (*DeprecateDomainResponse, error)
+}
+
+type buildDomainAPIYARPCProceduresParams struct {
+	Server      DomainAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildDomainAPIYARPCProcedures(params buildDomainAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_DomainAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.api.v1.DomainAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "RegisterDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RegisterDomain,
+							NewRequest:  newDomainAPIServiceRegisterDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeDomain,
+							NewRequest:  newDomainAPIServiceDescribeDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListDomains",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListDomains,
+							NewRequest:  newDomainAPIServiceListDomainsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateDomain,
+							NewRequest:  newDomainAPIServiceUpdateDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeprecateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeprecateDomain,
+							NewRequest:  newDomainAPIServiceDeprecateDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildDomainAPIYARPCProcedures prepares an implementation of the DomainAPI service for YARPC registration.
+func BuildDomainAPIYARPCProcedures(server DomainAPIYARPCServer) []transport.Procedure {
+	return buildDomainAPIYARPCProcedures(buildDomainAPIYARPCProceduresParams{Server: server})
+}
+
+// FxDomainAPIYARPCClientParams defines the input
+// for NewFxDomainAPIYARPCClient. It provides the
+// paramaters to get a DomainAPIYARPCClient in an
+// Fx application.
+type FxDomainAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxDomainAPIYARPCClientResult defines the output
+// of NewFxDomainAPIYARPCClient. It provides a
+// DomainAPIYARPCClient to an Fx application.
+type FxDomainAPIYARPCClientResult struct {
+	fx.Out
+
+	Client DomainAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxDomainAPIYARPCClient provides a DomainAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//  fx.Provide(
+//    apiv1.NewFxDomainAPIYARPCClient("service-name"),
+//    ...
+//  )
+func NewFxDomainAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxDomainAPIYARPCClientParams) FxDomainAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxDomainAPIYARPCClientResult{
+			Client: newDomainAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxDomainAPIYARPCProceduresParams defines the input
+// for NewFxDomainAPIYARPCProcedures. It provides the
+// paramaters to get DomainAPIYARPCServer procedures in an
+// Fx application.
+type FxDomainAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      DomainAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxDomainAPIYARPCProceduresResult defines the output
+// of NewFxDomainAPIYARPCProcedures. It provides
+// DomainAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxDomainAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxDomainAPIYARPCProcedures provides DomainAPIYARPCServer procedures to an Fx application.
+// It expects a DomainAPIYARPCServer to be present in the container.
+//
+//  fx.Provide(
+//    apiv1.NewFxDomainAPIYARPCProcedures(),
+//    ...
+//  )
+func NewFxDomainAPIYARPCProcedures() interface{} {
+	return func(params FxDomainAPIYARPCProceduresParams) FxDomainAPIYARPCProceduresResult {
+		return FxDomainAPIYARPCProceduresResult{
+			Procedures: buildDomainAPIYARPCProcedures(buildDomainAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: reflection.ServerMeta{
+				ServiceName:     "uber.cadence.api.v1.DomainAPI",
+				FileDescriptors: yarpcFileDescriptorClosure2e37d15268893114,
+			},
+		}
+	}
+}
+
+type _DomainAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_DomainAPIYARPCCaller) RegisterDomain(ctx context.Context, request *RegisterDomainRequest, options ...yarpc.CallOption) (*RegisterDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RegisterDomain", request, newDomainAPIServiceRegisterDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RegisterDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceRegisterDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_DomainAPIYARPCCaller) DescribeDomain(ctx context.Context, request *DescribeDomainRequest, options ...yarpc.CallOption) (*DescribeDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeDomain", request, newDomainAPIServiceDescribeDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceDescribeDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_DomainAPIYARPCCaller) ListDomains(ctx context.Context, request *ListDomainsRequest, options ...yarpc.CallOption) (*ListDomainsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDomains", request, newDomainAPIServiceListDomainsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDomainsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceListDomainsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_DomainAPIYARPCCaller) UpdateDomain(ctx context.Context, request *UpdateDomainRequest, options ...yarpc.CallOption) (*UpdateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomain", request, newDomainAPIServiceUpdateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceUpdateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_DomainAPIYARPCCaller) DeprecateDomain(ctx context.Context, request *DeprecateDomainRequest, options ...yarpc.CallOption) (*DeprecateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeprecateDomain", request, newDomainAPIServiceDeprecateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeprecateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceDeprecateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _DomainAPIYARPCHandler struct {
+	server DomainAPIYARPCServer
+}
+
+func (h *_DomainAPIYARPCHandler) RegisterDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RegisterDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RegisterDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceRegisterDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RegisterDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_DomainAPIYARPCHandler) DescribeDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceDescribeDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_DomainAPIYARPCHandler) ListDomains(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDomainsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDomainsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceListDomainsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDomains(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_DomainAPIYARPCHandler) UpdateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceUpdateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_DomainAPIYARPCHandler) DeprecateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeprecateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeprecateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceDeprecateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeprecateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newDomainAPIServiceRegisterDomainYARPCRequest() proto.Message {
+	return &RegisterDomainRequest{}
+}
+
+func newDomainAPIServiceRegisterDomainYARPCResponse() proto.Message {
+	return &RegisterDomainResponse{}
+}
+
+func newDomainAPIServiceDescribeDomainYARPCRequest() proto.Message {
+	return &DescribeDomainRequest{}
+}
+
+func newDomainAPIServiceDescribeDomainYARPCResponse() proto.Message {
+	return &DescribeDomainResponse{}
+}
+
+func newDomainAPIServiceListDomainsYARPCRequest() proto.Message {
+	return &ListDomainsRequest{}
+}
+
+func newDomainAPIServiceListDomainsYARPCResponse() proto.Message {
+	return &ListDomainsResponse{}
+}
+
+func newDomainAPIServiceUpdateDomainYARPCRequest() proto.Message {
+	return &UpdateDomainRequest{}
+}
+
+func newDomainAPIServiceUpdateDomainYARPCResponse() proto.Message {
+	return &UpdateDomainResponse{}
+}
+
+func newDomainAPIServiceDeprecateDomainYARPCRequest() proto.Message {
+	return &DeprecateDomainRequest{}
+}
+
+func newDomainAPIServiceDeprecateDomainYARPCResponse() proto.Message {
+	return &DeprecateDomainResponse{}
+}
+
+var (
+	emptyDomainAPIServiceRegisterDomainYARPCRequest   = &RegisterDomainRequest{}
+	emptyDomainAPIServiceRegisterDomainYARPCResponse  = &RegisterDomainResponse{}
+	emptyDomainAPIServiceDescribeDomainYARPCRequest   = &DescribeDomainRequest{}
+	emptyDomainAPIServiceDescribeDomainYARPCResponse  = &DescribeDomainResponse{}
+	emptyDomainAPIServiceListDomainsYARPCRequest      = &ListDomainsRequest{}
+	emptyDomainAPIServiceListDomainsYARPCResponse     = &ListDomainsResponse{}
+	emptyDomainAPIServiceUpdateDomainYARPCRequest     = &UpdateDomainRequest{}
+	emptyDomainAPIServiceUpdateDomainYARPCResponse    = &UpdateDomainResponse{}
+	emptyDomainAPIServiceDeprecateDomainYARPCRequest  = &DeprecateDomainRequest{}
+	emptyDomainAPIServiceDeprecateDomainYARPCResponse = &DeprecateDomainResponse{}
+)
+
+var yarpcFileDescriptorClosure2e37d15268893114 = [][]byte{
+	// uber/cadence/api/v1/service_domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdb, 0x6e, 0xdb, 0x46,
+		0x13, 0x8e, 0x7c, 0x8a, 0x3d, 0x3a, 0xd8, 0x5e, 0x9f, 0x18, 0x05, 0xf8, 0x7f, 0x41, 0x41, 0x5b,
+		0x35, 0x6d, 0xa9, 0x5a, 0xe9, 0x09, 0xcd, 0x55, 0x64, 0x39, 0x75, 0xd1, 0x26, 0x10, 0xe8, 0xf8,
+		0xa2, 0xed, 0x05, 0xbb, 0x24, 0xc7, 0xf4, 0xc2, 0x14, 0x97, 0xdd, 0x5d, 0xca, 0x51, 0x1e, 0xa4,
+		0xcf, 0xd6, 0x17, 0xe8, 0x7b, 0x14, 0x5c, 0x92, 0xb6, 0x0e, 0x94, 0x2d, 0x24, 0xce, 0x1d, 0x35,
+		0xfb, 0xcd, 0x37, 0xb3, 0x33, 0xf3, 0x0d, 0x45, 0x68, 0xc5, 0x0e, 0x8a, 0xb6, 0x4b, 0x3d, 0x0c,
+		0x5d, 0x6c, 0xd3, 0x88, 0xb5, 0x87, 0x87, 0x6d, 0x89, 0x62, 0xc8, 0x5c, 0xb4, 0x3d, 0x3e, 0xa0,
+		0x2c, 0x34, 0x23, 0xc1, 0x15, 0x27, 0x3b, 0x09, 0xd2, 0xcc, 0x90, 0x26, 0x8d, 0x98, 0x39, 0x3c,
+		0xac, 0xff, 0xcf, 0xe7, 0xdc, 0x0f, 0xb0, 0xad, 0x21, 0x4e, 0x7c, 0xde, 0xf6, 0x62, 0x41, 0x15,
+		0xe3, 0x99, 0x53, 0xbd, 0x31, 0x7d, 0x7e, 0xce, 0x30, 0xf0, 0xec, 0x01, 0x95, 0x97, 0x39, 0xa2,
+		0x28, 0x81, 0xf1, 0xc0, 0xcd, 0x7f, 0xd6, 0x60, 0xcf, 0x42, 0x9f, 0x49, 0x85, 0xa2, 0xa7, 0x0f,
+		0x2c, 0xfc, 0x2b, 0x46, 0xa9, 0xc8, 0x27, 0x50, 0x93, 0xe8, 0xc6, 0x82, 0xa9, 0x91, 0xad, 0xf8,
+		0x25, 0x86, 0x46, 0xa9, 0x51, 0x6a, 0x6d, 0x58, 0xd5, 0xdc, 0xfa, 0x26, 0x31, 0x12, 0x02, 0x2b,
+		0x21, 0x1d, 0xa0, 0xb1, 0xa4, 0x0f, 0xf5, 0x33, 0x69, 0x40, 0xd9, 0x43, 0xe9, 0x0a, 0x16, 0x25,
+		0xd9, 0x1a, 0xcb, 0xfa, 0x68, 0xdc, 0x44, 0xfe, 0x0f, 0x65, 0x7e, 0x15, 0xa2, 0xb0, 0x71, 0x40,
+		0x59, 0x60, 0xac, 0x68, 0x04, 0x68, 0xd3, 0x71, 0x62, 0x21, 0x17, 0xf0, 0xe4, 0x8a, 0x8b, 0xcb,
+		0xf3, 0x80, 0x5f, 0xd9, 0xf8, 0x16, 0xdd, 0x38, 0x71, 0xb3, 0x05, 0x2a, 0x0c, 0xf5, 0x53, 0x84,
+		0x82, 0x71, 0xcf, 0x58, 0x6d, 0x94, 0x5a, 0xe5, 0xce, 0x23, 0x33, 0xad, 0x84, 0x99, 0x57, 0xc2,
+		0xec, 0x65, 0x95, 0xb2, 0x1a, 0x39, 0xcb, 0x71, 0x4e, 0x62, 0xe5, 0x1c, 0x7d, 0x4d, 0x41, 0xfa,
+		0xb0, 0xee, 0x06, 0x71, 0x72, 0x7f, 0x69, 0xac, 0x35, 0x96, 0x5b, 0xe5, 0xce, 0x37, 0x66, 0x41,
+		0x37, 0xcc, 0xa3, 0x14, 0x64, 0x61, 0x14, 0x30, 0x57, 0x93, 0x1f, 0xf1, 0xf0, 0x9c, 0xf9, 0x79,
+		0xa4, 0x6b, 0x16, 0x62, 0xc2, 0x0e, 0x75, 0x15, 0x1b, 0xa2, 0x9d, 0x99, 0x6c, 0x5d, 0xa1, 0x87,
+		0xfa, 0x92, 0xdb, 0xe9, 0x51, 0xc6, 0xf6, 0x3a, 0x29, 0xd7, 0x09, 0xac, 0x78, 0x54, 0x51, 0x63,
+		0xfd, 0x96, 0xe8, 0x85, 0x3d, 0x32, 0x7b, 0x54, 0xd1, 0xe3, 0x50, 0x89, 0x91, 0xa5, 0x19, 0x48,
+		0x0b, 0xb6, 0x98, 0xb4, 0xfd, 0x80, 0x3b, 0x34, 0xc8, 0x06, 0xcc, 0xd8, 0x68, 0x94, 0x5a, 0xeb,
+		0x56, 0x8d, 0xc9, 0x9f, 0xb4, 0x39, 0x25, 0x20, 0x7f, 0xc0, 0xc1, 0x05, 0x93, 0x8a, 0x8b, 0x91,
+		0x4d, 0x85, 0x7b, 0xc1, 0x86, 0x34, 0xb0, 0xa5, 0xa2, 0x2a, 0x96, 0x06, 0x34, 0x4a, 0xad, 0x5a,
+		0xe7, 0x49, 0x61, 0x1a, 0x2f, 0x32, 0xec, 0xa9, 0x86, 0x5a, 0x7b, 0x19, 0xc7, 0xa4, 0x99, 0x7c,
+		0x0d, 0xbb, 0x33, 0xe4, 0xb1, 0x60, 0x46, 0x59, 0x57, 0x80, 0x4c, 0x39, 0x9d, 0x09, 0x46, 0x28,
+		0xd4, 0x87, 0x4c, 0x32, 0x87, 0x05, 0xc9, 0xb8, 0x4d, 0x67, 0x54, 0x59, 0x3c, 0x23, 0xe3, 0x86,
+		0x66, 0x2a, 0xa9, 0xef, 0xe0, 0xa0, 0x28, 0x44, 0x92, 0x57, 0x55, 0xe7, 0xb5, 0x37, 0xeb, 0x7a,
+		0x26, 0x58, 0xfd, 0x7b, 0xd8, 0xb8, 0x2e, 0x33, 0xd9, 0x82, 0xe5, 0x4b, 0x1c, 0x65, 0x4a, 0x48,
+		0x1e, 0xc9, 0x2e, 0xac, 0x0e, 0x69, 0x10, 0xe7, 0x02, 0x48, 0x7f, 0xfc, 0xb8, 0xf4, 0x43, 0xa9,
+		0x69, 0xc0, 0xfe, 0x74, 0xd7, 0x64, 0xc4, 0x43, 0x89, 0xcd, 0x7f, 0xd7, 0x61, 0xe7, 0x2c, 0xf2,
+		0xa8, 0xc2, 0x7b, 0x93, 0xdc, 0x73, 0x28, 0xc7, 0x9a, 0x51, 0xcb, 0x5f, 0xf7, 0xb0, 0xdc, 0xa9,
+		0xcf, 0xe8, 0xe2, 0x65, 0xb2, 0x21, 0x5e, 0x51, 0x79, 0x69, 0x41, 0x0a, 0x4f, 0x9e, 0xa7, 0xf5,
+		0x5a, 0xbe, 0x53, 0xaf, 0x95, 0x19, 0xbd, 0xbe, 0xcc, 0x66, 0xb8, 0xaa, 0x67, 0xb8, 0x53, 0xd8,
+		0xaa, 0x82, 0x2b, 0xcf, 0x4c, 0xf0, 0x82, 0xba, 0xaf, 0x7d, 0xb8, 0xee, 0x8f, 0xa0, 0xe2, 0x50,
+		0xcf, 0x76, 0x58, 0x48, 0x05, 0x43, 0x69, 0x6c, 0x6a, 0xca, 0x46, 0x61, 0xe6, 0x5d, 0xea, 0x75,
+		0x33, 0x9c, 0x55, 0x76, 0x6e, 0x7e, 0xdc, 0x26, 0xa3, 0xad, 0x8f, 0x26, 0xa3, 0xed, 0xf7, 0x94,
+		0x11, 0xf9, 0xc8, 0x32, 0xda, 0xb9, 0x45, 0x46, 0xf3, 0x96, 0xe2, 0xee, 0xbc, 0xa5, 0x38, 0xbe,
+		0x96, 0xf7, 0xee, 0x65, 0x2d, 0x3f, 0x85, 0x6d, 0x0f, 0x03, 0x54, 0x68, 0x5f, 0xf7, 0x7d, 0x64,
+		0xec, 0xeb, 0xf8, 0x9b, 0xe9, 0x41, 0xde, 0xe6, 0x11, 0xe9, 0xc1, 0xd6, 0x39, 0x65, 0x01, 0x1f,
+		0xa2, 0xb0, 0x15, 0x1b, 0x20, 0x8f, 0x95, 0x71, 0x70, 0xd7, 0xcc, 0x6d, 0xe6, 0x2e, 0x6f, 0x52,
+		0x0f, 0x72, 0x0c, 0x55, 0xc1, 0x03, 0x4c, 0x62, 0x79, 0x2c, 0xf4, 0xa5, 0x61, 0xe8, 0x8b, 0x14,
+		0xcf, 0x98, 0xc5, 0x03, 0xec, 0xa6, 0x40, 0xab, 0x22, 0x6e, 0x7e, 0xc8, 0xf7, 0xdf, 0x40, 0xbf,
+		0xc0, 0xee, 0xa4, 0xe6, 0xd2, 0xfd, 0x43, 0x9e, 0xc1, 0x5a, 0xf6, 0x72, 0x28, 0xe9, 0x3b, 0x3d,
+		0x2e, 0x4c, 0x28, 0x73, 0xca, 0xa0, 0xcd, 0x53, 0xd8, 0xef, 0x61, 0x24, 0xd0, 0xbd, 0xc7, 0xb5,
+		0xd5, 0x7c, 0x04, 0x07, 0x33, 0xa4, 0xd9, 0x92, 0x7c, 0x0d, 0x7b, 0x3d, 0xbd, 0x81, 0x9c, 0xa9,
+		0x70, 0x5b, 0xb0, 0xc4, 0xbc, 0x34, 0xc4, 0xc9, 0x03, 0x6b, 0x89, 0x79, 0x64, 0x77, 0x9c, 0xf9,
+		0xe4, 0x41, 0xca, 0xdd, 0xad, 0xe6, 0x5b, 0xcd, 0x41, 0xdb, 0x19, 0x35, 0x5f, 0x25, 0xf9, 0x4f,
+		0xf2, 0x7d, 0x48, 0x39, 0x7e, 0x03, 0xf2, 0x2b, 0x93, 0x2a, 0xb5, 0xca, 0x3c, 0xb7, 0xc7, 0xb0,
+		0x11, 0x51, 0x1f, 0x6d, 0xc9, 0xde, 0xa1, 0x66, 0x5b, 0xb5, 0xd6, 0x13, 0xc3, 0x29, 0x7b, 0x87,
+		0xe4, 0x53, 0xd8, 0x0c, 0xf1, 0xad, 0xb2, 0x35, 0x22, 0x2d, 0x54, 0x92, 0x71, 0xc5, 0xaa, 0x26,
+		0xe6, 0x3e, 0xf5, 0x51, 0x17, 0xaa, 0xa9, 0x60, 0x67, 0x82, 0x3a, 0x4b, 0xf3, 0x5b, 0x78, 0x98,
+		0xc6, 0x96, 0x46, 0x49, 0xcf, 0xd1, 0xad, 0x79, 0xe6, 0xd8, 0x45, 0xa3, 0x76, 0xfe, 0x5e, 0x81,
+		0x8d, 0xd4, 0xf7, 0x45, 0xff, 0x67, 0xc2, 0xa0, 0x36, 0xf9, 0xf2, 0x22, 0x4f, 0x17, 0xff, 0x5f,
+		0x52, 0xff, 0x62, 0x21, 0x6c, 0x76, 0x2f, 0x06, 0xb5, 0xc9, 0xc6, 0xcc, 0x09, 0x55, 0x38, 0x0d,
+		0x73, 0x42, 0xcd, 0xe9, 0xf4, 0x9f, 0x50, 0x1e, 0xab, 0x2c, 0xf9, 0xac, 0xd0, 0x77, 0xb6, 0xad,
+		0xf5, 0xd6, 0xdd, 0xc0, 0x2c, 0x82, 0x0b, 0x95, 0x71, 0xc9, 0x91, 0xd6, 0xa2, 0x6f, 0xc2, 0xfa,
+		0xe7, 0x0b, 0x20, 0xb3, 0x20, 0x01, 0x6c, 0x4e, 0xa9, 0x86, 0xcc, 0x2b, 0x43, 0x91, 0x60, 0xeb,
+		0x5f, 0x2e, 0x06, 0x4e, 0xa3, 0x75, 0x1d, 0x38, 0x70, 0xf9, 0xa0, 0xc8, 0xa5, 0x4b, 0x52, 0xe8,
+		0x69, 0xfa, 0x49, 0xd3, 0x4f, 0x36, 0x62, 0xbf, 0xf4, 0xfb, 0xa1, 0xcf, 0xd4, 0x45, 0xec, 0x98,
+		0x2e, 0x1f, 0xb4, 0xc7, 0x3f, 0x40, 0xbe, 0x62, 0x5e, 0xd0, 0xf6, 0x79, 0xfa, 0xbd, 0x92, 0x7d,
+		0x8d, 0x3c, 0xa7, 0x11, 0x1b, 0x1e, 0x3a, 0x6b, 0xda, 0xf6, 0xec, 0xbf, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x05, 0x8f, 0x52, 0x6b, 0x32, 0x0d, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/field_mask.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0xcb, 0x4c, 0xcd,
+		0x49, 0x89, 0xcf, 0x4d, 0x2c, 0xce, 0xd6, 0x03, 0x8b, 0x09, 0xf1, 0x43, 0x54, 0xe8, 0xc1, 0x54,
+		0x28, 0x29, 0x72, 0x71, 0xba, 0x81, 0x14, 0xf9, 0x26, 0x16, 0x67, 0x0b, 0x89, 0x70, 0xb1, 0x16,
+		0x24, 0x96, 0x64, 0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x41, 0x38, 0x4e, 0xad, 0x8c,
+		0x5c, 0xc2, 0xc9, 0xf9, 0xb9, 0x7a, 0x68, 0x5a, 0x9d, 0xf8, 0xe0, 0x1a, 0x03, 0x40, 0x42, 0x01,
+		0x8c, 0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x08,
+		0xa7, 0x94, 0x54, 0x16, 0xa4, 0x16, 0xeb, 0x67, 0xe7, 0xe5, 0x97, 0xe7, 0x41, 0x9c, 0x05, 0x72,
+		0x55, 0x41, 0xd2, 0x0f, 0x46, 0xc6, 0x45, 0x4c, 0xcc, 0xee, 0x01, 0x4e, 0xab, 0x98, 0xe4, 0xdc,
+		0x21, 0xba, 0x03, 0xa0, 0x5a, 0xf4, 0xc2, 0x53, 0x73, 0x72, 0xbc, 0x41, 0x1a, 0x42, 0x40, 0x7a,
+		0x93, 0xd8, 0xc0, 0x66, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x94, 0x66, 0x94, 0x9d, 0xe6,
+		0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x73, 0xda, 0x46,
+		0x10, 0xae, 0xc0, 0x76, 0xed, 0x15, 0x10, 0x72, 0x8e, 0x83, 0x42, 0x33, 0x35, 0x21, 0x93, 0x19,
+		0x9a, 0x99, 0x8a, 0x98, 0x74, 0xda, 0xa4, 0x9d, 0x3e, 0x00, 0x52, 0x52, 0x3a, 0x8e, 0xe3, 0x11,
+		0xc4, 0x9d, 0x69, 0x1e, 0xd4, 0x43, 0x3a, 0xe0, 0x26, 0x42, 0xa7, 0x39, 0x09, 0x1c, 0xbf, 0x75,
+		0xfa, 0xb3, 0xf2, 0xd8, 0x5f, 0xd6, 0xd1, 0xe9, 0x04, 0x02, 0x34, 0x76, 0xdf, 0x74, 0xbb, 0xdf,
+		0x7e, 0xfb, 0xdd, 0xde, 0xee, 0x9d, 0xa0, 0xb1, 0x18, 0x13, 0xde, 0x76, 0xb0, 0x4b, 0x7c, 0x87,
+		0xb4, 0x71, 0x40, 0xdb, 0xcb, 0xb3, 0xb6, 0xcb, 0xe6, 0x98, 0xfa, 0x7a, 0xc0, 0x59, 0xc4, 0xd0,
+		0x71, 0x8c, 0xd0, 0x25, 0x42, 0xc7, 0x01, 0xd5, 0x97, 0x67, 0xf5, 0x6f, 0xa7, 0x8c, 0x4d, 0x3d,
+		0xd2, 0x16, 0x90, 0xf1, 0x62, 0xd2, 0x76, 0x17, 0x1c, 0x47, 0x94, 0xc9, 0xa0, 0xfa, 0xe9, 0xb6,
+		0x3f, 0xa2, 0x73, 0x12, 0x46, 0x78, 0x1e, 0x48, 0x40, 0x6e, 0x5e, 0x87, 0xcd, 0xe7, 0x29, 0x45,
+		0xf3, 0xcb, 0x11, 0x1c, 0x18, 0x42, 0x08, 0xaa, 0x40, 0x81, 0xba, 0x9a, 0xd2, 0x50, 0x5a, 0x47,
+		0x56, 0x81, 0xba, 0x08, 0xc1, 0x9e, 0x8f, 0xe7, 0x44, 0x2b, 0x08, 0x8b, 0xf8, 0x46, 0xaf, 0xe1,
+		0x20, 0x8c, 0x70, 0xb4, 0x08, 0xb5, 0x62, 0x43, 0x69, 0x55, 0x3a, 0x4f, 0xf4, 0x1c, 0xdd, 0x7a,
+		0x42, 0x38, 0x14, 0x40, 0x4b, 0x06, 0xa0, 0x06, 0xa8, 0x2e, 0x09, 0x1d, 0x4e, 0x83, 0x78, 0x07,
+		0xda, 0x9e, 0x60, 0xcd, 0x9a, 0xd0, 0x29, 0xa8, 0xec, 0xda, 0x27, 0xdc, 0x26, 0x73, 0x4c, 0x3d,
+		0x6d, 0x5f, 0x20, 0x40, 0x98, 0xcc, 0xd8, 0x82, 0x5e, 0xc3, 0x9e, 0x8b, 0x23, 0xac, 0x1d, 0x34,
+		0x8a, 0x2d, 0xb5, 0xf3, 0xec, 0x96, 0xdc, 0xba, 0x81, 0x23, 0x6c, 0xfa, 0x11, 0xbf, 0xb1, 0x44,
+		0x08, 0x9a, 0xc1, 0xd3, 0x6b, 0xc6, 0x3f, 0x4d, 0x3c, 0x76, 0x6d, 0x93, 0xcf, 0xc4, 0x59, 0xc4,
+		0x19, 0x6d, 0x4e, 0x22, 0xe2, 0x8b, 0xaf, 0x80, 0x70, 0xca, 0x5c, 0xed, 0xeb, 0x86, 0xd2, 0x52,
+		0x3b, 0x8f, 0xf4, 0xa4, 0xb0, 0x7a, 0x5a, 0x58, 0xdd, 0x90, 0x85, 0xb7, 0x1a, 0x29, 0x8b, 0x99,
+		0x92, 0x58, 0x29, 0xc7, 0xa5, 0xa0, 0x40, 0x7d, 0x28, 0x8d, 0xb1, 0x6b, 0x8f, 0xa9, 0x8f, 0x39,
+		0x25, 0xa1, 0x76, 0x28, 0x28, 0x1b, 0xb9, 0x62, 0x7b, 0xd8, 0xed, 0x49, 0x9c, 0xa5, 0x8e, 0xd7,
+		0x0b, 0xf4, 0x11, 0x6a, 0x33, 0x1a, 0x46, 0x8c, 0xdf, 0xd8, 0x98, 0x3b, 0x33, 0xba, 0xc4, 0x9e,
+		0x2d, 0x0b, 0x7f, 0x24, 0x0a, 0xff, 0x34, 0x97, 0xaf, 0x2b, 0xb1, 0xb2, 0xf4, 0x27, 0x92, 0x63,
+		0xd3, 0x8c, 0x5e, 0xc0, 0x83, 0x1d, 0xf2, 0x05, 0xa7, 0x1a, 0x88, 0x82, 0xa3, 0xad, 0xa0, 0x0f,
+		0x9c, 0x22, 0x0c, 0xf5, 0x25, 0x0d, 0xe9, 0x98, 0x7a, 0x34, 0xda, 0x55, 0xa4, 0xfe, 0x7f, 0x45,
+		0xda, 0x9a, 0x66, 0x4b, 0xd4, 0x8f, 0x50, 0xcb, 0x4b, 0x11, 0xeb, 0x2a, 0x09, 0x5d, 0x27, 0xbb,
+		0xa1, 0xb1, 0x34, 0x1d, 0x8e, 0xb1, 0x13, 0xd1, 0x25, 0xb1, 0x1d, 0x6f, 0x11, 0x46, 0x84, 0xdb,
+		0xa2, 0x69, 0xcb, 0x22, 0xe6, 0x7e, 0xe2, 0xea, 0x27, 0x9e, 0x8b, 0xb8, 0x83, 0x2f, 0xe1, 0x50,
+		0x02, 0x43, 0xad, 0x22, 0xfa, 0xe8, 0x87, 0x5c, 0xe1, 0x32, 0xc6, 0x22, 0x81, 0x47, 0x1d, 0x71,
+		0xf6, 0x7d, 0xe6, 0x4f, 0xe8, 0x34, 0x6d, 0x84, 0x15, 0x0b, 0xfa, 0x0e, 0xaa, 0x13, 0x4c, 0x3d,
+		0xb6, 0x24, 0xdc, 0x5e, 0x12, 0x1e, 0xc6, 0xdd, 0x7d, 0xaf, 0xa1, 0xb4, 0x8a, 0xd6, 0xbd, 0xd4,
+		0x7e, 0x95, 0x98, 0x51, 0x0b, 0xaa, 0x34, 0xb4, 0xa7, 0x1e, 0x1b, 0x63, 0xcf, 0x4e, 0xe6, 0x5f,
+		0xab, 0x36, 0x94, 0xd6, 0xa1, 0x55, 0xa1, 0xe1, 0x5b, 0x61, 0x96, 0xc3, 0xf8, 0x06, 0xca, 0x2b,
+		0x52, 0xea, 0x4f, 0x98, 0x76, 0x5f, 0xb4, 0x51, 0xfe, 0xbc, 0xbd, 0x91, 0xc8, 0x81, 0x3f, 0x61,
+		0x56, 0x69, 0x92, 0x59, 0xa1, 0x8f, 0x71, 0x46, 0xe6, 0x09, 0xcd, 0xf6, 0x94, 0xb3, 0x45, 0x10,
+		0x6a, 0x48, 0x50, 0xbd, 0xc8, 0xa5, 0x1a, 0xa4, 0xe0, 0xb7, 0x31, 0x76, 0x73, 0xcb, 0xf7, 0xe8,
+		0x86, 0x33, 0x44, 0x26, 0x94, 0x39, 0xf3, 0x48, 0xdc, 0xeb, 0x2e, 0xf5, 0xa7, 0xa1, 0x76, 0x2c,
+		0x0a, 0x9a, 0xdf, 0xeb, 0x16, 0xf3, 0x48, 0x2f, 0x01, 0x5a, 0x25, 0xbe, 0x5e, 0x84, 0xf5, 0x9f,
+		0xe0, 0x68, 0x35, 0xae, 0xa8, 0x0a, 0xc5, 0x4f, 0xe4, 0x46, 0x5e, 0x43, 0xf1, 0x27, 0x7a, 0x00,
+		0xfb, 0x4b, 0xec, 0x2d, 0xd2, 0x8b, 0x28, 0x59, 0xfc, 0x5c, 0x78, 0xa5, 0x34, 0x0d, 0x38, 0xbd,
+		0xe3, 0x98, 0xd0, 0x13, 0x28, 0x6d, 0xf4, 0x45, 0xc2, 0xab, 0x3a, 0xeb, 0x8e, 0x68, 0x7e, 0x51,
+		0x40, 0xcd, 0x0c, 0x22, 0xfa, 0x1d, 0x0e, 0x57, 0xc3, 0xab, 0x88, 0x0d, 0xe9, 0x77, 0x0d, 0xaf,
+		0x9e, 0x7e, 0x24, 0x57, 0xce, 0x2a, 0xbe, 0x6e, 0x43, 0x79, 0xc3, 0x95, 0xb3, 0xbd, 0x57, 0xd9,
+		0xed, 0xa9, 0x9d, 0xe6, 0xad, 0xb9, 0x6e, 0xc4, 0x11, 0x67, 0x4a, 0xf0, 0x8f, 0x02, 0xe5, 0x0d,
+		0x27, 0x7a, 0x08, 0x07, 0x9c, 0xe0, 0x90, 0xf9, 0x32, 0x89, 0x5c, 0xa1, 0x3a, 0x1c, 0xb2, 0x80,
+		0x70, 0x1c, 0x31, 0x2e, 0x2b, 0xb9, 0x5a, 0xa3, 0x5f, 0xa1, 0xe4, 0x70, 0x82, 0x23, 0xe2, 0xda,
+		0xf1, 0x13, 0x22, 0x2e, 0x77, 0xb5, 0x53, 0xdf, 0xb9, 0x06, 0x47, 0xe9, 0xfb, 0x62, 0xa9, 0x12,
+		0x1f, 0x5b, 0x9a, 0xff, 0x16, 0xa0, 0x94, 0xed, 0xc1, 0xdc, 0x91, 0x50, 0xf2, 0x47, 0x62, 0x04,
+		0xda, 0x0a, 0x1a, 0x46, 0x98, 0x47, 0xf6, 0xea, 0x11, 0x93, 0x15, 0xb9, 0x4d, 0xc6, 0xc3, 0x34,
+		0x76, 0x18, 0x87, 0xae, 0xec, 0xe8, 0x0a, 0x1e, 0xad, 0x58, 0xc9, 0xe7, 0x80, 0x72, 0x92, 0xa1,
+		0xbd, 0x7b, 0x77, 0xb5, 0x34, 0xd8, 0x14, 0xb1, 0x6b, 0xde, 0x0e, 0x9c, 0x38, 0x6c, 0x1e, 0x78,
+		0x24, 0x2e, 0x55, 0x38, 0xc3, 0xdc, 0xb5, 0x1d, 0xb6, 0xf0, 0x23, 0xf1, 0x9c, 0xed, 0x5b, 0xc7,
+		0x2b, 0xe7, 0x30, 0xf6, 0xf5, 0x63, 0x17, 0x7a, 0x06, 0x95, 0x80, 0x88, 0x56, 0x4f, 0x22, 0x42,
+		0x6d, 0xbf, 0x51, 0x6c, 0xed, 0x5b, 0x65, 0x69, 0x15, 0xd0, 0xb0, 0xf9, 0x17, 0xa8, 0x99, 0x11,
+		0x41, 0x8f, 0xe1, 0x28, 0xe0, 0xd4, 0x77, 0x68, 0x80, 0x3d, 0x79, 0x92, 0x6b, 0x03, 0x7a, 0x09,
+		0x7b, 0xf1, 0x08, 0x89, 0x0a, 0x55, 0x3a, 0xa7, 0xb7, 0xbc, 0x84, 0x31, 0xa7, 0x25, 0xc0, 0xcf,
+		0xff, 0x56, 0xa0, 0x94, 0x7d, 0x9a, 0xd1, 0x23, 0x38, 0x31, 0xde, 0xbf, 0xeb, 0x0e, 0x2e, 0xec,
+		0xe1, 0xa8, 0x3b, 0xfa, 0x30, 0xb4, 0x07, 0x17, 0x57, 0xdd, 0xf3, 0x81, 0x51, 0xfd, 0x0a, 0x3d,
+		0x06, 0x6d, 0xd3, 0x65, 0x99, 0x6f, 0x07, 0xc3, 0x91, 0x69, 0x99, 0x46, 0x55, 0xd9, 0xf5, 0x1a,
+		0xe6, 0xa5, 0x65, 0xf6, 0xbb, 0x23, 0xd3, 0xa8, 0x16, 0x76, 0x69, 0x0d, 0xf3, 0xdc, 0x8c, 0x5d,
+		0xc5, 0xe7, 0x33, 0xa8, 0x6c, 0xdd, 0xfb, 0xdf, 0x40, 0xad, 0x6b, 0xf5, 0x7f, 0x1b, 0x5c, 0x75,
+		0xcf, 0x73, 0x55, 0x6c, 0x3b, 0x8d, 0xc1, 0xb0, 0xdb, 0x3b, 0x17, 0x2a, 0x72, 0x42, 0xcd, 0x8b,
+		0xc4, 0x59, 0x78, 0x4e, 0x01, 0xd6, 0x05, 0x40, 0x35, 0x38, 0x96, 0x92, 0xac, 0xf7, 0xe7, 0x66,
+		0x26, 0xc3, 0x03, 0xa8, 0x66, 0x1d, 0x96, 0xd9, 0x8d, 0x99, 0x4f, 0xe0, 0x7e, 0xd6, 0xfa, 0x87,
+		0x35, 0x18, 0x99, 0xd5, 0xc2, 0xb6, 0xb9, 0x6b, 0xbc, 0x1b, 0x5c, 0x54, 0x8b, 0xbd, 0x8f, 0x50,
+		0x73, 0xd8, 0x3c, 0xef, 0x0c, 0x7a, 0x6a, 0xa2, 0xe1, 0x32, 0x6e, 0xb1, 0x4b, 0xe5, 0xcf, 0xb3,
+		0x29, 0x8d, 0x66, 0x8b, 0xb1, 0xee, 0xb0, 0x79, 0x3b, 0xfb, 0x6b, 0xf6, 0x3d, 0x75, 0xbd, 0xf6,
+		0x94, 0x25, 0x3f, 0x72, 0xf2, 0x3f, 0xed, 0x17, 0x1c, 0xd0, 0xe5, 0xd9, 0xf8, 0x40, 0xd8, 0x5e,
+		0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x97, 0xb6, 0xa5, 0x4e, 0x43, 0x0a, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x51, 0x6f, 0xdb, 0x36,
+		0x17, 0xfd, 0x14, 0xc7, 0x4e, 0x7b, 0x9d, 0x26, 0xfa, 0x98, 0x35, 0x71, 0xd2, 0x75, 0x4b, 0x05,
+		0x0c, 0xf5, 0x8a, 0x4d, 0x46, 0xdc, 0x97, 0x62, 0x45, 0x37, 0x38, 0xb6, 0x93, 0xa8, 0xcd, 0x6c,
+		0x43, 0xf6, 0x1a, 0x74, 0x03, 0x26, 0xd0, 0x12, 0xe5, 0x72, 0x96, 0x48, 0x81, 0xa2, 0x9c, 0xf8,
+		0x65, 0xd8, 0x2f, 0xd9, 0xc3, 0xfe, 0xd2, 0xfe, 0xd0, 0x20, 0x89, 0x8a, 0xed, 0xce, 0x41, 0xf7,
+		0x30, 0xec, 0x8d, 0xbc, 0xe7, 0xdc, 0xc3, 0x43, 0xe2, 0xde, 0x2b, 0xc1, 0x71, 0x32, 0x26, 0xa2,
+		0xe1, 0x62, 0x8f, 0x30, 0x97, 0x34, 0x70, 0x44, 0x1b, 0xb3, 0x93, 0x86, 0xcb, 0xc3, 0x90, 0x33,
+		0x33, 0x12, 0x5c, 0x72, 0xb4, 0x97, 0x32, 0x4c, 0xc5, 0x30, 0x71, 0x44, 0xcd, 0xd9, 0xc9, 0xd1,
+		0x67, 0x13, 0xce, 0x27, 0x01, 0x69, 0x64, 0x94, 0x71, 0xe2, 0x37, 0xbc, 0x44, 0x60, 0x49, 0x8b,
+		0x24, 0xe3, 0x0d, 0xfc, 0xff, 0x8a, 0x8b, 0xa9, 0x1f, 0xf0, 0xeb, 0xee, 0x0d, 0x71, 0x93, 0x14,
+		0x42, 0x9f, 0x43, 0xf5, 0x5a, 0x05, 0x1d, 0xea, 0xd5, 0xb4, 0x63, 0xad, 0x7e, 0xdf, 0x86, 0x22,
+		0x64, 0x79, 0xe8, 0x21, 0x54, 0x44, 0xc2, 0x52, 0x6c, 0x23, 0xc3, 0xca, 0x22, 0x61, 0x96, 0x67,
+		0x18, 0xb0, 0x5d, 0x88, 0x8d, 0xe6, 0x11, 0x41, 0x08, 0x36, 0x19, 0x0e, 0x89, 0x12, 0xc8, 0xd6,
+		0x29, 0xa7, 0xe5, 0x4a, 0x3a, 0xa3, 0x72, 0x7e, 0x27, 0xe7, 0x31, 0x6c, 0x0d, 0xf0, 0x3c, 0xe0,
+		0xd8, 0x4b, 0x61, 0x0f, 0x4b, 0x9c, 0xc1, 0xdb, 0x76, 0xb6, 0x36, 0x5e, 0xc2, 0xd6, 0x19, 0xa6,
+		0x41, 0x22, 0x08, 0xda, 0x87, 0x8a, 0x20, 0x38, 0xe6, 0x4c, 0xe5, 0xab, 0x1d, 0xaa, 0xc1, 0x96,
+		0x47, 0x24, 0xa6, 0x41, 0x9c, 0x39, 0xdc, 0xb6, 0x8b, 0xad, 0xf1, 0xbb, 0x06, 0x9b, 0xdf, 0x93,
+		0x90, 0xa3, 0x57, 0x50, 0xf1, 0x29, 0x09, 0xbc, 0xb8, 0xa6, 0x1d, 0x97, 0xea, 0xd5, 0xe6, 0x17,
+		0xe6, 0x9a, 0xf7, 0x33, 0x53, 0xaa, 0x79, 0x96, 0xf1, 0xba, 0x4c, 0x8a, 0xb9, 0xad, 0x92, 0x8e,
+		0xae, 0xa0, 0xba, 0x14, 0x46, 0x3a, 0x94, 0xa6, 0x64, 0xae, 0x5c, 0xa4, 0x4b, 0xd4, 0x84, 0xf2,
+		0x0c, 0x07, 0x09, 0xc9, 0x0c, 0x54, 0x9b, 0x9f, 0xae, 0x95, 0x57, 0xd7, 0xb4, 0x73, 0xea, 0x37,
+		0x1b, 0x2f, 0x34, 0xe3, 0x0f, 0x0d, 0x2a, 0x17, 0x04, 0x7b, 0x44, 0xa0, 0xef, 0x3e, 0xb0, 0xf8,
+		0x74, 0xad, 0x46, 0x4e, 0xfe, 0x6f, 0x4d, 0xfe, 0xa9, 0x81, 0x3e, 0x24, 0x58, 0xb8, 0xef, 0x5b,
+		0x52, 0x0a, 0x3a, 0x4e, 0x24, 0x89, 0x91, 0x03, 0x3b, 0x94, 0x79, 0xe4, 0x86, 0x78, 0xce, 0x8a,
+		0xed, 0x17, 0x6b, 0x55, 0x3f, 0x4c, 0x37, 0xad, 0x3c, 0x77, 0xf9, 0x1e, 0x0f, 0xe8, 0x72, 0xec,
+		0xe8, 0x67, 0x40, 0x7f, 0x27, 0xfd, 0x8b, 0xb7, 0xf2, 0xe1, 0x5e, 0x07, 0x4b, 0x7c, 0x1a, 0xf0,
+		0x31, 0x3a, 0x83, 0x07, 0x84, 0xb9, 0xdc, 0xa3, 0x6c, 0xe2, 0xc8, 0x79, 0x94, 0x17, 0xe8, 0x4e,
+		0xf3, 0xc9, 0x5a, 0xad, 0xae, 0x62, 0xa6, 0x15, 0x6d, 0x6f, 0x93, 0xa5, 0xdd, 0x6d, 0x01, 0x6f,
+		0x2c, 0x15, 0xf0, 0x20, 0x6f, 0x3a, 0x22, 0xde, 0x12, 0x11, 0x53, 0xce, 0x2c, 0xe6, 0xf3, 0x94,
+		0x48, 0xc3, 0x28, 0x28, 0x1a, 0x21, 0x5d, 0xa3, 0xa7, 0xb0, 0xeb, 0x13, 0x2c, 0x13, 0x41, 0x9c,
+		0x59, 0x4e, 0x55, 0x0d, 0xb7, 0xa3, 0xc2, 0x4a, 0xc0, 0x78, 0x03, 0x07, 0xc3, 0x24, 0x8a, 0xb8,
+		0x90, 0xc4, 0x6b, 0x07, 0x94, 0x30, 0xa9, 0x90, 0x38, 0xed, 0xd5, 0x09, 0x77, 0x62, 0x6f, 0xaa,
+		0x94, 0xcb, 0x13, 0x3e, 0xf4, 0xa6, 0xe8, 0x10, 0xee, 0xfd, 0x82, 0x67, 0x38, 0x03, 0x72, 0xcd,
+		0xad, 0x74, 0x3f, 0xf4, 0xa6, 0xc6, 0x6f, 0x25, 0xa8, 0xda, 0x44, 0x8a, 0xf9, 0x80, 0x07, 0xd4,
+		0x9d, 0xa3, 0x0e, 0xe8, 0x94, 0x51, 0x49, 0x71, 0xe0, 0x50, 0x26, 0x89, 0x98, 0xe1, 0xdc, 0x65,
+		0xb5, 0x79, 0x68, 0xe6, 0xe3, 0xc5, 0x2c, 0xc6, 0x8b, 0xd9, 0x51, 0xe3, 0xc5, 0xde, 0x55, 0x29,
+		0x96, 0xca, 0x40, 0x0d, 0xd8, 0x1b, 0x63, 0x77, 0xca, 0x7d, 0xdf, 0x71, 0x39, 0xf1, 0x7d, 0xea,
+		0xa6, 0x36, 0xb3, 0xb3, 0x35, 0x1b, 0x29, 0xa8, 0xbd, 0x40, 0xd2, 0x63, 0x43, 0x7c, 0x43, 0xc3,
+		0x24, 0x5c, 0x1c, 0x5b, 0xfa, 0xe8, 0xb1, 0x2a, 0xe5, 0xf6, 0xd8, 0x2f, 0x17, 0x2a, 0x58, 0x4a,
+		0x12, 0x46, 0x32, 0xae, 0x6d, 0x1e, 0x6b, 0xf5, 0xf2, 0x2d, 0xb5, 0xa5, 0xc2, 0xe8, 0x15, 0x3c,
+		0x62, 0x9c, 0x39, 0x22, 0xbd, 0x3a, 0x1e, 0x07, 0xc4, 0x21, 0x42, 0x70, 0xe1, 0xe4, 0x23, 0x25,
+		0xae, 0x95, 0x8f, 0x4b, 0xf5, 0xfb, 0x76, 0x8d, 0x71, 0x66, 0x17, 0x8c, 0x6e, 0x4a, 0xb0, 0x73,
+		0x1c, 0xbd, 0x86, 0x3d, 0x72, 0x13, 0xd1, 0xdc, 0xc8, 0xc2, 0x72, 0xe5, 0x63, 0x96, 0xd1, 0x22,
+		0xab, 0x70, 0x6d, 0x84, 0x70, 0x60, 0xc5, 0x3c, 0xc8, 0x82, 0xe7, 0x82, 0x27, 0xd1, 0x00, 0x0b,
+		0x49, 0xb3, 0xe1, 0xbc, 0x66, 0x60, 0xa2, 0x6f, 0xa1, 0x1c, 0x4b, 0x2c, 0xf3, 0x82, 0xdf, 0x69,
+		0xd6, 0xd7, 0x16, 0xe9, 0xaa, 0xe0, 0x30, 0xe5, 0xdb, 0x79, 0x9a, 0x31, 0x83, 0x47, 0xab, 0x68,
+		0x9b, 0x33, 0x9f, 0x4e, 0x94, 0x43, 0x74, 0x05, 0x3a, 0x2d, 0x60, 0x67, 0x92, 0xe2, 0x45, 0x6b,
+		0x7f, 0xf5, 0x0f, 0x4e, 0xba, 0xb5, 0x6e, 0xef, 0xd2, 0x15, 0x20, 0x7e, 0x76, 0x0d, 0xdb, 0xcb,
+		0xad, 0x83, 0x0e, 0xe1, 0x61, 0xb7, 0xd7, 0xee, 0x77, 0xac, 0xde, 0xb9, 0x33, 0x7a, 0x37, 0xe8,
+		0x3a, 0x56, 0xef, 0x6d, 0xeb, 0xd2, 0xea, 0xe8, 0xff, 0x43, 0x47, 0xb0, 0xbf, 0x0a, 0x8d, 0x2e,
+		0x6c, 0xeb, 0x6c, 0x64, 0x5f, 0xe9, 0x1a, 0xda, 0x07, 0xb4, 0x8a, 0xbd, 0x1e, 0xf6, 0x7b, 0xfa,
+		0x06, 0xaa, 0xc1, 0x27, 0xab, 0xf1, 0x81, 0xdd, 0x1f, 0xf5, 0x9f, 0xeb, 0xa5, 0x67, 0xbf, 0xc2,
+		0xde, 0x9a, 0xe7, 0x40, 0x4f, 0xe0, 0xb1, 0x35, 0xec, 0x5f, 0xb6, 0x46, 0x56, 0xbf, 0xe7, 0x9c,
+		0xdb, 0xfd, 0x1f, 0x06, 0xce, 0x70, 0xd4, 0x1a, 0x2d, 0xfb, 0xb8, 0x93, 0x72, 0xd1, 0x6d, 0x5d,
+		0x8e, 0x2e, 0xde, 0xe9, 0xda, 0xdd, 0x94, 0x8e, 0xdd, 0xb2, 0x7a, 0xdd, 0x8e, 0xbe, 0x71, 0xfa,
+		0x13, 0x1c, 0xb8, 0x3c, 0x5c, 0xf7, 0x78, 0xa7, 0xd5, 0x76, 0xf6, 0x51, 0x1f, 0xa4, 0x75, 0x32,
+		0xd0, 0x7e, 0x3c, 0x99, 0x50, 0xf9, 0x3e, 0x19, 0x9b, 0x2e, 0x0f, 0x1b, 0xcb, 0xbf, 0x00, 0x5f,
+		0x53, 0x2f, 0x68, 0x4c, 0x78, 0xfe, 0x61, 0x57, 0xff, 0x03, 0x2f, 0x71, 0x44, 0x67, 0x27, 0xe3,
+		0x4a, 0x16, 0x7b, 0xfe, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0x0a, 0xaa, 0xd2, 0x33, 0x08,
+		0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) DomainAPIYARPCClient {
+			return NewDomainAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
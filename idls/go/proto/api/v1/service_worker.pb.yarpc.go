@@ -0,0 +1,1726 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/service_worker.proto
+
+package apiv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// WorkerAPIYARPCClient is the YARPC client-side interface for the WorkerAPI service.
+type WorkerAPIYARPCClient interface {
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest, ...yarpc.CallOption) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest, ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest, ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest, ...yarpc.CallOption) (*PollForActivityTaskResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatByIDResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest, ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest, ...yarpc.CallOption) (*ResetStickyTaskListResponse, error)
+}
+
+func newWorkerAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) WorkerAPIYARPCClient {
+	return &_WorkerAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.api.v1.WorkerAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewWorkerAPIYARPCClient builds a new YARPC client for the WorkerAPI service.
+func NewWorkerAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) WorkerAPIYARPCClient {
+	return newWorkerAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// WorkerAPIYARPCServer is the YARPC server-side interface for the WorkerAPI service.
+type WorkerAPIYARPCServer interface {
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest) (*PollForActivityTaskResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest) (*RespondActivityTaskCanceledByIDResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest) (*RecordActivityTaskHeartbeatByIDResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest) (*ResetStickyTaskListResponse, error)
+}
+
+type buildWorkerAPIYARPCProceduresParams struct {
+	Server      WorkerAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildWorkerAPIYARPCProcedures(params buildWorkerAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_WorkerAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.api.v1.WorkerAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "PollForDecisionTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PollForDecisionTask,
+							NewRequest:  newWorkerAPIServicePollForDecisionTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondDecisionTaskCompleted,
+							NewRequest:  newWorkerAPIServiceRespondDecisionTaskCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondDecisionTaskFailed,
+							NewRequest:  newWorkerAPIServiceRespondDecisionTaskFailedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PollForActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PollForActivityTask,
+							NewRequest:  newWorkerAPIServicePollForActivityTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCompleted,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompletedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCompletedByID,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskFailed,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskFailedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskFailedByID,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskFailedByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceled",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCanceled,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskCanceledYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceledByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCanceledByID,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeat",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RecordActivityTaskHeartbeat,
+							NewRequest:  newWorkerAPIServiceRecordActivityTaskHeartbeatYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeatByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RecordActivityTaskHeartbeatByID,
+							NewRequest:  newWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondQueryTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondQueryTaskCompleted,
+							NewRequest:  newWorkerAPIServiceRespondQueryTaskCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResetStickyTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResetStickyTaskList,
+							NewRequest:  newWorkerAPIServiceResetStickyTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildWorkerAPIYARPCProcedures prepares an implementation of the WorkerAPI service for YARPC registration.
+func BuildWorkerAPIYARPCProcedures(server WorkerAPIYARPCServer) []transport.Procedure {
+	return buildWorkerAPIYARPCProcedures(buildWorkerAPIYARPCProceduresParams{Server: server})
+}
+
+// FxWorkerAPIYARPCClientParams defines the input
+// for NewFxWorkerAPIYARPCClient. It provides the
+// paramaters to get a WorkerAPIYARPCClient in an
+// Fx application.
+type FxWorkerAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxWorkerAPIYARPCClientResult defines the output
+// of NewFxWorkerAPIYARPCClient. It provides a
+// WorkerAPIYARPCClient to an Fx application.
+type FxWorkerAPIYARPCClientResult struct {
+	fx.Out
+
+	Client WorkerAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxWorkerAPIYARPCClient provides a WorkerAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//  fx.Provide(
+//    apiv1.NewFxWorkerAPIYARPCClient("service-name"),
+//    ...
+//  )
+func NewFxWorkerAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxWorkerAPIYARPCClientParams) FxWorkerAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxWorkerAPIYARPCClientResult{
+			Client: newWorkerAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxWorkerAPIYARPCProceduresParams defines the input
+// for NewFxWorkerAPIYARPCProcedures. It provides the
+// paramaters to get WorkerAPIYARPCServer procedures in an
+// Fx application.
+type FxWorkerAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      WorkerAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxWorkerAPIYARPCProceduresResult defines the output
+// of NewFxWorkerAPIYARPCProcedures. It provides
+// WorkerAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxWorkerAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxWorkerAPIYARPCProcedures provides WorkerAPIYARPCServer procedures to an Fx application.
+// It expects a WorkerAPIYARPCServer to be present in the container.
+//
+//  fx.Provide(
+//    apiv1.NewFxWorkerAPIYARPCProcedures(),
+//    ...
+//  )
+func NewFxWorkerAPIYARPCProcedures() interface{} {
+	return func(params FxWorkerAPIYARPCProceduresParams) FxWorkerAPIYARPCProceduresResult {
+		return FxWorkerAPIYARPCProceduresResult{
+			Procedures: buildWorkerAPIYARPCProcedures(buildWorkerAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: reflection.ServerMeta{
+				ServiceName:     "uber.cadence.api.v1.WorkerAPI",
+				FileDescriptors: yarpcFileDescriptorClosure00684e887839a48c,
+			},
+		}
+	}
+}
+
+type _WorkerAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_WorkerAPIYARPCCaller) PollForDecisionTask(ctx context.Context, request *PollForDecisionTaskRequest, options ...yarpc.CallOption) (*PollForDecisionTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForDecisionTask", request, newWorkerAPIServicePollForDecisionTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForDecisionTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServicePollForDecisionTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondDecisionTaskCompleted(ctx context.Context, request *RespondDecisionTaskCompletedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskCompleted", request, newWorkerAPIServiceRespondDecisionTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondDecisionTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondDecisionTaskFailed(ctx context.Context, request *RespondDecisionTaskFailedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskFailed", request, newWorkerAPIServiceRespondDecisionTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondDecisionTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) PollForActivityTask(ctx context.Context, request *PollForActivityTaskRequest, options ...yarpc.CallOption) (*PollForActivityTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForActivityTask", request, newWorkerAPIServicePollForActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServicePollForActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskCompleted(ctx context.Context, request *RespondActivityTaskCompletedRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompleted", request, newWorkerAPIServiceRespondActivityTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskCompletedByID(ctx context.Context, request *RespondActivityTaskCompletedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompletedByID", request, newWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskFailed(ctx context.Context, request *RespondActivityTaskFailedRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailed", request, newWorkerAPIServiceRespondActivityTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskFailedByID(ctx context.Context, request *RespondActivityTaskFailedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailedByID", request, newWorkerAPIServiceRespondActivityTaskFailedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskFailedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskCanceled(ctx context.Context, request *RespondActivityTaskCanceledRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceled", request, newWorkerAPIServiceRespondActivityTaskCanceledYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCanceledYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskCanceledByID(ctx context.Context, request *RespondActivityTaskCanceledByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceledByID", request, newWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RecordActivityTaskHeartbeat(ctx context.Context, request *RecordActivityTaskHeartbeatRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeat", request, newWorkerAPIServiceRecordActivityTaskHeartbeatYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRecordActivityTaskHeartbeatYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RecordActivityTaskHeartbeatByID(ctx context.Context, request *RecordActivityTaskHeartbeatByIDRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeatByID", request, newWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondQueryTaskCompleted(ctx context.Context, request *RespondQueryTaskCompletedRequest, options ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondQueryTaskCompleted", request, newWorkerAPIServiceRespondQueryTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondQueryTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondQueryTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) ResetStickyTaskList(ctx context.Context, request *ResetStickyTaskListRequest, options ...yarpc.CallOption) (*ResetStickyTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetStickyTaskList", request, newWorkerAPIServiceResetStickyTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetStickyTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceResetStickyTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _WorkerAPIYARPCHandler struct {
+	server WorkerAPIYARPCServer
+}
+
+func (h *_WorkerAPIYARPCHandler) PollForDecisionTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForDecisionTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForDecisionTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServicePollForDecisionTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForDecisionTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondDecisionTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondDecisionTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondDecisionTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondDecisionTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) PollForActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServicePollForActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskCompletedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompletedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskFailedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskFailedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskCanceled(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCanceledYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceled(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskCanceledByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceledByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RecordActivityTaskHeartbeat(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRecordActivityTaskHeartbeatYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeat(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeatByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondQueryTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondQueryTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondQueryTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondQueryTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondQueryTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) ResetStickyTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetStickyTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetStickyTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceResetStickyTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetStickyTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newWorkerAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
+	return &PollForDecisionTaskRequest{}
+}
+
+func newWorkerAPIServicePollForDecisionTaskYARPCResponse() proto.Message {
+	return &PollForDecisionTaskResponse{}
+}
+
+func newWorkerAPIServiceRespondDecisionTaskCompletedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskCompletedRequest{}
+}
+
+func newWorkerAPIServiceRespondDecisionTaskCompletedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskCompletedResponse{}
+}
+
+func newWorkerAPIServiceRespondDecisionTaskFailedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskFailedRequest{}
+}
+
+func newWorkerAPIServiceRespondDecisionTaskFailedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskFailedResponse{}
+}
+
+func newWorkerAPIServicePollForActivityTaskYARPCRequest() proto.Message {
+	return &PollForActivityTaskRequest{}
+}
+
+func newWorkerAPIServicePollForActivityTaskYARPCResponse() proto.Message {
+	return &PollForActivityTaskResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCompletedYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCompletedYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedByIDRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedByIDResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskFailedYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskFailedYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskFailedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedByIDRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskFailedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedByIDResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCanceledYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCanceledYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledByIDRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledByIDResponse{}
+}
+
+func newWorkerAPIServiceRecordActivityTaskHeartbeatYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatRequest{}
+}
+
+func newWorkerAPIServiceRecordActivityTaskHeartbeatYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatByIDRequest{}
+}
+
+func newWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatByIDResponse{}
+}
+
+func newWorkerAPIServiceRespondQueryTaskCompletedYARPCRequest() proto.Message {
+	return &RespondQueryTaskCompletedRequest{}
+}
+
+func newWorkerAPIServiceRespondQueryTaskCompletedYARPCResponse() proto.Message {
+	return &RespondQueryTaskCompletedResponse{}
+}
+
+func newWorkerAPIServiceResetStickyTaskListYARPCRequest() proto.Message {
+	return &ResetStickyTaskListRequest{}
+}
+
+func newWorkerAPIServiceResetStickyTaskListYARPCResponse() proto.Message {
+	return &ResetStickyTaskListResponse{}
+}
+
+var (
+	emptyWorkerAPIServicePollForDecisionTaskYARPCRequest               = &PollForDecisionTaskRequest{}
+	emptyWorkerAPIServicePollForDecisionTaskYARPCResponse              = &PollForDecisionTaskResponse{}
+	emptyWorkerAPIServiceRespondDecisionTaskCompletedYARPCRequest      = &RespondDecisionTaskCompletedRequest{}
+	emptyWorkerAPIServiceRespondDecisionTaskCompletedYARPCResponse     = &RespondDecisionTaskCompletedResponse{}
+	emptyWorkerAPIServiceRespondDecisionTaskFailedYARPCRequest         = &RespondDecisionTaskFailedRequest{}
+	emptyWorkerAPIServiceRespondDecisionTaskFailedYARPCResponse        = &RespondDecisionTaskFailedResponse{}
+	emptyWorkerAPIServicePollForActivityTaskYARPCRequest               = &PollForActivityTaskRequest{}
+	emptyWorkerAPIServicePollForActivityTaskYARPCResponse              = &PollForActivityTaskResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskCompletedYARPCRequest      = &RespondActivityTaskCompletedRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskCompletedYARPCResponse     = &RespondActivityTaskCompletedResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCRequest  = &RespondActivityTaskCompletedByIDRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCResponse = &RespondActivityTaskCompletedByIDResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskFailedYARPCRequest         = &RespondActivityTaskFailedRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskFailedYARPCResponse        = &RespondActivityTaskFailedResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskFailedByIDYARPCRequest     = &RespondActivityTaskFailedByIDRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskFailedByIDYARPCResponse    = &RespondActivityTaskFailedByIDResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskCanceledYARPCRequest       = &RespondActivityTaskCanceledRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskCanceledYARPCResponse      = &RespondActivityTaskCanceledResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCRequest   = &RespondActivityTaskCanceledByIDRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCResponse  = &RespondActivityTaskCanceledByIDResponse{}
+	emptyWorkerAPIServiceRecordActivityTaskHeartbeatYARPCRequest       = &RecordActivityTaskHeartbeatRequest{}
+	emptyWorkerAPIServiceRecordActivityTaskHeartbeatYARPCResponse      = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCRequest   = &RecordActivityTaskHeartbeatByIDRequest{}
+	emptyWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCResponse  = &RecordActivityTaskHeartbeatByIDResponse{}
+	emptyWorkerAPIServiceRespondQueryTaskCompletedYARPCRequest         = &RespondQueryTaskCompletedRequest{}
+	emptyWorkerAPIServiceRespondQueryTaskCompletedYARPCResponse        = &RespondQueryTaskCompletedResponse{}
+	emptyWorkerAPIServiceResetStickyTaskListYARPCRequest               = &ResetStickyTaskListRequest{}
+	emptyWorkerAPIServiceResetStickyTaskListYARPCResponse              = &ResetStickyTaskListResponse{}
+)
+
+var yarpcFileDescriptorClosure00684e887839a48c = [][]byte{
+	// uber/cadence/api/v1/service_worker.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdc, 0xc6,
+		0x15, 0x06, 0x75, 0xd7, 0x59, 0x5d, 0x56, 0x63, 0xd4, 0x5d, 0xad, 0x6f, 0xf2, 0xa6, 0xb6, 0x95,
+		0x36, 0xdd, 0xad, 0x95, 0xd4, 0x71, 0xec, 0xb8, 0xa8, 0x2e, 0x16, 0xac, 0x22, 0x4d, 0x15, 0x7a,
+		0xe3, 0x00, 0x29, 0x60, 0x62, 0x96, 0x1c, 0x69, 0x07, 0xe2, 0x72, 0x68, 0x72, 0xb8, 0xf2, 0xbe,
+		0xf4, 0xa1, 0x8f, 0x49, 0x1f, 0x0a, 0x14, 0x4d, 0x5f, 0x0a, 0xf8, 0xb9, 0x3f, 0xa0, 0xbf, 0xa6,
+		0x4f, 0x7d, 0xee, 0x7f, 0x28, 0x0a, 0xce, 0x0c, 0xb9, 0xdc, 0xdd, 0x21, 0xf7, 0xd2, 0x07, 0x05,
+		0xc8, 0x9b, 0x38, 0xfc, 0xce, 0xe1, 0x99, 0xf3, 0x9d, 0x39, 0xf3, 0xcd, 0x68, 0x61, 0x37, 0x6a,
+		0x91, 0xa0, 0x61, 0x63, 0x87, 0x78, 0x36, 0x69, 0x60, 0x9f, 0x36, 0xba, 0x0f, 0x1b, 0x21, 0x09,
+		0xba, 0xd4, 0x26, 0xd6, 0x25, 0x0b, 0x2e, 0x48, 0x50, 0xf7, 0x03, 0xc6, 0x19, 0xba, 0x16, 0x23,
+		0xeb, 0x0a, 0x59, 0xc7, 0x3e, 0xad, 0x77, 0x1f, 0x56, 0x6f, 0x9f, 0x33, 0x76, 0xee, 0x92, 0x86,
+		0x80, 0xb4, 0xa2, 0xb3, 0x86, 0x13, 0x05, 0x98, 0x53, 0xe6, 0x49, 0xa3, 0xea, 0x9d, 0xe1, 0xf7,
+		0x9c, 0x76, 0x48, 0xc8, 0x71, 0xc7, 0x57, 0x80, 0x11, 0x07, 0x97, 0x01, 0xf6, 0x7d, 0x12, 0x84,
+		0xea, 0xfd, 0x8e, 0x2e, 0x3e, 0x9b, 0x75, 0x3a, 0xe9, 0x27, 0x6a, 0x3a, 0x84, 0x43, 0x6c, 0x1a,
+		0xf6, 0xc3, 0xb8, 0xab, 0xc3, 0xb4, 0x69, 0xc8, 0x59, 0xd0, 0x4b, 0x22, 0xd5, 0x41, 0xde, 0x44,
+		0x24, 0x05, 0x68, 0xbf, 0xc3, 0x71, 0x78, 0xe1, 0xd2, 0x90, 0x17, 0x61, 0xe2, 0x2c, 0x9e, 0xb9,
+		0xec, 0x52, 0x62, 0x6a, 0xff, 0x34, 0xa0, 0x7a, 0xca, 0x5c, 0xf7, 0x98, 0x05, 0x47, 0x2a, 0xca,
+		0x26, 0x0e, 0x2f, 0x4c, 0xf2, 0x26, 0x22, 0x21, 0x47, 0xd7, 0x61, 0xc9, 0x61, 0x1d, 0x4c, 0xbd,
+		0x8a, 0xb1, 0x63, 0xec, 0xae, 0x9a, 0xea, 0x09, 0x3d, 0x81, 0xd5, 0xf8, 0x63, 0x56, 0xfc, 0xb5,
+		0xca, 0xdc, 0x8e, 0xb1, 0x5b, 0xda, 0xbb, 0x55, 0xd7, 0x50, 0x52, 0x8f, 0x9d, 0x7d, 0x46, 0x43,
+		0x6e, 0xae, 0x70, 0xf5, 0x17, 0xaa, 0xc2, 0x0a, 0x75, 0x88, 0xc7, 0x29, 0xef, 0x55, 0xe6, 0x85,
+		0xd7, 0xf4, 0x19, 0x3d, 0x80, 0xcd, 0x16, 0xf5, 0x70, 0xd0, 0xb3, 0xec, 0x36, 0xb1, 0x2f, 0xc2,
+		0xa8, 0x53, 0x59, 0x10, 0x90, 0x0d, 0x39, 0x7c, 0xa8, 0x46, 0x6b, 0xff, 0x5d, 0x86, 0x1b, 0xda,
+		0xb8, 0x43, 0x9f, 0x79, 0x21, 0x41, 0xb7, 0x00, 0x44, 0x80, 0x9c, 0x5d, 0x10, 0x19, 0xfc, 0x9a,
+		0x29, 0x42, 0x6e, 0xc6, 0x03, 0xe8, 0x4b, 0x40, 0x49, 0x22, 0x2c, 0xf2, 0x96, 0xd8, 0x51, 0x5c,
+		0x25, 0x6a, 0x22, 0xf7, 0xb5, 0x13, 0xf9, 0x4a, 0xc1, 0x9f, 0x27, 0x68, 0x73, 0xeb, 0x72, 0x78,
+		0x08, 0x1d, 0xc3, 0x7a, 0xea, 0x96, 0xf7, 0x7c, 0x22, 0xe6, 0x57, 0xda, 0xbb, 0x5b, 0xe8, 0xb1,
+		0xd9, 0xf3, 0x89, 0xb9, 0x76, 0x99, 0x79, 0x42, 0xaf, 0x60, 0xdb, 0x0f, 0x48, 0x97, 0xb2, 0x28,
+		0xb4, 0x42, 0x8e, 0x03, 0x4e, 0x1c, 0x8b, 0x74, 0x89, 0xc7, 0x2d, 0xea, 0x88, 0x84, 0x94, 0xf6,
+		0x6e, 0xd4, 0x65, 0xad, 0xd6, 0x93, 0x5a, 0xad, 0x9f, 0x78, 0xfc, 0xd1, 0x47, 0xaf, 0xb0, 0x1b,
+		0x11, 0xf3, 0x7a, 0x62, 0xfd, 0x52, 0x1a, 0x3f, 0x8f, 0x6d, 0x4f, 0x1c, 0xb4, 0x0b, 0xe5, 0x11,
+		0x77, 0x8b, 0x3b, 0xc6, 0xee, 0xbc, 0xb9, 0x11, 0x0e, 0x22, 0x2b, 0xb0, 0x8c, 0x39, 0x27, 0x1d,
+		0x9f, 0x57, 0x96, 0x04, 0x20, 0x79, 0x44, 0x1f, 0x00, 0x6a, 0x61, 0xfb, 0xc2, 0x65, 0xe7, 0x96,
+		0xcd, 0x22, 0x8f, 0x5b, 0x6d, 0xea, 0xf1, 0xca, 0xb2, 0x00, 0x95, 0xd5, 0x9b, 0xc3, 0xf8, 0xc5,
+		0x0b, 0xea, 0x71, 0xf4, 0x08, 0x96, 0x55, 0x65, 0x57, 0x56, 0x44, 0xdc, 0x37, 0xb5, 0xb9, 0x78,
+		0x21, 0x31, 0x66, 0x02, 0x46, 0xf7, 0x61, 0xd3, 0x23, 0x6f, 0xb9, 0xe5, 0xe3, 0x73, 0xa2, 0x48,
+		0x5c, 0x15, 0x24, 0xae, 0xc7, 0xc3, 0xa7, 0xf8, 0x9c, 0x48, 0x22, 0x1f, 0xc3, 0xa2, 0x58, 0x16,
+		0x15, 0x10, 0xde, 0x6b, 0x85, 0x99, 0xfe, 0x22, 0x46, 0x9a, 0xd2, 0x00, 0xbd, 0x86, 0x9b, 0xa3,
+		0x25, 0x60, 0xf5, 0xab, 0xba, 0x34, 0x49, 0x55, 0x6f, 0x8f, 0xd4, 0x40, 0xf2, 0x0a, 0xed, 0xc3,
+		0x46, 0x68, 0xb7, 0x89, 0x13, 0xb9, 0xc4, 0xb1, 0xe2, 0x46, 0x53, 0x59, 0x13, 0x1e, 0xab, 0x23,
+		0xc4, 0x35, 0x93, 0x2e, 0x64, 0xae, 0xa7, 0x16, 0xf1, 0x18, 0x7a, 0x06, 0x6b, 0x09, 0x5d, 0xc2,
+		0xc1, 0xfa, 0x58, 0x07, 0x25, 0x85, 0x17, 0xe6, 0x5f, 0xc1, 0x72, 0x3c, 0x55, 0x4a, 0xc2, 0xca,
+		0xc6, 0xce, 0xfc, 0x6e, 0x69, 0xef, 0x99, 0x76, 0x32, 0x05, 0xcb, 0xa8, 0xfe, 0x85, 0xb4, 0x7f,
+		0xee, 0xf1, 0x98, 0x1c, 0xe5, 0x0d, 0xd5, 0x40, 0xb0, 0xd0, 0xaf, 0xa1, 0x4d, 0xc1, 0x7e, 0x29,
+		0x1e, 0x54, 0x05, 0x54, 0x7d, 0x0d, 0x6b, 0x59, 0x63, 0x54, 0x86, 0xf9, 0x0b, 0xd2, 0x53, 0x6d,
+		0x24, 0xfe, 0x33, 0xa6, 0xae, 0x1b, 0x57, 0xab, 0x5a, 0x76, 0x13, 0x51, 0x27, 0x0c, 0x9e, 0xcc,
+		0x3d, 0x36, 0x6a, 0xff, 0x58, 0x84, 0xf7, 0x64, 0x98, 0x4e, 0x36, 0xf2, 0x43, 0xd6, 0xf1, 0x5d,
+		0xc2, 0x89, 0x93, 0x74, 0xb0, 0x31, 0x8d, 0xe0, 0x29, 0xac, 0x26, 0xdd, 0x39, 0xac, 0xcc, 0x89,
+		0x2c, 0xe9, 0x29, 0x4f, 0x3e, 0x62, 0xf6, 0xf1, 0xe8, 0x67, 0xb0, 0xd5, 0xaf, 0x1c, 0x9b, 0x79,
+		0x9c, 0xbc, 0xe5, 0x62, 0xc9, 0xaf, 0x99, 0xe5, 0xf4, 0xc5, 0xa1, 0x1c, 0x1f, 0x68, 0x7b, 0x0b,
+		0x43, 0x6d, 0xef, 0xf7, 0xb0, 0x15, 0x72, 0x6a, 0x5f, 0xf4, 0x2c, 0xcc, 0x79, 0x40, 0x5b, 0x11,
+		0x27, 0xa1, 0x58, 0x98, 0xa5, 0xbd, 0xba, 0x36, 0x9a, 0x97, 0x02, 0x9d, 0x16, 0xdd, 0x7e, 0x6a,
+		0x65, 0x96, 0xa5, 0xa3, 0xfe, 0x08, 0xfa, 0x18, 0x2a, 0x01, 0xe1, 0x51, 0xe0, 0x59, 0x1e, 0xb9,
+		0xb4, 0x92, 0xe8, 0x45, 0xa5, 0x8b, 0xb5, 0xbd, 0x62, 0xfe, 0x48, 0xbe, 0xff, 0x9c, 0x5c, 0x66,
+		0x53, 0x89, 0x0e, 0xe0, 0xf6, 0x19, 0x0b, 0x6c, 0x62, 0xd9, 0x01, 0xc1, 0x9c, 0x68, 0xcc, 0x97,
+		0x85, 0x79, 0x55, 0xa0, 0x0e, 0x05, 0x68, 0xd8, 0x87, 0xa6, 0xa1, 0xaf, 0xe8, 0x1a, 0x3a, 0x62,
+		0xb0, 0x2e, 0xd6, 0xa5, 0x15, 0x90, 0x30, 0x72, 0x79, 0x58, 0x59, 0x15, 0x64, 0xfc, 0x46, 0x3b,
+		0xfd, 0x09, 0x88, 0xaf, 0xcb, 0x8a, 0x91, 0xce, 0x64, 0xfd, 0xae, 0xbd, 0xc9, 0x0c, 0x55, 0x29,
+		0x6c, 0x8d, 0x40, 0x34, 0x55, 0xfa, 0xab, 0xc1, 0x2a, 0xdd, 0x9d, 0xa0, 0x4a, 0x85, 0xc3, 0x6c,
+		0xad, 0xbe, 0x9b, 0x87, 0x9f, 0x14, 0x87, 0xac, 0x76, 0xad, 0x2f, 0x61, 0x7d, 0x30, 0xc1, 0x86,
+		0xf8, 0xe8, 0x2f, 0xa6, 0x5d, 0xb7, 0xe6, 0x9a, 0x93, 0x25, 0xe1, 0x9d, 0x01, 0xb7, 0xb1, 0xcd,
+		0x69, 0x97, 0x72, 0x4a, 0x42, 0x8b, 0x33, 0xcb, 0xa1, 0xa1, 0x8f, 0xb9, 0xdd, 0xb6, 0x5c, 0x66,
+		0x63, 0xd7, 0xed, 0xa9, 0xd2, 0xff, 0x7a, 0x86, 0x6c, 0xab, 0x4e, 0xb1, 0x9f, 0xfa, 0x6f, 0xb2,
+		0x23, 0xe5, 0xfd, 0x33, 0xe9, 0x5c, 0x66, 0xff, 0x06, 0xce, 0x47, 0x54, 0xff, 0x00, 0x3b, 0xe3,
+		0x1c, 0x68, 0xb8, 0x39, 0x1a, 0xe4, 0x46, 0xbf, 0x54, 0x94, 0xdf, 0x9e, 0xf0, 0x95, 0x38, 0x3e,
+		0xf1, 0xce, 0x58, 0x96, 0xa1, 0x3f, 0xce, 0xc1, 0x8e, 0x66, 0x9a, 0xc7, 0x98, 0xba, 0x13, 0xb7,
+		0x92, 0x03, 0x58, 0xb4, 0x71, 0x14, 0xca, 0x68, 0x36, 0xf6, 0x3e, 0x28, 0x6c, 0x23, 0x7d, 0xef,
+		0x87, 0xb1, 0x8d, 0x29, 0x4d, 0xe3, 0xed, 0xd2, 0x21, 0x1c, 0x53, 0x37, 0x54, 0xd2, 0x41, 0xbf,
+		0x5d, 0x9e, 0xe2, 0x9e, 0xcb, 0xb0, 0x63, 0x26, 0xe0, 0xc2, 0xe6, 0xa2, 0x59, 0x82, 0x8b, 0x5a,
+		0x4d, 0xf5, 0x1e, 0xdc, 0x2d, 0xc8, 0x81, 0xe4, 0xb9, 0xf6, 0xef, 0xbe, 0x60, 0x4c, 0x32, 0x7b,
+		0x95, 0x82, 0xf1, 0x25, 0xa0, 0xd4, 0xaf, 0xd5, 0x21, 0x1c, 0x3b, 0x98, 0x63, 0x25, 0x91, 0xee,
+		0x15, 0x7e, 0xe0, 0xb7, 0x0a, 0x6c, 0x96, 0xf9, 0xd0, 0x48, 0xed, 0xdb, 0x95, 0x54, 0x5c, 0x0e,
+		0xce, 0xf1, 0x4a, 0xc5, 0xe5, 0x1d, 0x28, 0xa9, 0x25, 0xd4, 0x8b, 0xf7, 0x5c, 0x99, 0x09, 0x48,
+		0x86, 0x4e, 0x9c, 0x58, 0x7d, 0xa6, 0x00, 0xa1, 0x3e, 0x17, 0x0a, 0xd4, 0x67, 0x3a, 0x31, 0xa1,
+		0x3e, 0x71, 0xe6, 0x09, 0xed, 0xc1, 0x22, 0xf5, 0xfc, 0x88, 0xab, 0x1d, 0xa8, 0xb8, 0x04, 0x25,
+		0x54, 0xa3, 0x76, 0x96, 0xfe, 0x5f, 0xb5, 0xb3, 0x3c, 0x9d, 0xda, 0x69, 0xc2, 0x76, 0xe2, 0x2f,
+		0xee, 0x70, 0xb6, 0xcb, 0x42, 0x22, 0x1c, 0xb1, 0x88, 0x2b, 0xed, 0xb9, 0x3d, 0xe2, 0xeb, 0x48,
+		0x1d, 0x10, 0xcd, 0xeb, 0x89, 0x6d, 0x93, 0x1d, 0xc6, 0x96, 0x4d, 0x69, 0x88, 0x3e, 0x87, 0xeb,
+		0xe2, 0x23, 0xa3, 0x2e, 0x57, 0xc7, 0xb9, 0xbc, 0x26, 0x0c, 0x87, 0xfc, 0x1d, 0xc3, 0x56, 0x9b,
+		0xe0, 0x80, 0xb7, 0x08, 0xe6, 0xa9, 0x2b, 0x18, 0xe7, 0xaa, 0x9c, 0xda, 0x24, 0x7e, 0x32, 0xfa,
+		0x3c, 0x16, 0xaa, 0x8b, 0x7d, 0x7d, 0xfe, 0x1a, 0x6e, 0x0f, 0x32, 0x61, 0xb1, 0x33, 0x8b, 0xb7,
+		0x69, 0x68, 0x25, 0x06, 0xe3, 0x75, 0x68, 0x75, 0x80, 0x99, 0xdf, 0x9d, 0x35, 0xdb, 0x34, 0xdc,
+		0x57, 0xfe, 0x4f, 0xb2, 0x33, 0x48, 0x9a, 0xd5, 0xfa, 0x04, 0x95, 0xd2, 0x9f, 0xc4, 0x91, 0xea,
+		0x5a, 0x23, 0xc7, 0xa5, 0x8d, 0xd9, 0x8e, 0x4b, 0x0f, 0x60, 0x33, 0xf5, 0xa3, 0xba, 0xcf, 0xa6,
+		0xec, 0x70, 0xc9, 0xf0, 0x91, 0xec, 0x42, 0x1f, 0xc2, 0x52, 0x9b, 0x60, 0x87, 0x04, 0x95, 0xb2,
+		0x3a, 0x44, 0x69, 0x0f, 0x23, 0x02, 0x62, 0x2a, 0x68, 0xed, 0x3b, 0x23, 0x55, 0x9a, 0xd9, 0x6e,
+		0x30, 0xad, 0xd2, 0xfc, 0x08, 0x96, 0xa4, 0xb4, 0x51, 0x9d, 0xa0, 0x38, 0x59, 0x0a, 0x5b, 0xd4,
+		0xfb, 0x6a, 0xf7, 0x53, 0x55, 0x91, 0x13, 0x97, 0x6a, 0xd9, 0xdf, 0xce, 0xc1, 0x83, 0x22, 0xe0,
+		0x41, 0xef, 0xe4, 0x68, 0x5c, 0xff, 0xbe, 0xaa, 0x9e, 0xd6, 0xcf, 0xda, 0xc2, 0x8c, 0x59, 0x5b,
+		0x1c, 0xca, 0xda, 0x4f, 0x61, 0x77, 0x7c, 0x32, 0x54, 0xe6, 0xfe, 0x6a, 0xa4, 0xb2, 0x20, 0x0b,
+		0x9e, 0x4a, 0x16, 0x3c, 0x82, 0xe5, 0x33, 0x4c, 0xdd, 0x28, 0x20, 0x85, 0xc4, 0x1f, 0x4b, 0x8c,
+		0x99, 0x80, 0x0b, 0x99, 0xef, 0xef, 0xd4, 0xba, 0xb0, 0x54, 0xf0, 0xdf, 0xcc, 0x69, 0xeb, 0x43,
+		0xa2, 0xbe, 0xcf, 0x9c, 0x67, 0x32, 0xb6, 0x30, 0x6b, 0xc6, 0x86, 0x59, 0x7f, 0x00, 0xf7, 0xc6,
+		0xe4, 0x42, 0x65, 0xed, 0x6f, 0x06, 0xd4, 0x74, 0xf5, 0x81, 0x3d, 0x9b, 0x4c, 0x45, 0x7a, 0xd2,
+		0x1a, 0xe7, 0x66, 0xd5, 0x71, 0xc3, 0xa4, 0xdf, 0xd3, 0xb7, 0xa1, 0x34, 0x30, 0x35, 0x81, 0x3f,
+		0xcd, 0xc1, 0xfd, 0x02, 0xdc, 0xf7, 0x9c, 0xf8, 0x24, 0x6b, 0x0b, 0xb3, 0x66, 0x6d, 0x98, 0xf8,
+		0xf7, 0xf5, 0xbd, 0x6f, 0x20, 0x1b, 0x03, 0xd4, 0xdb, 0x2c, 0x18, 0x80, 0xbe, 0x48, 0x76, 0xad,
+		0x2b, 0xa4, 0xfe, 0x34, 0xa6, 0xbe, 0x20, 0x30, 0xa5, 0x4b, 0xdf, 0x87, 0xb2, 0x2d, 0x26, 0x66,
+		0x05, 0x32, 0x56, 0xe2, 0x88, 0xf8, 0x56, 0xcc, 0x4d, 0x39, 0x6e, 0x26, 0xc3, 0xaa, 0x4a, 0x72,
+		0x5d, 0xfe, 0xd0, 0xaa, 0xa4, 0x19, 0x57, 0xc9, 0x98, 0x6c, 0x4c, 0x9f, 0xe4, 0x7f, 0xf5, 0xb7,
+		0x0f, 0x71, 0x33, 0x30, 0x8b, 0x6c, 0xf8, 0xf5, 0x90, 0x6c, 0x98, 0xfc, 0x02, 0x22, 0xd9, 0x0c,
+		0x5f, 0xc1, 0x35, 0xf9, 0xaf, 0x13, 0xab, 0x4b, 0x02, 0x71, 0xb5, 0x40, 0xbd, 0x33, 0xa6, 0xce,
+		0x97, 0xf9, 0x44, 0x91, 0xe0, 0x95, 0x84, 0x8b, 0xb3, 0xf2, 0xd6, 0xe5, 0xf0, 0x50, 0x66, 0x13,
+		0xd2, 0x4d, 0x2e, 0xd1, 0x1e, 0x06, 0x54, 0x4d, 0x12, 0x12, 0x2e, 0x6f, 0xac, 0xd2, 0xd3, 0xdd,
+		0x95, 0xd4, 0x56, 0xed, 0x16, 0xdc, 0xd0, 0x06, 0x23, 0x83, 0xdd, 0xfb, 0xcf, 0x26, 0xac, 0xca,
+		0xa9, 0xef, 0x9f, 0x9e, 0xa0, 0xb7, 0x70, 0x4d, 0x73, 0xc5, 0x82, 0x1a, 0x93, 0x5f, 0xc6, 0x88,
+		0x39, 0x56, 0xa7, 0xbe, 0xbd, 0x41, 0x7f, 0x31, 0xe0, 0x66, 0xd1, 0xa5, 0x0b, 0x7a, 0x3c, 0xeb,
+		0xad, 0x58, 0xf5, 0x93, 0x99, 0x6f, 0x78, 0xd0, 0x37, 0x06, 0x6c, 0xe7, 0xde, 0x0f, 0xa0, 0x5f,
+		0x4e, 0xea, 0x78, 0x40, 0x3c, 0x55, 0x1f, 0x4d, 0x6b, 0xa6, 0x82, 0xe9, 0x93, 0x93, 0x5d, 0xb1,
+		0xc5, 0xe4, 0x68, 0xee, 0x2b, 0x8a, 0xc9, 0xd1, 0x1e, 0xfe, 0x33, 0xe4, 0x68, 0x05, 0x64, 0x31,
+		0x39, 0x45, 0x27, 0x88, 0x62, 0x72, 0x0a, 0x35, 0x3e, 0x7a, 0xa7, 0x57, 0xaa, 0x03, 0xb2, 0x16,
+		0x7d, 0x3a, 0xb5, 0xff, 0xcc, 0x3e, 0x50, 0x7d, 0x36, 0xa3, 0xf5, 0x68, 0xf9, 0x8c, 0x4a, 0xb0,
+		0xe2, 0xf2, 0xc9, 0xd5, 0xde, 0xc5, 0xe5, 0x93, 0xaf, 0x8d, 0xd1, 0x77, 0x06, 0xdc, 0x2a, 0xd4,
+		0x83, 0xe8, 0x93, 0xe9, 0x3c, 0x67, 0x13, 0xf5, 0x64, 0x16, 0x53, 0x15, 0xd8, 0x9f, 0x0d, 0xd1,
+		0xa2, 0xf2, 0xf4, 0x0a, 0xfa, 0x78, 0x62, 0x12, 0x06, 0x05, 0x6b, 0xf5, 0xf1, 0xf4, 0x86, 0x2a,
+		0xa4, 0xbf, 0x1b, 0x70, 0x67, 0x8c, 0x84, 0x42, 0x4f, 0xa7, 0xf5, 0x9e, 0xcd, 0xd7, 0xa7, 0xb3,
+		0x19, 0x0f, 0x64, 0x2c, 0x77, 0xef, 0xce, 0xcd, 0xd8, 0x38, 0x9d, 0x97, 0x9b, 0xb1, 0xf1, 0x3a,
+		0x4c, 0x66, 0xac, 0x50, 0x4e, 0xe4, 0x66, 0x6c, 0x12, 0x49, 0x96, 0x9b, 0xb1, 0xc9, 0x14, 0x4c,
+		0x66, 0x25, 0x8e, 0xee, 0xdc, 0xc5, 0x2b, 0x31, 0x57, 0xc6, 0x14, 0xaf, 0xc4, 0x7c, 0x81, 0x10,
+		0x37, 0x72, 0xcd, 0x96, 0x9c, 0xd3, 0xc8, 0xf3, 0x95, 0x44, 0x4e, 0x23, 0x2f, 0xd8, 0xed, 0x0f,
+		0x5a, 0xf0, 0x63, 0x9b, 0x75, 0x74, 0x66, 0x07, 0x48, 0xaa, 0x80, 0x97, 0xf2, 0x97, 0x27, 0xa7,
+		0x01, 0xe3, 0xec, 0xd4, 0xf8, 0xfa, 0xe1, 0x39, 0xe5, 0xed, 0xa8, 0x55, 0xb7, 0x59, 0xa7, 0x91,
+		0xfd, 0x69, 0xc5, 0xcf, 0xa9, 0xe3, 0x36, 0xce, 0x99, 0xfc, 0xd5, 0x88, 0xfa, 0x9d, 0xc5, 0x53,
+		0xec, 0xd3, 0xee, 0xc3, 0xd6, 0x92, 0x18, 0xfb, 0xf0, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b,
+		0xa4, 0xf1, 0x91, 0xd9, 0x22, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
+		0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x51, 0x6f, 0xdb, 0x36,
+		0x17, 0xfd, 0x14, 0xc7, 0x4e, 0x7b, 0x9d, 0x26, 0xfa, 0x98, 0x35, 0x71, 0xd2, 0x75, 0x4b, 0x05,
+		0x0c, 0xf5, 0x8a, 0x4d, 0x46, 0xdc, 0x97, 0x62, 0x45, 0x37, 0x38, 0xb6, 0x93, 0xa8, 0xcd, 0x6c,
+		0x43, 0xf6, 0x1a, 0x74, 0x03, 0x26, 0xd0, 0x12, 0xe5, 0x72, 0x96, 0x48, 0x81, 0xa2, 0x9c, 0xf8,
+		0x65, 0xd8, 0x2f, 0xd9, 0xc3, 0xfe, 0xd2, 0xfe, 0xd0, 0x20, 0x89, 0x8a, 0xed, 0xce, 0x41, 0xf7,
+		0x30, 0xec, 0x8d, 0xbc, 0xe7, 0xdc, 0xc3, 0x43, 0xe2, 0xde, 0x2b, 0xc1, 0x71, 0x32, 0x26, 0xa2,
+		0xe1, 0x62, 0x8f, 0x30, 0x97, 0x34, 0x70, 0x44, 0x1b, 0xb3, 0x93, 0x86, 0xcb, 0xc3, 0x90, 0x33,
+		0x33, 0x12, 0x5c, 0x72, 0xb4, 0x97, 0x32, 0x4c, 0xc5, 0x30, 0x71, 0x44, 0xcd, 0xd9, 0xc9, 0xd1,
+		0x67, 0x13, 0xce, 0x27, 0x01, 0x69, 0x64, 0x94, 0x71, 0xe2, 0x37, 0xbc, 0x44, 0x60, 0x49, 0x8b,
+		0x24, 0xe3, 0x0d, 0xfc, 0xff, 0x8a, 0x8b, 0xa9, 0x1f, 0xf0, 0xeb, 0xee, 0x0d, 0x71, 0x93, 0x14,
+		0x42, 0x9f, 0x43, 0xf5, 0x5a, 0x05, 0x1d, 0xea, 0xd5, 0xb4, 0x63, 0xad, 0x7e, 0xdf, 0x86, 0x22,
+		0x64, 0x79, 0xe8, 0x21, 0x54, 0x44, 0xc2, 0x52, 0x6c, 0x23, 0xc3, 0xca, 0x22, 0x61, 0x96, 0x67,
+		0x18, 0xb0, 0x5d, 0x88, 0x8d, 0xe6, 0x11, 0x41, 0x08, 0x36, 0x19, 0x0e, 0x89, 0x12, 0xc8, 0xd6,
+		0x29, 0xa7, 0xe5, 0x4a, 0x3a, 0xa3, 0x72, 0x7e, 0x27, 0xe7, 0x31, 0x6c, 0x0d, 0xf0, 0x3c, 0xe0,
+		0xd8, 0x4b, 0x61, 0x0f, 0x4b, 0x9c, 0xc1, 0xdb, 0x76, 0xb6, 0x36, 0x5e, 0xc2, 0xd6, 0x19, 0xa6,
+		0x41, 0x22, 0x08, 0xda, 0x87, 0x8a, 0x20, 0x38, 0xe6, 0x4c, 0xe5, 0xab, 0x1d, 0xaa, 0xc1, 0x96,
+		0x47, 0x24, 0xa6, 0x41, 0x9c, 0x39, 0xdc, 0xb6, 0x8b, 0xad, 0xf1, 0xbb, 0x06, 0x9b, 0xdf, 0x93,
+		0x90, 0xa3, 0x57, 0x50, 0xf1, 0x29, 0x09, 0xbc, 0xb8, 0xa6, 0x1d, 0x97, 0xea, 0xd5, 0xe6, 0x17,
+		0xe6, 0x9a, 0xf7, 0x33, 0x53, 0xaa, 0x79, 0x96, 0xf1, 0xba, 0x4c, 0x8a, 0xb9, 0xad, 0x92, 0x8e,
+		0xae, 0xa0, 0xba, 0x14, 0x46, 0x3a, 0x94, 0xa6, 0x64, 0xae, 0x5c, 0xa4, 0x4b, 0xd4, 0x84, 0xf2,
+		0x0c, 0x07, 0x09, 0xc9, 0x0c, 0x54, 0x9b, 0x9f, 0xae, 0x95, 0x57, 0xd7, 0xb4, 0x73, 0xea, 0x37,
+		0x1b, 0x2f, 0x34, 0xe3, 0x0f, 0x0d, 0x2a, 0x17, 0x04, 0x7b, 0x44, 0xa0, 0xef, 0x3e, 0xb0, 0xf8,
+		0x74, 0xad, 0x46, 0x4e, 0xfe, 0x6f, 0x4d, 0xfe, 0xa9, 0x81, 0x3e, 0x24, 0x58, 0xb8, 0xef, 0x5b,
+		0x52, 0x0a, 0x3a, 0x4e, 0x24, 0x89, 0x91, 0x03, 0x3b, 0x94, 0x79, 0xe4, 0x86, 0x78, 0xce, 0x8a,
+		0xed, 0x17, 0x6b, 0x55, 0x3f, 0x4c, 0x37, 0xad, 0x3c, 0x77, 0xf9, 0x1e, 0x0f, 0xe8, 0x72, 0xec,
+		0xe8, 0x67, 0x40, 0x7f, 0x27, 0xfd, 0x8b, 0xb7, 0xf2, 0xe1, 0x5e, 0x07, 0x4b, 0x7c, 0x1a, 0xf0,
+		0x31, 0x3a, 0x83, 0x07, 0x84, 0xb9, 0xdc, 0xa3, 0x6c, 0xe2, 0xc8, 0x79, 0x94, 0x17, 0xe8, 0x4e,
+		0xf3, 0xc9, 0x5a, 0xad, 0xae, 0x62, 0xa6, 0x15, 0x6d, 0x6f, 0x93, 0xa5, 0xdd, 0x6d, 0x01, 0x6f,
+		0x2c, 0x15, 0xf0, 0x20, 0x6f, 0x3a, 0x22, 0xde, 0x12, 0x11, 0x53, 0xce, 0x2c, 0xe6, 0xf3, 0x94,
+		0x48, 0xc3, 0x28, 0x28, 0x1a, 0x21, 0x5d, 0xa3, 0xa7, 0xb0, 0xeb, 0x13, 0x2c, 0x13, 0x41, 0x9c,
+		0x59, 0x4e, 0x55, 0x0d, 0xb7, 0xa3, 0xc2, 0x4a, 0xc0, 0x78, 0x03, 0x07, 0xc3, 0x24, 0x8a, 0xb8,
+		0x90, 0xc4, 0x6b, 0x07, 0x94, 0x30, 0xa9, 0x90, 0x38, 0xed, 0xd5, 0x09, 0x77, 0x62, 0x6f, 0xaa,
+		0x94, 0xcb, 0x13, 0x3e, 0xf4, 0xa6, 0xe8, 0x10, 0xee, 0xfd, 0x82, 0x67, 0x38, 0x03, 0x72, 0xcd,
+		0xad, 0x74, 0x3f, 0xf4, 0xa6, 0xc6, 0x6f, 0x25, 0xa8, 0xda, 0x44, 0x8a, 0xf9, 0x80, 0x07, 0xd4,
+		0x9d, 0xa3, 0x0e, 0xe8, 0x94, 0x51, 0x49, 0x71, 0xe0, 0x50, 0x26, 0x89, 0x98, 0xe1, 0xdc, 0x65,
+		0xb5, 0x79, 0x68, 0xe6, 0xe3, 0xc5, 0x2c, 0xc6, 0x8b, 0xd9, 0x51, 0xe3, 0xc5, 0xde, 0x55, 0x29,
+		0x96, 0xca, 0x40, 0x0d, 0xd8, 0x1b, 0x63, 0x77, 0xca, 0x7d, 0xdf, 0x71, 0x39, 0xf1, 0x7d, 0xea,
+		0xa6, 0x36, 0xb3, 0xb3, 0x35, 0x1b, 0x29, 0xa8, 0xbd, 0x40, 0xd2, 0x63, 0x43, 0x7c, 0x43, 0xc3,
+		0x24, 0x5c, 0x1c, 0x5b, 0xfa, 0xe8, 0xb1, 0x2a, 0xe5, 0xf6, 0xd8, 0x2f, 0x17, 0x2a, 0x58, 0x4a,
+		0x12, 0x46, 0x32, 0xae, 0x6d, 0x1e, 0x6b, 0xf5, 0xf2, 0x2d, 0xb5, 0xa5, 0xc2, 0xe8, 0x15, 0x3c,
+		0x62, 0x9c, 0x39, 0x22, 0xbd, 0x3a, 0x1e, 0x07, 0xc4, 0x21, 0x42, 0x70, 0xe1, 0xe4, 0x23, 0x25,
+		0xae, 0x95, 0x8f, 0x4b, 0xf5, 0xfb, 0x76, 0x8d, 0x71, 0x66, 0x17, 0x8c, 0x6e, 0x4a, 0xb0, 0x73,
+		0x1c, 0xbd, 0x86, 0x3d, 0x72, 0x13, 0xd1, 0xdc, 0xc8, 0xc2, 0x72, 0xe5, 0x63, 0x96, 0xd1, 0x22,
+		0xab, 0x70, 0x6d, 0x84, 0x70, 0x60, 0xc5, 0x3c, 0xc8, 0x82, 0xe7, 0x82, 0x27, 0xd1, 0x00, 0x0b,
+		0x49, 0xb3, 0xe1, 0xbc, 0x66, 0x60, 0xa2, 0x6f, 0xa1, 0x1c, 0x4b, 0x2c, 0xf3, 0x82, 0xdf, 0x69,
+		0xd6, 0xd7, 0x16, 0xe9, 0xaa, 0xe0, 0x30, 0xe5, 0xdb, 0x79, 0x9a, 0x31, 0x83, 0x47, 0xab, 0x68,
+		0x9b, 0x33, 0x9f, 0x4e, 0x94, 0x43, 0x74, 0x05, 0x3a, 0x2d, 0x60, 0x67, 0x92, 0xe2, 0x45, 0x6b,
+		0x7f, 0xf5, 0x0f, 0x4e, 0xba, 0xb5, 0x6e, 0xef, 0xd2, 0x15, 0x20, 0x7e, 0x76, 0x0d, 0xdb, 0xcb,
+		0xad, 0x83, 0x0e, 0xe1, 0x61, 0xb7, 0xd7, 0xee, 0x77, 0xac, 0xde, 0xb9, 0x33, 0x7a, 0x37, 0xe8,
+		0x3a, 0x56, 0xef, 0x6d, 0xeb, 0xd2, 0xea, 0xe8, 0xff, 0x43, 0x47, 0xb0, 0xbf, 0x0a, 0x8d, 0x2e,
+		0x6c, 0xeb, 0x6c, 0x64, 0x5f, 0xe9, 0x1a, 0xda, 0x07, 0xb4, 0x8a, 0xbd, 0x1e, 0xf6, 0x7b, 0xfa,
+		0x06, 0xaa, 0xc1, 0x27, 0xab, 0xf1, 0x81, 0xdd, 0x1f, 0xf5, 0x9f, 0xeb, 0xa5, 0x67, 0xbf, 0xc2,
+		0xde, 0x9a, 0xe7, 0x40, 0x4f, 0xe0, 0xb1, 0x35, 0xec, 0x5f, 0xb6, 0x46, 0x56, 0xbf, 0xe7, 0x9c,
+		0xdb, 0xfd, 0x1f, 0x06, 0xce, 0x70, 0xd4, 0x1a, 0x2d, 0xfb, 0xb8, 0x93, 0x72, 0xd1, 0x6d, 0x5d,
+		0x8e, 0x2e, 0xde, 0xe9, 0xda, 0xdd, 0x94, 0x8e, 0xdd, 0xb2, 0x7a, 0xdd, 0x8e, 0xbe, 0x71, 0xfa,
+		0x13, 0x1c, 0xb8, 0x3c, 0x5c, 0xf7, 0x78, 0xa7, 0xd5, 0x76, 0xf6, 0x51, 0x1f, 0xa4, 0x75, 0x32,
+		0xd0, 0x7e, 0x3c, 0x99, 0x50, 0xf9, 0x3e, 0x19, 0x9b, 0x2e, 0x0f, 0x1b, 0xcb, 0xbf, 0x00, 0x5f,
+		0x53, 0x2f, 0x68, 0x4c, 0x78, 0xfe, 0x61, 0x57, 0xff, 0x03, 0x2f, 0x71, 0x44, 0x67, 0x27, 0xe3,
+		0x4a, 0x16, 0x7b, 0xfe, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0x0a, 0xaa, 0xd2, 0x33, 0x08,
+		0x00, 0x00,
+	},
+	// uber/cadence/api/v1/decision.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6e, 0x14, 0xc7,
+		0x16, 0xbe, 0xed, 0x9f, 0xf9, 0x39, 0x1e, 0x03, 0x2e, 0x83, 0xb1, 0xc1, 0x60, 0x33, 0x57, 0xd7,
+		0x5c, 0xb0, 0x3c, 0x63, 0x1b, 0x2e, 0x42, 0x80, 0xd0, 0xc5, 0x06, 0x0b, 0x4b, 0x60, 0xac, 0xc6,
+		0x04, 0x29, 0x52, 0xd4, 0x2a, 0x57, 0x97, 0xed, 0x8a, 0x7b, 0xba, 0x27, 0xd5, 0xd5, 0x1e, 0x26,
+		0x52, 0xa4, 0xac, 0x92, 0x6c, 0xf2, 0x00, 0x91, 0xb2, 0xca, 0x2a, 0xd9, 0x90, 0x6d, 0xa2, 0xac,
+		0xf2, 0x08, 0x59, 0xe4, 0x49, 0xf2, 0x02, 0x51, 0x55, 0x57, 0xf7, 0x8c, 0xc7, 0x3d, 0x3d, 0xdd,
+		0x86, 0xb0, 0x73, 0xd7, 0x9c, 0xf3, 0xd5, 0x57, 0x75, 0x4e, 0x9f, 0xef, 0x1b, 0x0f, 0x54, 0x83,
+		0x5d, 0xca, 0xeb, 0x04, 0xdb, 0xd4, 0x25, 0xb4, 0x8e, 0x9b, 0xac, 0x7e, 0xb4, 0x52, 0xb7, 0x29,
+		0x61, 0x3e, 0xf3, 0xdc, 0x5a, 0x93, 0x7b, 0xc2, 0x43, 0x93, 0x32, 0xa6, 0xa6, 0x63, 0x6a, 0xb8,
+		0xc9, 0x6a, 0x47, 0x2b, 0x97, 0xae, 0xee, 0x7b, 0xde, 0xbe, 0x43, 0xeb, 0x2a, 0x64, 0x37, 0xd8,
+		0xab, 0xdb, 0x01, 0xc7, 0x22, 0x4e, 0xba, 0x34, 0x9f, 0x04, 0x4c, 0xbc, 0x46, 0x23, 0x8e, 0x48,
+		0xdc, 0x5a, 0x60, 0xff, 0xd0, 0x61, 0xbe, 0x48, 0x8b, 0x69, 0x79, 0xfc, 0x70, 0xcf, 0xf1, 0x5a,
+		0x61, 0x4c, 0xf5, 0x9b, 0x09, 0x28, 0x3d, 0xd6, 0x8c, 0xd1, 0x77, 0x06, 0xdc, 0xf4, 0xc9, 0x01,
+		0xb5, 0x03, 0x87, 0x5a, 0x98, 0x08, 0x76, 0xc4, 0x44, 0xdb, 0x92, 0xa8, 0x56, 0x74, 0x2a, 0x0b,
+		0x0b, 0xc1, 0xd9, 0x6e, 0x20, 0xa8, 0x3f, 0x6d, 0xcc, 0x1b, 0xff, 0x1d, 0x5b, 0xbd, 0x5f, 0x4b,
+		0x38, 0x61, 0xed, 0xa5, 0x86, 0x79, 0xa4, 0x51, 0x76, 0xb0, 0x7f, 0x18, 0xed, 0xf3, 0x28, 0x86,
+		0x78, 0xfa, 0x2f, 0x73, 0xc1, 0xcf, 0x14, 0x89, 0x3e, 0x87, 0x39, 0x5f, 0x60, 0x2e, 0x2c, 0xc1,
+		0x1a, 0x94, 0x27, 0xf2, 0x19, 0x52, 0x7c, 0x56, 0x92, 0xf9, 0xc8, 0xdc, 0x1d, 0x99, 0x9a, 0xc8,
+		0x62, 0xd6, 0x4f, 0xf9, 0x1c, 0xfd, 0x68, 0x80, 0xbc, 0xfd, 0xa6, 0x43, 0x05, 0xb5, 0xa2, 0x0b,
+		0xb4, 0xe8, 0x1b, 0x4a, 0x02, 0x59, 0xb4, 0x44, 0x32, 0xc3, 0x8a, 0xcc, 0xff, 0x13, 0xc9, 0xac,
+		0x6b, 0xac, 0xd7, 0x1a, 0xea, 0x49, 0x84, 0x94, 0xc8, 0x6d, 0x91, 0x64, 0x0f, 0x47, 0xdf, 0x1b,
+		0xb0, 0xb8, 0x87, 0x99, 0x93, 0x95, 0xe6, 0x88, 0xa2, 0xf9, 0x20, 0x91, 0xe6, 0x06, 0x66, 0x4e,
+		0x36, 0x8a, 0xd7, 0xf7, 0xb2, 0x85, 0xa2, 0x9f, 0x0c, 0x58, 0xe6, 0xf4, 0xb3, 0x80, 0xfa, 0xc2,
+		0x22, 0xd8, 0x25, 0xd4, 0xc9, 0xd0, 0x67, 0xa3, 0x29, 0x57, 0x69, 0x86, 0x60, 0xeb, 0x0a, 0x6b,
+		0x60, 0xb3, 0x2d, 0xf2, 0xec, 0xe1, 0xe8, 0x0b, 0x98, 0xd7, 0x14, 0xfb, 0xb7, 0x5c, 0x41, 0x51,
+		0x5b, 0x4d, 0xae, 0xb2, 0x4a, 0xee, 0xdf, 0x73, 0x57, 0x48, 0x5a, 0x00, 0xfa, 0xc1, 0x80, 0x25,
+		0xbd, 0x7f, 0xc6, 0x5a, 0x16, 0x15, 0x99, 0x87, 0x29, 0x64, 0xb2, 0x55, 0xf3, 0x06, 0xc9, 0x1a,
+		0x8c, 0xfe, 0x30, 0xe0, 0x61, 0x4f, 0x3d, 0xe9, 0x1b, 0x41, 0xb9, 0x8b, 0x33, 0xb3, 0x2e, 0x29,
+		0xd6, 0xcf, 0x07, 0x57, 0xf7, 0x89, 0x06, 0xce, 0x76, 0x88, 0xbb, 0xfc, 0x94, 0xb9, 0xe8, 0x4b,
+		0x03, 0xae, 0x71, 0x4a, 0x3c, 0x6e, 0x5b, 0x0d, 0xcc, 0x0f, 0xfb, 0x54, 0xbe, 0xac, 0x68, 0xdf,
+		0xea, 0x43, 0x5b, 0x66, 0x3f, 0x57, 0xc9, 0x89, 0xe4, 0xae, 0xf2, 0xd4, 0x08, 0xf4, 0xab, 0x01,
+		0x77, 0x88, 0xe7, 0x0a, 0xe6, 0x06, 0xd4, 0xc2, 0xbe, 0xe5, 0xd2, 0x56, 0xd6, 0xeb, 0x04, 0xc5,
+		0xeb, 0x49, 0x9f, 0xb9, 0x13, 0x42, 0x3e, 0xf2, 0xb7, 0x68, 0x2b, 0xdb, 0x35, 0x2e, 0x93, 0x9c,
+		0x39, 0xe8, 0x67, 0x03, 0x56, 0xc3, 0x49, 0x4d, 0x0e, 0x98, 0x63, 0x67, 0xe5, 0x3d, 0xa6, 0x78,
+		0xaf, 0xf5, 0x1f, 0xde, 0xeb, 0x12, 0x2d, 0x1b, 0xe9, 0x25, 0x3f, 0x4f, 0x02, 0xfa, 0xcd, 0x80,
+		0x3b, 0x3e, 0xdb, 0x97, 0x3d, 0x9b, 0xb7, 0x79, 0x2b, 0x8a, 0xf5, 0x46, 0x32, 0x6b, 0x05, 0x99,
+		0xaf, 0x6b, 0x57, 0xfc, 0xbc, 0x49, 0xe8, 0x17, 0x03, 0xfe, 0x17, 0x34, 0x7d, 0xca, 0x45, 0x87,
+		0xb4, 0x4f, 0x31, 0x27, 0x07, 0x5d, 0x44, 0x13, 0xc9, 0x8f, 0xa7, 0xb4, 0xca, 0x2b, 0x85, 0x18,
+		0xed, 0xff, 0x52, 0xe1, 0x75, 0x36, 0x4d, 0x6e, 0x95, 0x20, 0x67, 0xce, 0x5a, 0x05, 0xa0, 0x43,
+		0xa7, 0xfa, 0x6d, 0x01, 0x16, 0xb2, 0xd9, 0x06, 0x34, 0x07, 0x63, 0xb1, 0x6c, 0x30, 0x5b, 0x19,
+		0x91, 0xb2, 0x09, 0xd1, 0xd2, 0xa6, 0x8d, 0x36, 0x60, 0xbc, 0xa3, 0x2b, 0xed, 0x26, 0xd5, 0xde,
+		0xe0, 0x5a, 0xe2, 0x59, 0xe3, 0xcd, 0xda, 0x4d, 0x6a, 0x56, 0x70, 0xd7, 0x13, 0x9a, 0x82, 0x82,
+		0xed, 0x35, 0x30, 0x73, 0x95, 0x9e, 0x97, 0x4d, 0xfd, 0x84, 0xee, 0x41, 0x59, 0xc9, 0x95, 0x74,
+		0x5b, 0x5a, 0x43, 0xaf, 0x24, 0x62, 0xcb, 0x03, 0x3c, 0x63, 0xbe, 0x30, 0x4b, 0x42, 0xff, 0x85,
+		0x56, 0x61, 0x94, 0xb9, 0xcd, 0x40, 0x68, 0x5d, 0x9b, 0x4d, 0xcc, 0xdb, 0xc6, 0x6d, 0xc7, 0xc3,
+		0xb6, 0x19, 0x86, 0xa2, 0x1d, 0x98, 0x89, 0x8d, 0x99, 0xf0, 0x2c, 0xe2, 0x78, 0x3e, 0x55, 0xb2,
+		0xe4, 0x05, 0x42, 0x8b, 0xd0, 0x4c, 0x2d, 0x34, 0x95, 0xb5, 0xc8, 0x54, 0xd6, 0x1e, 0x6b, 0x53,
+		0x69, 0x4e, 0x45, 0xb9, 0x3b, 0xde, 0xba, 0xcc, 0xdc, 0x09, 0x13, 0x7b, 0x51, 0x3b, 0xfe, 0x4a,
+		0xa2, 0x16, 0x73, 0xa0, 0xc6, 0xee, 0x4a, 0xa2, 0x6e, 0xc1, 0x94, 0x46, 0xea, 0x25, 0x5a, 0x1a,
+		0x04, 0x39, 0x19, 0xda, 0xb0, 0xe3, 0x2c, 0x37, 0x60, 0xe2, 0x80, 0x62, 0x2e, 0x76, 0x29, 0xee,
+		0xb0, 0x2b, 0x0f, 0x82, 0x3a, 0x17, 0xe7, 0x44, 0x38, 0xeb, 0x50, 0xe1, 0x54, 0xf0, 0xb6, 0xd5,
+		0xf4, 0x1c, 0x46, 0xda, 0x7a, 0xe2, 0xcc, 0xf7, 0x99, 0xe0, 0x82, 0xb7, 0xb7, 0x55, 0x9c, 0x39,
+		0xc6, 0x3b, 0x0f, 0xe8, 0x16, 0x14, 0x0e, 0x28, 0xb6, 0x29, 0xd7, 0xaf, 0xfe, 0xe5, 0xc4, 0xf4,
+		0xa7, 0x2a, 0xc4, 0xd4, 0xa1, 0xe8, 0x36, 0x4c, 0x45, 0x22, 0xe9, 0x78, 0x04, 0x3b, 0x96, 0xcd,
+		0xfc, 0x26, 0x16, 0xe4, 0x40, 0xbd, 0x82, 0x25, 0xf3, 0xbc, 0xfe, 0xf4, 0x99, 0xfc, 0xf0, 0xb1,
+		0xfe, 0xac, 0xfa, 0xb5, 0x01, 0xb3, 0x69, 0xb6, 0x15, 0xcd, 0x40, 0x29, 0x74, 0x26, 0xf1, 0x2b,
+		0x50, 0x54, 0xcf, 0x9b, 0x36, 0x7a, 0x06, 0x17, 0xe2, 0x1a, 0xec, 0x31, 0xde, 0x29, 0xc1, 0xd0,
+		0xa0, 0x7b, 0x43, 0xba, 0x04, 0x1b, 0x8c, 0x47, 0x15, 0xa8, 0x12, 0x58, 0xcc, 0x61, 0x59, 0xd1,
+		0x6d, 0x28, 0x70, 0xea, 0x07, 0x8e, 0xd0, 0xdf, 0x10, 0xd2, 0x3b, 0x5c, 0xc7, 0x56, 0x31, 0x5c,
+		0xcf, 0x68, 0x38, 0xd1, 0x1d, 0x28, 0x4a, 0xc3, 0x19, 0x70, 0x9a, 0xba, 0xc3, 0x46, 0x18, 0x63,
+		0x46, 0xc1, 0xd5, 0x2d, 0x58, 0xcc, 0xe1, 0x17, 0x07, 0x4e, 0x99, 0xea, 0x3d, 0xb8, 0x92, 0x6a,
+		0xf2, 0x52, 0x2a, 0x54, 0x25, 0x70, 0x23, 0xb3, 0x27, 0x93, 0x07, 0xb6, 0xa9, 0xc0, 0xcc, 0xf1,
+		0x33, 0x5d, 0x69, 0x14, 0x5c, 0xfd, 0xcb, 0x80, 0xbb, 0xa7, 0xf5, 0x50, 0x5d, 0xb3, 0xcf, 0x38,
+		0x36, 0xfb, 0x5e, 0x01, 0x3a, 0xa9, 0x8e, 0xba, 0xb1, 0x16, 0x12, 0x79, 0x9d, 0xd8, 0xcd, 0x9c,
+		0x68, 0xf5, 0x2e, 0xa1, 0x69, 0x28, 0x4a, 0xaf, 0xc1, 0x3d, 0x47, 0xcd, 0xda, 0x8a, 0x19, 0x3d,
+		0xa2, 0x1a, 0x4c, 0xf6, 0x58, 0x09, 0xcf, 0x75, 0xda, 0x6a, 0xec, 0x96, 0xcc, 0x09, 0xd2, 0x2d,
+		0xf3, 0x2f, 0x5c, 0xa7, 0x5d, 0x7d, 0x6b, 0xc0, 0xd5, 0x74, 0x0b, 0x26, 0x4b, 0xab, 0xbd, 0x9d,
+		0x8b, 0x1b, 0x34, 0x2a, 0x6d, 0xb8, 0xb4, 0x85, 0x1b, 0xb4, 0xfb, 0xc6, 0x87, 0x72, 0xdc, 0x78,
+		0xd7, 0x7c, 0x18, 0xce, 0x3c, 0x1f, 0xaa, 0x6f, 0x4b, 0xb0, 0x9c, 0xd7, 0x9b, 0x49, 0x89, 0x8b,
+		0xef, 0x43, 0x49, 0x9c, 0x91, 0x22, 0x71, 0x11, 0x60, 0x28, 0x71, 0xad, 0xae, 0xa7, 0xe3, 0x52,
+		0x36, 0x74, 0x4a, 0x29, 0x1b, 0xce, 0x2e, 0x65, 0x18, 0xe6, 0x3b, 0x9e, 0xaa, 0x8f, 0x50, 0x8c,
+		0x0c, 0x9a, 0x52, 0xb3, 0x31, 0xc4, 0xcb, 0x04, 0xc5, 0x78, 0x0d, 0x97, 0xd5, 0x91, 0xfa, 0xa0,
+		0x8f, 0x0e, 0x42, 0xbf, 0x28, 0xb3, 0x93, 0x80, 0x5f, 0xc0, 0xd4, 0x2e, 0x26, 0x87, 0xde, 0xde,
+		0x9e, 0xc6, 0x66, 0xae, 0xa0, 0xfc, 0x08, 0x3b, 0x83, 0x35, 0xf8, 0xbc, 0x4e, 0x54, 0xb0, 0x9b,
+		0x3a, 0xed, 0x84, 0x26, 0x15, 0x4f, 0xa3, 0x49, 0x9b, 0x50, 0x66, 0x2e, 0x13, 0x0c, 0x0b, 0x8f,
+		0x2b, 0x8d, 0x3d, 0xb3, 0xba, 0x38, 0xd8, 0xff, 0x6f, 0x46, 0x29, 0x66, 0x27, 0xbb, 0x7b, 0xb2,
+		0x96, 0x73, 0x4c, 0x56, 0x64, 0xc2, 0x94, 0x83, 0xe5, 0x77, 0xc0, 0x50, 0x26, 0x64, 0x69, 0xb5,
+		0x04, 0x40, 0x86, 0xce, 0x38, 0x2f, 0x73, 0xd7, 0xe3, 0x54, 0x53, 0x65, 0xa2, 0x7f, 0xc3, 0x38,
+		0xe1, 0xb2, 0x47, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x36, 0x2b, 0x72, 0x31, 0xf2, 0x89, 0xa7, 0xd3,
+		0xe3, 0x25, 0x18, 0x69, 0xd0, 0x86, 0xa7, 0x0d, 0xf0, 0x4c, 0x62, 0xca, 0x73, 0xda, 0xf0, 0x4c,
+		0x15, 0x86, 0x4c, 0x98, 0x38, 0x61, 0xa8, 0xa7, 0xcf, 0xa8, 0xdc, 0xff, 0x24, 0x3b, 0xff, 0x1e,
+		0xeb, 0x6b, 0x9e, 0xf3, 0x7b, 0x56, 0xd0, 0x03, 0xa8, 0x7c, 0xca, 0x84, 0xa0, 0x3c, 0x6c, 0xa4,
+		0xe9, 0xb3, 0x83, 0xfa, 0x67, 0x2c, 0x0c, 0x57, 0xed, 0x53, 0xfd, 0xb3, 0x08, 0x4b, 0xb9, 0xbe,
+		0x14, 0xf5, 0x1d, 0xe6, 0x73, 0x30, 0x16, 0x4f, 0x11, 0x66, 0xab, 0xf7, 0xbf, 0x6c, 0x42, 0xb4,
+		0x14, 0x3a, 0xe9, 0xe3, 0x63, 0x66, 0xf8, 0x3d, 0x8c, 0x99, 0x0f, 0xe0, 0x98, 0xb3, 0x8c, 0x99,
+		0xc2, 0x3f, 0x3a, 0x66, 0x8a, 0xa7, 0x1e, 0x33, 0x1f, 0xc1, 0x64, 0x13, 0x73, 0xea, 0x0a, 0x8d,
+		0xa8, 0x87, 0x43, 0xf8, 0x6a, 0x2f, 0xf4, 0x39, 0xbd, 0x8c, 0x57, 0x28, 0x7a, 0x44, 0x4c, 0x34,
+		0x7b, 0x97, 0xba, 0x25, 0xb6, 0x7c, 0x5c, 0x62, 0x09, 0x4c, 0x77, 0xb5, 0x81, 0xc5, 0x69, 0xd0,
+		0xd9, 0x16, 0xd4, 0xb6, 0x37, 0x53, 0x0b, 0xbe, 0x69, 0x9b, 0x32, 0x45, 0x6f, 0x7d, 0xa1, 0x95,
+		0xb4, 0xfc, 0x7e, 0x0c, 0xf8, 0x89, 0xa9, 0x50, 0x49, 0x9d, 0x0a, 0xe3, 0xf9, 0xa7, 0xc2, 0x99,
+		0x77, 0x98, 0x0a, 0x67, 0xdf, 0x69, 0x2a, 0x54, 0x7f, 0x1f, 0x82, 0x95, 0xdc, 0xff, 0x36, 0xf8,
+		0xd0, 0x46, 0x6d, 0x0e, 0xc6, 0xf4, 0x7f, 0x4b, 0x94, 0x77, 0x0a, 0xbf, 0x18, 0x43, 0xb8, 0xa4,
+		0xbc, 0x53, 0xfc, 0xba, 0x8e, 0x64, 0x7f, 0x5d, 0xbb, 0x5a, 0x73, 0x34, 0x93, 0xfb, 0x2b, 0xf4,
+		0x73, 0x7f, 0x5f, 0x19, 0xb0, 0x9c, 0xf7, 0xbf, 0x17, 0xc9, 0xc5, 0x34, 0xde, 0xa9, 0x98, 0x6b,
+		0x9f, 0xc0, 0x45, 0xe2, 0x35, 0x92, 0xb2, 0xd7, 0xc6, 0x23, 0x0a, 0xdb, 0x72, 0x28, 0x6c, 0x1b,
+		0x1f, 0xaf, 0xec, 0x33, 0x71, 0x10, 0xec, 0xd6, 0x88, 0xd7, 0xa8, 0x77, 0xff, 0x6a, 0xb3, 0xc4,
+		0x6c, 0xa7, 0xbe, 0xef, 0x85, 0x3f, 0x14, 0xe9, 0x9f, 0x70, 0xee, 0xe3, 0x26, 0x3b, 0x5a, 0xd9,
+		0x2d, 0xa8, 0xb5, 0x5b, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xc1, 0xe0, 0x1d, 0x85, 0x1a,
+		0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xb4, 0x4b, 0xe8, 0xd9, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xf3, 0x74, 0x51,
+		0x04, 0xc5, 0x26, 0xc1, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0xb1, 0x83, 0x55, 0xb0, 0xe3, 0x1a, 0x92,
+		0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x9b, 0xd0, 0x0f, 0x05, 0x92, 0x72, 0xe2, 0x17, 0xd9,
+		0xc3, 0xec, 0x89, 0xf6, 0x18, 0x03, 0x29, 0xd9, 0xf3, 0x12, 0x6f, 0x77, 0xe4, 0xf9, 0xce, 0x77,
+		0x7e, 0x3e, 0x9e, 0x43, 0xe0, 0x54, 0x31, 0x15, 0x5e, 0x82, 0x09, 0x2d, 0x12, 0xea, 0xe1, 0x92,
+		0x79, 0xeb, 0xa1, 0xa7, 0xb0, 0x4c, 0x33, 0x26, 0x95, 0x5b, 0x0a, 0xae, 0x38, 0xfc, 0x42, 0xfb,
+		0xb8, 0x8d, 0x8f, 0x8b, 0x4b, 0xe6, 0xae, 0x87, 0xfd, 0xaf, 0x97, 0x9c, 0x2f, 0x33, 0xea, 0x19,
+		0x97, 0xb8, 0xfa, 0xe8, 0x91, 0x4a, 0x60, 0xc5, 0x78, 0x51, 0x93, 0xfa, 0xdf, 0x3c, 0xc4, 0x15,
+		0xcb, 0xa9, 0x54, 0x38, 0x2f, 0x1b, 0x87, 0x47, 0x01, 0xee, 0x04, 0x2e, 0x4b, 0x2a, 0x64, 0x8d,
+		0x3b, 0x1f, 0xc0, 0x49, 0x84, 0x65, 0x3a, 0x63, 0x52, 0x41, 0x08, 0x8e, 0x0b, 0x9c, 0xd3, 0x33,
+		0x6b, 0x60, 0x9d, 0x9f, 0x06, 0xe6, 0x0c, 0x7f, 0x04, 0xc7, 0x29, 0x2b, 0xc8, 0xd9, 0xd1, 0xc0,
+		0x3a, 0xef, 0x5e, 0x7c, 0xeb, 0x1e, 0x28, 0xd2, 0xdd, 0x06, 0x98, 0xb2, 0x82, 0x04, 0xc6, 0xdd,
+		0xc1, 0xc0, 0xde, 0x5a, 0xaf, 0xa9, 0xc2, 0x04, 0x2b, 0x0c, 0xaf, 0xc1, 0x97, 0x39, 0xbe, 0x47,
+		0xba, 0x6d, 0x89, 0x4a, 0x2a, 0x90, 0xa4, 0x09, 0x2f, 0x88, 0x49, 0xd7, 0xbe, 0xf8, 0xca, 0xad,
+		0x2b, 0x75, 0xb7, 0x95, 0xba, 0x13, 0x5e, 0xc5, 0x19, 0xbd, 0xc1, 0x59, 0x45, 0x83, 0xcf, 0x73,
+		0x7c, 0xaf, 0x03, 0xca, 0x05, 0x15, 0xa1, 0xa1, 0x39, 0x1f, 0x40, 0x6f, 0x9b, 0x62, 0x81, 0x85,
+		0x62, 0x5a, 0x95, 0x5d, 0x2e, 0x1b, 0xb4, 0x52, 0xba, 0x69, 0x3a, 0xd1, 0x47, 0xf8, 0x06, 0x3c,
+		0xe3, 0x77, 0x05, 0x15, 0x68, 0xc5, 0xa5, 0x42, 0xa6, 0xcf, 0x23, 0x83, 0x76, 0x8c, 0xf9, 0x1d,
+		0x97, 0x6a, 0x8e, 0x73, 0xea, 0xfc, 0x65, 0x81, 0xee, 0x36, 0x6e, 0xa8, 0xb0, 0xaa, 0x24, 0xfc,
+		0x0e, 0xc0, 0x18, 0x27, 0x69, 0xc6, 0x97, 0x28, 0xe1, 0x55, 0xa1, 0xd0, 0x8a, 0x15, 0xca, 0xc4,
+		0x6e, 0x05, 0x76, 0x83, 0x8c, 0x35, 0xf0, 0x8e, 0x15, 0x0a, 0xbe, 0x06, 0x40, 0x50, 0x4c, 0x50,
+		0x46, 0xd7, 0x34, 0x33, 0x39, 0x5a, 0xc1, 0xa9, 0xb6, 0xcc, 0xb4, 0x01, 0xbe, 0x02, 0xa7, 0x38,
+		0x49, 0x1b, 0xb4, 0x65, 0xd0, 0x13, 0x9c, 0xa4, 0x35, 0xf8, 0x06, 0x3c, 0x13, 0x58, 0xd1, 0x7d,
+		0x75, 0x8e, 0x07, 0xd6, 0xb9, 0x15, 0x74, 0xb4, 0x79, 0xd7, 0x3b, 0x9c, 0x80, 0x8e, 0x96, 0x11,
+		0x31, 0x82, 0xe2, 0x8c, 0x27, 0xe9, 0xd9, 0x13, 0xa3, 0xe1, 0xe0, 0x3f, 0x9f, 0xc7, 0x9f, 0x5c,
+		0x6a, 0xbf, 0xa0, 0xad, 0x69, 0x3e, 0x31, 0x17, 0xe7, 0x67, 0xd0, 0xde, 0xc3, 0x60, 0x0f, 0x9c,
+		0x48, 0x85, 0x85, 0x42, 0x8c, 0x34, 0xcd, 0x7d, 0x6a, 0xee, 0x3e, 0x81, 0xcf, 0xc1, 0x53, 0x5a,
+		0x10, 0x0d, 0xd4, 0xfd, 0x3c, 0xa1, 0x05, 0xf1, 0x89, 0xf3, 0x87, 0x05, 0xc0, 0x82, 0x67, 0x19,
+		0x15, 0x7e, 0xf1, 0x91, 0xc3, 0x09, 0xb0, 0x33, 0x2c, 0x15, 0xc2, 0x49, 0x42, 0xa5, 0x44, 0x7a,
+		0x14, 0x9b, 0xc7, 0xed, 0x3f, 0x7a, 0xdc, 0x68, 0x3b, 0xa7, 0x41, 0x57, 0x73, 0x46, 0x86, 0xa2,
+		0x8d, 0xb0, 0x0f, 0x4e, 0x18, 0xa1, 0x85, 0x62, 0x6a, 0xd3, 0xbc, 0xd0, 0xee, 0x7e, 0x48, 0x9f,
+		0xd6, 0x01, 0x7d, 0x9c, 0x3f, 0x2d, 0xd0, 0x0b, 0x15, 0x4b, 0xd2, 0xcd, 0xd5, 0x3d, 0x4d, 0x2a,
+		0x3d, 0x1a, 0x23, 0xa5, 0x04, 0x8b, 0x2b, 0x45, 0x25, 0xfc, 0x05, 0xd8, 0x77, 0x5c, 0xa4, 0x54,
+		0x98, 0x59, 0x44, 0x7a, 0x07, 0x9b, 0x3a, 0x5f, 0xff, 0xef, 0x7c, 0x07, 0xdd, 0x9a, 0xb6, 0x5b,
+		0x98, 0x08, 0xf4, 0x64, 0xb2, 0xa2, 0xa4, 0xca, 0x28, 0x52, 0x1c, 0xd5, 0xea, 0xe9, 0xb6, 0x79,
+		0xa5, 0x4c, 0xed, 0xed, 0x8b, 0xde, 0xe3, 0xb1, 0x6e, 0x36, 0x38, 0x78, 0xb1, 0xe5, 0x46, 0x3c,
+		0xd4, 0xcc, 0xa8, 0x26, 0xbe, 0xfd, 0x1d, 0x7c, 0xb6, 0xbf, 0x51, 0xb0, 0x0f, 0x5e, 0x44, 0xa3,
+		0x70, 0x8a, 0x66, 0x7e, 0x18, 0xa1, 0xa9, 0x3f, 0x9f, 0x20, 0x7f, 0x7e, 0x33, 0x9a, 0xf9, 0x13,
+		0xfb, 0x13, 0xd8, 0x03, 0xcf, 0x1f, 0x60, 0xf3, 0xf7, 0xc1, 0xf5, 0x68, 0x66, 0x5b, 0x07, 0xa0,
+		0x30, 0xf2, 0xc7, 0xd3, 0x5b, 0xfb, 0xe8, 0x2d, 0xf9, 0x27, 0x43, 0xb4, 0x29, 0xe9, 0xbf, 0x33,
+		0x44, 0xb7, 0x8b, 0xab, 0xbd, 0x0c, 0xaf, 0xc0, 0xcb, 0x07, 0xd8, 0xe4, 0x6a, 0xec, 0x87, 0xfe,
+		0xfb, 0xb9, 0x6d, 0x1d, 0x00, 0x47, 0xe3, 0xc8, 0xbf, 0xf1, 0xa3, 0x5b, 0xfb, 0xe8, 0xf2, 0x37,
+		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
+		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
+		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
+		0x07, 0x00, 0x00, 0xff, 0xff, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x73, 0xdb, 0xc8,
+		0xd1, 0x7e, 0x41, 0x4a, 0xb2, 0xd4, 0xd4, 0x07, 0x34, 0x92, 0x2c, 0x5a, 0xde, 0xb5, 0x65, 0xee,
+		0xda, 0x2b, 0xf3, 0x5d, 0x49, 0x2b, 0xef, 0xda, 0x5e, 0x5b, 0x71, 0x1c, 0x08, 0x84, 0x2c, 0xd8,
+		0x14, 0xc8, 0x0c, 0x41, 0xcb, 0xda, 0x4a, 0x82, 0x82, 0xc8, 0x91, 0x84, 0x98, 0x04, 0x58, 0xc0,
+		0xd0, 0xb6, 0xee, 0xa9, 0xca, 0x39, 0x97, 0x54, 0x2a, 0xa7, 0xfc, 0x80, 0xa4, 0x52, 0xa9, 0x9c,
+		0x53, 0xa9, 0xca, 0x21, 0xb7, 0x5c, 0xf3, 0x1f, 0xf2, 0x2f, 0x52, 0x33, 0x18, 0x90, 0xe0, 0x27,
+		0xe8, 0xa4, 0x6a, 0x73, 0x13, 0x7a, 0x9e, 0xa7, 0xd1, 0xd3, 0xd3, 0xfd, 0xf4, 0x80, 0x82, 0x5c,
+		0xfb, 0x8c, 0xf8, 0xbb, 0x35, 0xbb, 0x4e, 0xdc, 0x1a, 0xd9, 0xb5, 0x5b, 0xce, 0xee, 0xbb, 0xbd,
+		0xdd, 0xf7, 0x9e, 0xff, 0xf6, 0xbc, 0xe1, 0xbd, 0xdf, 0x69, 0xf9, 0x1e, 0xf5, 0xd0, 0x0a, 0xc3,
+		0xec, 0x08, 0xcc, 0x8e, 0xdd, 0x72, 0x76, 0xde, 0xed, 0x6d, 0xdc, 0xba, 0xf0, 0xbc, 0x8b, 0x06,
+		0xd9, 0xe5, 0x90, 0xb3, 0xf6, 0xf9, 0x6e, 0xbd, 0xed, 0xdb, 0xd4, 0xf1, 0xdc, 0x90, 0xb4, 0x71,
+		0xbb, 0x7f, 0x9d, 0x3a, 0x4d, 0x12, 0x50, 0xbb, 0xd9, 0x12, 0x80, 0xcd, 0x61, 0x6f, 0xae, 0x79,
+		0xcd, 0x66, 0xc7, 0xc5, 0xd0, 0xd8, 0xa8, 0x1d, 0xbc, 0x6d, 0x38, 0x01, 0x0d, 0x31, 0xb9, 0x3f,
+		0xcc, 0xc2, 0xda, 0x89, 0x08, 0x57, 0xfb, 0x40, 0x6a, 0x6d, 0x16, 0x82, 0xee, 0x9e, 0x7b, 0xa8,
+		0x0a, 0x28, 0xda, 0x87, 0x45, 0xa2, 0x95, 0xac, 0xb4, 0x29, 0x6d, 0x65, 0x1e, 0xdc, 0xdb, 0x19,
+		0xb2, 0xa5, 0x9d, 0x01, 0x3f, 0x78, 0xf9, 0x7d, 0xbf, 0x09, 0x3d, 0x84, 0x29, 0x7a, 0xd5, 0x22,
+		0xd9, 0x14, 0x77, 0x74, 0x67, 0xac, 0x23, 0xf3, 0xaa, 0x45, 0x30, 0x87, 0xa3, 0x27, 0x00, 0x01,
+		0xb5, 0x7d, 0x6a, 0xb1, 0x34, 0x64, 0xd3, 0x9c, 0xbc, 0xb1, 0x13, 0xe6, 0x68, 0x27, 0xca, 0xd1,
+		0x8e, 0x19, 0xe5, 0x08, 0xcf, 0x71, 0x34, 0x7b, 0x66, 0xd4, 0x5a, 0xc3, 0x0b, 0x48, 0x48, 0x9d,
+		0x4a, 0xa6, 0x72, 0x34, 0xa7, 0x9a, 0x30, 0x1f, 0x52, 0x03, 0x6a, 0xd3, 0x76, 0x90, 0x9d, 0xde,
+		0x94, 0xb6, 0x16, 0x1f, 0xec, 0x4d, 0xb6, 0x7b, 0x95, 0x31, 0x2b, 0x9c, 0x88, 0x33, 0xb5, 0xee,
+		0x03, 0xba, 0x0b, 0x8b, 0x97, 0x4e, 0x40, 0x3d, 0xff, 0xca, 0x6a, 0x10, 0xf7, 0x82, 0x5e, 0x66,
+		0x67, 0x36, 0xa5, 0xad, 0x34, 0x5e, 0x10, 0xd6, 0x22, 0x37, 0xa2, 0x9f, 0xc0, 0x5a, 0xcb, 0xf6,
+		0x89, 0x4b, 0xbb, 0xe9, 0xb7, 0x1c, 0xf7, 0xdc, 0xcb, 0x5e, 0xe3, 0x5b, 0xd8, 0x1a, 0x1a, 0x45,
+		0x99, 0x33, 0x7a, 0x4e, 0x12, 0xaf, 0xb4, 0x06, 0x8d, 0x48, 0x81, 0xc5, 0xae, 0x5b, 0x9e, 0x99,
+		0xd9, 0xc4, 0xcc, 0x2c, 0x74, 0x18, 0x3c, 0x3b, 0xdb, 0x30, 0xd5, 0x24, 0x4d, 0x2f, 0x3b, 0xc7,
+		0x89, 0x37, 0x86, 0xc6, 0x73, 0x4c, 0x9a, 0x1e, 0xe6, 0x30, 0x84, 0x61, 0x39, 0x20, 0xb6, 0x5f,
+		0xbb, 0xb4, 0x6c, 0x4a, 0x7d, 0xe7, 0xac, 0x4d, 0x49, 0x90, 0x05, 0xce, 0xbd, 0x3b, 0x94, 0x5b,
+		0xe1, 0x68, 0xa5, 0x03, 0xc6, 0x72, 0xd0, 0x67, 0x41, 0x45, 0x58, 0xb6, 0xdb, 0xd4, 0xb3, 0x7c,
+		0x12, 0x10, 0x6a, 0xb5, 0x3c, 0xc7, 0xa5, 0x41, 0x36, 0xc3, 0x7d, 0x6e, 0x0e, 0xf5, 0x89, 0x19,
+		0xb0, 0xcc, 0x71, 0x78, 0x89, 0x51, 0x63, 0x06, 0x74, 0x13, 0xe6, 0x58, 0x7b, 0x58, 0xac, 0x3f,
+		0xb2, 0xf3, 0x9b, 0xd2, 0xd6, 0x1c, 0x9e, 0x65, 0x86, 0xa2, 0x13, 0x50, 0xb4, 0x0e, 0xd7, 0x9c,
+		0xc0, 0xaa, 0xf9, 0x9e, 0x9b, 0x5d, 0xd8, 0x94, 0xb6, 0x66, 0xf1, 0x8c, 0x13, 0xa8, 0xbe, 0xe7,
+		0xa2, 0x7d, 0xc8, 0xb4, 0x5b, 0x75, 0x9b, 0x8a, 0x02, 0x5b, 0x4c, 0x4c, 0x23, 0x84, 0x70, 0x9e,
+		0xc3, 0x9f, 0x83, 0xdc, 0xb2, 0x7d, 0xea, 0xf0, 0x63, 0xa8, 0x79, 0xee, 0xb9, 0x73, 0x91, 0x5d,
+		0xda, 0x4c, 0x6f, 0x65, 0x1e, 0x3c, 0x9f, 0xac, 0xca, 0xd8, 0x61, 0xb2, 0x53, 0x0f, 0x5d, 0xa8,
+		0xdc, 0x83, 0xe6, 0x52, 0xff, 0x0a, 0x2f, 0xb5, 0x7a, 0xad, 0x1b, 0x07, 0xb0, 0x3a, 0x0c, 0x88,
+		0x64, 0x48, 0xbf, 0x25, 0x57, 0xbc, 0xb5, 0xe7, 0x30, 0xfb, 0x13, 0xad, 0xc2, 0xf4, 0x3b, 0xbb,
+		0xd1, 0x0e, 0xbb, 0x74, 0x0e, 0x87, 0x0f, 0x4f, 0x53, 0xdf, 0x4a, 0xb9, 0xdf, 0xa4, 0xe0, 0xd6,
+		0x60, 0xa5, 0x73, 0x67, 0x42, 0xbf, 0xd0, 0xd3, 0x78, 0x16, 0x43, 0xbd, 0xf8, 0x74, 0xe8, 0x5e,
+		0x4c, 0x91, 0xda, 0x58, 0x92, 0x6d, 0xd8, 0xec, 0x56, 0xa5, 0x68, 0x78, 0xcf, 0xea, 0xb6, 0xaf,
+		0xd7, 0xa6, 0x42, 0x39, 0x6e, 0x0c, 0x24, 0xb8, 0x20, 0x02, 0xc0, 0x9f, 0x74, 0x5c, 0x54, 0xb8,
+		0x08, 0x78, 0x6a, 0xd4, 0xd0, 0x5e, 0x9b, 0xa2, 0x13, 0xb8, 0xc9, 0xc3, 0x1b, 0xe1, 0x3d, 0x9d,
+		0xe4, 0x7d, 0x9d, 0xb1, 0x87, 0x38, 0xce, 0xfd, 0x43, 0x82, 0x95, 0x21, 0xed, 0xc7, 0xaa, 0xaa,
+		0xee, 0x35, 0x6d, 0xc7, 0xb5, 0x9c, 0xba, 0x48, 0xf2, 0x6c, 0x68, 0xd0, 0xeb, 0xe8, 0x36, 0x64,
+		0xc4, 0xa2, 0x6b, 0x37, 0xa3, 0x7c, 0x43, 0x68, 0x32, 0xec, 0x26, 0x19, 0x21, 0xc3, 0xe9, 0xff,
+		0x56, 0x86, 0xef, 0xc0, 0xbc, 0xe3, 0x3a, 0xd4, 0xb1, 0x29, 0xa9, 0xb3, 0xb8, 0xa6, 0xb8, 0x02,
+		0x65, 0x3a, 0x36, 0xbd, 0x9e, 0xfb, 0x95, 0x04, 0x6b, 0xda, 0x07, 0x4a, 0x7c, 0xd7, 0x6e, 0x7c,
+		0x2f, 0xa3, 0xa1, 0x3f, 0xa6, 0xd4, 0x60, 0x4c, 0xbf, 0x9e, 0x81, 0x95, 0x32, 0x71, 0xeb, 0x8e,
+		0x7b, 0xa1, 0xd4, 0xa8, 0xf3, 0xce, 0xa1, 0x57, 0x3c, 0xa2, 0xdb, 0x90, 0xb1, 0xc5, 0x73, 0x37,
+		0xcb, 0x10, 0x99, 0xf4, 0x3a, 0x3a, 0x84, 0x85, 0x0e, 0x20, 0x71, 0xfe, 0x44, 0xae, 0xf9, 0xfc,
+		0x99, 0xb7, 0x63, 0x4f, 0xe8, 0x39, 0x4c, 0xb3, 0x59, 0x10, 0x8e, 0xa0, 0xc5, 0x07, 0xf7, 0x87,
+		0x8b, 0x70, 0x6f, 0x84, 0x4c, 0xf6, 0x09, 0x0e, 0x79, 0x48, 0x87, 0xe5, 0x4b, 0x62, 0xfb, 0xf4,
+		0x8c, 0xd8, 0xd4, 0xaa, 0x13, 0x6a, 0x3b, 0x8d, 0x40, 0x0c, 0xa5, 0x4f, 0x46, 0x28, 0xfa, 0x55,
+		0xc3, 0xb3, 0xeb, 0x58, 0xee, 0xd0, 0x0a, 0x21, 0x0b, 0xbd, 0x84, 0x95, 0x86, 0x1d, 0x50, 0xab,
+		0xeb, 0x8f, 0x0b, 0xd0, 0x74, 0xa2, 0x00, 0x2d, 0x33, 0xda, 0x51, 0xc4, 0xe2, 0x3a, 0x74, 0x08,
+		0xdc, 0x18, 0x76, 0x05, 0xa9, 0x87, 0x9e, 0x66, 0x12, 0x3d, 0x2d, 0x31, 0x52, 0x25, 0xe4, 0x70,
+		0x3f, 0x59, 0xb8, 0x66, 0x53, 0x4a, 0x9a, 0x2d, 0xca, 0xc7, 0xd4, 0x34, 0x8e, 0x1e, 0xd1, 0x7d,
+		0x90, 0x9b, 0xf6, 0x07, 0xa7, 0xd9, 0x6e, 0x5a, 0xc2, 0x14, 0xf0, 0x91, 0x33, 0x8d, 0x97, 0x84,
+		0x5d, 0x11, 0x66, 0x36, 0x9b, 0x82, 0xda, 0x25, 0xa9, 0xb7, 0x1b, 0x51, 0x24, 0x73, 0xc9, 0xb3,
+		0xa9, 0xc3, 0xe0, 0x71, 0xa8, 0xb0, 0x44, 0x3e, 0xb4, 0x9c, 0xb0, 0x67, 0x43, 0x1f, 0x90, 0xe8,
+		0x63, 0xb1, 0x4b, 0xe1, 0x4e, 0x9e, 0xc3, 0x3c, 0x4f, 0xca, 0xb9, 0xed, 0x34, 0xda, 0x3e, 0x11,
+		0x83, 0x65, 0xf8, 0x31, 0x1d, 0x86, 0x18, 0x9c, 0x61, 0x0c, 0xf1, 0x80, 0xbe, 0x82, 0x55, 0xee,
+		0x80, 0xd5, 0x3a, 0xf1, 0x2d, 0xa7, 0x4e, 0x5c, 0xea, 0xd0, 0x2b, 0x31, 0x5b, 0x10, 0x5b, 0x3b,
+		0xe1, 0x4b, 0xba, 0x58, 0x41, 0x8f, 0x60, 0x3d, 0x3a, 0x82, 0x7e, 0xd2, 0x02, 0x27, 0xad, 0x89,
+		0xe5, 0x5e, 0x5e, 0xee, 0xcf, 0x29, 0xb8, 0x21, 0xca, 0x4e, 0xbd, 0x74, 0x1a, 0xf5, 0xef, 0xa5,
+		0x61, 0xbf, 0x8c, 0xb9, 0x65, 0x4d, 0x15, 0xd7, 0x30, 0xf9, 0x7d, 0xec, 0x12, 0xc7, 0x95, 0xac,
+		0xbf, 0xbd, 0xd3, 0x03, 0xed, 0x8d, 0x5e, 0x83, 0xb8, 0xab, 0x08, 0x51, 0x6e, 0x79, 0x0d, 0xa7,
+		0x76, 0xc5, 0xdb, 0x63, 0x71, 0x44, 0xa0, 0xa1, 0xe2, 0x72, 0x21, 0x2e, 0x73, 0x34, 0x5e, 0x6e,
+		0xf5, 0x9b, 0xd0, 0x75, 0x98, 0x09, 0x25, 0x95, 0x37, 0xc7, 0x1c, 0x16, 0x4f, 0xb9, 0xbf, 0xa7,
+		0x3a, 0x72, 0x52, 0x20, 0x35, 0x27, 0x88, 0xf2, 0xd5, 0xe9, 0x72, 0x29, 0xb9, 0xcb, 0x23, 0x62,
+		0x4f, 0x97, 0x0f, 0x56, 0x70, 0xea, 0x63, 0x2b, 0xf8, 0x19, 0xcc, 0xf7, 0x34, 0x63, 0xf2, 0x9d,
+		0x37, 0x13, 0x0c, 0x6f, 0xc4, 0xa9, 0xde, 0x46, 0xc4, 0xb0, 0xee, 0xf9, 0xce, 0x85, 0xe3, 0xda,
+		0x0d, 0xab, 0x2f, 0xc8, 0x64, 0xe9, 0x58, 0x8b, 0xa8, 0x95, 0x78, 0xb0, 0xb9, 0xbf, 0xa4, 0xe0,
+		0x46, 0x24, 0x77, 0x45, 0xaf, 0x66, 0x37, 0x0a, 0x4e, 0xd0, 0xb2, 0x69, 0xed, 0x72, 0x32, 0x75,
+		0xfe, 0xdf, 0xa7, 0xeb, 0x67, 0x70, 0xab, 0x37, 0x02, 0xcb, 0x3b, 0xb7, 0xe8, 0xa5, 0x13, 0x58,
+		0xf1, 0x2c, 0x8e, 0x77, 0xb8, 0xd1, 0x13, 0x51, 0xe9, 0xdc, 0xbc, 0x74, 0x02, 0xa1, 0x69, 0xe8,
+		0x53, 0x00, 0x7e, 0xeb, 0xa0, 0xde, 0x5b, 0x12, 0x56, 0xe1, 0x3c, 0xe6, 0xd7, 0x24, 0x93, 0x19,
+		0x72, 0x2f, 0x21, 0x13, 0xbf, 0x88, 0xee, 0xc3, 0x8c, 0xb8, 0xcb, 0x4a, 0xfc, 0x2e, 0xf8, 0x59,
+		0xc2, 0x5d, 0x96, 0x5f, 0xf3, 0x05, 0x25, 0xf7, 0xc7, 0x14, 0x2c, 0xf6, 0x2e, 0xa1, 0x2f, 0x60,
+		0xe9, 0xcc, 0x71, 0x6d, 0xff, 0xca, 0xaa, 0x5d, 0x92, 0xda, 0xdb, 0xa0, 0xdd, 0x14, 0x87, 0xb0,
+		0x18, 0x9a, 0x55, 0x61, 0x45, 0x6b, 0x30, 0xe3, 0xb7, 0xdd, 0x68, 0xf8, 0xce, 0xe1, 0x69, 0xbf,
+		0xcd, 0x6e, 0x29, 0xcf, 0xe0, 0xe6, 0xb9, 0xe3, 0x07, 0x6c, 0x60, 0x85, 0xc5, 0x6e, 0xd5, 0xbc,
+		0x66, 0xab, 0x41, 0x7a, 0x3a, 0x39, 0xcb, 0x21, 0x51, 0x3b, 0xa8, 0x11, 0x80, 0xd3, 0xe7, 0x6b,
+		0x3e, 0xb1, 0x3b, 0x67, 0x93, 0x9c, 0xca, 0x8c, 0xc0, 0x0b, 0x19, 0x5e, 0xe0, 0xc2, 0xec, 0xb8,
+		0x17, 0x93, 0x96, 0xe9, 0x7c, 0x44, 0xe0, 0x0e, 0x6e, 0x01, 0xf0, 0x0f, 0x04, 0x6a, 0x9f, 0x35,
+		0xc2, 0xa9, 0x36, 0x8b, 0x63, 0x96, 0xfc, 0x9f, 0x24, 0x58, 0x1d, 0x36, 0xb3, 0x51, 0x0e, 0x6e,
+		0x95, 0x35, 0xa3, 0xa0, 0x1b, 0x2f, 0x2c, 0x45, 0x35, 0xf5, 0xd7, 0xba, 0x79, 0x6a, 0x55, 0x4c,
+		0xc5, 0xd4, 0x2c, 0xdd, 0x78, 0xad, 0x14, 0xf5, 0x82, 0xfc, 0x7f, 0xe8, 0x73, 0xd8, 0x1c, 0x81,
+		0xa9, 0xa8, 0x47, 0x5a, 0xa1, 0x5a, 0xd4, 0x0a, 0xb2, 0x34, 0xc6, 0x53, 0xc5, 0x54, 0xb0, 0xa9,
+		0x15, 0xe4, 0x14, 0xfa, 0x7f, 0xf8, 0x62, 0x04, 0x46, 0x55, 0x0c, 0x55, 0x2b, 0x5a, 0x58, 0xfb,
+		0x71, 0x55, 0xab, 0x30, 0x70, 0x3a, 0xff, 0x8b, 0x6e, 0xcc, 0x3d, 0x0a, 0x14, 0x7f, 0x53, 0x41,
+		0x53, 0xf5, 0x8a, 0x5e, 0x32, 0xc6, 0xc5, 0xdc, 0x87, 0x19, 0x11, 0x73, 0x3f, 0x2a, 0x8a, 0x39,
+		0xff, 0xcb, 0x54, 0xf7, 0xf7, 0x03, 0xbd, 0x8e, 0x49, 0xbb, 0xa3, 0xb9, 0x9f, 0xc3, 0xe6, 0x49,
+		0x09, 0xbf, 0x3a, 0x2c, 0x96, 0x4e, 0x2c, 0xbd, 0x60, 0x61, 0xad, 0x5a, 0xd1, 0xac, 0x72, 0xa9,
+		0xa8, 0xab, 0xa7, 0xb1, 0x48, 0xbe, 0x85, 0x6f, 0x46, 0xa2, 0x94, 0x22, 0xb3, 0x16, 0xaa, 0xe5,
+		0xa2, 0xae, 0xb2, 0xb7, 0x1e, 0x2a, 0x7a, 0x51, 0x2b, 0x58, 0x25, 0xa3, 0x78, 0x2a, 0x4b, 0xe8,
+		0x4b, 0xd8, 0x9a, 0x94, 0x29, 0xa7, 0xd0, 0x36, 0xdc, 0x1f, 0x89, 0xc6, 0xda, 0x4b, 0x4d, 0x35,
+		0x63, 0xf0, 0x34, 0xda, 0x83, 0xed, 0x91, 0x70, 0x53, 0xc3, 0xc7, 0xba, 0xc1, 0x13, 0x7a, 0x68,
+		0xe1, 0xaa, 0x61, 0xe8, 0xc6, 0x0b, 0x79, 0x2a, 0xff, 0x3b, 0x09, 0x96, 0x07, 0x86, 0x11, 0xba,
+		0x0d, 0x37, 0xcb, 0x0a, 0xd6, 0x0c, 0xd3, 0x52, 0x8b, 0xa5, 0x61, 0x09, 0x18, 0x01, 0x50, 0x0e,
+		0x14, 0xa3, 0x50, 0x32, 0x64, 0x09, 0xdd, 0x83, 0xdc, 0x30, 0x80, 0xa8, 0x05, 0x51, 0x1a, 0x72,
+		0x0a, 0xdd, 0x81, 0x4f, 0x87, 0xe1, 0x3a, 0xd1, 0xca, 0xe9, 0xfc, 0xbf, 0x52, 0xf0, 0xc9, 0xb8,
+		0x9f, 0x29, 0x58, 0x05, 0x76, 0xb6, 0xad, 0xbd, 0xd1, 0xd4, 0xaa, 0xc9, 0xce, 0x3c, 0xf4, 0xc7,
+		0x4e, 0xbe, 0x5a, 0x89, 0x45, 0x1e, 0x4f, 0xe9, 0x08, 0xb0, 0x5a, 0x3a, 0x2e, 0x17, 0x35, 0x93,
+		0x57, 0x53, 0x1e, 0xee, 0x25, 0xc1, 0xc3, 0x03, 0x96, 0x53, 0x3d, 0x67, 0x3b, 0xca, 0x35, 0xdf,
+		0x37, 0x6b, 0x05, 0xb4, 0x03, 0xf9, 0x24, 0x74, 0x27, 0x0b, 0x05, 0x79, 0x0a, 0x7d, 0x03, 0x5f,
+		0x25, 0x07, 0x6e, 0x98, 0xba, 0x51, 0xd5, 0x0a, 0x96, 0x52, 0xb1, 0x0c, 0xed, 0x44, 0x9e, 0x9e,
+		0x64, 0xbb, 0xa6, 0x7e, 0xcc, 0xea, 0xb3, 0x6a, 0xca, 0x33, 0xf9, 0xbf, 0x4a, 0x70, 0x5d, 0xf5,
+		0x5c, 0xea, 0xb8, 0x6d, 0xa2, 0x04, 0x06, 0x79, 0xaf, 0x87, 0xf7, 0x1c, 0xcf, 0x47, 0x77, 0xe1,
+		0x4e, 0xe4, 0x5f, 0xb8, 0xb7, 0x74, 0x43, 0x37, 0x75, 0xc5, 0x2c, 0xe1, 0x58, 0x7e, 0xc7, 0xc2,
+		0x58, 0x43, 0x16, 0x34, 0x1c, 0xe6, 0x75, 0x34, 0x0c, 0x6b, 0x26, 0x3e, 0x15, 0xa5, 0x10, 0x2a,
+		0xcc, 0x68, 0xac, 0x8a, 0x59, 0x7f, 0x8b, 0xfe, 0x97, 0xd3, 0xf9, 0xdf, 0x4b, 0x90, 0x11, 0xdf,
+		0xb6, 0xfc, 0xd3, 0x27, 0x0b, 0xab, 0x6c, 0x83, 0xa5, 0xaa, 0x69, 0x99, 0xa7, 0x65, 0xad, 0xb7,
+		0x86, 0x7b, 0x56, 0xb8, 0x3c, 0x58, 0x66, 0x29, 0xcc, 0x4e, 0xa8, 0x24, 0xbd, 0x00, 0xf1, 0x16,
+		0x86, 0xe1, 0x60, 0x39, 0x35, 0x16, 0x13, 0xfa, 0x49, 0xa3, 0x0d, 0xb8, 0xde, 0x83, 0x39, 0xd2,
+		0x14, 0x6c, 0x1e, 0x68, 0x8a, 0x29, 0x4f, 0xe5, 0x7f, 0x2b, 0xc1, 0x8d, 0x48, 0x09, 0x4d, 0x36,
+		0x58, 0x9d, 0x26, 0xa9, 0x97, 0xda, 0x54, 0xb5, 0xdb, 0x01, 0x41, 0xf7, 0xe1, 0x6e, 0x47, 0xc3,
+		0x4c, 0xa5, 0xf2, 0xaa, 0x7b, 0x56, 0x96, 0xaa, 0xb0, 0xe6, 0xee, 0xee, 0x26, 0x11, 0x2a, 0x42,
+		0x90, 0x25, 0xf4, 0x05, 0x7c, 0x36, 0x1e, 0x8a, 0xb5, 0x8a, 0x66, 0xca, 0xa9, 0xfc, 0x3f, 0x33,
+		0xb0, 0x1e, 0x0f, 0x8e, 0x7d, 0x20, 0x90, 0x7a, 0x18, 0xda, 0x3d, 0xc8, 0xf5, 0x3a, 0x11, 0x3a,
+		0xd7, 0x1f, 0xd7, 0x1e, 0x6c, 0x8f, 0xc1, 0x55, 0x8d, 0x23, 0xc5, 0x28, 0xb0, 0xe7, 0x08, 0x24,
+		0x4b, 0xe8, 0x39, 0xec, 0x8f, 0xa1, 0x1c, 0x28, 0x85, 0x6e, 0x96, 0x3b, 0x13, 0x47, 0x31, 0x4d,
+		0xac, 0x1f, 0x54, 0x4d, 0xad, 0x22, 0xa7, 0x90, 0x06, 0x4a, 0x82, 0x83, 0x5e, 0x1d, 0x1a, 0xea,
+		0x26, 0x8d, 0x9e, 0xc0, 0xc3, 0xa4, 0x38, 0xc2, 0x92, 0xd1, 0x8f, 0x35, 0x1c, 0xa7, 0x4e, 0xa1,
+		0xa7, 0xf0, 0x28, 0x81, 0x2a, 0xde, 0x3c, 0xc0, 0x9d, 0x46, 0xfb, 0xf0, 0x38, 0x31, 0x7a, 0xb5,
+		0x84, 0x0b, 0xd6, 0xb1, 0x82, 0x5f, 0xf5, 0x92, 0x67, 0x90, 0x0e, 0x5a, 0xd2, 0x8b, 0x85, 0xba,
+		0x59, 0x43, 0x74, 0x21, 0xe6, 0xea, 0xda, 0x04, 0x59, 0x64, 0x86, 0x04, 0x37, 0xb3, 0xe8, 0x05,
+		0xa8, 0x93, 0xa5, 0x62, 0xbc, 0xa3, 0x39, 0xf4, 0x06, 0xcc, 0x8f, 0x3b, 0x55, 0xed, 0x8d, 0xa9,
+		0x61, 0x43, 0x49, 0xf2, 0x0c, 0xe8, 0x19, 0x3c, 0x49, 0x4c, 0x5a, 0xaf, 0xfe, 0xc4, 0xe8, 0x19,
+		0xf4, 0x18, 0xbe, 0x1e, 0x43, 0x8f, 0xd7, 0x48, 0xf7, 0x56, 0xa0, 0x17, 0xe4, 0x79, 0xf4, 0x10,
+		0xf6, 0xc6, 0x10, 0x79, 0x17, 0x5a, 0x15, 0x53, 0x57, 0x5f, 0x9d, 0x86, 0xcb, 0x45, 0xbd, 0x62,
+		0xca, 0x0b, 0xe8, 0x47, 0xf0, 0x83, 0x31, 0xb4, 0xce, 0x66, 0xd9, 0x1f, 0x1a, 0x8e, 0xb5, 0x18,
+		0x83, 0x55, 0xb1, 0x26, 0x2f, 0x4e, 0x70, 0x26, 0x15, 0xfd, 0x45, 0x72, 0xe6, 0x96, 0x90, 0x0a,
+		0xcf, 0x27, 0x6a, 0x11, 0xf5, 0x48, 0x2f, 0x16, 0x86, 0x3b, 0x91, 0xd1, 0xd7, 0xb0, 0x3b, 0xc6,
+		0xc9, 0x61, 0x09, 0xab, 0x9a, 0x98, 0x58, 0x1d, 0x91, 0x58, 0x46, 0x8f, 0xe0, 0xc1, 0x38, 0x92,
+		0xa2, 0x17, 0x4b, 0xaf, 0x35, 0xdc, 0xcf, 0x43, 0x6c, 0x8c, 0x4e, 0xb6, 0x75, 0xdd, 0x28, 0x57,
+		0x4d, 0xab, 0xa2, 0x7f, 0xa7, 0xc9, 0x2b, 0x6c, 0x8c, 0x26, 0x9e, 0x54, 0x94, 0x2b, 0x79, 0x75,
+		0x50, 0x8c, 0x07, 0x5e, 0x72, 0xa0, 0x1b, 0x0a, 0x3e, 0x95, 0xd7, 0x12, 0x6a, 0x6f, 0x50, 0xe8,
+		0x7a, 0x4a, 0xe8, 0xfa, 0x24, 0xdb, 0xd1, 0x14, 0xac, 0x1e, 0xc5, 0x33, 0xbe, 0xce, 0xa6, 0xce,
+		0x1d, 0xfe, 0x83, 0xcb, 0xc0, 0xbd, 0x2a, 0x2e, 0xf1, 0x7b, 0xb0, 0x1d, 0x9e, 0xdb, 0x90, 0x2a,
+		0x18, 0xa1, 0xf6, 0x07, 0xf0, 0xc3, 0xc9, 0x28, 0x9d, 0x75, 0xa5, 0x88, 0x35, 0xa5, 0x70, 0xda,
+		0xb9, 0x92, 0x4a, 0xf9, 0xbf, 0x49, 0x90, 0x57, 0x6d, 0xb7, 0x46, 0x1a, 0xd1, 0xef, 0xb8, 0x63,
+		0xa3, 0xdc, 0x87, 0xc7, 0x13, 0xf4, 0xfb, 0x88, 0x78, 0x4f, 0xa0, 0xf2, 0xb1, 0xe4, 0xaa, 0xf1,
+		0xca, 0x28, 0x9d, 0x18, 0xe3, 0x08, 0x62, 0x13, 0x15, 0xe7, 0x82, 0xff, 0x08, 0x3d, 0xd9, 0x26,
+		0x44, 0xd9, 0xfd, 0x67, 0x9b, 0xf8, 0x58, 0xf2, 0x44, 0x9b, 0x38, 0xf8, 0x29, 0xac, 0xd7, 0xbc,
+		0xe6, 0xb0, 0xaf, 0xf8, 0x83, 0x85, 0x68, 0x3b, 0x65, 0xf6, 0x19, 0x5b, 0x96, 0xbe, 0xdb, 0xbb,
+		0x70, 0xe8, 0x65, 0xfb, 0x6c, 0xa7, 0xe6, 0x35, 0x77, 0xe3, 0xff, 0xc1, 0xdd, 0x76, 0xea, 0x8d,
+		0xdd, 0x0b, 0x2f, 0xfc, 0x8f, 0xb0, 0xf8, 0x77, 0xee, 0xbe, 0xdd, 0x72, 0xde, 0xed, 0x9d, 0xcd,
+		0x70, 0xdb, 0xd7, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x86, 0x60, 0x70, 0x2f, 0x8e, 0x1e, 0x00,
+		0x00,
+	},
+	// uber/cadence/api/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0x57,
+		0xf5, 0xef, 0xec, 0xda, 0x6b, 0xef, 0x59, 0xdb, 0xb1, 0x6f, 0x1c, 0xc7, 0xce, 0xa7, 0x33, 0x49,
+		0x13, 0xd7, 0x71, 0xd6, 0x89, 0x93, 0x26, 0x4d, 0xd2, 0x8f, 0x7f, 0xec, 0xd8, 0xea, 0x4a, 0xfe,
+		0x27, 0x61, 0xe2, 0xa4, 0x80, 0x2a, 0x2d, 0xe3, 0x9d, 0xeb, 0x78, 0xf0, 0xee, 0xce, 0x76, 0xe6,
+		0xae, 0x37, 0x46, 0xf0, 0xc4, 0x03, 0x12, 0x6a, 0x05, 0x55, 0x85, 0x44, 0x05, 0x15, 0x08, 0x09,
+		0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x90, 0x40, 0x85, 0x27, 0x5e, 0x78,
+		0xe5, 0x81, 0xbe, 0xf5, 0x81, 0xf2, 0x86, 0x84, 0xe6, 0xce, 0x9d, 0xdd, 0x9d, 0x99, 0x7b, 0x67,
+		0xee, 0xac, 0x9d, 0x16, 0xd4, 0xbc, 0x79, 0xee, 0x9c, 0x73, 0xe6, 0x77, 0xef, 0x3d, 0xe7, 0xdc,
+		0x73, 0xcf, 0x39, 0x6b, 0x38, 0xd1, 0x5c, 0xc7, 0xf6, 0x7c, 0x45, 0x37, 0x70, 0xbd, 0x82, 0xe7,
+		0xf5, 0x86, 0x39, 0xbf, 0x7d, 0x61, 0x7e, 0xd3, 0x74, 0x88, 0x65, 0xef, 0x14, 0x1b, 0xb6, 0x45,
+		0x2c, 0xb4, 0xdf, 0x25, 0x29, 0x32, 0x92, 0xa2, 0xde, 0x30, 0x8b, 0xdb, 0x17, 0x0e, 0x1d, 0x7b,
+		0x60, 0x59, 0x0f, 0xaa, 0x78, 0x9e, 0x92, 0xac, 0x37, 0x37, 0xe6, 0x8d, 0xa6, 0xad, 0x13, 0xd3,
+		0xaa, 0x7b, 0x4c, 0x87, 0x8e, 0x87, 0xdf, 0x13, 0xb3, 0x86, 0x1d, 0xa2, 0xd7, 0x1a, 0x8c, 0x60,
+		0x9a, 0xf7, 0xe1, 0x8a, 0x55, 0xab, 0xb5, 0x45, 0xa8, 0x3c, 0x0a, 0xa2, 0x3b, 0x5b, 0x55, 0xd3,
+		0x21, 0x71, 0x34, 0x2d, 0xcb, 0xde, 0xda, 0xa8, 0x5a, 0x2d, 0x8f, 0x46, 0xbd, 0x09, 0x03, 0x2f,
+		0x7a, 0x13, 0x42, 0x57, 0x21, 0x87, 0xb7, 0x71, 0x9d, 0x38, 0x93, 0xca, 0x74, 0x76, 0xa6, 0xb0,
+		0x70, 0xa2, 0xc8, 0x99, 0x5b, 0x91, 0x51, 0x2f, 0xbb, 0x94, 0x1a, 0x63, 0x50, 0xdf, 0xbf, 0x02,
+		0x43, 0xdd, 0x2f, 0xd0, 0x14, 0x0c, 0xd2, 0x57, 0x65, 0xd3, 0x98, 0x54, 0xa6, 0x95, 0x99, 0xac,
+		0x36, 0x40, 0x9f, 0x4b, 0x06, 0xba, 0x0a, 0xe0, 0xbd, 0x72, 0x27, 0x3d, 0x99, 0x99, 0x56, 0x66,
+		0x0a, 0x0b, 0x87, 0x8a, 0xde, 0x8a, 0x14, 0xfd, 0x15, 0x29, 0xae, 0xf9, 0x2b, 0xa2, 0xe5, 0x29,
+		0xb5, 0xfb, 0x8c, 0x26, 0x61, 0x60, 0x1b, 0xdb, 0x8e, 0x69, 0xd5, 0x27, 0xb3, 0x9e, 0x50, 0xf6,
+		0x88, 0x0e, 0xc2, 0x80, 0x3b, 0x79, 0xf7, 0x73, 0x7d, 0xf4, 0x4d, 0xce, 0x7d, 0x2c, 0x19, 0xe8,
+		0xdb, 0x0a, 0x9c, 0xf5, 0xa7, 0x5c, 0xc6, 0x0f, 0x71, 0xa5, 0xe9, 0xee, 0x43, 0xd9, 0x21, 0xba,
+		0x4d, 0xb0, 0x51, 0xf6, 0x90, 0xe8, 0x84, 0xd8, 0xe6, 0x7a, 0x93, 0x60, 0x67, 0xb2, 0x9f, 0xe2,
+		0x79, 0x96, 0x3b, 0xf5, 0x97, 0x98, 0x9c, 0x65, 0x5f, 0xcc, 0x5d, 0x4f, 0x0a, 0x9d, 0xf2, 0x8d,
+		0xb6, 0x8c, 0x17, 0x9f, 0xd0, 0xce, 0xb4, 0xe4, 0x48, 0xd1, 0xf7, 0x14, 0x38, 0xc7, 0x81, 0x57,
+		0xb1, 0x6a, 0x8d, 0x2a, 0xe6, 0x02, 0xcc, 0x51, 0x80, 0xcf, 0xcb, 0x01, 0x5c, 0xf2, 0xe5, 0x44,
+		0x21, 0x3e, 0xd5, 0x92, 0x25, 0x46, 0x6f, 0x2a, 0x30, 0xcb, 0x01, 0xb9, 0xa1, 0x9b, 0x55, 0x1e,
+		0xc2, 0x01, 0x8a, 0xf0, 0xba, 0x1c, 0xc2, 0x15, 0x2a, 0x24, 0x0a, 0xef, 0x74, 0x4b, 0x8a, 0x12,
+		0x7d, 0x97, 0xbf, 0x80, 0xae, 0x6e, 0x19, 0x65, 0xab, 0x49, 0xa2, 0xf0, 0x06, 0x29, 0xbc, 0xe7,
+		0xe4, 0xe0, 0xb9, 0x6a, 0x67, 0xdc, 0x6e, 0x92, 0x28, 0xc0, 0x99, 0x96, 0x24, 0x2d, 0x7a, 0x43,
+		0x81, 0x19, 0x03, 0x57, 0x4c, 0x87, 0x02, 0x73, 0xb5, 0xd4, 0xa9, 0x6c, 0x62, 0xa3, 0xc9, 0x5d,
+		0xbc, 0x3c, 0x45, 0x77, 0x95, 0x8b, 0xee, 0x26, 0x13, 0xb2, 0xa6, 0x3b, 0x5b, 0x77, 0x7d, 0x11,
+		0x51, 0x64, 0xa7, 0x0c, 0x09, 0x3a, 0xf4, 0x9a, 0x02, 0xa7, 0x43, 0xa8, 0x44, 0x36, 0x01, 0x14,
+		0xd3, 0x95, 0x64, 0x4c, 0x22, 0x73, 0x50, 0x8d, 0x44, 0x2a, 0xce, 0x2a, 0xc5, 0x18, 0x41, 0x41,
+		0x72, 0x95, 0x62, 0xf4, 0x3f, 0xb0, 0x4a, 0x42, 0xd5, 0x7f, 0x3d, 0x82, 0x2a, 0x46, 0xb3, 0x86,
+		0x28, 0xaa, 0x67, 0x12, 0x51, 0x89, 0x95, 0xea, 0xa4, 0x91, 0x4c, 0x86, 0xbe, 0xaa, 0xc0, 0x93,
+		0x41, 0x4c, 0x22, 0x4b, 0x1c, 0xa6, 0x80, 0x2e, 0x27, 0x02, 0x12, 0x19, 0xe1, 0x09, 0x23, 0x89,
+		0x88, 0x6e, 0x9b, 0x5e, 0x21, 0xe6, 0xb6, 0x49, 0x76, 0x12, 0x95, 0x7b, 0x24, 0x66, 0xdb, 0x6e,
+		0x30, 0x21, 0x49, 0xca, 0xad, 0x4b, 0xd0, 0x51, 0xe5, 0x0e, 0xa1, 0x12, 0x29, 0xf7, 0xbe, 0x18,
+		0xe5, 0x0e, 0x60, 0x12, 0x2a, 0xb7, 0x9e, 0x48, 0xc5, 0x59, 0xa5, 0x18, 0xe5, 0x1e, 0x95, 0x5c,
+		0xa5, 0x38, 0xe5, 0xd6, 0x25, 0xe8, 0xa8, 0x22, 0x05, 0x51, 0x89, 0x14, 0x69, 0x2c, 0x46, 0x91,
+		0xba, 0x21, 0x09, 0x15, 0x49, 0x4f, 0x22, 0xa2, 0x96, 0x16, 0x04, 0x13, 0x63, 0x69, 0x28, 0xc6,
+		0xd2, 0xba, 0xf1, 0xc4, 0x58, 0x9a, 0x9e, 0x4c, 0x86, 0x5a, 0x70, 0xcc, 0x05, 0x61, 0x8b, 0xb5,
+		0x67, 0x3f, 0x05, 0x72, 0x9e, 0x0b, 0xc4, 0x95, 0x6a, 0x0b, 0xd5, 0xe6, 0x30, 0x11, 0xbf, 0x46,
+		0xaf, 0xc0, 0x11, 0xef, 0xc3, 0x1b, 0xa6, 0xcd, 0xfb, 0xec, 0x38, 0xfd, 0x6c, 0x51, 0xfc, 0xd9,
+		0x15, 0x97, 0x2f, 0xfa, 0xd1, 0x29, 0x22, 0x7a, 0x89, 0x7e, 0xa0, 0xc0, 0x7c, 0x48, 0x45, 0xf5,
+		0x7a, 0x05, 0x57, 0xcb, 0x36, 0x7e, 0xa5, 0x89, 0x1d, 0xee, 0xec, 0x0f, 0x50, 0x18, 0x2f, 0x24,
+		0x6b, 0x2a, 0x95, 0xa4, 0xf9, 0x82, 0xa2, 0xb8, 0x66, 0x75, 0x69, 0x6a, 0xf4, 0x53, 0x05, 0x2e,
+		0x31, 0x4c, 0x3e, 0x44, 0x39, 0x25, 0x9e, 0xa0, 0x68, 0x97, 0xb8, 0x68, 0xd9, 0xd7, 0xbc, 0x4f,
+		0xcb, 0x68, 0x74, 0xd1, 0x4e, 0xc5, 0x81, 0xbe, 0xae, 0xc0, 0x19, 0xde, 0xf2, 0xf2, 0x80, 0x1e,
+		0x94, 0xd4, 0xee, 0x25, 0x26, 0x21, 0x41, 0xbb, 0x05, 0x64, 0xe8, 0x0b, 0x70, 0xdc, 0x53, 0x32,
+		0x31, 0x92, 0x49, 0x8a, 0xe4, 0x82, 0x58, 0xcf, 0xc4, 0x10, 0x3c, 0x05, 0x16, 0x7d, 0xfb, 0x2b,
+		0x0a, 0x9c, 0x62, 0x9b, 0xc7, 0x14, 0x5d, 0xb0, 0x69, 0x53, 0x14, 0xc1, 0xd3, 0x5c, 0x04, 0x9e,
+		0x70, 0x4f, 0xdf, 0x05, 0xdb, 0x34, 0x5d, 0x49, 0xa0, 0x41, 0x5f, 0x82, 0xe9, 0x9a, 0x6e, 0x6f,
+		0x61, 0xbb, 0x6c, 0xe3, 0x8a, 0x65, 0x1b, 0x3c, 0x10, 0x87, 0x28, 0x88, 0x05, 0x2e, 0x88, 0xff,
+		0xa7, 0xcc, 0x1a, 0xe3, 0x8d, 0x22, 0x38, 0x5a, 0x8b, 0x23, 0x40, 0xdf, 0x51, 0x60, 0x8e, 0x77,
+		0x3f, 0x31, 0x1f, 0xd4, 0x75, 0xee, 0x82, 0x1c, 0x4e, 0x13, 0xbe, 0xde, 0x65, 0x62, 0x64, 0xc2,
+		0x57, 0x01, 0x2d, 0xfa, 0xbe, 0x02, 0x45, 0x5e, 0x84, 0x8d, 0xed, 0x9a, 0x59, 0xd7, 0xb9, 0x7e,
+		0xe1, 0x48, 0x8c, 0x5f, 0x88, 0x86, 0xd8, 0x6d, 0x41, 0x1c, 0xbf, 0xd0, 0x92, 0xa6, 0x46, 0x3f,
+		0x53, 0xe0, 0x12, 0xef, 0x2a, 0x95, 0xe8, 0xc5, 0x8e, 0x52, 0xb4, 0x37, 0x25, 0x6f, 0x54, 0x49,
+		0xae, 0x6c, 0xbe, 0x95, 0x8e, 0x45, 0xa4, 0x01, 0x62, 0xa3, 0x3c, 0x96, 0x46, 0x03, 0xc4, 0x06,
+		0x3a, 0xd3, 0x92, 0xa4, 0x45, 0x7f, 0x57, 0x60, 0x39, 0xe4, 0x71, 0xf1, 0x43, 0x82, 0xed, 0xba,
+		0x5e, 0x2d, 0x73, 0x90, 0x9b, 0x75, 0x93, 0x98, 0x7c, 0xc5, 0x38, 0x4e, 0xa1, 0xdf, 0x4d, 0x76,
+		0xc1, 0xcb, 0x4c, 0x7e, 0x64, 0x3e, 0x25, 0x5f, 0x78, 0x74, 0x42, 0xcf, 0xdb, 0xbb, 0x92, 0x80,
+		0xfe, 0xa6, 0xc0, 0x62, 0x8a, 0x69, 0x8a, 0x3c, 0xd6, 0x34, 0x9d, 0xe3, 0x9d, 0x5d, 0xcc, 0x51,
+		0xe4, 0xcc, 0xae, 0xdb, 0xbd, 0xb3, 0xa3, 0xf7, 0x14, 0x78, 0x2e, 0x6e, 0x3a, 0xc9, 0x76, 0x72,
+		0x82, 0x4e, 0x6c, 0x95, 0x3b, 0x31, 0x21, 0x98, 0x44, 0x7b, 0xb9, 0x82, 0x7b, 0x63, 0xa5, 0x71,
+		0x00, 0x37, 0x75, 0x52, 0x27, 0x66, 0xbd, 0x89, 0x8d, 0xb2, 0xee, 0x94, 0xeb, 0xb8, 0x15, 0x9d,
+		0x87, 0x1a, 0x13, 0x07, 0x70, 0x32, 0x28, 0x4c, 0xdc, 0x0d, 0xe7, 0x16, 0x6e, 0x71, 0xe2, 0x80,
+		0x56, 0x2a, 0x0e, 0xf4, 0x5b, 0x05, 0xae, 0xd2, 0x68, 0xb2, 0x5c, 0xd9, 0x34, 0xab, 0x46, 0x4a,
+		0xfb, 0x39, 0x49, 0xa1, 0xbf, 0xc8, 0x85, 0x4e, 0x43, 0xc9, 0x25, 0x57, 0x68, 0x1a, 0xa3, 0xb9,
+		0xe8, 0xa4, 0x67, 0x43, 0xbf, 0x54, 0xe0, 0x72, 0xc2, 0x24, 0x44, 0xd6, 0x71, 0x8a, 0xce, 0x60,
+		0x39, 0xed, 0x0c, 0x44, 0x26, 0x71, 0xde, 0x49, 0xc9, 0x83, 0x7e, 0xa4, 0xc0, 0x05, 0x21, 0x6a,
+		0x61, 0x9c, 0xff, 0x24, 0x85, 0x7d, 0x83, 0x1f, 0x86, 0x70, 0xbf, 0x2e, 0x0c, 0xfc, 0xe7, 0x2a,
+		0x29, 0xe8, 0xd1, 0x4f, 0x14, 0xb8, 0x28, 0x84, 0x1b, 0x73, 0x89, 0x3c, 0x1d, 0xa3, 0xe4, 0x7c,
+		0xc0, 0x31, 0xd7, 0xc9, 0x62, 0x25, 0x15, 0x07, 0x7a, 0x47, 0x81, 0xf3, 0xa9, 0x35, 0xe3, 0x0c,
+		0x45, 0xfc, 0x7f, 0x29, 0x10, 0x8b, 0x94, 0xe2, 0x6c, 0x25, 0x85, 0x3e, 0xbc, 0xab, 0xc0, 0x82,
+		0x78, 0x81, 0x85, 0x87, 0xf0, 0x0c, 0x45, 0xbb, 0x98, 0x66, 0x7d, 0x85, 0x27, 0xf1, 0xb9, 0x4a,
+		0x1a, 0x06, 0xf4, 0xe3, 0x38, 0x95, 0x88, 0xb9, 0x34, 0x3f, 0x95, 0x1a, 0xb2, 0xf8, 0xfa, 0x2c,
+		0x80, 0x2c, 0xba, 0x48, 0xbb, 0xb1, 0x99, 0x18, 0x72, 0x4c, 0x24, 0x39, 0x1b, 0x13, 0x9b, 0x09,
+		0x30, 0xc7, 0x84, 0x93, 0xf3, 0x95, 0x74, 0x2c, 0xf4, 0xd0, 0xf4, 0x42, 0xf1, 0x5e, 0x23, 0x9e,
+		0xb3, 0x31, 0x87, 0xa6, 0x17, 0x71, 0xf7, 0x12, 0xea, 0x5c, 0x71, 0x7a, 0x63, 0x45, 0xbf, 0x53,
+		0xe0, 0x9a, 0xc4, 0x84, 0x44, 0x36, 0x3a, 0x47, 0x67, 0x53, 0xea, 0x65, 0x36, 0x22, 0x63, 0xbd,
+		0xe4, 0xf4, 0xc0, 0x87, 0x7e, 0xa1, 0xc0, 0xd3, 0x71, 0x13, 0x10, 0xdf, 0x9f, 0xce, 0xc5, 0x1c,
+		0x40, 0x42, 0x10, 0xe2, 0x7b, 0xd4, 0x79, 0x9c, 0x92, 0x87, 0x3a, 0x9c, 0x66, 0xc3, 0xc1, 0x36,
+		0xe9, 0x00, 0x77, 0xb0, 0x6e, 0x57, 0x36, 0xbb, 0x60, 0x46, 0x71, 0x17, 0x63, 0xac, 0xf7, 0x1e,
+		0x15, 0xe7, 0x23, 0xb8, 0x4b, 0x85, 0x75, 0xbe, 0xc8, 0xb1, 0xde, 0x66, 0x1a, 0x86, 0xc5, 0x21,
+		0x80, 0x0e, 0x10, 0xf5, 0x83, 0x61, 0x38, 0x23, 0x7b, 0x7a, 0xad, 0xc0, 0x70, 0x7b, 0x8e, 0x64,
+		0xa7, 0x81, 0x69, 0x2d, 0x50, 0x54, 0x59, 0xf4, 0x85, 0xae, 0xed, 0x34, 0xb0, 0x36, 0xd4, 0xea,
+		0x7a, 0x42, 0x2f, 0xc3, 0x81, 0x86, 0x6e, 0xbb, 0x2b, 0xd2, 0x6d, 0x74, 0x1b, 0x16, 0x2b, 0x1f,
+		0xce, 0x70, 0xe5, 0xdd, 0xa1, 0x1c, 0x5d, 0x36, 0xb1, 0x61, 0x69, 0xfb, 0x1b, 0xd1, 0x41, 0x74,
+		0x0d, 0xf2, 0x34, 0x23, 0x53, 0x35, 0x1d, 0x42, 0x0b, 0x8b, 0x85, 0x85, 0xa3, 0xfc, 0x94, 0x87,
+		0xee, 0x6c, 0xad, 0x9a, 0x0e, 0xd1, 0x06, 0x09, 0xfb, 0x0b, 0x2d, 0x40, 0xbf, 0x59, 0x6f, 0x34,
+		0x09, 0x2d, 0x3b, 0x16, 0x16, 0x8e, 0x08, 0x90, 0xec, 0x54, 0x2d, 0xdd, 0xd0, 0x3c, 0x52, 0xa4,
+		0xc3, 0x74, 0x28, 0xe4, 0x28, 0x13, 0xab, 0x5c, 0xa9, 0x5a, 0x0e, 0xa6, 0xfe, 0xdb, 0x6a, 0x12,
+		0x56, 0x87, 0x9c, 0x8a, 0xd4, 0x45, 0x6f, 0xb2, 0x4a, 0xb2, 0x76, 0x04, 0x07, 0xd6, 0x7e, 0xcd,
+		0x5a, 0x72, 0xf9, 0xd7, 0x3c, 0x76, 0xf4, 0x12, 0x1c, 0xee, 0xa4, 0xbd, 0xa3, 0xd2, 0x73, 0x49,
+		0xd2, 0x0f, 0x12, 0x3f, 0x99, 0x1d, 0x12, 0x7c, 0x1d, 0x0e, 0x75, 0x22, 0xec, 0xce, 0x2c, 0xec,
+		0x66, 0xbd, 0x6c, 0x1a, 0xb4, 0xf4, 0x97, 0xd7, 0x0e, 0xb6, 0x29, 0xda, 0xeb, 0xac, 0x35, 0xeb,
+		0x25, 0x03, 0x95, 0x20, 0xcf, 0x5c, 0xa5, 0x65, 0xd3, 0x3a, 0xdc, 0xc8, 0xc2, 0x59, 0xbe, 0x6b,
+		0x67, 0x02, 0x68, 0x08, 0x5d, 0xf2, 0x59, 0xb4, 0x0e, 0x37, 0x2a, 0xc1, 0x58, 0x07, 0x87, 0xeb,
+		0xae, 0x9a, 0x36, 0x66, 0xc5, 0x33, 0xfe, 0x1e, 0xac, 0x78, 0x34, 0xda, 0x68, 0x9b, 0x8d, 0x8d,
+		0x20, 0x0d, 0x26, 0xaa, 0xba, 0x7b, 0xe7, 0xf3, 0xc2, 0x19, 0x3a, 0x1d, 0xec, 0x34, 0xab, 0x84,
+		0x15, 0xbe, 0xe2, 0xf7, 0x74, 0xdc, 0xe5, 0x5d, 0x6a, 0xb3, 0x6a, 0x94, 0x13, 0x5d, 0x85, 0x29,
+		0xcb, 0x36, 0x1f, 0x98, 0x9e, 0xa3, 0x0d, 0xad, 0x52, 0x81, 0xae, 0xd2, 0x84, 0x4f, 0x10, 0x5a,
+		0xa4, 0x43, 0x30, 0x68, 0x1a, 0xb8, 0x4e, 0x4c, 0xb2, 0x43, 0x2b, 0x4a, 0x79, 0xad, 0xfd, 0x8c,
+		0x2e, 0xc2, 0xc4, 0x86, 0x69, 0x3b, 0x24, 0x2a, 0x73, 0x98, 0x52, 0xee, 0xa7, 0x6f, 0x43, 0x02,
+		0x97, 0x60, 0xc8, 0xc6, 0xc4, 0xde, 0x29, 0x37, 0xac, 0xaa, 0x59, 0xd9, 0x61, 0x55, 0x98, 0x69,
+		0xc1, 0x05, 0x95, 0xd8, 0x3b, 0x77, 0x28, 0x9d, 0x56, 0xb0, 0x3b, 0x0f, 0x68, 0x12, 0x06, 0x74,
+		0x42, 0x70, 0xad, 0x41, 0x68, 0xc5, 0xa4, 0x5f, 0xf3, 0x1f, 0xd1, 0x12, 0xec, 0xc3, 0x0f, 0x1b,
+		0xa6, 0xa7, 0x38, 0x5e, 0x51, 0x7f, 0x34, 0xb1, 0xa8, 0x3f, 0xd2, 0x61, 0xa1, 0x95, 0xfd, 0x93,
+		0x30, 0x5c, 0xb1, 0x5d, 0x6b, 0x60, 0x15, 0x1d, 0x5a, 0x71, 0xc8, 0x6b, 0x43, 0xee, 0xa0, 0x5f,
+		0xe5, 0x41, 0x9f, 0x86, 0xc3, 0xde, 0xec, 0x83, 0xd5, 0xaf, 0x75, 0xbd, 0xb2, 0x65, 0x6d, 0x6c,
+		0xb0, 0xa2, 0x40, 0x8c, 0x52, 0x4f, 0x52, 0xee, 0xee, 0xc2, 0xd7, 0xa2, 0xc7, 0x8a, 0xce, 0x41,
+		0x5f, 0x0d, 0xd7, 0x2c, 0x96, 0xce, 0x9f, 0xe2, 0x27, 0xfa, 0x70, 0xcd, 0xd2, 0x28, 0x19, 0xd2,
+		0x60, 0x2c, 0xe2, 0xb1, 0x59, 0x4e, 0xfe, 0x49, 0xfe, 0xd9, 0x18, 0xf2, 0xb0, 0xda, 0xa8, 0x13,
+		0x1a, 0x41, 0xf7, 0x60, 0xa2, 0x61, 0xe3, 0xed, 0xb2, 0xde, 0x24, 0x96, 0xab, 0x7f, 0x98, 0x94,
+		0x1b, 0x96, 0x59, 0x27, 0x7e, 0x96, 0x5d, 0xb4, 0x5f, 0x0e, 0x26, 0x77, 0x28, 0x9d, 0xb6, 0xdf,
+		0xe5, 0xbf, 0xd1, 0x24, 0x56, 0xd7, 0x20, 0xba, 0x08, 0xb9, 0x4d, 0xac, 0x1b, 0xd8, 0x66, 0xe9,
+		0xef, 0xc3, 0xfc, 0xa6, 0x0e, 0x4a, 0xa2, 0x31, 0x52, 0xb4, 0x0a, 0xe3, 0xde, 0x42, 0x77, 0x6a,
+		0x79, 0x74, 0x5f, 0x0f, 0x26, 0xee, 0x2b, 0xa2, 0x7c, 0xed, 0xba, 0x1c, 0xdd, 0xdb, 0x2f, 0xc2,
+		0x68, 0x43, 0xb7, 0x89, 0xe9, 0x5f, 0xcf, 0x37, 0xcc, 0x07, 0x93, 0x93, 0xb4, 0xc3, 0xe4, 0x53,
+		0xbb, 0x69, 0xb3, 0x70, 0xfd, 0xbb, 0x27, 0x74, 0x89, 0xca, 0x5c, 0xae, 0x13, 0x7b, 0x47, 0xdb,
+		0xd7, 0x08, 0x8e, 0x1e, 0x5a, 0x84, 0x71, 0x1e, 0x21, 0x1a, 0x85, 0xec, 0x16, 0xde, 0xa1, 0x07,
+		0x52, 0x5e, 0x73, 0xff, 0x44, 0xe3, 0xd0, 0xbf, 0xad, 0x57, 0x9b, 0x5e, 0x4f, 0x4a, 0x5e, 0xf3,
+		0x1e, 0xae, 0x65, 0x9e, 0x51, 0xd4, 0x77, 0x14, 0x78, 0x4a, 0xfe, 0xf6, 0x73, 0x09, 0x72, 0xcc,
+		0x7f, 0x28, 0x12, 0xfe, 0x83, 0xd1, 0xa2, 0x15, 0x98, 0x8e, 0x2f, 0x7f, 0x9b, 0x06, 0x05, 0x96,
+		0xd5, 0x8e, 0x88, 0x2b, 0xd7, 0x25, 0x43, 0x7d, 0x5b, 0x81, 0xd3, 0x92, 0x41, 0xd4, 0x65, 0x18,
+		0xf0, 0x3d, 0xa7, 0x22, 0xe1, 0x39, 0x7d, 0xe2, 0x3d, 0x83, 0x6a, 0xc1, 0x8c, 0xf4, 0x0d, 0x62,
+		0x09, 0x86, 0xd8, 0xe1, 0xd5, 0x09, 0x24, 0x46, 0x04, 0x46, 0xc1, 0xce, 0x2a, 0x1a, 0x47, 0x14,
+		0x48, 0xe7, 0x41, 0xfd, 0x93, 0x02, 0xa7, 0x64, 0x9a, 0x28, 0x82, 0x11, 0x81, 0x92, 0x2e, 0x22,
+		0xb8, 0x05, 0x13, 0x82, 0x53, 0x37, 0x93, 0xe4, 0xa0, 0xf6, 0x3b, 0x9c, 0x13, 0xb7, 0xcb, 0xf3,
+		0x66, 0x03, 0x9e, 0x57, 0x7d, 0x4d, 0x01, 0x35, 0xb9, 0xff, 0x02, 0xcd, 0x01, 0x0a, 0xd7, 0xe4,
+		0xdb, 0x5d, 0x59, 0xa3, 0x4e, 0x60, 0x09, 0x42, 0xc7, 0x4f, 0x26, 0x74, 0xfc, 0x1c, 0x05, 0xf0,
+		0x13, 0xa4, 0xa6, 0x41, 0xd1, 0xe4, 0xb5, 0x3c, 0x1b, 0x29, 0x19, 0xea, 0x07, 0xa1, 0xe5, 0x15,
+		0x5a, 0x48, 0x3a, 0x44, 0x33, 0x30, 0x1a, 0xcc, 0xcb, 0xb4, 0xd5, 0x6b, 0xc4, 0xe9, 0x9a, 0x71,
+		0x08, 0x7b, 0x36, 0x84, 0xfd, 0x0c, 0xec, 0x5b, 0x37, 0xeb, 0xba, 0xbd, 0x53, 0xae, 0x6c, 0xe2,
+		0xca, 0x96, 0xd3, 0xac, 0xd1, 0x90, 0x2d, 0xaf, 0x8d, 0x78, 0xc3, 0x4b, 0x6c, 0x14, 0x9d, 0x85,
+		0xb1, 0x60, 0x36, 0x11, 0x3f, 0xf4, 0xc2, 0xb1, 0x21, 0x6d, 0x14, 0x77, 0x27, 0xf9, 0xf0, 0x43,
+		0xa2, 0xbe, 0x9a, 0x85, 0x93, 0x12, 0xad, 0x1d, 0x8f, 0x6c, 0xc6, 0x61, 0xb3, 0xc8, 0xf6, 0x60,
+		0x16, 0xe8, 0x18, 0x14, 0xd6, 0x75, 0x07, 0xfb, 0xa1, 0x84, 0xb7, 0x2c, 0x79, 0x77, 0xc8, 0x0b,
+		0x20, 0x8e, 0x00, 0xd4, 0x71, 0xcb, 0x7f, 0xdd, 0xef, 0x2d, 0x6c, 0x1d, 0xb7, 0xbc, 0xb7, 0x73,
+		0x80, 0x36, 0x2c, 0x7b, 0x8b, 0x21, 0xf5, 0xfb, 0xf3, 0x72, 0xde, 0xd4, 0xdc, 0x37, 0x14, 0xeb,
+		0x7d, 0xd6, 0xa8, 0x37, 0xe1, 0x3a, 0x47, 0xdd, 0xb1, 0xea, 0x2c, 0x56, 0x64, 0x4f, 0xe8, 0x26,
+		0xf4, 0x57, 0xf4, 0xa6, 0x83, 0x59, 0x58, 0x58, 0x94, 0x6e, 0xa2, 0x59, 0x72, 0xb9, 0x34, 0x8f,
+		0x59, 0x7d, 0x3b, 0x0b, 0x27, 0x12, 0x1b, 0x5b, 0x1e, 0xd9, 0x66, 0x2c, 0xfa, 0x73, 0xf0, 0x76,
+		0x61, 0x4e, 0xb2, 0xef, 0xa6, 0x7b, 0x06, 0xdd, 0x3e, 0xb9, 0x2f, 0x8d, 0x4f, 0xee, 0x56, 0xfd,
+		0xfe, 0x90, 0xea, 0x87, 0xf6, 0x37, 0x17, 0xbf, 0xbf, 0x03, 0x52, 0xfb, 0x3b, 0x28, 0xd8, 0x5f,
+		0x8e, 0x99, 0xe5, 0x79, 0x66, 0xa6, 0xbe, 0x95, 0x83, 0x53, 0x32, 0x3d, 0x3f, 0xe8, 0x38, 0x14,
+		0xda, 0x85, 0x73, 0xb6, 0x4d, 0x79, 0x0d, 0xfc, 0xa1, 0x92, 0xe1, 0x5e, 0x32, 0x3b, 0x95, 0x75,
+		0xd7, 0x08, 0x32, 0x31, 0x97, 0xcc, 0xf6, 0x27, 0xe9, 0x25, 0x53, 0xef, 0x7a, 0x72, 0x55, 0xd3,
+		0xb0, 0x6a, 0xba, 0x59, 0x67, 0xbe, 0x83, 0x3d, 0x05, 0x0f, 0x83, 0xbe, 0x1e, 0xaf, 0x87, 0x39,
+		0xf9, 0xeb, 0xe1, 0x1a, 0x4c, 0xf9, 0x4a, 0x18, 0x3d, 0x43, 0x06, 0x92, 0xce, 0x90, 0x09, 0x9f,
+		0x37, 0x74, 0x8c, 0x84, 0xa4, 0xb2, 0x23, 0x8a, 0x49, 0x1d, 0x4c, 0x21, 0xd5, 0xbb, 0x15, 0x32,
+		0xa9, 0xe2, 0xc3, 0x2e, 0xdf, 0xd3, 0x61, 0xb7, 0x02, 0x63, 0x9b, 0x58, 0xb7, 0xc9, 0x3a, 0xd6,
+		0x3b, 0xe8, 0x20, 0x49, 0xd4, 0x68, 0x9b, 0xa7, 0x23, 0x27, 0x39, 0x44, 0x29, 0x24, 0x87, 0x28,
+		0x91, 0xbb, 0xd3, 0x50, 0x2f, 0x77, 0xa7, 0x4e, 0x0c, 0x3e, 0x2c, 0x1d, 0x83, 0xab, 0xff, 0x50,
+		0x40, 0x4d, 0xee, 0x3f, 0xfb, 0xc8, 0x0e, 0xf7, 0xee, 0x30, 0xa4, 0x2f, 0x78, 0x01, 0x7c, 0x01,
+		0x86, 0xe8, 0xfd, 0xd9, 0xf7, 0x5b, 0xfd, 0x12, 0x7e, 0xab, 0xe0, 0x72, 0xb0, 0x07, 0xf5, 0x2f,
+		0x4a, 0xd0, 0x15, 0xec, 0x71, 0x64, 0xcd, 0x5f, 0xa2, 0x4c, 0x0a, 0x77, 0x9f, 0x4d, 0x8c, 0x36,
+		0xfa, 0x82, 0x8b, 0xa9, 0xfe, 0x59, 0x81, 0x13, 0xc9, 0x4d, 0x41, 0xbd, 0x06, 0xe0, 0x1f, 0xc7,
+		0x8c, 0x7e, 0x95, 0x81, 0x93, 0x12, 0xad, 0x75, 0xee, 0x9c, 0x0c, 0x4c, 0x74, 0xb3, 0xea, 0x48,
+		0x6d, 0x92, 0x4f, 0xfc, 0xc8, 0xe6, 0x14, 0x8e, 0x90, 0xfa, 0x7a, 0x89, 0x90, 0x76, 0xad, 0xe2,
+		0xdf, 0x50, 0x60, 0x56, 0xbe, 0x23, 0x4e, 0xe6, 0xcc, 0xdb, 0x9b, 0x2b, 0xd8, 0xbb, 0x0a, 0xa4,
+		0xec, 0x7d, 0x4b, 0xc6, 0x36, 0xee, 0x87, 0x41, 0xec, 0x1e, 0xed, 0x05, 0x36, 0x32, 0x88, 0xb3,
+		0x12, 0x88, 0xdf, 0x0c, 0xe9, 0xa1, 0xa8, 0x4a, 0xd6, 0xab, 0x1e, 0xae, 0xc0, 0x74, 0x55, 0x27,
+		0x5d, 0x3d, 0x20, 0xe1, 0x8e, 0x88, 0xce, 0xca, 0x7a, 0x74, 0xbc, 0xad, 0xf4, 0xc2, 0x26, 0x8e,
+		0x3e, 0x67, 0x53, 0xe8, 0x73, 0x5f, 0xa2, 0x8d, 0x86, 0x02, 0x3d, 0xf5, 0x3d, 0x05, 0x0e, 0xc7,
+		0x74, 0x9d, 0xa2, 0x29, 0x18, 0xf4, 0xba, 0xed, 0xda, 0xfb, 0x36, 0x40, 0x9f, 0x4b, 0x06, 0x5a,
+		0x85, 0x03, 0xed, 0x83, 0x7c, 0xc3, 0xb4, 0x53, 0x5c, 0x5a, 0x11, 0x3b, 0xc7, 0x57, 0x4c, 0x1b,
+		0xa7, 0x39, 0x7e, 0x65, 0x36, 0xfb, 0x73, 0x30, 0x25, 0x6c, 0x67, 0x8d, 0x9b, 0x8d, 0x74, 0xcc,
+		0xae, 0xfe, 0x5e, 0x81, 0x23, 0x71, 0x9d, 0x8c, 0x7b, 0xf2, 0x95, 0xbd, 0x5a, 0x8f, 0x58, 0x07,
+		0xfd, 0x73, 0x05, 0xa6, 0x93, 0x3a, 0x22, 0xe3, 0x66, 0xf3, 0x48, 0xcd, 0x36, 0x16, 0xf9, 0xbf,
+		0x07, 0x20, 0x65, 0xe3, 0x0d, 0x9a, 0x87, 0x71, 0xda, 0xdb, 0x13, 0x4e, 0x83, 0x7b, 0x73, 0x1a,
+		0xab, 0xe3, 0x56, 0x28, 0x09, 0x1e, 0xa9, 0x44, 0x65, 0x7a, 0xab, 0x44, 0x3d, 0xae, 0x15, 0xc9,
+		0xd7, 0x8a, 0x64, 0x74, 0x67, 0x40, 0x42, 0x77, 0x6e, 0xc3, 0x04, 0xcb, 0xf1, 0x33, 0x8c, 0x66,
+		0x9d, 0x60, 0x7b, 0x5b, 0xaf, 0x26, 0xdf, 0x5b, 0xc6, 0x19, 0x23, 0x85, 0x57, 0x62, 0x6c, 0xc1,
+		0x3a, 0x54, 0x7e, 0x57, 0x75, 0xa8, 0xae, 0x10, 0x0e, 0xd2, 0x84, 0x70, 0xe2, 0xa2, 0x53, 0xa1,
+		0xe7, 0xa2, 0x53, 0xe7, 0x9e, 0x31, 0x24, 0x9f, 0xeb, 0xf7, 0x4b, 0x1f, 0xc3, 0xbb, 0x28, 0x7d,
+		0x8c, 0xec, 0xaa, 0xf4, 0xe1, 0xfa, 0xe0, 0xf9, 0xb4, 0xdd, 0x7f, 0x6d, 0x6f, 0xa5, 0x74, 0x7b,
+		0xab, 0xb8, 0xfb, 0xcd, 0x3a, 0x1c, 0x6c, 0x77, 0x0c, 0x84, 0xaa, 0xc8, 0x9e, 0x1d, 0xcf, 0xc6,
+		0xf6, 0x04, 0x04, 0xeb, 0xc8, 0x07, 0x30, 0x6f, 0x58, 0xfd, 0xa1, 0xc2, 0x49, 0x69, 0x8b, 0x4e,
+		0x16, 0x19, 0xf3, 0x50, 0x24, 0xcc, 0xa3, 0x2b, 0xd2, 0xc9, 0xa4, 0x88, 0x74, 0xd4, 0x0f, 0x15,
+		0x38, 0x1a, 0xdb, 0xbd, 0xee, 0x86, 0x7a, 0xac, 0x37, 0xbe, 0xae, 0xd7, 0xfc, 0xa5, 0x06, 0x6f,
+		0xe8, 0x96, 0x5e, 0xc3, 0xbd, 0x7e, 0x7a, 0xcf, 0x4e, 0x95, 0x8e, 0xc6, 0xf7, 0xc9, 0xdf, 0xac,
+		0xbf, 0xc5, 0xdb, 0x24, 0x51, 0xb7, 0xc6, 0x71, 0x28, 0xb0, 0x7e, 0x99, 0xee, 0x25, 0xf0, 0x86,
+		0xe8, 0x12, 0xb4, 0x9d, 0x7a, 0x46, 0xde, 0xa9, 0xc7, 0xe4, 0xa9, 0xd5, 0x6f, 0x2a, 0x30, 0x9b,
+		0xa2, 0x43, 0xa9, 0x93, 0x4f, 0x55, 0x02, 0xf9, 0xd4, 0x5e, 0x77, 0x26, 0x0e, 0xda, 0x6f, 0x32,
+		0xf0, 0xfc, 0xee, 0xba, 0xb4, 0xf7, 0x4c, 0xe7, 0x3b, 0xb9, 0xba, 0x4c, 0x20, 0x57, 0x77, 0x0f,
+		0x50, 0xb4, 0x1b, 0x88, 0xd9, 0xf7, 0x69, 0xb9, 0x6a, 0xa3, 0x36, 0x16, 0x69, 0xe9, 0x45, 0x93,
+		0x30, 0x50, 0xb1, 0xea, 0xc4, 0xb6, 0xaa, 0x54, 0xd1, 0x86, 0x34, 0xff, 0x11, 0x15, 0x61, 0x7f,
+		0xa8, 0xb1, 0xcd, 0xaa, 0x57, 0xbd, 0xc8, 0x7c, 0x50, 0x1b, 0x0b, 0xf4, 0x9b, 0xdd, 0xae, 0x57,
+		0x77, 0xd4, 0x37, 0xb2, 0x70, 0x7d, 0x17, 0x5d, 0xe0, 0xe8, 0x5e, 0xb7, 0xdf, 0x1b, 0x11, 0xfc,
+		0xc6, 0x42, 0x4a, 0x72, 0x20, 0xed, 0xbc, 0x47, 0xf7, 0x49, 0x61, 0x0e, 0x95, 0xbf, 0x2f, 0x7d,
+		0xbb, 0xdd, 0x97, 0x39, 0x40, 0xe1, 0xde, 0x3b, 0x56, 0xa1, 0xc8, 0x6a, 0xa3, 0x66, 0x40, 0x09,
+		0xbd, 0x14, 0x96, 0xbf, 0x8b, 0xb9, 0xc0, 0x2e, 0xaa, 0x7f, 0x55, 0xe0, 0x4a, 0x8f, 0x2d, 0xec,
+		0x02, 0x0c, 0x8a, 0x00, 0xc3, 0x47, 0xab, 0xb8, 0xea, 0xd7, 0xb2, 0x70, 0xa5, 0xc7, 0x36, 0xc3,
+		0xff, 0x55, 0x5b, 0x0d, 0x79, 0xec, 0x3e, 0xb1, 0xc7, 0xee, 0x97, 0xf7, 0xd8, 0x42, 0xd5, 0x11,
+		0x39, 0x80, 0x01, 0x91, 0x03, 0x78, 0x35, 0x0b, 0x97, 0x7a, 0x69, 0x95, 0x94, 0xb3, 0x7c, 0x29,
+		0xc9, 0x8f, 0x2d, 0xbf, 0x63, 0xf9, 0xef, 0x2b, 0x70, 0x3e, 0x6d, 0xdb, 0xe7, 0x7f, 0xb5, 0xc9,
+		0x8b, 0xcf, 0x2a, 0xf5, 0x8f, 0x0a, 0x9c, 0x4b, 0xd5, 0x2a, 0xba, 0x67, 0x2e, 0x80, 0x7b, 0x6b,
+		0xc8, 0xec, 0xee, 0xd6, 0xf0, 0x56, 0x1e, 0x2e, 0xf6, 0xf0, 0x9b, 0x97, 0xae, 0xed, 0x50, 0x02,
+		0xdb, 0x71, 0x1c, 0x0a, 0xed, 0xed, 0x60, 0x3a, 0x9f, 0xd7, 0xc0, 0x1f, 0xe2, 0xa5, 0x10, 0xb2,
+		0x7b, 0x90, 0x42, 0xe8, 0xb5, 0x9e, 0xd8, 0xbf, 0xb7, 0x29, 0x84, 0xdc, 0x23, 0x4d, 0x21, 0x0c,
+		0xf4, 0x9c, 0x42, 0xb8, 0x0f, 0xac, 0x63, 0x97, 0x49, 0x64, 0x65, 0x38, 0xaf, 0x49, 0xe0, 0x74,
+		0x4c, 0xdb, 0x2f, 0x95, 0xc2, 0x8a, 0x71, 0x63, 0x8d, 0xf0, 0x50, 0xb7, 0x91, 0xe4, 0x83, 0xfe,
+		0x5c, 0x46, 0xe5, 0x41, 0x42, 0xe5, 0x2b, 0x30, 0xd9, 0xa5, 0x4e, 0x65, 0x1b, 0x37, 0x3b, 0xf0,
+		0x0b, 0x14, 0xfe, 0x6c, 0xac, 0xe2, 0x94, 0x0c, 0xcd, 0x65, 0x61, 0x53, 0x38, 0xd0, 0xe2, 0x0d,
+		0x47, 0xca, 0x93, 0xc3, 0xbd, 0x94, 0x27, 0x23, 0xbd, 0x97, 0x23, 0x9c, 0xde, 0xcb, 0xce, 0x4d,
+		0x6b, 0x5f, 0xfa, 0xdc, 0xc2, 0xe8, 0x2e, 0x72, 0x0b, 0x63, 0xbb, 0x6b, 0xab, 0xbc, 0x06, 0x05,
+		0x03, 0x57, 0xf5, 0x1d, 0x4f, 0x35, 0x93, 0x7b, 0x44, 0x81, 0x52, 0x53, 0x55, 0x44, 0xcf, 0xc2,
+		0xd0, 0xe7, 0x4d, 0x42, 0xfc, 0xff, 0xff, 0xd0, 0xee, 0x0e, 0x15, 0x32, 0x17, 0x3c, 0x72, 0xca,
+		0xad, 0xbe, 0x9e, 0x85, 0xf3, 0x69, 0x7f, 0xd1, 0xf6, 0xf1, 0x3b, 0xa7, 0x55, 0x3f, 0xca, 0xf0,
+		0xea, 0x64, 0x97, 0x53, 0xff, 0x1c, 0x2b, 0x10, 0x5c, 0x74, 0x99, 0x59, 0x7f, 0xd0, 0xcc, 0xf8,
+		0x47, 0x68, 0x4e, 0x70, 0x84, 0xee, 0x51, 0x26, 0x51, 0xfd, 0x43, 0x06, 0xe6, 0xd2, 0xfc, 0x5c,
+		0x4f, 0xb8, 0x1f, 0xfc, 0xb3, 0x3b, 0xb3, 0xdb, 0xb3, 0x7b, 0xaf, 0x76, 0x91, 0xbf, 0xba, 0x7d,
+		0x82, 0xd5, 0xed, 0xd8, 0x76, 0xbf, 0x7c, 0x16, 0xe5, 0xc3, 0x0c, 0xa4, 0xfc, 0x21, 0xe1, 0x27,
+		0x63, 0x31, 0x79, 0x45, 0xa1, 0x7e, 0x6e, 0x51, 0xa8, 0xd3, 0xcd, 0x90, 0x93, 0xef, 0x66, 0x50,
+		0xff, 0x99, 0x81, 0xb3, 0x7b, 0xe1, 0x51, 0x3e, 0xa1, 0x8b, 0xde, 0x95, 0xaf, 0xcf, 0xa5, 0xc8,
+		0xd7, 0xab, 0xff, 0xca, 0xc0, 0xb9, 0x54, 0xbf, 0xeb, 0x7c, 0xbc, 0xf0, 0x91, 0x85, 0xf7, 0x13,
+		0x92, 0xb9, 0x34, 0x59, 0xea, 0x2f, 0x67, 0x45, 0x0b, 0x2f, 0xea, 0x40, 0x79, 0xbc, 0xf0, 0xb1,
+		0x0d, 0x30, 0xb9, 0x5e, 0x3a, 0xe7, 0x7f, 0x9d, 0x81, 0xf9, 0x94, 0xbf, 0xb7, 0x7d, 0xbc, 0x0f,
+		0x81, 0x7d, 0x98, 0x25, 0xb0, 0x8f, 0xfe, 0xb9, 0x62, 0x56, 0x09, 0xb6, 0xe9, 0xa7, 0x8e, 0xc2,
+		0xd4, 0xf2, 0xfd, 0xe5, 0x5b, 0x6b, 0xe5, 0x95, 0xd2, 0xea, 0xda, 0xb2, 0x56, 0x5e, 0xfb, 0xcc,
+		0x9d, 0xe5, 0x72, 0xe9, 0xd6, 0xfd, 0x1b, 0xab, 0xa5, 0x9b, 0xa3, 0x4f, 0xa0, 0xe3, 0x70, 0x38,
+		0xfa, 0xfa, 0xc6, 0xea, 0x6a, 0x99, 0x8e, 0x8e, 0x2a, 0xe8, 0x04, 0x1c, 0x8d, 0x12, 0x2c, 0xad,
+		0xde, 0xbe, 0xbb, 0xcc, 0x48, 0x32, 0x8b, 0x2f, 0xc3, 0xc1, 0x8a, 0x55, 0xe3, 0xad, 0xc1, 0xa2,
+		0xff, 0x1f, 0x5b, 0xef, 0xb8, 0x71, 0xec, 0x1d, 0xe5, 0xb3, 0x17, 0x1e, 0x98, 0x64, 0xb3, 0xb9,
+		0x5e, 0xac, 0x58, 0xb5, 0xf9, 0xee, 0xff, 0x1c, 0x7b, 0xce, 0x34, 0xaa, 0xf3, 0x0f, 0x2c, 0xef,
+		0xbf, 0xd5, 0xb2, 0x7f, 0x23, 0x7b, 0x5d, 0x6f, 0x98, 0xdb, 0x17, 0xd6, 0x73, 0x74, 0xec, 0xe2,
+		0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xeb, 0xec, 0x99, 0xe6, 0x29, 0x57, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/query.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdf, 0x6f, 0x93, 0x50,
+		0x18, 0x95, 0x9a, 0x2c, 0xd9, 0xb7, 0x55, 0xc9, 0x9d, 0xc6, 0xda, 0xec, 0x47, 0xd3, 0xed, 0x61,
+		0x69, 0x14, 0xec, 0xf4, 0x6d, 0x4f, 0x8c, 0x5e, 0x0d, 0x86, 0x01, 0x03, 0xda, 0xa5, 0x7b, 0x21,
+		0x94, 0x5e, 0x2b, 0x8e, 0x72, 0xf1, 0x5e, 0x68, 0xed, 0x3f, 0xe0, 0xbb, 0x7f, 0x8d, 0xff, 0x9e,
+		0x81, 0x52, 0x5b, 0x2d, 0x33, 0xbe, 0x7d, 0x9c, 0xef, 0x1c, 0xce, 0x39, 0xb9, 0xf9, 0xe0, 0x24,
+		0x1b, 0x11, 0x26, 0x07, 0xfe, 0x98, 0xc4, 0x01, 0x91, 0xfd, 0x24, 0x94, 0x67, 0x5d, 0xf9, 0x6b,
+		0x46, 0xd8, 0x42, 0x4a, 0x18, 0x4d, 0x29, 0x3a, 0xc8, 0x09, 0x52, 0x49, 0x90, 0xfc, 0x24, 0x94,
+		0x66, 0xdd, 0x66, 0xab, 0x4a, 0x15, 0xd0, 0xe9, 0x94, 0xc6, 0x4b, 0x59, 0xb3, 0x5d, 0xc5, 0x98,
+		0x53, 0x76, 0xff, 0x29, 0xa2, 0xf3, 0x25, 0xa7, 0x7d, 0x0f, 0xf5, 0xdb, 0x12, 0xb9, 0xc9, 0x1d,
+		0xd1, 0x11, 0x40, 0x61, 0xed, 0xa5, 0x8b, 0x84, 0x34, 0x84, 0x96, 0x70, 0xbe, 0x6b, 0xef, 0x16,
+		0x88, 0xbb, 0x48, 0x08, 0xba, 0x5c, 0xad, 0x7d, 0x36, 0xe1, 0x8d, 0x5a, 0x4b, 0x38, 0xdf, 0xbb,
+		0x38, 0x94, 0x2a, 0xf2, 0x49, 0x96, 0xbf, 0x88, 0xa8, 0x3f, 0x2e, 0xc5, 0x0a, 0x9b, 0xf0, 0xf6,
+		0x4f, 0x01, 0x0e, 0xfe, 0x70, 0xb3, 0x09, 0xcf, 0xa2, 0x14, 0x61, 0xd8, 0x63, 0xc5, 0xb4, 0x36,
+		0x7d, 0x72, 0x71, 0x56, 0xf9, 0xd7, 0x0d, 0x59, 0x9e, 0xc7, 0x06, 0xf6, 0x7b, 0x46, 0xef, 0x60,
+		0xc7, 0x8f, 0xf9, 0x9c, 0xb0, 0xff, 0xca, 0x55, 0x72, 0xd1, 0x29, 0xd4, 0x09, 0x63, 0x94, 0x79,
+		0x53, 0xc2, 0xb9, 0x3f, 0x21, 0x8d, 0xc7, 0x45, 0xe7, 0xfd, 0x02, 0xbc, 0x5e, 0x62, 0x6d, 0x02,
+		0xf5, 0xd2, 0xf9, 0x0b, 0x09, 0x52, 0x32, 0x46, 0x2e, 0xec, 0x07, 0x11, 0xe5, 0xc4, 0xe3, 0xa9,
+		0x9f, 0x66, 0xbc, 0xcc, 0xdc, 0xad, 0x74, 0x5c, 0x55, 0xc6, 0xdf, 0x48, 0x90, 0xa5, 0x21, 0x8d,
+		0xd5, 0x5c, 0xe9, 0x14, 0x42, 0x7b, 0x2f, 0x58, 0x7f, 0x74, 0x62, 0x78, 0xfa, 0x57, 0x41, 0x74,
+		0x04, 0x2f, 0x6f, 0xfa, 0xd8, 0x1e, 0x7a, 0x36, 0x76, 0xfa, 0xba, 0xeb, 0xb9, 0x43, 0x0b, 0x7b,
+		0x9a, 0x31, 0x50, 0x74, 0xad, 0x27, 0x3e, 0x42, 0xc7, 0xd0, 0xdc, 0x5e, 0x2b, 0x86, 0x73, 0x8b,
+		0x6d, 0xdc, 0x13, 0x05, 0x74, 0x08, 0x8d, 0xed, 0xfd, 0x7b, 0x45, 0xd3, 0x71, 0x4f, 0xac, 0x75,
+		0x7e, 0x08, 0xf0, 0x6c, 0xa3, 0x97, 0x4a, 0xe3, 0x71, 0x98, 0x07, 0x44, 0x6d, 0x38, 0x5e, 0xc9,
+		0x3e, 0x62, 0xd5, 0xf5, 0x54, 0xd3, 0xe8, 0x69, 0xae, 0x66, 0x1a, 0x1b, 0xd6, 0xa7, 0x70, 0xf2,
+		0x00, 0xc7, 0x30, 0x5d, 0xcf, 0xb4, 0xb0, 0x21, 0x0a, 0xe8, 0x0d, 0xbc, 0xfa, 0x07, 0x49, 0x35,
+		0xaf, 0x2d, 0x1d, 0xbb, 0xb8, 0xe7, 0xa9, 0x3a, 0x56, 0x0c, 0x7d, 0x28, 0xd6, 0x3a, 0xdf, 0x05,
+		0x78, 0x5e, 0x64, 0x52, 0x69, 0xcc, 0x43, 0x9e, 0x92, 0x38, 0x58, 0xe8, 0x64, 0x46, 0xa2, 0xb5,
+		0xa1, 0x6a, 0x1a, 0x8e, 0xe6, 0xb8, 0xd8, 0x50, 0x87, 0x9e, 0x8e, 0x07, 0x58, 0xdf, 0x48, 0x75,
+		0x06, 0xad, 0x87, 0x48, 0x78, 0x80, 0x0d, 0xb7, 0xaf, 0xe8, 0xa2, 0xb0, 0xee, 0xb7, 0xcd, 0x72,
+		0x5c, 0xdb, 0x34, 0x3e, 0x88, 0xb5, 0xab, 0x3b, 0x78, 0x11, 0xd0, 0x69, 0xd5, 0x8b, 0x5e, 0x41,
+		0x11, 0xd0, 0xca, 0x2f, 0xc8, 0x12, 0xee, 0xba, 0x93, 0x30, 0xfd, 0x9c, 0x8d, 0xa4, 0x80, 0x4e,
+		0xe5, 0xcd, 0x93, 0x7b, 0x1d, 0x8e, 0x23, 0x79, 0x42, 0xe5, 0xe2, 0xd2, 0xca, 0xfb, 0xbb, 0xf4,
+		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x69,
+		0x28, 0x5b, 0xfb, 0x03, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) WorkerAPIYARPCClient {
+			return NewWorkerAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
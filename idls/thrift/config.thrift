@@ -0,0 +1,54 @@
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+namespace java com.uber.cadence.config
+
+include "shared.thrift"
+
+struct DynamicConfigBlob {
+	10: optional i64 schemaVersion
+	20: optional list<DynamicConfigEntry> entries
+	// author and timestamp of the change which produced this snapshot
+	30: optional string author
+	40: optional i64 (js.type = "Long") timestamp
+}
+
+struct DynamicConfigVersion {
+  10: optional i64 (js.type = "Long") version
+  20: optional string author
+  30: optional i64 (js.type = "Long") timestamp
+  40: optional list<DynamicConfigEntry> entries
+}
+
+struct DynamicConfigEntry {
+  10: optional string name
+  20: optional list<DynamicConfigValue> values
+}
+
+struct DynamicConfigValue {
+  10: optional shared.DataBlob value
+  20: optional list<DynamicConfigFilter> filters
+  // source is the config layer the value comes from, e.g. the base file, an overlay file or an environment variable
+  30: optional string source
+}
+
+struct DynamicConfigFilter {
+  10: optional string name
+  20: optional shared.DataBlob value
+}
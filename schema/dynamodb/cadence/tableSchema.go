@@ -0,0 +1,260 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+// below are the names of all DynamoDB tables, which are prefixed with the keyspace of the config
+const (
+	ClusterConfigTableName      = "cluster_config"
+	ShardTableName              = "shard"
+	CurrentWorkflowTableName    = "current_workflow"
+	WorkflowExecutionTableName  = "workflow_execution"
+	TransferTaskTableName       = "transfer_task"
+	CrossClusterTaskTableName   = "cross_cluster_task"
+	ReplicationTaskTableName    = "replication_task"
+	TimerTaskTableName          = "timer_task"
+	ReplicationDLQTaskTableName = "replication_dlq_task"
+	HistoryTreeTableName        = "history_tree"
+	HistoryNodeTableName        = "history_node"
+	QueueMessageTableName       = "queue_message"
+	QueueMetadataTableName      = "queue_metadata"
+	DomainTableName             = "domain"
+	DomainByIDTableName         = "domain_by_id"
+	DomainMetadataTableName     = "domain_metadata"
+	TaskListTableName           = "tasklist"
+	TaskTableName               = "task"
+	VisibilityTableName         = "visibility"
+)
+
+// below are the names of the global secondary indexes of visibility table
+const (
+	VisibilityStartTimeIndexName = "domainid_starttime"
+	VisibilityCloseTimeIndexName = "domainid_closetime"
+)
+
+// NOTE1: DynamoDB tables only define the key attributes. We use Go lang structs to define the other attributes of the items.
+
+// NOTE2: Composite keys are used when a Cassandra primary key has more than two columns. The components of a composite key
+// are joined by "#", and numeric components are encoded to strings which preserve the order, see the plugin for details.
+
+// NOTE3: Only the attributes that are used in keys, filters or conditional updates are stored as individual attributes.
+// All the other fields are encoded into the Data/DataEncoding blob so that adding new fields does not require schema changes.
+
+// NOTE4: Tables with TTL use ExpireAt in unix seconds. DynamoDB deletes expired items in background,
+// so the expired items may still be returned and must be filtered by readers if needed.
+
+// ClusterConfigItem is the schema of cluster_config table
+// IMPORTANT: making change to this struct is changing the DynamoDB table schema. Please make sure it's backward compatible(e.g., don't delete the field, or change the annotation value).
+type ClusterConfigItem struct {
+	RowType              int    `dynamodbav:"rowtype"`
+	Version              int64  `dynamodbav:"version"`
+	Data                 []byte `dynamodbav:"data"`
+	DataEncoding         string `dynamodbav:"dataencoding"`
+	UnixTimestampSeconds int64  `dynamodbav:"unixtimestampseconds"`
+}
+
+// ShardItem is the schema of shard table
+type ShardItem struct {
+	ShardID      int    `dynamodbav:"shardid"`
+	RangeID      int64  `dynamodbav:"rangeid"`
+	Data         []byte `dynamodbav:"data"`
+	DataEncoding string `dynamodbav:"dataencoding"`
+}
+
+// CurrentWorkflowItem is the schema of current_workflow table
+// WorkflowKey is composed of domainID and workflowID
+type CurrentWorkflowItem struct {
+	ShardID          int    `dynamodbav:"shardid"`
+	WorkflowKey      string `dynamodbav:"workflowkey"`
+	DomainID         string `dynamodbav:"domainid"`
+	WorkflowID       string `dynamodbav:"workflowid"`
+	RunID            string `dynamodbav:"runid"`
+	State            int    `dynamodbav:"state"`
+	CloseStatus      int    `dynamodbav:"closestatus"`
+	CreateRequestID  string `dynamodbav:"createrequestid"`
+	LastWriteVersion int64  `dynamodbav:"lastwriteversion"`
+}
+
+// WorkflowExecutionItem is the schema of workflow_execution table
+// ExecutionKey is composed of domainID, workflowID and runID.
+// The five maps of a workflow execution are stored as map attributes keyed by the string form of the map key,
+// so that a single entry can be set or removed without reading the whole map. SignalRequested is a map for the same reason,
+// because a DynamoDB set can't be empty.
+type WorkflowExecutionItem struct {
+	ShardID             int                  `dynamodbav:"shardid"`
+	ExecutionKey        string               `dynamodbav:"executionkey"`
+	DomainID            string               `dynamodbav:"domainid"`
+	WorkflowID          string               `dynamodbav:"workflowid"`
+	RunID               string               `dynamodbav:"runid"`
+	NextEventID         int64                `dynamodbav:"nexteventid"`
+	Data                []byte               `dynamodbav:"data"`
+	DataEncoding        string               `dynamodbav:"dataencoding"`
+	ActivityMap         map[string][]byte    `dynamodbav:"activitymap"`
+	TimerMap            map[string][]byte    `dynamodbav:"timermap"`
+	ChildExecutionMap   map[string][]byte    `dynamodbav:"childexecutionmap"`
+	RequestCancelMap    map[string][]byte    `dynamodbav:"requestcancelmap"`
+	SignalMap           map[string][]byte    `dynamodbav:"signalmap"`
+	SignalRequested     map[string]bool      `dynamodbav:"signalrequested"`
+	BufferedEvents      []BufferedEventsItem `dynamodbav:"bufferedevents"`
+	MapsDataEncoding    string               `dynamodbav:"mapsdataencoding"`
+	LastUpdateTimestamp int64                `dynamodbav:"lastupdatetimestamp"`
+}
+
+// BufferedEventsItem is a batch of buffered events of a workflow execution
+type BufferedEventsItem struct {
+	Data         []byte `dynamodbav:"data"`
+	DataEncoding string `dynamodbav:"dataencoding"`
+}
+
+// ShardTaskItem is the schema of transfer_task, cross_cluster_task, replication_task and replication_dlq_task tables
+// ShardKey is composed of shardID and Cluster, and it's only the hash key of cross_cluster_task and replication_dlq_task.
+// Cluster is the target cluster for cross_cluster_task, and the source cluster for replication_dlq_task.
+type ShardTaskItem struct {
+	ShardID      int    `dynamodbav:"shardid"`
+	ShardKey     string `dynamodbav:"shardkey,omitempty"`
+	Cluster      string `dynamodbav:"cluster,omitempty"`
+	TaskID       int64  `dynamodbav:"taskid"`
+	Data         []byte `dynamodbav:"data"`
+	DataEncoding string `dynamodbav:"dataencoding"`
+}
+
+// TimerTaskItem is the schema of timer_task table
+// TimerKey is composed of VisibilityTimestamp and TaskID
+type TimerTaskItem struct {
+	ShardID  int    `dynamodbav:"shardid"`
+	TimerKey string `dynamodbav:"timerkey"`
+	// VisibilityTimestamp is in unix nanoseconds
+	VisibilityTimestamp int64  `dynamodbav:"visibilitytimestamp"`
+	TaskID              int64  `dynamodbav:"taskid"`
+	Data                []byte `dynamodbav:"data"`
+	DataEncoding        string `dynamodbav:"dataencoding"`
+}
+
+// HistoryTreeItem is the schema of history_tree table
+type HistoryTreeItem struct {
+	TreeID    string                   `dynamodbav:"treeid"`
+	BranchID  string                   `dynamodbav:"branchid"`
+	ShardID   int                      `dynamodbav:"shardid"`
+	Ancestors []HistoryBranchRangeItem `dynamodbav:"ancestors"`
+	// CreateTimestamp is in unix nanoseconds
+	CreateTimestamp int64  `dynamodbav:"createtimestamp"`
+	Info            string `dynamodbav:"info"`
+}
+
+// HistoryBranchRangeItem is an ancestor of a history branch
+type HistoryBranchRangeItem struct {
+	BranchID  string `dynamodbav:"branchid"`
+	EndNodeID int64  `dynamodbav:"endnodeid"`
+}
+
+// HistoryNodeItem is the schema of history_node table
+// BranchKey is composed of TreeID and BranchID, NodeKey is composed of NodeID and TxnID(in descending order)
+type HistoryNodeItem struct {
+	BranchKey    string `dynamodbav:"branchkey"`
+	NodeKey      string `dynamodbav:"nodekey"`
+	ShardID      int    `dynamodbav:"shardid"`
+	TreeID       string `dynamodbav:"treeid"`
+	BranchID     string `dynamodbav:"branchid"`
+	NodeID       int64  `dynamodbav:"nodeid"`
+	TxnID        int64  `dynamodbav:"txnid"`
+	Data         []byte `dynamodbav:"data"`
+	DataEncoding string `dynamodbav:"dataencoding"`
+}
+
+// QueueMessageItem is the schema of queue_message table
+type QueueMessageItem struct {
+	QueueType int    `dynamodbav:"queuetype"`
+	MessageID int64  `dynamodbav:"messageid"`
+	Payload   []byte `dynamodbav:"payload"`
+}
+
+// QueueMetadataItem is the schema of queue_metadata table
+type QueueMetadataItem struct {
+	QueueType        int              `dynamodbav:"queuetype"`
+	ClusterAckLevels map[string]int64 `dynamodbav:"clusteracklevels"`
+	Version          int64            `dynamodbav:"version"`
+}
+
+// DomainItem is the schema of domain table
+type DomainItem struct {
+	Name                string `dynamodbav:"name"`
+	DomainID            string `dynamodbav:"domainid"`
+	NotificationVersion int64  `dynamodbav:"notificationversion"`
+	Data                []byte `dynamodbav:"data"`
+	DataEncoding        string `dynamodbav:"dataencoding"`
+}
+
+// DomainByIDItem is the schema of domain_by_id table, which maps a domainID to the name of the domain
+type DomainByIDItem struct {
+	DomainID string `dynamodbav:"domainid"`
+	Name     string `dynamodbav:"name"`
+}
+
+// DomainMetadataItem is the schema of domain_metadata table, which contains only one item
+type DomainMetadataItem struct {
+	ID                  int   `dynamodbav:"id"`
+	NotificationVersion int64 `dynamodbav:"notificationversion"`
+}
+
+// TaskListItem is the schema of tasklist table
+// TaskListKey is composed of DomainID, TaskListName and TaskListType
+type TaskListItem struct {
+	TaskListKey  string `dynamodbav:"tasklistkey"`
+	DomainID     string `dynamodbav:"domainid"`
+	TaskListName string `dynamodbav:"tasklistname"`
+	TaskListType int    `dynamodbav:"tasklisttype"`
+	RangeID      int64  `dynamodbav:"rangeid"`
+	Data         []byte `dynamodbav:"data"`
+	DataEncoding string `dynamodbav:"dataencoding"`
+	// ExpireAt is in unix seconds, items without it never expire
+	ExpireAt int64 `dynamodbav:"expireat,omitempty"`
+}
+
+// TaskItem is the schema of task table
+// TaskListKey is the same as the key of the tasklist item
+type TaskItem struct {
+	TaskListKey  string `dynamodbav:"tasklistkey"`
+	TaskID       int64  `dynamodbav:"taskid"`
+	DomainID     string `dynamodbav:"domainid"`
+	TaskListName string `dynamodbav:"tasklistname"`
+	TaskListType int    `dynamodbav:"tasklisttype"`
+	Data         []byte `dynamodbav:"data"`
+	DataEncoding string `dynamodbav:"dataencoding"`
+	// ExpireAt is in unix seconds, items without it never expire
+	ExpireAt int64 `dynamodbav:"expireat,omitempty"`
+}
+
+// VisibilityItem is the schema of visibility table
+type VisibilityItem struct {
+	DomainID     string `dynamodbav:"domainid"`
+	RunID        string `dynamodbav:"runid"`
+	WorkflowID   string `dynamodbav:"workflowid"`
+	WorkflowType string `dynamodbav:"workflowtype"`
+	// StartTime and CloseTime are in unix nanoseconds
+	StartTime int64 `dynamodbav:"starttime"`
+	// CloseTime is only set for closed workflows, so that the close time index only contains closed workflows
+	CloseTime    int64  `dynamodbav:"closetime,omitempty"`
+	IsClosed     bool   `dynamodbav:"isclosed"`
+	CloseStatus  int32  `dynamodbav:"closestatus"`
+	Data         []byte `dynamodbav:"data"`
+	DataEncoding string `dynamodbav:"dataencoding"`
+	// ExpireAt is in unix seconds, items without it never expire
+	ExpireAt int64 `dynamodbav:"expireat,omitempty"`
+}
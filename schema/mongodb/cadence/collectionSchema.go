@@ -20,15 +20,39 @@
 
 package cadence
 
+import (
+	"time"
+)
+
 // below are the names of all mongoDB collections
 const (
-	ClusterConfigCollectionName = "cluster_config"
+	ClusterConfigCollectionName      = "cluster_config"
+	ShardCollectionName              = "shard"
+	CurrentWorkflowCollectionName    = "current_workflow"
+	WorkflowExecutionCollectionName  = "workflow_execution"
+	TransferTaskCollectionName       = "transfer_task"
+	CrossClusterTaskCollectionName   = "cross_cluster_task"
+	ReplicationTaskCollectionName    = "replication_task"
+	TimerTaskCollectionName          = "timer_task"
+	ReplicationDLQTaskCollectionName = "replication_dlq_task"
+	HistoryTreeCollectionName        = "history_tree"
+	HistoryNodeCollectionName        = "history_node"
+	QueueMessageCollectionName       = "queue_message"
+	QueueMetadataCollectionName      = "queue_metadata"
+	DomainCollectionName             = "domain"
+	DomainMetadataCollectionName     = "domain_metadata"
+	TaskListCollectionName           = "tasklist"
+	TaskCollectionName               = "task"
+	VisibilityCollectionName         = "visibility"
 )
 
 // NOTE1: MongoDB collection is schemaless -- there is no schema file for collection. We use Go lang structs to define the collection fields.
 
 // NOTE2: MongoDB doesn't allow using camel case or underscore in the field names
 
+// NOTE3: Only the fields that are used in query filters, sorting or conditional updates are stored as individual fields.
+// All the other fields are encoded into the Data/DataEncoding blob so that adding new fields does not require schema changes.
+
 // ClusterConfigCollectionEntry is the schema of configStore
 // IMPORTANT: making change to this struct is changing the MongoDB collection schema. Please make sure it's backward compatible(e.g., don't delete the field, or change the annotation value).
 type ClusterConfigCollectionEntry struct {
@@ -39,3 +63,174 @@ type ClusterConfigCollectionEntry struct {
 	DataEncoding         string `json:"dataencoding"`
 	UnixTimestampSeconds int64  `json:"unixtimestampseconds"`
 }
+
+// ShardCollectionEntry is the schema of shard collection
+type ShardCollectionEntry struct {
+	ShardID      int    `bson:"shardid"`
+	RangeID      int64  `bson:"rangeid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+}
+
+// CurrentWorkflowCollectionEntry is the schema of current_workflow collection
+type CurrentWorkflowCollectionEntry struct {
+	ID               interface{} `bson:"_id,omitempty"`
+	ShardID          int         `bson:"shardid"`
+	DomainID         string      `bson:"domainid"`
+	WorkflowID       string      `bson:"workflowid"`
+	RunID            string      `bson:"runid"`
+	State            int         `bson:"state"`
+	CloseStatus      int         `bson:"closestatus"`
+	CreateRequestID  string      `bson:"createrequestid"`
+	LastWriteVersion int64       `bson:"lastwriteversion"`
+}
+
+// WorkflowExecutionCollectionEntry is the schema of workflow_execution collection
+// The six maps of a workflow execution are stored as sub-documents keyed by the string form of the map key,
+// so that a single entry can be set or unset without reading the whole map.
+type WorkflowExecutionCollectionEntry struct {
+	ID                  interface{}           `bson:"_id,omitempty"`
+	ShardID             int                   `bson:"shardid"`
+	DomainID            string                `bson:"domainid"`
+	WorkflowID          string                `bson:"workflowid"`
+	RunID               string                `bson:"runid"`
+	NextEventID         int64                 `bson:"nexteventid"`
+	Data                []byte                `bson:"data"`
+	DataEncoding        string                `bson:"dataencoding"`
+	ActivityMap         map[string][]byte     `bson:"activitymap"`
+	TimerMap            map[string][]byte     `bson:"timermap"`
+	ChildExecutionMap   map[string][]byte     `bson:"childexecutionmap"`
+	RequestCancelMap    map[string][]byte     `bson:"requestcancelmap"`
+	SignalMap           map[string][]byte     `bson:"signalmap"`
+	SignalRequested     []string              `bson:"signalrequested"`
+	BufferedEvents      []BufferedEventsEntry `bson:"bufferedevents"`
+	MapsDataEncoding    string                `bson:"mapsdataencoding"`
+	LastUpdateTimestamp int64                 `bson:"lastupdatetimestamp"`
+}
+
+// BufferedEventsEntry is a batch of buffered events of a workflow execution
+type BufferedEventsEntry struct {
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+}
+
+// ShardTaskCollectionEntry is the schema of transfer_task, cross_cluster_task, replication_task and replication_dlq_task collections
+// Cluster is the target cluster for cross_cluster_task, and the source cluster for replication_dlq_task.
+type ShardTaskCollectionEntry struct {
+	ShardID      int    `bson:"shardid"`
+	Cluster      string `bson:"cluster,omitempty"`
+	TaskID       int64  `bson:"taskid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+}
+
+// TimerTaskCollectionEntry is the schema of timer_task collection
+type TimerTaskCollectionEntry struct {
+	ShardID int `bson:"shardid"`
+	// VisibilityTimestamp is in unix nanoseconds
+	VisibilityTimestamp int64  `bson:"visibilitytimestamp"`
+	TaskID              int64  `bson:"taskid"`
+	Data                []byte `bson:"data"`
+	DataEncoding        string `bson:"dataencoding"`
+}
+
+// HistoryTreeCollectionEntry is the schema of history_tree collection
+type HistoryTreeCollectionEntry struct {
+	ID        interface{}               `bson:"_id,omitempty"`
+	ShardID   int                       `bson:"shardid"`
+	TreeID    string                    `bson:"treeid"`
+	BranchID  string                    `bson:"branchid"`
+	Ancestors []HistoryBranchRangeEntry `bson:"ancestors"`
+	// CreateTimestamp is in unix nanoseconds
+	CreateTimestamp int64  `bson:"createtimestamp"`
+	Info            string `bson:"info"`
+}
+
+// HistoryBranchRangeEntry is an ancestor of a history branch
+type HistoryBranchRangeEntry struct {
+	BranchID  string `bson:"branchid"`
+	EndNodeID int64  `bson:"endnodeid"`
+}
+
+// HistoryNodeCollectionEntry is the schema of history_node collection
+type HistoryNodeCollectionEntry struct {
+	ShardID      int    `bson:"shardid"`
+	TreeID       string `bson:"treeid"`
+	BranchID     string `bson:"branchid"`
+	NodeID       int64  `bson:"nodeid"`
+	TxnID        int64  `bson:"txnid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+}
+
+// QueueMessageCollectionEntry is the schema of queue_message collection
+type QueueMessageCollectionEntry struct {
+	QueueType int    `bson:"queuetype"`
+	MessageID int64  `bson:"messageid"`
+	Payload   []byte `bson:"payload"`
+}
+
+// QueueMetadataCollectionEntry is the schema of queue_metadata collection
+type QueueMetadataCollectionEntry struct {
+	QueueType        int              `bson:"queuetype"`
+	ClusterAckLevels map[string]int64 `bson:"clusteracklevels"`
+	Version          int64            `bson:"version"`
+}
+
+// DomainCollectionEntry is the schema of domain collection
+type DomainCollectionEntry struct {
+	ID                  interface{} `bson:"_id,omitempty"`
+	DomainID            string      `bson:"domainid"`
+	Name                string      `bson:"name"`
+	NotificationVersion int64       `bson:"notificationversion"`
+	Data                []byte      `bson:"data"`
+	DataEncoding        string      `bson:"dataencoding"`
+}
+
+// DomainMetadataCollectionEntry is the schema of domain_metadata collection, which contains only one document
+type DomainMetadataCollectionEntry struct {
+	ID                  int   `bson:"_id"`
+	NotificationVersion int64 `bson:"notificationversion"`
+}
+
+// TaskListCollectionEntry is the schema of tasklist collection
+type TaskListCollectionEntry struct {
+	ID           interface{} `bson:"_id,omitempty"`
+	DomainID     string      `bson:"domainid"`
+	TaskListName string      `bson:"tasklistname"`
+	TaskListType int         `bson:"tasklisttype"`
+	RangeID      int64       `bson:"rangeid"`
+	Data         []byte      `bson:"data"`
+	DataEncoding string      `bson:"dataencoding"`
+	// ExpireAt is covered by a TTL index, documents without it never expire
+	ExpireAt *time.Time `bson:"expireat,omitempty"`
+}
+
+// TaskCollectionEntry is the schema of task collection
+type TaskCollectionEntry struct {
+	DomainID     string `bson:"domainid"`
+	TaskListName string `bson:"tasklistname"`
+	TaskListType int    `bson:"tasklisttype"`
+	TaskID       int64  `bson:"taskid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+	// ExpireAt is covered by a TTL index, documents without it never expire
+	ExpireAt *time.Time `bson:"expireat,omitempty"`
+}
+
+// VisibilityCollectionEntry is the schema of visibility collection
+type VisibilityCollectionEntry struct {
+	DomainID     string `bson:"domainid"`
+	WorkflowID   string `bson:"workflowid"`
+	RunID        string `bson:"runid"`
+	WorkflowType string `bson:"workflowtype"`
+	// StartTime and CloseTime are in unix nanoseconds
+	StartTime    int64  `bson:"starttime"`
+	CloseTime    int64  `bson:"closetime"`
+	IsClosed     bool   `bson:"isclosed"`
+	CloseStatus  int32  `bson:"closestatus"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+	// ExpireAt is covered by a TTL index, documents without it never expire
+	ExpireAt *time.Time `bson:"expireat,omitempty"`
+}
@@ -23,6 +23,7 @@ package frontend
 import (
 	"context"
 
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
@@ -35,12 +36,13 @@ type AccessControlledWorkflowAdminHandler struct {
 	AdminHandler
 
 	authorizer authorization.Authorizer
+	auditor    audit.Auditor
 }
 
 var _ AdminHandler = (*AccessControlledWorkflowAdminHandler)(nil)
 
 // NewAccessControlledAdminHandlerImpl creates frontend handler with authentication support
-func NewAccessControlledAdminHandlerImpl(adminHandler AdminHandler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) *AccessControlledWorkflowAdminHandler {
+func NewAccessControlledAdminHandlerImpl(adminHandler AdminHandler, resource resource.Resource, authorizer authorization.Authorizer, auditor audit.Auditor, cfg config.Authorization) *AccessControlledWorkflowAdminHandler {
 	if authorizer == nil {
 		var err error
 		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache())
@@ -48,9 +50,17 @@ func NewAccessControlledAdminHandlerImpl(adminHandler AdminHandler, resource res
 			resource.GetLogger().Fatal("Error when initiating the Authorizer", tag.Error(err))
 		}
 	}
+	if auditor == nil {
+		var err error
+		auditor, err = audit.NewAuditor(cfg.Audit, resource.GetMessagingClient(), resource.GetMetricsClient(), resource.GetLogger())
+		if err != nil {
+			resource.GetLogger().Fatal("Error when initiating the Auditor", tag.Error(err))
+		}
+	}
 	return &AccessControlledWorkflowAdminHandler{
 		AdminHandler: adminHandler,
 		authorizer:   authorizer,
+		auditor:      auditor,
 	}
 }
 
@@ -340,6 +350,24 @@ func (a *AccessControlledWorkflowAdminHandler) RefreshWorkflowTasks(ctx context.
 	return a.AdminHandler.RefreshWorkflowTasks(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) RestoreArchivedWorkflow(ctx context.Context, request *types.RestoreArchivedWorkflowRequest) error {
+	attr := &authorization.Attributes{
+		APIName:     "RestoreArchivedWorkflow",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: request,
+	}
+
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.RestoreArchivedWorkflow(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) RemoveTask(ctx context.Context, request *types.RemoveTaskRequest) error {
 	attr := &authorization.Attributes{
 		APIName:     "RemoveTask",
@@ -437,7 +465,8 @@ func (a *AccessControlledWorkflowAdminHandler) UpdateDynamicConfig(ctx context.C
 		RequestBody: request,
 	}
 
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	// the change is recorded with the principal authenticated by the authorizer
+	ctx, isAuthorized, err := a.authorize(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -455,7 +484,8 @@ func (a *AccessControlledWorkflowAdminHandler) RestoreDynamicConfig(ctx context.
 		RequestBody: request,
 	}
 
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	// the change is recorded with the principal authenticated by the authorizer
+	ctx, isAuthorized, err := a.authorize(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -520,14 +550,61 @@ func (a *AccessControlledWorkflowAdminHandler) ListDynamicConfig(ctx context.Con
 	return a.AdminHandler.ListDynamicConfig(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) ListDynamicConfigHistory(ctx context.Context, request *types.ListDynamicConfigHistoryRequest) (*types.ListDynamicConfigHistoryResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:     "ListDynamicConfigHistory",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: request,
+	}
+
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ListDynamicConfigHistory(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) RollbackDynamicConfig(ctx context.Context, request *types.RollbackDynamicConfigRequest) error {
+	attr := &authorization.Attributes{
+		APIName:     "RollbackDynamicConfig",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: request,
+	}
+
+	// the change is recorded with the principal authenticated by the authorizer
+	ctx, isAuthorized, err := a.authorize(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.RollbackDynamicConfig(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
 ) (bool, error) {
+	_, isAuth, err := a.authorize(ctx, attr)
+	return isAuth, err
+}
+
+// authorize is isAuthorized which also returns the context carrying the principal authenticated by the authorizer
+func (a *AccessControlledWorkflowAdminHandler) authorize(
+	ctx context.Context,
+	attr *authorization.Attributes,
+) (context.Context, bool, error) {
 	result, err := a.authorizer.Authorize(ctx, attr)
+	a.auditor.Audit(ctx, attr, result, err)
 	if err != nil {
-		return false, err
+		return ctx, false, err
 	}
 	isAuth := result.Decision == authorization.DecisionAllow
-	return isAuth, nil
+	return authorization.ContextWithPrincipal(ctx, result.Principal), isAuth, nil
 }
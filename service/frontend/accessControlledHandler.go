@@ -23,6 +23,7 @@ package frontend
 import (
 	"context"
 
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
@@ -39,12 +40,13 @@ type AccessControlledWorkflowHandler struct {
 
 	frontendHandler Handler
 	authorizer      authorization.Authorizer
+	auditor         audit.Auditor
 }
 
 var _ Handler = (*AccessControlledWorkflowHandler)(nil)
 
 // NewAccessControlledHandlerImpl creates frontend handler with authentication support
-func NewAccessControlledHandlerImpl(wfHandler Handler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) *AccessControlledWorkflowHandler {
+func NewAccessControlledHandlerImpl(wfHandler Handler, resource resource.Resource, authorizer authorization.Authorizer, auditor audit.Auditor, cfg config.Authorization) *AccessControlledWorkflowHandler {
 	if authorizer == nil {
 		var err error
 		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache())
@@ -52,10 +54,18 @@ func NewAccessControlledHandlerImpl(wfHandler Handler, resource resource.Resourc
 			resource.GetLogger().Fatal("Error when initiating the Authorizer", tag.Error(err))
 		}
 	}
+	if auditor == nil {
+		var err error
+		auditor, err = audit.NewAuditor(cfg.Audit, resource.GetMessagingClient(), resource.GetMetricsClient(), resource.GetLogger())
+		if err != nil {
+			resource.GetLogger().Fatal("Error when initiating the Auditor", tag.Error(err))
+		}
+	}
 	return &AccessControlledWorkflowHandler{
 		Resource:        resource,
 		frontendHandler: wfHandler,
 		authorizer:      authorizer,
+		auditor:         auditor,
 	}
 }
 
@@ -896,6 +906,7 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	defer sw.Stop()
 
 	result, err := a.authorizer.Authorize(ctx, attr)
+	a.auditor.Audit(ctx, attr, result, err)
 	if err != nil {
 		scope.IncCounter(metrics.CadenceErrAuthorizeFailedCounter)
 		return false, err
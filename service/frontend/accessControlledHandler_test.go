@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/metrics"
@@ -45,6 +46,7 @@ type (
 		mockResource        *resource.Test
 		mockFrontendHandler *MockHandler
 		mockAuthorizer      *authorization.MockAuthorizer
+		mockAuditor         *audit.MockAuditor
 		mockMetricsScope    *mocks.Scope
 
 		handler *AccessControlledWorkflowHandler
@@ -62,8 +64,9 @@ func (s *accessControlledHandlerSuite) SetupTest() {
 	s.mockResource = resource.NewTest(s.controller, metrics.Frontend)
 	s.mockFrontendHandler = NewMockHandler(s.controller)
 	s.mockAuthorizer = authorization.NewMockAuthorizer(s.controller)
+	s.mockAuditor = audit.NewMockAuditor(s.controller)
 	s.mockMetricsScope = &mocks.Scope{}
-	s.handler = NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, config.Authorization{})
+	s.handler = NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, s.mockAuditor, config.Authorization{})
 }
 
 func (s *accessControlledHandlerSuite) TearDownTest() {
@@ -79,6 +82,7 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized() {
 		Return(metrics.Stopwatch{}).Once()
 	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
 		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockAuditor.EXPECT().Audit(ctx, attr, authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
 
 	res, err := s.handler.isAuthorized(ctx, attr, s.mockMetricsScope)
 	s.True(res)
@@ -94,6 +98,7 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized_Failed() {
 	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
 		Return(authorization.Result{Decision: authorization.DecisionDeny}, errors.New("test")).
 		Times(1)
+	s.mockAuditor.EXPECT().Audit(ctx, attr, authorization.Result{Decision: authorization.DecisionDeny}, errors.New("test")).Times(1)
 	s.mockMetricsScope.On("IncCounter", metrics.CadenceErrAuthorizeFailedCounter).Once()
 
 	res, err := s.handler.isAuthorized(ctx, attr, s.mockMetricsScope)
@@ -110,6 +115,7 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized_Unauthorized() {
 	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
 		Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).
 		Times(1)
+	s.mockAuditor.EXPECT().Audit(ctx, attr, authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
 	s.mockMetricsScope.On("IncCounter", metrics.CadenceErrUnauthorizedCounter).Once()
 
 	res, err := s.handler.isAuthorized(ctx, attr, s.mockMetricsScope)
@@ -176,11 +176,26 @@ func (g adminGRPCHandler) MaintainCorruptWorkflow(ctx context.Context, request *
 	return proto.FromAdminMaintainWorkflowResponse(response), proto.FromError(err)
 }
 
+func (g adminGRPCHandler) RestoreArchivedWorkflow(ctx context.Context, request *adminv1.RestoreArchivedWorkflowRequest) (*adminv1.RestoreArchivedWorkflowResponse, error) {
+	err := g.h.RestoreArchivedWorkflow(ctx, proto.ToAdminRestoreArchivedWorkflowRequest(request))
+	return &adminv1.RestoreArchivedWorkflowResponse{}, proto.FromError(err)
+}
+
 func (g adminGRPCHandler) ListDynamicConfig(ctx context.Context, request *adminv1.ListDynamicConfigRequest) (*adminv1.ListDynamicConfigResponse, error) {
 	response, err := g.h.ListDynamicConfig(ctx, proto.ToListDynamicConfigRequest(request))
 	return proto.FromListDynamicConfigResponse(response), proto.FromError(err)
 }
 
+func (g adminGRPCHandler) ListDynamicConfigHistory(ctx context.Context, request *adminv1.ListDynamicConfigHistoryRequest) (*adminv1.ListDynamicConfigHistoryResponse, error) {
+	response, err := g.h.ListDynamicConfigHistory(ctx, proto.ToListDynamicConfigHistoryRequest(request))
+	return proto.FromListDynamicConfigHistoryResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) RollbackDynamicConfig(ctx context.Context, request *adminv1.RollbackDynamicConfigRequest) (*adminv1.RollbackDynamicConfigResponse, error) {
+	err := g.h.RollbackDynamicConfig(ctx, proto.ToRollbackDynamicConfigRequest(request))
+	return &adminv1.RollbackDynamicConfigResponse{}, proto.FromError(err)
+}
+
 func (g adminGRPCHandler) GetGlobalIsolationGroups(ctx context.Context, request *adminv1.GetGlobalIsolationGroupsRequest) (*adminv1.GetGlobalIsolationGroupsResponse, error) {
 	res, err := g.h.GetGlobalIsolationGroups(ctx, proto.ToGetGlobalIsolationGroupsRequest(request))
 	return proto.FromGetGlobalIsolationGroupsResponse(res), proto.FromError(err)
@@ -31,12 +31,15 @@ import (
 	"strconv"
 	"time"
 
+	"go.uber.org/yarpc"
+
 	"github.com/uber/cadence/common/isolationgroup/isolationgroupapi"
 
 	"github.com/pborman/uuid"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/codec"
@@ -62,7 +65,8 @@ const (
 )
 
 var (
-	errInvalidFilters = &types.BadRequestError{Message: "Request Filters are invalid, unable to parse."}
+	errInvalidFilters                   = &types.BadRequestError{Message: "Request Filters are invalid, unable to parse."}
+	errDynamicConfigHistoryNotSupported = &types.BadRequestError{Message: "Dynamic config client does not keep a version history."}
 )
 
 type (
@@ -87,6 +91,7 @@ type (
 		ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 		ReapplyEvents(context.Context, *types.ReapplyEventsRequest) error
 		RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest) error
+		RestoreArchivedWorkflow(context.Context, *types.RestoreArchivedWorkflowRequest) error
 		RemoveTask(context.Context, *types.RemoveTaskRequest) error
 		ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest) error
 		ResetQueue(context.Context, *types.ResetQueueRequest) error
@@ -96,6 +101,8 @@ type (
 		UpdateDynamicConfig(context.Context, *types.UpdateDynamicConfigRequest) error
 		RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest) error
 		ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error)
+		ListDynamicConfigHistory(context.Context, *types.ListDynamicConfigHistoryRequest) (*types.ListDynamicConfigHistoryResponse, error)
+		RollbackDynamicConfig(context.Context, *types.RollbackDynamicConfigRequest) error
 		DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error)
 		MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error)
 		GetGlobalIsolationGroups(ctx context.Context, request *types.GetGlobalIsolationGroupsRequest) (*types.GetGlobalIsolationGroupsResponse, error)
@@ -1320,6 +1327,39 @@ func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// RestoreArchivedWorkflow recreates a deleted workflow from its archived history
+func (adh *adminHandlerImpl) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.RestoreArchivedWorkflowRequest,
+) (err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRestoreArchivedWorkflowScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.Execution.GetRunID() == "" {
+		return adh.error(errRunIDNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().RestoreArchivedWorkflow(ctx, &types.HistoryRestoreArchivedWorkflowRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *adminHandlerImpl) ResendReplicationTasks(
 	ctx context.Context,
@@ -1667,6 +1707,9 @@ func (adh *adminHandlerImpl) UpdateDynamicConfig(ctx context.Context, request *t
 		return adh.error(err, scope)
 	}
 
+	if client, ok := adh.params.DynamicConfig.(dc.VersionedClient); ok {
+		return client.UpdateValueWithAuthor(keyVal, request.ConfigValues, dynamicConfigAuthor(ctx, request.Identity))
+	}
 	return adh.params.DynamicConfig.UpdateValue(keyVal, request.ConfigValues)
 }
 
@@ -1694,6 +1737,9 @@ func (adh *adminHandlerImpl) RestoreDynamicConfig(ctx context.Context, request *
 			return adh.error(errInvalidFilters, scope)
 		}
 	}
+	if client, ok := adh.params.DynamicConfig.(dc.VersionedClient); ok {
+		return client.RestoreValueWithAuthor(keyVal, filters, dynamicConfigAuthor(ctx, request.Identity))
+	}
 	return adh.params.DynamicConfig.RestoreValue(keyVal, filters)
 }
 
@@ -1728,6 +1774,67 @@ func (adh *adminHandlerImpl) ListDynamicConfig(ctx context.Context, request *typ
 	}, nil
 }
 
+func (adh *adminHandlerImpl) ListDynamicConfigHistory(ctx context.Context, request *types.ListDynamicConfigHistoryRequest) (_ *types.ListDynamicConfigHistoryResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListDynamicConfigHistoryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	client, ok := adh.params.DynamicConfig.(dc.VersionedClient)
+	if !ok {
+		return nil, adh.error(errDynamicConfigHistoryNotSupported, scope)
+	}
+
+	var keyVal dc.Key
+	if request.ConfigName != "" {
+		var err error
+		keyVal, err = dc.GetKeyFromKeyName(request.ConfigName)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	versions, err := client.ListValueHistory(keyVal)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.ListDynamicConfigHistoryResponse{
+		Versions: versions,
+	}, nil
+}
+
+func (adh *adminHandlerImpl) RollbackDynamicConfig(ctx context.Context, request *types.RollbackDynamicConfigRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRollbackDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil || request.Version <= 0 {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	client, ok := adh.params.DynamicConfig.(dc.VersionedClient)
+	if !ok {
+		return adh.error(errDynamicConfigHistoryNotSupported, scope)
+	}
+
+	var keyVal dc.Key
+	if request.ConfigName != "" {
+		var err error
+		keyVal, err = dc.GetKeyFromKeyName(request.ConfigName)
+		if err != nil {
+			return adh.error(err, scope)
+		}
+	}
+
+	if err := client.RollbackValue(request.Version, keyVal, dynamicConfigAuthor(ctx, request.Identity)); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 func (adh *adminHandlerImpl) GetGlobalIsolationGroups(ctx context.Context, request *types.GetGlobalIsolationGroupsRequest) (_ *types.GetGlobalIsolationGroupsResponse, retError error) {
 	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
 	scope, sw := adh.startRequestProfile(ctx, metrics.GetGlobalIsolationGroups)
@@ -1811,3 +1918,16 @@ func convertFilterListToMap(filters []*types.DynamicConfigFilter) (map[dc.Filter
 	}
 	return newFilters, nil
 }
+
+// dynamicConfigAuthor returns the author recorded for a dynamic config change, the principal authenticated
+// by the authorizer if any, the identity set on the request or the name of the calling service otherwise.
+// The identity and the caller name are reported by the caller and not verified.
+func dynamicConfigAuthor(ctx context.Context, identity string) string {
+	if principal := authorization.PrincipalFromContext(ctx); principal != "" {
+		return principal
+	}
+	if identity != "" {
+		return identity
+	}
+	return yarpc.CallFromContext(ctx).Caller()
+}
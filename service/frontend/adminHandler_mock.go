@@ -326,6 +326,21 @@ func (mr *MockAdminHandlerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).ListDynamicConfig), arg0, arg1)
 }
 
+// ListDynamicConfigHistory mocks base method.
+func (m *MockAdminHandler) ListDynamicConfigHistory(arg0 context.Context, arg1 *types.ListDynamicConfigHistoryRequest) (*types.ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockAdminHandlerMockRecorder) ListDynamicConfigHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockAdminHandler)(nil).ListDynamicConfigHistory), arg0, arg1)
+}
+
 // MaintainCorruptWorkflow mocks base method.
 func (m *MockAdminHandler) MaintainCorruptWorkflow(arg0 context.Context, arg1 *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -470,6 +485,20 @@ func (mr *MockAdminHandlerMockRecorder) RespondCrossClusterTasksCompleted(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondCrossClusterTasksCompleted", reflect.TypeOf((*MockAdminHandler)(nil).RespondCrossClusterTasksCompleted), arg0, arg1)
 }
 
+// RestoreArchivedWorkflow mocks base method.
+func (m *MockAdminHandler) RestoreArchivedWorkflow(arg0 context.Context, arg1 *types.RestoreArchivedWorkflowRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreArchivedWorkflow indicates an expected call of RestoreArchivedWorkflow.
+func (mr *MockAdminHandlerMockRecorder) RestoreArchivedWorkflow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflow", reflect.TypeOf((*MockAdminHandler)(nil).RestoreArchivedWorkflow), arg0, arg1)
+}
+
 // RestoreDynamicConfig mocks base method.
 func (m *MockAdminHandler) RestoreDynamicConfig(arg0 context.Context, arg1 *types.RestoreDynamicConfigRequest) error {
 	m.ctrl.T.Helper()
@@ -484,6 +513,20 @@ func (mr *MockAdminHandlerMockRecorder) RestoreDynamicConfig(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).RestoreDynamicConfig), arg0, arg1)
 }
 
+// RollbackDynamicConfig mocks base method.
+func (m *MockAdminHandler) RollbackDynamicConfig(arg0 context.Context, arg1 *types.RollbackDynamicConfigRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackDynamicConfig indicates an expected call of RollbackDynamicConfig.
+func (mr *MockAdminHandlerMockRecorder) RollbackDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).RollbackDynamicConfig), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockAdminHandler) Start() {
 	m.ctrl.T.Helper()
@@ -47,7 +47,9 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
 	esmock "github.com/uber/cadence/common/elasticsearch/mocks"
@@ -399,6 +401,41 @@ func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_SameStartIDAnd
 	s.NoError(err)
 }
 
+func (s *adminHandlerSuite) Test_RestoreArchivedWorkflow_FailedOnMissingRunID() {
+	ctx := context.Background()
+	err := s.handler.RestoreArchivedWorkflow(ctx, &types.RestoreArchivedWorkflowRequest{
+		Domain: s.domainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: "workflowID",
+		},
+	})
+	s.Equal(errRunIDNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_RestoreArchivedWorkflow() {
+	ctx := context.Background()
+	execution := &types.WorkflowExecution{
+		WorkflowID: "workflowID",
+		RunID:      uuid.New(),
+	}
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{},
+		cluster.TestCurrentClusterName,
+	)
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).Times(1)
+	s.mockHistoryClient.EXPECT().RestoreArchivedWorkflow(ctx, &types.HistoryRestoreArchivedWorkflowRequest{
+		DomainUUID: s.domainID,
+		Execution:  execution,
+	}).Return(nil).Times(1)
+
+	err := s.handler.RestoreArchivedWorkflow(ctx, &types.RestoreArchivedWorkflowRequest{
+		Domain:    s.domainName,
+		Execution: execution,
+	})
+	s.NoError(err)
+}
+
 func (s *adminHandlerSuite) Test_SetRequestDefaultValueAndGetTargetVersionHistory_DefinedStartAndEnd() {
 	inputStartEventID := int64(1)
 	inputStartVersion := int64(10)
@@ -718,6 +755,79 @@ func (s *adminHandlerSuite) Test_ConfigStore_InvalidKey() {
 	s.Error(err)
 }
 
+func (s *adminHandlerSuite) Test_ListDynamicConfigHistory() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockVersionedClient(s.controller)
+	handler.params.DynamicConfig = dynamicConfig
+
+	versions := []*types.DynamicConfigVersion{
+		{Version: 2, Author: "cadence-cli@host"},
+		{Version: 1, Author: "cadence-cli@host"},
+	}
+	dynamicConfig.EXPECT().ListValueHistory(dynamicconfig.TestGetBoolPropertyKey).Return(versions, nil).Times(1)
+
+	resp, err := handler.ListDynamicConfigHistory(ctx, &types.ListDynamicConfigHistoryRequest{
+		ConfigName: dynamicconfig.TestGetBoolPropertyKey.String(),
+	})
+	s.NoError(err)
+	s.Equal(versions, resp.Versions)
+}
+
+func (s *adminHandlerSuite) Test_ListDynamicConfigHistory_NotSupported() {
+	ctx := context.Background()
+	handler := s.handler
+	handler.params.DynamicConfig = dynamicconfig.NewMockClient(s.controller)
+
+	_, err := handler.ListDynamicConfigHistory(ctx, &types.ListDynamicConfigHistoryRequest{})
+	s.Equal(errDynamicConfigHistoryNotSupported, err)
+}
+
+func (s *adminHandlerSuite) Test_RollbackDynamicConfig() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockVersionedClient(s.controller)
+	handler.params.DynamicConfig = dynamicConfig
+
+	dynamicConfig.EXPECT().RollbackValue(int64(3), nil, "operator").Return(nil).Times(1)
+	err := handler.RollbackDynamicConfig(ctx, &types.RollbackDynamicConfigRequest{
+		Version:  3,
+		Identity: "operator",
+	})
+	s.NoError(err)
+
+	err = handler.RollbackDynamicConfig(ctx, &types.RollbackDynamicConfigRequest{})
+	s.Equal(errRequestNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateDynamicConfig_RecordsAuthor() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockVersionedClient(s.controller)
+	handler.params.DynamicConfig = dynamicConfig
+
+	dynamicConfig.EXPECT().UpdateValueWithAuthor(dynamicconfig.TestGetBoolPropertyKey, gomock.Any(), "operator").Return(nil).Times(1)
+	err := handler.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{
+		ConfigName: dynamicconfig.TestGetBoolPropertyKey.String(),
+		Identity:   "operator",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateDynamicConfig_PrefersAuthenticatedPrincipal() {
+	ctx := authorization.ContextWithPrincipal(context.Background(), "spiffe://cluster/operator")
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockVersionedClient(s.controller)
+	handler.params.DynamicConfig = dynamicConfig
+
+	dynamicConfig.EXPECT().UpdateValueWithAuthor(dynamicconfig.TestGetBoolPropertyKey, gomock.Any(), "spiffe://cluster/operator").Return(nil).Times(1)
+	err := handler.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{
+		ConfigName: dynamicconfig.TestGetBoolPropertyKey.String(),
+		Identity:   "operator",
+	})
+	s.NoError(err)
+}
+
 func (s *adminHandlerSuite) Test_GetDynamicConfig_NoFilter() {
 	ctx := context.Background()
 	handler := s.handler
@@ -142,6 +142,12 @@ func (t AdminThriftHandler) RefreshWorkflowTasks(ctx context.Context, request *s
 	return thrift.FromError(err)
 }
 
+// RestoreArchivedWorkflow forwards request to the underlying handler
+func (t AdminThriftHandler) RestoreArchivedWorkflow(ctx context.Context, request *admin.RestoreArchivedWorkflowRequest) error {
+	err := t.h.RestoreArchivedWorkflow(ctx, thrift.ToRestoreArchivedWorkflowRequest(request))
+	return thrift.FromError(err)
+}
+
 // RemoveTask forwards request to the underlying handler
 func (t AdminThriftHandler) RemoveTask(ctx context.Context, request *shared.RemoveTaskRequest) error {
 	err := t.h.RemoveTask(ctx, thrift.ToRemoveTaskRequest(request))
@@ -208,6 +214,18 @@ func (t AdminThriftHandler) ListDynamicConfig(ctx context.Context, request *admi
 	return thrift.FromListDynamicConfigResponse(response), thrift.FromError(err)
 }
 
+// ListDynamicConfigHistory lists the stored versions of the dynamic config
+func (t AdminThriftHandler) ListDynamicConfigHistory(ctx context.Context, request *admin.ListDynamicConfigHistoryRequest) (*admin.ListDynamicConfigHistoryResponse, error) {
+	response, err := t.h.ListDynamicConfigHistory(ctx, thrift.ToListDynamicConfigHistoryRequest(request))
+	return thrift.FromListDynamicConfigHistoryResponse(response), thrift.FromError(err)
+}
+
+// RollbackDynamicConfig restores the values of a previous version of the dynamic config
+func (t AdminThriftHandler) RollbackDynamicConfig(ctx context.Context, request *admin.RollbackDynamicConfigRequest) error {
+	err := t.h.RollbackDynamicConfig(ctx, thrift.ToRollbackDynamicConfigRequest(request))
+	return thrift.FromError(err)
+}
+
 func (t AdminThriftHandler) GetGlobalIsolationGroups(ctx context.Context, request *admin.GetGlobalIsolationGroupsRequest) (*admin.GetGlobalIsolationGroupsResponse, error) {
 	res, err := t.h.GetGlobalIsolationGroups(ctx, thrift.ToGetGlobalIsolationGroupsRequest(request))
 	return thrift.FromGetGlobalIsolationGroupsResponse(res), thrift.FromError(err)
@@ -129,6 +129,22 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.RefreshWorkflowTasks(ctx, &shared.RefreshWorkflowTasksRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RestoreArchivedWorkflow", func(t *testing.T) {
+		h.EXPECT().RestoreArchivedWorkflow(ctx, &types.RestoreArchivedWorkflowRequest{}).Return(internalErr).Times(1)
+		err := th.RestoreArchivedWorkflow(ctx, &admin.RestoreArchivedWorkflowRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ListDynamicConfigHistory", func(t *testing.T) {
+		h.EXPECT().ListDynamicConfigHistory(ctx, &types.ListDynamicConfigHistoryRequest{}).Return(&types.ListDynamicConfigHistoryResponse{}, internalErr).Times(1)
+		resp, err := th.ListDynamicConfigHistory(ctx, &admin.ListDynamicConfigHistoryRequest{})
+		assert.Equal(t, admin.ListDynamicConfigHistoryResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RollbackDynamicConfig", func(t *testing.T) {
+		h.EXPECT().RollbackDynamicConfig(ctx, &types.RollbackDynamicConfigRequest{}).Return(internalErr).Times(1)
+		err := th.RollbackDynamicConfig(ctx, &admin.RollbackDynamicConfigRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RemoveTask", func(t *testing.T) {
 		h.EXPECT().RemoveTask(ctx, &types.RemoveTaskRequest{}).Return(internalErr).Times(1)
 		err := th.RemoveTask(ctx, &shared.RemoveTaskRequest{})
@@ -27,9 +27,11 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 )
@@ -57,9 +59,14 @@ type Config struct {
 	MaxDomainUserRPSPerInstance       dynamicconfig.IntPropertyFnWithDomainFilter
 	MaxDomainWorkerRPSPerInstance     dynamicconfig.IntPropertyFnWithDomainFilter
 	MaxDomainVisibilityRPSPerInstance dynamicconfig.IntPropertyFnWithDomainFilter
+	MaxDomainCallerRPSPerInstance     dynamicconfig.IntPropertyFnWithCallerIdentityFilter
+	MaxDomainAPIRPSPerInstance        dynamicconfig.IntPropertyFnWithRPCMethodFilter
+	MaxDomainCallerTypeRPSPerInstance dynamicconfig.MapPropertyFnWithDomainFilter
 	GlobalDomainUserRPS               dynamicconfig.IntPropertyFnWithDomainFilter
 	GlobalDomainWorkerRPS             dynamicconfig.IntPropertyFnWithDomainFilter
 	GlobalDomainVisibilityRPS         dynamicconfig.IntPropertyFnWithDomainFilter
+	EnableGlobalRatelimiter           dynamicconfig.BoolPropertyFn
+	GlobalRatelimiterUpdateInterval   dynamicconfig.DurationPropertyFn
 	EnableClientVersionCheck          dynamicconfig.BoolPropertyFn
 	EnableQueryAttributeValidation    dynamicconfig.BoolPropertyFn
 	DisallowQuery                     dynamicconfig.BoolPropertyFnWithDomainFilter
@@ -69,6 +76,9 @@ type Config struct {
 	// isolation configuration
 	EnableTasklistIsolation dynamicconfig.BoolPropertyFnWithDomainFilter
 
+	// fairness configuration
+	FairnessKeySearchAttribute dynamicconfig.StringPropertyFnWithDomainFilter
+
 	// id length limits
 	MaxIDLengthWarnLimit  dynamicconfig.IntPropertyFn
 	DomainNameMaxLength   dynamicconfig.IntPropertyFnWithDomainFilter
@@ -143,9 +153,14 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		MaxDomainUserRPSPerInstance:                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxDomainUserRPSPerInstance),
 		MaxDomainWorkerRPSPerInstance:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxDomainWorkerRPSPerInstance),
 		MaxDomainVisibilityRPSPerInstance:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxDomainVisibilityRPSPerInstance),
+		MaxDomainCallerRPSPerInstance:               dc.GetIntPropertyFilteredByCallerIdentity(dynamicconfig.FrontendMaxDomainCallerRPSPerInstance),
+		MaxDomainAPIRPSPerInstance:                  dc.GetIntPropertyFilteredByRPCMethod(dynamicconfig.FrontendMaxDomainAPIRPSPerInstance),
+		MaxDomainCallerTypeRPSPerInstance:           dc.GetMapPropertyFilteredByDomain(dynamicconfig.FrontendMaxDomainCallerTypeRPSPerInstance),
 		GlobalDomainUserRPS:                         dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendGlobalDomainUserRPS),
 		GlobalDomainWorkerRPS:                       dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendGlobalDomainWorkerRPS),
 		GlobalDomainVisibilityRPS:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendGlobalDomainVisibilityRPS),
+		EnableGlobalRatelimiter:                     dc.GetBoolProperty(dynamicconfig.FrontendEnableGlobalRatelimiter),
+		GlobalRatelimiterUpdateInterval:             dc.GetDurationProperty(dynamicconfig.GlobalRatelimiterUpdateInterval),
 		MaxIDLengthWarnLimit:                        dc.GetIntProperty(dynamicconfig.MaxIDLengthWarnLimit),
 		DomainNameMaxLength:                         dc.GetIntPropertyFilteredByDomain(dynamicconfig.DomainNameMaxLength),
 		IdentityMaxLength:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.IdentityMaxLength),
@@ -179,6 +194,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		EmitSignalNameMetricsTag:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEmitSignalNameMetricsTag),
 		Lockdown:                                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.Lockdown),
 		EnableTasklistIsolation:                     dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTasklistIsolation),
+		FairnessKeySearchAttribute:                  dc.GetStringPropertyFilteredByDomain(dynamicconfig.FrontendFairnessKeySearchAttribute),
 		domainConfig: domain.Config{
 			MaxBadBinaryCount:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxBadBinaries),
 			MinRetentionDays:       dc.GetIntProperty(dynamicconfig.MinRetentionDays),
@@ -208,6 +224,7 @@ type Service struct {
 	status       int32
 	handler      *WorkflowHandler
 	adminHandler AdminHandler
+	auditor      audit.Auditor
 	stopC        chan struct{}
 	config       *Config
 	params       *resource.Params
@@ -296,7 +313,13 @@ func (s *Service) Start() {
 		handler = NewClusterRedirectionHandler(handler, s, s.config, *s.params.ClusterRedirectionPolicy)
 	}
 
-	handler = NewAccessControlledHandlerImpl(handler, s, s.params.Authorizer, s.params.AuthorizationConfig)
+	// the auditor is shared by the frontend and admin handlers
+	auditor, err := audit.NewAuditor(s.params.AuthorizationConfig.Audit, s.GetMessagingClient(), s.GetMetricsClient(), logger)
+	if err != nil {
+		logger.Fatal("Error when initiating the Auditor", tag.Error(err))
+	}
+	s.auditor = auditor
+	handler = NewAccessControlledHandlerImpl(handler, s, s.params.Authorizer, s.auditor, s.params.AuthorizationConfig)
 
 	// Register the latest (most decorated) handler
 	thriftHandler := NewThriftHandler(handler)
@@ -306,7 +329,7 @@ func (s *Service) Start() {
 	grpcHandler.register(s.GetDispatcher())
 
 	s.adminHandler = NewAdminHandler(s, s.params, s.config, dh)
-	s.adminHandler = NewAccessControlledAdminHandlerImpl(s.adminHandler, s, s.params.Authorizer, s.params.AuthorizationConfig)
+	s.adminHandler = NewAccessControlledAdminHandlerImpl(s.adminHandler, s, s.params.Authorizer, s.auditor, s.params.AuthorizationConfig)
 
 	adminThriftHandler := NewAdminThriftHandler(s.adminHandler)
 	adminThriftHandler.register(s.GetDispatcher())
@@ -353,6 +376,7 @@ func (s *Service) Stop() {
 
 	s.GetLogger().Info("ShutdownHandler: Draining traffic")
 	time.Sleep(requestDrainTime)
+	s.auditor.Stop()
 
 	close(s.stopC)
 	s.Resource.Stop()
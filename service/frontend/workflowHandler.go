@@ -39,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -47,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/quotas/global"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
@@ -77,6 +79,14 @@ const (
 	ratelimitTypeVisibility
 )
 
+const (
+	// dimensionLimiterCacheSize bounds the limiters kept by a ratelimit dimension, so that callers
+	// sending random identities can only evict limiters and not grow the memory of the frontend
+	dimensionLimiterCacheSize = 10000
+	// dimensionLimiterTTL expires the limiters of keys which stopped sending requests
+	dimensionLimiterTTL = time.Hour
+)
+
 type (
 	// WorkflowHandler - Thrift handler interface for workflow service
 	WorkflowHandler struct {
@@ -85,6 +95,7 @@ type (
 		shuttingDown              int32
 		healthStatus              int32
 		tokenSerializer           common.TaskTokenSerializer
+		globalRatelimiters        *global.Collection
 		userRateLimiter           quotas.Policy
 		workerRateLimiter         quotas.Policy
 		visibilityRateLimiter     quotas.Policy
@@ -110,6 +121,10 @@ type (
 		GetDomain() string
 	}
 
+	identityGetter interface {
+		GetIdentity() string
+	}
+
 	// HealthStatus is an enum that refers to the rpc handler health status
 	HealthStatus int32
 )
@@ -122,6 +137,7 @@ var (
 	errTaskListTypeNotSet                         = &types.BadRequestError{Message: "TaskListType is not set on request."}
 	errExecutionNotSet                            = &types.BadRequestError{Message: "Execution is not set on request."}
 	errWorkflowIDNotSet                           = &types.BadRequestError{Message: "WorkflowId is not set on request."}
+	errRunIDNotSet                                = &types.BadRequestError{Message: "RunId is not set on request."}
 	errActivityIDNotSet                           = &types.BadRequestError{Message: "ActivityID is not set on request."}
 	errSignalNameNotSet                           = &types.BadRequestError{Message: "SignalName is not set on request."}
 	errInvalidRunID                               = &types.BadRequestError{Message: "Invalid RunId."}
@@ -167,43 +183,60 @@ func NewWorkflowHandler(
 	versionChecker client.VersionChecker,
 	domainHandler domain.Handler,
 ) *WorkflowHandler {
+	globalRatelimiters := global.NewCollection(
+		service.Frontend,
+		resource.GetHostInfo().Identity(),
+		resource.GetMembershipResolver(),
+		resource.GetHistoryClient(),
+		config.EnableGlobalRatelimiter,
+		config.GlobalRatelimiterUpdateInterval,
+		resource.GetTimeSource(),
+		resource.GetLogger(),
+	)
 	return &WorkflowHandler{
-		Resource:        resource,
-		config:          config,
-		healthStatus:    int32(HealthStatusWarmingUp),
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
-		userRateLimiter: quotas.NewMultiStageRateLimiter(
-			quotas.NewDynamicRateLimiter(config.UserRPS.AsFloat64()),
-			quotas.NewCollection(func(domain string) quotas.Limiter {
-				return quotas.NewDynamicRateLimiter(quotas.PerMemberDynamic(
-					service.Frontend,
-					config.GlobalDomainUserRPS.AsFloat64(domain),
-					config.MaxDomainUserRPSPerInstance.AsFloat64(domain),
-					resource.GetMembershipResolver(),
-				))
-			}),
+		Resource:           resource,
+		config:             config,
+		healthStatus:       int32(HealthStatusWarmingUp),
+		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
+		globalRatelimiters: globalRatelimiters,
+		userRateLimiter: newDimensionalRateLimiter(
+			quotas.NewMultiStageRateLimiter(
+				quotas.NewDynamicRateLimiter(config.UserRPS.AsFloat64()),
+				quotas.NewCollection(func(domain string) quotas.Limiter {
+					return globalRatelimiters.For(
+						"user:"+domain,
+						config.GlobalDomainUserRPS.AsFloat64(domain),
+						config.MaxDomainUserRPSPerInstance.AsFloat64(domain),
+					)
+				}),
+			),
+			config,
 		),
-		workerRateLimiter: quotas.NewMultiStageRateLimiter(
-			quotas.NewDynamicRateLimiter(config.WorkerRPS.AsFloat64()),
-			quotas.NewCollection(func(domain string) quotas.Limiter {
-				return quotas.NewDynamicRateLimiter(quotas.PerMemberDynamic(
-					service.Frontend,
-					config.GlobalDomainWorkerRPS.AsFloat64(domain),
-					config.MaxDomainWorkerRPSPerInstance.AsFloat64(domain),
-					resource.GetMembershipResolver(),
-				))
-			}),
+		workerRateLimiter: newDimensionalRateLimiter(
+			quotas.NewMultiStageRateLimiter(
+				quotas.NewDynamicRateLimiter(config.WorkerRPS.AsFloat64()),
+				quotas.NewCollection(func(domain string) quotas.Limiter {
+					return globalRatelimiters.For(
+						"worker:"+domain,
+						config.GlobalDomainWorkerRPS.AsFloat64(domain),
+						config.MaxDomainWorkerRPSPerInstance.AsFloat64(domain),
+					)
+				}),
+			),
+			config,
 		),
-		visibilityRateLimiter: quotas.NewMultiStageRateLimiter(
-			quotas.NewDynamicRateLimiter(config.VisibilityRPS.AsFloat64()),
-			quotas.NewCollection(func(domain string) quotas.Limiter {
-				return quotas.NewDynamicRateLimiter(quotas.PerMemberDynamic(
-					service.Frontend,
-					config.GlobalDomainVisibilityRPS.AsFloat64(domain),
-					config.MaxDomainVisibilityRPSPerInstance.AsFloat64(domain),
-					resource.GetMembershipResolver(),
-				))
-			}),
+		visibilityRateLimiter: newDimensionalRateLimiter(
+			quotas.NewMultiStageRateLimiter(
+				quotas.NewDynamicRateLimiter(config.VisibilityRPS.AsFloat64()),
+				quotas.NewCollection(func(domain string) quotas.Limiter {
+					return globalRatelimiters.For(
+						"visibility:"+domain,
+						config.GlobalDomainVisibilityRPS.AsFloat64(domain),
+						config.MaxDomainVisibilityRPSPerInstance.AsFloat64(domain),
+					)
+				}),
+			),
+			config,
 		),
 		versionChecker: versionChecker,
 		domainHandler:  domainHandler,
@@ -242,11 +275,14 @@ func (wh *WorkflowHandler) Start() {
 			wh.GetLogger().Warn(fmt.Sprintf("Warmup time has elapsed. Service status is: %v", status.String()))
 		}
 	}()
+
+	wh.globalRatelimiters.Start()
 }
 
 // Stop stops the handler
 func (wh *WorkflowHandler) Stop() {
 	atomic.StoreInt32(&wh.shuttingDown, 1)
+	wh.globalRatelimiters.Stop()
 }
 
 // UpdateHealthStatus sets the health status for this rpc handler.
@@ -591,7 +627,7 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wh.error(errIdentityTooLong, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeWorker, pollRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeWorker, "PollForActivityTask", pollRequest); !ok {
 		// pollers exponentially back off up to 10s
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
@@ -725,7 +761,7 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wh.error(err, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeWorker, pollRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeWorker, "PollForDecisionTask", pollRequest); !ok {
 		// pollers exponentially back off up to 10s
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
@@ -801,6 +837,27 @@ func (wh *WorkflowHandler) getPartitionConfig(ctx context.Context, domainName st
 	return nil
 }
 
+// getStartPartitionConfig returns the partition config of a workflow being started, which also
+// carries the fairness key that matching uses to share task lists between workflows
+func (wh *WorkflowHandler) getStartPartitionConfig(
+	ctx context.Context,
+	domainName string,
+	header *types.Header,
+	searchAttributes *types.SearchAttributes,
+) map[string]string {
+	partitionConfig := wh.getPartitionConfig(ctx, domainName)
+	fairnessKey := common.GetFairnessKey(header, searchAttributes, wh.config.FairnessKeySearchAttribute(domainName))
+	if fairnessKey == "" {
+		return partitionConfig
+	}
+	result := make(map[string]string, len(partitionConfig)+1)
+	for k, v := range partitionConfig {
+		result[k] = v
+	}
+	result[partition.FairnessKey] = fairnessKey
+	return result
+}
+
 func (wh *WorkflowHandler) isIsolationGroupHealthy(ctx context.Context, domainName, isolationGroup string) bool {
 	if wh.GetIsolationGroupState() != nil && wh.config.EnableTasklistIsolation(domainName) {
 		isDrained, err := wh.GetIsolationGroupState().IsDrained(ctx, domainName, isolationGroup)
@@ -927,7 +984,7 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, dw)
+	wh.allow(ctx, ratelimitTypeWorker, "RecordActivityTaskHeartbeat", dw)
 
 	tags := getDomainWfIDRunIDTags(domainName, &types.WorkflowExecution{
 		WorkflowID: taskToken.WorkflowID,
@@ -1010,7 +1067,7 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, heartbeatRequest)
+	wh.allow(ctx, ratelimitTypeWorker, "RecordActivityTaskHeartbeatByID", heartbeatRequest)
 
 	wh.GetLogger().Debug("Received RecordActivityTaskHeartbeatByID")
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
@@ -1140,7 +1197,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, dw)
+	wh.allow(ctx, ratelimitTypeWorker, "RespondActivityTaskCompleted", dw)
 
 	tags := getDomainWfIDRunIDTags(domainName, &types.WorkflowExecution{
 		WorkflowID: taskToken.WorkflowID,
@@ -1234,7 +1291,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, completeRequest)
+	wh.allow(ctx, ratelimitTypeWorker, "RespondActivityTaskCompletedByID", completeRequest)
 
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
@@ -1374,7 +1431,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, dw)
+	wh.allow(ctx, ratelimitTypeWorker, "RespondActivityTaskFailed", dw)
 
 	tags := getDomainWfIDRunIDTags(domainName, &types.WorkflowExecution{
 		WorkflowID: taskToken.WorkflowID,
@@ -1456,7 +1513,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, failedRequest)
+	wh.allow(ctx, ratelimitTypeWorker, "RespondActivityTaskFailedByID", failedRequest)
 
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
@@ -1587,7 +1644,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, dw)
+	wh.allow(ctx, ratelimitTypeWorker, "RespondActivityTaskCanceled", dw)
 
 	tags := getDomainWfIDRunIDTags(domainName, &types.WorkflowExecution{
 		WorkflowID: taskToken.WorkflowID,
@@ -1681,7 +1738,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, cancelRequest)
+	wh.allow(ctx, ratelimitTypeWorker, "RespondActivityTaskCanceledByID", cancelRequest)
 
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
@@ -1821,7 +1878,7 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, dw)
+	wh.allow(ctx, ratelimitTypeWorker, "RespondDecisionTaskCompleted", dw)
 
 	tags := getDomainWfIDRunIDTags(domainName, &types.WorkflowExecution{
 		WorkflowID: taskToken.WorkflowID,
@@ -1931,7 +1988,7 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, dw)
+	wh.allow(ctx, ratelimitTypeWorker, "RespondDecisionTaskFailed", dw)
 
 	tags := getDomainWfIDRunIDTags(domainName, &types.WorkflowExecution{
 		WorkflowID: taskToken.WorkflowID,
@@ -2027,7 +2084,7 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, dw)
+	wh.allow(ctx, ratelimitTypeWorker, "RespondQueryTaskCompleted", dw)
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
@@ -2114,7 +2171,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, startRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "StartWorkflowExecution", startRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -2255,7 +2312,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 
 	wh.GetLogger().Debug("Start workflow execution request domainID", tag.WorkflowDomainID(domainID))
 	historyRequest, err := common.CreateHistoryStartWorkflowRequest(
-		domainID, startRequest, time.Now(), wh.getPartitionConfig(ctx, domainName))
+		domainID, startRequest, time.Now(), wh.getStartPartitionConfig(ctx, domainName, startRequest.Header, startRequest.SearchAttributes))
 	if err != nil {
 		return nil, err
 	}
@@ -2297,7 +2354,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, getRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "GetWorkflowExecutionHistory", getRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -2572,7 +2629,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, signalRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "SignalWorkflowExecution", signalRequest); !ok {
 		return wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -2694,7 +2751,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, signalWithStartRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "SignalWithStartWorkflowExecution", signalWithStartRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -2840,7 +2897,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 	resp, err = wh.GetHistoryClient().SignalWithStartWorkflowExecution(ctx, &types.HistorySignalWithStartWorkflowExecutionRequest{
 		DomainUUID:             domainID,
 		SignalWithStartRequest: signalWithStartRequest,
-		PartitionConfig:        wh.getPartitionConfig(ctx, domainName),
+		PartitionConfig:        wh.getStartPartitionConfig(ctx, domainName, signalWithStartRequest.Header, signalWithStartRequest.SearchAttributes),
 	})
 	if err != nil {
 		return nil, wh.error(err, scope, tags...)
@@ -2880,7 +2937,7 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 		return wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, terminateRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "TerminateWorkflowExecution", terminateRequest); !ok {
 		return wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -2935,7 +2992,7 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, resetRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "ResetWorkflowExecution", resetRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -2989,7 +3046,7 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, cancelRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "RequestCancelWorkflowExecution", cancelRequest); !ok {
 		return wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -3039,7 +3096,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeVisibility, listRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeVisibility, "ListOpenWorkflowExecutions", listRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -3156,7 +3213,7 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeVisibility, listRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeVisibility, "ListArchivedWorkflowExecutions", listRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -3248,7 +3305,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeVisibility, listRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeVisibility, "ListClosedWorkflowExecutions", listRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -3388,7 +3445,7 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeVisibility, listRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeVisibility, "ListWorkflowExecutions", listRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -3457,7 +3514,7 @@ func (wh *WorkflowHandler) RestartWorkflowExecution(ctx context.Context, request
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, request); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "RestartWorkflowExecution", request); !ok {
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -3488,7 +3545,7 @@ func (wh *WorkflowHandler) RestartWorkflowExecution(ctx context.Context, request
 	}
 	startRequest := constructRestartWorkflowRequest(history.History.Events[0].WorkflowExecutionStartedEventAttributes,
 		domainName, request.Identity, wfExecution.WorkflowID)
-	req, err := common.CreateHistoryStartWorkflowRequest(domainID, startRequest, time.Now(), wh.getPartitionConfig(ctx, domainName))
+	req, err := common.CreateHistoryStartWorkflowRequest(domainID, startRequest, time.Now(), wh.getStartPartitionConfig(ctx, domainName, startRequest.Header, startRequest.SearchAttributes))
 	if err != nil {
 		return nil, err
 	}
@@ -3529,7 +3586,7 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, listRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "ScanWorkflowExecutions", listRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -3597,7 +3654,7 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, countRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "CountWorkflowExecutions", countRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -3682,7 +3739,7 @@ func (wh *WorkflowHandler) ResetStickyTaskList(
 
 	// Count the request in the host RPS,
 	// but we still accept it even if RPS is exceeded
-	wh.allow(ratelimitTypeWorker, resetRequest)
+	wh.allow(ctx, ratelimitTypeWorker, "ResetStickyTaskList", resetRequest)
 
 	if err := validateExecution(wfExecution); err != nil {
 		return nil, wh.error(err, scope, tags...)
@@ -3733,7 +3790,7 @@ func (wh *WorkflowHandler) QueryWorkflow(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, queryRequest); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "QueryWorkflow", queryRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -3815,7 +3872,7 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, request); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "DescribeWorkflowExecution", request); !ok {
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
 
@@ -3868,7 +3925,7 @@ func (wh *WorkflowHandler) DescribeTaskList(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, request); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "DescribeTaskList", request); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -3918,7 +3975,7 @@ func (wh *WorkflowHandler) ListTaskListPartitions(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, request); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "ListTaskListPartitions", request); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -3955,7 +4012,7 @@ func (wh *WorkflowHandler) GetTaskListsByDomain(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	if ok := wh.allow(ratelimitTypeUser, request); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "GetTaskListsByDomain", request); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -4584,24 +4641,92 @@ func (wh *WorkflowHandler) isListRequestPageSizeTooLarge(pageSize int32, domain
 		pageSize > int32(wh.config.ESIndexMaxResultWindow())
 }
 
-func (wh *WorkflowHandler) allow(requestType ratelimitType, d domainGetter) bool {
-	domain := ""
+func (wh *WorkflowHandler) allow(
+	ctx context.Context,
+	requestType ratelimitType,
+	api string,
+	d domainGetter,
+) bool {
+	info := quotas.Info{
+		API:        api,
+		CallerType: yarpc.CallFromContext(ctx).Header(common.ClientImplHeaderName),
+	}
 	if d != nil {
-		domain = d.GetDomain()
+		info.Domain = d.GetDomain()
+	}
+	if i, ok := d.(identityGetter); ok {
+		info.Caller = i.GetIdentity()
 	}
 	switch requestType {
 	case ratelimitTypeUser:
-		return wh.userRateLimiter.Allow(quotas.Info{Domain: domain})
+		return wh.userRateLimiter.Allow(info)
 	case ratelimitTypeWorker:
-		return wh.workerRateLimiter.Allow(quotas.Info{Domain: domain})
+		return wh.workerRateLimiter.Allow(info)
 	case ratelimitTypeVisibility:
-		return wh.visibilityRateLimiter.Allow(quotas.Info{Domain: domain})
+		return wh.visibilityRateLimiter.Allow(info)
 	default:
 		wh.GetLogger().Fatal("coding error, unrecognized request ratelimit type value", tag.Value(requestType))
 		panic("unreachable")
 	}
 }
 
+// newDimensionalRateLimiter wraps given policy with per-API, per-caller-type and per-caller limits of a domain.
+// Every limiter wrapped this way has its own dimensions, so user, worker and visibility requests keep separate budgets.
+func newDimensionalRateLimiter(policy quotas.Policy, config *Config) quotas.Policy {
+	apiRPS := func(info quotas.Info) float64 {
+		return float64(config.MaxDomainAPIRPSPerInstance(info.Domain, info.API))
+	}
+	callerTypeRPS := func(info quotas.Info) float64 {
+		return rpsFromMap(config.MaxDomainCallerTypeRPSPerInstance(info.Domain), info.CallerType)
+	}
+	callerRPS := func(info quotas.Info) float64 {
+		return float64(config.MaxDomainCallerRPSPerInstance(info.Domain, info.Caller))
+	}
+	return quotas.NewDimensionalRateLimiter(
+		policy,
+		newDimension(func(info quotas.Info) string { return info.API }, apiRPS),
+		newDimension(func(info quotas.Info) string { return info.CallerType }, callerTypeRPS),
+		newDimension(func(info quotas.Info) string { return info.Caller }, callerRPS),
+	)
+}
+
+// newDimension creates a dimension keyed by domain and given value.
+// Requests with an empty value or without a configured limit are left to the other limits,
+// so that the dimension only keeps limiters for the keys that are actually limited.
+// The values are reported by the callers and not authenticated, a caller changing its identity
+// gets a new budget, so these limits protect against misbehaving callers and not malicious ones.
+func newDimension(value func(quotas.Info) string, rps func(quotas.Info) float64) *quotas.Dimension {
+	return quotas.NewDimension(
+		func(info quotas.Info) string {
+			if value(info) == "" || rps(info) >= dynamicconfig.UnlimitedRPS {
+				return ""
+			}
+			return info.Domain + "/" + value(info)
+		},
+		func(info quotas.Info) quotas.Limiter {
+			return quotas.NewDynamicRateLimiter(func() float64 {
+				return rps(info)
+			})
+		},
+		cache.New(&cache.Options{
+			InitialCapacity: 32,
+			MaxCount:        dimensionLimiterCacheSize,
+			TTL:             dimensionLimiterTTL,
+		}),
+	)
+}
+
+func rpsFromMap(rpsByKey map[string]interface{}, key string) float64 {
+	switch rps := rpsByKey[key].(type) {
+	case int:
+		return float64(rps)
+	case float64:
+		return rps
+	default:
+		return dynamicconfig.UnlimitedRPS
+	}
+}
+
 // GetClusterInfo return information about cadence deployment
 func (wh *WorkflowHandler) GetClusterInfo(
 	ctx context.Context,
@@ -4609,7 +4734,7 @@ func (wh *WorkflowHandler) GetClusterInfo(
 	defer func() { log.CapturePanic(recover(), wh.GetLogger(), &err) }()
 
 	scope := wh.getDefaultScope(ctx, metrics.FrontendClientGetClusterInfoScope)
-	if ok := wh.allow(ratelimitTypeUser, nil); !ok {
+	if ok := wh.allow(ctx, ratelimitTypeUser, "GetClusterInfo", nil); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
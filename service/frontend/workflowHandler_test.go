@@ -216,6 +216,39 @@ func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
 	s.Equal(errNoPermission, err)
 }
 
+func (s *workflowHandlerSuite) TestAllow_DimensionalRateLimits() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.MaxDomainAPIRPSPerInstance = func(domain string, rpcMethod string) int {
+		if rpcMethod == "ListWorkflowExecutions" {
+			return 1
+		}
+		return dc.UnlimitedRPS
+	}
+	config.MaxDomainCallerRPSPerInstance = func(domain string, callerIdentity string) int {
+		if callerIdentity == "worker" {
+			return 1
+		}
+		return dc.UnlimitedRPS
+	}
+	wh := s.getWorkflowHandler(config)
+	ctx := context.Background()
+
+	listRequest := &types.ListWorkflowExecutionsRequest{Domain: s.testDomain}
+	s.True(wh.allow(ctx, ratelimitTypeVisibility, "ListWorkflowExecutions", listRequest))
+	s.False(wh.allow(ctx, ratelimitTypeVisibility, "ListWorkflowExecutions", listRequest))
+	// the limit of an API is looked up by its method name, other APIs of the domain are not limited by the exhausted API budget
+	s.True(wh.allow(ctx, ratelimitTypeVisibility, "ListOpenWorkflowExecutions", &types.ListOpenWorkflowExecutionsRequest{Domain: s.testDomain}))
+
+	// worker polls have their own caller budget
+	pollRequest := &types.PollForDecisionTaskRequest{Domain: s.testDomain, Identity: "worker"}
+	s.True(wh.allow(ctx, ratelimitTypeWorker, "PollForDecisionTask", pollRequest))
+	s.False(wh.allow(ctx, ratelimitTypeWorker, "PollForDecisionTask", pollRequest))
+	// the limit of a caller is looked up by its identity
+	anotherPollRequest := &types.PollForDecisionTaskRequest{Domain: s.testDomain, Identity: "another-worker"}
+	s.True(wh.allow(ctx, ratelimitTypeWorker, "PollForDecisionTask", anotherPollRequest))
+	s.True(wh.allow(ctx, ratelimitTypeWorker, "PollForDecisionTask", anotherPollRequest))
+}
+
 func (s *workflowHandlerSuite) TestPollForTask_Failed_ContextTimeoutTooShort() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	wh := s.getWorkflowHandler(config)
@@ -1699,6 +1732,29 @@ func (s *workflowHandlerSuite) TestSignalMetricHasSignalName() {
 	s.True(expectedMetrics["test.cadence_errors_bad_request"])
 }
 
+func (s *workflowHandlerSuite) TestGetStartPartitionConfig() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.EnableTasklistIsolation = dc.GetBoolPropertyFnFilteredByDomain(true)
+	config.FairnessKeySearchAttribute = dc.GetStringPropertyFnFilteredByDomain("Tenant")
+	wh := s.getWorkflowHandler(config)
+
+	isolationConfig := map[string]string{partition.IsolationGroupKey: "zone-1"}
+	ctx := partition.ContextWithConfig(context.Background(), isolationConfig)
+	s.Equal(isolationConfig, wh.getStartPartitionConfig(ctx, s.testDomain, nil, nil))
+
+	searchAttributes := &types.SearchAttributes{IndexedFields: map[string][]byte{"Tenant": []byte(`"tenant-a"`)}}
+	s.Equal(map[string]string{
+		partition.IsolationGroupKey: "zone-1",
+		partition.FairnessKey:       "tenant-a",
+	}, wh.getStartPartitionConfig(ctx, s.testDomain, nil, searchAttributes))
+	s.Equal(map[string]string{partition.IsolationGroupKey: "zone-1"}, isolationConfig, "partition config in context must not be modified")
+
+	header := &types.Header{Fields: map[string][]byte{common.FairnessKeyHeaderKey: []byte("tenant-b")}}
+	s.Equal(map[string]string{
+		partition.FairnessKey: "tenant-b",
+	}, wh.getStartPartitionConfig(context.Background(), s.testDomain, header, searchAttributes))
+}
+
 func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *Config {
 	config := NewConfig(
 		dc.NewCollection(
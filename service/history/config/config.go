@@ -306,10 +306,17 @@ type Config struct {
 	NotifyFailoverMarkerTimerJitterCoefficient dynamicconfig.FloatPropertyFn
 	EnableGracefulFailover                     dynamicconfig.BoolPropertyFn
 
+	// Global ratelimiter aggregation
+	GlobalRatelimiterUpdateInterval dynamicconfig.DurationPropertyFn
+
 	// Allows worker to dispatch activity tasks through local tunnel after decisions are made. This is an performance optimization to skip activity scheduling efforts.
 	EnableActivityLocalDispatchByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 	// Max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.
 	MaxActivityCountDispatchByDomain dynamicconfig.IntPropertyFnWithDomainFilter
+	// Sets the priority from workflow and activity headers on the tasks pushed to matching
+	EnableTaskPriority dynamicconfig.BoolPropertyFnWithDomainFilter
+	// Sends the activity type on the activity tasks pushed to matching for per activity type dispatch limits
+	SendActivityTypeToMatching dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	ActivityMaxScheduleToStartTimeoutForRetry dynamicconfig.DurationPropertyFnWithDomainFilter
 
@@ -555,8 +562,12 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		NotifyFailoverMarkerTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.NotifyFailoverMarkerTimerJitterCoefficient),
 		EnableGracefulFailover:                     dc.GetBoolProperty(dynamicconfig.EnableGracefulFailover),
 
+		GlobalRatelimiterUpdateInterval: dc.GetDurationProperty(dynamicconfig.GlobalRatelimiterUpdateInterval),
+
 		EnableActivityLocalDispatchByDomain: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityLocalDispatchByDomain),
 		MaxActivityCountDispatchByDomain:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxActivityCountDispatchByDomain),
+		EnableTaskPriority:                  dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTaskPriority),
+		SendActivityTypeToMatching:          dc.GetBoolPropertyFilteredByDomain(dynamicconfig.SendActivityTypeToMatching),
 
 		ActivityMaxScheduleToStartTimeoutForRetry: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxScheduleToStartTimeoutForRetry),
 
@@ -630,6 +630,20 @@ func (mr *MockEngineMockRecorder) RespondDecisionTaskFailed(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDecisionTaskFailed", reflect.TypeOf((*MockEngine)(nil).RespondDecisionTaskFailed), ctx, request)
 }
 
+// RestoreArchivedWorkflow mocks base method.
+func (m *MockEngine) RestoreArchivedWorkflow(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflow", ctx, domainUUID, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreArchivedWorkflow indicates an expected call of RestoreArchivedWorkflow.
+func (mr *MockEngineMockRecorder) RestoreArchivedWorkflow(ctx, domainUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflow", reflect.TypeOf((*MockEngine)(nil).RestoreArchivedWorkflow), ctx, domainUUID, execution)
+}
+
 // ScheduleDecisionTask mocks base method.
 func (m *MockEngine) ScheduleDecisionTask(ctx context.Context, request *types.ScheduleDecisionTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -120,11 +120,14 @@ type (
 		GetActivityByActivityID(string) (*persistence.ActivityInfo, bool)
 		GetActivityInfo(int64) (*persistence.ActivityInfo, bool)
 		GetActivityScheduledEvent(context.Context, int64) (*types.HistoryEvent, error)
+		GetActivityTaskPriority(context.Context, int64) (int32, error)
+		GetActivityTypeForMatching(context.Context, int64) (*types.ActivityType, error)
 		GetChildExecutionInfo(int64) (*persistence.ChildExecutionInfo, bool)
 		GetChildExecutionInitiatedEvent(context.Context, int64) (*types.HistoryEvent, error)
 		GetCompletionEvent(context.Context) (*types.HistoryEvent, error)
 		GetDecisionInfo(int64) (*DecisionInfo, bool)
 		GetDecisionScheduleToStartTimeout() time.Duration
+		GetDecisionTaskPriority(context.Context) (int32, error)
 		GetDomainEntry() *cache.DomainCacheEntry
 		GetStartEvent(context.Context) (*types.HistoryEvent, error)
 		GetCurrentBranchToken() ([]byte, error)
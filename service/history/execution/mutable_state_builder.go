@@ -1205,6 +1205,74 @@ func (e *mutableStateBuilder) GetStartEvent(
 	return startEvent, nil
 }
 
+// GetDecisionTaskPriority returns the dispatch priority of decision tasks, which is set by the
+// priority header of the workflow. Zero is returned if task priority is disabled for the domain
+func (e *mutableStateBuilder) GetDecisionTaskPriority(
+	ctx context.Context,
+) (int32, error) {
+
+	if !e.config.EnableTaskPriority(e.GetDomainEntry().GetInfo().Name) {
+		return 0, nil
+	}
+	startEvent, err := e.GetStartEvent(ctx)
+	if err != nil {
+		return 0, err
+	}
+	attributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
+	if attributes == nil {
+		return 0, nil
+	}
+	priority, _ := common.GetTaskPriorityFromHeader(attributes.Header)
+	return priority, nil
+}
+
+// GetActivityTaskPriority returns the dispatch priority of the given activity task, which is set by the
+// priority header of the activity. Activities without a priority inherit the priority of the workflow
+func (e *mutableStateBuilder) GetActivityTaskPriority(
+	ctx context.Context,
+	scheduleEventID int64,
+) (int32, error) {
+
+	if !e.config.EnableTaskPriority(e.GetDomainEntry().GetInfo().Name) {
+		return 0, nil
+	}
+	scheduledEvent, err := e.GetActivityScheduledEvent(ctx, scheduleEventID)
+	if err != nil {
+		return 0, err
+	}
+	return e.getActivityTaskPriority(ctx, scheduledEvent)
+}
+
+func (e *mutableStateBuilder) getActivityTaskPriority(
+	ctx context.Context,
+	scheduledEvent *types.HistoryEvent,
+) (int32, error) {
+
+	if attributes := scheduledEvent.GetActivityTaskScheduledEventAttributes(); attributes != nil {
+		if priority, ok := common.GetTaskPriorityFromHeader(attributes.Header); ok {
+			return priority, nil
+		}
+	}
+	return e.GetDecisionTaskPriority(ctx)
+}
+
+// GetActivityTypeForMatching returns the activity type of the given activity task, which is used by matching
+// to enforce per activity type dispatch limits. Nil is returned if sending the activity type is disabled for the domain
+func (e *mutableStateBuilder) GetActivityTypeForMatching(
+	ctx context.Context,
+	scheduleEventID int64,
+) (*types.ActivityType, error) {
+
+	if !e.config.SendActivityTypeToMatching(e.GetDomainEntry().GetInfo().Name) {
+		return nil, nil
+	}
+	scheduledEvent, err := e.GetActivityScheduledEvent(ctx, scheduleEventID)
+	if err != nil {
+		return nil, err
+	}
+	return scheduledEvent.GetActivityTaskScheduledEventAttributes().GetActivityType(), nil
+}
+
 // DeletePendingChildExecution deletes details about a ChildExecutionInfo.
 func (e *mutableStateBuilder) DeletePendingChildExecution(
 	initiatedEventID int64,
@@ -2218,6 +2286,18 @@ func (e *mutableStateBuilder) tryDispatchActivityTask(
 		metrics.WorkflowTypeTag(e.GetWorkflowType().Name),
 		metrics.TaskListTag(ai.TaskList))
 	taggedScope.IncCounter(metrics.DecisionTypeScheduleActivityDispatchCounter)
+	var priority int32
+	if e.config.EnableTaskPriority(e.domainEntry.GetInfo().Name) {
+		var err error
+		if priority, err = e.getActivityTaskPriority(ctx, scheduledEvent); err != nil {
+			// fallback to the transfer task which retries on failure
+			return false
+		}
+	}
+	var activityType *types.ActivityType
+	if e.config.SendActivityTypeToMatching(e.domainEntry.GetInfo().Name) {
+		activityType = scheduledEvent.GetActivityTaskScheduledEventAttributes().GetActivityType()
+	}
 	err := e.shard.GetService().GetMatchingClient().AddActivityTask(ctx, &types.AddActivityTaskRequest{
 		DomainUUID:       e.executionInfo.DomainID,
 		SourceDomainUUID: e.domainEntry.GetInfo().ID,
@@ -2236,6 +2316,8 @@ func (e *mutableStateBuilder) tryDispatchActivityTask(
 			ScheduledTimestampOfThisAttempt: common.Int64Ptr(ai.ScheduledTime.UnixNano()),
 		},
 		PartitionConfig: e.executionInfo.PartitionConfig,
+		Priority:        priority,
+		ActivityType:    activityType,
 	})
 	if err == nil {
 		taggedScope.IncCounter(metrics.DecisionTypeScheduleActivityDispatchSucceedCounter)
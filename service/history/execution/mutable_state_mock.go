@@ -1097,6 +1097,36 @@ func (mr *MockMutableStateMockRecorder) GetActivityScheduledEvent(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityScheduledEvent", reflect.TypeOf((*MockMutableState)(nil).GetActivityScheduledEvent), arg0, arg1)
 }
 
+// GetActivityTaskPriority mocks base method.
+func (m *MockMutableState) GetActivityTaskPriority(arg0 context.Context, arg1 int64) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityTaskPriority", arg0, arg1)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityTaskPriority indicates an expected call of GetActivityTaskPriority.
+func (mr *MockMutableStateMockRecorder) GetActivityTaskPriority(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityTaskPriority", reflect.TypeOf((*MockMutableState)(nil).GetActivityTaskPriority), arg0, arg1)
+}
+
+// GetActivityTypeForMatching mocks base method.
+func (m *MockMutableState) GetActivityTypeForMatching(arg0 context.Context, arg1 int64) (*types.ActivityType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityTypeForMatching", arg0, arg1)
+	ret0, _ := ret[0].(*types.ActivityType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityTypeForMatching indicates an expected call of GetActivityTypeForMatching.
+func (mr *MockMutableStateMockRecorder) GetActivityTypeForMatching(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityTypeForMatching", reflect.TypeOf((*MockMutableState)(nil).GetActivityTypeForMatching), arg0, arg1)
+}
+
 // GetChildExecutionInfo mocks base method.
 func (m *MockMutableState) GetChildExecutionInfo(arg0 int64) (*persistence.ChildExecutionInfo, bool) {
 	m.ctrl.T.Helper()
@@ -1229,6 +1259,21 @@ func (mr *MockMutableStateMockRecorder) GetDecisionScheduleToStartTimeout() *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecisionScheduleToStartTimeout", reflect.TypeOf((*MockMutableState)(nil).GetDecisionScheduleToStartTimeout))
 }
 
+// GetDecisionTaskPriority mocks base method.
+func (m *MockMutableState) GetDecisionTaskPriority(arg0 context.Context) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecisionTaskPriority", arg0)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecisionTaskPriority indicates an expected call of GetDecisionTaskPriority.
+func (mr *MockMutableStateMockRecorder) GetDecisionTaskPriority(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecisionTaskPriority", reflect.TypeOf((*MockMutableState)(nil).GetDecisionTaskPriority), arg0)
+}
+
 // GetDomainEntry mocks base method.
 func (m *MockMutableState) GetDomainEntry() *cache.DomainCacheEntry {
 	m.ctrl.T.Helper()
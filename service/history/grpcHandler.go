@@ -133,6 +133,11 @@ func (g grpcHandler) ReadDLQMessages(ctx context.Context, request *historyv1.Rea
 	return proto.FromHistoryReadDLQMessagesResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) RatelimitUpdate(ctx context.Context, request *historyv1.RatelimitUpdateRequest) (*historyv1.RatelimitUpdateResponse, error) {
+	response, err := g.h.RatelimitUpdate(ctx, proto.ToHistoryRatelimitUpdateRequest(request))
+	return proto.FromHistoryRatelimitUpdateResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) ReapplyEvents(ctx context.Context, request *historyv1.ReapplyEventsRequest) (*historyv1.ReapplyEventsResponse, error) {
 	err := g.h.ReapplyEvents(ctx, proto.ToHistoryReapplyEventsRequest(request))
 	return &historyv1.ReapplyEventsResponse{}, proto.FromError(err)
@@ -163,6 +168,11 @@ func (g grpcHandler) RefreshWorkflowTasks(ctx context.Context, request *historyv
 	return &historyv1.RefreshWorkflowTasksResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) RestoreArchivedWorkflow(ctx context.Context, request *historyv1.RestoreArchivedWorkflowRequest) (*historyv1.RestoreArchivedWorkflowResponse, error) {
+	err := g.h.RestoreArchivedWorkflow(ctx, proto.ToHistoryRestoreArchivedWorkflowRequest(request))
+	return &historyv1.RestoreArchivedWorkflowResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) RemoveSignalMutableState(ctx context.Context, request *historyv1.RemoveSignalMutableStateRequest) (*historyv1.RemoveSignalMutableStateResponse, error) {
 	err := g.h.RemoveSignalMutableState(ctx, proto.ToHistoryRemoveSignalMutableStateRequest(request))
 	return &historyv1.RemoveSignalMutableStateResponse{}, proto.FromError(err)
@@ -34,6 +34,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/quotas/global"
 	"github.com/uber/cadence/common/types/mapper/proto"
 
 	"github.com/pborman/uuid"
@@ -90,6 +91,7 @@ type (
 		RecordChildExecutionCompleted(context.Context, *types.RecordChildExecutionCompletedRequest) error
 		RecordDecisionTaskStarted(context.Context, *types.RecordDecisionTaskStartedRequest) (*types.RecordDecisionTaskStartedResponse, error)
 		RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest) error
+		RestoreArchivedWorkflow(context.Context, *types.HistoryRestoreArchivedWorkflowRequest) error
 		RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest) error
 		RemoveTask(context.Context, *types.RemoveTaskRequest) error
 		ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request) error
@@ -111,6 +113,7 @@ type (
 		SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 		TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
 		GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
+		RatelimitUpdate(context.Context, *types.RatelimitUpdateRequest) (*types.RatelimitUpdateResponse, error)
 	}
 
 	// handlerImpl is an implementation for history service independent of wire protocol
@@ -128,6 +131,7 @@ type (
 		replicationTaskFetchers  replication.TaskFetchers
 		queueTaskProcessor       task.Processor
 		failoverCoordinator      failover.Coordinator
+		ratelimitAggregator      *global.Aggregator
 	}
 )
 
@@ -145,6 +149,7 @@ var (
 	errInvalidTaskType         = &types.BadRequestError{Message: "Invalid task type"}
 	errHistoryHostThrottle     = &types.ServiceBusyError{Message: "History host rps exceeded"}
 	errShuttingDown            = &types.InternalServiceError{Message: "Shutting down"}
+	errCallerNotSet            = &types.BadRequestError{Message: "Caller not set on request."}
 )
 
 // NewHandler creates a thrift handler for the history service
@@ -157,6 +162,10 @@ func NewHandler(
 		config:          config,
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		rateLimiter:     quotas.NewDynamicRateLimiter(config.RPS.AsFloat64()),
+		ratelimitAggregator: global.NewAggregator(
+			resource.GetTimeSource(),
+			config.GlobalRatelimiterUpdateInterval,
+		),
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -1945,6 +1954,43 @@ func (h *handlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// RestoreArchivedWorkflow re-creates the history and mutable state of an archived workflow
+func (h *handlerImpl) RestoreArchivedWorkflow(
+	ctx context.Context,
+	request *types.HistoryRestoreArchivedWorkflowRequest) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRestoreArchivedWorkflowScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+	execution := request.GetExecution()
+	if execution.GetWorkflowID() == "" || execution.GetRunID() == "" {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+
+	workflowID := execution.GetWorkflowID()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
+	if err := engine.RestoreArchivedWorkflow(ctx, domainID, *execution); err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // NotifyFailoverMarkers sends the failover markers to failover coordinator.
 // The coordinator decides when the failover finishes based on received failover marker.
 func (h *handlerImpl) NotifyFailoverMarkers(
@@ -2083,6 +2129,30 @@ func (h *handlerImpl) GetFailoverInfo(
 	return resp, nil
 }
 
+// RatelimitUpdate aggregates the usage of global rate limits reported by a host
+// and returns the share of each limit the host is allowed to use
+func (h *handlerImpl) RatelimitUpdate(
+	ctx context.Context,
+	request *types.RatelimitUpdateRequest,
+) (resp *types.RatelimitUpdateResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRatelimitUpdateScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if request.GetCaller() == "" {
+		return nil, h.error(errCallerNotSet, scope, "", "")
+	}
+
+	return &types.RatelimitUpdateResponse{
+		Weights: h.ratelimitAggregator.Update(request.GetCaller(), request.GetUsage()),
+	}, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -114,6 +114,19 @@ func (s *handlerSuite) TestGetCrossClusterTasks() {
 	}
 }
 
+func (s *handlerSuite) TestRatelimitUpdate() {
+	_, err := s.handler.RatelimitUpdate(context.Background(), &types.RatelimitUpdateRequest{})
+	s.IsType(&types.BadRequestError{}, err)
+
+	// the usage of other hosts is not known yet for a key the host just started to aggregate
+	response, err := s.handler.RatelimitUpdate(context.Background(), &types.RatelimitUpdateRequest{
+		Caller: "frontend-host",
+		Usage:  map[string]float64{"key": 10},
+	})
+	s.NoError(err)
+	s.Empty(response.GetWeights())
+}
+
 func (s *handlerSuite) TestRespondCrossClusterTaskCompleted_FetchNewTask() {
 	s.testRespondCrossClusterTaskCompleted(true)
 }
@@ -65,6 +65,7 @@ import (
 	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/history/reset"
+	"github.com/uber/cadence/service/history/restore"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 	"github.com/uber/cadence/service/history/workflow"
@@ -111,6 +112,7 @@ type (
 		config                     *config.Config
 		archivalClient             warchiver.Client
 		workflowResetter           reset.WorkflowResetter
+		workflowRestorer           restore.WorkflowRestorer
 		queueTaskProcessor         task.Processor
 		crossClusterTaskProcessors common.Daemon
 		replicationTaskProcessors  []replication.TaskProcessor
@@ -214,6 +216,11 @@ func NewEngineWithShardContext(
 			executionCache,
 			logger,
 		),
+		workflowRestorer: restore.NewWorkflowRestorer(
+			shard,
+			executionCache,
+			logger,
+		),
 		publicClient:           publicClient,
 		matchingClient:         matching,
 		rawMatchingClient:      rawMatchingClient,
@@ -3696,6 +3703,22 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+func (e *historyEngineImpl) RestoreArchivedWorkflow(
+	ctx context.Context,
+	domainUUID string,
+	workflowExecution types.WorkflowExecution,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainUUID)
+	if err != nil {
+		return err
+	}
+	if workflowExecution.GetRunID() == "" {
+		return &types.BadRequestError{Message: "RunID is required to restore an archived workflow."}
+	}
+	return e.workflowRestorer.RestoreWorkflow(ctx, domainEntry.GetInfo().ID, workflowExecution)
+}
+
 func (e *historyEngineImpl) GetCrossClusterTasks(
 	ctx context.Context,
 	targetCluster string,
@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/shard"
@@ -131,7 +132,7 @@ func (e *taskExecutorImpl) handleActivityTask(
 		LastWorkerIdentity: attr.LastWorkerIdentity,
 		VersionHistory:     attr.GetVersionHistory(),
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	ctx, cancel := context.WithTimeout(newReplicationContext(), replicationTimeout)
 	defer cancel()
 
 	var syncActivityAction func() error
@@ -213,7 +214,7 @@ func (e *taskExecutorImpl) handleHistoryReplicationTaskV2(
 		// new run events does not need version history since there is no prior events
 		NewRunEvents: attr.NewRunEvents,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	ctx, cancel := context.WithTimeout(newReplicationContext(), replicationTimeout)
 	defer cancel()
 
 	var historyReplicationAction func() error
@@ -312,3 +313,9 @@ func (e *taskExecutorImpl) convertRetryTaskV2Error(
 	retError, ok := err.(*types.RetryTaskV2Error)
 	return retError, ok
 }
+
+// newReplicationContext returns the root context of replication tasks, whose persistence calls are
+// shed before user facing calls when the database is degraded
+func newReplicationContext() context.Context {
+	return persistence.ContextWithCallerPriority(context.Background(), persistence.CallerPriorityLow)
+}
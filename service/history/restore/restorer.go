@@ -0,0 +1,435 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination restorer_mock.go
+
+package restore
+
+import (
+	"context"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/events"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+const (
+	archivedHistoryPageSize = 250
+)
+
+type (
+	// WorkflowRestorer recreates a closed workflow whose history has been archived
+	// and deleted after the domain retention period
+	WorkflowRestorer interface {
+		RestoreWorkflow(
+			ctx context.Context,
+			domainID string,
+			execution types.WorkflowExecution,
+		) error
+	}
+
+	workflowRestorerImpl struct {
+		shard             shard.Context
+		domainCache       cache.DomainCache
+		executionCache    *execution.Cache
+		archiverProvider  provider.ArchiverProvider
+		newStateRebuilder stateRebuilderProvider
+		logger            log.Logger
+	}
+
+	stateRebuilderProvider func() execution.StateRebuilder
+)
+
+var _ WorkflowRestorer = (*workflowRestorerImpl)(nil)
+
+// NewWorkflowRestorer creates a workflow restorer
+func NewWorkflowRestorer(
+	shard shard.Context,
+	executionCache *execution.Cache,
+	logger log.Logger,
+) WorkflowRestorer {
+	return &workflowRestorerImpl{
+		shard:            shard,
+		domainCache:      shard.GetDomainCache(),
+		executionCache:   executionCache,
+		archiverProvider: shard.GetService().GetArchiverProvider(),
+		newStateRebuilder: func() execution.StateRebuilder {
+			return execution.NewStateRebuilder(shard, logger)
+		},
+		logger: logger,
+	}
+}
+
+// RestoreWorkflow reads the archived history of a closed workflow, writes it back
+// to the history store and recreates the mutable state of the workflow.
+// The restored workflow is closed and will be deleted again once the domain
+// retention period, counted from the time of restore, has passed.
+func (r *workflowRestorerImpl) RestoreWorkflow(
+	ctx context.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+) (retError error) {
+
+	domainEntry, err := r.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return err
+	}
+
+	wfContext, release, err := r.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	_, err = wfContext.LoadWorkflowExecution(ctx)
+	switch err.(type) {
+	case nil:
+		return &types.BadRequestError{Message: "Workflow execution still exists and does not need to be restored."}
+	case *types.EntityNotExistsError:
+		// workflow has been deleted, proceed with restore
+	default:
+		return err
+	}
+	wfContext.Clear()
+
+	historyBatches, err := r.readArchivedHistory(ctx, domainEntry, workflowExecution)
+	if err != nil {
+		return err
+	}
+
+	branchToken, err := persistence.NewHistoryBranchToken(workflowExecution.GetRunID())
+	if err != nil {
+		return err
+	}
+	historySize, err := r.persistHistory(ctx, wfContext, domainID, workflowExecution, branchToken, historyBatches)
+	if err != nil {
+		return err
+	}
+
+	currentRunExists, err := r.currentRunExists(ctx, domainEntry, workflowExecution.GetWorkflowID())
+	if err != nil {
+		return err
+	}
+
+	// workflow can not be created in completed state, so the workflow is first
+	// created from its start batch and then moved to the closed state
+	startEvent := historyBatches[0].Events[0]
+	rebuildTime := time.Unix(0, startEvent.GetTimestamp())
+	startMutableState, err := r.createWorkflow(
+		ctx,
+		wfContext,
+		domainID,
+		workflowExecution,
+		branchToken,
+		historyBatches[0],
+		historySize,
+		rebuildTime,
+		currentRunExists,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := r.closeWorkflow(
+		ctx,
+		wfContext,
+		domainEntry,
+		workflowExecution,
+		branchToken,
+		historyBatches[len(historyBatches)-1],
+		startMutableState.GetNextEventID(),
+		rebuildTime,
+		currentRunExists,
+	); err != nil {
+		return err
+	}
+
+	r.logger.Info("Restored archived workflow.",
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(workflowExecution.GetWorkflowID()),
+		tag.WorkflowRunID(workflowExecution.GetRunID()),
+	)
+	return nil
+}
+
+func (r *workflowRestorerImpl) readArchivedHistory(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflowExecution types.WorkflowExecution,
+) ([]*types.History, error) {
+
+	URIString := domainEntry.GetConfig().HistoryArchivalURI
+	if URIString == "" {
+		return nil, &types.BadRequestError{Message: "Domain has no history archival URI configured."}
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := r.archiverProvider.GetHistoryArchiver(URI.Scheme(), service.History)
+	if err != nil {
+		return nil, err
+	}
+
+	var historyBatches []*types.History
+	request := &archiver.GetHistoryRequest{
+		DomainID:   domainEntry.GetInfo().ID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		PageSize:   archivedHistoryPageSize,
+	}
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, batch := range resp.HistoryBatches {
+			if len(batch.Events) > 0 {
+				historyBatches = append(historyBatches, batch)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	if len(historyBatches) == 0 {
+		return nil, &types.BadRequestError{Message: "Archived workflow history is empty."}
+	}
+	if historyBatches[0].Events[0].GetEventType() != types.EventTypeWorkflowExecutionStarted {
+		return nil, &types.BadRequestError{Message: "Archived workflow history does not begin with a workflow started event."}
+	}
+	lastBatch := historyBatches[len(historyBatches)-1]
+	if !isWorkflowCloseEvent(lastBatch.Events[len(lastBatch.Events)-1]) {
+		return nil, &types.BadRequestError{Message: "Archived workflow history does not end with a workflow close event."}
+	}
+	return historyBatches, nil
+}
+
+func (r *workflowRestorerImpl) persistHistory(
+	ctx context.Context,
+	wfContext execution.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+	branchToken []byte,
+	historyBatches []*types.History,
+) (int64, error) {
+
+	historySize := int64(0)
+	for i, batch := range historyBatches {
+		workflowEvents := &persistence.WorkflowEvents{
+			DomainID:    domainID,
+			WorkflowID:  workflowExecution.GetWorkflowID(),
+			RunID:       workflowExecution.GetRunID(),
+			BranchToken: branchToken,
+			Events:      batch.Events,
+		}
+		var blob events.PersistedBlob
+		var err error
+		if i == 0 {
+			blob, err = wfContext.PersistStartWorkflowBatchEvents(ctx, workflowEvents)
+		} else {
+			blob, err = wfContext.PersistNonStartWorkflowBatchEvents(ctx, workflowEvents)
+		}
+		if err != nil {
+			return 0, err
+		}
+		historySize += int64(len(blob.Data))
+	}
+	return historySize, nil
+}
+
+func (r *workflowRestorerImpl) currentRunExists(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflowID string,
+) (bool, error) {
+
+	_, err := r.shard.GetExecutionManager().GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   domainEntry.GetInfo().ID,
+		WorkflowID: workflowID,
+		DomainName: domainEntry.GetInfo().Name,
+	})
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case *types.EntityNotExistsError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (r *workflowRestorerImpl) createWorkflow(
+	ctx context.Context,
+	wfContext execution.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+	branchToken []byte,
+	startBatch *types.History,
+	historySize int64,
+	now time.Time,
+	currentRunExists bool,
+) (execution.MutableState, error) {
+
+	workflowIdentifier := definition.NewWorkflowIdentifier(
+		domainID,
+		workflowExecution.GetWorkflowID(),
+		workflowExecution.GetRunID(),
+	)
+	lastEvent := startBatch.Events[len(startBatch.Events)-1]
+	mutableState, _, err := r.newStateRebuilder().Rebuild(
+		ctx,
+		now,
+		workflowIdentifier,
+		branchToken,
+		lastEvent.ID,
+		lastEvent.Version,
+		workflowIdentifier,
+		branchToken,
+		uuid.New(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the workflow is closed right after creation, tasks of the running workflow are not needed
+	mutableState.DeleteTransferTasks()
+	mutableState.DeleteCrossClusterTasks()
+	mutableState.DeleteTimerTasks()
+
+	createMode := persistence.CreateWorkflowModeBrandNew
+	if currentRunExists {
+		// workflow ID is occupied by another run, restored run must not become current
+		if err := mutableState.UpdateWorkflowStateCloseStatus(
+			persistence.WorkflowStateZombie,
+			persistence.WorkflowCloseStatusNone,
+		); err != nil {
+			return nil, err
+		}
+		createMode = persistence.CreateWorkflowModeZombie
+	}
+
+	snapshot, _, err := mutableState.CloseTransactionAsSnapshot(now, execution.TransactionPolicyPassive)
+	if err != nil {
+		return nil, err
+	}
+	wfContext.SetHistorySize(historySize)
+	if err := wfContext.CreateWorkflowExecution(
+		ctx,
+		snapshot,
+		events.PersistedBlob{},
+		createMode,
+		"",
+		common.EmptyVersion,
+	); err != nil {
+		return nil, err
+	}
+	return mutableState, nil
+}
+
+func (r *workflowRestorerImpl) closeWorkflow(
+	ctx context.Context,
+	wfContext execution.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflowExecution types.WorkflowExecution,
+	branchToken []byte,
+	closeBatch *types.History,
+	nextEventIDInDB int64,
+	now time.Time,
+	currentRunExists bool,
+) error {
+
+	workflowIdentifier := definition.NewWorkflowIdentifier(
+		domainEntry.GetInfo().ID,
+		workflowExecution.GetWorkflowID(),
+		workflowExecution.GetRunID(),
+	)
+	lastEvent := closeBatch.Events[len(closeBatch.Events)-1]
+	mutableState, _, err := r.newStateRebuilder().Rebuild(
+		ctx,
+		now,
+		workflowIdentifier,
+		branchToken,
+		lastEvent.ID,
+		lastEvent.Version,
+		workflowIdentifier,
+		branchToken,
+		uuid.New(),
+	)
+	if err != nil {
+		return err
+	}
+
+	// history deletion is scheduled from the close time of the workflow,
+	// which has already passed, so count retention from the time of restore
+	restoreTime := r.shard.GetTimeSource().Now()
+	retention := time.Duration(domainEntry.GetRetentionDays(workflowExecution.GetWorkflowID())) * 24 * time.Hour
+	for _, task := range mutableState.GetTimerTasks() {
+		if deleteTask, ok := task.(*persistence.DeleteHistoryEventTask); ok {
+			deleteTask.VisibilityTimestamp = restoreTime.Add(retention)
+		}
+	}
+	mutableState.SetUpdateCondition(nextEventIDInDB)
+
+	conflictResolveMode := persistence.ConflictResolveWorkflowModeUpdateCurrent
+	if currentRunExists {
+		conflictResolveMode = persistence.ConflictResolveWorkflowModeBypassCurrent
+	}
+	return wfContext.ConflictResolveWorkflowExecution(
+		ctx,
+		restoreTime,
+		conflictResolveMode,
+		mutableState,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func isWorkflowCloseEvent(event *types.HistoryEvent) bool {
+	switch event.GetEventType() {
+	case types.EventTypeWorkflowExecutionCompleted,
+		types.EventTypeWorkflowExecutionFailed,
+		types.EventTypeWorkflowExecutionTimedOut,
+		types.EventTypeWorkflowExecutionCanceled,
+		types.EventTypeWorkflowExecutionTerminated,
+		types.EventTypeWorkflowExecutionContinuedAsNew:
+		return true
+	default:
+		return false
+	}
+}
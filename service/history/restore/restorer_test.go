@@ -0,0 +1,274 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+const (
+	testArchivalURI = "test:///history/archival"
+)
+
+type (
+	workflowRestorerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller          *gomock.Controller
+		mockShard           *shard.TestContext
+		mockEngine          *engine.MockEngine
+		mockStateRebuilder  *execution.MockStateRebuilder
+		mockHistoryArchiver *archiver.HistoryArchiverMock
+
+		domainEntry *cache.DomainCacheEntry
+		execution   types.WorkflowExecution
+
+		workflowRestorer *workflowRestorerImpl
+	}
+)
+
+func TestWorkflowRestorerSuite(t *testing.T) {
+	s := new(workflowRestorerSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowRestorerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockStateRebuilder = execution.NewMockStateRebuilder(s.controller)
+	s.mockHistoryArchiver = &archiver.HistoryArchiverMock{}
+
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID:          0,
+			RangeID:          1,
+			TransferAckLevel: 0,
+		},
+		config.NewForTest(),
+	)
+	s.mockEngine = engine.NewMockEngine(s.controller)
+	s.mockShard.SetEngine(s.mockEngine)
+
+	s.domainEntry = cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: constants.TestDomainID, Name: constants.TestDomainName},
+		&persistence.DomainConfig{
+			Retention:             1,
+			HistoryArchivalStatus: types.ArchivalStatusEnabled,
+			HistoryArchivalURI:    testArchivalURI,
+		},
+		cluster.TestCurrentClusterName,
+	)
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(s.domainEntry, nil).AnyTimes()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(constants.TestDomainID).Return(constants.TestDomainName, nil).AnyTimes()
+	s.mockShard.Resource.ArchiverProvider.On("GetHistoryArchiver", "test", service.History).Return(s.mockHistoryArchiver, nil).Maybe()
+
+	s.execution = types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      uuid.New(),
+	}
+
+	s.workflowRestorer = NewWorkflowRestorer(
+		s.mockShard,
+		execution.NewCache(s.mockShard),
+		loggerimpl.NewLoggerForTest(s.Suite),
+	).(*workflowRestorerImpl)
+	s.workflowRestorer.newStateRebuilder = func() execution.StateRebuilder {
+		return s.mockStateRebuilder
+	}
+}
+
+func (s *workflowRestorerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+	s.mockHistoryArchiver.AssertExpectations(s.T())
+}
+
+func (s *workflowRestorerSuite) TestRestoreWorkflow_WorkflowExists() {
+	s.mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:   constants.TestDomainID,
+				WorkflowID: s.execution.GetWorkflowID(),
+				RunID:      s.execution.GetRunID(),
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+		},
+		MutableStateStats: &persistence.MutableStateStats{},
+	}, nil).Once()
+
+	err := s.workflowRestorer.RestoreWorkflow(context.Background(), constants.TestDomainID, s.execution)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *workflowRestorerSuite) TestRestoreWorkflow_HistoryNotClosed() {
+	s.mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{s.startBatch()},
+	}, nil).Once()
+
+	err := s.workflowRestorer.RestoreWorkflow(context.Background(), constants.TestDomainID, s.execution)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *workflowRestorerSuite) TestRestoreWorkflow_BrandNew() {
+	startBatch := s.startBatch()
+	closeBatch := s.closeBatch()
+
+	s.mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.RunID == s.execution.GetRunID() && len(request.NextPageToken) == 0
+	})).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{startBatch},
+		NextPageToken:  []byte("next"),
+	}, nil).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return string(request.NextPageToken) == "next"
+	})).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*types.History{closeBatch},
+	}, nil).Once()
+	s.mockShard.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return request.IsNewBranch
+	})).Return(&persistence.AppendHistoryNodesResponse{DataBlob: persistence.DataBlob{Data: make([]byte, 10)}}, nil).Once()
+	s.mockShard.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return !request.IsNewBranch
+	})).Return(&persistence.AppendHistoryNodesResponse{DataBlob: persistence.DataBlob{Data: make([]byte, 20)}}, nil).Once()
+	s.mockShard.Resource.ExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+
+	startMutableState := execution.NewMockMutableState(s.controller)
+	s.mockStateRebuilder.EXPECT().Rebuild(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+		startBatch.Events[0].ID,
+		startBatch.Events[0].Version,
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(startMutableState, int64(0), nil).Times(1)
+	startMutableState.EXPECT().DeleteTransferTasks().Times(1)
+	startMutableState.EXPECT().DeleteCrossClusterTasks().Times(1)
+	startMutableState.EXPECT().DeleteTimerTasks().Times(1)
+	startMutableState.EXPECT().CloseTransactionAsSnapshot(gomock.Any(), execution.TransactionPolicyPassive).Return(
+		&persistence.WorkflowSnapshot{ExecutionInfo: &persistence.WorkflowExecutionInfo{DomainID: constants.TestDomainID}},
+		[]*persistence.WorkflowEvents{},
+		nil,
+	).Times(1)
+	startMutableState.EXPECT().GetNextEventID().Return(int64(2)).AnyTimes()
+	s.mockShard.Resource.ExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		return request.Mode == persistence.CreateWorkflowModeBrandNew &&
+			request.NewWorkflowSnapshot.ExecutionStats.HistorySize == 30
+	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	closeMutableState := execution.NewMockMutableState(s.controller)
+	deleteHistoryTask := &persistence.DeleteHistoryEventTask{VisibilityTimestamp: time.Unix(0, closeBatch.Events[0].GetTimestamp())}
+	s.mockStateRebuilder.EXPECT().Rebuild(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+		closeBatch.Events[0].ID,
+		closeBatch.Events[0].Version,
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(closeMutableState, int64(0), nil).Times(1)
+	closeMutableState.EXPECT().GetTimerTasks().Return([]persistence.Task{deleteHistoryTask}).Times(1)
+	closeMutableState.EXPECT().SetUpdateCondition(int64(2)).Times(1)
+	closeMutableState.EXPECT().CloseTransactionAsSnapshot(gomock.Any(), execution.TransactionPolicyPassive).Return(
+		&persistence.WorkflowSnapshot{ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:    constants.TestDomainID,
+			State:       persistence.WorkflowStateCompleted,
+			CloseStatus: persistence.WorkflowCloseStatusCompleted,
+		}},
+		[]*persistence.WorkflowEvents{},
+		nil,
+	).Times(1)
+	closeMutableState.EXPECT().GetCurrentBranchToken().Return([]byte("branch token"), nil).AnyTimes()
+	closeMutableState.EXPECT().GetWorkflowStateCloseStatus().Return(persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted).AnyTimes()
+	closeMutableState.EXPECT().GetLastFirstEventID().Return(closeBatch.Events[0].ID).AnyTimes()
+	closeMutableState.EXPECT().GetNextEventID().Return(closeBatch.Events[0].ID + 1).AnyTimes()
+	closeMutableState.EXPECT().GetPreviousStartedEventID().Return(common.EmptyEventID).AnyTimes()
+	closeMutableState.EXPECT().GetCompletionEvent(gomock.Any()).Return(closeBatch.Events[0], nil).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewHistoryEvent(gomock.Any()).Times(1)
+	s.mockEngine.EXPECT().NotifyNewTransferTasks(gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewCrossClusterTasks(gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewTimerTasks(gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewReplicationTasks(gomock.Any()).AnyTimes()
+	s.mockShard.Resource.ExecutionMgr.On("ConflictResolveWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.ConflictResolveWorkflowExecutionRequest) bool {
+		return request.Mode == persistence.ConflictResolveWorkflowModeUpdateCurrent
+	})).Return(&persistence.ConflictResolveWorkflowExecutionResponse{}, nil).Once()
+
+	err := s.workflowRestorer.RestoreWorkflow(context.Background(), constants.TestDomainID, s.execution)
+	s.NoError(err)
+	s.True(deleteHistoryTask.VisibilityTimestamp.After(s.mockShard.GetTimeSource().Now()))
+}
+
+func (s *workflowRestorerSuite) startBatch() *types.History {
+	return &types.History{
+		Events: []*types.HistoryEvent{
+			{
+				ID:        common.FirstEventID,
+				Version:   common.EmptyVersion,
+				Timestamp: common.Int64Ptr(time.Now().Add(-48 * time.Hour).UnixNano()),
+				EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+			},
+		},
+	}
+}
+
+func (s *workflowRestorerSuite) closeBatch() *types.History {
+	return &types.History{
+		Events: []*types.HistoryEvent{
+			{
+				ID:        common.FirstEventID + 1,
+				Version:   common.EmptyVersion,
+				Timestamp: common.Int64Ptr(time.Now().Add(-24 * time.Hour).UnixNano()),
+				EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+			},
+		},
+	}
+}
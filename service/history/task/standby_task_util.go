@@ -115,23 +115,31 @@ type (
 	pushActivityToMatchingInfo struct {
 		activityScheduleToStartTimeout int32
 		partitionConfig                map[string]string
+		priority                       int32
+		activityType                   *types.ActivityType
 	}
 
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		tasklist                       types.TaskList
 		partitionConfig                map[string]string
+		priority                       int32
+		workflowType                   *types.WorkflowType
 	}
 )
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
 	partitionConfig map[string]string,
+	priority int32,
+	activityType *types.ActivityType,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
 		partitionConfig:                partitionConfig,
+		priority:                       priority,
+		activityType:                   activityType,
 	}
 }
 
@@ -139,12 +147,16 @@ func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist types.TaskList,
 	partitionConfig map[string]string,
+	priority int32,
+	workflowType *types.WorkflowType,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
 		partitionConfig:                partitionConfig,
+		priority:                       priority,
+		workflowType:                   workflowType,
 	}
 }
 
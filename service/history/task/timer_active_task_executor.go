@@ -588,6 +588,14 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		Name: activityInfo.TaskList,
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	priority, err := mutableState.GetActivityTaskPriority(ctx, scheduledID)
+	if err != nil {
+		return err
+	}
+	activityType, err := mutableState.GetActivityTypeForMatching(ctx, scheduledID)
+	if err != nil {
+		return err
+	}
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -599,6 +607,8 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
 		PartitionConfig:               mutableState.GetExecutionInfo().PartitionConfig,
+		Priority:                      priority,
+		ActivityType:                  activityType,
 	})
 }
 
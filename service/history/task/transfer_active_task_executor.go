@@ -189,10 +189,18 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	priority, err := mutableState.GetActivityTaskPriority(ctx, task.ScheduleID)
+	if err != nil {
+		return err
+	}
+	activityType, err := mutableState.GetActivityTypeForMatching(ctx, task.ScheduleID)
+	if err != nil {
+		return err
+	}
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(ctx, task, timeout, mutableState.GetExecutionInfo().PartitionConfig)
+	return t.pushActivity(ctx, task, timeout, mutableState.GetExecutionInfo().PartitionConfig, priority, activityType)
 }
 
 func (t *transferActiveTaskExecutor) processDecisionTask(
@@ -258,10 +266,14 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	// for the decision. Using MaxTaskTimeout here for now so at least no
 	// decision will be lost.
 
+	priority, err := mutableState.GetDecisionTaskPriority(ctx)
+	if err != nil {
+		return err
+	}
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig)
+	err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig, priority, mutableState.GetWorkflowType())
 	if _, ok := err.(*types.StickyWorkerUnavailableError); ok {
 		// sticky worker is unavailable, switch to non-sticky task list
 		taskList = &types.TaskList{
@@ -273,7 +285,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		// There is no need to reset sticky, because if this task is picked by new worker, the new worker will reset
 		// the sticky queue to a new one. However, if worker is completely down, that schedule_to_start timeout task
 		// will re-create a new non-sticky task and reset sticky.
-		err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig)
+		err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig, priority, mutableState.GetWorkflowType())
 	}
 	return err
 }
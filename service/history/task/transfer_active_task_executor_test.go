@@ -1661,6 +1661,7 @@ func createAddDecisionTaskRequest(
 		ScheduleID:                    taskInfo.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(timeout),
 		PartitionConfig:               executionInfo.PartitionConfig,
+		WorkflowType:                  mutableState.GetWorkflowType(),
 	}
 }
 
@@ -137,9 +137,19 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedID == common.EmptyEventID {
+			priority, err := mutableState.GetActivityTaskPriority(ctx, transferTask.ScheduleID)
+			if err != nil {
+				return nil, err
+			}
+			activityType, err := mutableState.GetActivityTypeForMatching(ctx, transferTask.ScheduleID)
+			if err != nil {
+				return nil, err
+			}
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
 				mutableState.GetExecutionInfo().PartitionConfig,
+				priority,
+				activityType,
 			), nil
 		}
 
@@ -191,10 +201,16 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 		}
 
 		if decisionInfo.StartedID == common.EmptyEventID {
+			priority, err := mutableState.GetDecisionTaskPriority(ctx)
+			if err != nil {
+				return nil, err
+			}
 			return newPushDecisionToMatchingInfo(
 				decisionTimeout,
 				types.TaskList{Name: executionInfo.TaskList}, // at standby, always use non-sticky tasklist
 				mutableState.GetExecutionInfo().PartitionConfig,
+				priority,
+				mutableState.GetWorkflowType(),
 			), nil
 		}
 
@@ -596,6 +612,8 @@ func (t *transferStandbyTaskExecutor) pushActivity(
 		task.(*persistence.TransferTaskInfo),
 		timeout,
 		pushActivityInfo.partitionConfig,
+		pushActivityInfo.priority,
+		pushActivityInfo.activityType,
 	)
 }
 
@@ -618,6 +636,8 @@ func (t *transferStandbyTaskExecutor) pushDecision(
 		&pushDecisionInfo.tasklist,
 		timeout,
 		pushDecisionInfo.partitionConfig,
+		pushDecisionInfo.priority,
+		pushDecisionInfo.workflowType,
 	)
 }
 
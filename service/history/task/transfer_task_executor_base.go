@@ -90,6 +90,8 @@ func (t *transferTaskExecutorBase) pushActivity(
 	task *persistence.TransferTaskInfo,
 	activityScheduleToStartTimeout int32,
 	partitionConfig map[string]string,
+	priority int32,
+	activityType *types.ActivityType,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -110,6 +112,8 @@ func (t *transferTaskExecutorBase) pushActivity(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		Priority:                      priority,
+		ActivityType:                  activityType,
 	})
 }
 
@@ -119,6 +123,8 @@ func (t *transferTaskExecutorBase) pushDecision(
 	tasklist *types.TaskList,
 	decisionScheduleToStartTimeout int32,
 	partitionConfig map[string]string,
+	priority int32,
+	workflowType *types.WorkflowType,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -138,6 +144,8 @@ func (t *transferTaskExecutorBase) pushDecision(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		Priority:                      priority,
+		WorkflowType:                  workflowType,
 	})
 }
 
@@ -181,6 +181,12 @@ func (t ThriftHandler) RefreshWorkflowTasks(ctx context.Context, request *h.Refr
 	return thrift.FromError(err)
 }
 
+// RestoreArchivedWorkflow forwards request to the underlying handler
+func (t ThriftHandler) RestoreArchivedWorkflow(ctx context.Context, request *h.RestoreArchivedWorkflowRequest) error {
+	err := t.h.RestoreArchivedWorkflow(ctx, thrift.ToHistoryRestoreArchivedWorkflowRequest(request))
+	return thrift.FromError(err)
+}
+
 // RemoveSignalMutableState forwards request to the underlying handler
 func (t ThriftHandler) RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error {
 	err := t.h.RemoveSignalMutableState(ctx, thrift.ToRemoveSignalMutableStateRequest(request))
@@ -306,3 +312,9 @@ func (t ThriftHandler) GetFailoverInfo(ctx context.Context, request *h.GetFailov
 	response, err := t.h.GetFailoverInfo(ctx, thrift.ToGetFailoverInfoRequest(request))
 	return thrift.FromGetFailoverInfoResponse(response), thrift.FromError(err)
 }
+
+// RatelimitUpdate forwards request to the underlying handler
+func (t ThriftHandler) RatelimitUpdate(ctx context.Context, request *h.RatelimitUpdateRequest) (*h.RatelimitUpdateResponse, error) {
+	response, err := t.h.RatelimitUpdate(ctx, thrift.ToRatelimitUpdateRequest(request))
+	return thrift.FromRatelimitUpdateResponse(response), thrift.FromError(err)
+}
@@ -153,6 +153,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, replicator.ReadDLQMessagesResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RatelimitUpdate", func(t *testing.T) {
+		h.EXPECT().RatelimitUpdate(ctx, &types.RatelimitUpdateRequest{}).Return(&types.RatelimitUpdateResponse{}, internalErr).Times(1)
+		resp, err := th.RatelimitUpdate(ctx, &hist.RatelimitUpdateRequest{})
+		assert.Equal(t, hist.RatelimitUpdateResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ReapplyEvents", func(t *testing.T) {
 		h.EXPECT().ReapplyEvents(ctx, &types.HistoryReapplyEventsRequest{}).Return(internalErr).Times(1)
 		err := th.ReapplyEvents(ctx, &hist.ReapplyEventsRequest{})
@@ -193,6 +199,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.RefreshWorkflowTasks(ctx, &hist.RefreshWorkflowTasksRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RestoreArchivedWorkflow", func(t *testing.T) {
+		h.EXPECT().RestoreArchivedWorkflow(ctx, &types.HistoryRestoreArchivedWorkflowRequest{}).Return(internalErr).Times(1)
+		err := th.RestoreArchivedWorkflow(ctx, &hist.RestoreArchivedWorkflowRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RemoveSignalMutableState", func(t *testing.T) {
 		h.EXPECT().RemoveSignalMutableState(ctx, &types.RemoveSignalMutableStateRequest{}).Return(internalErr).Times(1)
 		err := th.RemoveSignalMutableState(ctx, &hist.RemoveSignalMutableStateRequest{})
@@ -0,0 +1,289 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// number of consecutive checks that must find the removed partitions empty before they are dropped
+	adaptiveScalerDrainedChecks   = 2
+	adaptiveScalerDescribeTimeout = 5 * time.Second
+)
+
+type (
+	// adaptiveScaler runs on the root partition of a task list and adjusts the number of
+	// partitions based on the observed add rate. The partition config is persisted with
+	// the task list metadata and is picked up by matching clients through GetTaskListPartitionConfig.
+	//
+	// Upscale happens in two steps: read partitions are added first so that pollers are
+	// spread to the new partitions before any task is written to them, then write partitions
+	// follow. Downscale removes write partitions first, and read partitions are only removed
+	// once the partitions that no longer receive writes have no backlog left.
+	adaptiveScaler struct {
+		status         int32
+		tlMgr          *taskListManagerImpl
+		matchingClient matching.Client
+		timeSource     clock.TimeSource
+		shutdownChan   chan struct{}
+		wg             sync.WaitGroup
+
+		addCount int64 // number of tasks added to the root partition since last evaluation, accessed atomically
+
+		// the fields below are only accessed from the event loop
+		lastEvaluation time.Time
+		lastUpdate     time.Time
+		overloadSince  time.Time
+		underloadSince time.Time
+		drainedChecks  int
+	}
+)
+
+var _ common.Daemon = (*adaptiveScaler)(nil)
+
+func newAdaptiveScaler(tlMgr *taskListManagerImpl, matchingClient matching.Client, timeSource clock.TimeSource) *adaptiveScaler {
+	return &adaptiveScaler{
+		status:         common.DaemonStatusInitialized,
+		tlMgr:          tlMgr,
+		matchingClient: matchingClient,
+		timeSource:     timeSource,
+		shutdownChan:   make(chan struct{}),
+		lastEvaluation: timeSource.Now(),
+	}
+}
+
+func (s *adaptiveScaler) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	s.wg.Add(1)
+	go s.eventLoop()
+}
+
+func (s *adaptiveScaler) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(s.shutdownChan)
+	s.wg.Wait()
+}
+
+// recordAdd records a task added to the root partition by history service
+func (s *adaptiveScaler) recordAdd() {
+	atomic.AddInt64(&s.addCount, 1)
+}
+
+func (s *adaptiveScaler) eventLoop() {
+	defer s.wg.Done()
+	timer := time.NewTimer(s.tlMgr.config.AdaptiveScalerUpdateInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			s.evaluate()
+			timer.Reset(s.tlMgr.config.AdaptiveScalerUpdateInterval())
+		case <-s.shutdownChan:
+			return
+		}
+	}
+}
+
+func (s *adaptiveScaler) evaluate() {
+	now := s.timeSource.Now()
+	addCount := atomic.SwapInt64(&s.addCount, 0)
+	elapsed := now.Sub(s.lastEvaluation)
+	s.lastEvaluation = now
+	if !s.tlMgr.config.EnableAdaptiveScaler() || elapsed <= 0 {
+		s.resetLoadTracking()
+		return
+	}
+
+	current := s.currentPartitionConfig()
+	// tasks from history are spread evenly across write partitions, so the root
+	// partition only observes its share of the total add rate of the task list
+	qps := float64(addCount) / elapsed.Seconds() * float64(current.NumWritePartitions)
+	s.tlMgr.scope.UpdateGauge(metrics.EstimatedAddTaskQPSPerTaskListGauge, qps)
+
+	next := s.nextPartitionConfig(now, current, qps)
+	if next != nil {
+		if err := s.tlMgr.db.UpdatePartitionConfig(next); err != nil {
+			s.tlMgr.scope.IncCounter(metrics.TaskListPartitionUpdateFailedCounter)
+			s.tlMgr.logger.Error("Failed to update task list partition config", tag.Error(err))
+			s.tlMgr.handleErr(err)
+			return
+		}
+		s.tlMgr.logger.Info("Task list partition config updated",
+			tag.WorkflowTaskListReadPartitions(next.NumReadPartitions),
+			tag.WorkflowTaskListWritePartitions(next.NumWritePartitions),
+		)
+		if next.NumReadPartitions > current.NumReadPartitions || next.NumWritePartitions > current.NumWritePartitions {
+			s.tlMgr.scope.IncCounter(metrics.TaskListPartitionUpscaleCounter)
+		} else {
+			s.tlMgr.scope.IncCounter(metrics.TaskListPartitionDownscaleCounter)
+		}
+		s.lastUpdate = now
+		s.drainedChecks = 0
+		current = next
+	}
+	s.tlMgr.scope.UpdateGauge(metrics.TaskListReadPartitionsGauge, float64(current.NumReadPartitions))
+	s.tlMgr.scope.UpdateGauge(metrics.TaskListWritePartitionsGauge, float64(current.NumWritePartitions))
+}
+
+// currentPartitionConfig returns the persisted partition config, or the one
+// derived from dynamic config if the task list has never been scaled
+func (s *adaptiveScaler) currentPartitionConfig() *persistence.TaskListPartitionConfig {
+	if config := s.tlMgr.db.PartitionConfig(); config != nil {
+		return config
+	}
+	numRead := s.tlMgr.config.NumReadPartitions()
+	return &persistence.TaskListPartitionConfig{
+		NumReadPartitions:  numRead,
+		NumWritePartitions: common.MinInt(numRead, s.tlMgr.config.NumWritePartitions()),
+	}
+}
+
+// nextPartitionConfig returns the partition config the task list should move to, or nil if it should stay as is
+func (s *adaptiveScaler) nextPartitionConfig(
+	now time.Time,
+	current *persistence.TaskListPartitionConfig,
+	qps float64,
+) *persistence.TaskListPartitionConfig {
+	numRead := current.NumReadPartitions
+	numWrite := current.NumWritePartitions
+	if numRead > numWrite {
+		s.resetLoadTracking()
+		// give matching clients time to pick up the last change before moving on
+		if now.Sub(s.lastUpdate) < 2*s.tlMgr.config.PartitionConfigRefreshInterval() {
+			return nil
+		}
+		if s.desiredPartitions(qps, float64(s.tlMgr.config.PartitionUpscaleRPS())) >= numRead {
+			// pollers are already spread to the new partitions, start writing to them
+			return newPartitionConfig(current, numRead, numRead)
+		}
+		if s.isDrained(numWrite, numRead) {
+			return newPartitionConfig(current, numWrite, numWrite)
+		}
+		return nil
+	}
+
+	upscaleRPS := float64(s.tlMgr.config.PartitionUpscaleRPS())
+	downscaleRPS := upscaleRPS * s.tlMgr.config.PartitionDownscaleFactor()
+	if desired := s.desiredPartitions(qps, upscaleRPS); desired > numWrite {
+		s.underloadSince = time.Time{}
+		if s.overloadSince.IsZero() {
+			s.overloadSince = now
+		}
+		if now.Sub(s.overloadSince) < s.tlMgr.config.PartitionUpscaleSustainedDuration() {
+			return nil
+		}
+		s.resetLoadTracking()
+		return newPartitionConfig(current, desired, numWrite)
+	}
+	if downscaleRPS <= 0 || numWrite <= 1 {
+		s.resetLoadTracking()
+		return nil
+	}
+	if desired := s.desiredPartitions(qps, downscaleRPS); desired < numWrite && s.tlMgr.backlogCount() == 0 {
+		s.overloadSince = time.Time{}
+		if s.underloadSince.IsZero() {
+			s.underloadSince = now
+		}
+		if now.Sub(s.underloadSince) < s.tlMgr.config.PartitionDownscaleSustainedDuration() {
+			return nil
+		}
+		s.resetLoadTracking()
+		return newPartitionConfig(current, numRead, desired)
+	}
+	s.resetLoadTracking()
+	return nil
+}
+
+// desiredPartitions returns the number of partitions needed to serve the given qps at rpsPerPartition
+func (s *adaptiveScaler) desiredPartitions(qps float64, rpsPerPartition float64) int {
+	desired := int(math.Ceil(qps / rpsPerPartition))
+	return common.MaxInt(1, common.MinInt(desired, s.tlMgr.config.AdaptiveScalerMaxPartitions()))
+}
+
+// isDrained returns true once the partitions in [from, to) have been found
+// without backlog by enough consecutive checks
+func (s *adaptiveScaler) isDrained(from, to int) bool {
+	for i := from; i < to; i++ {
+		if !s.isPartitionDrained(i) {
+			s.drainedChecks = 0
+			return false
+		}
+	}
+	s.drainedChecks++
+	return s.drainedChecks >= adaptiveScalerDrainedChecks
+}
+
+func (s *adaptiveScaler) isPartitionDrained(partition int) bool {
+	taskListType := types.TaskListTypeDecision
+	if s.tlMgr.taskListID.taskType == persistence.TaskListTypeActivity {
+		taskListType = types.TaskListTypeActivity
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), adaptiveScalerDescribeTimeout)
+	defer cancel()
+	resp, err := s.matchingClient.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: s.tlMgr.taskListID.domainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList: &types.TaskList{
+				Name: s.tlMgr.taskListID.mkName(partition),
+				Kind: types.TaskListKindNormal.Ptr(),
+			},
+			TaskListType:          &taskListType,
+			IncludeTaskListStatus: true,
+		},
+	})
+	if err != nil {
+		s.tlMgr.logger.Warn("Failed to describe task list partition being drained", tag.Error(err), tag.Number(int64(partition)))
+		return false
+	}
+	status := resp.GetTaskListStatus()
+	return status != nil && status.GetBacklogCountHint() == 0 && status.GetAckLevel() >= status.GetReadLevel()
+}
+
+func (s *adaptiveScaler) resetLoadTracking() {
+	s.overloadSince = time.Time{}
+	s.underloadSince = time.Time{}
+}
+
+func newPartitionConfig(current *persistence.TaskListPartitionConfig, numRead, numWrite int) *persistence.TaskListPartitionConfig {
+	return &persistence.TaskListPartitionConfig{
+		Version:            current.Version + 1,
+		NumReadPartitions:  numRead,
+		NumWritePartitions: numWrite,
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func setupAdaptiveScalerTest(t *testing.T) (*adaptiveScaler, *matching.MockClient, *clock.EventTimeSource) {
+	controller := gomock.NewController(t)
+	tlm := createTestTaskListManager(controller)
+	_, err := tlm.db.RenewLease()
+	require.NoError(t, err)
+
+	tlm.config.EnableAdaptiveScaler = func() bool { return true }
+	tlm.config.PartitionUpscaleRPS = func() int { return 10 }
+	tlm.config.PartitionDownscaleFactor = func() float64 { return 0.5 }
+	tlm.config.AdaptiveScalerMaxPartitions = func() int { return 5 }
+	tlm.config.PartitionUpscaleSustainedDuration = func() time.Duration { return time.Minute }
+	tlm.config.PartitionDownscaleSustainedDuration = func() time.Duration { return 2 * time.Minute }
+	tlm.config.PartitionConfigRefreshInterval = func() time.Duration { return 10 * time.Second }
+
+	mockClient := matching.NewMockClient(controller)
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Now())
+	return newAdaptiveScaler(tlm, mockClient, timeSource), mockClient, timeSource
+}
+
+// evaluateAfter simulates tasks added to the root partition at the given rate for the given duration
+func evaluateAfter(s *adaptiveScaler, timeSource *clock.EventTimeSource, d time.Duration, qps int64) {
+	atomic.AddInt64(&s.addCount, qps*int64(d/time.Second))
+	timeSource.Update(timeSource.Now().Add(d))
+	s.evaluate()
+}
+
+func partitionStatus(backlog int64) *types.DescribeTaskListResponse {
+	return &types.DescribeTaskListResponse{
+		TaskListStatus: &types.TaskListStatus{
+			BacklogCountHint: backlog,
+			AckLevel:         10,
+			ReadLevel:        10 + backlog,
+		},
+	}
+}
+
+func TestAdaptiveScaler_Upscale(t *testing.T) {
+	s, _, timeSource := setupAdaptiveScalerTest(t)
+
+	evaluateAfter(s, timeSource, 10*time.Second, 30)
+	require.Nil(t, s.tlMgr.db.PartitionConfig(), "upscale must be sustained")
+
+	evaluateAfter(s, timeSource, time.Minute, 30)
+	require.Equal(t, &persistence.TaskListPartitionConfig{Version: 1, NumReadPartitions: 3, NumWritePartitions: 1}, s.tlMgr.db.PartitionConfig())
+
+	evaluateAfter(s, timeSource, 10*time.Second, 30)
+	require.Equal(t, int64(1), s.tlMgr.db.PartitionConfig().Version, "write partitions must wait for clients to refresh")
+
+	evaluateAfter(s, timeSource, 10*time.Second, 30)
+	require.Equal(t, &persistence.TaskListPartitionConfig{Version: 2, NumReadPartitions: 3, NumWritePartitions: 3}, s.tlMgr.db.PartitionConfig())
+	require.Equal(t, &types.TaskListPartitionConfig{Version: 2, NumReadPartitions: 3, NumWritePartitions: 3}, s.tlMgr.PartitionConfig())
+
+	// the root partition only sees its share of the load once tasks are spread across partitions
+	evaluateAfter(s, timeSource, 2*time.Minute, 10)
+	require.Equal(t, int64(2), s.tlMgr.db.PartitionConfig().Version)
+}
+
+func TestAdaptiveScaler_UpscaleCappedAtMaxPartitions(t *testing.T) {
+	s, _, timeSource := setupAdaptiveScalerTest(t)
+
+	evaluateAfter(s, timeSource, 10*time.Second, 1000)
+	evaluateAfter(s, timeSource, time.Minute, 1000)
+	require.Equal(t, 5, s.tlMgr.db.PartitionConfig().NumReadPartitions)
+}
+
+func TestAdaptiveScaler_Downscale(t *testing.T) {
+	s, mockClient, timeSource := setupAdaptiveScalerTest(t)
+	require.NoError(t, s.tlMgr.db.UpdatePartitionConfig(&persistence.TaskListPartitionConfig{Version: 2, NumReadPartitions: 3, NumWritePartitions: 3}))
+
+	evaluateAfter(s, timeSource, 10*time.Second, 1)
+	require.Equal(t, int64(2), s.tlMgr.db.PartitionConfig().Version, "downscale must be sustained")
+
+	evaluateAfter(s, timeSource, 2*time.Minute, 1)
+	require.Equal(t, &persistence.TaskListPartitionConfig{Version: 3, NumReadPartitions: 3, NumWritePartitions: 1}, s.tlMgr.db.PartitionConfig())
+
+	mockClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *types.MatchingDescribeTaskListRequest, _ ...interface{}) (*types.DescribeTaskListResponse, error) {
+			require.True(t, request.DescRequest.IncludeTaskListStatus)
+			require.Equal(t, types.TaskListTypeActivity, request.DescRequest.GetTaskListType())
+			return partitionStatus(0), nil
+		},
+	).Times(4)
+	evaluateAfter(s, timeSource, 20*time.Second, 1)
+	require.Equal(t, int64(3), s.tlMgr.db.PartitionConfig().Version, "partitions must be found drained twice")
+
+	evaluateAfter(s, timeSource, 10*time.Second, 1)
+	require.Equal(t, &persistence.TaskListPartitionConfig{Version: 4, NumReadPartitions: 1, NumWritePartitions: 1}, s.tlMgr.db.PartitionConfig())
+}
+
+func TestAdaptiveScaler_DownscaleWaitsForBacklog(t *testing.T) {
+	s, mockClient, timeSource := setupAdaptiveScalerTest(t)
+	require.NoError(t, s.tlMgr.db.UpdatePartitionConfig(&persistence.TaskListPartitionConfig{Version: 3, NumReadPartitions: 3, NumWritePartitions: 1}))
+
+	mockClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(partitionStatus(5), nil).Times(3)
+	for i := 0; i < 3; i++ {
+		evaluateAfter(s, timeSource, 20*time.Second, 1)
+	}
+	require.Equal(t, &persistence.TaskListPartitionConfig{Version: 3, NumReadPartitions: 3, NumWritePartitions: 1}, s.tlMgr.db.PartitionConfig())
+}
+
+func TestAdaptiveScaler_ResumeUpscaleWhileDraining(t *testing.T) {
+	s, _, timeSource := setupAdaptiveScalerTest(t)
+	require.NoError(t, s.tlMgr.db.UpdatePartitionConfig(&persistence.TaskListPartitionConfig{Version: 3, NumReadPartitions: 3, NumWritePartitions: 1}))
+
+	evaluateAfter(s, timeSource, 20*time.Second, 40)
+	require.Equal(t, &persistence.TaskListPartitionConfig{Version: 4, NumReadPartitions: 3, NumWritePartitions: 3}, s.tlMgr.db.PartitionConfig())
+}
+
+func TestAdaptiveScaler_Disabled(t *testing.T) {
+	s, _, timeSource := setupAdaptiveScalerTest(t)
+	s.tlMgr.config.EnableAdaptiveScaler = func() bool { return false }
+
+	evaluateAfter(s, timeSource, 10*time.Second, 1000)
+	evaluateAfter(s, timeSource, 10*time.Minute, 1000)
+	require.Nil(t, s.tlMgr.db.PartitionConfig())
+	require.Nil(t, s.tlMgr.PartitionConfig())
+}
+
+func TestAdaptiveScaler_PartitionConfigSurvivesLease(t *testing.T) {
+	s, _, timeSource := setupAdaptiveScalerTest(t)
+
+	evaluateAfter(s, timeSource, 10*time.Second, 30)
+	evaluateAfter(s, timeSource, time.Minute, 30)
+
+	state, err := s.tlMgr.db.RenewLease()
+	require.NoError(t, err)
+	require.Equal(t, &persistence.TaskListPartitionConfig{Version: 1, NumReadPartitions: 3, NumWritePartitions: 1}, state.partitionConfig)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
 )
 
 type (
@@ -54,8 +55,32 @@ type (
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		AsyncTaskDispatchTimeout     dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 
+		// priority dispatch configuration
+		PriorityStarvationThreshold   dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PriorityTaskSyncMatchWaitTime dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// fairness configuration
+		EnableTaskListFairness      dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		FairnessKeyWeights          dynamicconfig.MapPropertyFnWithDomainFilter
+		FairnessKeyMaxBufferedTasks dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		FairnessMaxSkippedTasks     dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+		// per workflow and activity type dispatch limits
+		WorkflowTypeDispatchRPS dynamicconfig.MapPropertyFnWithDomainFilter
+		ActivityTypeDispatchRPS dynamicconfig.MapPropertyFnWithDomainFilter
+
+		// adaptive scaler configuration
+		EnableAdaptiveScaler                dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		AdaptiveScalerUpdateInterval        dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		AdaptiveScalerMaxPartitions         dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleRPS                 dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionDownscaleFactor            dynamicconfig.FloatPropertyFn
+		PartitionUpscaleSustainedDuration   dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionDownscaleSustainedDuration dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionConfigRefreshInterval      dynamicconfig.DurationPropertyFn
+
 		// Time to hold a poll request before returning an empty response if there are no tasks
-		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithIsolationGroupFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
@@ -85,11 +110,23 @@ type (
 		ForwarderMaxChildrenPerNode  func() int
 	}
 
+	adaptiveScalerConfig struct {
+		EnableAdaptiveScaler                func() bool
+		AdaptiveScalerUpdateInterval        func() time.Duration
+		AdaptiveScalerMaxPartitions         func() int
+		PartitionUpscaleRPS                 func() int
+		PartitionDownscaleFactor            func() float64
+		PartitionUpscaleSustainedDuration   func() time.Duration
+		PartitionDownscaleSustainedDuration func() time.Duration
+		PartitionConfigRefreshInterval      func() time.Duration
+	}
+
 	taskListConfig struct {
 		forwarderConfig
+		adaptiveScalerConfig
 		EnableSyncMatch func() bool
-		// Time to hold a poll request before returning an empty response if there are no tasks
-		LongPollExpirationInterval    func() time.Duration
+		// Time to hold a poll request of given isolation group before returning an empty response if there are no tasks
+		LongPollExpirationInterval    func(isolationGroup string) time.Duration
 		RangeSize                     int64
 		ActivityTaskSyncMatchWaitTime dynamicconfig.DurationPropertyFnWithDomainFilter
 		GetTasksBatchSize             func() int
@@ -99,6 +136,16 @@ type (
 		MinTaskThrottlingBurstSize    func() int
 		MaxTaskDeleteBatchSize        func() int
 		AsyncTaskDispatchTimeout      func() time.Duration
+		// priority dispatch configuration
+		PriorityStarvationThreshold   func() int
+		PriorityTaskSyncMatchWaitTime func() time.Duration
+		// fairness configuration
+		EnableTaskListFairness      func() bool
+		FairnessKeyWeights          func() map[string]interface{}
+		FairnessKeyMaxBufferedTasks func() int
+		FairnessMaxSkippedTasks     func() int
+		// dispatch rps keyed by the workflow type or activity type of the tasks, depending on the task list type
+		TypeDispatchRPS func() map[string]interface{}
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
 		MaxTaskBatchSize                func() int
@@ -115,38 +162,54 @@ type (
 // NewConfig returns new service config with default values
 func NewConfig(dc *dynamicconfig.Collection, hostName string) *Config {
 	return &Config{
-		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS),
-		PersistenceGlobalMaxQPS:         dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS),
-		EnableSyncMatch:                 dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch),
-		UserRPS:                         dc.GetIntProperty(dynamicconfig.MatchingUserRPS),
-		WorkerRPS:                       dc.GetIntProperty(dynamicconfig.MatchingWorkerRPS),
-		DomainUserRPS:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainUserRPS),
-		DomainWorkerRPS:                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainWorkerRPS),
-		RangeSize:                       100000,
-		GetTasksBatchSize:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize),
-		UpdateAckInterval:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval),
-		IdleTasklistCheckInterval:       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval),
-		MaxTasklistIdleTime:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime),
-		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval),
-		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize),
-		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize),
-		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold),
-		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize),
-		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS),
-		NumTasklistWritePartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions),
-		NumTasklistReadPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
-		ForwarderMaxOutstandingPolls:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls),
-		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks),
-		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond),
-		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode),
-		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration),
-		EnableDebugMode:                 dc.GetBoolProperty(dynamicconfig.EnableDebugMode)(),
-		EnableTaskInfoLogByDomainID:     dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID),
-		ActivityTaskSyncMatchWaitTime:   dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingActivityTaskSyncMatchWaitTime),
-		EnableTasklistIsolation:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTasklistIsolation),
-		AllIsolationGroups:              mapIGs(dc.GetListProperty(dynamicconfig.AllIsolationGroups)()),
-		AsyncTaskDispatchTimeout:        dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.AsyncTaskDispatchTimeout),
-		HostName:                        hostName,
+		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS),
+		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS),
+		EnableSyncMatch:                     dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch),
+		UserRPS:                             dc.GetIntProperty(dynamicconfig.MatchingUserRPS),
+		WorkerRPS:                           dc.GetIntProperty(dynamicconfig.MatchingWorkerRPS),
+		DomainUserRPS:                       dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainUserRPS),
+		DomainWorkerRPS:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainWorkerRPS),
+		RangeSize:                           100000,
+		GetTasksBatchSize:                   dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize),
+		UpdateAckInterval:                   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval),
+		IdleTasklistCheckInterval:           dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval),
+		MaxTasklistIdleTime:                 dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime),
+		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByIsolationGroup(dynamicconfig.MatchingLongPollExpirationInterval),
+		MinTaskThrottlingBurstSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize),
+		MaxTaskDeleteBatchSize:              dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize),
+		OutstandingTaskAppendsThreshold:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold),
+		MaxTaskBatchSize:                    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize),
+		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS),
+		NumTasklistWritePartitions:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions),
+		NumTasklistReadPartitions:           dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
+		ForwarderMaxOutstandingPolls:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls),
+		ForwarderMaxOutstandingTasks:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks),
+		ForwarderMaxRatePerSecond:           dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond),
+		ForwarderMaxChildrenPerNode:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode),
+		ShutdownDrainDuration:               dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration),
+		EnableDebugMode:                     dc.GetBoolProperty(dynamicconfig.EnableDebugMode)(),
+		EnableTaskInfoLogByDomainID:         dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID),
+		ActivityTaskSyncMatchWaitTime:       dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingActivityTaskSyncMatchWaitTime),
+		EnableTasklistIsolation:             dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableTasklistIsolation),
+		AllIsolationGroups:                  mapIGs(dc.GetListProperty(dynamicconfig.AllIsolationGroups)()),
+		AsyncTaskDispatchTimeout:            dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.AsyncTaskDispatchTimeout),
+		PriorityStarvationThreshold:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPriorityStarvationThreshold),
+		PriorityTaskSyncMatchWaitTime:       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPriorityTaskSyncMatchWaitTime),
+		EnableTaskListFairness:              dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableTaskListFairness),
+		FairnessKeyWeights:                  dc.GetMapPropertyFilteredByDomain(dynamicconfig.MatchingFairnessKeyWeights),
+		FairnessKeyMaxBufferedTasks:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingFairnessKeyMaxBufferedTasks),
+		FairnessMaxSkippedTasks:             dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingFairnessMaxSkippedTasks),
+		WorkflowTypeDispatchRPS:             dc.GetMapPropertyFilteredByDomain(dynamicconfig.MatchingWorkflowTypeDispatchRPS),
+		ActivityTypeDispatchRPS:             dc.GetMapPropertyFilteredByDomain(dynamicconfig.MatchingActivityTypeDispatchRPS),
+		EnableAdaptiveScaler:                dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableAdaptiveScaler),
+		AdaptiveScalerUpdateInterval:        dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingAdaptiveScalerUpdateInterval),
+		AdaptiveScalerMaxPartitions:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingAdaptiveScalerMaxPartitions),
+		PartitionUpscaleRPS:                 dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleRPS),
+		PartitionDownscaleFactor:            dc.GetFloat64Property(dynamicconfig.MatchingPartitionDownscaleFactor),
+		PartitionUpscaleSustainedDuration:   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleSustainedDuration),
+		PartitionDownscaleSustainedDuration: dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDownscaleSustainedDuration),
+		PartitionConfigRefreshInterval:      dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval),
+		HostName:                            hostName,
 	}
 }
 
@@ -194,8 +257,8 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		EnableSyncMatch: func() bool {
 			return config.EnableSyncMatch(domainName, taskListName, taskType)
 		},
-		LongPollExpirationInterval: func() time.Duration {
-			return config.LongPollExpirationInterval(domainName, taskListName, taskType)
+		LongPollExpirationInterval: func(isolationGroup string) time.Duration {
+			return config.LongPollExpirationInterval(domainName, taskListName, taskType, isolationGroup)
 		},
 		MaxTaskDeleteBatchSize: func() int {
 			return config.MaxTaskDeleteBatchSize(domainName, taskListName, taskType)
@@ -215,6 +278,30 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		AsyncTaskDispatchTimeout: func() time.Duration {
 			return config.AsyncTaskDispatchTimeout(domainName, taskListName, taskType)
 		},
+		PriorityStarvationThreshold: func() int {
+			return config.PriorityStarvationThreshold(domainName, taskListName, taskType)
+		},
+		PriorityTaskSyncMatchWaitTime: func() time.Duration {
+			return config.PriorityTaskSyncMatchWaitTime(domainName, taskListName, taskType)
+		},
+		EnableTaskListFairness: func() bool {
+			return config.EnableTaskListFairness(domainName, taskListName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]interface{} {
+			return config.FairnessKeyWeights(domainName)
+		},
+		FairnessKeyMaxBufferedTasks: func() int {
+			return config.FairnessKeyMaxBufferedTasks(domainName, taskListName, taskType)
+		},
+		FairnessMaxSkippedTasks: func() int {
+			return config.FairnessMaxSkippedTasks(domainName, taskListName, taskType)
+		},
+		TypeDispatchRPS: func() map[string]interface{} {
+			if taskType == persistence.TaskListTypeDecision {
+				return config.WorkflowTypeDispatchRPS(domainName)
+			}
+			return config.ActivityTypeDispatchRPS(domainName)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(domainName, taskListName, taskType)
@@ -229,6 +316,32 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 				return common.MaxInt(1, config.ForwarderMaxChildrenPerNode(domainName, taskListName, taskType))
 			},
 		},
+		adaptiveScalerConfig: adaptiveScalerConfig{
+			EnableAdaptiveScaler: func() bool {
+				return config.EnableAdaptiveScaler(domainName, taskListName, taskType)
+			},
+			AdaptiveScalerUpdateInterval: func() time.Duration {
+				return config.AdaptiveScalerUpdateInterval(domainName, taskListName, taskType)
+			},
+			AdaptiveScalerMaxPartitions: func() int {
+				return common.MaxInt(1, config.AdaptiveScalerMaxPartitions(domainName, taskListName, taskType))
+			},
+			PartitionUpscaleRPS: func() int {
+				return common.MaxInt(1, config.PartitionUpscaleRPS(domainName, taskListName, taskType))
+			},
+			PartitionDownscaleFactor: func() float64 {
+				return config.PartitionDownscaleFactor()
+			},
+			PartitionUpscaleSustainedDuration: func() time.Duration {
+				return config.PartitionUpscaleSustainedDuration(domainName, taskListName, taskType)
+			},
+			PartitionDownscaleSustainedDuration: func() time.Duration {
+				return config.PartitionDownscaleSustainedDuration(domainName, taskListName, taskType)
+			},
+			PartitionConfigRefreshInterval: func() time.Duration {
+				return config.PartitionConfigRefreshInterval()
+			},
+		},
 		HostName: config.HostName,
 	}, nil
 }
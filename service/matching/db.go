@@ -39,12 +39,17 @@ type (
 		taskListKind int
 		taskType     int
 		rangeID      int64
+		ackLevel     int64
 		store        persistence.TaskManager
 		logger       log.Logger
+		// partitionConfig is the partition config persisted by the adaptive scaler,
+		// nil when the number of partitions comes from dynamic config
+		partitionConfig *persistence.TaskListPartitionConfig
 	}
 	taskListState struct {
-		rangeID  int64
-		ackLevel int64
+		rangeID         int64
+		ackLevel        int64
+		partitionConfig *persistence.TaskListPartitionConfig
 	}
 )
 
@@ -94,25 +99,55 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 		return taskListState{}, err
 	}
 	db.rangeID = resp.TaskListInfo.RangeID
-	return taskListState{rangeID: db.rangeID, ackLevel: resp.TaskListInfo.AckLevel}, nil
+	db.ackLevel = resp.TaskListInfo.AckLevel
+	db.partitionConfig = resp.TaskListInfo.AdaptivePartitionConfig
+	return taskListState{
+		rangeID:         db.rangeID,
+		ackLevel:        db.ackLevel,
+		partitionConfig: db.partitionConfig,
+	}, nil
+}
+
+// PartitionConfig returns the current persistence view of the partition config
+func (db *taskListDB) PartitionConfig() *persistence.TaskListPartitionConfig {
+	db.Lock()
+	defer db.Unlock()
+	return db.partitionConfig
 }
 
 // UpdateState updates the taskList state with the given value
 func (db *taskListDB) UpdateState(ackLevel int64) error {
 	db.Lock()
 	defer db.Unlock()
+	return db.updateLocked(ackLevel, db.partitionConfig)
+}
+
+// UpdatePartitionConfig persists the given partition config along with the last known ackLevel
+func (db *taskListDB) UpdatePartitionConfig(partitionConfig *persistence.TaskListPartitionConfig) error {
+	db.Lock()
+	defer db.Unlock()
+	return db.updateLocked(db.ackLevel, partitionConfig)
+}
+
+func (db *taskListDB) updateLocked(ackLevel int64, partitionConfig *persistence.TaskListPartitionConfig) error {
 	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:                db.domainID,
+			Name:                    db.taskListName,
+			TaskType:                db.taskType,
+			AckLevel:                ackLevel,
+			RangeID:                 db.rangeID,
+			Kind:                    db.taskListKind,
+			AdaptivePartitionConfig: partitionConfig,
 		},
 		DomainName: db.domainName,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	db.ackLevel = ackLevel
+	db.partitionConfig = partitionConfig
+	return nil
 }
 
 // CreateTasks creates a batch of given tasks for this task list
@@ -0,0 +1,169 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// fairTaskBuffer buffers backlog tasks in a queue per fairness key and hands them
+	// out in weighted round robin order across the keys, so that a burst of tasks for
+	// one key does not hold back the tasks of the other keys sharing the task list.
+	// Each key gets up to its weight worth of tasks in its turn, the same way the
+	// weighted round robin task scheduler serves its priority queues.
+	fairTaskBuffer struct {
+		sync.Mutex
+
+		capacity int
+		weights  func() map[string]interface{}
+		queues   map[string][]*persistence.TaskInfo
+		keys     []string // keys with buffered tasks in round robin order
+		current  int      // index of the key whose turn it is
+		served   int      // number of tasks handed out in the current turn
+		weight   int      // weight of the key whose turn it is
+		size     int
+		notifyC  chan struct{} // signaled when a task is added
+		spaceC   chan struct{} // signaled when a task is removed
+	}
+)
+
+const defaultFairnessKeyWeight = 1
+
+func newFairTaskBuffer(capacity int, weights func() map[string]interface{}) *fairTaskBuffer {
+	return &fairTaskBuffer{
+		capacity: capacity,
+		weights:  weights,
+		queues:   make(map[string][]*persistence.TaskInfo),
+		notifyC:  make(chan struct{}, 1),
+		spaceC:   make(chan struct{}, 1),
+	}
+}
+
+// Put adds the task to the queue of the given fairness key, blocking while the buffer
+// is full. Returns false if the context is done before the task is added.
+func (b *fairTaskBuffer) Put(ctx context.Context, key string, task *persistence.TaskInfo) bool {
+	for {
+		b.Lock()
+		if b.size < b.capacity {
+			if _, ok := b.queues[key]; !ok {
+				b.keys = append(b.keys, key)
+			}
+			b.queues[key] = append(b.queues[key], task)
+			b.size++
+			b.Unlock()
+			signal(b.notifyC)
+			return true
+		}
+		b.Unlock()
+
+		select {
+		case <-b.spaceC:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// Pop returns the next task in weighted round robin order without blocking,
+// the second return value is false if the buffer is empty
+func (b *fairTaskBuffer) Pop() (*persistence.TaskInfo, bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.size == 0 {
+		return nil, false
+	}
+	for {
+		if b.current >= len(b.keys) {
+			b.current = 0
+		}
+		key := b.keys[b.current]
+		queue := b.queues[key]
+		if len(queue) == 0 {
+			// no more tasks for this key, take it out of the rotation
+			delete(b.queues, key)
+			b.keys = append(b.keys[:b.current], b.keys[b.current+1:]...)
+			b.served = 0
+			continue
+		}
+		if b.served == 0 {
+			b.weight = fairnessKeyWeight(b.weights(), key)
+		}
+		if b.served < b.weight {
+			task := queue[0]
+			queue[0] = nil
+			b.queues[key] = queue[1:]
+			b.served++
+			b.size--
+			signal(b.spaceC)
+			return task, true
+		}
+		// the key used up its weight for this round, move on to the next one
+		b.current++
+		b.served = 0
+	}
+}
+
+// HasRoom returns true if less than maxBufferedTasks tasks of the fairness key are buffered
+func (b *fairTaskBuffer) HasRoom(key string, maxBufferedTasks int) bool {
+	b.Lock()
+	defer b.Unlock()
+	return len(b.queues[key]) < maxBufferedTasks
+}
+
+// NotifyC returns a channel which is signaled when a task is added to the buffer
+func (b *fairTaskBuffer) NotifyC() <-chan struct{} {
+	return b.notifyC
+}
+
+// Len returns the number of buffered tasks
+func (b *fairTaskBuffer) Len() int {
+	b.Lock()
+	defer b.Unlock()
+	return b.size
+}
+
+func fairnessKeyWeight(weights map[string]interface{}, key string) int {
+	var weight int
+	switch w := weights[key].(type) {
+	case int:
+		weight = w
+	case float64:
+		weight = int(w)
+	default:
+		return defaultFairnessKeyWeight
+	}
+	if weight < 1 {
+		return defaultFairnessKeyWeight
+	}
+	return weight
+}
+
+func signal(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default: // channel already has a signal, don't block
+	}
+}
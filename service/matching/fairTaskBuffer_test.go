@@ -0,0 +1,131 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestFairTaskBufferRoundRobin(t *testing.T) {
+	b := newFairTaskBuffer(100, func() map[string]interface{} { return nil })
+	taskID := int64(0)
+	put := func(key string, count int) {
+		for i := 0; i < count; i++ {
+			taskID++
+			require.True(t, b.Put(context.Background(), key, &persistence.TaskInfo{TaskID: taskID, WorkflowID: key}))
+		}
+	}
+	put("a", 4)
+	put("b", 2)
+	put("", 1)
+
+	assert.Equal(t, []string{"a", "b", "", "a", "b", "a", "a"}, popKeys(b))
+	_, ok := b.Pop()
+	assert.False(t, ok)
+	assert.Equal(t, 0, b.Len())
+}
+
+func TestFairTaskBufferWeights(t *testing.T) {
+	b := newFairTaskBuffer(100, func() map[string]interface{} {
+		return map[string]interface{}{"a": 3, "b": 2.0, "c": 0, "d": "invalid"}
+	})
+	for _, key := range []string{"a", "b", "c", "d"} {
+		for i := 0; i < 4; i++ {
+			require.True(t, b.Put(context.Background(), key, &persistence.TaskInfo{WorkflowID: key}))
+		}
+	}
+
+	assert.Equal(t, []string{
+		"a", "a", "a", "b", "b", "c", "d",
+		"a", "b", "b", "c", "d",
+		"c", "d",
+		"c", "d",
+	}, popKeys(b))
+}
+
+func TestFairTaskBufferNewKeyJoinsRotation(t *testing.T) {
+	b := newFairTaskBuffer(100, func() map[string]interface{} { return nil })
+	for i := 0; i < 3; i++ {
+		require.True(t, b.Put(context.Background(), "a", &persistence.TaskInfo{WorkflowID: "a"}))
+	}
+	task, ok := b.Pop()
+	require.True(t, ok)
+	assert.Equal(t, "a", task.WorkflowID)
+
+	require.True(t, b.Put(context.Background(), "b", &persistence.TaskInfo{WorkflowID: "b"}))
+	assert.Equal(t, []string{"b", "a", "a"}, popKeys(b))
+}
+
+func TestFairTaskBufferPutBlocksWhenFull(t *testing.T) {
+	b := newFairTaskBuffer(1, func() map[string]interface{} { return nil })
+	require.True(t, b.Put(context.Background(), "a", &persistence.TaskInfo{WorkflowID: "a"}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.False(t, b.Put(ctx, "b", &persistence.TaskInfo{WorkflowID: "b"}))
+
+	done := make(chan bool)
+	go func() {
+		done <- b.Put(context.Background(), "b", &persistence.TaskInfo{WorkflowID: "b"})
+	}()
+	task, ok := b.Pop()
+	require.True(t, ok)
+	assert.Equal(t, "a", task.WorkflowID)
+	select {
+	case added := <-done:
+		assert.True(t, added)
+	case <-time.After(time.Second):
+		t.Fatal("put did not unblock after a task was removed")
+	}
+	assert.Equal(t, []string{"b"}, popKeys(b))
+}
+
+func TestFairTaskBufferHasRoom(t *testing.T) {
+	b := newFairTaskBuffer(100, func() map[string]interface{} { return nil })
+	for i := 0; i < 2; i++ {
+		require.True(t, b.Put(context.Background(), "a", &persistence.TaskInfo{WorkflowID: "a"}))
+	}
+	assert.False(t, b.HasRoom("a", 2))
+	assert.True(t, b.HasRoom("a", 3))
+	assert.True(t, b.HasRoom("b", 2))
+
+	_, ok := b.Pop()
+	require.True(t, ok)
+	assert.True(t, b.HasRoom("a", 2))
+}
+
+func popKeys(b *fairTaskBuffer) []string {
+	var keys []string
+	for {
+		task, ok := b.Pop()
+		if !ok {
+			return keys
+		}
+		keys = append(keys, task.WorkflowID)
+	}
+}
@@ -131,6 +131,10 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 
 	switch fwdr.taskListID.taskType {
 	case persistence.TaskListTypeDecision:
+		var workflowType *types.WorkflowType
+		if task.event.TypeName != "" {
+			workflowType = &types.WorkflowType{Name: task.event.TypeName}
+		}
 		err = fwdr.client.AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
 			DomainUUID: task.event.DomainID,
 			Execution:  task.workflowExecution(),
@@ -143,8 +147,14 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
 			PartitionConfig:               task.event.PartitionConfig,
+			Priority:                      task.event.Priority,
+			WorkflowType:                  workflowType,
 		})
 	case persistence.TaskListTypeActivity:
+		var activityType *types.ActivityType
+		if task.event.TypeName != "" {
+			activityType = &types.ActivityType{Name: task.event.TypeName}
+		}
 		err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
 			DomainUUID:       fwdr.taskListID.domainID,
 			SourceDomainUUID: task.event.DomainID,
@@ -158,6 +168,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
 			PartitionConfig:               task.event.PartitionConfig,
+			Priority:                      task.event.Priority,
+			ActivityType:                  activityType,
 		})
 	default:
 		return errInvalidTaskListType
@@ -78,6 +78,11 @@ func (g grpcHandler) GetTaskListsByDomain(ctx context.Context, request *matching
 	return proto.FromMatchingGetTaskListsByDomainResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) GetTaskListPartitionConfig(ctx context.Context, request *matchingv1.GetTaskListPartitionConfigRequest) (*matchingv1.GetTaskListPartitionConfigResponse, error) {
+	response, err := g.h.GetTaskListPartitionConfig(ctx, proto.ToMatchingGetTaskListPartitionConfigRequest(request))
+	return proto.FromMatchingGetTaskListPartitionConfigResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) PollForActivityTask(ctx context.Context, request *matchingv1.PollForActivityTaskRequest) (*matchingv1.PollForActivityTaskResponse, error) {
 	response, err := g.h.PollForActivityTask(ctx, proto.ToMatchingPollForActivityTaskRequest(request))
 	return proto.FromMatchingPollForActivityTaskResponse(response), proto.FromError(err)
@@ -115,6 +115,21 @@ func (mr *MockHandlerMockRecorder) DescribeTaskList(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockHandler)(nil).DescribeTaskList), arg0, arg1)
 }
 
+// GetTaskListPartitionConfig mocks base method.
+func (m *MockHandler) GetTaskListPartitionConfig(arg0 context.Context, arg1 *types.MatchingGetTaskListPartitionConfigRequest) (*types.MatchingGetTaskListPartitionConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListPartitionConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.MatchingGetTaskListPartitionConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListPartitionConfig indicates an expected call of GetTaskListPartitionConfig.
+func (mr *MockHandlerMockRecorder) GetTaskListPartitionConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListPartitionConfig", reflect.TypeOf((*MockHandler)(nil).GetTaskListPartitionConfig), arg0, arg1)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockHandler) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
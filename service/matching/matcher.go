@@ -23,10 +23,13 @@ package matching
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
 
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
@@ -43,6 +46,10 @@ type TaskMatcher struct {
 	// synchronos task channels to match producer/consumer for a certain isolation group
 	// the key is the name of the isolation group
 	isolatedTaskC map[string]chan *InternalTask
+	// synchronous task channels for tasks with a dispatch priority, pollers
+	// read from these channels ahead of taskC and isolatedTaskC
+	priorityTaskC         chan *InternalTask
+	isolatedPriorityTaskC map[string]chan *InternalTask
 	// synchronous task channel to match query task - the reason to have
 	// separate channel for this is because there are cases when consumers
 	// are interested in queryTasks but not others. Example is when domain is
@@ -50,10 +57,19 @@ type TaskMatcher struct {
 	queryTaskC chan *InternalTask
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter *quotas.RateLimiter
+	// ratelimiters that limit the rate at which tasks of a workflow type or an
+	// activity type can be dispatched to consumers, keyed by the type name
+	typeLimiters    *quotas.Collection
+	typeDispatchRPS func() map[string]interface{}
 
 	fwdr          *Forwarder
 	scope         metrics.Scope // domain metric scope
 	numPartitions func() int    // number of task list partitions
+
+	// number of consecutive high priority tasks handed to pollers, used
+	// to keep a steady stream of priority tasks from starving other tasks
+	priorityStreak              int64
+	priorityStarvationThreshold func() int
 }
 
 const (
@@ -63,25 +79,43 @@ const (
 
 var errTasklistThrottled = errors.New("cannot add to tasklist, limit exceeded")
 
+// taskTypeThrottledError is returned by MustOffer when the workflow or activity type of the
+// task has no dispatch capacity left, the type has capacity again after the delay
+type taskTypeThrottledError struct {
+	delay time.Duration
+}
+
+func (e *taskTypeThrottledError) Error() string {
+	return fmt.Sprintf("task type throttled, retry after %v", e.delay)
+}
+
 // newTaskMatcher returns an task matcher instance. The returned instance can be
 // used by task producers and consumers to find a match. Both sync matches and non-sync
 // matches should use this implementation
-func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scope metrics.Scope, isolationGroups []string) *TaskMatcher {
+func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scope metrics.Scope, isolationGroups []string, numPartitions func() int) *TaskMatcher {
 	dPtr := _defaultTaskDispatchRPS
 	limiter := quotas.NewRateLimiter(&dPtr, _defaultTaskDispatchRPSTTL, config.MinTaskThrottlingBurstSize())
 	isolatedTaskC := make(map[string]chan *InternalTask)
+	isolatedPriorityTaskC := make(map[string]chan *InternalTask)
 	for _, g := range isolationGroups {
 		isolatedTaskC[g] = make(chan *InternalTask)
+		isolatedPriorityTaskC[g] = make(chan *InternalTask)
 	}
-	return &TaskMatcher{
-		limiter:       limiter,
-		scope:         scope,
-		fwdr:          fwdr,
-		taskC:         make(chan *InternalTask),
-		isolatedTaskC: isolatedTaskC,
-		queryTaskC:    make(chan *InternalTask),
-		numPartitions: config.NumReadPartitions,
+	tm := &TaskMatcher{
+		limiter:                     limiter,
+		typeDispatchRPS:             config.TypeDispatchRPS,
+		scope:                       scope,
+		fwdr:                        fwdr,
+		taskC:                       make(chan *InternalTask),
+		isolatedTaskC:               isolatedTaskC,
+		priorityTaskC:               make(chan *InternalTask),
+		isolatedPriorityTaskC:       isolatedPriorityTaskC,
+		queryTaskC:                  make(chan *InternalTask),
+		numPartitions:               numPartitions,
+		priorityStarvationThreshold: config.PriorityStarvationThreshold,
 	}
+	tm.typeLimiters = quotas.NewCollection(quotas.DynamicRateLimiterFactory(tm.typeRatePerPartition))
+	return tm
 }
 
 // Offer offers a task to a potential consumer (poller)
@@ -99,8 +133,10 @@ func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scope metrics.Scope
 //
 // Ratelimit:
 // When a ratelimit token is not available, this method might block
-// waiting for a token until the provided context timeout. Rate limits are
-// not enforced for forwarded tasks from child partition.
+// waiting for a token until the provided context timeout. This applies to
+// both the task list ratelimit and the ratelimit of the workflow or activity
+// type of the task. Rate limits are not enforced for forwarded tasks from
+// child partition.
 //
 // Forwarded tasks that originated from db backlog:
 // When this method is called with a task that is forwarded from a
@@ -123,6 +159,14 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 			return false, err
 		}
 	}
+	typeRsv, err := tm.typeRatelimit(ctx, task)
+	if err != nil {
+		if rsv != nil {
+			rsv.Cancel()
+		}
+		tm.scope.IncCounter(metrics.SyncThrottlePerTaskListCounter)
+		return false, err
+	}
 
 	select {
 	case tm.getTaskC(task) <- task: // poller picked up the task
@@ -159,11 +203,19 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 			// return it since we did not really do any work
 			rsv.Cancel()
 		}
+		if typeRsv != nil {
+			typeRsv.Cancel()
+		}
 		return false, nil
 	}
 }
 
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *InternalTask) (bool, error) {
+	typeRsv, err := tm.typeRatelimit(ctx, task)
+	if err != nil {
+		tm.scope.IncCounter(metrics.SyncThrottlePerTaskListCounter)
+		return false, nil
+	}
 	select {
 	case tm.getTaskC(task) <- task: // poller picked up the task
 		if task.responseC != nil {
@@ -176,6 +228,9 @@ func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *InternalTask) (
 		}
 		return task.activityTaskDispatchInfo != nil, nil
 	case <-ctx.Done():
+		if typeRsv != nil {
+			typeRsv.Cancel()
+		}
 		return false, nil
 	}
 }
@@ -220,7 +275,12 @@ func (tm *TaskMatcher) OfferQuery(ctx context.Context, task *InternalTask) (*typ
 // MustOffer blocks until a consumer is found to handle this task
 // Returns error only when context is canceled or the ratelimit is set to zero (allow nothing)
 // The passed in context MUST NOT have a deadline associated with it
+// It does not wait for the ratelimit of the workflow or activity type of the task, a
+// *taskTypeThrottledError is returned instead so that tasks of other types are not held back
 func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error {
+	if delay := tm.typeThrottleDelay(task); delay > 0 {
+		return &taskTypeThrottledError{delay: delay}
+	}
 	if _, err := tm.ratelimit(ctx); err != nil {
 		return err
 	}
@@ -276,22 +336,46 @@ forLoop:
 
 // Poll blocks until a task is found or context deadline is exceeded
 // On success, the returned task could be a query task or a regular task
+// High priority tasks are returned ahead of other tasks unless they have
+// been dispatched back to back more than the starvation threshold
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) Poll(ctx context.Context, isolationGroup string) (*InternalTask, error) {
+	task, err := tm.pollTask(ctx, isolationGroup)
+	if err == nil {
+		tm.recordDispatch(task)
+	}
+	return task, err
+}
+
+func (tm *TaskMatcher) pollTask(ctx context.Context, isolationGroup string) (*InternalTask, error) {
 	isolatedTaskC, ok := tm.isolatedTaskC[isolationGroup]
+	isolatedPriorityTaskC := tm.isolatedPriorityTaskC[isolationGroup]
 	if !ok && isolationGroup != "" {
 		// fallback to default isolation group instead of making poller crash if the isolation group is invalid
 		isolatedTaskC = tm.taskC
+		isolatedPriorityTaskC = tm.priorityTaskC
 		tm.scope.IncCounter(metrics.PollerInvalidIsolationGroupCounter)
 	}
-	// try local match first without blocking until context timeout
+	// try local match first without blocking until context timeout, priority tasks
+	// are picked first unless other tasks are being starved by them
+	starved := tm.isStarvedByPriorityTasks()
+	if !starved {
+		if task, err := tm.pollNonBlocking(ctx, isolatedPriorityTaskC, tm.priorityTaskC, nil); err == nil {
+			return task, nil
+		}
+	}
 	if task, err := tm.pollNonBlocking(ctx, isolatedTaskC, tm.taskC, tm.queryTaskC); err == nil {
 		return task, nil
 	}
+	if starved {
+		if task, err := tm.pollNonBlocking(ctx, isolatedPriorityTaskC, tm.priorityTaskC, nil); err == nil {
+			return task, nil
+		}
+	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, isolationGroup, isolatedTaskC, tm.taskC, tm.queryTaskC)
+	return tm.pollOrForward(ctx, isolationGroup, isolatedPriorityTaskC, tm.priorityTaskC, isolatedTaskC, tm.taskC, tm.queryTaskC)
 }
 
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
@@ -304,7 +388,7 @@ func (tm *TaskMatcher) PollForQuery(ctx context.Context) (*InternalTask, error)
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, "", nil, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctx, "", nil, nil, nil, nil, tm.queryTaskC)
 }
 
 // UpdateRatelimit updates the task dispatch rate
@@ -329,11 +413,25 @@ func (tm *TaskMatcher) Rate() float64 {
 func (tm *TaskMatcher) pollOrForward(
 	ctx context.Context,
 	isolationGroup string,
+	isolatedPriorityTaskC <-chan *InternalTask,
+	priorityTaskC <-chan *InternalTask,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
+	case task := <-isolatedPriorityTaskC:
+		if task.responseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
+	case task := <-priorityTaskC:
+		if task.responseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-isolatedTaskC:
 		if task.responseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -359,17 +457,31 @@ func (tm *TaskMatcher) pollOrForward(
 			return task, nil
 		}
 		token.release(isolationGroup)
-		return tm.poll(ctx, isolatedTaskC, taskC, queryTaskC)
+		return tm.poll(ctx, isolatedPriorityTaskC, priorityTaskC, isolatedTaskC, taskC, queryTaskC)
 	}
 }
 
 func (tm *TaskMatcher) poll(
 	ctx context.Context,
+	isolatedPriorityTaskC <-chan *InternalTask,
+	priorityTaskC <-chan *InternalTask,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
+	case task := <-isolatedPriorityTaskC:
+		if task.responseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
+	case task := <-priorityTaskC:
+		if task.responseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-isolatedTaskC:
 		if task.responseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -435,6 +547,62 @@ func (tm *TaskMatcher) fwdrAddReqTokenC() <-chan *ForwarderReqToken {
 }
 
 func (tm *TaskMatcher) ratelimit(ctx context.Context) (*rate.Reservation, error) {
+	return tm.reserveToken(ctx, tm.limiter)
+}
+
+// typeRatelimit limits the dispatch rate of tasks by their workflow or activity type.
+// Tasks forwarded from a child partition were already limited by the child partition
+func (tm *TaskMatcher) typeRatelimit(ctx context.Context, task *InternalTask) (*rate.Reservation, error) {
+	if task.isForwarded() || task.event == nil || task.event.TypeName == "" {
+		return nil, nil
+	}
+	if tm.typeRatePerPartition(task.event.TypeName) == dynamicconfig.UnlimitedRPS {
+		return nil, nil
+	}
+	return tm.reserveToken(ctx, tm.typeLimiters.For(task.event.TypeName))
+}
+
+// typeThrottleDelay takes a dispatch token of the workflow or activity type of the task without
+// blocking. It returns how long to wait for the next token if none is available right now
+func (tm *TaskMatcher) typeThrottleDelay(task *InternalTask) time.Duration {
+	if task.isForwarded() || task.event == nil || task.event.TypeName == "" {
+		return 0
+	}
+	if tm.typeRatePerPartition(task.event.TypeName) == dynamicconfig.UnlimitedRPS {
+		return 0
+	}
+	rsv := tm.typeLimiters.For(task.event.TypeName).Reserve()
+	if !rsv.OK() {
+		return time.Second
+	}
+	delay := rsv.Delay()
+	if delay > 0 {
+		// the token is taken again when the task is dispatched after the delay
+		rsv.Cancel()
+	}
+	return delay
+}
+
+// typeRatePerPartition returns the dispatch rate of the given workflow or activity type
+// for this partition. The configured rate applies to the whole task list, so it is
+// divided equally across all partitions
+func (tm *TaskMatcher) typeRatePerPartition(typeName string) float64 {
+	var rps float64
+	switch r := tm.typeDispatchRPS()[typeName].(type) {
+	case int:
+		rps = float64(r)
+	case float64:
+		rps = r
+	default:
+		return dynamicconfig.UnlimitedRPS
+	}
+	if rps <= 0 {
+		return dynamicconfig.UnlimitedRPS
+	}
+	return rps / float64(tm.numPartitions())
+}
+
+func (tm *TaskMatcher) reserveToken(ctx context.Context, limiter quotas.Limiter) (*rate.Reservation, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -443,13 +611,13 @@ func (tm *TaskMatcher) ratelimit(ctx context.Context) (*rate.Reservation, error)
 
 	deadline, ok := ctx.Deadline()
 	if !ok {
-		if err := tm.limiter.Wait(ctx); err != nil {
+		if err := limiter.Wait(ctx); err != nil {
 			return nil, err
 		}
 		return nil, nil
 	}
 
-	rsv := tm.limiter.Reserve()
+	rsv := limiter.Reserve()
 	// If we have to wait too long for reservation, give up and return
 	if !rsv.OK() || rsv.Delay() > time.Until(deadline) {
 		if rsv.OK() { // if we were indeed given a reservation, return it before we bail out
@@ -467,9 +635,32 @@ func (tm *TaskMatcher) isForwardingAllowed() bool {
 }
 
 func (tm *TaskMatcher) getTaskC(task *InternalTask) chan<- *InternalTask {
+	if task.isHighPriority() {
+		priorityTaskC := tm.priorityTaskC
+		if isolatedPriorityTaskC, ok := tm.isolatedPriorityTaskC[task.isolationGroup]; ok && task.isolationGroup != "" {
+			priorityTaskC = isolatedPriorityTaskC
+		}
+		return priorityTaskC
+	}
 	taskC := tm.taskC
 	if isolatedTaskC, ok := tm.isolatedTaskC[task.isolationGroup]; ok && task.isolationGroup != "" {
 		taskC = isolatedTaskC
 	}
 	return taskC
 }
+
+// isStarvedByPriorityTasks returns true when enough high priority tasks have been
+// dispatched back to back that pollers should look at other tasks first
+func (tm *TaskMatcher) isStarvedByPriorityTasks() bool {
+	threshold := tm.priorityStarvationThreshold()
+	return threshold > 0 && atomic.LoadInt64(&tm.priorityStreak) >= int64(threshold)
+}
+
+func (tm *TaskMatcher) recordDispatch(task *InternalTask) {
+	switch {
+	case task.isHighPriority():
+		atomic.AddInt64(&tm.priorityStreak, 1)
+	case !task.isQuery():
+		atomic.StoreInt64(&tm.priorityStreak, 0)
+	}
+}
@@ -23,6 +23,7 @@ package matching
 import (
 	"context"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -72,12 +73,12 @@ func (t *MatcherTestSuite) SetupTest() {
 	t.cfg = tlCfg
 	t.isolationGroups = []string{"dca1", "dca2"}
 	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskList, types.TaskListKindNormal, t.client, []string{"dca1", "dca2"})
-	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopScope(metrics.Matching), []string{"dca1", "dca2"})
+	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopScope(metrics.Matching), []string{"dca1", "dca2"}, tlCfg.NumReadPartitions)
 
 	rootTaskList := newTestTaskListID(t.taskList.domainID, t.taskList.Parent(20), persistence.TaskListTypeDecision)
 	rootTasklistCfg, err := newTaskListConfig(rootTaskList, cfg, t.newDomainCache())
 	t.NoError(err)
-	t.rootMatcher = newTaskMatcher(rootTasklistCfg, nil, metrics.NoopScope(metrics.Matching), []string{"dca1", "dca2"})
+	t.rootMatcher = newTaskMatcher(rootTasklistCfg, nil, metrics.NoopScope(metrics.Matching), []string{"dca1", "dca2"}, rootTasklistCfg.NumReadPartitions)
 }
 
 func (t *MatcherTestSuite) TearDownTest() {
@@ -575,6 +576,129 @@ func (t *MatcherTestSuite) TestIsolationPollFailure() {
 	t.Nil(task)
 }
 
+func (t *MatcherTestSuite) TestPollPrefersPriorityTask() {
+	var wg sync.WaitGroup
+	t.offerAsync(&wg, t.rootMatcher, 0)
+	t.offerAsync(&wg, t.rootMatcher, 5)
+	time.Sleep(100 * time.Millisecond) // let the offers block waiting for a poller
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	task, err := t.rootMatcher.Poll(ctx, "")
+	t.NoError(err)
+	t.Equal(int32(5), task.event.Priority)
+	task, err = t.rootMatcher.Poll(ctx, "")
+	t.NoError(err)
+	t.Equal(int32(0), task.event.Priority)
+	wg.Wait()
+}
+
+func (t *MatcherTestSuite) TestPollPriorityStarvationThreshold() {
+	t.rootMatcher.priorityStarvationThreshold = func() int { return 2 }
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		t.offerAsync(&wg, t.rootMatcher, 1)
+	}
+	t.offerAsync(&wg, t.rootMatcher, 0)
+	time.Sleep(100 * time.Millisecond) // let the offers block waiting for a poller
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var priorities []int32
+	for i := 0; i < 4; i++ {
+		task, err := t.rootMatcher.Poll(ctx, "")
+		t.NoError(err)
+		priorities = append(priorities, task.event.Priority)
+	}
+	t.Equal([]int32{1, 1, 0, 1}, priorities)
+	wg.Wait()
+}
+
+func (t *MatcherTestSuite) TestOfferTypeRatelimit() {
+	t.rootMatcher.typeDispatchRPS = func() map[string]interface{} {
+		return map[string]interface{}{"throttled-type": 1}
+	}
+	// consume the only token of the throttled type
+	t.True(t.rootMatcher.typeLimiters.For("throttled-type").Allow())
+
+	taskInfo := t.newTaskInfo()
+	taskInfo.TypeName = "throttled-type"
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true, nil, "")
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	syncMatch, err := t.rootMatcher.Offer(ctx, task)
+	cancel()
+	t.Equal(errTasklistThrottled, err)
+	t.False(syncMatch)
+
+	// tasks of other types are not throttled
+	taskInfo = t.newTaskInfo()
+	taskInfo.TypeName = "other-type"
+	task = newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true, nil, "")
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	syncMatch, err = t.rootMatcher.Offer(ctx, task)
+	cancel()
+	t.NoError(err)
+	t.False(syncMatch)
+}
+
+func (t *MatcherTestSuite) TestMustOfferTypeRatelimit() {
+	t.rootMatcher.typeDispatchRPS = func() map[string]interface{} {
+		return map[string]interface{}{"throttled-type": 1}
+	}
+	// consume the only token of the throttled type
+	t.True(t.rootMatcher.typeLimiters.For("throttled-type").Allow())
+
+	// the throttled task is handed back instead of blocking the dispatch of other tasks
+	taskInfo := t.newTaskInfo()
+	taskInfo.TypeName = "throttled-type"
+	task := newInternalTask(taskInfo, nil, types.TaskSourceDbBacklog, "", false, nil, "")
+	err := t.rootMatcher.MustOffer(context.Background(), task)
+	t.IsType(&taskTypeThrottledError{}, err)
+	t.True(err.(*taskTypeThrottledError).delay > 0)
+
+	taskInfo = t.newTaskInfo()
+	taskInfo.TypeName = "other-type"
+	task = newInternalTask(taskInfo, nil, types.TaskSourceDbBacklog, "", false, nil, "")
+	pollerDone := make(chan struct{})
+	go func() {
+		defer close(pollerDone)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		polledTask, err := t.rootMatcher.Poll(ctx, "")
+		t.NoError(err)
+		t.Equal("other-type", polledTask.event.TypeName)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	t.NoError(t.rootMatcher.MustOffer(ctx, task))
+	<-pollerDone
+}
+
+func (t *MatcherTestSuite) TestTypeRatePerPartition() {
+	t.rootMatcher.numPartitions = func() int { return 2 }
+	t.rootMatcher.typeDispatchRPS = func() map[string]interface{} {
+		return map[string]interface{}{"int-type": 10, "float-type": 0.5, "zero-type": 0, "invalid-type": "10"}
+	}
+	t.Equal(5.0, t.rootMatcher.typeRatePerPartition("int-type"))
+	t.Equal(0.25, t.rootMatcher.typeRatePerPartition("float-type"))
+	t.Equal(float64(dynamicconfig.UnlimitedRPS), t.rootMatcher.typeRatePerPartition("zero-type"))
+	t.Equal(float64(dynamicconfig.UnlimitedRPS), t.rootMatcher.typeRatePerPartition("invalid-type"))
+	t.Equal(float64(dynamicconfig.UnlimitedRPS), t.rootMatcher.typeRatePerPartition("missing-type"))
+}
+
+func (t *MatcherTestSuite) offerAsync(wg *sync.WaitGroup, matcher *TaskMatcher, priority int32) {
+	taskInfo := t.newTaskInfo()
+	taskInfo.Priority = priority
+	task := newInternalTask(taskInfo, nil, types.TaskSourceDbBacklog, "", false, nil, "")
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		t.NoError(matcher.MustOffer(ctx, task))
+	}()
+}
+
 func (t *MatcherTestSuite) newDomainCache() cache.DomainCache {
 	domainName := "test-domain"
 	dc := cache.NewMockDomainCache(t.controller)
@@ -338,6 +338,8 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
 		PartitionConfig:        request.GetPartitionConfig(),
+		Priority:               request.GetPriority(),
+		TypeName:               request.GetWorkflowType().GetName(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -404,6 +406,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
 		PartitionConfig:        request.GetPartitionConfig(),
+		Priority:               request.GetPriority(),
+		TypeName:               request.GetActivityType().GetName(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -779,6 +783,31 @@ func (e *matchingEngineImpl) DescribeTaskList(
 	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
 }
 
+func (e *matchingEngineImpl) GetTaskListPartitionConfig(
+	hCtx *handlerContext,
+	request *types.MatchingGetTaskListPartitionConfigRequest,
+) (*types.MatchingGetTaskListPartitionConfigResponse, error) {
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskList, err := newTaskListID(request.GetDomainUUID(), request.GetTaskList().GetName(), taskListType)
+	if err != nil {
+		return nil, err
+	}
+	if !taskList.IsRoot() {
+		return nil, &types.BadRequestError{Message: "Partition config is only available on the root partition of a task list."}
+	}
+
+	tlMgr, err := e.getTaskListManager(taskList, types.TaskListKindNormal.Ptr())
+	if err != nil {
+		return nil, err
+	}
+	return &types.MatchingGetTaskListPartitionConfigResponse{
+		PartitionConfig: tlMgr.PartitionConfig(),
+	}, nil
+}
+
 func (e *matchingEngineImpl) ListTaskListPartitions(
 	hCtx *handlerContext,
 	request *types.MatchingListTaskListPartitionsRequest,
@@ -865,6 +894,10 @@ func (e *matchingEngineImpl) getAllPartitions(
 
 	nWritePartitions := e.config.NumTasklistWritePartitions
 	n := nWritePartitions(request.GetDomain(), rootPartition, taskListType)
+	if config := e.getLoadedPartitionConfig(taskListID); config != nil {
+		// partitions may have been scaled by the root partition, every partition that can still be read from is listed
+		n = int(config.NumReadPartitions)
+	}
 	if n <= 0 {
 		return partitionKeys, nil
 	}
@@ -876,6 +909,22 @@ func (e *matchingEngineImpl) getAllPartitions(
 	return partitionKeys, nil
 }
 
+// getLoadedPartitionConfig returns the partition config of the root partition
+// if it is owned by this host, it does not load the task list otherwise
+func (e *matchingEngineImpl) getLoadedPartitionConfig(taskList *taskListID) *types.TaskListPartitionConfig {
+	rootID, err := newTaskListID(taskList.domainID, taskList.GetRoot(), taskList.taskType)
+	if err != nil {
+		return nil
+	}
+	e.taskListsLock.RLock()
+	tlMgr, ok := e.taskLists[*rootID]
+	e.taskListsLock.RUnlock()
+	if !ok {
+		return nil
+	}
+	return tlMgr.PartitionConfig()
+}
+
 // Loads a task from persistence and wraps it in a task context
 func (e *matchingEngineImpl) getTask(
 	ctx context.Context, taskList *taskListID, maxDispatchPerSecond *float64, taskListKind *types.TaskListKind,
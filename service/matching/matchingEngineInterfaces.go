@@ -36,5 +36,6 @@ type (
 		DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(hCtx *handlerContext, request *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		GetTaskListPartitionConfig(hCtx *handlerContext, request *types.MatchingGetTaskListPartitionConfigRequest) (*types.MatchingGetTaskListPartitionConfigResponse, error)
 	}
 )
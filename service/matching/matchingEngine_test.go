@@ -242,7 +242,7 @@ func (s *matchingEngineSuite) PollForDecisionTasksResultTest() {
 	stickyTaskList.Kind = &stickyTlKind
 
 	s.matchingEngine.config.RangeSize = 2 // to test that range is not updated without tasks
-	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByIsolationGroup(10 * time.Millisecond)
 
 	runID := "run1"
 	workflowID := "workflow1"
@@ -317,7 +317,7 @@ func (s *matchingEngineSuite) PollForDecisionTasksResultTest() {
 func (s *matchingEngineSuite) PollForTasksEmptyResultTest(callContext context.Context, taskType int) {
 	s.matchingEngine.config.RangeSize = 2 // to test that range is not updated without tasks
 	if _, ok := callContext.Deadline(); !ok {
-		s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+		s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByIsolationGroup(10 * time.Millisecond)
 	}
 
 	domainID := "domainId"
@@ -489,7 +489,7 @@ func (s *matchingEngineSuite) TestAddAndPollActivityTasksIsolation() {
 }
 
 func (s *matchingEngineSuite) AddAndPollTasks(taskType int, enableIsolation bool) {
-	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByIsolationGroup(10 * time.Millisecond)
 	s.matchingEngine.config.EnableTasklistIsolation = dynamicconfig.GetBoolPropertyFnFilteredByDomainID(enableIsolation)
 
 	isolationGroups := s.matchingEngine.config.AllIsolationGroups
@@ -577,7 +577,7 @@ func (s *matchingEngineSuite) SyncMatchTasks(taskType int, enableIsolation bool)
 	isolationGroups := s.matchingEngine.config.AllIsolationGroups
 
 	// Set a short long poll expiration so we don't have to wait too long for 0 throttling cases
-	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(50 * time.Millisecond)
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByIsolationGroup(50 * time.Millisecond)
 	s.matchingEngine.config.RangeSize = rangeSize // override to low number for the test
 	// So we can get snapshots
 	scope := tally.NewTestScope("test", nil)
@@ -1238,7 +1238,7 @@ func (s *matchingEngineSuite) TestUnloadDecisionTasklistOnIsolationConfigChange(
 }
 
 func (s *matchingEngineSuite) UnloadTasklistOnIsolationConfigChange(taskType int) {
-	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(50 * time.Millisecond)
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByIsolationGroup(50 * time.Millisecond)
 	s.matchingEngine.config.EnableTasklistIsolation = dynamicconfig.GetBoolPropertyFnFilteredByDomainID(false)
 
 	const taskCount = 1000
@@ -1297,7 +1297,7 @@ func (s *matchingEngineSuite) TestDrainDecisionBacklogNoPollersIsolationGroup()
 }
 
 func (s *matchingEngineSuite) DrainBacklogNoPollersIsolationGroup(taskType int) {
-	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByIsolationGroup(10 * time.Millisecond)
 	s.matchingEngine.config.EnableTasklistIsolation = dynamicconfig.GetBoolPropertyFnFilteredByDomainID(true)
 	s.matchingEngine.config.AsyncTaskDispatchTimeout = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
 
@@ -1365,7 +1365,7 @@ func (s *matchingEngineSuite) DrainBacklogNoPollersIsolationGroup(taskType int)
 func (s *matchingEngineSuite) TestAddStickyDecisionNoPollerIsolation() {
 	s.T().Skip("skip test until we re-enable isolation for sticky tasklist")
 	taskType := persistence.TaskListTypeDecision
-	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByIsolationGroup(10 * time.Millisecond)
 	s.matchingEngine.config.EnableTasklistIsolation = dynamicconfig.GetBoolPropertyFnFilteredByDomainID(true)
 	s.matchingEngine.config.AsyncTaskDispatchTimeout = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(100 * time.Millisecond)
 
@@ -1609,6 +1609,7 @@ type testTaskListManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	partitionConfig *persistence.TaskListPartitionConfig
 	createTaskCount int
 	tasks           *treemap.Map
 }
@@ -1638,12 +1639,25 @@ func newTestTaskListID(domainID string, name string, taskType int) *taskListID {
 	return result
 }
 
+// newTestPersistedTaskListID returns the key of a task list persisted by the test task manager, the priority
+// queues of task lists are persisted under names which are not valid task list names
+func newTestPersistedTaskListID(domainID string, name string, taskType int) *taskListID {
+	if result, err := newTaskListID(domainID, name, taskType); err == nil {
+		return result
+	}
+	return &taskListID{
+		qualifiedTaskListName: qualifiedTaskListName{name: name, baseName: name},
+		domainID:              domainID,
+		taskType:              taskType,
+	}
+}
+
 // LeaseTaskList provides a mock function with given fields: ctx, request
 func (m *testTaskManager) LeaseTaskList(
 	_ context.Context,
 	request *persistence.LeaseTaskListRequest,
 ) (*persistence.LeaseTaskListResponse, error) {
-	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManager(newTestPersistedTaskListID(request.DomainID, request.TaskList, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	tlm.rangeID++
@@ -1657,6 +1671,8 @@ func (m *testTaskManager) LeaseTaskList(
 			TaskType: request.TaskType,
 			RangeID:  tlm.rangeID,
 			Kind:     request.TaskListKind,
+
+			AdaptivePartitionConfig: tlm.partitionConfig,
 		},
 	}, nil
 }
@@ -1669,7 +1685,7 @@ func (m *testTaskManager) UpdateTaskList(
 	m.logger.Debug(fmt.Sprintf("UpdateTaskList taskListInfo=%v, ackLevel=%v", request.TaskListInfo, request.TaskListInfo.AckLevel))
 
 	tli := request.TaskListInfo
-	tlm := m.getTaskListManager(newTestTaskListID(tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManager(newTestPersistedTaskListID(tli.DomainID, tli.Name, tli.TaskType))
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1679,6 +1695,7 @@ func (m *testTaskManager) UpdateTaskList(
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.partitionConfig = tli.AdaptivePartitionConfig
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -1693,7 +1710,7 @@ func (m *testTaskManager) CompleteTask(
 	}
 
 	tli := request.TaskList
-	tlm := m.getTaskListManager(newTestTaskListID(tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManager(newTestPersistedTaskListID(tli.DomainID, tli.Name, tli.TaskType))
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1707,7 +1724,7 @@ func (m *testTaskManager) CompleteTasksLessThan(
 	_ context.Context,
 	request *persistence.CompleteTasksLessThanRequest,
 ) (*persistence.CompleteTasksLessThanResponse, error) {
-	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskListName, request.TaskType))
+	tlm := m.getTaskListManager(newTestPersistedTaskListID(request.DomainID, request.TaskListName, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	rowsDeleted := 0
@@ -1737,7 +1754,7 @@ func (m *testTaskManager) DeleteTaskList(
 ) error {
 	m.Lock()
 	defer m.Unlock()
-	key := newTestTaskListID(request.DomainID, request.TaskListName, request.TaskListType)
+	key := newTestPersistedTaskListID(request.DomainID, request.TaskListName, request.TaskListType)
 	delete(m.taskLists, *key)
 	return nil
 }
@@ -1752,7 +1769,7 @@ func (m *testTaskManager) CreateTasks(
 	taskType := request.TaskListInfo.TaskType
 	rangeID := request.TaskListInfo.RangeID
 
-	tlm := m.getTaskListManager(newTestTaskListID(domainID, taskList, taskType))
+	tlm := m.getTaskListManager(newTestPersistedTaskListID(domainID, taskList, taskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -1788,6 +1805,8 @@ func (m *testTaskManager) CreateTasks(
 			TaskID:          task.TaskID,
 			WorkflowID:      task.Execution.WorkflowID,
 			PartitionConfig: task.Data.PartitionConfig,
+			Priority:        task.Data.Priority,
+			TypeName:        task.Data.TypeName,
 		}
 		if task.Data.ScheduleToStartTimeout != 0 {
 			info.Expiry = time.Now().Add(time.Duration(task.Data.ScheduleToStartTimeout) * time.Second)
@@ -1806,7 +1825,7 @@ func (m *testTaskManager) GetTasks(
 ) (*persistence.GetTasksResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.GetTasks readLevel=%v, maxReadLevel=%v", request.ReadLevel, *request.MaxReadLevel))
 
-	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManager(newTestPersistedTaskListID(request.DomainID, request.TaskList, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	var tasks []*persistence.TaskInfo
@@ -1886,7 +1905,7 @@ func validateTimeRange(t time.Time, expectedDuration time.Duration) bool {
 
 func defaultTestConfig() *Config {
 	config := NewConfig(dynamicconfig.NewNopCollection(), "some random hostname")
-	config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(100 * time.Millisecond)
+	config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByIsolationGroup(100 * time.Millisecond)
 	config.MaxTaskDeleteBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1)
 	config.AllIsolationGroups = []string{"datacenterA", "datacenterB"}
 	config.AsyncTaskDispatchTimeout = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
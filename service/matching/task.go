@@ -118,6 +118,12 @@ func (task *InternalTask) isForwarded() bool {
 	return task.forwardedFrom != ""
 }
 
+// isHighPriority returns true if the underlying task has a dispatch priority
+// and should be matched ahead of tasks without one
+func (task *InternalTask) isHighPriority() bool {
+	return task.event != nil && task.event.Priority > 0
+}
+
 func (task *InternalTask) workflowExecution() *types.WorkflowExecution {
 	switch {
 	case task.event != nil:
@@ -90,6 +90,8 @@ type (
 		String() string
 		GetTaskListKind() types.TaskListKind
 		TaskListID() *taskListID
+		// PartitionConfig returns the persisted partition config of the task list, nil if it has never been scaled
+		PartitionConfig() *types.TaskListPartitionConfig
 	}
 
 	// Single task list in memory state
@@ -103,15 +105,21 @@ type (
 		taskWriter      *taskWriter
 		taskReader      *taskReader // reads tasks from db and async matches it with poller
 		liveness        *liveness
-		taskGC          *taskGC
+		adaptiveScaler  *adaptiveScaler      // only set for the root partition of a normal task list
 		taskAckManager  messaging.AckManager // tracks ackLevel for delivered messages
-		matcher         *TaskMatcher         // for matching a task producer with a poller
-		clusterMetadata cluster.Metadata
-		domainCache     cache.DomainCache
-		partitioner     partition.Partitioner
-		logger          log.Logger
-		scope           metrics.Scope
-		domainName      string
+		// tasks with a dispatch priority are persisted to a separate queue, with its own read and ack
+		// levels, so that they are read from persistence ahead of the backlog of other tasks
+		priorityDB             *taskListDB
+		priorityTaskWriter     *taskWriter
+		priorityTaskReader     *taskReader
+		priorityTaskAckManager messaging.AckManager
+		matcher                *TaskMatcher // for matching a task producer with a poller
+		clusterMetadata        cluster.Metadata
+		domainCache            cache.DomainCache
+		partitioner            partition.Partitioner
+		logger                 log.Logger
+		scope                  metrics.Scope
+		domainName             string
 		// pollerHistory stores poller which poll from this tasklist in last few minutes
 		pollerHistory *pollerHistory
 		// outstandingPollsMap is needed to keep track of all outstanding pollers for a
@@ -124,6 +132,8 @@ type (
 		startWG              sync.WaitGroup // ensures that background processes do not start until setup is ready
 		stopped              int32
 		closeCallback        func(taskListManager)
+		// returns the partition config of the root partition if it's loaded on this host
+		rootPartitionConfig func() *types.TaskListPartitionConfig
 	}
 )
 
@@ -159,24 +169,29 @@ func newTaskListManager(
 	}
 	scope := newPerTaskListScope(domainName, taskList.name, *taskListKind, e.metricsClient, metrics.MatchingTaskListMgrScope)
 	db := newTaskListDB(e.taskManager, taskList.domainID, domainName, taskList.name, taskList.taskType, int(*taskListKind), e.logger)
+	priorityDB := newTaskListDB(e.taskManager, taskList.domainID, domainName, taskList.priorityQueueName(), taskList.taskType, int(*taskListKind), e.logger)
 
 	tlMgr := &taskListManagerImpl{
-		createTime:          createTime,
-		enableIsolation:     taskListConfig.EnableTasklistIsolation(),
-		domainCache:         e.domainCache,
-		clusterMetadata:     e.clusterMetadata,
-		partitioner:         e.partitioner,
-		taskListID:          taskList,
-		taskListKind:        *taskListKind,
-		logger:              e.logger.WithTags(tag.WorkflowDomainName(domainName), tag.WorkflowTaskListName(taskList.name), tag.WorkflowTaskListType(taskList.taskType)),
-		db:                  db,
-		taskAckManager:      messaging.NewAckManager(e.logger),
-		taskGC:              newTaskGC(db, taskListConfig),
-		config:              taskListConfig,
-		outstandingPollsMap: make(map[string]context.CancelFunc),
-		domainName:          domainName,
-		scope:               scope,
-		closeCallback:       e.removeTaskListManager,
+		createTime:             createTime,
+		enableIsolation:        taskListConfig.EnableTasklistIsolation(),
+		domainCache:            e.domainCache,
+		clusterMetadata:        e.clusterMetadata,
+		partitioner:            e.partitioner,
+		taskListID:             taskList,
+		taskListKind:           *taskListKind,
+		logger:                 e.logger.WithTags(tag.WorkflowDomainName(domainName), tag.WorkflowTaskListName(taskList.name), tag.WorkflowTaskListType(taskList.taskType)),
+		db:                     db,
+		taskAckManager:         messaging.NewAckManager(e.logger),
+		priorityDB:             priorityDB,
+		priorityTaskAckManager: messaging.NewAckManager(e.logger),
+		config:                 taskListConfig,
+		outstandingPollsMap:    make(map[string]context.CancelFunc),
+		domainName:             domainName,
+		scope:                  scope,
+		closeCallback:          e.removeTaskListManager,
+		rootPartitionConfig: func() *types.TaskListPartitionConfig {
+			return e.getLoadedPartitionConfig(taskList)
+		},
 	}
 
 	taskListTypeMetricScope := tlMgr.scope.Tagged(
@@ -191,13 +206,19 @@ func newTaskListManager(
 	if tlMgr.isIsolationMatcherEnabled() {
 		isolationGroups = config.AllIsolationGroups
 	}
-	tlMgr.taskWriter = newTaskWriter(tlMgr)
-	tlMgr.taskReader = newTaskReader(tlMgr, isolationGroups)
+	tlMgr.taskWriter = newTaskWriter(tlMgr, db, tlMgr.taskAckManager)
+	tlMgr.taskReader = newTaskReader(tlMgr, db, tlMgr.taskWriter, tlMgr.taskAckManager, tlMgr.scope, isolationGroups)
+	tlMgr.priorityTaskWriter = newTaskWriter(tlMgr, priorityDB, tlMgr.priorityTaskAckManager)
+	priorityScope := newPerTaskListScope(domainName, taskList.priorityQueueName(), *taskListKind, e.metricsClient, metrics.MatchingTaskListMgrScope)
+	tlMgr.priorityTaskReader = newTaskReader(tlMgr, priorityDB, tlMgr.priorityTaskWriter, tlMgr.priorityTaskAckManager, priorityScope, isolationGroups)
 	var fwdr *Forwarder
 	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
 		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient, isolationGroups)
 	}
-	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups)
+	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.numReadPartitions)
+	if taskList.IsRoot() && *taskListKind == types.TaskListKindNormal {
+		tlMgr.adaptiveScaler = newAdaptiveScaler(tlMgr, e.matchingClient, clock.NewRealTimeSource())
+	}
 	tlMgr.startWG.Add(1)
 	return tlMgr, nil
 }
@@ -212,7 +233,15 @@ func (c *taskListManagerImpl) Start() error {
 		c.Stop()
 		return err
 	}
+	if err := c.priorityTaskWriter.Start(); err != nil {
+		c.Stop()
+		return err
+	}
 	c.taskReader.Start()
+	c.priorityTaskReader.Start()
+	if c.adaptiveScaler != nil {
+		c.adaptiveScaler.Start()
+	}
 
 	return nil
 }
@@ -224,8 +253,13 @@ func (c *taskListManagerImpl) Stop() {
 	}
 	c.closeCallback(c)
 	c.liveness.Stop()
+	if c.adaptiveScaler != nil {
+		c.adaptiveScaler.Stop()
+	}
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
+	c.priorityTaskWriter.Stop()
+	c.priorityTaskReader.Stop()
 	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
 }
 
@@ -256,6 +290,9 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 	if params.forwardedFrom == "" {
 		// request sent by history service
 		c.liveness.markAlive(time.Now())
+		if c.adaptiveScaler != nil {
+			c.adaptiveScaler.recordAdd()
+		}
 	}
 	var syncMatch bool
 	_, err := c.executeWithRetry(func() (interface{}, error) {
@@ -277,7 +314,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 				return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 			}
 
-			r, err := c.taskWriter.appendTask(params.execution, params.taskInfo)
+			r, err := c.taskWriterFor(params.taskInfo).appendTask(params.execution, params.taskInfo)
 			return r, err
 		}
 
@@ -300,7 +337,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 		}
 
-		return c.taskWriter.appendTask(params.execution, params.taskInfo)
+		return c.taskWriterFor(params.taskInfo).appendTask(params.execution, params.taskInfo)
 	})
 
 	if err != nil {
@@ -310,7 +347,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			tag.WorkflowTaskListType(c.taskListID.taskType),
 		)
 	} else {
-		c.taskReader.Signal()
+		c.taskReaderFor(params.taskInfo).Signal()
 	}
 
 	return syncMatch, err
@@ -353,19 +390,19 @@ func (c *taskListManagerImpl) GetTask(
 		return nil, err
 	}
 	task.domainName = c.domainName
-	task.backlogCountHint = c.taskAckManager.GetBacklogCount()
+	task.backlogCountHint = c.backlogCount()
 	return task, nil
 }
 
 func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond *float64) (*InternalTask, error) {
+	isolationGroup, _ := ctx.Value(_isolationGroupKey).(string)
 	// We need to set a shorter timeout than the original ctx; otherwise, by the time ctx deadline is
 	// reached, instead of emptyTask, context timeout error is returned to the frontend by the rpc stack,
 	// which counts against our SLO. By shortening the timeout by a very small amount, the emptyTask can be
 	// returned to the handler before a context timeout error is generated.
-	childCtx, cancel := c.newChildContext(ctx, c.config.LongPollExpirationInterval(), returnEmptyTaskTimeBudget)
+	childCtx, cancel := c.newChildContext(ctx, c.config.LongPollExpirationInterval(isolationGroup), returnEmptyTaskTimeBudget)
 	defer cancel()
 
-	isolationGroup, _ := ctx.Value(_isolationGroupKey).(string)
 	pollerID, ok := ctx.Value(pollerIDKey).(string)
 	if ok && pollerID != "" {
 		// Found pollerID on context, add it to the map to allow it to be canceled in
@@ -453,7 +490,7 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 	response.TaskListStatus = &types.TaskListStatus{
 		ReadLevel:        c.taskAckManager.GetReadLevel(),
 		AckLevel:         c.taskAckManager.GetAckLevel(),
-		BacklogCountHint: c.taskAckManager.GetBacklogCount(),
+		BacklogCountHint: c.backlogCount(),
 		RatePerSecond:    c.matcher.Rate(),
 		TaskIDBlock: &types.TaskIDBlock{
 			StartID: taskIDBlock.start,
@@ -481,6 +518,27 @@ func (c *taskListManagerImpl) String() string {
 	return buf.String()
 }
 
+// backlogCount returns the number of tasks read from persistence which are not yet dispatched
+func (c *taskListManagerImpl) backlogCount() int64 {
+	return c.taskAckManager.GetBacklogCount() + c.priorityTaskAckManager.GetBacklogCount()
+}
+
+// taskWriterFor returns the writer of the queue the task is persisted to
+func (c *taskListManagerImpl) taskWriterFor(taskInfo *persistence.TaskInfo) *taskWriter {
+	if taskInfo.Priority > 0 {
+		return c.priorityTaskWriter
+	}
+	return c.taskWriter
+}
+
+// taskReaderFor returns the reader of the queue the task is persisted to
+func (c *taskListManagerImpl) taskReaderFor(taskInfo *persistence.TaskInfo) *taskReader {
+	if taskInfo.Priority > 0 {
+		return c.priorityTaskReader
+	}
+	return c.taskReader
+}
+
 func (c *taskListManagerImpl) GetTaskListKind() types.TaskListKind {
 	return c.taskListKind
 }
@@ -489,6 +547,33 @@ func (c *taskListManagerImpl) TaskListID() *taskListID {
 	return c.taskListID
 }
 
+func (c *taskListManagerImpl) PartitionConfig() *types.TaskListPartitionConfig {
+	config := c.db.PartitionConfig()
+	if config == nil {
+		return nil
+	}
+	return &types.TaskListPartitionConfig{
+		Version:            config.Version,
+		NumReadPartitions:  int32(config.NumReadPartitions),
+		NumWritePartitions: int32(config.NumWritePartitions),
+	}
+}
+
+// numReadPartitions returns the number of partitions the tasks of the task list are read from. The partition
+// config of the root partition takes precedence over dynamic config once the partitions have been scaled
+func (c *taskListManagerImpl) numReadPartitions() int {
+	var config *types.TaskListPartitionConfig
+	if c.taskListID.IsRoot() {
+		config = c.PartitionConfig()
+	} else {
+		config = c.rootPartitionConfig()
+	}
+	if config != nil && config.NumReadPartitions > 0 {
+		return int(config.NumReadPartitions)
+	}
+	return c.config.NumReadPartitions()
+}
+
 // Retry operation on transient error. On rangeID update by another process calls c.Stop().
 func (c *taskListManagerImpl) executeWithRetry(
 	operation func() (interface{}, error),
@@ -528,6 +613,13 @@ func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params addTaskPa
 		matched, err = c.matcher.Offer(childCtx, task)
 	}
 	cancel()
+	if !matched && err == nil && task.isHighPriority() && !task.isForwarded() && params.activityTaskDispatchInfo == nil {
+		// give high priority decision tasks a chance to be picked up by the next poller
+		// instead of going to the back of the backlog behind lower priority tasks
+		childCtx, cancel = c.newChildContext(ctx, c.config.PriorityTaskSyncMatchWaitTime(), time.Second)
+		matched, err = c.matcher.offerOrTimeout(childCtx, task)
+		cancel()
+	}
 	return matched, err
 }
 
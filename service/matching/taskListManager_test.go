@@ -88,6 +88,153 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	wg.Wait()
 }
 
+func TestPriorityTasksReadAheadOfBacklog(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(4)
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	// the backlog of tasks without a priority is much larger than the buffers of the task reader
+	execution := &types.WorkflowExecution{WorkflowID: "workflowID", RunID: "runID"}
+	for i := int64(0); i < 20; i++ {
+		_, err := tlm.taskWriterFor(&persistence.TaskInfo{}).appendTask(execution, &persistence.TaskInfo{DomainID: "domain", ScheduleID: i})
+		require.NoError(t, err)
+	}
+	priorityTask := &persistence.TaskInfo{DomainID: "domain", ScheduleID: 20, Priority: 1}
+	_, err := tlm.taskWriterFor(priorityTask).appendTask(execution, priorityTask)
+	require.NoError(t, err)
+	tlm.taskReader.Signal()
+	tlm.priorityTaskReader.Signal()
+
+	require.Eventually(t, func() bool {
+		return tlm.priorityTaskAckManager.GetBacklogCount() == 1
+	}, time.Second, 10*time.Millisecond, "priority task must be read from persistence")
+	assert.Less(t, tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel(), "backlog must not be read entirely")
+	time.Sleep(100 * time.Millisecond) // let the priority task be offered to pollers
+
+	task, err := tlm.GetTask(context.Background(), nil)
+	require.NoError(t, err)
+	assert.True(t, task.isHighPriority())
+	assert.Equal(t, int64(20), task.event.ScheduleID)
+	task.finish(nil)
+}
+
+func TestNextBufferedTaskFairness(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	tlm.config.EnableTaskListFairness = func() bool { return true }
+	tr := tlm.taskReader
+	tasks := []*persistence.TaskInfo{
+		{TaskID: 1, PartitionConfig: map[string]string{partition.FairnessKey: "a"}},
+		{TaskID: 2, PartitionConfig: map[string]string{partition.FairnessKey: "a"}},
+		{TaskID: 3, PartitionConfig: map[string]string{partition.FairnessKey: "a"}},
+		{TaskID: 4, PartitionConfig: map[string]string{partition.FairnessKey: "b"}},
+		{TaskID: 5},
+	}
+	for _, task := range tasks {
+		require.True(t, tr.addSingleTaskToBuffer(task))
+	}
+
+	var taskIDs []int64
+	for range tasks {
+		task, ok := tr.nextBufferedTask(defaultTaskBufferIsolationGroup)
+		require.True(t, ok)
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+	assert.Equal(t, []int64{1, 4, 5, 2, 3}, taskIDs)
+}
+
+func TestFairnessSkipsTasksOfDominantKey(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(6)
+	cfg.EnableTaskListFairness = func(string, string, int) bool { return true }
+	cfg.FairnessKeyMaxBufferedTasks = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	// key a has many more tasks in the backlog than fit in the buffer, ahead of the tasks of key b
+	execution := &types.WorkflowExecution{WorkflowID: "workflowID", RunID: "runID"}
+	for i := int64(0); i < 22; i++ {
+		key := "a"
+		if i >= 20 {
+			key = "b"
+		}
+		_, err := tlm.taskWriter.appendTask(execution, &persistence.TaskInfo{
+			DomainID:        "domain",
+			ScheduleID:      i,
+			PartitionConfig: map[string]string{partition.FairnessKey: key},
+		})
+		require.NoError(t, err)
+	}
+	tlm.taskReader.Signal()
+	require.Eventually(t, func() bool {
+		return tlm.taskAckManager.GetBacklogCount() == 22
+	}, time.Second, 10*time.Millisecond, "whole backlog must be read")
+
+	var keys []string
+	scheduleIDs := make(map[int64]bool)
+	for deadline := time.Now().Add(5 * time.Second); len(scheduleIDs) < 22 && time.Now().Before(deadline); {
+		task, err := tlm.GetTask(context.Background(), nil)
+		if err == ErrNoTasks {
+			continue
+		}
+		require.NoError(t, err)
+		keys = append(keys, task.event.PartitionConfig[partition.FairnessKey])
+		scheduleIDs[task.event.ScheduleID] = true
+		task.finish(nil)
+	}
+	require.Len(t, scheduleIDs, 22, "skipped tasks must be read again")
+	assert.Contains(t, keys[:3], "b", "tasks of key b must not wait for the backlog of key a")
+	assert.Equal(t, int64(0), tlm.taskAckManager.GetBacklogCount())
+}
+
+func TestThrottledTypeDoesNotBlockOtherTypes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	tlm.matcher.typeDispatchRPS = func() map[string]interface{} { return map[string]interface{}{"throttled-type": 10} }
+	// consume the burst of the throttled type
+	for tlm.matcher.typeLimiters.For("throttled-type").Allow() {
+	}
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	execution := &types.WorkflowExecution{WorkflowID: "workflowID", RunID: "runID"}
+	for i, typeName := range []string{"throttled-type", "throttled-type", "throttled-type", "other-type"} {
+		_, err := tlm.taskWriter.appendTask(execution, &persistence.TaskInfo{
+			DomainID:   "domain",
+			ScheduleID: int64(i),
+			TypeName:   typeName,
+		})
+		require.NoError(t, err)
+	}
+	tlm.taskReader.Signal()
+
+	var typeNames []string
+	for deadline := time.Now().Add(5 * time.Second); len(typeNames) < 4 && time.Now().Before(deadline); {
+		task, err := tlm.GetTask(context.Background(), nil)
+		if err == ErrNoTasks {
+			continue
+		}
+		require.NoError(t, err)
+		typeNames = append(typeNames, task.event.TypeName)
+		task.finish(nil)
+	}
+	require.Equal(t, []string{"other-type", "throttled-type", "throttled-type", "throttled-type"}, typeNames)
+	assert.Equal(t, int64(0), tlm.taskAckManager.GetBacklogCount())
+}
+
 func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -287,6 +434,29 @@ func TestCheckIdleTaskList(t *testing.T) {
 	require.Equal(t, int32(1), tlm.stopped)
 }
 
+func TestGetTaskLongPollExpirationByIsolationGroup(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := NewConfig(dynamicconfig.NewNopCollection(), "some random hostname")
+	var pollIsolationGroup string
+	cfg.LongPollExpirationInterval = func(domain string, taskList string, taskType int, isolationGroup string) time.Duration {
+		pollIsolationGroup = isolationGroup
+		return 10 * time.Millisecond
+	}
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	tlMgrStartWithoutNotifyEvent(tlm)
+	defer tlm.Stop()
+
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), _isolationGroupKey, "zone-a"), time.Second)
+	defer cancel()
+	start := time.Now()
+	_, err := tlm.GetTask(ctx, nil)
+	require.Equal(t, ErrNoTasks, err)
+	require.Less(t, time.Since(start), time.Second, "poll should expire after the interval of its isolation group")
+	require.Equal(t, "zone-a", pollIsolationGroup)
+}
+
 func TestAddTaskStandby(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -343,3 +513,20 @@ func TestAddTaskStandby(t *testing.T) {
 	require.Error(t, err) // should not persist the task
 	require.False(t, syncMatch)
 }
+
+func TestNumReadPartitions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	tlm := createTestTaskListManager(controller)
+	tlm.config.NumReadPartitions = func() int { return 2 }
+	tlm.matcher.typeDispatchRPS = func() map[string]interface{} { return map[string]interface{}{"type": 30} }
+	require.Equal(t, 2, tlm.numReadPartitions())
+	require.Equal(t, 15.0, tlm.matcher.typeRatePerPartition("type"))
+
+	// the partition config of the root partition takes precedence once the task list has been scaled
+	_, err := tlm.db.RenewLease()
+	require.NoError(t, err)
+	require.NoError(t, tlm.db.UpdatePartitionConfig(&persistence.TaskListPartitionConfig{Version: 1, NumReadPartitions: 3, NumWritePartitions: 1}))
+	require.Equal(t, 3, tlm.numReadPartitions())
+	require.Equal(t, 10.0, tlm.matcher.typeRatePerPartition("type"))
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -73,34 +74,61 @@ type (
 		onFatalErr               func()
 		dispatchTask             func(context.Context, *InternalTask) error
 		getIsolationGroupForTask func(context.Context, *persistence.TaskInfo) (string, error)
+		// buffers for tasks without a dispatch priority when fairness mode is enabled
+		fairTaskBuffers map[string]*fairTaskBuffer
+		// backlog tasks skipped in fairness mode because their fairness key had no room in the buffers,
+		// in the order they were read. They are read again from persistence once their key has room.
+		skippedTasks      []skippedTask
+		skippedTaskCounts map[string]int // number of skipped tasks per fairness key
+		numSkippedTasks   int64
+		// number of tasks waiting for the ratelimit of their workflow or activity type
+		// before they are buffered again
+		numThrottledTasks int64
+	}
+
+	skippedTask struct {
+		taskID      int64
+		fairnessKey string
 	}
 )
 
-func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskReader {
+// newTaskReader returns a reader of the tasks persisted by the given taskWriter, a task list
+// has one reader for the tasks with a dispatch priority and one for the rest of its backlog
+func newTaskReader(
+	tlMgr *taskListManagerImpl,
+	db *taskListDB,
+	taskWriter *taskWriter,
+	taskAckManager messaging.AckManager,
+	scope metrics.Scope,
+	isolationGroups []string,
+) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
 	taskBuffers := make(map[string]chan *persistence.TaskInfo)
-	taskBuffers[defaultTaskBufferIsolationGroup] = make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1)
-	for _, g := range isolationGroups {
+	fairTaskBuffers := make(map[string]*fairTaskBuffer)
+	for _, g := range append([]string{defaultTaskBufferIsolationGroup}, isolationGroups...) {
 		taskBuffers[g] = make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1)
+		fairTaskBuffers[g] = newFairTaskBuffer(tlMgr.config.GetTasksBatchSize()-1, tlMgr.config.FairnessKeyWeights)
 	}
 	return &taskReader{
 		tlMgr:          tlMgr,
 		taskListID:     tlMgr.taskListID,
 		config:         tlMgr.config,
-		db:             tlMgr.db,
-		taskWriter:     tlMgr.taskWriter,
-		taskGC:         tlMgr.taskGC,
-		taskAckManager: tlMgr.taskAckManager,
+		db:             db,
+		taskWriter:     taskWriter,
+		taskGC:         newTaskGC(db, tlMgr.config),
+		taskAckManager: taskAckManager,
 		cancelCtx:      ctx,
 		cancelFunc:     cancel,
 		notifyC:        make(chan struct{}, 1),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffers:              taskBuffers,
+		fairTaskBuffers:          fairTaskBuffers,
+		skippedTaskCounts:        make(map[string]int),
 		domainCache:              tlMgr.domainCache,
 		clusterMetadata:          tlMgr.clusterMetadata,
 		logger:                   tlMgr.logger,
-		scope:                    tlMgr.scope,
+		scope:                    scope,
 		handleErr:                tlMgr.handleErr,
 		onFatalErr:               tlMgr.Stop,
 		dispatchTask:             tlMgr.DispatchTask,
@@ -143,67 +171,73 @@ func (tr *taskReader) Signal() {
 func (tr *taskReader) dispatchBufferedTasks(isolationGroup string) {
 dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffers[isolationGroup]:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
+		taskInfo, ok := tr.nextBufferedTask(isolationGroup)
+		if !ok { // Task list getTasks pump is shutdown
+			break dispatchLoop
+		}
+		for {
+			task := newInternalTask(taskInfo, tr.completeTask, types.TaskSourceDbBacklog, "", false, nil, isolationGroup)
+			dispatchCtx, cancel := tr.newDispatchContext(isolationGroup)
+			timerScope := tr.scope.StartTimer(metrics.AsyncMatchLatencyPerTaskList)
+			err := tr.dispatchTask(dispatchCtx, task)
+			timerScope.Stop()
+			cancel()
+			if err == nil {
+				break
 			}
-			for {
-				task := newInternalTask(taskInfo, tr.completeTask, types.TaskSourceDbBacklog, "", false, nil, isolationGroup)
-				dispatchCtx, cancel := tr.newDispatchContext(isolationGroup)
-				timerScope := tr.scope.StartTimer(metrics.AsyncMatchLatencyPerTaskList)
-				err := tr.dispatchTask(dispatchCtx, task)
-				timerScope.Stop()
-				cancel()
-				if err == nil {
+			if throttledErr, ok := err.(*taskTypeThrottledError); ok {
+				if tr.requeueThrottledTask(isolationGroup, taskInfo, throttledErr.delay) {
 					break
 				}
-				if err == context.Canceled {
-					tr.logger.Info("Tasklist manager context is cancelled, shutting down")
+				// too many tasks are waiting for their type already, wait here instead
+				select {
+				case <-time.After(throttledErr.delay):
+					continue
+				case <-tr.cancelCtx.Done():
 					break dispatchLoop
 				}
-				if err == context.DeadlineExceeded {
-					// it only happens when isolation is enabled and there is no pollers from the given isolation group
-					// if this happens, we don't want to block the task dispatching, because there might be pollers from
-					// other isolation groups, we just simply continue and dispatch the task to a new isolation group which
-					// has pollers
-					tr.logger.Warn("Async task dispatch timed out", tag.IsolationGroup(isolationGroup))
-					tr.scope.IncCounter(metrics.AsyncMatchDispatchTimeoutCounterPerTaskList)
-					group, err := tr.getIsolationGroupForTask(tr.cancelCtx, taskInfo)
-					if err != nil {
-						// it only errors when the tasklist is a sticky tasklist and
-						// the sticky pollers are not available, in this case, we just complete the task
-						// and let the decision get timed out and rescheduled to non-sticky tasklist
-						if err == _stickyPollerUnavailableError {
-							tr.completeTask(taskInfo, nil)
-						} else {
-							// it should never happen, unless there is a bug in 'getIsolationGroupForTask' method
-							tr.logger.Error("taskReader: unexpected error getting isolation group", tag.Error(err))
-							tr.completeTask(taskInfo, err)
-						}
-						break
-					}
-					if group == isolationGroup {
-						continue
+			}
+			if err == context.Canceled {
+				tr.logger.Info("Tasklist manager context is cancelled, shutting down")
+				break dispatchLoop
+			}
+			if err == context.DeadlineExceeded {
+				// it only happens when isolation is enabled and there is no pollers from the given isolation group
+				// if this happens, we don't want to block the task dispatching, because there might be pollers from
+				// other isolation groups, we just simply continue and dispatch the task to a new isolation group which
+				// has pollers
+				tr.logger.Warn("Async task dispatch timed out", tag.IsolationGroup(isolationGroup))
+				tr.scope.IncCounter(metrics.AsyncMatchDispatchTimeoutCounterPerTaskList)
+				group, err := tr.getIsolationGroupForTask(tr.cancelCtx, taskInfo)
+				if err != nil {
+					// it only errors when the tasklist is a sticky tasklist and
+					// the sticky pollers are not available, in this case, we just complete the task
+					// and let the decision get timed out and rescheduled to non-sticky tasklist
+					if err == _stickyPollerUnavailableError {
+						tr.completeTask(taskInfo, nil)
 					} else {
-						// if there is no poller in the isolation group or the isolation group is drained,
-						// we want to redistribute the tasks to other isolation groups in this case to drain
-						// the backlog
-						select {
-						case <-tr.cancelCtx.Done():
-							break dispatchLoop
-						case tr.taskBuffers[group] <- taskInfo:
-						}
-						break
+						// it should never happen, unless there is a bug in 'getIsolationGroupForTask' method
+						tr.logger.Error("taskReader: unexpected error getting isolation group", tag.Error(err))
+						tr.completeTask(taskInfo, err)
 					}
+					break
+				}
+				if group == isolationGroup {
+					continue
+				} else {
+					// if there is no poller in the isolation group or the isolation group is drained,
+					// we want to redistribute the tasks to other isolation groups in this case to drain
+					// the backlog
+					if !tr.bufferTask(group, taskInfo) {
+						break dispatchLoop
+					}
+					break
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope.IncCounter(metrics.BufferThrottlePerTaskListCounter)
-				tr.logger.Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
 			}
-		case <-tr.cancelCtx.Done():
-			break dispatchLoop
+			// this should never happen unless there is a bug - don't drop the task
+			tr.scope.IncCounter(metrics.BufferThrottlePerTaskListCounter)
+			tr.logger.Error("taskReader: unexpected error dispatching task", tag.Error(err))
+			runtime.Gosched()
 		}
 	}
 }
@@ -218,6 +252,9 @@ getTasksPumpLoop:
 			break getTasksPumpLoop
 		case <-tr.notifyC:
 			{
+				if !tr.readSkippedTasks() {
+					break getTasksPumpLoop
+				}
 				tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch()
 				if err != nil {
 					tr.Signal() // re-enqueue the event
@@ -342,6 +379,124 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 		}
 		return true
 	}
+	if tr.skipTask(isolationGroup, task) {
+		return true
+	}
+	return tr.bufferTask(isolationGroup, task)
+}
+
+// skipTask skips a backlog task in fairness mode when its fairness key already has its share of the buffer,
+// or has older tasks skipped, so that the backlog of the other keys behind it is read instead of waiting for
+// the key to drain. Returns false if the task has to be buffered.
+func (tr *taskReader) skipTask(isolationGroup string, task *persistence.TaskInfo) bool {
+	if !tr.config.EnableTaskListFairness() || len(tr.skippedTasks) >= tr.config.FairnessMaxSkippedTasks() {
+		return false
+	}
+	key := task.PartitionConfig[partition.FairnessKey]
+	if tr.skippedTaskCounts[key] == 0 && tr.fairTaskBuffers[isolationGroup].HasRoom(key, tr.config.FairnessKeyMaxBufferedTasks()) {
+		return false
+	}
+	tr.skippedTasks = append(tr.skippedTasks, skippedTask{taskID: task.TaskID, fairnessKey: key})
+	tr.skippedTaskCounts[key]++
+	atomic.StoreInt64(&tr.numSkippedTasks, int64(len(tr.skippedTasks)))
+	tr.scope.IncCounter(metrics.FairnessSkippedTasksPerTaskListCounter)
+	return true
+}
+
+// readSkippedTasks reads the skipped backlog tasks of the fairness keys which have drained to half of their
+// share of the buffer again and buffers them, in the order they were read the first time. Skipped tasks
+// stay in flight in the ack manager, so the ack level doesn't move past them. Returns false if the task
+// reader is shutting down.
+func (tr *taskReader) readSkippedTasks() bool {
+	if len(tr.skippedTasks) == 0 {
+		return true
+	}
+	first := -1
+	for i, skipped := range tr.skippedTasks {
+		if tr.canReadSkippedTasks(skipped.fairnessKey) {
+			first = i
+			break
+		}
+	}
+	if first == -1 {
+		return true
+	}
+
+	readLevel := tr.skippedTasks[first].taskID - 1
+	maxReadLevel := tr.skippedTasks[len(tr.skippedTasks)-1].taskID
+	tasks, err := tr.getTaskBatchWithRange(readLevel, maxReadLevel)
+	if err != nil {
+		tr.Signal() // retry on the next run of the pump
+		return true
+	}
+	if len(tasks) == tr.config.GetTasksBatchSize() {
+		// the batch doesn't cover the whole range, the rest is read on the next run of the pump
+		maxReadLevel = tasks[len(tasks)-1].TaskID
+		tr.Signal()
+	}
+	readTasks := make(map[int64]*persistence.TaskInfo, len(tasks))
+	for _, t := range tasks {
+		readTasks[t.TaskID] = t
+	}
+
+	now := time.Now()
+	skippedTasks := tr.skippedTasks
+	tr.skippedTasks = nil
+	for i, skipped := range skippedTasks {
+		if i < first || skipped.taskID > maxReadLevel || !tr.canReadSkippedTasks(skipped.fairnessKey) {
+			tr.skippedTasks = append(tr.skippedTasks, skipped)
+			continue
+		}
+		tr.skippedTaskCounts[skipped.fairnessKey]--
+		if tr.skippedTaskCounts[skipped.fairnessKey] == 0 {
+			delete(tr.skippedTaskCounts, skipped.fairnessKey)
+		}
+		task, ok := readTasks[skipped.taskID]
+		if !ok || tr.isTaskExpired(task, now) {
+			// the task is gone or expired since it was skipped, don't hold the ack level back for it
+			tr.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
+			tr.taskGC.Run(tr.taskAckManager.AckItem(skipped.taskID))
+			continue
+		}
+		isolationGroup, err := tr.getIsolationGroupForTask(tr.cancelCtx, task)
+		if err != nil {
+			if err == _stickyPollerUnavailableError {
+				tr.completeTask(task, nil)
+			} else {
+				tr.logger.Error("taskReader: unexpected error getting isolation group", tag.Error(err))
+				tr.completeTask(task, err)
+			}
+			continue
+		}
+		if !tr.bufferTask(isolationGroup, task) {
+			return false
+		}
+	}
+	atomic.StoreInt64(&tr.numSkippedTasks, int64(len(tr.skippedTasks)))
+	return true
+}
+
+// canReadSkippedTasks returns true if the fairness key has drained to half of its share of the buffer of
+// any isolation group, skipped tasks are read in batches rather than one by one as buffered ones are dispatched
+func (tr *taskReader) canReadSkippedTasks(key string) bool {
+	if !tr.config.EnableTaskListFairness() {
+		return true
+	}
+	maxBufferedTasks := tr.config.FairnessKeyMaxBufferedTasks()/2 + 1
+	for _, buffer := range tr.fairTaskBuffers {
+		if buffer.HasRoom(key, maxBufferedTasks) {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferTask adds the task to the buffer of the isolation group it should be dispatched from,
+// blocking while the buffer is full. Returns false if the task reader is shutting down.
+func (tr *taskReader) bufferTask(isolationGroup string, task *persistence.TaskInfo) bool {
+	if tr.config.EnableTaskListFairness() {
+		return tr.fairTaskBuffers[isolationGroup].Put(tr.cancelCtx, task.PartitionConfig[partition.FairnessKey], task)
+	}
 	select {
 	case tr.taskBuffers[isolationGroup] <- task:
 		return true
@@ -350,6 +505,52 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 	}
 }
 
+// requeueThrottledTask buffers the task again once its workflow or activity type has dispatch capacity,
+// so that the tasks of other types are dispatched in the meantime. Returns false if too many tasks
+// are waiting for their type already.
+func (tr *taskReader) requeueThrottledTask(isolationGroup string, task *persistence.TaskInfo, delay time.Duration) bool {
+	if atomic.AddInt64(&tr.numThrottledTasks, 1) > int64(tr.config.GetTasksBatchSize()) {
+		atomic.AddInt64(&tr.numThrottledTasks, -1)
+		return false
+	}
+	tr.scope.IncCounter(metrics.TypeThrottledTasksPerTaskListCounter)
+	time.AfterFunc(delay, func() {
+		defer atomic.AddInt64(&tr.numThrottledTasks, -1)
+		// the task is read again from persistence when the reader is shut down before it's buffered
+		tr.bufferTask(isolationGroup, task)
+	})
+	return true
+}
+
+// nextBufferedTask blocks until a buffered task is available for the isolation group. Tasks buffered
+// in fairness mode are returned in weighted round robin order across their fairness keys. Returns false
+// when the task reader is shutting down.
+func (tr *taskReader) nextBufferedTask(isolationGroup string) (*persistence.TaskInfo, bool) {
+	buffer := tr.taskBuffers[isolationGroup]
+	fairBuffer := tr.fairTaskBuffers[isolationGroup]
+	for {
+		select {
+		case taskInfo, ok := <-buffer:
+			return taskInfo, ok
+		default:
+		}
+		if taskInfo, ok := fairBuffer.Pop(); ok {
+			if atomic.LoadInt64(&tr.numSkippedTasks) > 0 {
+				tr.Signal() // the fairness key may have room for its skipped tasks now
+			}
+			return taskInfo, true
+		}
+		select {
+		case taskInfo, ok := <-buffer:
+			return taskInfo, ok
+		case <-fairBuffer.NotifyC():
+			// a task was added to the fair buffer, pick the next one in round robin order
+		case <-tr.cancelCtx.Done():
+			return nil, false
+		}
+	}
+}
+
 func (tr *taskReader) persistAckLevel() error {
 	ackLevel := tr.taskAckManager.GetAckLevel()
 	if ackLevel >= 0 {
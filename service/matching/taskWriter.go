@@ -75,12 +75,12 @@ type (
 // errShutdown indicates that the task list is shutting down
 var errShutdown = errors.New("task list shutting down")
 
-func newTaskWriter(tlMgr *taskListManagerImpl) *taskWriter {
+func newTaskWriter(tlMgr *taskListManagerImpl, db *taskListDB, taskAckManager messaging.AckManager) *taskWriter {
 	return &taskWriter{
-		db:             tlMgr.db,
+		db:             db,
 		config:         tlMgr.config,
 		taskListID:     tlMgr.taskListID,
-		taskAckManager: tlMgr.taskAckManager,
+		taskAckManager: taskAckManager,
 		stopCh:         make(chan struct{}),
 		appendCh:       make(chan *writeTaskRequest, tlMgr.config.OutstandingTaskAppendsThreshold()),
 		logger:         tlMgr.logger,
@@ -30,6 +30,8 @@ import (
 	"github.com/uber/cadence/common/persistence"
 )
 
+const priorityQueueSuffix = "priority"
+
 type (
 	// taskListID is the key that uniquely identifies a task list
 	taskListID struct {
@@ -104,6 +106,14 @@ func (tn *qualifiedTaskListName) mkName(partition int) string {
 	return fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, tn.baseName, partition)
 }
 
+// priorityQueueName returns the name under which the tasks with a dispatch priority are persisted,
+// they are kept apart from the rest of the backlog so that they can be read ahead of it
+//
+//	/__cadence_sys/[name]/priority
+func (tn *qualifiedTaskListName) priorityQueueName() string {
+	return fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, tn.name, priorityQueueSuffix)
+}
+
 func (tn *qualifiedTaskListName) init() error {
 	if !strings.HasPrefix(tn.name, common.ReservedTaskListPrefix) {
 		return nil
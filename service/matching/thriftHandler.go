@@ -90,6 +90,12 @@ func (t ThriftHandler) GetTaskListsByDomain(ctx context.Context, request *s.GetT
 	return thrift.FromGetTaskListsByDomainResponse(response), thrift.FromError(err)
 }
 
+// GetTaskListPartitionConfig forwards request to the underlying handler
+func (t ThriftHandler) GetTaskListPartitionConfig(ctx context.Context, request *m.GetTaskListPartitionConfigRequest) (*m.GetTaskListPartitionConfigResponse, error) {
+	response, err := t.h.GetTaskListPartitionConfig(ctx, thrift.ToMatchingGetTaskListPartitionConfigRequest(request))
+	return thrift.FromMatchingGetTaskListPartitionConfigResponse(response), thrift.FromError(err)
+}
+
 // PollForActivityTask forwards request to the underlying handler
 func (t ThriftHandler) PollForActivityTask(ctx context.Context, request *m.PollForActivityTaskRequest) (*s.PollForActivityTaskResponse, error) {
 	response, err := t.h.PollForActivityTask(ctx, thrift.ToMatchingPollForActivityTaskRequest(request))
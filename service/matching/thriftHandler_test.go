@@ -77,6 +77,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, s.ListTaskListPartitionsResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("GetTaskListPartitionConfig", func(t *testing.T) {
+		h.EXPECT().GetTaskListPartitionConfig(ctx, &types.MatchingGetTaskListPartitionConfigRequest{}).Return(&types.MatchingGetTaskListPartitionConfigResponse{}, internalErr).Times(1)
+		resp, err := th.GetTaskListPartitionConfig(ctx, &m.GetTaskListPartitionConfigRequest{})
+		assert.Equal(t, m.GetTaskListPartitionConfigResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("PollForActivityTask", func(t *testing.T) {
 		h.EXPECT().PollForActivityTask(ctx, &types.MatchingPollForActivityTaskRequest{}).Return(&types.PollForActivityTaskResponse{}, internalErr).Times(1)
 		resp, err := th.PollForActivityTask(ctx, &m.PollForActivityTaskRequest{})
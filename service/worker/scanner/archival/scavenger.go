@@ -0,0 +1,242 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	// ScavengerHeartbeatDetails is the heartbeat detail for ArchivalRetentionScavengerActivity
+	ScavengerHeartbeatDetails struct {
+		// DomainID is the domain being scanned, domains are scanned in the order of their IDs
+		DomainID      string
+		NextPageToken []byte
+		ExpiredCount  int
+		DeletedCount  int
+		ErrorCount    int
+	}
+
+	// Scavenger is the type that holds the state for archival retention scavenger daemon
+	Scavenger struct {
+		domainCache      cache.DomainCache
+		archiverProvider provider.ArchiverProvider
+		retentionPeriod  dynamicconfig.DurationPropertyFnWithDomainFilter
+		dryRun           dynamicconfig.BoolPropertyFnWithDomainFilter
+		hbd              ScavengerHeartbeatDetails
+		metrics          metrics.Client
+		logger           log.Logger
+		isInTest         bool
+	}
+)
+
+const (
+	pageSize = 100
+)
+
+// NewScavenger returns an instance of archival retention scavenger daemon
+// The Scavenger can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over the archived visibility records of all domains
+// with a retention period. For each record closed before the retention period,
+// the scavenger will attempt
+//   - deletion of the archived history
+//   - deletion of the archived visibility record, if the history is deleted
+//
+// In dry run mode, expired records are only logged and counted.
+func NewScavenger(
+	domainCache cache.DomainCache,
+	archiverProvider provider.ArchiverProvider,
+	retentionPeriod dynamicconfig.DurationPropertyFnWithDomainFilter,
+	dryRun dynamicconfig.BoolPropertyFnWithDomainFilter,
+	hbd ScavengerHeartbeatDetails,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Scavenger {
+	return &Scavenger{
+		domainCache:      domainCache,
+		archiverProvider: archiverProvider,
+		retentionPeriod:  retentionPeriod,
+		dryRun:           dryRun,
+		hbd:              hbd,
+		metrics:          metricsClient,
+		logger:           logger,
+	}
+}
+
+// Run runs the scavenger
+func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error) {
+	domains := s.domainCache.GetAllDomain()
+	domainIDs := make([]string, 0, len(domains))
+	for domainID := range domains {
+		domainIDs = append(domainIDs, domainID)
+	}
+	sort.Strings(domainIDs)
+
+	for _, domainID := range domainIDs {
+		if domainID < s.hbd.DomainID {
+			// already scanned before the last heartbeat
+			continue
+		}
+		if domainID != s.hbd.DomainID {
+			s.hbd.DomainID = domainID
+			s.hbd.NextPageToken = nil
+		}
+		if err := s.scanDomain(ctx, domains[domainID]); err != nil {
+			return s.hbd, err
+		}
+	}
+	return s.hbd, nil
+}
+
+func (s *Scavenger) scanDomain(ctx context.Context, domain *cache.DomainCacheEntry) error {
+	domainID := domain.GetInfo().ID
+	domainName := domain.GetInfo().Name
+	retention := s.retentionPeriod(domainName)
+	visibilityURI := domain.GetConfig().VisibilityArchivalURI
+	if retention <= 0 || len(visibilityURI) == 0 {
+		return nil
+	}
+
+	logger := s.logger.WithTags(tag.WorkflowDomainID(domainID), tag.WorkflowDomainName(domainName))
+	scope := s.metrics.Scope(metrics.ArchivalRetentionScavengerScope, metrics.DomainTag(domainName))
+	URI, err := archiver.NewURI(visibilityURI)
+	if err != nil {
+		logger.Error("archival retention scavenger: invalid visibility archival URI", tag.ArchivalURI(visibilityURI), tag.Error(err))
+		scope.IncCounter(metrics.ArchivalRetentionErrorCount)
+		s.hbd.ErrorCount++
+		return nil
+	}
+	visibilityArchiver, err := s.archiverProvider.GetVisibilityArchiver(URI.Scheme(), service.Worker)
+	if err != nil {
+		logger.Error("archival retention scavenger: failed to get visibility archiver", tag.ArchivalURI(visibilityURI), tag.Error(err))
+		scope.IncCounter(metrics.ArchivalRetentionErrorCount)
+		s.hbd.ErrorCount++
+		return nil
+	}
+
+	closeTimeBefore := time.Now().Add(-retention).UnixNano()
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		resp, err := visibilityArchiver.List(ctx, URI, &archiver.ListVisibilityRequest{
+			DomainID:        domainID,
+			CloseTimeBefore: closeTimeBefore,
+			PageSize:        pageSize,
+			NextPageToken:   s.hbd.NextPageToken,
+		})
+		if err != nil {
+			// move on to the next domain, this one is retried in the next run
+			logger.Error("archival retention scavenger: failed to list archived visibility records", tag.ArchivalURI(visibilityURI), tag.Error(err))
+			scope.IncCounter(metrics.ArchivalRetentionErrorCount)
+			s.hbd.ErrorCount++
+			return nil
+		}
+
+		// dry run is checked for every page so that it can be turned on while the scavenger is running
+		dryRun := s.dryRun(domainName)
+		for _, record := range resp.Records {
+			scope.IncCounter(metrics.ArchivalRetentionExpiredCount)
+			s.hbd.ExpiredCount++
+			recordLogger := logger.WithTags(
+				tag.WorkflowID(record.WorkflowID),
+				tag.WorkflowRunID(record.RunID),
+				tag.ArchivalRequestCloseTimestamp(record.CloseTimestamp),
+			)
+			if dryRun {
+				recordLogger.Info("archival retention scavenger: dry run, skipped deleting expired archived workflow")
+				scope.IncCounter(metrics.ArchivalRetentionDryRunCount)
+				continue
+			}
+			if err := s.deleteRecord(ctx, domain, URI, visibilityArchiver, record); err != nil {
+				recordLogger.Error("archival retention scavenger: failed to delete expired archived workflow", tag.Error(err))
+				scope.IncCounter(metrics.ArchivalRetentionErrorCount)
+				s.hbd.ErrorCount++
+				continue
+			}
+			scope.IncCounter(metrics.ArchivalRetentionDeletedCount)
+			s.hbd.DeletedCount++
+		}
+
+		s.hbd.NextPageToken = resp.NextPageToken
+		if !s.isInTest {
+			activity.RecordHeartbeat(ctx, s.hbd)
+		}
+		if len(s.hbd.NextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// deleteRecord deletes the archived history before the visibility record,
+// so that a history is never left behind without a way to find it
+func (s *Scavenger) deleteRecord(
+	ctx context.Context,
+	domain *cache.DomainCacheEntry,
+	visibilityURI archiver.URI,
+	visibilityArchiver archiver.VisibilityArchiver,
+	record *archiver.ArchiveVisibilityRequest,
+) error {
+	historyURI := record.HistoryArchivalURI
+	if len(historyURI) == 0 {
+		historyURI = domain.GetConfig().HistoryArchivalURI
+	}
+	if len(historyURI) != 0 {
+		URI, err := archiver.NewURI(historyURI)
+		if err != nil {
+			return err
+		}
+		historyArchiver, err := s.archiverProvider.GetHistoryArchiver(URI.Scheme(), service.Worker)
+		if err != nil {
+			return err
+		}
+		if err := historyArchiver.Delete(ctx, URI, &archiver.DeleteHistoryRequest{
+			DomainID:   record.DomainID,
+			WorkflowID: record.WorkflowID,
+			RunID:      record.RunID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return visibilityArchiver.Delete(ctx, visibilityURI, &archiver.DeleteVisibilityRequest{
+		DomainID:         record.DomainID,
+		WorkflowID:       record.WorkflowID,
+		RunID:            record.RunID,
+		WorkflowTypeName: record.WorkflowTypeName,
+		StartTimestamp:   record.StartTimestamp,
+		CloseTimestamp:   record.CloseTimestamp,
+	})
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		controller         *gomock.Controller
+		mockCache          *cache.MockDomainCache
+		archiverProvider   *provider.MockArchiverProvider
+		historyArchiver    *archiver.HistoryArchiverMock
+		visibilityArchiver *archiver.VisibilityArchiverMock
+	}
+)
+
+const (
+	testVisibilityURI = "file:///tmp/visibility"
+	testHistoryURI    = "file:///tmp/history"
+	testRecordURI     = "s3://bucket/history"
+)
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockCache = cache.NewMockDomainCache(s.controller)
+	s.archiverProvider = &provider.MockArchiverProvider{}
+	s.historyArchiver = &archiver.HistoryArchiverMock{}
+	s.visibilityArchiver = &archiver.VisibilityArchiverMock{}
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, service.Worker).Return(s.historyArchiver, nil)
+	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, service.Worker).Return(s.visibilityArchiver, nil)
+}
+
+func (s *ScavengerTestSuite) TearDownTest() {
+	s.controller.Finish()
+	s.historyArchiver.AssertExpectations(s.T())
+	s.visibilityArchiver.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) createTestScavenger(
+	retentionPeriod time.Duration,
+	dryRun bool,
+	hbd ScavengerHeartbeatDetails,
+) *Scavenger {
+	scvgr := NewScavenger(
+		s.mockCache,
+		s.archiverProvider,
+		func(domain string) time.Duration {
+			if domain == "no-retention" {
+				return 0
+			}
+			return retentionPeriod
+		},
+		dynamicconfig.GetBoolPropertyFnFilteredByDomain(dryRun),
+		hbd,
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		loggerimpl.NewNopLogger(),
+	)
+	scvgr.isInTest = true
+	return scvgr
+}
+
+func (s *ScavengerTestSuite) setupDomains(domains ...*cache.DomainCacheEntry) {
+	entries := make(map[string]*cache.DomainCacheEntry)
+	for _, domain := range domains {
+		entries[domain.GetInfo().ID] = domain
+	}
+	s.mockCache.EXPECT().GetAllDomain().Return(entries).Times(1)
+}
+
+func newTestDomain(domainID, domainName, visibilityURI string) *cache.DomainCacheEntry {
+	return cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: domainName},
+		&persistence.DomainConfig{
+			HistoryArchivalURI:    testHistoryURI,
+			VisibilityArchivalURI: visibilityURI,
+		},
+		"active",
+	)
+}
+
+func newTestRecord(domainID, runID, historyURI string) *archiver.ArchiveVisibilityRequest {
+	return &archiver.ArchiveVisibilityRequest{
+		DomainID:           domainID,
+		WorkflowID:         "workflowID",
+		RunID:              runID,
+		WorkflowTypeName:   "workflowType",
+		StartTimestamp:     1,
+		CloseTimestamp:     2,
+		HistoryArchivalURI: historyURI,
+	}
+}
+
+func (s *ScavengerTestSuite) expectDelete(record *archiver.ArchiveVisibilityRequest, historyURI string, historyErr error) {
+	s.historyArchiver.On("Delete", mock.Anything, mock.MatchedBy(func(URI archiver.URI) bool {
+		return URI.String() == historyURI
+	}), &archiver.DeleteHistoryRequest{
+		DomainID:   record.DomainID,
+		WorkflowID: record.WorkflowID,
+		RunID:      record.RunID,
+	}).Return(historyErr).Once()
+	if historyErr != nil {
+		return
+	}
+	s.visibilityArchiver.On("Delete", mock.Anything, mock.Anything, &archiver.DeleteVisibilityRequest{
+		DomainID:         record.DomainID,
+		WorkflowID:       record.WorkflowID,
+		RunID:            record.RunID,
+		WorkflowTypeName: record.WorkflowTypeName,
+		StartTimestamp:   record.StartTimestamp,
+		CloseTimestamp:   record.CloseTimestamp,
+	}).Return(nil).Once()
+}
+
+func (s *ScavengerTestSuite) TestDeleteExpired() {
+	s.setupDomains(
+		newTestDomain("domain1", "domain-name-1", testVisibilityURI),
+		newTestDomain("domain2", "no-retention", testVisibilityURI),
+		newTestDomain("domain3", "domain-name-3", ""),
+	)
+	record1 := newTestRecord("domain1", "run1", testRecordURI)
+	record2 := newTestRecord("domain1", "run2", "")
+	record3 := newTestRecord("domain1", "run3", "")
+	s.visibilityArchiver.On("List", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.ListVisibilityRequest) bool {
+		return request.DomainID == "domain1" && request.NextPageToken == nil && request.CloseTimeBefore < time.Now().Add(-time.Hour).UnixNano()
+	})).Return(&archiver.ListVisibilityResponse{
+		Records:       []*archiver.ArchiveVisibilityRequest{record1, record2},
+		NextPageToken: []byte("page1"),
+	}, nil).Once()
+	s.visibilityArchiver.On("List", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.ListVisibilityRequest) bool {
+		return request.DomainID == "domain1" && string(request.NextPageToken) == "page1"
+	})).Return(&archiver.ListVisibilityResponse{
+		Records: []*archiver.ArchiveVisibilityRequest{record3},
+	}, nil).Once()
+	s.expectDelete(record1, testRecordURI, nil)
+	s.expectDelete(record2, testHistoryURI, nil)
+	s.expectDelete(record3, testHistoryURI, errors.New("some random error"))
+
+	hbd, err := s.createTestScavenger(time.Hour, false, ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(ScavengerHeartbeatDetails{
+		DomainID:     "domain3",
+		ExpiredCount: 3,
+		DeletedCount: 2,
+		ErrorCount:   1,
+	}, hbd)
+}
+
+func (s *ScavengerTestSuite) TestDryRun() {
+	s.setupDomains(newTestDomain("domain1", "domain-name-1", testVisibilityURI))
+	s.visibilityArchiver.On("List", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.ListVisibilityResponse{
+		Records: []*archiver.ArchiveVisibilityRequest{newTestRecord("domain1", "run1", "")},
+	}, nil).Once()
+
+	hbd, err := s.createTestScavenger(time.Hour, true, ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.ExpiredCount)
+	s.Equal(0, hbd.DeletedCount)
+	s.Equal(0, hbd.ErrorCount)
+}
+
+func (s *ScavengerTestSuite) TestResumeFromHeartbeat() {
+	s.setupDomains(
+		newTestDomain("domain1", "domain-name-1", testVisibilityURI),
+		newTestDomain("domain2", "domain-name-2", testVisibilityURI),
+	)
+	s.visibilityArchiver.On("List", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.ListVisibilityRequest) bool {
+		return request.DomainID == "domain2" && string(request.NextPageToken) == "page1"
+	})).Return(&archiver.ListVisibilityResponse{}, nil).Once()
+
+	hbd, err := s.createTestScavenger(time.Hour, false, ScavengerHeartbeatDetails{
+		DomainID:      "domain2",
+		NextPageToken: []byte("page1"),
+		ExpiredCount:  10,
+		DeletedCount:  10,
+	}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(ScavengerHeartbeatDetails{
+		DomainID:     "domain2",
+		ExpiredCount: 10,
+		DeletedCount: 10,
+	}, hbd)
+}
+
+func (s *ScavengerTestSuite) TestListError() {
+	s.setupDomains(
+		newTestDomain("domain1", "domain-name-1", testVisibilityURI),
+		newTestDomain("domain2", "domain-name-2", testVisibilityURI),
+	)
+	s.visibilityArchiver.On("List", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.ListVisibilityRequest) bool {
+		return request.DomainID == "domain1"
+	})).Return(nil, errors.New("some random error")).Once()
+	s.visibilityArchiver.On("List", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.ListVisibilityRequest) bool {
+		return request.DomainID == "domain2"
+	})).Return(&archiver.ListVisibilityResponse{}, nil).Once()
+
+	hbd, err := s.createTestScavenger(time.Hour, false, ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.ErrorCount)
+	s.Equal("domain2", hbd.DomainID)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
@@ -67,6 +68,12 @@ type (
 		ClusterMetadata cluster.Metadata
 		// HistoryScannerEnabled indicates if history scanner should be started as part of scanner
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
+		// ArchivalRetentionScannerEnabled indicates if archival retention scanner should be started as part of scanner
+		ArchivalRetentionScannerEnabled dynamicconfig.BoolPropertyFn
+		// ArchivalRetentionScannerDryRun indicates if archival retention scanner should only report expired archived workflows
+		ArchivalRetentionScannerDryRun dynamicconfig.BoolPropertyFnWithDomainFilter
+		// ArchivalRetentionPeriod is how long archived workflows of a domain are kept, zero keeps them forever
+		ArchivalRetentionPeriod dynamicconfig.DurationPropertyFnWithDomainFilter
 		// ShardScanners is a list of shard scanner configs
 		ShardScanners              []*shardscanner.ScannerConfig
 		MaxWorkflowRetentionInDays dynamicconfig.IntPropertyFn
@@ -125,7 +132,8 @@ func New(
 
 // Start starts the scanner
 func (s *Scanner) Start() error {
-	ctx := context.Background()
+	// scanners are background jobs, their persistence calls are shed first when the database is degraded
+	ctx := persistence.ContextWithCallerPriority(context.Background(), persistence.CallerPriorityLow)
 	var workerTaskListNames []string
 	var wtl []string
 
@@ -150,6 +158,13 @@ func (s *Scanner) Start() error {
 			historyScannerWFTypeName)
 		workerTaskListNames = append(workerTaskListNames, historyScannerTaskListName)
 	}
+	if s.context.cfg.ArchivalRetentionScannerEnabled() {
+		ctx = s.startScanner(
+			ctx,
+			archivalRetentionScannerWFStartOptions,
+			archivalRetentionScannerWFTypeName)
+		workerTaskListNames = append(workerTaskListNames, archivalRetentionScannerTaskListName)
+	}
 
 	workerOpts := worker.Options{
 		Logger:                                 s.zapLogger,
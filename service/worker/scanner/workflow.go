@@ -30,6 +30,7 @@ import (
 	"go.uber.org/cadence/workflow"
 
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/service/worker/scanner/archival"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/history"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
@@ -50,6 +51,11 @@ const (
 	historyScannerWFTypeName     = "cadence-sys-history-scanner-workflow"
 	historyScannerTaskListName   = "cadence-sys-history-scanner-tasklist-0"
 	historyScavengerActivityName = "cadence-sys-history-scanner-scvg-activity"
+
+	archivalRetentionScannerWFID           = "cadence-sys-archival-retention-scanner"
+	archivalRetentionScannerWFTypeName     = "cadence-sys-archival-retention-scanner-workflow"
+	archivalRetentionScannerTaskListName   = "cadence-sys-archival-retention-scanner-tasklist-0"
+	archivalRetentionScavengerActivityName = "cadence-sys-archival-retention-scanner-scvg-activity"
 )
 
 var (
@@ -81,6 +87,13 @@ var (
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
 		CronSchedule:                 "0 */12 * * *",
 	}
+	archivalRetentionScannerWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           archivalRetentionScannerWFID,
+		TaskList:                     archivalRetentionScannerTaskListName,
+		ExecutionStartToCloseTimeout: infiniteDuration,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 */12 * * *",
+	}
 )
 
 func init() {
@@ -90,6 +103,9 @@ func init() {
 	workflow.RegisterWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 	activity.RegisterWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 
+	workflow.RegisterWithOptions(ArchivalRetentionScannerWorkflow, workflow.RegisterOptions{Name: archivalRetentionScannerWFTypeName})
+	activity.RegisterWithOptions(ArchivalRetentionScavengerActivity, activity.RegisterOptions{Name: archivalRetentionScavengerActivityName})
+
 	workflow.RegisterWithOptions(executions.ConcreteScannerWorkflow, workflow.RegisterOptions{Name: executions.ConcreteExecutionsScannerWFTypeName})
 	workflow.RegisterWithOptions(executions.CurrentScannerWorkflow, workflow.RegisterOptions{Name: executions.CurrentExecutionsScannerWFTypeName})
 	workflow.RegisterWithOptions(executions.ConcreteFixerWorkflow, workflow.RegisterOptions{Name: executions.ConcreteExecutionsFixerWFTypeName})
@@ -152,6 +168,48 @@ func HistoryScavengerActivity(
 	return scavenger.Run(activityCtx)
 }
 
+// ArchivalRetentionScannerWorkflow is the workflow that runs the archival retention scanner background daemon
+func ArchivalRetentionScannerWorkflow(
+	ctx workflow.Context,
+) error {
+
+	future := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		archivalRetentionScavengerActivityName,
+	)
+	return future.Get(ctx, nil)
+}
+
+// ArchivalRetentionScavengerActivity is the activity that runs archival retention scavenger
+func ArchivalRetentionScavengerActivity(
+	activityCtx context.Context,
+) (archival.ScavengerHeartbeatDetails, error) {
+
+	ctx, err := getScannerContext(activityCtx)
+	if err != nil {
+		return archival.ScavengerHeartbeatDetails{}, err
+	}
+
+	res := ctx.resource
+
+	hbd := archival.ScavengerHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			res.GetLogger().Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+	scavenger := archival.NewScavenger(
+		res.GetDomainCache(),
+		res.GetArchiverProvider(),
+		ctx.cfg.ArchivalRetentionPeriod,
+		ctx.cfg.ArchivalRetentionScannerDryRun,
+		hbd,
+		res.GetMetricsClient(),
+		res.GetLogger(),
+	)
+	return scavenger.Run(activityCtx)
+}
+
 // TaskListScavengerActivity is the activity that runs task list scavenger
 func TaskListScavengerActivity(
 	activityCtx context.Context,
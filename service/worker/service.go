@@ -140,10 +140,13 @@ func NewConfig(params *resource.Params) *Config {
 				EnableCleaning:           dc.GetBoolProperty(dynamicconfig.EnableCleaningOrphanTaskInTasklistScavenger),
 				MaxTasksPerJobFn:         dc.GetIntProperty(dynamicconfig.ScannerMaxTasksProcessedPerTasklistJob),
 			},
-			Persistence:            &params.PersistenceConfig,
-			ClusterMetadata:        params.ClusterMetadata,
-			TaskListScannerEnabled: dc.GetBoolProperty(dynamicconfig.TaskListScannerEnabled),
-			HistoryScannerEnabled:  dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled),
+			Persistence:                     &params.PersistenceConfig,
+			ClusterMetadata:                 params.ClusterMetadata,
+			TaskListScannerEnabled:          dc.GetBoolProperty(dynamicconfig.TaskListScannerEnabled),
+			HistoryScannerEnabled:           dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled),
+			ArchivalRetentionScannerEnabled: dc.GetBoolProperty(dynamicconfig.ArchivalRetentionScannerEnabled),
+			ArchivalRetentionScannerDryRun:  dc.GetBoolPropertyFilteredByDomain(dynamicconfig.ArchivalRetentionScannerDryRun),
+			ArchivalRetentionPeriod:         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ArchivalRetentionPeriod),
 			ShardScanners: []*shardscanner.ScannerConfig{
 				executions.ConcreteExecutionScannerConfig(dc),
 				executions.CurrentExecutionScannerConfig(dc),
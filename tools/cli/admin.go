@@ -107,6 +107,24 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminRefreshWorkflowTasks(c)
 			},
 		},
+		{
+			Name:    "restore-archived",
+			Aliases: []string{"ra"},
+			Usage:   "Restores a closed workflow from its archived history after it has been deleted by retention",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRestoreArchivedWorkflow(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -1252,6 +1270,10 @@ func newAdminConfigStoreCommands() []cli.Command {
 					Usage:    fmt.Sprintf(`Can be specified multiple times for multiple values. ex: --%s '{"Value":true,"Filters":[]}'`, FlagDynamicConfigValue),
 					Required: true,
 				},
+				cli.BoolFlag{
+					Name:  FlagDryRunWithAlias,
+					Usage: "Optional. Validate the values and show the resulting values of the dynamic config parameter without updating it",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminUpdateDynamicConfig(c)
@@ -1279,12 +1301,45 @@ func newAdminConfigStoreCommands() []cli.Command {
 		{
 			Name:    "list",
 			Aliases: []string{"l"},
-			Usage:   "List Dynamic Config Value",
+			Usage:   "List Dynamic Config Value, with the config layer each value comes from when the config is layered",
 			Flags:   []cli.Flag{},
 			Action: func(c *cli.Context) {
 				AdminListDynamicConfig(c)
 			},
 		},
+		{
+			Name:    "history",
+			Aliases: []string{"hi"},
+			Usage:   "List the stored versions of Dynamic Config with their author and time, most recent first",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigName,
+					Usage: "Optional. Name of Dynamic Config parameter to list the changes of",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfigHistory(c)
+			},
+		},
+		{
+			Name:    "rollback",
+			Aliases: []string{"rb"},
+			Usage:   "Rollback Dynamic Config to the values of a previous version",
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     FlagDynamicConfigVersion,
+					Usage:    "Version to rollback to, as listed by the history command",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigName,
+					Usage: "Optional. Name of Dynamic Config parameter to rollback, all parameters are rolled back if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRollbackDynamicConfig(c)
+			},
+		},
 		{
 			Name:    "listall",
 			Aliases: []string{"la"},
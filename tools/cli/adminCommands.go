@@ -577,6 +577,31 @@ func AdminRefreshWorkflowTasks(c *cli.Context) {
 	}
 }
 
+// AdminRestoreArchivedWorkflow restores a deleted workflow from its archived history
+func AdminRestoreArchivedWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.RestoreArchivedWorkflow(ctx, &types.RestoreArchivedWorkflowRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Restore archived workflow failed", err)
+	} else {
+		fmt.Println("Restore archived workflow succeeded.")
+	}
+}
+
 // AdminResetQueue resets task processing queue states
 func AdminResetQueue(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
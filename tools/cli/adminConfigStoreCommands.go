@@ -21,10 +21,13 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
+	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/types"
 
@@ -41,6 +44,8 @@ type cliEntry struct {
 type cliValue struct {
 	Value   interface{}
 	Filters []*cliFilter
+	// Source is the config layer the value comes from, when the dynamic config client has layers
+	Source string `json:"source,omitempty"`
 }
 
 type cliFilter struct {
@@ -48,6 +53,18 @@ type cliFilter struct {
 	Value interface{}
 }
 
+type cliDryRun struct {
+	Current *cliEntry
+	Result  *cliEntry
+}
+
+type cliVersion struct {
+	Version int64
+	Author  string
+	Time    time.Time
+	Entries []*cliEntry
+}
+
 // AdminGetDynamicConfig gets value of specified dynamic config parameter matching specified filter
 func AdminGetDynamicConfig(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -122,6 +139,7 @@ func AdminUpdateDynamicConfig(c *cli.Context) {
 	defer cancel()
 
 	var parsedValues []*types.DynamicConfigValue
+	var inputValues []*cliValue
 
 	if dcValues != nil {
 		parsedValues = make([]*types.DynamicConfigValue, 0, len(dcValues))
@@ -137,14 +155,21 @@ func AdminUpdateDynamicConfig(c *cli.Context) {
 				ErrorAndExit("Unable to convert from inputValue to DynamicConfigValue", err)
 			}
 			parsedValues = append(parsedValues, parsedValue)
+			inputValues = append(inputValues, parsedInputValue)
 		}
 	} else {
 		parsedValues = nil
 	}
 
+	if c.Bool(FlagDryRun) {
+		dryRunDynamicConfigUpdate(ctx, adminClient, dcName, inputValues)
+		return
+	}
+
 	req := &types.UpdateDynamicConfigRequest{
 		ConfigName:   dcName,
 		ConfigValues: parsedValues,
+		Identity:     getCliIdentity(),
 	}
 
 	err := adminClient.UpdateDynamicConfig(ctx, req)
@@ -154,6 +179,64 @@ func AdminUpdateDynamicConfig(c *cli.Context) {
 	fmt.Printf("Dynamic Config %q updated with %s \n", dcName, dcValues)
 }
 
+// dryRunDynamicConfigUpdate validates the values against the schema of the key and prints the current values
+// of the key along with the values it would have after the update, the default value applying when none matches
+func dryRunDynamicConfigUpdate(ctx context.Context, adminClient admin.Client, dcName string, inputValues []*cliValue) {
+	key, err := dynamicconfig.GetKeyFromKeyName(dcName)
+	if err != nil {
+		ErrorAndExit("Invalid dynamic config name", err)
+	}
+	for _, inputValue := range inputValues {
+		if err := dynamicconfig.ValidateValue(key, inputValue.Value); err != nil {
+			ErrorAndExit("Invalid dynamic config value", err)
+		}
+		filterNames := make([]string, 0, len(inputValue.Filters))
+		for _, filter := range inputValue.Filters {
+			filterNames = append(filterNames, filter.Name)
+		}
+		if err := dynamicconfig.ValidateFilters(key, filterNames); err != nil {
+			ErrorAndExit("Invalid dynamic config filters", err)
+		}
+	}
+
+	resp, err := adminClient.ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{
+		ConfigName: dcName,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get dynamic config value(s)", err)
+	}
+
+	dryRun := &cliDryRun{
+		Current: &cliEntry{
+			Name:         dcName,
+			DefaultValue: key.DefaultValue(),
+			Values:       []*cliValue{},
+		},
+		Result: &cliEntry{
+			Name:         dcName,
+			DefaultValue: key.DefaultValue(),
+			Values:       inputValues,
+		},
+	}
+	if dryRun.Result.Values == nil {
+		dryRun.Result.Values = []*cliValue{}
+	}
+	if resp == nil {
+		resp = &types.ListDynamicConfigResponse{}
+	}
+	for _, dcEntry := range resp.Entries {
+		if dcEntry.Name != dcName {
+			continue
+		}
+		currentEntry, err := convertToInputEntry(dcEntry)
+		if err != nil {
+			ErrorAndExit("Cannot parse list response", err)
+		}
+		dryRun.Current.Values = currentEntry.Values
+	}
+	prettyPrintJSONObject(dryRun)
+}
+
 // AdminRestoreDynamicConfig removes values of specified dynamic config parameter matching specified filter
 func AdminRestoreDynamicConfig(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -172,6 +255,7 @@ func AdminRestoreDynamicConfig(c *cli.Context) {
 	req := &types.RestoreDynamicConfigRequest{
 		ConfigName: dcName,
 		Filters:    parsedFilters,
+		Identity:   getCliIdentity(),
 	}
 
 	err = adminClient.RestoreDynamicConfig(ctx, req)
@@ -212,6 +296,73 @@ func AdminListDynamicConfig(c *cli.Context) {
 	}
 }
 
+// AdminListDynamicConfigHistory lists the stored versions of dynamic config, only the ones which changed the specified parameter if any.
+func AdminListDynamicConfigHistory(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &types.ListDynamicConfigHistoryRequest{
+		ConfigName: c.String(FlagDynamicConfigName),
+	}
+
+	val, err := adminClient.ListDynamicConfigHistory(ctx, req)
+	if err != nil {
+		ErrorAndExit("Failed to list dynamic config history", err)
+	}
+
+	if val == nil || len(val.Versions) == 0 {
+		fmt.Printf("No dynamic config versions stored to list.\n")
+		return
+	}
+	cliVersions := make([]*cliVersion, 0, len(val.Versions))
+	for _, version := range val.Versions {
+		cliEntries := make([]*cliEntry, 0, len(version.Entries))
+		for _, dcEntry := range version.Entries {
+			cliEntry, err := convertToInputEntry(dcEntry)
+			if err != nil {
+				ErrorAndExit("Cannot parse history response", err)
+			}
+			cliEntries = append(cliEntries, cliEntry)
+		}
+		cliVersions = append(cliVersions, &cliVersion{
+			Version: version.Version,
+			Author:  version.Author,
+			Time:    time.Unix(0, version.Timestamp),
+			Entries: cliEntries,
+		})
+	}
+	prettyPrintJSONObject(cliVersions)
+}
+
+// AdminRollbackDynamicConfig restores the values of a previous version of dynamic config, only of the specified parameter if any.
+func AdminRollbackDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	version := c.Int64(FlagDynamicConfigVersion)
+	dcName := c.String(FlagDynamicConfigName)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &types.RollbackDynamicConfigRequest{
+		Version:    version,
+		ConfigName: dcName,
+		Identity:   getCliIdentity(),
+	}
+
+	err := adminClient.RollbackDynamicConfig(ctx, req)
+	if err != nil {
+		ErrorAndExit("Failed to rollback dynamic config", err)
+	}
+	if dcName == "" {
+		fmt.Printf("Dynamic Config rolled back to version %v\n", version)
+	} else {
+		fmt.Printf("Dynamic Config %q rolled back to version %v\n", dcName, version)
+	}
+}
+
 // AdminListConfigKeys lists all available dynamic config keys with description and default value
 func AdminListConfigKeys(c *cli.Context) {
 
@@ -277,6 +428,7 @@ func convertToInputValue(dcValue *types.DynamicConfigValue) (*cliValue, error) {
 	return &cliValue{
 		Value:   val,
 		Filters: newFilters,
+		Source:  dcValue.Source,
 	}, nil
 }
 
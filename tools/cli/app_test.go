@@ -21,6 +21,7 @@
 package cli
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
@@ -183,6 +184,39 @@ func (s *cliAppSuite) TestDomainUpdate() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainUpdate_RoleBindings() {
+	resp := describeDomainResponseServer
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.UpdateDomainRequest, _ ...interface{}) (*types.UpdateDomainResponse, error) {
+			s.Equal([]*types.RoleBinding{
+				{Principal: "team-a", Role: types.DomainRoleWrite.Ptr()},
+				{Principal: "spiffe://cluster/ns/auditor", Role: types.DomainRoleRead.Ptr()},
+			}, request.RoleBindings)
+			return nil, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "update", "--rb", "team-a=write,spiffe://cluster/ns/auditor=read"})
+	s.Nil(err)
+
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.UpdateDomainRequest, _ ...interface{}) (*types.UpdateDomainResponse, error) {
+			s.NotNil(request.RoleBindings)
+			s.Empty(request.RoleBindings)
+			return nil, nil
+		})
+	err = s.app.Run([]string{"", "--do", domainName, "domain", "update", "--rb", ""})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainUpdate_InvalidRoleBindings() {
+	resp := describeDomainResponseServer
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
+	// osExit is stubbed out, so the command keeps running after reporting the error
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, nil).MaxTimes(1)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "update", "--rb", "team-a=owner"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestDomainUpdate_DomainNotExist() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -256,6 +256,7 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			BadBinaries:                            binBinaries,
 			Clusters:                               clusters,
 			DeleteBadBinary:                        badBinaryToDelete,
+			RoleBindings:                           roleBindings(c, FlagRoleBindings),
 		}
 	}
 
@@ -398,6 +399,8 @@ VisibilityArchivalStatus: {{.VisibilityArchivalStatus}}{{with .VisibilityArchiva
 VisibilityArchivalURI: {{.}}{{end}}
 {{with .BadBinaries}}Bad binaries to reset:
 {{table .}}{{end}}
+{{with .RoleBindings}}Role bindings:
+{{table .}}{{end}}
 {{with .FailoverInfo}}Graceful failover info:
 {{table .}}{{end}}`
 
@@ -452,6 +455,11 @@ type BadBinaryRow struct {
 	Reason    string    `header:"Reason"`
 }
 
+type RoleBindingRow struct {
+	Principal string `header:"Principal"`
+	Role      string `header:"Role"`
+}
+
 type FailoverInfoRow struct {
 	FailoverVersion     int64     `header:"Failover Version"`
 	StartTime           time.Time `header:"Start Time"`
@@ -477,6 +485,7 @@ type DomainRow struct {
 	VisibilityArchivalStatus types.ArchivalStatus `header:"Visibility Archival Status"`
 	VisibilityArchivalURI    string               `header:"Visibility Archival URI"`
 	BadBinaries              []BadBinaryRow
+	RoleBindings             []RoleBindingRow
 	FailoverInfo             *FailoverInfoRow
 	LongRunningWorkFlowNum   *int
 }
@@ -521,6 +530,7 @@ func newDomainRow(domain *types.DescribeDomainResponse) DomainRow {
 		VisibilityArchivalStatus: domain.Configuration.GetVisibilityArchivalStatus(),
 		VisibilityArchivalURI:    domain.Configuration.GetVisibilityArchivalURI(),
 		BadBinaries:              newBadBinaryRows(domain.Configuration.BadBinaries),
+		RoleBindings:             newRoleBindingRows(domain.Configuration.GetRoleBindings()),
 		FailoverInfo:             newFailoverInfoRow(domain.FailoverInfo),
 	}
 }
@@ -554,6 +564,17 @@ func newBadBinaryRows(bb *types.BadBinaries) []BadBinaryRow {
 	return rows
 }
 
+func newRoleBindingRows(bindings []*types.RoleBinding) []RoleBindingRow {
+	var rows []RoleBindingRow
+	for _, binding := range bindings {
+		rows = append(rows, RoleBindingRow{
+			Principal: binding.GetPrincipal(),
+			Role:      strings.ToLower(binding.GetRole().String()),
+		})
+	}
+	return rows
+}
+
 func domainTableOptions(c *cli.Context) RenderOptions {
 	printAll := c.Bool(FlagAll)
 	printFull := c.Bool(FlagPrintFullyDetail)
@@ -717,6 +738,35 @@ func archivalStatus(c *cli.Context, statusFlagName string) *types.ArchivalStatus
 	return nil
 }
 
+// roleBindings parses role bindings in principal1=role1,principal2=role2 format.
+// An empty value yields an empty, non-nil list which removes all bindings of the domain.
+func roleBindings(c *cli.Context, flagName string) []*types.RoleBinding {
+	if !c.IsSet(flagName) {
+		return nil
+	}
+	bindings := []*types.RoleBinding{}
+	for _, pair := range strings.Split(c.String(flagName), ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		idx := strings.LastIndex(pair, "=")
+		if idx <= 0 {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", flagName), fmt.Errorf("role binding %q must be in principal=role format", pair))
+		}
+		var role types.DomainRole
+		roleName := pair[idx+1:]
+		if err := role.UnmarshalText([]byte(roleName)); err != nil || strings.ToLower(role.String()) != strings.ToLower(roleName) {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", flagName), fmt.Errorf("invalid role %q, valid values are \"read\", \"write\" and \"admin\"", roleName))
+		}
+		bindings = append(bindings, &types.RoleBinding{
+			Principal: pair[:idx],
+			Role:      role.Ptr(),
+		})
+	}
+	return bindings
+}
+
 func clustersToStrings(clusters []*types.ClusterReplicationConfiguration) []string {
 	var res []string
 	for _, cluster := range clusters {
@@ -165,6 +165,10 @@ var (
 			Name:  FlagRemoveBadBinary,
 			Usage: "Binary checksum to remove for resetting workflow",
 		},
+		cli.StringFlag{
+			Name:  FlagRoleBindingsWithAlias,
+			Usage: "Replace the domain role bindings (must be in principal1=role1,principal2=role2 format with roles read, write or admin, e.g. team-a=write,cadence-admins=admin). An empty value removes all role bindings",
+		},
 		cli.StringFlag{
 			Name:  FlagReason,
 			Usage: "Reason for the operation",
@@ -421,6 +425,7 @@ func initializeDynamicConfig(
 	dynamicConfigClient, err := dynamicconfig.NewFileBasedClient(
 		&serviceConfig.DynamicConfig.FileBased,
 		logger,
+		initializeMetricsClient(),
 		doneChan,
 	)
 	if err != nil {
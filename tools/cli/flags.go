@@ -100,6 +100,7 @@ const (
 	FlagSkipCurrentCompleted              = "skip_current_completed"
 	FlagSkipBaseIsNotCurrent              = "skip_base_is_not_current"
 	FlagDryRun                            = "dry_run"
+	FlagDryRunWithAlias                   = FlagDryRun + ", dry-run"
 	FlagNonDeterministicOnly              = "only_non_deterministic"
 	FlagInputTopic                        = "input_topic"
 	FlagInputTopicWithAlias               = FlagInputTopic + ", it"
@@ -210,6 +211,8 @@ const (
 	FlagSearchAttributesType              = "search_attr_type"
 	FlagAddBadBinary                      = "add_bad_binary"
 	FlagRemoveBadBinary                   = "remove_bad_binary"
+	FlagRoleBindings                      = "role_bindings"
+	FlagRoleBindingsWithAlias             = FlagRoleBindings + ", rb"
 	FlagResetType                         = "reset_type"
 	FlagDecisionOffset                    = "decision_offset"
 	FlagResetPointsOnly                   = "reset_points_only"
@@ -293,6 +296,7 @@ const (
 	FlagDynamicConfigName                 = "name"
 	FlagDynamicConfigFilter               = "filter"
 	FlagDynamicConfigValue                = "value"
+	FlagDynamicConfigVersion              = "version"
 	FlagTransport                         = "transport"
 	FlagTransportWithAlias                = FlagTransport + ", t"
 	FlagFormat                            = "format"